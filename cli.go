@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/wire"
+)
+
+// cliCommands
+// HTTPサーバーを起動せずに運用の作業を行うサブコマンド。
+// サーバーと同じ設定を使う。
+var cliCommands = map[string]func(ctx context.Context, args []string) error{
+	"admin":    adminCommand,
+	"keys":     keysCommand,
+	"playlogs": playLogsCommand,
+	"migrate":  migrateCommand,
+	"storage":  storageCommand,
+	"seats":    seatsCommand,
+}
+
+// runCLI
+// サブコマンドを実行する。
+// シグナルを受け取った場合は、実行中の処理をキャンセルする。
+func runCLI(command func(ctx context.Context, args []string) error, args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return command(ctx, args)
+}
+
+// subcommand
+// 「admin add」の「add」のような、コマンドの後に続く操作を取り出す。
+func subcommand(command string, args []string, subcommands ...string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("usage: %s <%s>", command, strings.Join(subcommands, "|"))
+	}
+
+	for _, sub := range subcommands {
+		if args[0] == sub {
+			return sub, args[1:], nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("unknown subcommand %s %s: must be one of %s", command, args[0], strings.Join(subcommands, "|"))
+}
+
+// adminCommand
+// adminの追加・削除を行う。
+// 最初のadminの追加など、traQのセッションなしで行う必要がある場合に使う。
+func adminCommand(ctx context.Context, args []string) error {
+	sub, args, err := subcommand("admin", args, "add", "remove")
+	if err != nil {
+		return err
+	}
+
+	flagSet := flag.NewFlagSet("admin "+sub, flag.ExitOnError)
+	flagSet.Usage = func() {
+		fmt.Fprintf(flagSet.Output(), "usage: admin %s <traQ user ID>\n", sub)
+	}
+	err = flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	if flagSet.NArg() != 1 {
+		flagSet.Usage()
+		return errors.New("traQ user ID is required")
+	}
+
+	id, err := uuid.Parse(flagSet.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid traQ user ID: %w", err)
+	}
+	userID := values.NewTrapMemberID(id)
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	switch sub {
+	case "add":
+		err = app.AdminAuth.AddAdminByOperator(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to add admin: %w", err)
+		}
+		slog.Info("admin added", slog.String("userID", id.String()))
+	case "remove":
+		err = app.AdminAuth.DeleteAdminByOperator(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to remove admin: %w", err)
+		}
+		slog.Info("admin removed", slog.String("userID", id.String()))
+	}
+
+	return nil
+}
+
+// keysCommand
+// エディションのプロダクトキーをまとめて生成し、標準出力に書き出す。
+func keysCommand(ctx context.Context, args []string) error {
+	_, args, err := subcommand("keys", args, "generate")
+	if err != nil {
+		return err
+	}
+
+	flagSet := flag.NewFlagSet("keys generate", flag.ExitOnError)
+	strEditionID := flagSet.String("edition", "", "プロダクトキーを生成するエディションのID")
+	count := flagSet.Uint("count", 1, "生成するプロダクトキーの数")
+	out := flagSet.String("out", "csv", "出力形式(csv、text)")
+	err = flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	editionUUID, err := uuid.Parse(*strEditionID)
+	if err != nil {
+		return fmt.Errorf("invalid edition ID: %w", err)
+	}
+	if *out != "csv" && *out != "text" {
+		return fmt.Errorf("invalid output format: %s", *out)
+	}
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	productKeys, err := app.EditionAuth.GenerateProductKey(ctx, values.NewEditionIDFromUUID(editionUUID), *count)
+	if err != nil {
+		return fmt.Errorf("failed to generate product keys: %w", err)
+	}
+	slog.Info("product keys generated",
+		slog.String("editionID", editionUUID.String()),
+		slog.Int("count", len(productKeys)),
+	)
+
+	if *out == "text" {
+		return writeProductKeysText(os.Stdout, productKeys)
+	}
+
+	return writeProductKeysCSV(os.Stdout, productKeys)
+}
+
+func writeProductKeysCSV(w io.Writer, productKeys []*domain.LauncherUser) error {
+	csvWriter := csv.NewWriter(w)
+
+	err := csvWriter.Write([]string{"id", "product_key", "status", "created_at"})
+	if err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, productKey := range productKeys {
+		var status string
+		switch productKey.GetStatus() {
+		case values.LauncherUserStatusActive:
+			status = "active"
+		case values.LauncherUserStatusInactive:
+			status = "revoked"
+		}
+
+		err = csvWriter.Write([]string{
+			uuid.UUID(productKey.GetID()).String(),
+			string(productKey.GetProductKey()),
+			status,
+			productKey.GetCreatedAt().Format(time.RFC3339),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write product key: %w", err)
+		}
+	}
+
+	csvWriter.Flush()
+	err = csvWriter.Error()
+	if err != nil {
+		return fmt.Errorf("failed to flush csv: %w", err)
+	}
+
+	return nil
+}
+
+func writeProductKeysText(w io.Writer, productKeys []*domain.LauncherUser) error {
+	for _, productKey := range productKeys {
+		_, err := fmt.Fprintln(w, productKey.GetProductKey())
+		if err != nil {
+			return fmt.Errorf("failed to write product key: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// playLogsCommand
+// 定期実行ジョブと同じく、長すぎるプレイログを削除する。
+func playLogsCommand(ctx context.Context, args []string) error {
+	_, _, err := subcommand("playlogs", args, "cleanup")
+	if err != nil {
+		return err
+	}
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	err = app.GamePlayLog.DeleteLongLogs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete long play logs: %w", err)
+	}
+	slog.Info("long play logs deleted")
+
+	return nil
+}
+
+// migrateCommand
+// DBのマイグレーションの状態の確認と適用を行う。
+// statusではマイグレーションを適用しない。
+func migrateCommand(ctx context.Context, args []string) error {
+	sub, _, err := subcommand("migrate", args, "status", "up")
+	if err != nil {
+		return err
+	}
+
+	app, err := wire.InjectMigration()
+	if err != nil {
+		return fmt.Errorf("failed to inject migration: %w", err)
+	}
+	defer app.DB.Close()
+
+	if sub == "up" {
+		err = app.Migrate(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to migrate: %w", err)
+		}
+	}
+
+	current, latest, err := app.GetMigrationVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get migration versions: %w", err)
+	}
+
+	if current == "" {
+		current = "none"
+	}
+	fmt.Printf("current: %s\nlatest: %s\nup to date: %t\n", current, latest, current == latest)
+
+	return nil
+}
+
+// storageCommand
+// ストレージに関する運用の作業を行う。
+func storageCommand(ctx context.Context, args []string) error {
+	sub, args, err := subcommand("storage", args, "verify", "backfill-sizes")
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "backfill-sizes":
+		return storageBackfillSizesCommand(ctx, args)
+	default:
+		return storageVerifyCommand(ctx, args)
+	}
+}
+
+// storageVerifyCommand
+// ストレージに接続してファイルを読み書きできるかを確認した後、
+// DBに記録されている全てのファイルがストレージに存在するかを検証する。
+// 問題が見つかった場合はエラーを返す。
+func storageVerifyCommand(ctx context.Context, args []string) error {
+	flagSet := flag.NewFlagSet("storage verify", flag.ExitOnError)
+	connectionOnly := flagSet.Bool("connection-only", false, "接続の確認のみを行い、ファイルの検証は行わない")
+	err := flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	err = app.StorageHealth.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("storage is unavailable: %w", err)
+	}
+	slog.Info("storage is available")
+
+	if *connectionOnly {
+		return nil
+	}
+
+	verification, err := app.StorageVerification.VerifyStorage(ctx)
+	if verification != nil {
+		slog.Info("storage verification finished",
+			slog.String("id", uuid.UUID(verification.GetID()).String()),
+			slog.Int("total", verification.GetTotalObjects()),
+			slog.Int("checked", verification.GetCheckedObjects()),
+			slog.Int("missing", verification.GetIssueCount(values.StorageVerificationIssueTypeMissing)),
+			slog.Int("hashMismatch", verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch)),
+			slog.Int("checkFailed", verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed)),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to verify storage: %w", err)
+	}
+
+	return nil
+}
+
+// storageBackfillSizesCommand
+// 容量が記録されていないゲームファイル・ゲーム画像・ゲーム動画の容量を、ストレージから読み込んで記録する。
+// 失敗したものがあった場合はエラーを返す。
+func storageBackfillSizesCommand(ctx context.Context, args []string) error {
+	flagSet := flag.NewFlagSet("storage backfill-sizes", flag.ExitOnError)
+	err := flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	result, err := app.StorageSizeBackfill.BackfillStorageSizes(ctx)
+	slog.Info("storage size backfill finished",
+		slog.Int("total", result.Total),
+		slog.Int("updated", result.Updated),
+		slog.Int("failed", result.Failed),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to backfill storage sizes: %w", err)
+	}
+
+	return nil
+}
+
+// seatsCommand
+// 座席数を変更する。
+// 既に存在する座席の状態は保持する。
+func seatsCommand(ctx context.Context, args []string) error {
+	_, args, err := subcommand("seats", args, "set")
+	if err != nil {
+		return err
+	}
+
+	flagSet := flag.NewFlagSet("seats set", flag.ExitOnError)
+	count := flagSet.Uint("count", 0, "座席数(必須)")
+	err = flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	// 指定忘れで座席が全て削除されないよう、0であっても明示的な指定を必須にする
+	countSet := false
+	flagSet.Visit(func(f *flag.Flag) {
+		if f.Name == "count" {
+			countSet = true
+		}
+	})
+	if !countSet {
+		flagSet.Usage()
+		return errors.New("--count is required")
+	}
+
+	app, err := wire.InjectCLI()
+	if err != nil {
+		return fmt.Errorf("failed to inject cli: %w", err)
+	}
+	defer app.Close()
+
+	seats, err := app.Seat.UpdateSeatNum(ctx, *count)
+	if err != nil {
+		return fmt.Errorf("failed to update seat num: %w", err)
+	}
+	slog.Info("seat num updated", slog.Int("count", len(seats)))
+
+	return nil
+}
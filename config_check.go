@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	v1 "github.com/traPtitech/trap-collection-server/src/config/v1"
+)
+
+// configCheck
+// 実際に使われる設定の値をシークレットを隠して出力し、設定が正しいかを確認する。
+func configCheck(w io.Writer) error {
+	err := v1.LoadConfigFile()
+	if err != nil {
+		return fmt.Errorf("failed to load config file: %w", err)
+	}
+
+	settings := v1.EffectiveSettings()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tFILE KEY\tVALUE\tSOURCE")
+	for _, setting := range settings {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", setting.Key, setting.FileKey, setting.Value, setting.Source)
+	}
+	err = tw.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to write settings: %w", err)
+	}
+
+	err = v1.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	fmt.Fprintln(w, "config is valid")
+
+	return nil
+}
@@ -1,16 +1,83 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	v1 "github.com/traPtitech/trap-collection-server/src/config/v1"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/wire"
 )
 
 func main() {
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "check" {
+		err := configCheck(os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	err := v1.LoadConfigFile()
+	if err != nil {
+		panic(err)
+	}
+
+	appLogger, err := logger.New(v1.NewLog(v1.NewApp()), os.Stderr)
+	if err != nil {
+		panic(err)
+	}
+	slog.SetDefault(appLogger)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate-storage" {
+		err = migrateStorage(os.Args[2:])
+		if err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	if len(os.Args) > 1 {
+		command, ok := cliCommands[os.Args[1]]
+		if ok {
+			err = runCLI(command, os.Args[2:])
+			if err != nil {
+				slog.Error("command failed", slog.String("command", os.Args[1]), slog.Any("error", err))
+				os.Exit(1)
+			}
+
+			return
+		}
+	}
+
+	// 設定の誤りに起動途中で気づかないよう、全ての設定を先に確認する
+	err = v1.Validate()
+	if err != nil {
+		panic(fmt.Errorf("invalid config: %w", err))
+	}
+
 	app, err := wire.InjectApp()
 	if err != nil {
 		panic(err)
 	}
 
-	err = app.Run()
+	// SIGTERMはKubernetesなどからの停止時に送られる
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		// 終了処理中にもう一度シグナルを受け取った場合は、終了処理を待たずに終了する
+		<-ctx.Done()
+		stop()
+	}()
+
+	err = app.Run(ctx)
 	if err != nil {
 		panic(err)
 	}
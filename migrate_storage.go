@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/wire"
+)
+
+// migrateStorage
+// STORAGE_FALLBACKのストレージからSTORAGEのストレージへファイルを移行する。
+func migrateStorage(args []string) error {
+	flagSet := flag.NewFlagSet("migrate-storage", flag.ExitOnError)
+	verifyExisting := flagSet.Bool("verify-existing", false, "移行先に既に存在するゲームファイルもハッシュ値を検証する")
+	err := flagSet.Parse(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	app, err := wire.InjectStorageMigration()
+	if err != nil {
+		return fmt.Errorf("failed to inject storage migration: %w", err)
+	}
+	defer app.DB.Close()
+
+	// 中断しても再実行で続きから移行できるので、シグナルを受け取ったら処理中のオブジェクトの後で止める
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	slog.Info("MigrateStorage: 開始")
+	progress, err := app.MigrateStorage(ctx, *verifyExisting, func(progress service.StorageMigrationProgress) {
+		if progress.Done%100 == 0 || progress.Done == progress.Total {
+			logStorageMigrationProgress(progress)
+		}
+	})
+	logStorageMigrationProgress(progress)
+	if err != nil {
+		slog.Error("MigrateStorage: エラー", slog.Any("error", err))
+		return err
+	}
+	slog.Info("MigrateStorage: 終了")
+
+	return nil
+}
+
+func logStorageMigrationProgress(progress service.StorageMigrationProgress) {
+	slog.Info("MigrateStorage: 進捗",
+		slog.Int("done", progress.Done),
+		slog.Int("total", progress.Total),
+		slog.Int("copied", progress.Copied),
+		slog.Int("skipped", progress.Skipped),
+		slog.Int("failed", progress.Failed),
+	)
+}
@@ -0,0 +1,17 @@
+-- Create "audit_logs" table
+CREATE TABLE `audit_logs` (
+  `id` varchar(36) NOT NULL,
+  `actor_id` varchar(36) NOT NULL,
+  `actor_name` varchar(32) NOT NULL,
+  `action` varchar(64) NOT NULL,
+  `target_type` varchar(32) NOT NULL,
+  `target_id` varchar(36) NOT NULL,
+  `before` text NULL,
+  `after` text NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  PRIMARY KEY (`id`),
+  INDEX `idx_audit_logs_action` (`action`),
+  INDEX `idx_audit_logs_actor_id` (`actor_id`),
+  INDEX `idx_audit_logs_created_at` (`created_at`),
+  INDEX `idx_audit_logs_target` (`target_type`, `target_id`)
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
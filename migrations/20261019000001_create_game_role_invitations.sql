@@ -0,0 +1,17 @@
+-- Create "game_role_invitations" table
+CREATE TABLE `game_role_invitations` (
+  `id` varchar(36) NOT NULL,
+  `game_id` varchar(36) NOT NULL,
+  `inviter_id` varchar(36) NOT NULL,
+  `invitee_id` varchar(36) NOT NULL,
+  `role_type_id` tinyint NOT NULL,
+  `status` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  `expires_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `fk_game_role_invitations_role_type_table` (`role_type_id`),
+  INDEX `idx_game_role_invitations_game_id` (`game_id`),
+  INDEX `idx_game_role_invitations_invitee_id` (`invitee_id`),
+  CONSTRAINT `fk_game_role_invitations_game` FOREIGN KEY (`game_id`) REFERENCES `games` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `fk_game_role_invitations_role_type_table` FOREIGN KEY (`role_type_id`) REFERENCES `game_management_role_types` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
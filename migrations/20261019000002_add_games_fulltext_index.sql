@@ -0,0 +1,2 @@
+-- Modify "games" table
+ALTER TABLE `games` ADD FULLTEXT INDEX `idx_games_name_description` (`name`, `description`);
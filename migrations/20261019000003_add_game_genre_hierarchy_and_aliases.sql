@@ -0,0 +1,13 @@
+-- Modify "game_genres" table
+ALTER TABLE `game_genres` ADD COLUMN `parent_id` varchar(36) NULL DEFAULT NULL AFTER `name`, ADD INDEX `idx_game_genres_parent_id` (`parent_id`), ADD CONSTRAINT `fk_game_genres_parent` FOREIGN KEY (`parent_id`) REFERENCES `game_genres` (`id`) ON UPDATE RESTRICT ON DELETE SET NULL;
+-- Create "game_genre_aliases" table
+CREATE TABLE `game_genre_aliases` (
+  `id` varchar(36) NOT NULL,
+  `genre_id` varchar(36) NOT NULL,
+  `name` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  PRIMARY KEY (`id`),
+  INDEX `idx_game_genre_aliases_genre_id` (`genre_id`),
+  UNIQUE INDEX `uni_game_genre_aliases_name` (`name`),
+  CONSTRAINT `fk_game_genre_aliases_genre` FOREIGN KEY (`genre_id`) REFERENCES `game_genres` (`id`) ON UPDATE RESTRICT ON DELETE CASCADE
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
@@ -0,0 +1,6 @@
+-- Modify "game_creators" table
+ALTER TABLE `game_creators` MODIFY COLUMN `user_id` varchar(36) NULL DEFAULT NULL, MODIFY COLUMN `user_name` varchar(32) NULL DEFAULT NULL, ADD COLUMN `external_name` varchar(64) NULL DEFAULT NULL AFTER `user_name`, ADD COLUMN `external_url` text NULL DEFAULT NULL AFTER `external_name`, ADD COLUMN `display_order` int NOT NULL DEFAULT 0 AFTER `external_url`;
+-- Modify "game_creator_job_relations" table
+ALTER TABLE `game_creator_job_relations` ADD COLUMN `display_order` int NOT NULL DEFAULT 0;
+-- Modify "game_creator_custom_job_relations" table
+ALTER TABLE `game_creator_custom_job_relations` ADD COLUMN `display_order` int NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+-- Create "v2_game_image_variants" table
+CREATE TABLE `v2_game_image_variants` (
+  `id` varchar(36) NOT NULL,
+  `game_image_id` varchar(36) NOT NULL,
+  `size` varchar(16) NOT NULL,
+  `image_type_id` tinyint NOT NULL,
+  `width` int NOT NULL,
+  `height` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  PRIMARY KEY (`id`),
+  INDEX `fk_v2_game_image_variants_game_image_type` (`image_type_id`),
+  UNIQUE INDEX `idx_game_image_variant_unique` (`game_image_id`, `size`, `image_type_id`),
+  CONSTRAINT `fk_v2_game_image_variants_game_image` FOREIGN KEY (`game_image_id`) REFERENCES `v2_game_images` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `fk_v2_game_image_variants_game_image_type` FOREIGN KEY (`image_type_id`) REFERENCES `game_image_types` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
+-- Add "webp" to "game_image_types"
+INSERT INTO `game_image_types` (`id`, `name`, `active`) VALUES (4, 'webp', 1);
@@ -0,0 +1,2 @@
+-- Modify "v2_game_videos" table
+ALTER TABLE `v2_game_videos` ADD COLUMN `duration_ms` bigint NULL DEFAULT NULL AFTER `video_type_id`, ADD COLUMN `width` int NULL DEFAULT NULL AFTER `duration_ms`, ADD COLUMN `height` int NULL DEFAULT NULL AFTER `width`, ADD COLUMN `video_codec` varchar(16) NULL DEFAULT NULL AFTER `height`, ADD COLUMN `audio_codec` varchar(16) NULL DEFAULT NULL AFTER `video_codec`, ADD COLUMN `poster_image_type_id` tinyint NULL DEFAULT NULL AFTER `audio_codec`, ADD INDEX `fk_v2_game_videos_poster_image_type` (`poster_image_type_id`), ADD CONSTRAINT `fk_v2_game_videos_poster_image_type` FOREIGN KEY (`poster_image_type_id`) REFERENCES `game_image_types` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT;
@@ -0,0 +1,7 @@
+-- Create "v2_game_file_blobs" table
+CREATE TABLE `v2_game_file_blobs` (
+  `hash` char(32) NOT NULL,
+  `ref_count` int unsigned NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  PRIMARY KEY (`hash`)
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
@@ -0,0 +1,15 @@
+-- Modify "v2_game_files" table
+ALTER TABLE `v2_game_files` ADD COLUMN `size` bigint NOT NULL DEFAULT 0 AFTER `entry_point`;
+-- Modify "v2_game_images" table
+ALTER TABLE `v2_game_images` ADD COLUMN `size` bigint NOT NULL DEFAULT 0 AFTER `image_type_id`;
+-- Modify "v2_game_videos" table
+ALTER TABLE `v2_game_videos` ADD COLUMN `size` bigint NOT NULL DEFAULT 0 AFTER `video_type_id`;
+-- Create "v2_game_storage_quotas" table
+CREATE TABLE `v2_game_storage_quotas` (
+  `game_id` varchar(36) NOT NULL,
+  `quota` bigint NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  `updated_at` datetime NOT NULL DEFAULT (current_timestamp()) ON UPDATE current_timestamp(),
+  PRIMARY KEY (`game_id`),
+  CONSTRAINT `fk_v2_game_storage_quotas_game` FOREIGN KEY (`game_id`) REFERENCES `games` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
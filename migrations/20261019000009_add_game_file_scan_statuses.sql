@@ -0,0 +1,17 @@
+-- Create "game_file_scan_statuses" table
+CREATE TABLE `game_file_scan_statuses` (
+  `id` tinyint NOT NULL AUTO_INCREMENT,
+  `name` varchar(32) NOT NULL,
+  `active` bool NULL DEFAULT 1,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uni_game_file_scan_statuses_name` (`name`)
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
+-- Add scan statuses to "game_file_scan_statuses"
+INSERT INTO `game_file_scan_statuses` (`id`, `name`, `active`)
+VALUES
+  (1,	'pending',	1),
+  (2,	'clean',	1),
+  (3,	'rejected',	1);
+-- Modify "v2_game_files" table
+-- 既存のファイルも未検査として扱い、定期実行のジョブで検査する
+ALTER TABLE `v2_game_files` ADD COLUMN `scan_status_id` tinyint NOT NULL DEFAULT 1 AFTER `size`, ADD INDEX `fk_v2_game_files_scan_status` (`scan_status_id`), ADD CONSTRAINT `fk_v2_game_files_scan_status` FOREIGN KEY (`scan_status_id`) REFERENCES `game_file_scan_statuses` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT;
@@ -0,0 +1,30 @@
+-- Create "edition_bundle_statuses" table
+CREATE TABLE `edition_bundle_statuses` (
+  `id` tinyint NOT NULL AUTO_INCREMENT,
+  `name` varchar(32) NOT NULL,
+  `active` bool NULL DEFAULT 1,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uni_edition_bundle_statuses_name` (`name`)
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
+-- Add bundle statuses to "edition_bundle_statuses"
+INSERT INTO `edition_bundle_statuses` (`id`, `name`, `active`)
+VALUES
+  (1,	'pending',	1),
+  (2,	'building',	1),
+  (3,	'completed',	1),
+  (4,	'failed',	1);
+-- Create "edition_bundles" table
+CREATE TABLE `edition_bundles` (
+  `id` varchar(36) NOT NULL,
+  `edition_id` varchar(36) NOT NULL,
+  `status_id` tinyint NOT NULL,
+  `total_items` int NOT NULL DEFAULT 0,
+  `processed_items` int NOT NULL DEFAULT 0,
+  `size` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT (current_timestamp()),
+  PRIMARY KEY (`id`),
+  INDEX `fk_edition_bundles_status` (`status_id`),
+  INDEX `idx_edition_bundles_edition_id_created_at` (`edition_id`, `created_at`),
+  CONSTRAINT `fk_edition_bundles_edition` FOREIGN KEY (`edition_id`) REFERENCES `editions` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `fk_edition_bundles_status` FOREIGN KEY (`status_id`) REFERENCES `edition_bundle_statuses` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
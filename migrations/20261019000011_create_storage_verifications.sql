@@ -0,0 +1,41 @@
+-- Create "storage_verification_statuses" table
+CREATE TABLE `storage_verification_statuses` (
+  `id` tinyint NOT NULL AUTO_INCREMENT,
+  `name` varchar(32) NOT NULL,
+  `active` bool NULL DEFAULT 1,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uni_storage_verification_statuses_name` (`name`)
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
+-- Add verification statuses to "storage_verification_statuses"
+INSERT INTO `storage_verification_statuses` (`id`, `name`, `active`)
+VALUES
+  (1,	'running',	1),
+  (2,	'completed',	1),
+  (3,	'failed',	1);
+-- Create "storage_verifications" table
+CREATE TABLE `storage_verifications` (
+  `id` varchar(36) NOT NULL,
+  `status_id` tinyint NOT NULL,
+  `total_objects` int NOT NULL DEFAULT 0,
+  `checked_objects` int NOT NULL DEFAULT 0,
+  `missing_objects` int NOT NULL DEFAULT 0,
+  `hash_mismatch_objects` int NOT NULL DEFAULT 0,
+  `check_failed_objects` int NOT NULL DEFAULT 0,
+  `started_at` datetime NOT NULL,
+  `finished_at` datetime NULL,
+  PRIMARY KEY (`id`),
+  INDEX `fk_storage_verifications_status` (`status_id`),
+  INDEX `idx_storage_verifications_started_at` (`started_at`),
+  CONSTRAINT `fk_storage_verifications_status` FOREIGN KEY (`status_id`) REFERENCES `storage_verification_statuses` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
+-- Create "storage_verification_issues" table
+CREATE TABLE `storage_verification_issues` (
+  `storage_verification_id` varchar(36) NOT NULL,
+  `object_kind` varchar(32) NOT NULL,
+  `object_id` varchar(36) NOT NULL,
+  `game_id` varchar(36) NOT NULL,
+  `issue_type` varchar(32) NOT NULL,
+  `detail` text NOT NULL,
+  PRIMARY KEY (`storage_verification_id`, `object_kind`, `object_id`),
+  CONSTRAINT `fk_storage_verification_issues_storage_verification` FOREIGN KEY (`storage_verification_id`) REFERENCES `storage_verifications` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_general_ci;
@@ -0,0 +1,6 @@
+-- Modify "v2_game_images" table
+ALTER TABLE `v2_game_images` ADD COLUMN `variants_generated` bool NOT NULL DEFAULT 0 AFTER `size`;
+-- 派生画像が存在する画像は生成済みとする
+-- 派生画像が存在しない画像は、定期実行のジョブで1度だけ生成を試みる
+UPDATE `v2_game_images` SET `variants_generated` = 1
+WHERE EXISTS (SELECT 1 FROM `v2_game_image_variants` WHERE `v2_game_image_variants`.`game_image_id` = `v2_game_images`.`id`);
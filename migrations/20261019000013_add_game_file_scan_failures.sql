@@ -0,0 +1,11 @@
+-- Add scan statuses to "game_file_scan_statuses"
+INSERT INTO `game_file_scan_statuses` (`id`, `name`, `active`)
+VALUES
+  (4,	'failed',	1);
+-- Modify "v2_game_files" table
+ALTER TABLE `v2_game_files` ADD COLUMN `scan_attempts` int NOT NULL DEFAULT 0 AFTER `scan_status_id`;
+-- 検査の導入前からゲームバージョンに紐づいて配信されているファイルは、検査を通過したものとして扱う
+-- 未検査のファイルはゲームバージョンに紐づけられないので、未検査で紐づいているファイルは検査の導入前のもののみ
+UPDATE `v2_game_files` SET `scan_status_id` = 2
+WHERE `scan_status_id` = 1
+  AND EXISTS (SELECT 1 FROM `game_version_game_file_relations` WHERE `game_version_game_file_relations`.`game_file_id` = `v2_game_files`.`id`);
@@ -0,0 +1,2 @@
+-- Modify "edition_bundles" table
+ALTER TABLE `edition_bundles` ADD COLUMN `owner` varchar(36) NULL DEFAULT NULL AFTER `created_at`, ADD COLUMN `heartbeat_at` datetime NULL DEFAULT NULL AFTER `owner`;
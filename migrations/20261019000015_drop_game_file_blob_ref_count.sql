@@ -0,0 +1,2 @@
+-- Modify "v2_game_file_blobs" table
+ALTER TABLE `v2_game_file_blobs` DROP COLUMN `ref_count`;
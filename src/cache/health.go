@@ -0,0 +1,13 @@
+package cache
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import "context"
+
+// Health
+// readinessの確認のため、キャッシュの状態を確認する。
+type Health interface {
+	// Ping
+	// キャッシュを利用できる状態かを確認する。
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+)
+
+// getValue
+// ローカルキャッシュ、Redisの順に値を探す。
+// どちらにも無い場合はcache.ErrCacheMissを返す。
+func getValue[T any](ctx context.Context, client *Client, localCache *localCache[T], key string, cacheType string) (T, error) {
+	var zero T
+
+	value, generation, ok := localCache.get(key)
+	if ok {
+		hitCount.WithLabelValues(cacheType, "local_hit").Inc()
+		return value, nil
+	}
+
+	// ローカルキャッシュの有効期限をRedisに合わせるため、TTLも同時に取得する
+	var (
+		getCmd *redis.StringCmd
+		ttlCmd *redis.DurationCmd
+	)
+	_, err := client.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		getCmd = pipe.Get(ctx, key)
+		ttlCmd = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		hitCount.WithLabelValues(cacheType, "miss").Inc()
+		return zero, cache.ErrCacheMiss
+	}
+	if err != nil {
+		return zero, fmt.Errorf("failed to get %s: %w", cacheType, err)
+	}
+	hitCount.WithLabelValues(cacheType, "hit").Inc()
+
+	err = json.Unmarshal([]byte(getCmd.Val()), &value)
+	if err != nil {
+		return zero, fmt.Errorf("failed to unmarshal %s: %w", cacheType, err)
+	}
+
+	if ttl := ttlCmd.Val(); ttl > 0 {
+		localCache.set(key, value, ttl, generation)
+	}
+
+	return value, nil
+}
+
+// setValue
+// Redisに値を書き込み、全レプリカのローカルキャッシュを無効化する
+func setValue[T any](ctx context.Context, client *Client, key string, value T, ttl time.Duration, cacheType string) error {
+	if ttl <= 0 {
+		return fmt.Errorf("invalid ttl for %s: %s", cacheType, ttl)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", cacheType, err)
+	}
+
+	err = client.client.Set(ctx, key, data, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to set %s: %w", cacheType, err)
+	}
+
+	client.publishInvalidation(ctx, key)
+
+	return nil
+}
+
+// deleteValue
+// Redisから値を削除し、全レプリカのローカルキャッシュを無効化する
+func deleteValue(ctx context.Context, client *Client, key string, cacheType string) error {
+	err := client.client.Del(ctx, key).Err()
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", cacheType, err)
+	}
+
+	client.publishInvalidation(ctx, key)
+
+	return nil
+}
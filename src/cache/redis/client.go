@@ -0,0 +1,117 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+)
+
+const (
+	keyPrefix = "trap_collection:cache:"
+	// invalidateChannel
+	// 書き込みのあったキーを各レプリカに通知するチャンネル
+	invalidateChannel = "trap_collection:cache:invalidate"
+)
+
+// Client
+// Redisのクライアント。
+// 書き込みのあったキーをpub/subで受け取り、各レプリカのローカルキャッシュを無効化する。
+type Client struct {
+	client *redis.Client
+	pubsub *redis.PubSub
+
+	localCachesLocker sync.RWMutex
+	localCaches       []invalidator
+}
+
+type invalidator interface {
+	invalidate(key string)
+}
+
+func NewClient(conf config.CacheRedis) (*Client, error) {
+	redisURL, err := conf.URL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redis url: %w", err)
+	}
+
+	options, err := redis.ParseURL(redisURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis url: %w", err)
+	}
+
+	return newClient(context.Background(), redis.NewClient(options))
+}
+
+func newClient(ctx context.Context, redisClient *redis.Client) (*Client, error) {
+	err := redisClient.Ping(ctx).Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping redis: %w", err)
+	}
+
+	pubsub := redisClient.Subscribe(ctx, invalidateChannel)
+	// 購読の完了を待たないと、直後の書き込みの通知を取りこぼす
+	_, err = pubsub.Receive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe invalidate channel: %w", err)
+	}
+
+	client := &Client{
+		client: redisClient,
+		pubsub: pubsub,
+	}
+
+	go client.watchInvalidation()
+
+	return client, nil
+}
+
+func (c *Client) watchInvalidation() {
+	// 再接続時にはgo-redisが自動で再購読する。
+	// 切断中の通知は取りこぼすが、ローカルキャッシュのTTLで古い値は消える。
+	for msg := range c.pubsub.Channel() {
+		c.localCachesLocker.RLock()
+		for _, localCache := range c.localCaches {
+			localCache.invalidate(msg.Payload)
+		}
+		c.localCachesLocker.RUnlock()
+	}
+}
+
+func (c *Client) addLocalCache(localCache invalidator) {
+	c.localCachesLocker.Lock()
+	defer c.localCachesLocker.Unlock()
+
+	c.localCaches = append(c.localCaches, localCache)
+}
+
+// publishInvalidation
+// 全レプリカ(自身を含む)のローカルキャッシュからkeyを削除させる
+func (c *Client) publishInvalidation(ctx context.Context, key string) {
+	err := c.client.Publish(ctx, invalidateChannel, key).Err()
+	if err != nil {
+		// 通知に失敗しても、ローカルキャッシュのTTLで古い値は消えるので致命傷ではない
+		logger.Error(ctx, "failed to publish cache invalidation", slog.Any("error", err))
+	}
+}
+
+// Close
+// pub/subの購読とRedisとの接続を閉じる。
+// 購読を閉じるとローカルキャッシュの無効化の監視も終了する。
+func (c *Client) Close() error {
+	var errs []error
+	if err := c.pubsub.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close redis pubsub: %w", err))
+	}
+
+	if err := c.client.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close redis client: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// newTestClient
+// miniredisに接続したClientを作る。
+// 同じminiredisに対して複数回呼ぶことで、複数のレプリカを再現できる。
+func newTestClient(t *testing.T, server *miniredis.Miniredis) *Client {
+	t.Helper()
+
+	client, err := newClient(context.Background(), redis.NewClient(&redis.Options{
+		Addr: server.Addr(),
+	}))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed to close client: %v", err)
+		}
+	})
+
+	return client
+}
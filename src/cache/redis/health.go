@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/traPtitech/trap-collection-server/src/cache"
+)
+
+var _ cache.Health = &Health{}
+
+type Health struct {
+	client *Client
+}
+
+func NewHealth(client *Client) *Health {
+	return &Health{
+		client: client,
+	}
+}
+
+func (h *Health) Ping(ctx context.Context) error {
+	err := h.client.client.Ping(ctx).Err()
+	if err != nil {
+		return fmt.Errorf("failed to ping redis: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+	health := NewHealth(newTestClient(t, server))
+
+	err := health.Ping(context.Background())
+	assert.NoError(t, err)
+
+	// Redisが停止しているのでエラー
+	server.Close()
+
+	err = health.Ping(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package redis
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// maxLocalTTL
+	// pub/subの通知を取りこぼした場合に備えて、ローカルキャッシュに保持する最大の時間
+	maxLocalTTL = 5 * time.Minute
+	// maxLocalEntries
+	// ローカルキャッシュに保持する最大の要素数
+	maxLocalEntries = 1000
+)
+
+// localCache
+// Redisへの問い合わせを減らすためのプロセス内のキャッシュ。
+// 書き込み時にはpub/sub経由で全レプリカから削除される。
+type localCache[T any] struct {
+	locker  sync.Mutex
+	entries map[string]localEntry[T]
+	// generation
+	// 無効化のたびに増える値。
+	// Redisからの読み込み中に無効化された場合に、古い値をローカルキャッシュに入れないために使う。
+	generation uint64
+}
+
+type localEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+func newLocalCache[T any]() *localCache[T] {
+	return &localCache[T]{
+		entries: map[string]localEntry[T]{},
+	}
+}
+
+func (lc *localCache[T]) get(key string) (T, uint64, bool) {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	entry, ok := lc.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		var zero T
+		return zero, lc.generation, false
+	}
+
+	return entry.value, lc.generation, true
+}
+
+// set
+// getからgenerationが変わっていない場合のみ値を保持する
+func (lc *localCache[T]) set(key string, value T, ttl time.Duration, generation uint64) {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	if generation != lc.generation {
+		return
+	}
+
+	now := time.Now()
+	if len(lc.entries) >= maxLocalEntries {
+		for k, entry := range lc.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(lc.entries, k)
+			}
+		}
+
+		if len(lc.entries) >= maxLocalEntries {
+			clear(lc.entries)
+		}
+	}
+
+	lc.entries[key] = localEntry[T]{
+		value:     value,
+		expiresAt: now.Add(min(ttl, maxLocalTTL)),
+	}
+}
+
+func (lc *localCache[T]) invalidate(key string) {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	lc.generation++
+	delete(lc.entries, key)
+}
@@ -0,0 +1,14 @@
+package redis
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// hitCount
+// statusはlocal_hit(ローカルキャッシュにヒット)、hit(Redisにヒット)、missのいずれか
+var hitCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "cache_trap_collection",
+	Subsystem: "redis",
+	Name:      "hit_count",
+}, []string{"type", "status"})
@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+const (
+	activeSeatsKey = keyPrefix + "seat:active_seats"
+)
+
+type Seat struct {
+	client         *Client
+	localCache     *localCache[[]seat]
+	activeSeatsTTL time.Duration
+}
+
+type seat struct {
+	ID     uint              `json:"id"`
+	Status values.SeatStatus `json:"status"`
+}
+
+func NewSeat(conf config.CacheRedis, client *Client) (*Seat, error) {
+	activeSeatsTTL, err := conf.ActiveSeatsTTL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active seats ttl: %w", err)
+	}
+
+	localCache := newLocalCache[[]seat]()
+	client.addLocalCache(localCache)
+
+	return &Seat{
+		client:         client,
+		localCache:     localCache,
+		activeSeatsTTL: activeSeatsTTL,
+	}, nil
+}
+
+func (s *Seat) GetActiveSeats(ctx context.Context) ([]*domain.Seat, error) {
+	cachedSeats, err := getValue(ctx, s.client, s.localCache, activeSeatsKey, "active_seats")
+	if err != nil {
+		return nil, err
+	}
+
+	seats := make([]*domain.Seat, 0, len(cachedSeats))
+	for _, cachedSeat := range cachedSeats {
+		seats = append(seats, domain.NewSeat(values.NewSeatID(cachedSeat.ID), cachedSeat.Status))
+	}
+
+	return seats, nil
+}
+
+func (s *Seat) SetActiveSeats(ctx context.Context, seats []*domain.Seat) error {
+	cachedSeats := make([]seat, 0, len(seats))
+	for _, domainSeat := range seats {
+		cachedSeats = append(cachedSeats, seat{
+			ID:     uint(domainSeat.ID()),
+			Status: domainSeat.Status(),
+		})
+	}
+
+	return setValue(ctx, s.client, activeSeatsKey, cachedSeats, s.activeSeatsTTL, "active_seats")
+}
+
+func (s *Seat) DeleteActiveSeats(ctx context.Context) error {
+	return deleteValue(ctx, s.client, activeSeatsKey, "active_seats")
+}
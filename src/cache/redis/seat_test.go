@@ -0,0 +1,202 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"go.uber.org/mock/gomock"
+)
+
+func TestActiveSeats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description string
+		keyExist    bool
+		seats       []*domain.Seat
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			keyExist:    true,
+			seats: []*domain.Seat{
+				domain.NewSeat(values.NewSeatID(1), values.SeatStatusEmpty),
+				domain.NewSeat(values.NewSeatID(2), values.SeatStatusInUse),
+			},
+		},
+		{
+			description: "座席が空でもエラーなし",
+			keyExist:    true,
+			seats:       []*domain.Seat{},
+		},
+		{
+			description: "キーが存在しないのでErrCacheMiss",
+			keyExist:    false,
+			isErr:       true,
+			err:         cache.ErrCacheMiss,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockConf := mock.NewMockCacheRedis(ctrl)
+			mockConf.
+				EXPECT().
+				ActiveSeatsTTL().
+				Return(time.Minute, nil)
+
+			server := miniredis.RunT(t)
+			seatCache, err := NewSeat(mockConf, newTestClient(t, server))
+			if err != nil {
+				t.Fatalf("failed to create seat cache: %v", err)
+			}
+
+			if testCase.keyExist {
+				err := seatCache.SetActiveSeats(ctx, testCase.seats)
+				assert.NoError(t, err)
+			}
+
+			seats, err := seatCache.GetActiveSeats(ctx)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.seats, seats)
+			assert.Equal(t, time.Minute, server.TTL(activeSeatsKey))
+		})
+	}
+}
+
+func TestActiveSeatsInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	mockConf := mock.NewMockCacheRedis(ctrl)
+	mockConf.
+		EXPECT().
+		ActiveSeatsTTL().
+		Return(time.Minute, nil).
+		Times(2)
+
+	server := miniredis.RunT(t)
+	// 同じRedisを共有する2つのレプリカ
+	seatCache1, err := NewSeat(mockConf, newTestClient(t, server))
+	if err != nil {
+		t.Fatalf("failed to create seat cache: %v", err)
+	}
+	seatCache2, err := NewSeat(mockConf, newTestClient(t, server))
+	if err != nil {
+		t.Fatalf("failed to create seat cache: %v", err)
+	}
+
+	oldSeats := []*domain.Seat{
+		domain.NewSeat(values.NewSeatID(1), values.SeatStatusEmpty),
+	}
+	newSeats := []*domain.Seat{
+		domain.NewSeat(values.NewSeatID(1), values.SeatStatusInUse),
+	}
+
+	err = seatCache1.SetActiveSeats(ctx, oldSeats)
+	assert.NoError(t, err)
+
+	// seatCache2のローカルキャッシュに古い値を載せる。
+	// 1回目の書き込みの通知が届く前に読み込んだ値はローカルキャッシュに載らないので、載るまで繰り返す
+	assert.Eventually(t, func() bool {
+		seats, err := seatCache2.GetActiveSeats(ctx)
+		if err != nil || seats[0].Status() != values.SeatStatusEmpty {
+			return false
+		}
+
+		_, _, ok := seatCache2.localCache.get(activeSeatsKey)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	err = seatCache1.SetActiveSeats(ctx, newSeats)
+	assert.NoError(t, err)
+
+	// pub/subの通知は非同期に届くので、反映されるまで待つ
+	assert.Eventually(t, func() bool {
+		seats, err := seatCache2.GetActiveSeats(ctx)
+		return err == nil && seats[0].Status() == values.SeatStatusInUse
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestDeleteActiveSeats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	mockConf := mock.NewMockCacheRedis(ctrl)
+	mockConf.
+		EXPECT().
+		ActiveSeatsTTL().
+		Return(time.Minute, nil).
+		Times(2)
+
+	server := miniredis.RunT(t)
+	// 同じRedisを共有する2つのレプリカ
+	seatCache1, err := NewSeat(mockConf, newTestClient(t, server))
+	if err != nil {
+		t.Fatalf("failed to create seat cache: %v", err)
+	}
+	seatCache2, err := NewSeat(mockConf, newTestClient(t, server))
+	if err != nil {
+		t.Fatalf("failed to create seat cache: %v", err)
+	}
+
+	err = seatCache1.SetActiveSeats(ctx, []*domain.Seat{
+		domain.NewSeat(values.NewSeatID(1), values.SeatStatusEmpty),
+	})
+	assert.NoError(t, err)
+
+	// seatCache2のローカルキャッシュに値を載せる
+	assert.Eventually(t, func() bool {
+		_, err := seatCache2.GetActiveSeats(ctx)
+		if err != nil {
+			return false
+		}
+
+		_, _, ok := seatCache2.localCache.get(activeSeatsKey)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	err = seatCache1.DeleteActiveSeats(ctx)
+	assert.NoError(t, err)
+
+	assert.False(t, server.Exists(activeSeatsKey))
+
+	// pub/subの通知は非同期に届くので、反映されるまで待つ
+	assert.Eventually(t, func() bool {
+		_, err := seatCache2.GetActiveSeats(ctx)
+		return errors.Is(err, cache.ErrCacheMiss)
+	}, time.Second, 10*time.Millisecond)
+}
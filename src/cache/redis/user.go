@@ -0,0 +1,146 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+const (
+	meKeyPrefix    = keyPrefix + "user:me:"
+	activeUsersKey = keyPrefix + "user:active_users"
+	allUsersKey    = keyPrefix + "user:all_users"
+)
+
+type User struct {
+	client         *Client
+	meCache        *localCache[user]
+	usersCache     *localCache[[]user]
+	activeUsersTTL time.Duration
+}
+
+type user struct {
+	ID     uuid.UUID               `json:"id"`
+	Name   string                  `json:"name"`
+	Status values.TraPMemberStatus `json:"status"`
+	Bot    bool                    `json:"bot"`
+}
+
+func NewUser(conf config.CacheRedis, client *Client) (*User, error) {
+	activeUsersTTL, err := conf.ActiveUsersTTL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activeUsersTTL: %w", err)
+	}
+
+	meCache := newLocalCache[user]()
+	client.addLocalCache(meCache)
+
+	usersCache := newLocalCache[[]user]()
+	client.addLocalCache(usersCache)
+
+	return &User{
+		client:         client,
+		meCache:        meCache,
+		usersCache:     usersCache,
+		activeUsersTTL: activeUsersTTL,
+	}, nil
+}
+
+// meKey
+// アクセストークンをそのままRedisに置かないよう、ハッシュ値をキーにする
+func meKey(accessToken values.OIDCAccessToken) string {
+	hash := sha256.Sum256([]byte(accessToken))
+
+	return meKeyPrefix + hex.EncodeToString(hash[:])
+}
+
+func (u *User) GetMe(ctx context.Context, accessToken values.OIDCAccessToken) (*service.UserInfo, error) {
+	cachedUser, err := getValue(ctx, u.client, u.meCache, meKey(accessToken), "me")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertUser(cachedUser), nil
+}
+
+func (u *User) SetMe(ctx context.Context, session *domain.OIDCSession, userInfo *service.UserInfo) error {
+	// sessionの有効期限が切れるとキャッシュが消えるようにTTLを設定する
+	return setValue(ctx, u.client, meKey(session.GetAccessToken()), newUser(userInfo), time.Until(session.GetExpiresAt()), "me")
+}
+
+// GetAllActiveUsers
+// deprecated: v1 API廃止時に削除する
+func (u *User) GetAllActiveUsers(ctx context.Context) ([]*service.UserInfo, error) {
+	return u.GetActiveUsers(ctx)
+}
+
+// SetAllActiveUsers
+// deprecated: v1 API廃止時に削除する
+func (u *User) SetAllActiveUsers(ctx context.Context, users []*service.UserInfo) error {
+	return u.SetActiveUsers(ctx, users)
+}
+
+func (u *User) GetActiveUsers(ctx context.Context) ([]*service.UserInfo, error) {
+	return u.getUsers(ctx, activeUsersKey, "active_users")
+}
+
+func (u *User) SetActiveUsers(ctx context.Context, users []*service.UserInfo) error {
+	return u.setUsers(ctx, activeUsersKey, users, "active_users")
+}
+
+func (u *User) GetAllUsers(ctx context.Context) ([]*service.UserInfo, error) {
+	return u.getUsers(ctx, allUsersKey, "all_users")
+}
+
+func (u *User) SetAllUsers(ctx context.Context, users []*service.UserInfo) error {
+	return u.setUsers(ctx, allUsersKey, users, "all_users")
+}
+
+func (u *User) getUsers(ctx context.Context, key string, cacheType string) ([]*service.UserInfo, error) {
+	cachedUsers, err := getValue(ctx, u.client, u.usersCache, key, cacheType)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*service.UserInfo, 0, len(cachedUsers))
+	for _, cachedUser := range cachedUsers {
+		users = append(users, convertUser(cachedUser))
+	}
+
+	return users, nil
+}
+
+func (u *User) setUsers(ctx context.Context, key string, users []*service.UserInfo, cacheType string) error {
+	cachedUsers := make([]user, 0, len(users))
+	for _, userInfo := range users {
+		cachedUsers = append(cachedUsers, newUser(userInfo))
+	}
+
+	return setValue(ctx, u.client, key, cachedUsers, u.activeUsersTTL, cacheType)
+}
+
+func newUser(userInfo *service.UserInfo) user {
+	return user{
+		ID:     uuid.UUID(userInfo.GetID()),
+		Name:   string(userInfo.GetName()),
+		Status: userInfo.GetStatus(),
+		Bot:    userInfo.GetBot(),
+	}
+}
+
+func convertUser(cachedUser user) *service.UserInfo {
+	return service.NewUserInfo(
+		values.NewTrapMemberID(cachedUser.ID),
+		values.NewTrapMemberName(cachedUser.Name),
+		cachedUser.Status,
+		cachedUser.Bot,
+	)
+}
@@ -0,0 +1,259 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestUser(t *testing.T, server *miniredis.Miniredis) *User {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockConf := mock.NewMockCacheRedis(ctrl)
+	mockConf.
+		EXPECT().
+		ActiveUsersTTL().
+		Return(time.Hour, nil)
+
+	userCache, err := NewUser(mockConf, newTestClient(t, server))
+	if err != nil {
+		t.Fatalf("failed to create user cache: %v", err)
+	}
+
+	return userCache
+}
+
+func TestMe(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description string
+		keyExist    bool
+		expiresAt   time.Time
+		userInfo    *service.UserInfo
+		isSetErr    bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			keyExist:    true,
+			expiresAt:   time.Now().Add(time.Hour),
+			userInfo: service.NewUserInfo(
+				values.NewTrapMemberID(uuid.New()),
+				values.NewTrapMemberName("mazrean"),
+				values.TrapMemberStatusActive,
+				false,
+			),
+		},
+		{
+			description: "キーが存在しないのでErrCacheMiss",
+			keyExist:    false,
+			isErr:       true,
+			err:         cache.ErrCacheMiss,
+		},
+		{
+			description: "セッションの有効期限が切れているので設定できない",
+			keyExist:    true,
+			expiresAt:   time.Now().Add(-time.Hour),
+			userInfo: service.NewUserInfo(
+				values.NewTrapMemberID(uuid.New()),
+				values.NewTrapMemberName("mazrean"),
+				values.TrapMemberStatusActive,
+				false,
+			),
+			isSetErr: true,
+			isErr:    true,
+			err:      cache.ErrCacheMiss,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			server := miniredis.RunT(t)
+			userCache := newTestUser(t, server)
+
+			accessToken := values.NewOIDCAccessToken("access token")
+			if testCase.keyExist {
+				err := userCache.SetMe(ctx, domain.NewOIDCSession(accessToken, testCase.expiresAt), testCase.userInfo)
+				if testCase.isSetErr {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+
+			user, err := userCache.GetMe(ctx, accessToken)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.userInfo, user)
+			// アクセストークンはそのままキーに含めない
+			for _, key := range server.Keys() {
+				assert.NotContains(t, key, string(accessToken))
+			}
+		})
+	}
+}
+
+func TestUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description string
+		set         func(userCache *User, users []*service.UserInfo) error
+		get         func(userCache *User) ([]*service.UserInfo, error)
+		keyExist    bool
+		users       []*service.UserInfo
+		isErr       bool
+		err         error
+	}
+
+	users := []*service.UserInfo{
+		service.NewUserInfo(
+			values.NewTrapMemberID(uuid.New()),
+			values.NewTrapMemberName("mazrean"),
+			values.TrapMemberStatusActive,
+			false,
+		),
+		service.NewUserInfo(
+			values.NewTrapMemberID(uuid.New()),
+			values.NewTrapMemberName("BOT_mazrean"),
+			values.TrapMemberStatusDeactivated,
+			true,
+		),
+	}
+	setActiveUsers := func(userCache *User, users []*service.UserInfo) error {
+		return userCache.SetActiveUsers(ctx, users)
+	}
+	getActiveUsers := func(userCache *User) ([]*service.UserInfo, error) {
+		return userCache.GetActiveUsers(ctx)
+	}
+	setAllUsers := func(userCache *User, users []*service.UserInfo) error {
+		return userCache.SetAllUsers(ctx, users)
+	}
+	getAllUsers := func(userCache *User) ([]*service.UserInfo, error) {
+		return userCache.GetAllUsers(ctx)
+	}
+
+	testCases := []test{
+		{
+			description: "ActiveUsers: 特に問題ないのでエラーなし",
+			set:         setActiveUsers,
+			get:         getActiveUsers,
+			keyExist:    true,
+			users:       users,
+		},
+		{
+			description: "ActiveUsers: キーが存在しないのでErrCacheMiss",
+			set:         setActiveUsers,
+			get:         getActiveUsers,
+			isErr:       true,
+			err:         cache.ErrCacheMiss,
+		},
+		{
+			description: "AllUsers: 特に問題ないのでエラーなし",
+			set:         setAllUsers,
+			get:         getAllUsers,
+			keyExist:    true,
+			users:       users,
+		},
+		{
+			description: "AllUsers: キーが存在しないのでErrCacheMiss",
+			set:         setAllUsers,
+			get:         getAllUsers,
+			isErr:       true,
+			err:         cache.ErrCacheMiss,
+		},
+		{
+			description: "ActiveUsersとAllUsersは別のキー",
+			set:         setActiveUsers,
+			get:         getAllUsers,
+			keyExist:    true,
+			users:       users,
+			isErr:       true,
+			err:         cache.ErrCacheMiss,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			server := miniredis.RunT(t)
+			userCache := newTestUser(t, server)
+
+			if testCase.keyExist {
+				err := testCase.set(userCache, testCase.users)
+				assert.NoError(t, err)
+			}
+
+			actualUsers, err := testCase.get(userCache)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.users, actualUsers)
+		})
+	}
+}
+
+func TestUsersTTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	server := miniredis.RunT(t)
+	userCache := newTestUser(t, server)
+
+	err := userCache.SetActiveUsers(ctx, []*service.UserInfo{})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, server.TTL(activeUsersKey))
+
+	server.FastForward(time.Hour)
+
+	// ローカルキャッシュを持たない別のレプリカからはRedisの有効期限切れが見える
+	_, err = newTestUser(t, server).GetActiveUsers(ctx)
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
+}
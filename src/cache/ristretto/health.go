@@ -0,0 +1,21 @@
+package ristretto
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/src/cache"
+)
+
+var _ cache.Health = &Health{}
+
+// Health
+// オンメモリのキャッシュなので、常に利用できる。
+type Health struct{}
+
+func NewHealth() *Health {
+	return &Health{}
+}
+
+func (*Health) Ping(context.Context) error {
+	return nil
+}
@@ -69,3 +69,9 @@ func (seat *Seat) SetActiveSeats(_ context.Context, seats []*domain.Seat) error
 
 	return nil
 }
+
+func (seat *Seat) DeleteActiveSeats(_ context.Context) error {
+	seat.activeSeats.Del(activeSeatsKey)
+
+	return nil
+}
@@ -13,4 +13,7 @@ type Seat interface {
 	// GetActiveSeats
 	// アクティブな座席情報一覧を取得する
 	GetActiveSeats(ctx context.Context) ([]*domain.Seat, error)
+	// DeleteActiveSeats
+	// アクティブな座席情報一覧のキャッシュを削除する
+	DeleteActiveSeats(ctx context.Context) error
 }
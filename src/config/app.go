@@ -2,6 +2,8 @@ package config
 
 //go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
 
+import "time"
+
 type AppStatus int8
 
 const (
@@ -13,4 +15,7 @@ type App interface {
 	Status() (AppStatus, error)
 	FeatureV2() bool
 	FeatureV1Write() bool
+	// ShutdownTimeout
+	// 終了シグナルを受け取ってから、リクエストの処理や定期実行ジョブの終了を待つ最大の時間。
+	ShutdownTimeout() (time.Duration, error)
 }
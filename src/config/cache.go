@@ -2,9 +2,34 @@ package config
 
 //go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
+
+type CacheType int8
+
+const (
+	// CacheTypeRistretto プロセス内のキャッシュ
+	CacheTypeRistretto CacheType = iota + 1
+	// CacheTypeRedis 複数のレプリカで共有するRedisのキャッシュ
+	CacheTypeRedis
+)
+
+type Cache interface {
+	Type() (CacheType, error)
+}
 
 type CacheRistretto interface {
 	ActiveUsersTTL() (time.Duration, error)
 	ActiveSeatsTTL() (time.Duration, error)
 }
+
+type CacheRedis interface {
+	ActiveUsersTTL() (time.Duration, error)
+	ActiveSeatsTTL() (time.Duration, error)
+	// URL
+	// Redisの接続先のURLを返す。
+	// 例: redis://:password@localhost:6379/0
+	URL() (*url.URL, error)
+}
@@ -1,6 +1,9 @@
 package config
 
-import "net/url"
+import (
+	"net"
+	"net/url"
+)
 
 //go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
 
@@ -9,4 +12,27 @@ type Handler interface {
 	SessionKey() (string, error)
 	SessionSecret() (string, error)
 	TraqBaseURL() (*url.URL, error)
+	// TrustedProxies
+	// X-Forwarded-Forを信頼するリバースプロキシのIPアドレスの範囲。
+	// 空の場合はX-Forwarded-Forを信頼せず、接続元のIPアドレスをクライアントのIPアドレスとして使う。
+	TrustedProxies() ([]*net.IPNet, error)
+}
+
+// RateLimit
+// トークンバケットによるレート制限の設定
+type RateLimit struct {
+	// Rate 1秒あたりに補充されるトークンの数
+	Rate float64
+	// Burst バケットに溜められるトークンの最大数
+	Burst int
+}
+
+type HandlerRateLimit interface {
+	// EditionAuthorize
+	// POST /editions/authorizeのIPアドレスごとの制限と、プロダクトキーごとの制限を返す。
+	// プロダクトキーの総当たりを防ぐため、他より厳しい制限にする。
+	EditionAuthorize() (perIP RateLimit, perKey RateLimit, err error)
+	// Public
+	// ログイン無しで利用できるゲーム一覧などのIPアドレスごとの制限を返す。
+	Public() (perIP RateLimit, err error)
 }
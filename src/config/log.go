@@ -0,0 +1,21 @@
+package config
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import "log/slog"
+
+type LogFormat int8
+
+const (
+	// LogFormatJSON 1行1つのJSONでログを出力する
+	LogFormatJSON LogFormat = iota + 1
+	// LogFormatText 開発用にkey=value形式でログを出力する
+	LogFormatText
+)
+
+type Log interface {
+	// Level
+	// 出力するログの最低レベルを返す。
+	Level() (slog.Level, error)
+	Format() (LogFormat, error)
+}
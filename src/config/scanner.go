@@ -0,0 +1,24 @@
+package config
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+type ScannerType int8
+
+const (
+	// ScannerTypeNone 検査を行わない
+	ScannerTypeNone ScannerType = iota + 1
+	// ScannerTypeRules zipファイル内のファイル名による検査
+	ScannerTypeRules
+	// ScannerTypeClamAV clamdによる検査
+	ScannerTypeClamAV
+)
+
+type Scanner interface {
+	Type() (ScannerType, error)
+}
+
+type ScannerClamAV interface {
+	// Socket
+	// clamdのUNIXドメインソケットのパスを返す。
+	Socket() (string, error)
+}
@@ -2,6 +2,8 @@ package config
 
 //go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
 
+import "crypto/ed25519"
+
 type ServiceV1 interface {
 	Administrators() ([]string, error)
 	ClientID() (string, error)
@@ -16,4 +18,21 @@ type ServiceV2 interface {
 	// OIDC・OAuth2.0(Authorization Code Flow)のClientSecretを取得する
 	// traQではSecret関連の機能は未実装なため、基本的に使うことはない
 	ClientSecret() (string, error)
+	// DefaultGameStorageQuota
+	// 管理者が上限を設定していないゲームに適用する、ストレージの上限(バイト)を取得する
+	// 設定されていない場合はfalseを返し、上限なしとして扱う
+	DefaultGameStorageQuota() (int64, bool, error)
+	// GameFileMaxUncompressedSize
+	// ゲームファイルのzipを展開した後の合計サイズ(バイト)の上限を取得する
+	GameFileMaxUncompressedSize() (int64, error)
+	// GameFileMaxEntries
+	// ゲームファイルのzipに含められるエントリー数の上限を取得する
+	GameFileMaxEntries() (int, error)
+	// GameFileMaxCompressionRatio
+	// ゲームファイルのzipの各エントリーの圧縮率(展開後のサイズ/圧縮後のサイズ)の上限を取得する
+	GameFileMaxCompressionRatio() (float64, error)
+	// EditionBundleSigningKey
+	// オフライン配布用のバンドルのマニフェストに署名するEd25519の秘密鍵を取得する
+	// 設定されていない場合はfalseを返し、バンドルを生成できないものとして扱う
+	EditionBundleSigningKey() (ed25519.PrivateKey, bool, error)
 }
@@ -14,6 +14,10 @@ const (
 
 type Storage interface {
 	Type() (StorageType, error)
+	// FallbackType
+	// 移行元のストレージの種類を返す。
+	// 設定されていない場合、第2返り値はfalseになる。
+	FallbackType() (StorageType, bool, error)
 }
 
 type StorageSwift interface {
@@ -37,4 +41,6 @@ type StorageS3 interface {
 
 type StorageLocal interface {
 	Path() (string, error)
+	TmpURLKey() (string, error)
+	BaseURL() (*url.URL, error)
 }
@@ -0,0 +1,22 @@
+package config
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+type TracingExporter int8
+
+const (
+	// TracingExporterNone トレースを出力しない
+	TracingExporterNone TracingExporter = iota + 1
+	// TracingExporterOTLP OTLP(HTTP)でトレースを送信する。
+	// 送信先などはOTEL_EXPORTER_OTLP_ENDPOINTなどのOpenTelemetryの標準の環境変数で設定する。
+	TracingExporterOTLP
+	// TracingExporterStdout 開発用に標準出力へトレースを出力する
+	TracingExporterStdout
+)
+
+type Tracing interface {
+	Exporter() (TracingExporter, error)
+	// ServiceName
+	// トレースのservice.nameを返す。
+	ServiceName() (string, error)
+}
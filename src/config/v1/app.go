@@ -2,9 +2,10 @@ package v1
 
 import (
 	"errors"
-	"log"
-	"os"
+	"fmt"
+	"log/slog"
 	"strconv"
+	"time"
 
 	"github.com/traPtitech/trap-collection-server/src/config"
 )
@@ -16,7 +17,7 @@ func NewApp() *App {
 }
 
 func (*App) Status() (config.AppStatus, error) {
-	env, ok := os.LookupEnv(envKeyCollectionEnv)
+	env, ok := lookupEnv(envKeyCollectionEnv)
 	if !ok {
 		return config.AppStatusProduction, nil
 	}
@@ -32,14 +33,14 @@ func (*App) Status() (config.AppStatus, error) {
 }
 
 func (*App) FeatureV2() bool {
-	env, ok := os.LookupEnv(envKeyFeatureV2)
+	env, ok := lookupEnv(envKeyFeatureV2)
 	if !ok {
 		return false
 	}
 
 	v2, err := strconv.ParseBool(env)
 	if err != nil {
-		log.Printf("failed to parse %s: %v\n", envKeyFeatureV2, err)
+		slog.Error("failed to parse env", slog.String("key", envKeyFeatureV2), slog.Any("error", err))
 		return false
 	}
 
@@ -47,16 +48,37 @@ func (*App) FeatureV2() bool {
 }
 
 func (*App) FeatureV1Write() bool {
-	env, ok := os.LookupEnv(envKeyFeatureV1Write)
+	env, ok := lookupEnv(envKeyFeatureV1Write)
 	if !ok {
 		return true
 	}
 
 	v2, err := strconv.ParseBool(env)
 	if err != nil {
-		log.Printf("failed to parse %s: %v\n", envKeyFeatureV2, err)
+		slog.Error("failed to parse env", slog.String("key", envKeyFeatureV2), slog.Any("error", err))
 		return true
 	}
 
 	return v2
 }
+
+// defaultShutdownTimeout
+// KubernetesのterminationGracePeriodSecondsのデフォルト(30秒)以内に終了するようにする
+const defaultShutdownTimeout = 25 * time.Second
+
+func (*App) ShutdownTimeout() (time.Duration, error) {
+	strTimeout, ok := lookupEnv(envKeyShutdownTimeout)
+	if !ok || strTimeout == "" {
+		return defaultShutdownTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(strTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse SHUTDOWN_TIMEOUT: %w", err)
+	}
+	if timeout <= 0 {
+		return 0, errors.New("SHUTDOWN_TIMEOUT must be positive")
+	}
+
+	return timeout, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type AuthTraQ struct{}
@@ -13,7 +15,10 @@ func NewAuthTraQ() *AuthTraQ {
 }
 
 func (*AuthTraQ) HTTPClient() (*http.Client, error) {
-	return http.DefaultClient, nil
+	return &http.Client{
+		// traQへのリクエストをトレースする
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}, nil
 }
 
 func (*AuthTraQ) BaseURL() (*url.URL, error) {
@@ -1,6 +1,35 @@
 package v1
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+type Cache struct{}
+
+func NewCache() *Cache {
+	return &Cache{}
+}
+
+func (*Cache) Type() (config.CacheType, error) {
+	cache, ok := lookupEnv(envKeyCache)
+	if !ok {
+		return config.CacheTypeRistretto, nil
+	}
+
+	switch cache {
+	case "ristretto":
+		return config.CacheTypeRistretto, nil
+	case "redis":
+		return config.CacheTypeRedis, nil
+	}
+
+	return 0, errors.New("invalid cache")
+}
 
 type CacheRistretto struct{}
 
@@ -15,3 +44,32 @@ func (*CacheRistretto) ActiveUsersTTL() (time.Duration, error) {
 func (*CacheRistretto) ActiveSeatsTTL() (time.Duration, error) {
 	return time.Second, nil
 }
+
+type CacheRedis struct{}
+
+func NewCacheRedis() *CacheRedis {
+	return &CacheRedis{}
+}
+
+func (*CacheRedis) ActiveUsersTTL() (time.Duration, error) {
+	return time.Hour, nil
+}
+
+func (*CacheRedis) ActiveSeatsTTL() (time.Duration, error) {
+	// 座席の状態は頻繁に変わるので、無効化の通知を取りこぼした場合に備えてristrettoと同じく短くしておく
+	return time.Second, nil
+}
+
+func (*CacheRedis) URL() (*url.URL, error) {
+	strRedisURL, ok := lookupEnv(envKeyRedisURL)
+	if !ok {
+		return nil, errors.New("REDIS_URL is not set")
+	}
+
+	redisURL, err := url.Parse(strRedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse REDIS_URL: %w", err)
+	}
+
+	return redisURL, nil
+}
@@ -3,11 +3,28 @@ package v1
 type envKey = string
 
 const (
+	envKeyConfigFile envKey = "CONFIG_FILE"
+
 	envKeyCollectionEnv  envKey = "COLLECTION_ENV"
 	envKeyFeatureV2      envKey = "FEATURE_V2"
 	envKeyFeatureV1Write envKey = "FEATURE_V1_WRITE"
 
-	envKeyStorage envKey = "STORAGE"
+	envKeyShutdownTimeout envKey = "SHUTDOWN_TIMEOUT"
+
+	envKeyStorage         envKey = "STORAGE"
+	envKeyStorageFallback envKey = "STORAGE_FALLBACK"
+
+	envKeyCache    envKey = "CACHE"
+	envKeyRedisURL envKey = "REDIS_URL"
+
+	envKeyLogLevel  envKey = "LOG_LEVEL"
+	envKeyLogFormat envKey = "LOG_FORMAT"
+
+	envKeyTracingExporter envKey = "TRACING_EXPORTER"
+	envKeyOTELServiceName envKey = "OTEL_SERVICE_NAME"
+
+	envKeyScanner      envKey = "SCANNER"
+	envKeyClamAVSocket envKey = "CLAMAV_SOCKET"
 
 	envKeySessionSecret envKey = "SESSION_SECRET"
 
@@ -16,6 +33,14 @@ const (
 
 	envKeyAdministrators envKey = "ADMINISTRATORS"
 
+	envKeyGameStorageQuota envKey = "GAME_STORAGE_QUOTA"
+
+	envKeyGameFileMaxUncompressedSize envKey = "GAME_FILE_MAX_UNCOMPRESSED_SIZE"
+	envKeyGameFileMaxEntries          envKey = "GAME_FILE_MAX_ENTRIES"
+	envKeyGameFileMaxCompressionRatio envKey = "GAME_FILE_MAX_COMPRESSION_RATIO"
+
+	envKeyEditionBundleSigningKey envKey = "EDITION_BUNDLE_SIGNING_KEY"
+
 	envKeySwiftAuthURL    envKey = "OS_AUTH_URL"
 	envKeySwiftUserName   envKey = "OS_USERNAME"
 	envKeySwiftPassword   envKey = "OS_PASSWORD"
@@ -31,9 +56,12 @@ const (
 	envKeyS3Endpoint        envKey = "S3_ENDPOINT"
 	envKeyS3UsePathStyle    envKey = "S3_USE_PATH_STYLE"
 
-	envKeyFilePath envKey = "FILE_PATH"
+	envKeyFilePath      envKey = "FILE_PATH"
+	envKeyFileTmpURLKey envKey = "FILE_TMP_URL_KEY"
+	envKeyFileBaseURL   envKey = "FILE_BASE_URL"
 
-	envKeyPort envKey = "PORT"
+	envKeyPort           envKey = "PORT"
+	envKeyTrustedProxies envKey = "TRUSTED_PROXIES"
 
 	envKeyDBUserName envKey = "DB_USERNAME"
 	envKeyDBPassword envKey = "DB_PASSWORD"
@@ -3,8 +3,11 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
-	"os"
+	"strings"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
 )
 
 type Handler struct{}
@@ -14,7 +17,7 @@ func NewHandler() *Handler {
 }
 
 func (*Handler) Addr() (string, error) {
-	port, ok := os.LookupEnv(envKeyPort)
+	port, ok := lookupEnv(envKeyPort)
 	if !ok {
 		return "", errors.New("PORT is not set")
 	}
@@ -27,7 +30,7 @@ func (*Handler) SessionKey() (string, error) {
 }
 
 func (*Handler) SessionSecret() (string, error) {
-	secret, ok := os.LookupEnv(envKeySessionSecret)
+	secret, ok := lookupEnv(envKeySessionSecret)
 	if !ok {
 		return "", errors.New("SESSION_SECRET is not set")
 	}
@@ -35,6 +38,25 @@ func (*Handler) SessionSecret() (string, error) {
 	return secret, nil
 }
 
+func (*Handler) TrustedProxies() ([]*net.IPNet, error) {
+	strTrustedProxies, ok := lookupEnv(envKeyTrustedProxies)
+	if !ok || strings.TrimSpace(strTrustedProxies) == "" {
+		return nil, nil
+	}
+
+	var trustedProxies []*net.IPNet
+	for _, strCIDR := range strings.Split(strTrustedProxies, ",") {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(strCIDR))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse TRUSTED_PROXIES: %w", err)
+		}
+
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+
+	return trustedProxies, nil
+}
+
 func (*Handler) TraqBaseURL() (*url.URL, error) {
 	traQBaseURL, err := url.Parse("https://q.trap.jp/api/v3")
 	if err != nil {
@@ -43,3 +65,32 @@ func (*Handler) TraqBaseURL() (*url.URL, error) {
 
 	return traQBaseURL, nil
 }
+
+type HandlerRateLimit struct{}
+
+func NewHandlerRateLimit() *HandlerRateLimit {
+	return &HandlerRateLimit{}
+}
+
+func (*HandlerRateLimit) EditionAuthorize() (config.RateLimit, config.RateLimit, error) {
+	// ランチャーの認可はアクセストークンの期限切れ時にしか行われないので、かなり厳しくて良い
+	perIP := config.RateLimit{
+		// 1分に10回
+		Rate:  10.0 / 60,
+		Burst: 10,
+	}
+	perKey := config.RateLimit{
+		// 1分に1回
+		Rate:  1.0 / 60,
+		Burst: 5,
+	}
+
+	return perIP, perKey, nil
+}
+
+func (*HandlerRateLimit) Public() (config.RateLimit, error) {
+	return config.RateLimit{
+		Rate:  5,
+		Burst: 50,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+type Log struct {
+	app *App
+}
+
+func NewLog(app *App) *Log {
+	return &Log{
+		app: app,
+	}
+}
+
+func (*Log) Level() (slog.Level, error) {
+	strLevel, ok := lookupEnv(envKeyLogLevel)
+	if !ok || strLevel == "" {
+		return slog.LevelInfo, nil
+	}
+
+	var level slog.Level
+	err := level.UnmarshalText([]byte(strLevel))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse log level: %w", err)
+	}
+
+	return level, nil
+}
+
+func (l *Log) Format() (config.LogFormat, error) {
+	format, ok := lookupEnv(envKeyLogFormat)
+	if !ok || format == "" {
+		// 指定がない場合、本番環境ではJSON、開発環境ではテキストで出力する
+		status, err := l.app.Status()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get app status: %w", err)
+		}
+
+		if status == config.AppStatusDevelopment {
+			return config.LogFormatText, nil
+		}
+
+		return config.LogFormatJSON, nil
+	}
+
+	switch format {
+	case "json":
+		return config.LogFormatJSON, nil
+	case "text":
+		return config.LogFormatText, nil
+	}
+
+	return 0, errors.New("invalid log format")
+}
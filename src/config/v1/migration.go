@@ -2,7 +2,6 @@ package v1
 
 import (
 	"errors"
-	"os"
 	"strconv"
 
 	"github.com/traPtitech/trap-collection-server/src/config"
@@ -17,7 +16,7 @@ func NewMigration() *Migration {
 }
 
 func (m *Migration) EmptyDB() (bool, error) {
-	emptyDBStr, ok := os.LookupEnv(envKeyMigrationEmptyDB)
+	emptyDBStr, ok := lookupEnv(envKeyMigrationEmptyDB)
 	if !ok {
 		return false, errors.New("MIGRATION_EMPTY_DB is not set")
 	}
@@ -30,7 +29,7 @@ func (m *Migration) EmptyDB() (bool, error) {
 }
 
 func (m *Migration) Baseline() (string, error) {
-	baseline, ok := os.LookupEnv(envKeyMigrationBaseline)
+	baseline, ok := lookupEnv(envKeyMigrationBaseline)
 	if !ok {
 		return "", errors.New("MIGRATION_BASELINE is not set")
 	}
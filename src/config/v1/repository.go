@@ -3,7 +3,6 @@ package v1
 import (
 	"errors"
 	"fmt"
-	"os"
 	"strconv"
 )
 
@@ -14,7 +13,7 @@ func NewRepositoryGorm2() *RepositoryGorm2 {
 }
 
 func (*RepositoryGorm2) User() (string, error) {
-	user, ok := os.LookupEnv(envKeyDBUserName)
+	user, ok := lookupEnv(envKeyDBUserName)
 	if !ok {
 		return "", errors.New("DB_USERNAME is not set")
 	}
@@ -23,7 +22,7 @@ func (*RepositoryGorm2) User() (string, error) {
 }
 
 func (*RepositoryGorm2) Password() (string, error) {
-	password, ok := os.LookupEnv(envKeyDBPassword)
+	password, ok := lookupEnv(envKeyDBPassword)
 	if !ok {
 		return "", errors.New("DB_PASSWORD is not set")
 	}
@@ -32,7 +31,7 @@ func (*RepositoryGorm2) Password() (string, error) {
 }
 
 func (*RepositoryGorm2) Host() (string, error) {
-	host, ok := os.LookupEnv(envKeyDBHostName)
+	host, ok := lookupEnv(envKeyDBHostName)
 	if !ok {
 		return "", errors.New("DB_HOSTNAME is not set")
 	}
@@ -41,7 +40,7 @@ func (*RepositoryGorm2) Host() (string, error) {
 }
 
 func (*RepositoryGorm2) Port() (int, error) {
-	strPort, ok := os.LookupEnv(envKeyDBPort)
+	strPort, ok := lookupEnv(envKeyDBPort)
 	if !ok {
 		return 0, errors.New("DB_PORT is not set")
 	}
@@ -55,7 +54,7 @@ func (*RepositoryGorm2) Port() (int, error) {
 }
 
 func (*RepositoryGorm2) Database() (string, error) {
-	database, ok := os.LookupEnv(envKeyDBDatabase)
+	database, ok := lookupEnv(envKeyDBDatabase)
 	if !ok {
 		return "", errors.New("DB_DATABASE is not set")
 	}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+type Scanner struct{}
+
+func NewScanner() *Scanner {
+	return &Scanner{}
+}
+
+func (*Scanner) Type() (config.ScannerType, error) {
+	scanner, ok := lookupEnv(envKeyScanner)
+	if !ok {
+		// 検査を有効にすると、検査を通過するまでファイルを配信できなくなるので、
+		// 運用者が明示的に選んだ場合のみ検査する
+		return config.ScannerTypeNone, nil
+	}
+
+	switch scanner {
+	case "none":
+		return config.ScannerTypeNone, nil
+	case "rules":
+		return config.ScannerTypeRules, nil
+	case "clamav":
+		return config.ScannerTypeClamAV, nil
+	}
+
+	return 0, errors.New("invalid scanner")
+}
+
+type ScannerClamAV struct{}
+
+func NewScannerClamAV() *ScannerClamAV {
+	return &ScannerClamAV{}
+}
+
+func (*ScannerClamAV) Socket() (string, error) {
+	socket, ok := lookupEnv(envKeyClamAVSocket)
+	if !ok {
+		return "", errors.New("CLAMAV_SOCKET is not set")
+	}
+
+	return socket, nil
+}
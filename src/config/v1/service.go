@@ -1,9 +1,15 @@
 package v1
 
 import (
+	"crypto/ed25519"
+	"encoding/base64"
 	"errors"
-	"os"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+
+	"github.com/dustin/go-humanize"
 )
 
 type ServiceV1 struct{}
@@ -13,7 +19,7 @@ func NewServiceV1() *ServiceV1 {
 }
 
 func (*ServiceV1) Administrators() ([]string, error) {
-	strAdministrators, ok := os.LookupEnv(envKeyAdministrators)
+	strAdministrators, ok := lookupEnv(envKeyAdministrators)
 	if !ok {
 		return nil, errors.New("ADMINISTRATORS is not set")
 	}
@@ -24,7 +30,7 @@ func (*ServiceV1) Administrators() ([]string, error) {
 }
 
 func (*ServiceV1) ClientID() (string, error) {
-	clientID, ok := os.LookupEnv(envKeyClientID)
+	clientID, ok := lookupEnv(envKeyClientID)
 	if !ok {
 		return "", errors.New("ENV CLIENT_ID IS NULL")
 	}
@@ -33,7 +39,7 @@ func (*ServiceV1) ClientID() (string, error) {
 }
 
 func (*ServiceV1) ClientSecret() (string, error) {
-	clientSecret, ok := os.LookupEnv(envKeyClientSecret)
+	clientSecret, ok := lookupEnv(envKeyClientSecret)
 	if !ok {
 		return "", errors.New("ENV CLIENT_SECRET IS NULL")
 	}
@@ -48,7 +54,7 @@ func NewServiceV2() *ServiceV2 {
 }
 
 func (*ServiceV2) ClientID() (string, error) {
-	clientID, ok := os.LookupEnv(envKeyClientID)
+	clientID, ok := lookupEnv(envKeyClientID)
 	if !ok {
 		return "", errors.New("ENV CLIENT_ID IS NULL")
 	}
@@ -57,10 +63,108 @@ func (*ServiceV2) ClientID() (string, error) {
 }
 
 func (*ServiceV2) ClientSecret() (string, error) {
-	clientSecret, ok := os.LookupEnv(envKeyClientSecret)
+	clientSecret, ok := lookupEnv(envKeyClientSecret)
 	if !ok {
 		return "", errors.New("ENV CLIENT_SECRET IS NULL")
 	}
 
 	return clientSecret, nil
 }
+
+func (*ServiceV2) DefaultGameStorageQuota() (int64, bool, error) {
+	strQuota, ok := lookupEnv(envKeyGameStorageQuota)
+	if !ok || strQuota == "" {
+		return 0, false, nil
+	}
+
+	// 10GiB、500MBのような単位付きの値も受け付ける
+	quota, err := humanize.ParseBytes(strQuota)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse GAME_STORAGE_QUOTA: %w", err)
+	}
+	if quota > math.MaxInt64 {
+		return 0, false, errors.New("GAME_STORAGE_QUOTA is too large")
+	}
+
+	return int64(quota), true, nil
+}
+
+const (
+	defaultGameFileMaxUncompressedSize = "10GiB"
+	defaultGameFileMaxEntries          = 100000
+	defaultGameFileMaxCompressionRatio = 100
+)
+
+func (*ServiceV2) GameFileMaxUncompressedSize() (int64, error) {
+	strSize, ok := lookupEnv(envKeyGameFileMaxUncompressedSize)
+	if !ok || strSize == "" {
+		strSize = defaultGameFileMaxUncompressedSize
+	}
+
+	size, err := humanize.ParseBytes(strSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse GAME_FILE_MAX_UNCOMPRESSED_SIZE: %w", err)
+	}
+	if size > math.MaxInt64 {
+		return 0, errors.New("GAME_FILE_MAX_UNCOMPRESSED_SIZE is too large")
+	}
+	if size == 0 {
+		return 0, errors.New("GAME_FILE_MAX_UNCOMPRESSED_SIZE must be positive")
+	}
+
+	return int64(size), nil
+}
+
+func (*ServiceV2) GameFileMaxEntries() (int, error) {
+	strEntries, ok := lookupEnv(envKeyGameFileMaxEntries)
+	if !ok || strEntries == "" {
+		return defaultGameFileMaxEntries, nil
+	}
+
+	entries, err := strconv.Atoi(strEntries)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse GAME_FILE_MAX_ENTRIES: %w", err)
+	}
+	if entries <= 0 {
+		return 0, errors.New("GAME_FILE_MAX_ENTRIES must be positive")
+	}
+
+	return entries, nil
+}
+
+func (*ServiceV2) GameFileMaxCompressionRatio() (float64, error) {
+	strRatio, ok := lookupEnv(envKeyGameFileMaxCompressionRatio)
+	if !ok || strRatio == "" {
+		return defaultGameFileMaxCompressionRatio, nil
+	}
+
+	ratio, err := strconv.ParseFloat(strRatio, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse GAME_FILE_MAX_COMPRESSION_RATIO: %w", err)
+	}
+	// 圧縮率が1未満だと、圧縮されていないファイルも拒否されてしまう
+	if math.IsNaN(ratio) || ratio < 1 {
+		return 0, errors.New("GAME_FILE_MAX_COMPRESSION_RATIO must be 1 or more")
+	}
+
+	return ratio, nil
+}
+
+// EditionBundleSigningKey
+// EDITION_BUNDLE_SIGNING_KEYには、Ed25519の秘密鍵のseed(32バイト)をbase64で指定する。
+func (*ServiceV2) EditionBundleSigningKey() (ed25519.PrivateKey, bool, error) {
+	strKey, ok := lookupEnv(envKeyEditionBundleSigningKey)
+	if !ok || strKey == "" {
+		return nil, false, nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(strKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode EDITION_BUNDLE_SIGNING_KEY: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, false, fmt.Errorf("EDITION_BUNDLE_SIGNING_KEY must be %d bytes", ed25519.SeedSize)
+	}
+
+	return ed25519.NewKeyFromSeed(seed), true, nil
+}
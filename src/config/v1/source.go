@@ -0,0 +1,366 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// 設定の値は、優先度の高い順に以下から読み込む。
+//  1. 環境変数
+//  2. 環境変数「<キー>_FILE」で指定したファイルの中身(シークレット用)
+//  3. 環境変数CONFIG_FILEで指定した設定ファイル(YAML、TOML)
+
+// fileEnvKeySuffix
+// 値をファイルから読み込む場合に、環境変数のキーに付ける接尾辞
+const fileEnvKeySuffix = "_FILE"
+
+type redactType int8
+
+const (
+	redactNone redactType = iota
+	// redactSecret 値を全て隠す
+	redactSecret
+	// redactURL URLのパスワードのみ隠す
+	redactURL
+)
+
+type setting struct {
+	key envKey
+	// fileKey 設定ファイルでのキー。ネストはドット区切り。
+	fileKey string
+	redact  redactType
+	// defaultValue 設定されていない場合の値。config checkでの表示にのみ使う。
+	defaultValue string
+}
+
+var settings = []setting{
+	{key: envKeyCollectionEnv, fileKey: "app.env", defaultValue: "production"},
+	{key: envKeyShutdownTimeout, fileKey: "app.shutdownTimeout", defaultValue: defaultShutdownTimeout.String()},
+	{key: envKeyFeatureV2, fileKey: "feature.v2", defaultValue: "false"},
+	{key: envKeyFeatureV1Write, fileKey: "feature.v1Write", defaultValue: "true"},
+
+	{key: envKeyLogLevel, fileKey: "log.level", defaultValue: "info"},
+	{key: envKeyLogFormat, fileKey: "log.format", defaultValue: "json (development: text)"},
+
+	{key: envKeyTracingExporter, fileKey: "tracing.exporter", defaultValue: "none"},
+	{key: envKeyOTELServiceName, fileKey: "tracing.serviceName", defaultValue: defaultServiceName},
+
+	{key: envKeyPort, fileKey: "server.port"},
+	{key: envKeyTrustedProxies, fileKey: "server.trustedProxies"},
+	{key: envKeySessionSecret, fileKey: "server.sessionSecret", redact: redactSecret},
+
+	{key: envKeyClientID, fileKey: "oauth.clientID"},
+	{key: envKeyClientSecret, fileKey: "oauth.clientSecret", redact: redactSecret},
+	{key: envKeyAdministrators, fileKey: "administrators"},
+
+	{key: envKeyGameStorageQuota, fileKey: "game.storageQuota"},
+	{key: envKeyGameFileMaxUncompressedSize, fileKey: "gameFile.maxUncompressedSize", defaultValue: defaultGameFileMaxUncompressedSize},
+	{key: envKeyGameFileMaxEntries, fileKey: "gameFile.maxEntries", defaultValue: strconv.Itoa(defaultGameFileMaxEntries)},
+	{key: envKeyGameFileMaxCompressionRatio, fileKey: "gameFile.maxCompressionRatio", defaultValue: strconv.Itoa(defaultGameFileMaxCompressionRatio)},
+	{key: envKeyEditionBundleSigningKey, fileKey: "edition.bundleSigningKey", redact: redactSecret},
+
+	{key: envKeyScanner, fileKey: "scanner.type", defaultValue: "none"},
+	{key: envKeyClamAVSocket, fileKey: "scanner.clamav.socket"},
+
+	{key: envKeyCache, fileKey: "cache.type", defaultValue: "ristretto"},
+	{key: envKeyRedisURL, fileKey: "cache.redis.url", redact: redactURL},
+
+	{key: envKeyStorage, fileKey: "storage.type", defaultValue: "swift"},
+	{key: envKeyStorageFallback, fileKey: "storage.fallback"},
+
+	{key: envKeySwiftAuthURL, fileKey: "storage.swift.authURL"},
+	{key: envKeySwiftUserName, fileKey: "storage.swift.userName"},
+	{key: envKeySwiftPassword, fileKey: "storage.swift.password", redact: redactSecret},
+	{key: envKeySwiftTenantID, fileKey: "storage.swift.tenantID"},
+	{key: envKeySwiftTenantName, fileKey: "storage.swift.tenantName"},
+	{key: envKeySwiftContainer, fileKey: "storage.swift.container"},
+	{key: envKeySwiftTmpURLKey, fileKey: "storage.swift.tmpURLKey", redact: redactSecret},
+
+	{key: envKeyS3AccessKeyID, fileKey: "storage.s3.accessKeyID"},
+	{key: envKeyS3SecretAccessKey, fileKey: "storage.s3.secretAccessKey", redact: redactSecret},
+	{key: envKeyS3Region, fileKey: "storage.s3.region"},
+	{key: envKeyS3Bucket, fileKey: "storage.s3.bucket"},
+	{key: envKeyS3Endpoint, fileKey: "storage.s3.endpoint"},
+	{key: envKeyS3UsePathStyle, fileKey: "storage.s3.usePathStyle", defaultValue: "false"},
+
+	{key: envKeyFilePath, fileKey: "storage.local.path"},
+	{key: envKeyFileTmpURLKey, fileKey: "storage.local.tmpURLKey", redact: redactSecret},
+	{key: envKeyFileBaseURL, fileKey: "storage.local.baseURL"},
+
+	{key: envKeyDBUserName, fileKey: "db.username"},
+	{key: envKeyDBPassword, fileKey: "db.password", redact: redactSecret},
+	{key: envKeyDBHostName, fileKey: "db.hostname"},
+	{key: envKeyDBPort, fileKey: "db.port"},
+	{key: envKeyDBDatabase, fileKey: "db.database"},
+
+	{key: envKeyMigrationEmptyDB, fileKey: "migration.emptyDB"},
+	{key: envKeyMigrationBaseline, fileKey: "migration.baseline"},
+}
+
+// fileValues 設定ファイルから読み込んだ値。キーは環境変数のキー。
+var fileValues atomic.Pointer[map[envKey]string]
+
+// LoadConfigFile
+// 環境変数CONFIG_FILEで指定した設定ファイルを読み込む。
+// 拡張子が.yaml、.ymlの場合はYAML、.tomlの場合はTOMLとして読み込む。
+// CONFIG_FILEが設定されていない場合は何もしない。
+func LoadConfigFile() error {
+	path, ok := os.LookupEnv(envKeyConfigFile)
+	if !ok || path == "" {
+		return nil
+	}
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw map[string]any
+	switch ext := filepath.Ext(path); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(buf, &raw)
+	case ".toml":
+		err = toml.NewDecoder(bytes.NewReader(buf)).Decode(&raw)
+	default:
+		return fmt.Errorf("unsupported config file extension: %s", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	values, err := parseConfigFile(raw)
+	if err != nil {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	fileValues.Store(&values)
+
+	return nil
+}
+
+func parseConfigFile(raw map[string]any) (map[envKey]string, error) {
+	flatValues := map[string]string{}
+	err := flattenConfig("", raw, flatValues)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[envKey]string, len(flatValues))
+	for _, s := range settings {
+		value, ok := flatValues[s.fileKey]
+		if !ok {
+			continue
+		}
+
+		values[s.key] = value
+		delete(flatValues, s.fileKey)
+	}
+
+	if len(flatValues) != 0 {
+		unknownKeys := make([]string, 0, len(flatValues))
+		for key := range flatValues {
+			unknownKeys = append(unknownKeys, key)
+		}
+		slices.Sort(unknownKeys)
+
+		return nil, fmt.Errorf("unknown keys: %s", strings.Join(unknownKeys, ", "))
+	}
+
+	return values, nil
+}
+
+// flattenConfig
+// ネストした設定をドット区切りのキーに展開する。
+// 配列はカンマ区切りの文字列にする。
+func flattenConfig(prefix string, raw map[string]any, values map[string]string) error {
+	for key, value := range raw {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			err := flattenConfig(key, nested, values)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if list, ok := value.([]any); ok {
+			strValues := make([]string, 0, len(list))
+			for _, v := range list {
+				strValue, err := configValueToString(v)
+				if err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+				strValues = append(strValues, strValue)
+			}
+			values[key] = strings.Join(strValues, ",")
+			continue
+		}
+
+		strValue, err := configValueToString(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		values[key] = strValue
+	}
+
+	return nil
+}
+
+func configValueToString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	}
+
+	return "", fmt.Errorf("unsupported value type: %T", value)
+}
+
+type valueSource int8
+
+const (
+	valueSourceNone valueSource = iota
+	valueSourceEnv
+	valueSourceEnvFile
+	valueSourceConfigFile
+)
+
+func (s valueSource) String() string {
+	switch s {
+	case valueSourceEnv:
+		return "env"
+	case valueSourceEnvFile:
+		return "env file"
+	case valueSourceConfigFile:
+		return "config file"
+	}
+
+	return "default"
+}
+
+// resolve
+// 設定の値と、その値をどこから読み込んだかを返す。
+func resolve(key envKey) (string, valueSource, error) {
+	value, envOK := os.LookupEnv(key)
+	path, fileOK := os.LookupEnv(key + fileEnvKeySuffix)
+	if envOK && fileOK {
+		return "", valueSourceNone, fmt.Errorf("both %s and %s%s are set", key, key, fileEnvKeySuffix)
+	}
+
+	if envOK {
+		return value, valueSourceEnv, nil
+	}
+
+	if fileOK {
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return "", valueSourceNone, fmt.Errorf("failed to read %s%s: %w", key, fileEnvKeySuffix, err)
+		}
+
+		// シークレットのファイルは末尾に改行があることが多いので取り除く
+		return strings.TrimRight(string(buf), "\r\n"), valueSourceEnvFile, nil
+	}
+
+	if values := fileValues.Load(); values != nil {
+		value, ok := (*values)[key]
+		if ok {
+			return value, valueSourceConfigFile, nil
+		}
+	}
+
+	return "", valueSourceNone, nil
+}
+
+// lookupEnv
+// os.LookupEnvと同様に設定の値を返す。
+// 読み込みに失敗した場合は設定されていないものとして扱う。
+// 失敗の原因はValidateで確認する。
+func lookupEnv(key envKey) (string, bool) {
+	value, source, err := resolve(key)
+	if err != nil || source == valueSourceNone {
+		return "", false
+	}
+
+	return value, true
+}
+
+// Setting
+// config checkで表示する設定の値
+type Setting struct {
+	Key     string
+	FileKey string
+	// Value シークレットは隠した値
+	Value  string
+	Source string
+}
+
+// EffectiveSettings
+// 全ての設定の、実際に使われる値を返す。
+// シークレットの値は隠す。
+// 読み込みに失敗した設定のSourceはinvalidになり、原因はValidateで確認する。
+func EffectiveSettings() []Setting {
+	effectiveSettings := make([]Setting, 0, len(settings))
+	for _, s := range settings {
+		value, source, err := resolve(s.key)
+		strSource := source.String()
+		switch {
+		case err != nil:
+			value = ""
+			strSource = "invalid"
+		case source == valueSourceNone:
+			value = s.defaultValue
+		default:
+			value = redactValue(value, s.redact)
+		}
+
+		effectiveSettings = append(effectiveSettings, Setting{
+			Key:     s.key,
+			FileKey: s.fileKey,
+			Value:   value,
+			Source:  strSource,
+		})
+	}
+
+	return effectiveSettings
+}
+
+func redactValue(value string, redact redactType) string {
+	switch redact {
+	case redactSecret:
+		if value == "" {
+			return ""
+		}
+		return "********"
+	case redactURL:
+		u, err := url.Parse(value)
+		if err != nil {
+			// パースできない場合、パスワードの位置がわからないので全て隠す
+			return "********"
+		}
+		return u.Redacted()
+	}
+
+	return value
+}
@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseConfigFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yaml   string
+		values map[envKey]string
+		isErr  bool
+	}{
+		"ネストした設定を環境変数のキーに変換できる": {
+			yaml: `
+app:
+  env: development
+db:
+  port: 3306
+storage:
+  s3:
+    usePathStyle: true
+gameFile:
+  maxCompressionRatio: 1.5
+`,
+			values: map[envKey]string{
+				envKeyCollectionEnv:               "development",
+				envKeyDBPort:                      "3306",
+				envKeyS3UsePathStyle:              "true",
+				envKeyGameFileMaxCompressionRatio: "1.5",
+			},
+		},
+		"配列はカンマ区切りになる": {
+			yaml: `administrators: [mazrean, temma]`,
+			values: map[envKey]string{
+				envKeyAdministrators: "mazrean,temma",
+			},
+		},
+		"未知のキーがあるのでエラー": {
+			yaml:  "storage:\n  s3:\n    unknown: 1\n",
+			isErr: true,
+		},
+		"設定の値がオブジェクトの配列なのでエラー": {
+			yaml:  "administrators:\n  - name: mazrean\n",
+			isErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var raw map[string]any
+			err := yaml.Unmarshal([]byte(testCase.yaml), &raw)
+			require.NoError(t, err)
+
+			values, err := parseConfigFile(raw)
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.values, values)
+		})
+	}
+}
+
+// TestResolve
+// 環境変数を書き換えるので並列に実行しない
+func TestResolve(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "secret")
+	err := os.WriteFile(secretPath, []byte("secret\n"), 0o600)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		env        map[string]string
+		fileValues map[envKey]string
+		value      string
+		source     valueSource
+		isErr      bool
+	}{
+		"環境変数が設定ファイルより優先される": {
+			env:        map[string]string{envKeyDBPassword: "env"},
+			fileValues: map[envKey]string{envKeyDBPassword: "file"},
+			value:      "env",
+			source:     valueSourceEnv,
+		},
+		"_FILEのファイルの中身を末尾の改行を除いて読み込む": {
+			env:        map[string]string{envKeyDBPassword + fileEnvKeySuffix: secretPath},
+			fileValues: map[envKey]string{envKeyDBPassword: "file"},
+			value:      "secret",
+			source:     valueSourceEnvFile,
+		},
+		"環境変数がなければ設定ファイルの値を使う": {
+			fileValues: map[envKey]string{envKeyDBPassword: "file"},
+			value:      "file",
+			source:     valueSourceConfigFile,
+		},
+		"どこにも設定されていない": {
+			source: valueSourceNone,
+		},
+		"環境変数と_FILEの両方が設定されているのでエラー": {
+			env: map[string]string{
+				envKeyDBPassword:                    "env",
+				envKeyDBPassword + fileEnvKeySuffix: secretPath,
+			},
+			isErr: true,
+		},
+		"_FILEのファイルが存在しないのでエラー": {
+			env:   map[string]string{envKeyDBPassword + fileEnvKeySuffix: filepath.Join(t.TempDir(), "not-found")},
+			isErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// 他のテストケースの環境変数が残らないよう、未設定の状態にしておく
+			t.Setenv(envKeyDBPassword, "")
+			t.Setenv(envKeyDBPassword+fileEnvKeySuffix, "")
+			os.Unsetenv(envKeyDBPassword)
+			os.Unsetenv(envKeyDBPassword + fileEnvKeySuffix)
+			for key, value := range testCase.env {
+				t.Setenv(key, value)
+			}
+
+			fileValues.Store(&testCase.fileValues)
+			t.Cleanup(func() {
+				fileValues.Store(nil)
+			})
+
+			value, source, err := resolve(envKeyDBPassword)
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.value, value)
+			assert.Equal(t, testCase.source, source)
+		})
+	}
+}
+
+func TestRedactValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", redactValue("", redactSecret))
+	assert.Equal(t, "********", redactValue("secret", redactSecret))
+	assert.Equal(t, "redis://:xxxxx@localhost:6379/0", redactValue("redis://:password@localhost:6379/0", redactURL))
+	assert.Equal(t, "plain", redactValue("plain", redactNone))
+}
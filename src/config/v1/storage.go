@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
-	"os"
 	"strconv"
 
 	"github.com/traPtitech/trap-collection-server/src/config"
@@ -17,11 +16,29 @@ func NewStorage() *Storage {
 }
 
 func (*Storage) Type() (config.StorageType, error) {
-	storage, ok := os.LookupEnv(envKeyStorage)
+	storage, ok := lookupEnv(envKeyStorage)
 	if !ok {
 		return config.StorageTypeSwift, nil
 	}
 
+	return parseStorageType(storage)
+}
+
+func (*Storage) FallbackType() (config.StorageType, bool, error) {
+	storage, ok := lookupEnv(envKeyStorageFallback)
+	if !ok || storage == "" {
+		return 0, false, nil
+	}
+
+	storageType, err := parseStorageType(storage)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s: %w", envKeyStorageFallback, err)
+	}
+
+	return storageType, true, nil
+}
+
+func parseStorageType(storage string) (config.StorageType, error) {
 	switch storage {
 	case "swift":
 		return config.StorageTypeSwift, nil
@@ -41,7 +58,7 @@ func NewStorageSwift() *StorageSwift {
 }
 
 func (*StorageSwift) AuthURL() (*url.URL, error) {
-	strSwiftAuthURL, ok := os.LookupEnv(envKeySwiftAuthURL)
+	strSwiftAuthURL, ok := lookupEnv(envKeySwiftAuthURL)
 	if !ok {
 		return nil, errors.New("OS_AUTH_URL is not set")
 	}
@@ -55,7 +72,7 @@ func (*StorageSwift) AuthURL() (*url.URL, error) {
 }
 
 func (*StorageSwift) UserName() (string, error) {
-	swiftUserName, ok := os.LookupEnv(envKeySwiftUserName)
+	swiftUserName, ok := lookupEnv(envKeySwiftUserName)
 	if !ok {
 		return "", errors.New("OS_USERNAME is not set")
 	}
@@ -64,7 +81,7 @@ func (*StorageSwift) UserName() (string, error) {
 }
 
 func (*StorageSwift) Password() (string, error) {
-	swiftPassword, ok := os.LookupEnv(envKeySwiftPassword)
+	swiftPassword, ok := lookupEnv(envKeySwiftPassword)
 	if !ok {
 		return "", errors.New("OS_PASSWORD is not set")
 	}
@@ -73,7 +90,7 @@ func (*StorageSwift) Password() (string, error) {
 }
 
 func (*StorageSwift) TenantID() (string, error) {
-	swiftTenantID, ok := os.LookupEnv(envKeySwiftTenantID)
+	swiftTenantID, ok := lookupEnv(envKeySwiftTenantID)
 	if !ok {
 		return "", errors.New("OS_TENANT_ID is not set")
 	}
@@ -82,7 +99,7 @@ func (*StorageSwift) TenantID() (string, error) {
 }
 
 func (*StorageSwift) TenantName() (string, error) {
-	swiftTenantName, ok := os.LookupEnv(envKeySwiftTenantName)
+	swiftTenantName, ok := lookupEnv(envKeySwiftTenantName)
 	if !ok {
 		return "", errors.New("OS_TENANT_NAME is not set")
 	}
@@ -91,7 +108,7 @@ func (*StorageSwift) TenantName() (string, error) {
 }
 
 func (*StorageSwift) Container() (string, error) {
-	swiftContainer, ok := os.LookupEnv(envKeySwiftContainer)
+	swiftContainer, ok := lookupEnv(envKeySwiftContainer)
 	if !ok {
 		return "", errors.New("OS_CONTAINER is not set")
 	}
@@ -100,7 +117,7 @@ func (*StorageSwift) Container() (string, error) {
 }
 
 func (*StorageSwift) TmpURLKey() (string, error) {
-	swiftTmpURLKey, ok := os.LookupEnv(envKeySwiftTmpURLKey)
+	swiftTmpURLKey, ok := lookupEnv(envKeySwiftTmpURLKey)
 	if !ok {
 		return "", errors.New("OS_TMP_URL_KEY is not set")
 	}
@@ -115,7 +132,7 @@ func NewStorageS3() *StorageS3 {
 }
 
 func (*StorageS3) AccessKeyID() (string, error) {
-	s3AccessKeyID, ok := os.LookupEnv(envKeyS3AccessKeyID)
+	s3AccessKeyID, ok := lookupEnv(envKeyS3AccessKeyID)
 	if !ok {
 		return "", errors.New("S3_ACCESS_KEY_ID is not set")
 	}
@@ -124,7 +141,7 @@ func (*StorageS3) AccessKeyID() (string, error) {
 }
 
 func (*StorageS3) SecretAccessKey() (string, error) {
-	s3SecretAccessKey, ok := os.LookupEnv(envKeyS3SecretAccessKey)
+	s3SecretAccessKey, ok := lookupEnv(envKeyS3SecretAccessKey)
 	if !ok {
 		return "", errors.New("S3_SECRET_ACCESS_KEY is not set")
 	}
@@ -133,7 +150,7 @@ func (*StorageS3) SecretAccessKey() (string, error) {
 }
 
 func (*StorageS3) Region() (string, error) {
-	s3Region, ok := os.LookupEnv(envKeyS3Region)
+	s3Region, ok := lookupEnv(envKeyS3Region)
 	if !ok {
 		return "", errors.New("S3_REGION is not set")
 	}
@@ -142,7 +159,7 @@ func (*StorageS3) Region() (string, error) {
 }
 
 func (*StorageS3) Bucket() (string, error) {
-	s3Bucket, ok := os.LookupEnv(envKeyS3Bucket)
+	s3Bucket, ok := lookupEnv(envKeyS3Bucket)
 	if !ok {
 		return "", errors.New("S3_BUCKET is not set")
 	}
@@ -151,7 +168,7 @@ func (*StorageS3) Bucket() (string, error) {
 }
 
 func (*StorageS3) Endpoint() (string, error) {
-	s3Endpoint, ok := os.LookupEnv(envKeyS3Endpoint)
+	s3Endpoint, ok := lookupEnv(envKeyS3Endpoint)
 	if !ok {
 		return "", errors.New("S3_ENDPOINT is not set")
 	}
@@ -160,7 +177,7 @@ func (*StorageS3) Endpoint() (string, error) {
 }
 
 func (*StorageS3) UsePathStyle() bool {
-	s3UsePathStyleStr, ok := os.LookupEnv(envKeyS3UsePathStyle)
+	s3UsePathStyleStr, ok := lookupEnv(envKeyS3UsePathStyle)
 	if !ok {
 		return false
 	}
@@ -179,10 +196,33 @@ func NewStorageLocal() *StorageLocal {
 }
 
 func (*StorageLocal) Path() (string, error) {
-	filePath, ok := os.LookupEnv(envKeyFilePath)
+	filePath, ok := lookupEnv(envKeyFilePath)
 	if !ok {
 		return "", errors.New("FILE_PATH is not set")
 	}
 
 	return filePath, nil
 }
+
+func (*StorageLocal) TmpURLKey() (string, error) {
+	tmpURLKey, ok := lookupEnv(envKeyFileTmpURLKey)
+	if !ok {
+		return "", errors.New("FILE_TMP_URL_KEY is not set")
+	}
+
+	return tmpURLKey, nil
+}
+
+func (*StorageLocal) BaseURL() (*url.URL, error) {
+	strBaseURL, ok := lookupEnv(envKeyFileBaseURL)
+	if !ok {
+		return nil, errors.New("FILE_BASE_URL is not set")
+	}
+
+	baseURL, err := url.Parse(strBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse baseURL: %w", err)
+	}
+
+	return baseURL, nil
+}
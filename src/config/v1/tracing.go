@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+type Tracing struct{}
+
+func NewTracing() *Tracing {
+	return &Tracing{}
+}
+
+func (*Tracing) Exporter() (config.TracingExporter, error) {
+	exporter, ok := lookupEnv(envKeyTracingExporter)
+	if !ok {
+		return config.TracingExporterNone, nil
+	}
+
+	switch exporter {
+	case "none":
+		return config.TracingExporterNone, nil
+	case "otlp":
+		return config.TracingExporterOTLP, nil
+	case "stdout":
+		return config.TracingExporterStdout, nil
+	}
+
+	return 0, errors.New("invalid tracing exporter")
+}
+
+const defaultServiceName = "trap-collection-server"
+
+func (*Tracing) ServiceName() (string, error) {
+	// OpenTelemetryの標準の環境変数を優先する
+	serviceName, ok := lookupEnv(envKeyOTELServiceName)
+	if !ok || serviceName == "" {
+		return defaultServiceName, nil
+	}
+
+	return serviceName, nil
+}
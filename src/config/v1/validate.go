@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+// Validate
+// サーバーの起動に必要な全ての設定を読み込み、不正な値や不足している設定をまとめて返す。
+// ストレージなど種類を選べるものは、選ばれている種類の設定のみ確認する。
+func Validate() error {
+	v := &validator{
+		invalidKeys: map[envKey]struct{}{},
+	}
+
+	for _, s := range settings {
+		_, _, err := resolve(s.key)
+		if err != nil {
+			v.check(s.key, err)
+			// 読み込めなかった設定は、未設定のエラーを重ねて出さない
+			v.invalidKeys[s.key] = struct{}{}
+		}
+	}
+
+	app := NewApp()
+	_, err := app.Status()
+	v.check(envKeyCollectionEnv, err)
+	v.check(envKeyFeatureV2, validateBool(envKeyFeatureV2))
+	v.check(envKeyFeatureV1Write, validateBool(envKeyFeatureV1Write))
+	_, err = app.ShutdownTimeout()
+	v.check(envKeyShutdownTimeout, err)
+
+	log := NewLog(app)
+	_, err = log.Level()
+	v.check(envKeyLogLevel, err)
+	_, err = log.Format()
+	v.check(envKeyLogFormat, err)
+
+	tracing := NewTracing()
+	_, err = tracing.Exporter()
+	v.check(envKeyTracingExporter, err)
+
+	handler := NewHandler()
+	_, err = handler.Addr()
+	v.check(envKeyPort, err)
+	_, err = handler.SessionSecret()
+	v.check(envKeySessionSecret, err)
+	_, err = handler.TrustedProxies()
+	v.check(envKeyTrustedProxies, err)
+
+	serviceV2 := NewServiceV2()
+	_, err = serviceV2.ClientID()
+	v.check(envKeyClientID, err)
+	_, _, err = serviceV2.DefaultGameStorageQuota()
+	v.check(envKeyGameStorageQuota, err)
+	_, err = serviceV2.GameFileMaxUncompressedSize()
+	v.check(envKeyGameFileMaxUncompressedSize, err)
+	_, err = serviceV2.GameFileMaxEntries()
+	v.check(envKeyGameFileMaxEntries, err)
+	_, err = serviceV2.GameFileMaxCompressionRatio()
+	v.check(envKeyGameFileMaxCompressionRatio, err)
+	_, _, err = serviceV2.EditionBundleSigningKey()
+	v.check(envKeyEditionBundleSigningKey, err)
+
+	storage := NewStorage()
+	storageType, err := storage.Type()
+	v.check(envKeyStorage, err)
+	if err == nil {
+		v.validateStorage(storageType)
+	}
+	fallbackType, ok, err := storage.FallbackType()
+	v.check(envKeyStorageFallback, err)
+	if err == nil && ok && fallbackType != storageType {
+		v.validateStorage(fallbackType)
+	}
+
+	cacheType, err := NewCache().Type()
+	v.check(envKeyCache, err)
+	if err == nil && cacheType == config.CacheTypeRedis {
+		_, err = NewCacheRedis().URL()
+		v.check(envKeyRedisURL, err)
+	}
+
+	scannerType, err := NewScanner().Type()
+	v.check(envKeyScanner, err)
+	if err == nil && scannerType == config.ScannerTypeClamAV {
+		_, err = NewScannerClamAV().Socket()
+		v.check(envKeyClamAVSocket, err)
+	}
+
+	repository := NewRepositoryGorm2()
+	_, err = repository.User()
+	v.check(envKeyDBUserName, err)
+	_, err = repository.Password()
+	v.check(envKeyDBPassword, err)
+	_, err = repository.Host()
+	v.check(envKeyDBHostName, err)
+	_, err = repository.Port()
+	v.check(envKeyDBPort, err)
+	_, err = repository.Database()
+	v.check(envKeyDBDatabase, err)
+
+	migration := NewMigration()
+	emptyDB, err := migration.EmptyDB()
+	v.check(envKeyMigrationEmptyDB, err)
+	if err == nil && !emptyDB {
+		_, err = migration.Baseline()
+		v.check(envKeyMigrationBaseline, err)
+	}
+
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	errs []error
+	// invalidKeys 値の読み込みに失敗した設定
+	invalidKeys map[envKey]struct{}
+}
+
+func (v *validator) check(key envKey, err error) {
+	if err == nil {
+		return
+	}
+	if _, ok := v.invalidKeys[key]; ok {
+		return
+	}
+
+	v.errs = append(v.errs, fmt.Errorf("%s: %w", key, err))
+}
+
+func (v *validator) validateStorage(storageType config.StorageType) {
+	switch storageType {
+	case config.StorageTypeSwift:
+		swift := NewStorageSwift()
+		_, err := swift.AuthURL()
+		v.check(envKeySwiftAuthURL, err)
+		_, err = swift.UserName()
+		v.check(envKeySwiftUserName, err)
+		_, err = swift.Password()
+		v.check(envKeySwiftPassword, err)
+		_, err = swift.TenantID()
+		v.check(envKeySwiftTenantID, err)
+		_, err = swift.TenantName()
+		v.check(envKeySwiftTenantName, err)
+		_, err = swift.Container()
+		v.check(envKeySwiftContainer, err)
+		_, err = swift.TmpURLKey()
+		v.check(envKeySwiftTmpURLKey, err)
+	case config.StorageTypeS3:
+		s3 := NewStorageS3()
+		_, err := s3.AccessKeyID()
+		v.check(envKeyS3AccessKeyID, err)
+		_, err = s3.SecretAccessKey()
+		v.check(envKeyS3SecretAccessKey, err)
+		_, err = s3.Region()
+		v.check(envKeyS3Region, err)
+		_, err = s3.Bucket()
+		v.check(envKeyS3Bucket, err)
+		_, err = s3.Endpoint()
+		v.check(envKeyS3Endpoint, err)
+		v.check(envKeyS3UsePathStyle, validateBool(envKeyS3UsePathStyle))
+	case config.StorageTypeLocal:
+		local := NewStorageLocal()
+		_, err := local.Path()
+		v.check(envKeyFilePath, err)
+		_, err = local.TmpURLKey()
+		v.check(envKeyFileTmpURLKey, err)
+		_, err = local.BaseURL()
+		v.check(envKeyFileBaseURL, err)
+	}
+}
+
+// validateBool
+// 不正な値の場合にデフォルト値を使うbool値の設定が、正しい値かを確認する。
+func validateBool(key envKey) error {
+	value, ok := lookupEnv(key)
+	if !ok {
+		return nil
+	}
+
+	_, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid bool: %w", err)
+	}
+
+	return nil
+}
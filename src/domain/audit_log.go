@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// AuditLog
+// 管理操作の監査ログ。
+// before、afterは操作対象の変更前後の状態をJSONで表したもので、
+// 追加や削除のように片方が存在しない場合はnilになる。
+type AuditLog struct {
+	id         values.AuditLogID
+	actorID    values.TraPMemberID
+	actorName  values.TraPMemberName
+	action     values.AuditLogAction
+	targetType values.AuditLogTargetType
+	targetID   uuid.UUID
+	before     json.RawMessage
+	after      json.RawMessage
+	createdAt  time.Time
+}
+
+func NewAuditLog(
+	id values.AuditLogID,
+	actorID values.TraPMemberID,
+	actorName values.TraPMemberName,
+	action values.AuditLogAction,
+	targetType values.AuditLogTargetType,
+	targetID uuid.UUID,
+	before json.RawMessage,
+	after json.RawMessage,
+	createdAt time.Time,
+) *AuditLog {
+	return &AuditLog{
+		id:         id,
+		actorID:    actorID,
+		actorName:  actorName,
+		action:     action,
+		targetType: targetType,
+		targetID:   targetID,
+		before:     before,
+		after:      after,
+		createdAt:  createdAt,
+	}
+}
+
+func (a *AuditLog) GetID() values.AuditLogID {
+	return a.id
+}
+
+func (a *AuditLog) GetActorID() values.TraPMemberID {
+	return a.actorID
+}
+
+func (a *AuditLog) GetActorName() values.TraPMemberName {
+	return a.actorName
+}
+
+func (a *AuditLog) GetAction() values.AuditLogAction {
+	return a.action
+}
+
+func (a *AuditLog) GetTargetType() values.AuditLogTargetType {
+	return a.targetType
+}
+
+func (a *AuditLog) GetTargetID() uuid.UUID {
+	return a.targetID
+}
+
+func (a *AuditLog) GetBefore() json.RawMessage {
+	return a.before
+}
+
+func (a *AuditLog) GetAfter() json.RawMessage {
+	return a.after
+}
+
+func (a *AuditLog) GetCreatedAt() time.Time {
+	return a.createdAt
+}
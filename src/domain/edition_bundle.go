@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// EditionBundle
+// ネットワークに接続できない会場で使うための、エディションのゲームをまとめたバンドル。
+type EditionBundle struct {
+	id        values.EditionBundleID
+	editionID values.EditionID
+	status    values.EditionBundleStatus
+	// totalItems
+	// バンドルに含める画像・動画・ファイルの数。
+	// 生成を始めるまでは0になる。
+	totalItems int
+	// processedItems
+	// バンドルに書き込み終わった画像・動画・ファイルの数。
+	processedItems int
+	// size
+	// 生成したバンドルの容量。
+	// 生成が終わるまでは0になる。
+	size      values.GameStorageSize
+	createdAt time.Time
+}
+
+func NewEditionBundle(
+	id values.EditionBundleID,
+	editionID values.EditionID,
+	status values.EditionBundleStatus,
+	createdAt time.Time,
+) *EditionBundle {
+	return &EditionBundle{
+		id:        id,
+		editionID: editionID,
+		status:    status,
+		createdAt: createdAt,
+	}
+}
+
+func (eb *EditionBundle) GetID() values.EditionBundleID {
+	return eb.id
+}
+
+func (eb *EditionBundle) GetEditionID() values.EditionID {
+	return eb.editionID
+}
+
+func (eb *EditionBundle) GetStatus() values.EditionBundleStatus {
+	return eb.status
+}
+
+func (eb *EditionBundle) SetStatus(status values.EditionBundleStatus) {
+	eb.status = status
+}
+
+func (eb *EditionBundle) GetTotalItems() int {
+	return eb.totalItems
+}
+
+func (eb *EditionBundle) GetProcessedItems() int {
+	return eb.processedItems
+}
+
+// SetProgress
+// 生成の進捗を設定する。
+func (eb *EditionBundle) SetProgress(processedItems, totalItems int) {
+	eb.processedItems = processedItems
+	eb.totalItems = totalItems
+}
+
+func (eb *EditionBundle) GetSize() values.GameStorageSize {
+	return eb.size
+}
+
+func (eb *EditionBundle) SetSize(size values.GameStorageSize) {
+	eb.size = size
+}
+
+func (eb *EditionBundle) GetCreatedAt() time.Time {
+	return eb.createdAt
+}
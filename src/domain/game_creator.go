@@ -6,12 +6,26 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 )
 
+// GameCreator
+// ゲームのクリエイター。
+// traPのメンバーのクリエイターと、traP外部のクリエイターの2種類がある。
 type GameCreator struct {
-	id        values.GameCreatorID
-	userID    values.TraPMemberID
-	gameID    values.GameID
-	userName  values.TraPMemberName
-	createdAt time.Time
+	id       values.GameCreatorID
+	userID   values.TraPMemberID
+	gameID   values.GameID
+	userName values.TraPMemberName
+	// isExternal
+	// traP外部のクリエイターの場合はtrue。
+	// このとき、userID、userNameは使われず、externalName、externalURLが使われる。
+	isExternal   bool
+	externalName values.GameCreatorExternalName
+	// externalURL
+	// 設定されていない場合はnil。
+	externalURL values.GameCreatorExternalURL
+	// displayOrder
+	// ゲーム内でのクリエイターの表示順。小さいほど先に表示される。
+	displayOrder int
+	createdAt    time.Time
 }
 
 func NewGameCreator(id values.GameCreatorID, userID values.TraPMemberID, gameID values.GameID, userName values.TraPMemberName, createdAt time.Time) *GameCreator {
@@ -24,11 +38,48 @@ func NewGameCreator(id values.GameCreatorID, userID values.TraPMemberID, gameID
 	}
 }
 
+// NewExternalGameCreator
+// traP外部のクリエイターを作成する。
+// URLが無い場合、externalURLはnilにする。
+func NewExternalGameCreator(id values.GameCreatorID, gameID values.GameID, externalName values.GameCreatorExternalName, externalURL values.GameCreatorExternalURL, createdAt time.Time) *GameCreator {
+	return &GameCreator{
+		id:           id,
+		gameID:       gameID,
+		isExternal:   true,
+		externalName: externalName,
+		externalURL:  externalURL,
+		createdAt:    createdAt,
+	}
+}
+
 func (creator *GameCreator) GetID() values.GameCreatorID        { return creator.id }
 func (creator *GameCreator) GetUserID() values.TraPMemberID     { return creator.userID }
 func (creator *GameCreator) GetGameID() values.GameID           { return creator.gameID }
 func (creator *GameCreator) GetUserName() values.TraPMemberName { return creator.userName }
-func (creator *GameCreator) GetCreatedAt() time.Time            { return creator.createdAt }
+func (creator *GameCreator) IsExternal() bool                   { return creator.isExternal }
+func (creator *GameCreator) GetExternalName() values.GameCreatorExternalName {
+	return creator.externalName
+}
+func (creator *GameCreator) GetExternalURL() values.GameCreatorExternalURL {
+	return creator.externalURL
+}
+func (creator *GameCreator) GetDisplayOrder() int    { return creator.displayOrder }
+func (creator *GameCreator) GetCreatedAt() time.Time { return creator.createdAt }
+
+// GetDisplayName
+// クレジットに表示する名前を返す。
+// traPのメンバーの場合はユーザー名、外部のクリエイターの場合は表示名。
+func (creator *GameCreator) GetDisplayName() string {
+	if creator.isExternal {
+		return string(creator.externalName)
+	}
+
+	return string(creator.userName)
+}
+
+func (creator *GameCreator) SetDisplayOrder(displayOrder int) {
+	creator.displayOrder = displayOrder
+}
 
 type GameCreatorJob struct {
 	id          values.GameCreatorJobID
@@ -75,6 +126,9 @@ type GameCreatorWithJobs struct {
 	gameCreator *GameCreator
 	jobs        []*GameCreatorJob
 	customJobs  []*GameCreatorCustomJob
+	// jobDisplayOrders
+	// プリセットジョブとカスタムジョブをまとめた、クリエイター内でのジョブの表示順。
+	jobDisplayOrders map[values.GameCreatorJobID]int
 }
 
 func NewGameCreatorWithJobs(gameCreator *GameCreator, jobs []*GameCreatorJob, customJobs []*GameCreatorCustomJob) *GameCreatorWithJobs {
@@ -88,3 +142,43 @@ func NewGameCreatorWithJobs(gameCreator *GameCreator, jobs []*GameCreatorJob, cu
 func (gcj *GameCreatorWithJobs) GetGameCreator() *GameCreator           { return gcj.gameCreator }
 func (gcj *GameCreatorWithJobs) GetJobs() []*GameCreatorJob             { return gcj.jobs }
 func (gcj *GameCreatorWithJobs) GetCustomJobs() []*GameCreatorCustomJob { return gcj.customJobs }
+
+// GetJobDisplayOrder
+// ジョブの表示順を返す。表示順が設定されていない場合は0。
+func (gcj *GameCreatorWithJobs) GetJobDisplayOrder(jobID values.GameCreatorJobID) int {
+	return gcj.jobDisplayOrders[jobID]
+}
+
+func (gcj *GameCreatorWithJobs) SetJobDisplayOrders(jobDisplayOrders map[values.GameCreatorJobID]int) {
+	gcj.jobDisplayOrders = jobDisplayOrders
+}
+
+// GameCredit
+// スタッフロールのうち、1つのジョブの欄。
+// creatorsはクリエイターの表示順に並ぶ。
+type GameCredit struct {
+	jobID          values.GameCreatorJobID
+	jobDisplayName values.GameCreatorJobDisplayName
+	isCustomJob    bool
+	creators       []*GameCreator
+}
+
+func NewGameCredit(jobID values.GameCreatorJobID, jobDisplayName values.GameCreatorJobDisplayName, isCustomJob bool, creators []*GameCreator) *GameCredit {
+	return &GameCredit{
+		jobID:          jobID,
+		jobDisplayName: jobDisplayName,
+		isCustomJob:    isCustomJob,
+		creators:       creators,
+	}
+}
+
+func (gc *GameCredit) GetJobID() values.GameCreatorJobID { return gc.jobID }
+func (gc *GameCredit) GetJobDisplayName() values.GameCreatorJobDisplayName {
+	return gc.jobDisplayName
+}
+func (gc *GameCredit) IsCustomJob() bool           { return gc.isCustomJob }
+func (gc *GameCredit) GetCreators() []*GameCreator { return gc.creators }
+
+func (gc *GameCredit) AddCreator(creator *GameCreator) {
+	gc.creators = append(gc.creators, creator)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import "github.com/traPtitech/trap-collection-server/src/domain/values"
+
+// GameFacets
+// ゲーム一覧の検索条件に一致するゲームの、ジャンル・ファイルの種類・公開範囲ごとの件数。
+type GameFacets struct {
+	genres       []*GameGenreFacet
+	fileTypes    []*GameFileTypeFacet
+	visibilities []*GameVisibilityFacet
+}
+
+func NewGameFacets(genres []*GameGenreFacet, fileTypes []*GameFileTypeFacet, visibilities []*GameVisibilityFacet) *GameFacets {
+	return &GameFacets{
+		genres:       genres,
+		fileTypes:    fileTypes,
+		visibilities: visibilities,
+	}
+}
+
+func (gf *GameFacets) GetGenres() []*GameGenreFacet {
+	return gf.genres
+}
+
+func (gf *GameFacets) GetFileTypes() []*GameFileTypeFacet {
+	return gf.fileTypes
+}
+
+func (gf *GameFacets) GetVisibilities() []*GameVisibilityFacet {
+	return gf.visibilities
+}
+
+type GameGenreFacet struct {
+	genre     *GameGenre
+	gameCount int
+}
+
+func NewGameGenreFacet(genre *GameGenre, gameCount int) *GameGenreFacet {
+	return &GameGenreFacet{
+		genre:     genre,
+		gameCount: gameCount,
+	}
+}
+
+func (gf *GameGenreFacet) GetGenre() *GameGenre {
+	return gf.genre
+}
+
+func (gf *GameGenreFacet) GetGameCount() int {
+	return gf.gameCount
+}
+
+// GameFileTypeFacet
+// 対応OSの絞り込みに使うため、そのファイルの種類のファイルを持つゲームの数を持つ。
+type GameFileTypeFacet struct {
+	fileType  values.GameFileType
+	gameCount int
+}
+
+func NewGameFileTypeFacet(fileType values.GameFileType, gameCount int) *GameFileTypeFacet {
+	return &GameFileTypeFacet{
+		fileType:  fileType,
+		gameCount: gameCount,
+	}
+}
+
+func (gf *GameFileTypeFacet) GetFileType() values.GameFileType {
+	return gf.fileType
+}
+
+func (gf *GameFileTypeFacet) GetGameCount() int {
+	return gf.gameCount
+}
+
+type GameVisibilityFacet struct {
+	visibility values.GameVisibility
+	gameCount  int
+}
+
+func NewGameVisibilityFacet(visibility values.GameVisibility, gameCount int) *GameVisibilityFacet {
+	return &GameVisibilityFacet{
+		visibility: visibility,
+		gameCount:  gameCount,
+	}
+}
+
+func (gf *GameVisibilityFacet) GetVisibility() values.GameVisibility {
+	return gf.visibility
+}
+
+func (gf *GameVisibilityFacet) GetGameCount() int {
+	return gf.gameCount
+}
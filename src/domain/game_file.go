@@ -12,6 +12,15 @@ type GameFile struct {
 	entryPoint values.GameFileEntryPoint
 	hash       values.GameFileHash
 	createdAt  time.Time
+	// size
+	// zipファイルの容量。
+	// 同じ内容のファイルとストレージ上の実体を共有していても、ゲームごとに容量を数える。
+	// 容量の記録の導入前に保存されたファイルでは0になる。
+	size values.GameStorageSize
+	// scanStatus
+	// ファイルの内容の検査の状態。
+	// 保存直後は未検査で、非同期に検査される。
+	scanStatus values.GameFileScanStatus
 }
 
 func NewGameFile(
@@ -49,3 +58,19 @@ func (gf *GameFile) GetHash() values.GameFileHash {
 func (gf *GameFile) GetCreatedAt() time.Time {
 	return gf.createdAt
 }
+
+func (gf *GameFile) GetSize() values.GameStorageSize {
+	return gf.size
+}
+
+func (gf *GameFile) SetSize(size values.GameStorageSize) {
+	gf.size = size
+}
+
+func (gf *GameFile) GetScanStatus() values.GameFileScanStatus {
+	return gf.scanStatus
+}
+
+func (gf *GameFile) SetScanStatus(scanStatus values.GameFileScanStatus) {
+	gf.scanStatus = scanStatus
+}
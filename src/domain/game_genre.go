@@ -35,3 +35,43 @@ func (gg *GameGenre) GetName() values.GameGenreName {
 func (gg *GameGenre) GetCreatedAt() time.Time {
 	return gg.createdAt
 }
+
+// GameGenreAlias
+// ゲームジャンルの別名。
+// ジャンル名の入力時に別名が指定された場合は、別名が指すジャンルとして扱う。
+type GameGenreAlias struct {
+	id        values.GameGenreAliasID
+	genreID   values.GameGenreID
+	name      values.GameGenreName
+	createdAt time.Time
+}
+
+func NewGameGenreAlias(
+	id values.GameGenreAliasID,
+	genreID values.GameGenreID,
+	name values.GameGenreName,
+	createdAt time.Time,
+) *GameGenreAlias {
+	return &GameGenreAlias{
+		id:        id,
+		genreID:   genreID,
+		name:      name,
+		createdAt: createdAt,
+	}
+}
+
+func (ga *GameGenreAlias) GetID() values.GameGenreAliasID {
+	return ga.id
+}
+
+func (ga *GameGenreAlias) GetGenreID() values.GameGenreID {
+	return ga.genreID
+}
+
+func (ga *GameGenreAlias) GetName() values.GameGenreName {
+	return ga.name
+}
+
+func (ga *GameGenreAlias) GetCreatedAt() time.Time {
+	return ga.createdAt
+}
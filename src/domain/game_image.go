@@ -12,6 +12,9 @@ type GameImage struct {
 	id        values.GameImageID
 	imageType values.GameImageType
 	createdAt time.Time
+	// size
+	// 元の画像の容量。容量の記録の導入前に保存された画像では0になる。
+	size values.GameStorageSize
 }
 
 func NewGameImage(
@@ -37,3 +40,64 @@ func (gi *GameImage) GetType() values.GameImageType {
 func (gi *GameImage) GetCreatedAt() time.Time {
 	return gi.createdAt
 }
+
+func (gi *GameImage) GetSize() values.GameStorageSize {
+	return gi.size
+}
+
+func (gi *GameImage) SetSize(size values.GameStorageSize) {
+	gi.size = size
+}
+
+// GameImageVariant
+// ゲーム画像から生成したサムネイル・WebPなどの派生画像。
+type GameImageVariant struct {
+	id        values.GameImageVariantID
+	size      values.GameImageSize
+	imageType values.GameImageType
+	width     int
+	height    int
+	createdAt time.Time
+}
+
+func NewGameImageVariant(
+	id values.GameImageVariantID,
+	size values.GameImageSize,
+	imageType values.GameImageType,
+	width int,
+	height int,
+	createdAt time.Time,
+) *GameImageVariant {
+	return &GameImageVariant{
+		id:        id,
+		size:      size,
+		imageType: imageType,
+		width:     width,
+		height:    height,
+		createdAt: createdAt,
+	}
+}
+
+func (v *GameImageVariant) GetID() values.GameImageVariantID {
+	return v.id
+}
+
+func (v *GameImageVariant) GetSize() values.GameImageSize {
+	return v.size
+}
+
+func (v *GameImageVariant) GetType() values.GameImageType {
+	return v.imageType
+}
+
+func (v *GameImageVariant) GetWidth() int {
+	return v.width
+}
+
+func (v *GameImageVariant) GetHeight() int {
+	return v.height
+}
+
+func (v *GameImageVariant) GetCreatedAt() time.Time {
+	return v.createdAt
+}
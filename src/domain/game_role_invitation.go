@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// GameRoleInvitation
+// ゲームの管理権限への招待を表すドメイン。
+// 招待されたユーザーが承諾するまで管理権限は付与されない。
+// 有効期限を過ぎた招待は承諾できない。
+type GameRoleInvitation struct {
+	id        values.GameRoleInvitationID
+	inviterID values.TraPMemberID
+	inviteeID values.TraPMemberID
+	role      values.GameManagementRole
+	status    values.GameRoleInvitationStatus
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func NewGameRoleInvitation(
+	id values.GameRoleInvitationID,
+	inviterID values.TraPMemberID,
+	inviteeID values.TraPMemberID,
+	role values.GameManagementRole,
+	status values.GameRoleInvitationStatus,
+	createdAt time.Time,
+	expiresAt time.Time,
+) *GameRoleInvitation {
+	return &GameRoleInvitation{
+		id:        id,
+		inviterID: inviterID,
+		inviteeID: inviteeID,
+		role:      role,
+		status:    status,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (gri *GameRoleInvitation) GetID() values.GameRoleInvitationID {
+	return gri.id
+}
+
+func (gri *GameRoleInvitation) GetInviterID() values.TraPMemberID {
+	return gri.inviterID
+}
+
+func (gri *GameRoleInvitation) GetInviteeID() values.TraPMemberID {
+	return gri.inviteeID
+}
+
+func (gri *GameRoleInvitation) GetRole() values.GameManagementRole {
+	return gri.role
+}
+
+func (gri *GameRoleInvitation) GetStatus() values.GameRoleInvitationStatus {
+	return gri.status
+}
+
+func (gri *GameRoleInvitation) SetStatus(status values.GameRoleInvitationStatus) {
+	gri.status = status
+}
+
+func (gri *GameRoleInvitation) GetCreatedAt() time.Time {
+	return gri.createdAt
+}
+
+func (gri *GameRoleInvitation) GetExpiresAt() time.Time {
+	return gri.expiresAt
+}
+
+// IsExpired 有効期限を過ぎていたらtrue
+func (gri *GameRoleInvitation) IsExpired() bool {
+	return time.Now().After(gri.expiresAt)
+}
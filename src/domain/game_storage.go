@@ -0,0 +1,40 @@
+package domain
+
+import "github.com/traPtitech/trap-collection-server/src/domain/values"
+
+// GameStorageUsage
+// ゲームが使用しているストレージの容量の内訳。
+// 画像の派生画像・動画のポスター画像は含まない。
+type GameStorageUsage struct {
+	file  values.GameStorageSize
+	image values.GameStorageSize
+	video values.GameStorageSize
+}
+
+func NewGameStorageUsage(
+	file values.GameStorageSize,
+	image values.GameStorageSize,
+	video values.GameStorageSize,
+) *GameStorageUsage {
+	return &GameStorageUsage{
+		file:  file,
+		image: image,
+		video: video,
+	}
+}
+
+func (u *GameStorageUsage) GetFile() values.GameStorageSize {
+	return u.file
+}
+
+func (u *GameStorageUsage) GetImage() values.GameStorageSize {
+	return u.image
+}
+
+func (u *GameStorageUsage) GetVideo() values.GameStorageSize {
+	return u.video
+}
+
+func (u *GameStorageUsage) GetTotal() values.GameStorageSize {
+	return u.file + u.image + u.video
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"time"
 
+	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 )
 
@@ -12,6 +13,13 @@ type GameVideo struct {
 	id        values.GameVideoID
 	videoType values.GameVideoType
 	createdAt time.Time
+	// size
+	// 動画の容量。容量の記録の導入前に保存された動画では0になる。
+	size values.GameStorageSize
+	// metadata
+	// メタデータの抽出機能の追加前に保存された動画ではinvalidになる。
+	metadata   option.Option[*GameVideoMetadata]
+	posterType option.Option[values.GameImageType]
 }
 
 func NewGameVideo(
@@ -37,3 +45,78 @@ func (v *GameVideo) GetType() values.GameVideoType {
 func (v *GameVideo) GetCreatedAt() time.Time {
 	return v.createdAt
 }
+
+func (v *GameVideo) GetSize() values.GameStorageSize {
+	return v.size
+}
+
+func (v *GameVideo) SetSize(size values.GameStorageSize) {
+	v.size = size
+}
+
+func (v *GameVideo) GetMetadata() option.Option[*GameVideoMetadata] {
+	return v.metadata
+}
+
+func (v *GameVideo) SetMetadata(metadata *GameVideoMetadata) {
+	v.metadata = option.NewOption(metadata)
+}
+
+// GetPosterType
+// ポスター画像の形式。
+// ポスター画像が設定されていない場合はinvalidになる。
+func (v *GameVideo) GetPosterType() option.Option[values.GameImageType] {
+	return v.posterType
+}
+
+func (v *GameVideo) SetPosterType(posterType values.GameImageType) {
+	v.posterType = option.NewOption(posterType)
+}
+
+// GameVideoMetadata
+// ゲームの紹介映像のコンテナから取得したメタデータ。
+type GameVideoMetadata struct {
+	duration   time.Duration
+	width      int
+	height     int
+	videoCodec values.GameVideoCodec
+	// audioCodec
+	// 音声トラックがない場合はinvalidになる。
+	audioCodec option.Option[values.GameVideoAudioCodec]
+}
+
+func NewGameVideoMetadata(
+	duration time.Duration,
+	width int,
+	height int,
+	videoCodec values.GameVideoCodec,
+	audioCodec option.Option[values.GameVideoAudioCodec],
+) *GameVideoMetadata {
+	return &GameVideoMetadata{
+		duration:   duration,
+		width:      width,
+		height:     height,
+		videoCodec: videoCodec,
+		audioCodec: audioCodec,
+	}
+}
+
+func (m *GameVideoMetadata) GetDuration() time.Duration {
+	return m.duration
+}
+
+func (m *GameVideoMetadata) GetWidth() int {
+	return m.width
+}
+
+func (m *GameVideoMetadata) GetHeight() int {
+	return m.height
+}
+
+func (m *GameVideoMetadata) GetVideoCodec() values.GameVideoCodec {
+	return m.videoCodec
+}
+
+func (m *GameVideoMetadata) GetAudioCodec() option.Option[values.GameVideoAudioCodec] {
+	return m.audioCodec
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// StorageVerification
+// DBに記録されているゲームファイル・画像・動画が、ストレージに存在するかの検証の1回分の結果。
+type StorageVerification struct {
+	id     values.StorageVerificationID
+	status values.StorageVerificationStatus
+	// totalObjects
+	// 検証対象のオブジェクトの数。
+	// オブジェクトの一覧を取得するまでは0になる。
+	totalObjects int
+	// checkedObjects
+	// 検証済みのオブジェクトの数。
+	checkedObjects int
+	// issueCounts
+	// 見つかった問題の種類ごとの数。
+	issueCounts map[values.StorageVerificationIssueType]int
+	startedAt   time.Time
+	// finishedAt
+	// 実行中の場合はinvalid。
+	finishedAt option.Option[time.Time]
+}
+
+func NewStorageVerification(
+	id values.StorageVerificationID,
+	status values.StorageVerificationStatus,
+	startedAt time.Time,
+) *StorageVerification {
+	return &StorageVerification{
+		id:          id,
+		status:      status,
+		issueCounts: map[values.StorageVerificationIssueType]int{},
+		startedAt:   startedAt,
+	}
+}
+
+func (sv *StorageVerification) GetID() values.StorageVerificationID {
+	return sv.id
+}
+
+func (sv *StorageVerification) GetStatus() values.StorageVerificationStatus {
+	return sv.status
+}
+
+func (sv *StorageVerification) GetTotalObjects() int {
+	return sv.totalObjects
+}
+
+func (sv *StorageVerification) GetCheckedObjects() int {
+	return sv.checkedObjects
+}
+
+// SetProgress
+// 検証の進捗を設定する。
+func (sv *StorageVerification) SetProgress(checkedObjects, totalObjects int) {
+	sv.checkedObjects = checkedObjects
+	sv.totalObjects = totalObjects
+}
+
+// GetIssueCount
+// 種類ごとの問題の数を返す。
+func (sv *StorageVerification) GetIssueCount(issueType values.StorageVerificationIssueType) int {
+	return sv.issueCounts[issueType]
+}
+
+func (sv *StorageVerification) SetIssueCount(issueType values.StorageVerificationIssueType, count int) {
+	sv.issueCounts[issueType] = count
+}
+
+// AddIssue
+// 問題が見つかったオブジェクトを数える。
+func (sv *StorageVerification) AddIssue(issueType values.StorageVerificationIssueType) {
+	sv.issueCounts[issueType]++
+}
+
+// GetTotalIssues
+// 全ての種類の問題の数の合計を返す。
+func (sv *StorageVerification) GetTotalIssues() int {
+	total := 0
+	for _, count := range sv.issueCounts {
+		total += count
+	}
+
+	return total
+}
+
+func (sv *StorageVerification) GetStartedAt() time.Time {
+	return sv.startedAt
+}
+
+func (sv *StorageVerification) GetFinishedAt() option.Option[time.Time] {
+	return sv.finishedAt
+}
+
+// Finish
+// 検証の終了を記録する。
+func (sv *StorageVerification) Finish(status values.StorageVerificationStatus, finishedAt time.Time) {
+	sv.status = status
+	sv.finishedAt = option.NewOption(finishedAt)
+}
+
+// StorageVerificationIssue
+// ストレージの検証で問題が見つかったオブジェクト。
+type StorageVerificationIssue struct {
+	objectKind values.StorageVerificationObjectKind
+	// objectID
+	// ゲームファイル・画像・派生画像・動画のID。
+	// ポスター画像の場合は動画のID。
+	objectID  uuid.UUID
+	gameID    values.GameID
+	issueType values.StorageVerificationIssueType
+	// detail
+	// ハッシュ値の不一致やアクセスの失敗の内容。
+	detail string
+}
+
+func NewStorageVerificationIssue(
+	objectKind values.StorageVerificationObjectKind,
+	objectID uuid.UUID,
+	gameID values.GameID,
+	issueType values.StorageVerificationIssueType,
+	detail string,
+) *StorageVerificationIssue {
+	return &StorageVerificationIssue{
+		objectKind: objectKind,
+		objectID:   objectID,
+		gameID:     gameID,
+		issueType:  issueType,
+		detail:     detail,
+	}
+}
+
+func (svi *StorageVerificationIssue) GetObjectKind() values.StorageVerificationObjectKind {
+	return svi.objectKind
+}
+
+func (svi *StorageVerificationIssue) GetObjectID() uuid.UUID {
+	return svi.objectID
+}
+
+func (svi *StorageVerificationIssue) GetGameID() values.GameID {
+	return svi.gameID
+}
+
+func (svi *StorageVerificationIssue) GetIssueType() values.StorageVerificationIssueType {
+	return svi.issueType
+}
+
+func (svi *StorageVerificationIssue) GetDetail() string {
+	return svi.detail
+}
@@ -0,0 +1,66 @@
+package values
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	AuditLogID         uuid.UUID
+	AuditLogAction     int
+	AuditLogTargetType int
+)
+
+func NewAuditLogID() AuditLogID {
+	return AuditLogID(uuid.New())
+}
+
+func NewAuditLogIDFromUUID(id uuid.UUID) AuditLogID {
+	return AuditLogID(id)
+}
+
+const (
+	// AuditLogActionAddAdmin traP Collection全体の管理者の追加
+	AuditLogActionAddAdmin AuditLogAction = iota
+	// AuditLogActionDeleteAdmin traP Collection全体の管理者の削除
+	AuditLogActionDeleteAdmin
+	// AuditLogActionEditGameManagementRole ゲームの管理者の追加・変更
+	AuditLogActionEditGameManagementRole
+	// AuditLogActionRemoveGameManagementRole ゲームの管理者の削除
+	AuditLogActionRemoveGameManagementRole
+	// AuditLogActionUpdateGame ゲームの情報(名前、説明、公開範囲)の変更
+	AuditLogActionUpdateGame
+	// AuditLogActionUpdateEdition エディションの情報の変更
+	AuditLogActionUpdateEdition
+	// AuditLogActionUpdateEditionGameVersions エディションに含まれるゲームバージョンの変更
+	AuditLogActionUpdateEditionGameVersions
+	// AuditLogActionDeleteEdition エディションの削除
+	AuditLogActionDeleteEdition
+	// AuditLogActionActivateProductKey プロダクトキーの再有効化
+	AuditLogActionActivateProductKey
+	// AuditLogActionRevokeProductKey プロダクトキーの失効
+	AuditLogActionRevokeProductKey
+	// AuditLogActionTransferGameOwnership ゲームのownerの譲渡
+	AuditLogActionTransferGameOwnership
+	// AuditLogActionMergeGameGenres ゲームジャンルの統合
+	AuditLogActionMergeGameGenres
+	// AuditLogActionUpdateGameGenreParent ゲームジャンルの親ジャンルの変更
+	AuditLogActionUpdateGameGenreParent
+	// AuditLogActionAddGameGenreAlias ゲームジャンルの別名の追加
+	AuditLogActionAddGameGenreAlias
+	// AuditLogActionDeleteGameGenreAlias ゲームジャンルの別名の削除
+	AuditLogActionDeleteGameGenreAlias
+	// AuditLogActionUpdateGameStorageQuota ゲームごとのストレージの上限の設定
+	AuditLogActionUpdateGameStorageQuota
+	// AuditLogActionDeleteGameStorageQuota ゲームごとのストレージの上限の削除
+	AuditLogActionDeleteGameStorageQuota
+	// AuditLogActionUpdateGameFileScanStatus ゲームファイルの検査の状態の変更・再検査の指示
+	AuditLogActionUpdateGameFileScanStatus
+)
+
+const (
+	AuditLogTargetTypeUser AuditLogTargetType = iota
+	AuditLogTargetTypeGame
+	AuditLogTargetTypeEdition
+	AuditLogTargetTypeProductKey
+	AuditLogTargetTypeGameGenre
+)
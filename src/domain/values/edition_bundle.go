@@ -0,0 +1,38 @@
+package values
+
+import (
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+type (
+	EditionBundleID uuid.UUID
+	// EditionBundleStatus
+	// オフライン配布用のバンドルの生成状況。
+	EditionBundleStatus int
+	EditionBundleTmpURL *url.URL
+)
+
+func NewEditionBundleID() EditionBundleID {
+	return EditionBundleID(uuid.New())
+}
+
+func NewEditionBundleIDFromUUID(id uuid.UUID) EditionBundleID {
+	return EditionBundleID(id)
+}
+
+const (
+	// EditionBundleStatusPending 生成待ち
+	EditionBundleStatusPending EditionBundleStatus = iota
+	// EditionBundleStatusBuilding 生成中
+	EditionBundleStatusBuilding
+	// EditionBundleStatusCompleted 生成済み
+	EditionBundleStatusCompleted
+	// EditionBundleStatusFailed 生成に失敗した
+	EditionBundleStatusFailed
+)
+
+func NewEditionBundleTmpURL(tmpURL *url.URL) EditionBundleTmpURL {
+	return EditionBundleTmpURL(tmpURL)
+}
@@ -1,6 +1,11 @@
 package values
 
-import "github.com/google/uuid"
+import (
+	"errors"
+	"net/url"
+
+	"github.com/google/uuid"
+)
 
 type GameCreatorID uuid.UUID
 
@@ -19,3 +24,39 @@ type GameCreatorJobDisplayName string
 func NewGameCreatorJobDisplayName(name string) GameCreatorJobDisplayName {
 	return GameCreatorJobDisplayName(name)
 }
+
+type (
+	// GameCreatorExternalName
+	// traPのメンバーでないクリエイターの表示名。
+	GameCreatorExternalName string
+	// GameCreatorExternalURL
+	// traPのメンバーでないクリエイターのWebサイトなどのURL。
+	GameCreatorExternalURL *url.URL
+)
+
+func NewGameCreatorExternalName(name string) GameCreatorExternalName {
+	return GameCreatorExternalName(name)
+}
+
+func NewGameCreatorExternalURL(link *url.URL) GameCreatorExternalURL {
+	return GameCreatorExternalURL(link)
+}
+
+var (
+	ErrGameCreatorExternalNameEmpty   = errors.New("game creator external name must not be empty")
+	ErrGameCreatorExternalNameTooLong = errors.New("game creator external name must be no longer than 64 characters")
+)
+
+const gameCreatorExternalNameLimit = 64
+
+// 表示名は0文字より長く64文字以下にする。
+func (n GameCreatorExternalName) Validate() error {
+	if len([]rune(string(n))) == 0 {
+		return ErrGameCreatorExternalNameEmpty
+	}
+	if len([]rune(string(n))) > gameCreatorExternalNameLimit {
+		return ErrGameCreatorExternalNameTooLong
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package values
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGameCreatorExternalNameValidate(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name        GameCreatorExternalName
+		isErr       bool
+		expectedErr error
+	}
+
+	testCases := map[string]test{
+		"特に問題ないのでエラー無し": {
+			name:  NewGameCreatorExternalName("creator"),
+			isErr: false,
+		},
+		"日本語で64文字でもエラー無し": {
+			name:  NewGameCreatorExternalName(strings.Repeat("あ", 64)),
+			isErr: false,
+		},
+		"空白なのでエラー": {
+			name:        NewGameCreatorExternalName(""),
+			isErr:       true,
+			expectedErr: ErrGameCreatorExternalNameEmpty,
+		},
+		"64文字より長いのでエラー": {
+			name:        NewGameCreatorExternalName(strings.Repeat("あ", 65)),
+			isErr:       true,
+			expectedErr: ErrGameCreatorExternalNameTooLong,
+		},
+	}
+
+	for desc, testCase := range testCases {
+		t.Run(desc, func(t *testing.T) {
+			err := testCase.name.Validate()
+
+			if testCase.isErr {
+				if testCase.expectedErr == nil {
+					assert.Error(t, err)
+				} else {
+					assert.ErrorIs(t, err, testCase.expectedErr)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
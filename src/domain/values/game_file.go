@@ -22,6 +22,10 @@ type (
 	// ファイルのハッシュ値。
 	// ランチャーでファイルが壊れていないかの確認に使用する。
 	GameFileHash []byte
+	// GameFileScanStatus
+	// ファイルの内容の検査の状態。
+	// 検査を通過したファイルのみ、ゲームバージョンへの紐づけやエディションからの配信ができる。
+	GameFileScanStatus int
 
 	GameFileTmpURL *url.URL
 )
@@ -40,6 +44,17 @@ const (
 	GameFileTypeMac
 )
 
+const (
+	// GameFileScanStatusPending 未検査
+	GameFileScanStatusPending GameFileScanStatus = iota
+	// GameFileScanStatusClean 検査を通過した
+	GameFileScanStatusClean
+	// GameFileScanStatusRejected 検査で問題が見つかった
+	GameFileScanStatusRejected
+	// GameFileScanStatusFailed 検査を繰り返し失敗した。adminが再検査を指示するまで検査しない
+	GameFileScanStatusFailed
+)
+
 func NewGameFileEntryPoint(entryPoint string) GameFileEntryPoint {
 	return GameFileEntryPoint(entryPoint)
 }
@@ -7,8 +7,9 @@ import (
 )
 
 type (
-	GameGenreID   uuid.UUID
-	GameGenreName string
+	GameGenreID      uuid.UUID
+	GameGenreName    string
+	GameGenreAliasID uuid.UUID
 )
 
 func NewGameGenreID() GameGenreID {
@@ -19,6 +20,14 @@ func GameGenreIDFromUUID(id uuid.UUID) GameGenreID {
 	return GameGenreID(id)
 }
 
+func NewGameGenreAliasID() GameGenreAliasID {
+	return GameGenreAliasID(uuid.New())
+}
+
+func NewGameGenreAliasIDFromUUID(id uuid.UUID) GameGenreAliasID {
+	return GameGenreAliasID(id)
+}
+
 func NewGameGenreName(name string) GameGenreName {
 	return GameGenreName(name)
 }
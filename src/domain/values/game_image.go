@@ -10,6 +10,12 @@ type (
 	GameImageID     uuid.UUID
 	GameImageType   int
 	GameImageTmpURL *url.URL
+
+	GameImageVariantID uuid.UUID
+	// GameImageSize
+	// ゲーム画像のサイズ。
+	// GameImageSizeOriginal以外はサムネイルとして生成した派生画像を表す。
+	GameImageSize int
 )
 
 func NewGameImageID() GameImageID {
@@ -24,6 +30,24 @@ const (
 	GameImageTypeJpeg GameImageType = iota
 	GameImageTypePng
 	GameImageTypeGif
+	// GameImageTypeWebp
+	// 派生画像にのみ使われ、アップロードされる画像の形式としては受け付けない。
+	GameImageTypeWebp
+)
+
+func NewGameImageVariantID() GameImageVariantID {
+	return GameImageVariantID(uuid.New())
+}
+
+func GameImageVariantIDFromUUID(id uuid.UUID) GameImageVariantID {
+	return GameImageVariantID(id)
+}
+
+const (
+	GameImageSizeOriginal GameImageSize = iota
+	GameImageSizeSmall
+	GameImageSizeMedium
+	GameImageSizeLarge
 )
 
 func NewGameImageTmpURL(tmpURL *url.URL) GameImageTmpURL {
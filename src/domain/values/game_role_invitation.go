@@ -0,0 +1,27 @@
+package values
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	GameRoleInvitationID     uuid.UUID
+	GameRoleInvitationStatus int
+)
+
+func NewGameRoleInvitationID() GameRoleInvitationID {
+	return GameRoleInvitationID(uuid.New())
+}
+
+func NewGameRoleInvitationIDFromUUID(id uuid.UUID) GameRoleInvitationID {
+	return GameRoleInvitationID(id)
+}
+
+const (
+	// GameRoleInvitationStatusPending 招待されたユーザーの応答待ち
+	GameRoleInvitationStatusPending GameRoleInvitationStatus = iota
+	// GameRoleInvitationStatusAccepted 招待されたユーザーが承諾した
+	GameRoleInvitationStatusAccepted
+	// GameRoleInvitationStatusDeclined 招待されたユーザーが辞退した
+	GameRoleInvitationStatusDeclined
+)
@@ -0,0 +1,5 @@
+package values
+
+// GameStorageSize
+// ゲームのファイル・画像・動画がストレージ上で占める容量(バイト)。
+type GameStorageSize int64
@@ -29,3 +29,34 @@ const (
 func NewGameVideoTmpURL(tmpURL *url.URL) GameVideoTmpURL {
 	return GameVideoTmpURL(tmpURL)
 }
+
+// GameVideoCodec
+// ゲーム動画の映像のコーデック。
+// ブラウザで再生できるもののみを扱う。
+type GameVideoCodec int8
+
+const (
+	GameVideoCodecH264 GameVideoCodec = iota
+	GameVideoCodecVP8
+	GameVideoCodecVP9
+	GameVideoCodecAV1
+)
+
+// GameVideoAudioCodec
+// ゲーム動画の音声のコーデック。
+// ブラウザで再生できるもののみを扱う。
+type GameVideoAudioCodec int8
+
+const (
+	GameVideoAudioCodecAAC GameVideoAudioCodec = iota
+	GameVideoAudioCodecMP3
+	GameVideoAudioCodecOpus
+	GameVideoAudioCodecVorbis
+	GameVideoAudioCodecFLAC
+)
+
+type GameVideoPosterTmpURL *url.URL
+
+func NewGameVideoPosterTmpURL(tmpURL *url.URL) GameVideoPosterTmpURL {
+	return GameVideoPosterTmpURL(tmpURL)
+}
@@ -0,0 +1,50 @@
+package values
+
+import "github.com/google/uuid"
+
+type (
+	StorageVerificationID uuid.UUID
+	// StorageVerificationStatus
+	// ストレージの検証の実行状況。
+	StorageVerificationStatus int
+	// StorageVerificationObjectKind
+	// 検証したオブジェクトの種類。
+	StorageVerificationObjectKind int
+	// StorageVerificationIssueType
+	// 検証で見つかった問題の種類。
+	StorageVerificationIssueType int
+)
+
+func NewStorageVerificationID() StorageVerificationID {
+	return StorageVerificationID(uuid.New())
+}
+
+func NewStorageVerificationIDFromUUID(id uuid.UUID) StorageVerificationID {
+	return StorageVerificationID(id)
+}
+
+const (
+	// StorageVerificationStatusRunning 実行中
+	StorageVerificationStatusRunning StorageVerificationStatus = iota
+	// StorageVerificationStatusCompleted 全てのオブジェクトを検証した
+	StorageVerificationStatusCompleted
+	// StorageVerificationStatusFailed オブジェクトの一覧の取得の失敗や中断で、検証を終えられなかった
+	StorageVerificationStatusFailed
+)
+
+const (
+	StorageVerificationObjectKindGameFile StorageVerificationObjectKind = iota
+	StorageVerificationObjectKindGameImage
+	StorageVerificationObjectKindGameImageVariant
+	StorageVerificationObjectKindGameVideo
+	StorageVerificationObjectKindGameVideoPoster
+)
+
+const (
+	// StorageVerificationIssueTypeMissing ストレージにオブジェクトが存在しない
+	StorageVerificationIssueTypeMissing StorageVerificationIssueType = iota
+	// StorageVerificationIssueTypeHashMismatch ゲームファイルのハッシュ値がDBの値と一致しない
+	StorageVerificationIssueTypeHashMismatch
+	// StorageVerificationIssueTypeCheckFailed ストレージへのアクセスに失敗し、検証できなかった
+	StorageVerificationIssueTypeCheckFailed
+)
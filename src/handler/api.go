@@ -1,27 +1,39 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 
 	"github.com/labstack/echo-contrib/prometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 
 	// v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/handler/session"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/storage"
 
 	v2 "github.com/traPtitech/trap-collection-server/src/handler/v2"
 )
 
 type API struct {
-	addr    string
-	session *session.Session
-	v2      *v2.API
+	addr string
+	// echo Shutdownで停止できるように、NewAPIで作成しておく
+	echo       *echo.Echo
+	session    *session.Session
+	health     *Health
+	v2         *v2.API
+	fileServer storage.FileServer
 }
 
-func NewAPI(appConf config.App, conf config.Handler, session *session.Session, v2 *v2.API) (*API, error) {
+// NewAPI
+// fileServerはストレージ自身がファイルを配信しない場合はnil
+func NewAPI(appConf config.App, conf config.Handler, session *session.Session, health *Health, v2API *v2.API, fileServer storage.FileServer) (*API, error) {
 	addr, err := conf.Addr()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get addr: %w", err)
@@ -31,29 +43,67 @@ func NewAPI(appConf config.App, conf config.Handler, session *session.Session, v
 		return nil, fmt.Errorf("only v2 is allowed")
 	}
 
+	trustedProxies, err := conf.TrustedProxies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trusted proxies: %w", err)
+	}
+
+	e := echo.New()
+	// 設定しないとX-Forwarded-ForやX-Real-IPをそのまま信頼してしまい、
+	// ヘッダーを変えるだけでIPアドレスごとのレート制限を回避できてしまう
+	e.IPExtractor = v2.IPExtractor(trustedProxies)
+
 	return &API{
-		addr:    addr,
-		session: session,
-		v2:      v2,
+		addr:       addr,
+		echo:       e,
+		session:    session,
+		health:     health,
+		v2:         v2API,
+		fileServer: fileServer,
 	}, nil
 }
 
-func (api *API) Start() error {
-	const metricsPath = "/api/metrics"
+const (
+	metricsPath = "/api/metrics"
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
 
-	e := echo.New()
+// monitoringSkipper
+// メトリクスの収集やprobeは頻繁にリクエストされるので、トレースとリクエストのログから除外する
+func monitoringSkipper(c echo.Context) bool {
+	switch c.Request().URL.Path {
+	case metricsPath, healthzPath, readyzPath:
+		return true
+	}
+
+	return false
+}
+
+// Start
+// HTTPサーバーを起動する。
+// Shutdownで停止した場合はnilを返す。
+func (api *API) Start() error {
+	e := api.echo
 	e.Use(middleware.Recover())
-	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		Skipper: func(c echo.Context) bool {
-			return c.Request().URL.Path == metricsPath
+	// リクエストのログにtrace_idを付与するため、RequestLoggerより前に設定する
+	e.Use(otelecho.Middleware("trap-collection-server", otelecho.WithSkipper(monitoringSkipper)))
+	// 以降のログにリクエストIDを付与するため、リクエストのcontextにロガーを持たせる
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			ctx := logger.With(c.Request().Context(), slog.String("request_id", requestID))
+			c.SetRequest(c.Request().WithContext(ctx))
 		},
+	}))
+	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		Skipper:     monitoringSkipper,
 		LogStatus:   true,
 		LogURI:      true,
 		LogMethod:   true,
 		LogLatency:  true,
 		LogRemoteIP: true,
-		LogValuesFunc: func(_ echo.Context, v middleware.RequestLoggerValues) error {
-			slog.Info("request",
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			logger.Info(c.Request().Context(), "request",
 				slog.String("method", v.Method),
 				slog.String("uri", v.URI),
 				slog.Int("status", v.Status),
@@ -70,10 +120,40 @@ func (api *API) Start() error {
 
 	api.session.Use(e)
 
+	e.GET(healthzPath, api.health.Healthz)
+	e.GET(readyzPath, api.health.Readyz)
+
 	err := api.v2.SetRoutes(e)
 	if err != nil {
 		return fmt.Errorf("failed to set v2 routes: %w", err)
 	}
 
-	return e.Start(api.addr)
+	if api.fileServer != nil {
+		prefix := api.fileServer.PathPrefix()
+		e.Match(
+			[]string{http.MethodGet, http.MethodHead},
+			prefix+"/*",
+			echo.WrapHandler(http.StripPrefix(prefix, api.fileServer)),
+		)
+	}
+
+	err = e.Start(api.addr)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Shutdown
+// 新しいリクエストの受付を止め、処理中のリクエストの完了を待ってからHTTPサーバーを停止する。
+// ctxの期限までに完了しなかったリクエストのコネクションは強制的に閉じる。
+func (api *API) Shutdown(ctx context.Context) error {
+	err := api.echo.Shutdown(ctx)
+	if err != nil {
+		closeErr := api.echo.Close()
+		return errors.Join(fmt.Errorf("failed to shutdown http server: %w", err), closeErr)
+	}
+
+	return nil
 }
@@ -2,54 +2,179 @@ package cron
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"github.com/robfig/cron/v3"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
+// 管理者向けのサーバーの状態に表示するジョブの名前
+const (
+	jobNameDeleteLongLogs            = "DeleteLongLogs"
+	jobNameBackfillGameImageVariants = "BackfillGameImageVariants"
+	jobNameScanGameFiles             = "ScanGameFiles"
+	jobNameBuildEditionBundles       = "BuildEditionBundles"
+	jobNameVerifyStorage             = "VerifyStorage"
+)
+
 // Cron 定期実行ジョブを管理する構造体
 type Cron struct {
 	deletePlayLogService service.GamePlayLogV2
+	gameImageService     service.GameImageV2
+	gameFileService      service.GameFileV2
+	editionBundleService service.EditionBundle
+	storageVerification  service.StorageVerification
+	supervisor           *supervisor
 	scheduler            *cron.Cron
 }
 
-func NewCron(deletePlayLogService service.GamePlayLogV2) *Cron {
+func NewCron(
+	deletePlayLogService service.GamePlayLogV2,
+	gameImageService service.GameImageV2,
+	gameFileService service.GameFileV2,
+	editionBundleService service.EditionBundle,
+	storageVerification service.StorageVerification,
+	statusService service.Status,
+) *Cron {
 	return &Cron{
 		deletePlayLogService: deletePlayLogService,
+		gameImageService:     gameImageService,
+		gameFileService:      gameFileService,
+		editionBundleService: editionBundleService,
+		storageVerification:  storageVerification,
+		supervisor:           newSupervisor(statusService),
 	}
 }
 
 func (c *Cron) Start() error {
 	c.scheduler = cron.New()
 
-	_, err := c.scheduler.AddFunc("@hourly", c.deleteLongLogs)
+	// 全てのジョブは、前回の実行が終わっていなければスキップする
+	jobs := []struct {
+		spec string
+		job  *job
+	}{
+		{
+			spec: "@hourly",
+			job: &job{
+				name:    jobNameDeleteLongLogs,
+				timeout: 5 * time.Minute,
+				run:     c.deleteLongLogs,
+			},
+		},
+		// アップロードされた画像の派生画像を生成する
+		// 生成に失敗した画像や、派生画像の機能追加前の画像もここで再度生成を試みる
+		{
+			spec: "@every 1m",
+			job: &job{
+				name:    jobNameBackfillGameImageVariants,
+				timeout: 30 * time.Minute,
+				run:     c.backfillGameImageVariants,
+			},
+		},
+		// アップロードされたゲームファイルを検査する
+		{
+			spec: "@every 1m",
+			job: &job{
+				name:    jobNameScanGameFiles,
+				timeout: 30 * time.Minute,
+				run:     c.scanGameFiles,
+			},
+		},
+		// 生成待ちのエディションのバンドルを生成する
+		{
+			spec: "@every 1m",
+			job: &job{
+				name:    jobNameBuildEditionBundles,
+				timeout: 2 * time.Hour,
+				run:     c.buildEditionBundles,
+			},
+		},
+		// DBに記録されているファイルがストレージに存在するかを検証する
+		// 全てのゲームファイルを読み込むので、頻度を下げる
+		{
+			spec: "@weekly",
+			job: &job{
+				name:    jobNameVerifyStorage,
+				timeout: 24 * time.Hour,
+				run:     c.verifyStorage,
+			},
+		},
+	}
+
+	for _, j := range jobs {
+		_, err := c.scheduler.AddFunc(j.spec, func() {
+			c.supervisor.runJob(j.job)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add job %s: %w", j.job.name, err)
+		}
+	}
+
+	c.scheduler.Start()
+	return nil
+}
+
+// Stop
+// 新しいジョブの実行を止め、実行中のジョブの終了を待つ。
+// ctxの期限までに終了しなかったジョブはcontextをキャンセルして中断する。
+func (c *Cron) Stop(ctx context.Context) error {
+	if c.scheduler == nil {
+		return nil
+	}
+
+	err := c.supervisor.stop(ctx, c.scheduler.Stop())
+	if err != nil {
+		return fmt.Errorf("failed to wait for running jobs: %w", err)
+	}
+	logger.Info(ctx, "Cron: 停止完了")
+
+	return nil
+}
+
+func (c *Cron) deleteLongLogs(ctx context.Context) error {
+	logger.Info(ctx, "DeleteLongLogs: 開始")
+	err := c.deletePlayLogService.DeleteLongLogs(ctx)
 	if err != nil {
 		return err
 	}
+	logger.Info(ctx, "DeleteLongLogs: 終了")
 
-	c.scheduler.Start()
 	return nil
 }
 
-func (c *Cron) Stop() {
-	if c.scheduler != nil {
-		ctx := c.scheduler.Stop()
-		<-ctx.Done()
-		log.Println("Cron: 停止完了")
+func (c *Cron) backfillGameImageVariants(ctx context.Context) error {
+	logger.Info(ctx, "BackfillGameImageVariants: 開始")
+	err := c.gameImageService.BackfillGameImageVariants(ctx)
+	if err != nil {
+		return err
 	}
+	logger.Info(ctx, "BackfillGameImageVariants: 終了")
+
+	return nil
 }
 
-func (c *Cron) deleteLongLogs() {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	defer cancel()
+// scanGameFiles
+// 1分ごとに実行されるので、開始・終了のログは出さない。
+func (c *Cron) scanGameFiles(ctx context.Context) error {
+	return c.gameFileService.ScanGameFiles(ctx)
+}
 
-	log.Println("DeleteLongLogs: 開始")
-	err := c.deletePlayLogService.DeleteLongLogs(ctx)
+// buildEditionBundles
+// 1分ごとに実行されるので、開始・終了のログは出さない。
+func (c *Cron) buildEditionBundles(ctx context.Context) error {
+	return c.editionBundleService.BuildEditionBundles(ctx)
+}
+
+func (c *Cron) verifyStorage(ctx context.Context) error {
+	logger.Info(ctx, "VerifyStorage: 開始")
+	_, err := c.storageVerification.VerifyStorage(ctx)
 	if err != nil {
-		log.Printf("DeleteLongLogs: エラー: %v\n", err)
-		return
+		return err
 	}
-	log.Printf("DeleteLongLogs: 終了\n")
+	logger.Info(ctx, "VerifyStorage: 終了")
+
+	return nil
 }
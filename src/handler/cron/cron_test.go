@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/service"
 	mockService "github.com/traPtitech/trap-collection-server/src/service/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -27,15 +28,196 @@ func TestDeleteLongLogs(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 			mockPlayLogService := mockService.NewMockGamePlayLogV2(ctrl)
+			mockGameImageService := mockService.NewMockGameImageV2(ctrl)
+			mockGameFileService := mockService.NewMockGameFileV2(ctrl)
+			mockEditionBundleService := mockService.NewMockEditionBundle(ctrl)
+			mockStorageVerificationService := mockService.NewMockStorageVerification(ctrl)
+			mockStatusService := mockService.NewMockStatus(ctrl)
 
 			mockPlayLogService.
 				EXPECT().
 				DeleteLongLogs(gomock.Any()).
 				Return(tc.deleteLongLogsErr)
 
-			cronHandler := NewCron(mockPlayLogService)
+			cronHandler := NewCron(mockPlayLogService, mockGameImageService, mockGameFileService, mockEditionBundleService, mockStorageVerificationService, mockStatusService)
 
-			cronHandler.deleteLongLogs()
+			err := cronHandler.deleteLongLogs(t.Context())
+			if tc.deleteLongLogsErr != nil {
+				assert.ErrorIs(t, err, tc.deleteLongLogsErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBackfillGameImageVariants(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		backfillErr error
+	}{
+		"正常に終了": {
+			backfillErr: nil,
+		},
+		"サービスエラー発生": {
+			backfillErr: assert.AnError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockPlayLogService := mockService.NewMockGamePlayLogV2(ctrl)
+			mockGameImageService := mockService.NewMockGameImageV2(ctrl)
+			mockGameFileService := mockService.NewMockGameFileV2(ctrl)
+			mockEditionBundleService := mockService.NewMockEditionBundle(ctrl)
+			mockStorageVerificationService := mockService.NewMockStorageVerification(ctrl)
+			mockStatusService := mockService.NewMockStatus(ctrl)
+
+			mockGameImageService.
+				EXPECT().
+				BackfillGameImageVariants(gomock.Any()).
+				Return(tc.backfillErr)
+
+			cronHandler := NewCron(mockPlayLogService, mockGameImageService, mockGameFileService, mockEditionBundleService, mockStorageVerificationService, mockStatusService)
+
+			err := cronHandler.backfillGameImageVariants(t.Context())
+			if tc.backfillErr != nil {
+				assert.ErrorIs(t, err, tc.backfillErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScanGameFiles(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		scanErr error
+	}{
+		"正常に終了": {
+			scanErr: nil,
+		},
+		"サービスエラー発生": {
+			scanErr: assert.AnError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockPlayLogService := mockService.NewMockGamePlayLogV2(ctrl)
+			mockGameImageService := mockService.NewMockGameImageV2(ctrl)
+			mockGameFileService := mockService.NewMockGameFileV2(ctrl)
+			mockEditionBundleService := mockService.NewMockEditionBundle(ctrl)
+			mockStorageVerificationService := mockService.NewMockStorageVerification(ctrl)
+			mockStatusService := mockService.NewMockStatus(ctrl)
+
+			mockGameFileService.
+				EXPECT().
+				ScanGameFiles(gomock.Any()).
+				Return(tc.scanErr)
+
+			cronHandler := NewCron(mockPlayLogService, mockGameImageService, mockGameFileService, mockEditionBundleService, mockStorageVerificationService, mockStatusService)
+
+			err := cronHandler.scanGameFiles(t.Context())
+			if tc.scanErr != nil {
+				assert.ErrorIs(t, err, tc.scanErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		buildErr error
+	}{
+		"正常に終了": {
+			buildErr: nil,
+		},
+		"サービスエラー発生": {
+			buildErr: assert.AnError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockPlayLogService := mockService.NewMockGamePlayLogV2(ctrl)
+			mockGameImageService := mockService.NewMockGameImageV2(ctrl)
+			mockGameFileService := mockService.NewMockGameFileV2(ctrl)
+			mockEditionBundleService := mockService.NewMockEditionBundle(ctrl)
+			mockStorageVerificationService := mockService.NewMockStorageVerification(ctrl)
+			mockStatusService := mockService.NewMockStatus(ctrl)
+
+			mockEditionBundleService.
+				EXPECT().
+				BuildEditionBundles(gomock.Any()).
+				Return(tc.buildErr)
+
+			cronHandler := NewCron(mockPlayLogService, mockGameImageService, mockGameFileService, mockEditionBundleService, mockStorageVerificationService, mockStatusService)
+
+			err := cronHandler.buildEditionBundles(t.Context())
+			if tc.buildErr != nil {
+				assert.ErrorIs(t, err, tc.buildErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyStorage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		verifyErr error
+	}{
+		"正常に終了": {
+			verifyErr: nil,
+		},
+		"問題が見つかった": {
+			verifyErr: service.ErrStorageVerificationIssuesFound,
+		},
+		"サービスエラー発生": {
+			verifyErr: assert.AnError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockPlayLogService := mockService.NewMockGamePlayLogV2(ctrl)
+			mockGameImageService := mockService.NewMockGameImageV2(ctrl)
+			mockGameFileService := mockService.NewMockGameFileV2(ctrl)
+			mockEditionBundleService := mockService.NewMockEditionBundle(ctrl)
+			mockStorageVerificationService := mockService.NewMockStorageVerification(ctrl)
+			mockStatusService := mockService.NewMockStatus(ctrl)
+
+			mockStorageVerificationService.
+				EXPECT().
+				VerifyStorage(gomock.Any()).
+				Return(nil, tc.verifyErr)
+
+			cronHandler := NewCron(mockPlayLogService, mockGameImageService, mockGameFileService, mockEditionBundleService, mockStorageVerificationService, mockStatusService)
+
+			err := cronHandler.verifyStorage(t.Context())
+			if tc.verifyErr != nil {
+				assert.ErrorIs(t, err, tc.verifyErr)
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
@@ -0,0 +1,139 @@
+package cron
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+var (
+	jobLastRunTimestamp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Unix time when the job last finished",
+	}, []string{"job"})
+	jobLastDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "last_duration_seconds",
+		Help:      "Duration of the last run of the job",
+	}, []string{"job"})
+	// jobLastSuccess 最後の実行が成功した場合は1、失敗した場合は0
+	jobLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "last_success",
+		Help:      "Whether the last run of the job succeeded",
+	}, []string{"job"})
+	// jobRunCount resultはsuccess、failureのいずれか
+	jobRunCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "run_count",
+		Help:      "The number of runs of the job",
+	}, []string{"job", "result"})
+	jobSkippedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "skipped_count",
+		Help:      "The number of runs skipped because the previous run was still running",
+	}, []string{"job"})
+	jobRunning = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cron_trap_collection",
+		Subsystem: "job",
+		Name:      "running",
+		Help:      "Whether the job is running",
+	}, []string{"job"})
+)
+
+// job 定期実行ジョブ
+type job struct {
+	name string
+	// timeout 1回の実行にかけられる最大の時間
+	timeout time.Duration
+	run     func(ctx context.Context) error
+	running atomic.Bool
+}
+
+// supervisor
+// 定期実行ジョブの実行を管理する。
+// 同じジョブの実行が重ならないようにし、実行結果をサーバーの状態とメトリクスに記録する。
+type supervisor struct {
+	statusService service.Status
+	// ctx 停止時に実行中のジョブを中断するためのcontext
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newSupervisor(statusService service.Status) *supervisor {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &supervisor{
+		statusService: statusService,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+// runJob
+// ジョブを1回実行する。
+// 前回の実行が終わっていない場合はスキップする。
+func (s *supervisor) runJob(j *job) {
+	ctx := logger.With(s.ctx, slog.String("job", j.name))
+
+	if !j.running.CompareAndSwap(false, true) {
+		jobSkippedCount.WithLabelValues(j.name).Inc()
+		logger.Debug(ctx, "skip job because the previous run is still running")
+		return
+	}
+	defer j.running.Store(false)
+
+	jobRunning.WithLabelValues(j.name).Set(1)
+	defer jobRunning.WithLabelValues(j.name).Set(0)
+
+	ctx, cancel := context.WithTimeout(ctx, j.timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	err := j.run(ctx)
+	finishedAt := time.Now()
+
+	s.statusService.RecordJobRun(j.name, startedAt, finishedAt, err)
+
+	jobLastRunTimestamp.WithLabelValues(j.name).Set(float64(finishedAt.Unix()))
+	jobLastDuration.WithLabelValues(j.name).Set(finishedAt.Sub(startedAt).Seconds())
+	if err != nil {
+		jobLastSuccess.WithLabelValues(j.name).Set(0)
+		jobRunCount.WithLabelValues(j.name, "failure").Inc()
+		logger.Error(ctx, "job failed", slog.Any("error", err), slog.Duration("duration", finishedAt.Sub(startedAt)))
+		return
+	}
+	jobLastSuccess.WithLabelValues(j.name).Set(1)
+	jobRunCount.WithLabelValues(j.name, "success").Inc()
+}
+
+// stop
+// 実行中のジョブの終了を待つ。
+// jobsDoneはスケジューラーの停止時に返される、実行中のジョブが全て終了すると閉じられるcontext。
+// ctxの期限までに終了しなかった場合は、実行中のジョブのcontextをキャンセルしてから終了を待つ。
+func (s *supervisor) stop(ctx context.Context, jobsDone context.Context) error {
+	select {
+	case <-jobsDone.Done():
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+	}
+
+	logger.Warn(ctx, "cancel running jobs because they did not finish before the shutdown timeout")
+	s.cancel()
+	<-jobsDone.Done()
+
+	return ctx.Err()
+}
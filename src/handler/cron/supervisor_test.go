@@ -0,0 +1,121 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mockService "github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunJob(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		running   bool
+		runErr    error
+		executeFn bool
+	}{
+		"正常に終了": {
+			executeFn: true,
+		},
+		"ジョブがエラーでも記録される": {
+			runErr:    assert.AnError,
+			executeFn: true,
+		},
+		"前回の実行が終わっていないのでスキップ": {
+			running: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockStatusService := mockService.NewMockStatus(ctrl)
+
+			s := newSupervisor(mockStatusService)
+
+			executed := false
+			j := &job{
+				name:    "test",
+				timeout: time.Minute,
+				run: func(ctx context.Context) error {
+					executed = true
+
+					_, ok := ctx.Deadline()
+					assert.True(t, ok)
+
+					return tc.runErr
+				},
+			}
+			j.running.Store(tc.running)
+
+			if tc.executeFn {
+				mockStatusService.
+					EXPECT().
+					RecordJobRun("test", gomock.Any(), gomock.Any(), tc.runErr)
+			}
+
+			s.runJob(j)
+
+			assert.Equal(t, tc.executeFn, executed)
+			// スキップした場合は実行中の状態を変えない
+			assert.Equal(t, tc.running, j.running.Load())
+		})
+	}
+}
+
+func TestStopSupervisor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ジョブが終了済みなのでキャンセルされない", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStatusService := mockService.NewMockStatus(ctrl)
+
+		s := newSupervisor(mockStatusService)
+
+		jobsDone, done := context.WithCancel(context.Background())
+		done()
+
+		err := s.stop(t.Context(), jobsDone)
+		assert.NoError(t, err)
+	})
+
+	t.Run("期限までに終了しないジョブはキャンセルされる", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStatusService := mockService.NewMockStatus(ctrl)
+
+		s := newSupervisor(mockStatusService)
+
+		mockStatusService.
+			EXPECT().
+			RecordJobRun("test", gomock.Any(), gomock.Any(), context.Canceled)
+
+		jobsDone, done := context.WithCancel(context.Background())
+		started := make(chan struct{})
+		go func() {
+			defer done()
+			s.runJob(&job{
+				name:    "test",
+				timeout: time.Hour,
+				run: func(ctx context.Context) error {
+					close(started)
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			})
+		}()
+		<-started
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+
+		err := s.stop(ctx, jobsDone)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+const (
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+
+	// readinessTimeout
+	// probeのタイムアウトより先に結果を返せるよう、短めにする
+	readinessTimeout = 5 * time.Second
+)
+
+// Health
+// Kubernetesなどからのliveness・readinessの確認に応答する。
+type Health struct {
+	statusService service.Status
+}
+
+func NewHealth(statusService service.Status) *Health {
+	return &Health{
+		statusService: statusService,
+	}
+}
+
+type healthResponse struct {
+	Status string `json:"status"`
+	// Checks 依存するサービスごとの状態
+	Checks map[service.DependencyName]string `json:"checks,omitempty"`
+}
+
+// Healthz
+// liveness probe。
+// 依存するサービスの障害でPodが再起動され続けないよう、依存するサービスは確認せず、応答できれば正常とする。
+// (GET /healthz)
+func (h *Health) Healthz(c echo.Context) error {
+	return c.JSON(http.StatusOK, healthResponse{
+		Status: healthStatusOK,
+	})
+}
+
+// Readyz
+// readiness probe。
+// 依存するサービスのいずれかを利用できない場合は503を返す。
+// 認証なしで公開されるので、エラーの詳細はレスポンスに含めずログに出力する。
+// (GET /readyz)
+func (h *Health) Readyz(c echo.Context) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), readinessTimeout)
+	defer cancel()
+
+	dependencies := h.statusService.CheckReadiness(ctx)
+
+	res := healthResponse{
+		Status: healthStatusOK,
+		Checks: make(map[service.DependencyName]string, len(dependencies)),
+	}
+	for _, dependency := range dependencies {
+		if dependency.Err != nil {
+			logger.Warn(ctx, "dependency is unavailable", slog.String("dependency", string(dependency.Name)), slog.Any("error", dependency.Err))
+			res.Status = healthStatusUnavailable
+			res.Checks[dependency.Name] = healthStatusUnavailable
+			continue
+		}
+
+		res.Checks[dependency.Name] = healthStatusOK
+	}
+
+	if res.Status != healthStatusOK {
+		return c.JSON(http.StatusServiceUnavailable, res)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
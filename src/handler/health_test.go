@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHealthz(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockStatusService := mock.NewMockStatus(ctrl)
+
+	health := NewHealth(mockStatusService)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, healthzPath, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := health.Healthz(c)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestReadyz(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependencies []*service.DependencyStatus
+		statusCode   int
+		expect       healthResponse
+	}{
+		"全て利用できるので200": {
+			dependencies: []*service.DependencyStatus{
+				{Name: service.DependencyDatabase},
+				{Name: service.DependencyStorage},
+			},
+			statusCode: http.StatusOK,
+			expect: healthResponse{
+				Status: healthStatusOK,
+				Checks: map[service.DependencyName]string{
+					service.DependencyDatabase: healthStatusOK,
+					service.DependencyStorage:  healthStatusOK,
+				},
+			},
+		},
+		"利用できないサービスがあるので503": {
+			dependencies: []*service.DependencyStatus{
+				{Name: service.DependencyDatabase},
+				{Name: service.DependencyStorage, Err: errors.New("error")},
+			},
+			statusCode: http.StatusServiceUnavailable,
+			expect: healthResponse{
+				Status: healthStatusUnavailable,
+				Checks: map[service.DependencyName]string{
+					service.DependencyDatabase: healthStatusOK,
+					service.DependencyStorage:  healthStatusUnavailable,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockStatusService := mock.NewMockStatus(ctrl)
+
+			health := NewHealth(mockStatusService)
+
+			mockStatusService.
+				EXPECT().
+				CheckReadiness(gomock.Any()).
+				Return(testCase.dependencies)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, readyzPath, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := health.Readyz(c)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res healthResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, testCase.expect, res)
+		})
+	}
+}
@@ -2,13 +2,14 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -39,7 +40,7 @@ func (a *Admin) GetAdmins(ctx echo.Context) error {
 
 	adminInfos, err := a.adminService.GetAdmins(ctx.Request().Context(), authSession)
 	if err != nil {
-		log.Printf("error: failed to get admins info: %v", err)
+		logger.Error(ctx.Request().Context(), "failed to get admins info", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get admins info")
 	}
 
@@ -81,7 +82,7 @@ func (a *Admin) PostAdmin(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "already admin")
 	}
 	if err != nil {
-		log.Printf("error: failed to add admin: %v", err)
+		logger.Error(ctx.Request().Context(), "failed to add admin", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to add admin")
 	}
 
@@ -119,7 +120,7 @@ func (a *Admin) DeleteAdmin(ctx echo.Context, userID openapi.UserIDInPath) error
 		return echo.NewHTTPError(http.StatusBadRequest, "cannot delete me from admin")
 	}
 	if err != nil {
-		log.Printf("error: failed to delete admin: %v", err)
+		logger.Error(ctx.Request().Context(), "failed to delete admin", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete admin")
 	}
 
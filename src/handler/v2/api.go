@@ -27,12 +27,18 @@ type API struct {
 	*GameFile
 	*GameImage
 	*GameVideo
+	*GameStorage
 	*GamePlayLog
 	*GameCreator
 	*GameFeedback
 	*Edition
 	*EditionAuth
+	*EditionBundle
 	*Seat
+	*AuditLog
+	*Status
+	*StorageVerification
+	*RateLimit
 }
 
 func NewAPI(
@@ -48,32 +54,44 @@ func NewAPI(
 	gameFile *GameFile,
 	gameImage *GameImage,
 	gameVideo *GameVideo,
+	gameStorage *GameStorage,
 	gamePlayLog *GamePlayLog,
 	gameCreator *GameCreator,
 	gameFeedback *GameFeedback,
 	edition *Edition,
 	editionAuth *EditionAuth,
+	editionBundle *EditionBundle,
 	seat *Seat,
+	auditLog *AuditLog,
+	status *Status,
+	storageVerification *StorageVerification,
+	rateLimit *RateLimit,
 ) *API {
 	return &API{
-		Checker:      checker,
-		Session:      session,
-		OAuth2:       oAuth2,
-		User:         user,
-		Admin:        admin,
-		Game:         game,
-		GameRole:     gameRole,
-		GameGenre:    gameGenre,
-		GameVersion:  gameVersion,
-		GameFile:     gameFile,
-		GameImage:    gameImage,
-		GameVideo:    gameVideo,
-		GamePlayLog:  gamePlayLog,
-		GameCreator:  gameCreator,
-		GameFeedback: gameFeedback,
-		Edition:      edition,
-		EditionAuth:  editionAuth,
-		Seat:         seat,
+		Checker:             checker,
+		Session:             session,
+		OAuth2:              oAuth2,
+		User:                user,
+		Admin:               admin,
+		Game:                game,
+		GameRole:            gameRole,
+		GameGenre:           gameGenre,
+		GameVersion:         gameVersion,
+		GameFile:            gameFile,
+		GameImage:           gameImage,
+		GameVideo:           gameVideo,
+		GameStorage:         gameStorage,
+		GamePlayLog:         gamePlayLog,
+		GameCreator:         gameCreator,
+		GameFeedback:        gameFeedback,
+		Edition:             edition,
+		EditionAuth:         editionAuth,
+		EditionBundle:       editionBundle,
+		Seat:                seat,
+		AuditLog:            auditLog,
+		Status:              status,
+		StorageVerification: storageVerification,
+		RateLimit:           rateLimit,
 	}
 }
 
@@ -109,6 +127,8 @@ func (api *API) setRoutes(e *echo.Echo) error {
 	// 他のrouteにはoapiMiddleware.OapiRequestValidatorを設定したくないため、
 	// 空のpathのgroupを作成し、oapiMiddleware.OapiRequestValidatorを設定する
 	apiGroup := e.Group("")
+	// 認証などの重い処理の前に制限する
+	apiGroup.Use(api.rateLimitMiddleware)
 	apiGroup.Use(echomiddleware.OapiRequestValidatorWithOptions(spec, &echomiddleware.Options{
 		Skipper: fileUploadSkipper,
 		Options: openapi3filter.Options{
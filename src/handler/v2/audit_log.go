@@ -0,0 +1,198 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+type AuditLog struct {
+	auditLogService service.AuditLog
+}
+
+func NewAuditLog(auditLogService service.AuditLog) *AuditLog {
+	return &AuditLog{
+		auditLogService: auditLogService,
+	}
+}
+
+// 監査ログの取得
+// (GET /audit-logs)
+func (a *AuditLog) GetAuditLogs(c echo.Context, params openapi.GetAuditLogsParams) error {
+	limit, offset := 0, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	var serviceParams service.GetAuditLogsParams
+	if params.Actor != nil {
+		serviceParams.ActorID = option.NewOption(values.NewTrapMemberID(*params.Actor))
+	}
+	if params.Action != nil {
+		action, ok := auditLogActionFromOpenAPI(*params.Action)
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid action")
+		}
+		serviceParams.Action = option.NewOption(action)
+	}
+	if params.TargetType != nil {
+		targetType, ok := auditLogTargetTypeFromOpenAPI(*params.TargetType)
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid target type")
+		}
+		serviceParams.TargetType = option.NewOption(targetType)
+	}
+	if params.TargetID != nil {
+		serviceParams.TargetID = option.NewOption(*params.TargetID)
+	}
+	if params.Since != nil {
+		serviceParams.Since = option.NewOption(*params.Since)
+	}
+	if params.Until != nil {
+		serviceParams.Until = option.NewOption(*params.Until)
+	}
+
+	num, auditLogs, err := a.auditLogService.GetAuditLogs(c.Request().Context(), limit, offset, &serviceParams)
+	if errors.Is(err, service.ErrInvalidLimit) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+	}
+	if errors.Is(err, service.ErrOffsetWithoutLimit) {
+		return echo.NewHTTPError(http.StatusBadRequest, "offset without limit")
+	}
+	if errors.Is(err, service.ErrInvalidAuditLogPeriod) {
+		return echo.NewHTTPError(http.StatusBadRequest, "since must be before until")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get audit logs", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get audit logs")
+	}
+
+	resLogs := make([]openapi.AuditLog, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		action, ok := auditLogActionToOpenAPI(auditLog.GetAction())
+		if !ok {
+			logger.Error(c.Request().Context(), "invalid audit log action", slog.Any("audit_log_action", auditLog.GetAction()))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get audit logs")
+		}
+
+		targetType, ok := auditLogTargetTypeToOpenAPI(auditLog.GetTargetType())
+		if !ok {
+			logger.Error(c.Request().Context(), "invalid audit log target type", slog.Any("audit_log_target_type", auditLog.GetTargetType()))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get audit logs")
+		}
+
+		before, err := auditLogStateToOpenAPI(auditLog.GetBefore())
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to unmarshal audit log before state", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get audit logs")
+		}
+
+		after, err := auditLogStateToOpenAPI(auditLog.GetAfter())
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to unmarshal audit log after state", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get audit logs")
+		}
+
+		resLogs = append(resLogs, openapi.AuditLog{
+			Id: uuid.UUID(auditLog.GetID()),
+			Actor: openapi.User{
+				Id:   uuid.UUID(auditLog.GetActorID()),
+				Name: string(auditLog.GetActorName()),
+			},
+			Action:     action,
+			TargetType: targetType,
+			TargetID:   auditLog.GetTargetID(),
+			Before:     before,
+			After:      after,
+			CreatedAt:  auditLog.GetCreatedAt(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, openapi.GetAuditLogsResponse{
+		Num:  num,
+		Logs: resLogs,
+	})
+}
+
+func auditLogStateToOpenAPI(state json.RawMessage) (*openapi.AuditLogState, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	var res openapi.AuditLogState
+	err := json.Unmarshal(state, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+var auditLogActionMap = map[values.AuditLogAction]openapi.AuditLogAction{
+	values.AuditLogActionAddAdmin:                  openapi.AddAdmin,
+	values.AuditLogActionDeleteAdmin:               openapi.DeleteAdmin,
+	values.AuditLogActionEditGameManagementRole:    openapi.EditGameManagementRole,
+	values.AuditLogActionRemoveGameManagementRole:  openapi.RemoveGameManagementRole,
+	values.AuditLogActionUpdateGame:                openapi.UpdateGame,
+	values.AuditLogActionUpdateEdition:             openapi.UpdateEdition,
+	values.AuditLogActionUpdateEditionGameVersions: openapi.UpdateEditionGameVersions,
+	values.AuditLogActionDeleteEdition:             openapi.DeleteEdition,
+	values.AuditLogActionActivateProductKey:        openapi.ActivateProductKey,
+	values.AuditLogActionRevokeProductKey:          openapi.RevokeProductKey,
+	values.AuditLogActionTransferGameOwnership:     openapi.TransferGameOwnership,
+	values.AuditLogActionMergeGameGenres:           openapi.MergeGameGenres,
+	values.AuditLogActionUpdateGameGenreParent:     openapi.UpdateGameGenreParent,
+	values.AuditLogActionAddGameGenreAlias:         openapi.AddGameGenreAlias,
+	values.AuditLogActionDeleteGameGenreAlias:      openapi.DeleteGameGenreAlias,
+	values.AuditLogActionUpdateGameStorageQuota:    openapi.UpdateGameStorageQuota,
+	values.AuditLogActionDeleteGameStorageQuota:    openapi.DeleteGameStorageQuota,
+	values.AuditLogActionUpdateGameFileScanStatus:  openapi.UpdateGameFileScanStatus,
+}
+
+func auditLogActionToOpenAPI(action values.AuditLogAction) (openapi.AuditLogAction, bool) {
+	res, ok := auditLogActionMap[action]
+	return res, ok
+}
+
+func auditLogActionFromOpenAPI(action openapi.AuditLogAction) (values.AuditLogAction, bool) {
+	for k, v := range auditLogActionMap {
+		if v == action {
+			return k, true
+		}
+	}
+	return 0, false
+}
+
+var auditLogTargetTypeMap = map[values.AuditLogTargetType]openapi.AuditLogTargetType{
+	values.AuditLogTargetTypeUser:       openapi.AuditLogTargetTypeUser,
+	values.AuditLogTargetTypeGame:       openapi.AuditLogTargetTypeGame,
+	values.AuditLogTargetTypeEdition:    openapi.AuditLogTargetTypeEdition,
+	values.AuditLogTargetTypeProductKey: openapi.AuditLogTargetTypeProductKey,
+	values.AuditLogTargetTypeGameGenre:  openapi.AuditLogTargetTypeGameGenre,
+}
+
+func auditLogTargetTypeToOpenAPI(targetType values.AuditLogTargetType) (openapi.AuditLogTargetType, bool) {
+	res, ok := auditLogTargetTypeMap[targetType]
+	return res, ok
+}
+
+func auditLogTargetTypeFromOpenAPI(targetType openapi.AuditLogTargetType) (values.AuditLogTargetType, bool) {
+	for k, v := range auditLogTargetTypeMap {
+		if v == targetType {
+			return k, true
+		}
+	}
+	return 0, false
+}
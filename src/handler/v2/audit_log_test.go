@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	actorID := values.NewTrapMemberID(uuid.New())
+	targetID := uuid.New()
+	now := time.Now()
+
+	auditLog1 := domain.NewAuditLog(
+		values.NewAuditLogID(),
+		actorID,
+		values.NewTrapMemberName("mazrean"),
+		values.AuditLogActionUpdateGame,
+		values.AuditLogTargetTypeGame,
+		targetID,
+		json.RawMessage(`{"name":"before"}`),
+		json.RawMessage(`{"name":"after"}`),
+		now,
+	)
+	auditLog2 := domain.NewAuditLog(
+		values.NewAuditLogID(),
+		actorID,
+		values.NewTrapMemberName("mazrean"),
+		values.AuditLogActionAddAdmin,
+		values.AuditLogTargetTypeUser,
+		targetID,
+		nil,
+		json.RawMessage(`{"id":"x"}`),
+		now.Add(-time.Hour),
+	)
+	transferAuditLog := domain.NewAuditLog(
+		values.NewAuditLogID(),
+		actorID,
+		values.NewTrapMemberName("mazrean"),
+		values.AuditLogActionTransferGameOwnership,
+		values.AuditLogTargetTypeGame,
+		targetID,
+		json.RawMessage(`{"roles":[{"userId":"`+uuid.UUID(actorID).String()+`","role":"owner"}]}`),
+		json.RawMessage(`{"roles":[{"userId":"`+uuid.UUID(actorID).String()+`","role":"maintainer"},{"userId":"`+uuid.NewString()+`","role":"owner"}]}`),
+		now.Add(-2*time.Hour),
+	)
+	invalidAuditLog := domain.NewAuditLog(
+		values.NewAuditLogID(),
+		actorID,
+		values.NewTrapMemberName("mazrean"),
+		values.AuditLogAction(100),
+		values.AuditLogTargetTypeUser,
+		targetID,
+		nil,
+		nil,
+		now,
+	)
+
+	limit := 10
+	offset := 10
+	action := openapi.UpdateGame
+	invalidAction := openapi.AuditLogAction("invalid")
+	targetType := openapi.AuditLogTargetTypeGame
+	since := now.Add(-time.Hour)
+
+	testCases := map[string]struct {
+		params              openapi.GetAuditLogsParams
+		executeGetAuditLogs bool
+		limit               int
+		offset              int
+		serviceParams       *service.GetAuditLogsParams
+		num                 int
+		auditLogs           []*domain.AuditLog
+		GetAuditLogsErr     error
+		expectNum           int
+		expectActions       []openapi.AuditLogAction
+		isErr               bool
+		statusCode          int
+	}{
+		"特に問題ないのでエラーなし": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			num:                 2,
+			auditLogs:           []*domain.AuditLog{auditLog1, auditLog2},
+			expectNum:           2,
+			expectActions:       []openapi.AuditLogAction{openapi.UpdateGame, openapi.AddAdmin},
+		},
+		"ゲームの所有権の譲渡の監査ログも取得できる": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			num:                 3,
+			auditLogs:           []*domain.AuditLog{auditLog1, auditLog2, transferAuditLog},
+			expectNum:           3,
+			expectActions:       []openapi.AuditLogAction{openapi.UpdateGame, openapi.AddAdmin, openapi.TransferGameOwnership},
+		},
+		"絞り込み条件があってもエラーなし": {
+			params: openapi.GetAuditLogsParams{
+				Limit:      &limit,
+				Offset:     &offset,
+				Actor:      (*openapi.UserID)(&actorID),
+				Action:     &action,
+				TargetType: &targetType,
+				TargetID:   &targetID,
+				Since:      &since,
+			},
+			executeGetAuditLogs: true,
+			limit:               limit,
+			offset:              offset,
+			serviceParams: &service.GetAuditLogsParams{
+				ActorID:    option.NewOption(actorID),
+				Action:     option.NewOption(values.AuditLogActionUpdateGame),
+				TargetType: option.NewOption(values.AuditLogTargetTypeGame),
+				TargetID:   option.NewOption(targetID),
+				Since:      option.NewOption(since),
+			},
+			num:           11,
+			auditLogs:     []*domain.AuditLog{auditLog1},
+			expectNum:     11,
+			expectActions: []openapi.AuditLogAction{openapi.UpdateGame},
+		},
+		"actionが不正なので400": {
+			params: openapi.GetAuditLogsParams{
+				Action: &invalidAction,
+			},
+			isErr:      true,
+			statusCode: http.StatusBadRequest,
+		},
+		"ErrInvalidAuditLogPeriodなので400": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			GetAuditLogsErr:     service.ErrInvalidAuditLogPeriod,
+			isErr:               true,
+			statusCode:          http.StatusBadRequest,
+		},
+		"ErrOffsetWithoutLimitなので400": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			GetAuditLogsErr:     service.ErrOffsetWithoutLimit,
+			isErr:               true,
+			statusCode:          http.StatusBadRequest,
+		},
+		"GetAuditLogsがエラーなので500": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			GetAuditLogsErr:     errors.New("error"),
+			isErr:               true,
+			statusCode:          http.StatusInternalServerError,
+		},
+		"不正なactionの監査ログがあるので500": {
+			executeGetAuditLogs: true,
+			serviceParams:       &service.GetAuditLogsParams{},
+			num:                 1,
+			auditLogs:           []*domain.AuditLog{invalidAuditLog},
+			isErr:               true,
+			statusCode:          http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuditLogService := mock.NewMockAuditLog(ctrl)
+			auditLog := NewAuditLog(mockAuditLogService)
+
+			if testCase.executeGetAuditLogs {
+				mockAuditLogService.
+					EXPECT().
+					GetAuditLogs(gomock.Any(), testCase.limit, testCase.offset, testCase.serviceParams).
+					Return(testCase.num, testCase.auditLogs, testCase.GetAuditLogsErr)
+			}
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, "/api/v2/audit-logs", nil)
+
+			err := auditLog.GetAuditLogs(c, testCase.params)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var res openapi.GetAuditLogsResponse
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectNum, res.Num)
+			require.Len(t, res.Logs, len(testCase.auditLogs))
+			for i, resLog := range res.Logs {
+				expected := testCase.auditLogs[i]
+				assert.Equal(t, uuid.UUID(expected.GetID()), resLog.Id)
+				assert.Equal(t, uuid.UUID(expected.GetActorID()), resLog.Actor.Id)
+				assert.Equal(t, string(expected.GetActorName()), resLog.Actor.Name)
+				assert.Equal(t, testCase.expectActions[i], resLog.Action)
+				assert.Equal(t, expected.GetTargetID(), resLog.TargetID)
+				assert.WithinDuration(t, expected.GetCreatedAt(), resLog.CreatedAt, time.Second)
+
+				if expected.GetBefore() == nil {
+					assert.Nil(t, resLog.Before)
+				} else {
+					assert.NotNil(t, resLog.Before)
+				}
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"slices"
 	"strings"
@@ -15,6 +15,7 @@ import (
 	echomiddleware "github.com/oapi-codegen/echo-middleware"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -73,7 +74,7 @@ func (checker *Checker) check(ctx context.Context, input *openapi3filter.Authent
 
 	checkerFunc, ok := checkerMap[input.SecuritySchemeName]
 	if !ok {
-		log.Printf("error: unknown security scheme: %s\n", input.SecuritySchemeName)
+		logger.Error(ctx, "unknown security scheme", slog.String("security_scheme", input.SecuritySchemeName))
 		return fmt.Errorf("unknown security scheme: %s", input.SecuritySchemeName)
 	}
 
@@ -98,13 +99,13 @@ func (checker *Checker) TrapMemberAuthChecker(ctx context.Context, _ *openapi3fi
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	ok, message, err := checker.checkTrapMemberAuth(c)
 	if err != nil {
-		log.Printf("error: failed to check launcher auth: %v\n", err)
+		logger.Error(ctx, "failed to check launcher auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -124,13 +125,13 @@ var (
 func (checker *Checker) GameInfoVisibilityChecker(ctx context.Context, ai *openapi3filter.AuthenticationInput) error {
 	c := echomiddleware.GetEchoContext(ctx)
 	if c == nil {
-		log.Println("error: failed to get echo context")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	ok, _, err := checker.checkTrapMemberAuth(c)
 	if err != nil {
-		log.Printf("error: failed to check member auth: %v\n", err)
+		logger.Error(ctx, "failed to check member auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -149,7 +150,7 @@ func (checker *Checker) GameInfoVisibilityChecker(ctx context.Context, ai *opena
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game visibility: %v\n", err)
+		logger.Error(ctx, "failed to get game visibility", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game visibility")
 	}
 
@@ -163,13 +164,13 @@ func (checker *Checker) GameInfoVisibilityChecker(ctx context.Context, ai *opena
 func (checker *Checker) GameFileVisibilityChecker(ctx context.Context, ai *openapi3filter.AuthenticationInput) error {
 	c := echomiddleware.GetEchoContext(ctx)
 	if c == nil {
-		log.Println("error: failed to get echo context")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	ok, _, err := checker.checkTrapMemberAuth(c)
 	if err != nil {
-		log.Printf("error: failed to check member auth: %v\n", err)
+		logger.Error(ctx, "failed to check member auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -188,7 +189,7 @@ func (checker *Checker) GameFileVisibilityChecker(ctx context.Context, ai *opena
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game visibility: %v\n", err)
+		logger.Error(ctx, "failed to get game visibility", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game visibility")
 	}
 
@@ -236,6 +237,8 @@ func (checker *Checker) checkTrapMemberAuth(c echo.Context) (bool, string, error
 		return false, "", fmt.Errorf("failed to check traP auth: %w", err)
 	}
 
+	checker.setTrapMemberLogAttrs(c, authSession)
+
 	return true, "", nil
 }
 
@@ -246,13 +249,13 @@ func (checker *Checker) AdminAuthChecker(ctx context.Context, _ *openapi3filter.
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	session, err := checker.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(ctx, "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -269,10 +272,12 @@ func (checker *Checker) AdminAuthChecker(ctx context.Context, _ *openapi3filter.
 		return echo.NewHTTPError(http.StatusUnauthorized, "not admin")
 	}
 	if err != nil {
-		log.Printf("error: failed to authorize admin: %v\n", err)
+		logger.Error(ctx, "failed to authorize admin", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authorize admin")
 	}
 
+	checker.setTrapMemberLogAttrs(c, authSession)
+
 	return nil
 }
 
@@ -283,13 +288,13 @@ func (checker *Checker) GameOwnerAuthChecker(ctx context.Context, _ *openapi3fil
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	session, err := checker.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(ctx, "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -298,7 +303,7 @@ func (checker *Checker) GameOwnerAuthChecker(ctx context.Context, _ *openapi3fil
 		return echo.NewHTTPError(http.StatusUnauthorized, "no access token")
 	}
 	if err != nil {
-		log.Printf("error: failed to get auth session: %v\n", err)
+		logger.Error(ctx, "failed to get auth session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -308,10 +313,11 @@ func (checker *Checker) GameOwnerAuthChecker(ctx context.Context, _ *openapi3fil
 		return echo.NewHTTPError(http.StatusUnauthorized, "session is expired")
 	}
 	if err != nil && !errors.Is(err, service.ErrForbidden) {
-		log.Printf("error: failed to check launcher admin auth: %v\n", err)
+		logger.Error(ctx, "failed to check launcher admin auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check launcher admin auth")
 	}
 	if err == nil {
+		checker.setTrapMemberLogAttrs(c, authSession)
 		return nil
 	}
 
@@ -330,9 +336,12 @@ func (checker *Checker) GameOwnerAuthChecker(ctx context.Context, _ *openapi3fil
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to authorize game owner: %v\n", err)
+		logger.Error(ctx, "failed to authorize game owner", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authorize game owner")
 	}
+
+	checker.setTrapMemberLogAttrs(c, authSession)
+
 	return nil
 }
 
@@ -345,13 +354,13 @@ func (checker *Checker) GameMaintainerAuthChecker(ctx context.Context, _ *openap
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	session, err := checker.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(ctx, "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -360,7 +369,7 @@ func (checker *Checker) GameMaintainerAuthChecker(ctx context.Context, _ *openap
 		return echo.NewHTTPError(http.StatusUnauthorized, "no access token")
 	}
 	if err != nil {
-		log.Printf("error: failed to get auth session: %v\n", err)
+		logger.Error(ctx, "failed to get auth session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -370,10 +379,11 @@ func (checker *Checker) GameMaintainerAuthChecker(ctx context.Context, _ *openap
 		return echo.NewHTTPError(http.StatusUnauthorized, "session is expired")
 	}
 	if err != nil && !errors.Is(err, service.ErrForbidden) {
-		log.Printf("error: failed to check launcher admin auth: %v\n", err)
+		logger.Error(ctx, "failed to check launcher admin auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check launcher admin auth")
 	}
 	if err == nil {
+		checker.setTrapMemberLogAttrs(c, authSession)
 		return nil
 	}
 
@@ -392,9 +402,12 @@ func (checker *Checker) GameMaintainerAuthChecker(ctx context.Context, _ *openap
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to authorize game owner or maintainer: %v\n", err)
+		logger.Error(ctx, "failed to authorize game owner or maintainer", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authorize game owner")
 	}
+
+	checker.setTrapMemberLogAttrs(c, authSession)
+
 	return nil
 }
 
@@ -403,13 +416,13 @@ func (checker *Checker) EditionAuthChecker(ctx context.Context, ai *openapi3filt
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
 	_, _, ok, message, err := checker.checkEditionAuth(c, ai)
 	if err != nil {
-		log.Printf("error: failed to check edition auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -425,7 +438,7 @@ func (checker *Checker) EditionGameFileAuthChecker(ctx context.Context, ai *open
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
@@ -434,14 +447,31 @@ func (checker *Checker) EditionGameFileAuthChecker(ctx context.Context, ai *open
 		return echo.NewHTTPError(http.StatusUnauthorized, message)
 	}
 
-	strFileID := c.Param("gameFileID")
-	uuidFileID, err := uuid.Parse(strFileID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid fileID")
+	var (
+		productKey *domain.LauncherUser
+		edition    *domain.Edition
+		err        error
+	)
+	if strGameVersionID := c.Param("gameVersionID"); c.Param("gameFileID") == "" && strGameVersionID != "" {
+		// ゲームバージョンのアセットをまとめて取得する場合は、ファイルIDの代わりにゲームバージョンIDで確認する
+		productKey, edition, err = checker.checkEditionGameVersionAuth(c, accessToken, strGameVersionID)
+		// パスの不正などはそのまま返し、サービスのエラーは下でファイルの場合と同様に扱う
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+	} else {
+		strFileID := c.Param("gameFileID")
+		// ここでerrを宣言し直すと、EditionFileAuthのエラーが下で扱われなくなるので注意
+		var uuidFileID uuid.UUID
+		uuidFileID, err = uuid.Parse(strFileID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid fileID")
+		}
+		fileID := values.NewGameFileIDFromUUID(uuidFileID)
+
+		productKey, edition, err = checker.editionAuthService.EditionFileAuth(ctx, accessToken, fileID)
 	}
-	fileID := values.NewGameFileIDFromUUID(uuidFileID)
-
-	productKey, edition, err := checker.editionAuthService.EditionFileAuth(ctx, accessToken, fileID)
 	if errors.Is(err, service.ErrInvalidAccessToken) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
 	}
@@ -451,23 +481,55 @@ func (checker *Checker) EditionGameFileAuthChecker(ctx context.Context, ai *open
 	if errors.Is(err, service.ErrForbidden) {
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden")
 	}
+	if errors.Is(err, service.ErrGameFileNotClean) {
+		return echo.NewHTTPError(http.StatusForbidden, "game file has not passed the scan")
+	}
 	if err != nil {
-		log.Printf("error: failed to check edition game file auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition game file auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check edition game file auth")
 	}
 
 	checker.context.SetProductKey(c, productKey)
 	checker.context.SetEdition(c, edition)
+	checker.setLauncherUserLogAttrs(c, productKey, edition)
 
 	return nil
 }
 
+// checkEditionGameVersionAuth
+// エディションにゲームバージョンが含まれ、パスのエディションIDがアクセストークンのエディションと一致するか確認する。
+// エディションの確認に失敗した場合のエラーは、EditionFileAuthと同様に呼び出し元で扱う。
+func (checker *Checker) checkEditionGameVersionAuth(c echo.Context, accessToken values.LauncherSessionAccessToken, strGameVersionID string) (*domain.LauncherUser, *domain.Edition, error) {
+	uuidGameVersionID, err := uuid.Parse(strGameVersionID)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid gameVersionID")
+	}
+	gameVersionID := values.NewGameVersionIDFromUUID(uuidGameVersionID)
+
+	uuidEditionID, err := uuid.Parse(c.Param("editionID"))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid editionID")
+	}
+	editionID := values.NewEditionIDFromUUID(uuidEditionID)
+
+	productKey, edition, err := checker.editionAuthService.EditionGameVersionAuth(c.Request().Context(), accessToken, gameVersionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if editionID != edition.GetID() {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "editionID is not matched")
+	}
+
+	return productKey, edition, nil
+}
+
 func (checker *Checker) EditionGameImageAuthChecker(ctx context.Context, ai *openapi3filter.AuthenticationInput) error {
 	c := echomiddleware.GetEchoContext(ctx)
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
@@ -494,12 +556,13 @@ func (checker *Checker) EditionGameImageAuthChecker(ctx context.Context, ai *ope
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden")
 	}
 	if err != nil {
-		log.Printf("error: failed to check edition game image auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition game image auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check edition game image auth")
 	}
 
 	checker.context.SetProductKey(c, productKey)
 	checker.context.SetEdition(c, edition)
+	checker.setLauncherUserLogAttrs(c, productKey, edition)
 
 	return nil
 }
@@ -509,7 +572,7 @@ func (checker *Checker) EditionGameVideoAuthChecker(ctx context.Context, ai *ope
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
@@ -536,12 +599,13 @@ func (checker *Checker) EditionGameVideoAuthChecker(ctx context.Context, ai *ope
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden")
 	}
 	if err != nil {
-		log.Printf("error: failed to check edition game video auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition game video auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check edition game video auth")
 	}
 
 	checker.context.SetProductKey(c, productKey)
 	checker.context.SetEdition(c, edition)
+	checker.setLauncherUserLogAttrs(c, productKey, edition)
 
 	return nil
 }
@@ -551,7 +615,7 @@ func (checker *Checker) EditionGameFeedbackAuthChecker(ctx context.Context, ai *
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return errors.New("echo context is not set")
 	}
 
@@ -578,12 +642,13 @@ func (checker *Checker) EditionGameFeedbackAuthChecker(ctx context.Context, ai *
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden")
 	}
 	if err != nil {
-		log.Printf("error: failed to check edition game feedback auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition game feedback auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check edition game feedback auth")
 	}
 
 	checker.context.SetProductKey(c, productKey)
 	checker.context.SetEdition(c, edition)
+	checker.setLauncherUserLogAttrs(c, productKey, edition)
 
 	return nil
 }
@@ -593,13 +658,13 @@ func (checker *Checker) EditionIDAuthChecker(ctx context.Context, ai *openapi3fi
 	// GetEchoContextの内部実装をみるとnilがかえりうるので、
 	// ここではありえないはずだが念の為チェックする
 	if c == nil {
-		log.Printf("error: failed to get echo context\n")
+		logger.Error(ctx, "failed to get echo context")
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
 	_, edition, ok, message, err := checker.checkEditionAuth(c, ai)
 	if err != nil {
-		log.Printf("error: failed to check edition auth: %v\n", err)
+		logger.Error(ctx, "failed to check edition auth", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -640,10 +705,37 @@ func (checker *Checker) checkEditionAuth(c echo.Context, ai *openapi3filter.Auth
 
 	checker.context.SetProductKey(c, productKey)
 	checker.context.SetEdition(c, edition)
+	checker.setLauncherUserLogAttrs(c, productKey, edition)
+	checker.context.SetAccessToken(c, accessToken)
 
 	return productKey, edition, true, "", nil
 }
 
+// setTrapMemberLogAttrs
+// 以降のログにリクエストしたtraPのメンバーを出力するようにする
+func (checker *Checker) setTrapMemberLogAttrs(c echo.Context, authSession *domain.OIDCSession) {
+	user, err := checker.oidcService.GetMe(c.Request().Context(), authSession)
+	if err != nil {
+		// ログへの出力のためだけに取得しているので、失敗しても認証は通す
+		logger.Warn(c.Request().Context(), "failed to get me for log", slog.Any("error", err))
+		return
+	}
+
+	checker.context.SetLogAttrs(c,
+		slog.String("user_id", uuid.UUID(user.GetID()).String()),
+		slog.String("user_name", string(user.GetName())),
+	)
+}
+
+// setLauncherUserLogAttrs
+// 以降のログにリクエストしたランチャーのユーザーとエディションを出力するようにする
+func (checker *Checker) setLauncherUserLogAttrs(c echo.Context, productKey *domain.LauncherUser, edition *domain.Edition) {
+	checker.context.SetLogAttrs(c,
+		slog.String("launcher_user_id", uuid.UUID(productKey.GetID()).String()),
+		slog.String("edition_id", uuid.UUID(edition.GetID()).String()),
+	)
+}
+
 func (checker *Checker) getAccessToken(ai *openapi3filter.AuthenticationInput) (values.LauncherSessionAccessToken, bool, string) {
 	authorizationHeader := ai.RequestValidationInput.Request.Header.Get(echo.HeaderAuthorization)
 
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/session"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 	"github.com/traPtitech/trap-collection-server/src/service/mock"
 	"go.uber.org/mock/gomock"
@@ -119,6 +121,12 @@ func TestTrapMemberAuthMiddleware(t *testing.T) {
 				EXPECT().
 				Authenticate(gomock.Any(), gomock.Any()).
 				Return(traPAuthErr)
+			if testCase.isOk {
+				mockOIDCService.
+					EXPECT().
+					GetMe(gomock.Any(), gomock.Any()).
+					Return(service.NewUserInfo(values.NewTrapMemberID(uuid.New()), values.NewTrapMemberName("mazrean"), values.TrapMemberStatusActive, false), nil)
+			}
 
 			err = checker.TrapMemberAuthChecker(ctx, nil)
 
@@ -141,6 +149,9 @@ func TestTrapMemberAuthMiddleware(t *testing.T) {
 			if err != nil {
 				return
 			}
+
+			// 以降のログにユーザーが出力されるよう、contextにロガーが設定されている
+			assert.NotEqual(t, slog.Default(), logger.FromContext(c.Request().Context()))
 		})
 	}
 }
@@ -152,6 +163,12 @@ func TestCheckTrapMemberAuth(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockOIDCService := mock.NewMockOIDCV2(ctrl)
+	// ログに出力するユーザーの取得
+	mockOIDCService.
+		EXPECT().
+		GetMe(gomock.Any(), gomock.Any()).
+		Return(service.NewUserInfo(values.NewTrapMemberID(uuid.New()), values.NewTrapMemberName("mazrean"), values.TrapMemberStatusActive, false), nil).
+		AnyTimes()
 	mockEditionService := mock.NewMockEdition(ctrl)
 	mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
 	mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
@@ -292,6 +309,12 @@ func TestGameInfoVisibilityChecker(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockOIDCService := mock.NewMockOIDCV2(ctrl)
+	// ログに出力するユーザーの取得
+	mockOIDCService.
+		EXPECT().
+		GetMe(gomock.Any(), gomock.Any()).
+		Return(service.NewUserInfo(values.NewTrapMemberID(uuid.New()), values.NewTrapMemberName("mazrean"), values.TrapMemberStatusActive, false), nil).
+		AnyTimes()
 	mockEditionService := mock.NewMockEdition(ctrl)
 	mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
 	mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
@@ -489,6 +512,12 @@ func TestGameFileVisibilityChecker(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockOIDCService := mock.NewMockOIDCV2(ctrl)
+	// ログに出力するユーザーの取得
+	mockOIDCService.
+		EXPECT().
+		GetMe(gomock.Any(), gomock.Any()).
+		Return(service.NewUserInfo(values.NewTrapMemberID(uuid.New()), values.NewTrapMemberName("mazrean"), values.TrapMemberStatusActive, false), nil).
+		AnyTimes()
 	mockEditionService := mock.NewMockEdition(ctrl)
 	mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
 	mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
@@ -622,3 +651,209 @@ func TestGameFileVisibilityChecker(t *testing.T) {
 	}
 
 }
+
+func TestEditionGameFileAuthChecker(t *testing.T) {
+	t.Parallel()
+
+	accessToken, err := values.NewLauncherSessionAccessToken()
+	if err != nil {
+		t.Fatalf("failed to create access token: %v", err)
+	}
+
+	productKey := domain.NewLauncherUser(values.NewLauncherUserID(), values.NewLauncherUserProductKeyFromString("product key"))
+	edition := domain.NewEditionWithoutQuestionnaire(values.NewEditionID(), "edition", time.Now())
+
+	type test struct {
+		authorization                 string
+		gameFileID                    string
+		gameVersionID                 string
+		editionID                     string
+		executeEditionFileAuth        bool
+		executeEditionGameVersionAuth bool
+		authErr                       error
+		isError                       bool
+		statusCode                    int
+	}
+
+	testCases := map[string]test{
+		"ゲームファイルの取得が許可されているのでOK": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+		},
+		"Authorizationヘッダーが無いので401": {
+			gameFileID: uuid.NewString(),
+			isError:    true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"ゲームファイルIDが不正なので400": {
+			authorization: "Bearer " + string(accessToken),
+			gameFileID:    "invalid",
+			isError:       true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"ゲームファイルでアクセストークンが不正なので401": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+			authErr:                service.ErrInvalidAccessToken,
+			isError:                true,
+			statusCode:             http.StatusUnauthorized,
+		},
+		"ゲームファイルでアクセストークンの期限が切れているので401": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+			authErr:                service.ErrExpiredAccessToken,
+			isError:                true,
+			statusCode:             http.StatusUnauthorized,
+		},
+		"ゲームファイルがエディションに含まれないので403": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+			authErr:                service.ErrForbidden,
+			isError:                true,
+			statusCode:             http.StatusForbidden,
+		},
+		"ゲームファイルが検査を通過していないので403": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+			authErr:                service.ErrGameFileNotClean,
+			isError:                true,
+			statusCode:             http.StatusForbidden,
+		},
+		"EditionFileAuthがエラーなので500": {
+			authorization:          "Bearer " + string(accessToken),
+			gameFileID:             uuid.NewString(),
+			executeEditionFileAuth: true,
+			authErr:                errors.New("error"),
+			isError:                true,
+			statusCode:             http.StatusInternalServerError,
+		},
+		"ゲームバージョンの取得が許可されているのでOK": {
+			authorization:                 "Bearer " + string(accessToken),
+			gameVersionID:                 uuid.NewString(),
+			editionID:                     uuid.UUID(edition.GetID()).String(),
+			executeEditionGameVersionAuth: true,
+		},
+		"ゲームバージョンIDが不正なので400": {
+			authorization: "Bearer " + string(accessToken),
+			gameVersionID: "invalid",
+			editionID:     uuid.UUID(edition.GetID()).String(),
+			isError:       true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"ゲームバージョンでアクセストークンが不正なので401": {
+			authorization:                 "Bearer " + string(accessToken),
+			gameVersionID:                 uuid.NewString(),
+			editionID:                     uuid.UUID(edition.GetID()).String(),
+			executeEditionGameVersionAuth: true,
+			authErr:                       service.ErrInvalidAccessToken,
+			isError:                       true,
+			statusCode:                    http.StatusUnauthorized,
+		},
+		"ゲームバージョンでアクセストークンの期限が切れているので401": {
+			authorization:                 "Bearer " + string(accessToken),
+			gameVersionID:                 uuid.NewString(),
+			editionID:                     uuid.UUID(edition.GetID()).String(),
+			executeEditionGameVersionAuth: true,
+			authErr:                       service.ErrExpiredAccessToken,
+			isError:                       true,
+			statusCode:                    http.StatusUnauthorized,
+		},
+		"ゲームバージョンがエディションに含まれないので403": {
+			authorization:                 "Bearer " + string(accessToken),
+			gameVersionID:                 uuid.NewString(),
+			editionID:                     uuid.UUID(edition.GetID()).String(),
+			executeEditionGameVersionAuth: true,
+			authErr:                       service.ErrForbidden,
+			isError:                       true,
+			statusCode:                    http.StatusForbidden,
+		},
+		"エディションIDが一致しないので401": {
+			authorization:                 "Bearer " + string(accessToken),
+			gameVersionID:                 uuid.NewString(),
+			editionID:                     uuid.NewString(),
+			executeEditionGameVersionAuth: true,
+			isError:                       true,
+			statusCode:                    http.StatusUnauthorized,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
+
+			checker := NewChecker(
+				NewContext(),
+				nil,
+				mock.NewMockOIDCV2(ctrl),
+				mock.NewMockEdition(ctrl),
+				mockEditionAuthService,
+				mock.NewMockGameRoleV2(ctrl),
+				mock.NewMockAdminAuthV2(ctrl),
+				mock.NewMockGameV2(ctrl),
+			)
+
+			c, req, _ := setupTestRequest(t, http.MethodGet, "/api/v2/games/files", nil)
+			if testCase.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, testCase.authorization)
+			}
+			if testCase.gameVersionID != "" {
+				c.SetParamNames("editionID", "gameID", "gameVersionID")
+				c.SetParamValues(testCase.editionID, uuid.NewString(), testCase.gameVersionID)
+			} else {
+				c.SetParamNames("gameID", "gameFileID")
+				c.SetParamValues(uuid.NewString(), testCase.gameFileID)
+			}
+
+			if testCase.executeEditionFileAuth {
+				mockEditionAuthService.
+					EXPECT().
+					EditionFileAuth(gomock.Any(), accessToken, values.NewGameFileIDFromUUID(uuid.MustParse(testCase.gameFileID))).
+					Return(productKey, edition, testCase.authErr)
+			}
+
+			if testCase.executeEditionGameVersionAuth {
+				mockEditionAuthService.
+					EXPECT().
+					EditionGameVersionAuth(gomock.Any(), accessToken, values.NewGameVersionIDFromUUID(uuid.MustParse(testCase.gameVersionID))).
+					Return(productKey, edition, testCase.authErr)
+			}
+
+			ctx := setEchoContext(context.Background(), c)
+
+			ai := openapi3filter.AuthenticationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request: req,
+				},
+			}
+
+			err := checker.EditionGameFileAuthChecker(ctx, &ai)
+
+			if testCase.isError {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+
+			actualProductKey, err := checker.context.GetProductKey(c)
+			assert.NoError(t, err)
+			assert.Equal(t, productKey, actualProductKey)
+
+			actualEdition, err := checker.context.GetEdition(c)
+			assert.NoError(t, err)
+			assert.Equal(t, edition, actualEdition)
+		})
+	}
+}
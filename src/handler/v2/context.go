@@ -3,11 +3,14 @@ package v2
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 )
 
 const (
-	productKeyContextKey = "productKey"
-	editionContextKey    = "edition"
+	productKeyContextKey  = "productKey"
+	editionContextKey     = "edition"
+	accessTokenContextKey = "accessToken"
 )
 
 type Context struct{}
@@ -41,3 +44,23 @@ func (context *Context) GetEdition(c echo.Context) (*domain.Edition, error) {
 
 	return edition, nil
 }
+
+func (context *Context) SetAccessToken(c echo.Context, accessToken values.LauncherSessionAccessToken) {
+	c.Set(accessTokenContextKey, accessToken)
+}
+
+func (context *Context) GetAccessToken(c echo.Context) (values.LauncherSessionAccessToken, error) {
+	accessToken, ok := c.Get(accessTokenContextKey).(values.LauncherSessionAccessToken)
+	if !ok || accessToken == "" {
+		return "", ErrNoValue
+	}
+
+	return accessToken, nil
+}
+
+// SetLogAttrs
+// リクエストのcontextのロガーに属性を追加し、以降のログに出力されるようにする。
+func (context *Context) SetLogAttrs(c echo.Context, args ...any) {
+	ctx := logger.With(c.Request().Context(), args...)
+	c.SetRequest(c.Request().WithContext(ctx))
+}
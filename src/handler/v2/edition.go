@@ -3,7 +3,7 @@ package v2
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -13,15 +13,18 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
 type Edition struct {
+	session        *Session
 	editionService service.Edition
 }
 
-func NewEdition(editionService service.Edition) *Edition {
+func NewEdition(session *Session, editionService service.Edition) *Edition {
 	return &Edition{
+		session:        session,
 		editionService: editionService,
 	}
 }
@@ -31,7 +34,7 @@ func NewEdition(editionService service.Edition) *Edition {
 func (edition *Edition) GetEditions(c echo.Context) error {
 	editions, err := edition.editionService.GetEditions(c.Request().Context())
 	if err != nil {
-		log.Printf("error: failed to get editions: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get editions", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get editions")
 	}
 
@@ -39,7 +42,7 @@ func (edition *Edition) GetEditions(c echo.Context) error {
 	for _, edition := range editions {
 		questionnaireURL, err := edition.GetQuestionnaireURL()
 		if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
-			log.Printf("error: failed to get questionnaire url: %v\n", err)
+			logger.Error(c.Request().Context(), "failed to get questionnaire url", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire url")
 		}
 
@@ -103,13 +106,13 @@ func (edition *Edition) PostEdition(c echo.Context) error {
 	case errors.Is(err, service.ErrDuplicateGame):
 		return echo.NewHTTPError(http.StatusBadRequest, "duplicate game")
 	case err != nil:
-		log.Printf("error: failed to create edition: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to create edition", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create edition")
 	}
 
 	questionnaireURL, err := domainEdition.GetQuestionnaireURL()
 	if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
-		log.Printf("error: failed to get questionnaire url: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get questionnaire url", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire url")
 	}
 
@@ -130,12 +133,21 @@ func (edition *Edition) PostEdition(c echo.Context) error {
 // エディションの削除
 // (DELETE /editions/{editionID})
 func (edition *Edition) DeleteEdition(ctx echo.Context, editionID openapi.EditionIDInPath) error {
-	err := edition.editionService.DeleteEdition(ctx.Request().Context(), values.NewEditionIDFromUUID(editionID))
+	session, err := edition.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := edition.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	err = edition.editionService.DeleteEdition(ctx.Request().Context(), authSession, values.NewEditionIDFromUUID(editionID))
 	if errors.Is(err, service.ErrInvalidEditionID) {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid edition id")
 	}
 	if err != nil {
-		log.Printf("error: failed to delete edition: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to delete edition", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete edition")
 	}
 
@@ -150,13 +162,13 @@ func (edition *Edition) GetEdition(ctx echo.Context, editionID openapi.EditionID
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid edition id")
 	}
 	if err != nil {
-		log.Printf("error: failed to get edition: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get edition", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition")
 	}
 
 	questionnaireURL, err := domainEdition.GetQuestionnaireURL()
 	if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
-		log.Printf("error: failed to get questionnaire url: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get questionnaire url", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire url")
 	}
 
@@ -198,8 +210,18 @@ func (edition *Edition) PatchEdition(ctx echo.Context, editionID openapi.Edition
 		optionQuestionnaireURL = option.NewOption(values.NewEditionQuestionnaireURL(urlValue))
 	}
 
+	session, err := edition.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := edition.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
 	domainEdition, err := edition.editionService.UpdateEdition(
 		ctx.Request().Context(),
+		authSession,
 		values.NewEditionIDFromUUID(editionID),
 		name,
 		optionQuestionnaireURL,
@@ -208,13 +230,13 @@ func (edition *Edition) PatchEdition(ctx echo.Context, editionID openapi.Edition
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid edition id")
 	}
 	if err != nil {
-		log.Printf("error: failed to update edition: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to update edition", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update edition")
 	}
 
 	questionnaireURL, err := domainEdition.GetQuestionnaireURL()
 	if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
-		log.Printf("error: failed to get questionnaire url: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get questionnaire url", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire url")
 	}
 
@@ -240,7 +262,7 @@ func (edition *Edition) GetEditionGames(ctx echo.Context, editionID openapi.Edit
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid edition id")
 	}
 	if err != nil {
-		log.Printf("error: failed to get games: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get games", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get games")
 	}
 
@@ -311,8 +333,18 @@ func (edition *Edition) PatchEditionGame(c echo.Context, editionID openapi.Editi
 		gameVersionIDs = append(gameVersionIDs, values.NewGameVersionIDFromUUID(gameVersionID))
 	}
 
+	session, err := edition.session.get(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := edition.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
 	gameVersions, err := edition.editionService.UpdateEditionGameVersions(
 		c.Request().Context(),
+		authSession,
 		values.NewEditionIDFromUUID(editionID),
 		gameVersionIDs,
 	)
@@ -324,7 +356,7 @@ func (edition *Edition) PatchEditionGame(c echo.Context, editionID openapi.Editi
 	case errors.Is(err, service.ErrDuplicateGame):
 		return echo.NewHTTPError(http.StatusBadRequest, "duplicate game")
 	case err != nil:
-		log.Printf("error: failed to update edition games: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to update edition games", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update edition games")
 	}
 
@@ -3,7 +3,7 @@ package v2
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -13,17 +13,20 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
 type EditionAuth struct {
 	context            *Context
+	session            *Session
 	editionAuthService service.EditionAuth
 }
 
-func NewEditionAuth(context *Context, editionAuth service.EditionAuth) *EditionAuth {
+func NewEditionAuth(context *Context, session *Session, editionAuth service.EditionAuth) *EditionAuth {
 	return &EditionAuth{
 		context:            context,
+		session:            session,
 		editionAuthService: editionAuth,
 	}
 }
@@ -54,7 +57,7 @@ func (editionAuth *EditionAuth) GetProductKeys(c echo.Context, editionID openapi
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid editionID")
 	}
 	if err != nil {
-		log.Printf("failed to get product keys: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get product keys", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get product keys")
 	}
 
@@ -67,7 +70,7 @@ func (editionAuth *EditionAuth) GetProductKeys(c echo.Context, editionID openapi
 		case values.LauncherUserStatusInactive:
 			status = openapi.Revoked
 		default:
-			log.Printf("error: invalid product key status: %v\n", productKey.GetStatus())
+			logger.Error(c.Request().Context(), "invalid product key status", slog.Any("product_key_status", productKey.GetStatus()))
 			continue
 		}
 
@@ -101,7 +104,7 @@ func (editionAuth *EditionAuth) PostProductKey(c echo.Context, editionID openapi
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid key num")
 	}
 	if err != nil {
-		log.Printf("error: failed to create product key: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to create product key", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create product key")
 	}
 
@@ -121,8 +124,18 @@ func (editionAuth *EditionAuth) PostProductKey(c echo.Context, editionID openapi
 // プロダクトキーの再有効化
 // (POST /editions/{editionID}/keys/{productKeyID}/activate)
 func (editionAuth *EditionAuth) PostActivateProductKey(c echo.Context, _ openapi.EditionIDInPath, productKeyID openapi.ProductKeyIDInPath) error {
+	session, err := editionAuth.session.get(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := editionAuth.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
 	productKey, err := editionAuth.editionAuthService.ActivateProductKey(
 		c.Request().Context(),
+		authSession,
 		values.NewLauncherUserIDFromUUID(productKeyID),
 	)
 	if errors.Is(err, service.ErrInvalidProductKey) {
@@ -132,7 +145,7 @@ func (editionAuth *EditionAuth) PostActivateProductKey(c echo.Context, _ openapi
 		return echo.NewHTTPError(http.StatusNotFound, "key already activated")
 	}
 	if err != nil {
-		log.Printf("error: failed to activate product key: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to activate product key", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to activate product key")
 	}
 
@@ -147,8 +160,18 @@ func (editionAuth *EditionAuth) PostActivateProductKey(c echo.Context, _ openapi
 // プロダクトキーの失効
 // (POST /editions/{editionID}/keys/{productKeyID}/revoke)
 func (editionAuth *EditionAuth) PostRevokeProductKey(c echo.Context, _ openapi.EditionIDInPath, productKeyID openapi.ProductKeyIDInPath) error {
+	session, err := editionAuth.session.get(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := editionAuth.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
 	productKey, err := editionAuth.editionAuthService.RevokeProductKey(
 		c.Request().Context(),
+		authSession,
 		values.NewLauncherUserIDFromUUID(productKeyID),
 	)
 	if errors.Is(err, service.ErrInvalidProductKey) {
@@ -158,7 +181,7 @@ func (editionAuth *EditionAuth) PostRevokeProductKey(c echo.Context, _ openapi.E
 		return echo.NewHTTPError(http.StatusNotFound, "key already revoked")
 	}
 	if err != nil {
-		log.Printf("error: failed to revoke product key: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to revoke product key", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke product key")
 	}
 
@@ -173,7 +196,7 @@ func (editionAuth *EditionAuth) PostRevokeProductKey(c echo.Context, _ openapi.E
 // ランチャーの認可リクエスト
 // (POST /editions/authorize)
 func (editionAuth *EditionAuth) PostEditionAuthorize(c echo.Context) error {
-	log.Println("DEBUG: PostEditionAuthorize Request Content-Type", c.Request().Header.Get(echo.HeaderContentType))
+	logger.Debug(c.Request().Context(), "PostEditionAuthorize request", slog.String("content_type", c.Request().Header.Get(echo.HeaderContentType)))
 
 	var params openapi.EditionAuthorizeRequest
 	err := c.Bind(&params)
@@ -194,7 +217,7 @@ func (editionAuth *EditionAuth) PostEditionAuthorize(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid product key")
 	}
 	if err != nil {
-		log.Printf("error: failed to authorize launcher: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to authorize launcher", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authorize launcher")
 	}
 
@@ -209,13 +232,13 @@ func (editionAuth *EditionAuth) PostEditionAuthorize(c echo.Context) error {
 func (editionAuth *EditionAuth) GetEditionInfo(c echo.Context) error {
 	edition, err := editionAuth.context.GetEdition(c)
 	if err != nil {
-		log.Printf("error: failed to get edition: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get edition", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition")
 	}
 
 	questionnaireURL, err := edition.GetQuestionnaireURL()
 	if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
-		log.Printf("error: failed to get questionnaire url: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get questionnaire url", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire url")
 	}
 
@@ -142,7 +142,7 @@ func TestGetProductKeys(t *testing.T) {
 			t.Parallel()
 
 			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
-			editionAuth := NewEditionAuth(NewContext(), mockEditionAuthService)
+			editionAuth := NewEditionAuth(NewContext(), nil, mockEditionAuthService)
 
 			if testCase.executeGetProductKeys {
 				var status option.Option[values.LauncherUserStatus]
@@ -290,7 +290,7 @@ func TestPostProductKey(t *testing.T) {
 			t.Parallel()
 
 			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
-			editionAuth := NewEditionAuth(NewContext(), mockEditionAuthService)
+			editionAuth := NewEditionAuth(NewContext(), nil, mockEditionAuthService)
 
 			if testCase.executeGetProductKeys {
 				mockEditionAuthService.
@@ -345,6 +345,8 @@ func TestPostActivateProductKey(t *testing.T) {
 
 	productKeyID := uuid.New()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	activeProductKey := domain.NewProductKey(
 		values.NewLauncherUserIDFromUUID(productKeyID),
 		values.NewLauncherUserProductKeyFromString("key"),
@@ -361,6 +363,7 @@ func TestPostActivateProductKey(t *testing.T) {
 
 	testCases := map[string]struct {
 		productKeyID              openapi.ProductKeyIDInPath
+		sessionExist              bool
 		executeActivateProductKey bool
 		productKey                *domain.LauncherUser
 		ActivateProductKeyErr     error
@@ -371,12 +374,14 @@ func TestPostActivateProductKey(t *testing.T) {
 	}{
 		"特に問題なし": {
 			productKeyID:              productKeyID,
+			sessionExist:              true,
 			executeActivateProductKey: true,
 			productKey:                activeProductKey,
 			resProductKey:             openapiActiveProductKey,
 		},
 		"ErrInvalidProductKeyなので400": {
 			productKeyID:              productKeyID,
+			sessionExist:              true,
 			executeActivateProductKey: true,
 			ActivateProductKeyErr:     service.ErrInvalidProductKey,
 			isErr:                     true,
@@ -384,6 +389,7 @@ func TestPostActivateProductKey(t *testing.T) {
 		},
 		"ErrKeyAlreadyActivatedなので404": {
 			productKeyID:              productKeyID,
+			sessionExist:              true,
 			executeActivateProductKey: true,
 			ActivateProductKeyErr:     service.ErrKeyAlreadyActivated,
 			isErr:                     true,
@@ -391,11 +397,17 @@ func TestPostActivateProductKey(t *testing.T) {
 		},
 		"エラーが発生して500": {
 			productKeyID:              productKeyID,
+			sessionExist:              true,
 			executeActivateProductKey: true,
 			ActivateProductKeyErr:     errors.New("error"),
 			isErr:                     true,
 			statusCode:                http.StatusInternalServerError,
 		},
+		"セッションが無いので401": {
+			productKeyID: productKeyID,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -403,16 +415,21 @@ func TestPostActivateProductKey(t *testing.T) {
 			t.Parallel()
 
 			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
-			editionAuth := NewEditionAuth(NewContext(), mockEditionAuthService)
+			session := newTestSession(t, ctrl)
+			editionAuth := NewEditionAuth(NewContext(), session, mockEditionAuthService)
 
 			if testCase.executeActivateProductKey {
 				mockEditionAuthService.
 					EXPECT().
-					ActivateProductKey(gomock.Any(), values.NewLauncherUserIDFromUUID(testCase.productKeyID)).
+					ActivateProductKey(gomock.Any(), gomock.Any(), values.NewLauncherUserIDFromUUID(testCase.productKeyID)).
 					Return(testCase.productKey, testCase.ActivateProductKeyErr)
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/editions/keys/%s/activate", testCase.productKeyID), nil)
+			c, req, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/editions/keys/%s/activate", testCase.productKeyID), nil)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			err := editionAuth.PostActivateProductKey(c, openapi.EditionIDInPath{}, testCase.productKeyID)
 
@@ -454,6 +471,8 @@ func TestPostRevokeProductKey(t *testing.T) {
 
 	productKeyID := uuid.New()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	revokedProductKey := domain.NewProductKey(
 		values.NewLauncherUserIDFromUUID(productKeyID),
 		values.NewLauncherUserProductKeyFromString("key"),
@@ -470,6 +489,7 @@ func TestPostRevokeProductKey(t *testing.T) {
 
 	testCases := map[string]struct {
 		productKeyID            openapi.ProductKeyIDInPath
+		sessionExist            bool
 		executeRevokeProductKey bool
 		productKey              *domain.LauncherUser
 		RevokeProductKeyErr     error
@@ -480,12 +500,14 @@ func TestPostRevokeProductKey(t *testing.T) {
 	}{
 		"特に問題なし": {
 			productKeyID:            productKeyID,
+			sessionExist:            true,
 			executeRevokeProductKey: true,
 			productKey:              revokedProductKey,
 			resProductKey:           openapiRevokedProductKey,
 		},
 		"ErrInvalidProductKeyなので400": {
 			productKeyID:            productKeyID,
+			sessionExist:            true,
 			executeRevokeProductKey: true,
 			RevokeProductKeyErr:     service.ErrInvalidProductKey,
 			isErr:                   true,
@@ -493,6 +515,7 @@ func TestPostRevokeProductKey(t *testing.T) {
 		},
 		"ErrKeyAlreadyRevokedなので404": {
 			productKeyID:            productKeyID,
+			sessionExist:            true,
 			executeRevokeProductKey: true,
 			RevokeProductKeyErr:     service.ErrKeyAlreadyRevoked,
 			isErr:                   true,
@@ -500,11 +523,17 @@ func TestPostRevokeProductKey(t *testing.T) {
 		},
 		"エラーが発生して500": {
 			productKeyID:            productKeyID,
+			sessionExist:            true,
 			executeRevokeProductKey: true,
 			RevokeProductKeyErr:     errors.New("error"),
 			isErr:                   true,
 			statusCode:              http.StatusInternalServerError,
 		},
+		"セッションが無いので401": {
+			productKeyID: productKeyID,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -512,16 +541,21 @@ func TestPostRevokeProductKey(t *testing.T) {
 			t.Parallel()
 
 			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
-			editionAuth := NewEditionAuth(NewContext(), mockEditionAuthService)
+			session := newTestSession(t, ctrl)
+			editionAuth := NewEditionAuth(NewContext(), session, mockEditionAuthService)
 
 			if testCase.executeRevokeProductKey {
 				mockEditionAuthService.
 					EXPECT().
-					RevokeProductKey(gomock.Any(), values.NewLauncherUserIDFromUUID(testCase.productKeyID)).
+					RevokeProductKey(gomock.Any(), gomock.Any(), values.NewLauncherUserIDFromUUID(testCase.productKeyID)).
 					Return(testCase.productKey, testCase.RevokeProductKeyErr)
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/editions/keys/%s/revoke", testCase.productKeyID), nil)
+			c, req, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/editions/keys/%s/revoke", testCase.productKeyID), nil)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			err := editionAuth.PostRevokeProductKey(c, openapi.EditionIDInPath{}, testCase.productKeyID)
 
@@ -634,7 +668,7 @@ func TestPostEditionAuthorize(t *testing.T) {
 			t.Parallel()
 
 			mockEditionAuthService := mock.NewMockEditionAuth(ctrl)
-			editionAuth := NewEditionAuth(NewContext(), mockEditionAuthService)
+			editionAuth := NewEditionAuth(NewContext(), nil, mockEditionAuthService)
 
 			if testCase.executeAuthorizeEdition {
 				mockEditionAuthService.
@@ -742,7 +776,7 @@ func TestGetEditionInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			editionAuth := NewEditionAuth(NewContext(), nil)
+			editionAuth := NewEditionAuth(NewContext(), nil, nil)
 
 			c, _, rec := setupTestRequest(t, http.MethodGet, "/api/v2/editions/info", nil)
 
@@ -0,0 +1,148 @@
+package v2
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+type EditionBundle struct {
+	editionBundleService service.EditionBundle
+}
+
+func NewEditionBundle(editionBundleService service.EditionBundle) *EditionBundle {
+	return &EditionBundle{
+		editionBundleService: editionBundleService,
+	}
+}
+
+// エディションのバンドルの生成
+// (POST /editions/{editionID}/bundles)
+func (editionBundle *EditionBundle) PostEditionBundle(c echo.Context, editionID openapi.EditionIDInPath) error {
+	bundle, err := editionBundle.editionBundleService.CreateEditionBundle(c.Request().Context(), values.NewEditionIDFromUUID(editionID))
+	if errors.Is(err, service.ErrInvalidEditionID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid edition id")
+	}
+	if errors.Is(err, service.ErrEditionBundleUnavailable) {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "edition bundle is unavailable")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to create edition bundle", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create edition bundle")
+	}
+
+	res, err := convertEditionBundle(bundle)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert edition bundle", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert edition bundle")
+	}
+
+	return c.JSON(http.StatusAccepted, res)
+}
+
+// エディションのバンドルの一覧の取得
+// (GET /editions/{editionID}/bundles)
+func (editionBundle *EditionBundle) GetEditionBundles(c echo.Context, editionID openapi.EditionIDInPath) error {
+	bundles, err := editionBundle.editionBundleService.GetEditionBundles(c.Request().Context(), values.NewEditionIDFromUUID(editionID))
+	if errors.Is(err, service.ErrInvalidEditionID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid edition id")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get edition bundles", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition bundles")
+	}
+
+	res := make([]openapi.EditionBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		resBundle, err := convertEditionBundle(bundle)
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to convert edition bundle", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert edition bundle")
+		}
+
+		res = append(res, resBundle)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// エディションのバンドルの情報の取得
+// (GET /editions/{editionID}/bundles/{editionBundleID})
+func (editionBundle *EditionBundle) GetEditionBundle(c echo.Context, editionID openapi.EditionIDInPath, editionBundleID openapi.EditionBundleIDInPath) error {
+	bundle, err := editionBundle.editionBundleService.GetEditionBundle(
+		c.Request().Context(),
+		values.NewEditionIDFromUUID(editionID),
+		values.NewEditionBundleIDFromUUID(editionBundleID),
+	)
+	if errors.Is(err, service.ErrInvalidEditionBundleID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid edition bundle id")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get edition bundle", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition bundle")
+	}
+
+	res, err := convertEditionBundle(bundle)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert edition bundle", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert edition bundle")
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// エディションのバンドルのダウンロード
+// (GET /editions/{editionID}/bundles/{editionBundleID}/download)
+func (editionBundle *EditionBundle) GetEditionBundleDownload(c echo.Context, editionID openapi.EditionIDInPath, editionBundleID openapi.EditionBundleIDInPath) error {
+	tmpURL, err := editionBundle.editionBundleService.GetEditionBundleURL(
+		c.Request().Context(),
+		values.NewEditionIDFromUUID(editionID),
+		values.NewEditionBundleIDFromUUID(editionBundleID),
+	)
+	if errors.Is(err, service.ErrInvalidEditionBundleID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid edition bundle id")
+	}
+	if errors.Is(err, service.ErrEditionBundleNotCompleted) {
+		return echo.NewHTTPError(http.StatusConflict, "edition bundle is not completed")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get edition bundle url", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition bundle url")
+	}
+
+	return c.Redirect(http.StatusSeeOther, (*url.URL)(tmpURL).String())
+}
+
+func convertEditionBundle(bundle *domain.EditionBundle) (openapi.EditionBundle, error) {
+	var status openapi.EditionBundleStatus
+	switch bundle.GetStatus() {
+	case values.EditionBundleStatusPending:
+		status = openapi.EditionBundleStatusPending
+	case values.EditionBundleStatusBuilding:
+		status = openapi.EditionBundleStatusBuilding
+	case values.EditionBundleStatusCompleted:
+		status = openapi.EditionBundleStatusCompleted
+	case values.EditionBundleStatusFailed:
+		status = openapi.EditionBundleStatusFailed
+	default:
+		return openapi.EditionBundle{}, errors.New("unknown edition bundle status")
+	}
+
+	return openapi.EditionBundle{
+		Id:             uuid.UUID(bundle.GetID()),
+		Status:         status,
+		TotalItems:     bundle.GetTotalItems(),
+		ProcessedItems: bundle.GetProcessedItems(),
+		Size:           int64(bundle.GetSize()),
+		CreatedAt:      bundle.GetCreatedAt(),
+	}, nil
+}
@@ -0,0 +1,373 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEditionBundleService := mock.NewMockEditionBundle(ctrl)
+	editionBundleHandler := NewEditionBundle(mockEditionBundleService)
+
+	now := time.Now()
+
+	testCases := map[string]struct {
+		status     values.EditionBundleStatus
+		createErr  error
+		resStatus  openapi.EditionBundleStatus
+		isErr      bool
+		statusCode int
+	}{
+		"特に問題ないのでエラー無し": {
+			status:     values.EditionBundleStatusPending,
+			resStatus:  openapi.EditionBundleStatusPending,
+			statusCode: http.StatusAccepted,
+		},
+		"CreateEditionBundleがErrInvalidEditionIDなので404": {
+			createErr:  service.ErrInvalidEditionID,
+			isErr:      true,
+			statusCode: http.StatusNotFound,
+		},
+		"CreateEditionBundleがErrEditionBundleUnavailableなので503": {
+			createErr:  service.ErrEditionBundleUnavailable,
+			isErr:      true,
+			statusCode: http.StatusServiceUnavailable,
+		},
+		"CreateEditionBundleがエラーなので500": {
+			createErr:  errors.New("error"),
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+		"未知の状態なので500": {
+			status:     values.EditionBundleStatus(100),
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			editionID := uuid.New()
+			bundleID := values.NewEditionBundleID()
+
+			c, _, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/editions/%s/bundles", editionID), nil)
+
+			var bundle *domain.EditionBundle
+			if testCase.createErr == nil {
+				bundle = domain.NewEditionBundle(bundleID, values.NewEditionIDFromUUID(editionID), testCase.status, now)
+			}
+
+			mockEditionBundleService.
+				EXPECT().
+				CreateEditionBundle(gomock.Any(), values.NewEditionIDFromUUID(editionID)).
+				Return(bundle, testCase.createErr)
+
+			err := editionBundleHandler.PostEditionBundle(c, editionID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.EditionBundle
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, uuid.UUID(bundleID), res.Id)
+			assert.Equal(t, testCase.resStatus, res.Status)
+			assert.Zero(t, res.TotalItems)
+			assert.Zero(t, res.ProcessedItems)
+			assert.Zero(t, res.Size)
+			assert.WithinDuration(t, now, res.CreatedAt, time.Second)
+		})
+	}
+}
+
+func TestGetEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEditionBundleService := mock.NewMockEditionBundle(ctrl)
+	editionBundleHandler := NewEditionBundle(mockEditionBundleService)
+
+	now := time.Now()
+	editionID := values.NewEditionID()
+
+	bundleID1 := values.NewEditionBundleID()
+	bundle1 := domain.NewEditionBundle(bundleID1, editionID, values.EditionBundleStatusCompleted, now)
+	bundle1.SetProgress(3, 3)
+	bundle1.SetSize(1000)
+	bundleID2 := values.NewEditionBundleID()
+	bundle2 := domain.NewEditionBundle(bundleID2, editionID, values.EditionBundleStatusBuilding, now.Add(-time.Hour))
+	bundle2.SetProgress(1, 3)
+
+	testCases := map[string]struct {
+		bundles       []*domain.EditionBundle
+		getBundlesErr error
+		resBody       []openapi.EditionBundle
+		isErr         bool
+		statusCode    int
+	}{
+		"特に問題ないのでエラー無し": {
+			bundles: []*domain.EditionBundle{bundle1, bundle2},
+			resBody: []openapi.EditionBundle{
+				{
+					Id:             uuid.UUID(bundleID1),
+					Status:         openapi.EditionBundleStatusCompleted,
+					TotalItems:     3,
+					ProcessedItems: 3,
+					Size:           1000,
+					CreatedAt:      now,
+				},
+				{
+					Id:             uuid.UUID(bundleID2),
+					Status:         openapi.EditionBundleStatusBuilding,
+					TotalItems:     3,
+					ProcessedItems: 1,
+					Size:           0,
+					CreatedAt:      now.Add(-time.Hour),
+				},
+			},
+			statusCode: http.StatusOK,
+		},
+		"バンドルが存在しなくてもエラー無し": {
+			bundles:    []*domain.EditionBundle{},
+			resBody:    []openapi.EditionBundle{},
+			statusCode: http.StatusOK,
+		},
+		"GetEditionBundlesがErrInvalidEditionIDなので404": {
+			getBundlesErr: service.ErrInvalidEditionID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"GetEditionBundlesがエラーなので500": {
+			getBundlesErr: errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/editions/%s/bundles", uuid.UUID(editionID)), nil)
+
+			mockEditionBundleService.
+				EXPECT().
+				GetEditionBundles(gomock.Any(), editionID).
+				Return(testCase.bundles, testCase.getBundlesErr)
+
+			err := editionBundleHandler.GetEditionBundles(c, uuid.UUID(editionID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res []openapi.EditionBundle
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			require.Len(t, res, len(testCase.resBody))
+			for i, resBundle := range res {
+				assert.Equal(t, testCase.resBody[i].Id, resBundle.Id)
+				assert.Equal(t, testCase.resBody[i].Status, resBundle.Status)
+				assert.Equal(t, testCase.resBody[i].TotalItems, resBundle.TotalItems)
+				assert.Equal(t, testCase.resBody[i].ProcessedItems, resBundle.ProcessedItems)
+				assert.Equal(t, testCase.resBody[i].Size, resBundle.Size)
+				assert.WithinDuration(t, testCase.resBody[i].CreatedAt, resBundle.CreatedAt, time.Second)
+			}
+		})
+	}
+}
+
+func TestGetEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEditionBundleService := mock.NewMockEditionBundle(ctrl)
+	editionBundleHandler := NewEditionBundle(mockEditionBundleService)
+
+	now := time.Now()
+	editionID := values.NewEditionID()
+
+	testCases := map[string]struct {
+		status       values.EditionBundleStatus
+		getBundleErr error
+		resStatus    openapi.EditionBundleStatus
+		isErr        bool
+		statusCode   int
+	}{
+		"特に問題ないのでエラー無し": {
+			status:     values.EditionBundleStatusBuilding,
+			resStatus:  openapi.EditionBundleStatusBuilding,
+			statusCode: http.StatusOK,
+		},
+		"生成に失敗していてもエラー無し": {
+			status:     values.EditionBundleStatusFailed,
+			resStatus:  openapi.EditionBundleStatusFailed,
+			statusCode: http.StatusOK,
+		},
+		"GetEditionBundleがErrInvalidEditionBundleIDなので404": {
+			getBundleErr: service.ErrInvalidEditionBundleID,
+			isErr:        true,
+			statusCode:   http.StatusNotFound,
+		},
+		"GetEditionBundleがエラーなので500": {
+			getBundleErr: errors.New("error"),
+			isErr:        true,
+			statusCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bundleID := values.NewEditionBundleID()
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/editions/%s/bundles/%s", uuid.UUID(editionID), uuid.UUID(bundleID)), nil)
+
+			var bundle *domain.EditionBundle
+			if testCase.getBundleErr == nil {
+				bundle = domain.NewEditionBundle(bundleID, editionID, testCase.status, now)
+				bundle.SetProgress(2, 5)
+			}
+
+			mockEditionBundleService.
+				EXPECT().
+				GetEditionBundle(gomock.Any(), editionID, bundleID).
+				Return(bundle, testCase.getBundleErr)
+
+			err := editionBundleHandler.GetEditionBundle(c, uuid.UUID(editionID), uuid.UUID(bundleID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.EditionBundle
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, uuid.UUID(bundleID), res.Id)
+			assert.Equal(t, testCase.resStatus, res.Status)
+			assert.Equal(t, 5, res.TotalItems)
+			assert.Equal(t, 2, res.ProcessedItems)
+		})
+	}
+}
+
+func TestGetEditionBundleDownload(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEditionBundleService := mock.NewMockEditionBundle(ctrl)
+	editionBundleHandler := NewEditionBundle(mockEditionBundleService)
+
+	editionID := values.NewEditionID()
+	urlLink, err := url.Parse("https://example.com/bundle")
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		tmpURL      values.EditionBundleTmpURL
+		getURLErr   error
+		resLocation string
+		isErr       bool
+		statusCode  int
+	}{
+		"特に問題ないのでリダイレクト": {
+			tmpURL:      values.NewEditionBundleTmpURL(urlLink),
+			resLocation: urlLink.String(),
+		},
+		"GetEditionBundleURLがErrInvalidEditionBundleIDなので404": {
+			getURLErr:  service.ErrInvalidEditionBundleID,
+			isErr:      true,
+			statusCode: http.StatusNotFound,
+		},
+		"GetEditionBundleURLがErrEditionBundleNotCompletedなので409": {
+			getURLErr:  service.ErrEditionBundleNotCompleted,
+			isErr:      true,
+			statusCode: http.StatusConflict,
+		},
+		"GetEditionBundleURLがエラーなので500": {
+			getURLErr:  errors.New("error"),
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bundleID := values.NewEditionBundleID()
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/editions/%s/bundles/%s/download", uuid.UUID(editionID), uuid.UUID(bundleID)), nil)
+
+			mockEditionBundleService.
+				EXPECT().
+				GetEditionBundleURL(gomock.Any(), editionID, bundleID).
+				Return(testCase.tmpURL, testCase.getURLErr)
+
+			err := editionBundleHandler.GetEditionBundleDownload(c, uuid.UUID(editionID), uuid.UUID(bundleID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusSeeOther, rec.Code)
+			assert.Equal(t, testCase.resLocation, rec.Header().Get("Location"))
+		})
+	}
+}
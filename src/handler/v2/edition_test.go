@@ -139,7 +139,7 @@ func TestGetEditions(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			edition := NewEdition(nil, mockEditionService)
 
 			c, _, rec := setupTestRequest(t, http.MethodGet, "/api/v2/editions", nil)
 
@@ -368,7 +368,7 @@ func TestPostEdition(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			edition := NewEdition(nil, mockEditionService)
 
 			var c echo.Context
 			var rec *httptest.ResponseRecorder
@@ -429,10 +429,13 @@ func TestPostEdition(t *testing.T) {
 func TestDeleteEdition(t *testing.T) {
 	t.Parallel()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	editionID := uuid.New()
 
 	type test struct {
 		description       string
+		sessionExist      bool
 		editionID         openapi.EditionIDInPath
 		executeDeleteMock bool
 		launcherVersionID values.EditionID
@@ -444,6 +447,7 @@ func TestDeleteEdition(t *testing.T) {
 	testCases := []test{
 		{
 			description:       "特に問題ないのでエラー無し",
+			sessionExist:      true,
 			editionID:         editionID,
 			executeDeleteMock: true,
 			launcherVersionID: values.NewEditionIDFromUUID(editionID),
@@ -451,6 +455,7 @@ func TestDeleteEdition(t *testing.T) {
 		},
 		{
 			description:       "存在しないエディションIDなので400",
+			sessionExist:      true,
 			editionID:         editionID,
 			executeDeleteMock: true,
 			launcherVersionID: values.NewEditionIDFromUUID(editionID),
@@ -460,6 +465,7 @@ func TestDeleteEdition(t *testing.T) {
 		},
 		{
 			description:       "DeleteEditionがエラーなので500",
+			sessionExist:      true,
 			editionID:         editionID,
 			executeDeleteMock: true,
 			launcherVersionID: values.NewEditionIDFromUUID(editionID),
@@ -467,6 +473,12 @@ func TestDeleteEdition(t *testing.T) {
 			isErr:             true,
 			statusCode:        http.StatusInternalServerError,
 		},
+		{
+			description: "セッションが無いので401",
+			editionID:   editionID,
+			isErr:       true,
+			statusCode:  http.StatusUnauthorized,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -476,16 +488,21 @@ func TestDeleteEdition(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			session := newTestSession(t, ctrl)
+			edition := NewEdition(session, mockEditionService)
 
 			if testCase.executeDeleteMock {
 				mockEditionService.
 					EXPECT().
-					DeleteEdition(gomock.Any(), testCase.launcherVersionID).
+					DeleteEdition(gomock.Any(), gomock.Any(), testCase.launcherVersionID).
 					Return(testCase.deleteEditionErr)
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodDelete, fmt.Sprintf("/api/v2/editions/%s", testCase.editionID), nil)
+			c, req, rec := setupTestRequest(t, http.MethodDelete, fmt.Sprintf("/api/v2/editions/%s", testCase.editionID), nil)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			err := edition.DeleteEdition(c, testCase.editionID)
 
@@ -586,7 +603,7 @@ func TestGetEdition(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			edition := NewEdition(nil, mockEditionService)
 
 			mockEditionService.
 				EXPECT().
@@ -627,8 +644,11 @@ func TestGetEdition(t *testing.T) {
 func TestPatchEdition(t *testing.T) {
 	t.Parallel()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	type test struct {
 		description       string
+		sessionExist      bool
 		editionID         openapi.EditionIDInPath
 		reqBody           *openapi.PatchEdition
 		invalidBody       bool
@@ -657,8 +677,9 @@ func TestPatchEdition(t *testing.T) {
 
 	testCases := []test{
 		{
-			description: "特に問題ないのでエラーなし",
-			editionID:   editionUUID,
+			description:  "特に問題ないのでエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name:          editionName,
 				Questionnaire: &strURL,
@@ -682,8 +703,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "URLがなくてもエラーなし",
-			editionID:   editionUUID,
+			description:  "URLがなくてもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: editionName,
 			},
@@ -705,15 +727,17 @@ func TestPatchEdition(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "リクエストボディが不正なので400",
-			editionID:   editionUUID,
-			invalidBody: true,
-			isErr:       true,
-			statusCode:  http.StatusBadRequest,
+			description:  "リクエストボディが不正なので400",
+			sessionExist: true,
+			editionID:    editionUUID,
+			invalidBody:  true,
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
 		},
 		{
-			description: "名前が空文字なので400",
-			editionID:   editionUUID,
+			description:  "名前が空文字なので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: "",
 			},
@@ -721,8 +745,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 		},
 		{
-			description: "名前が長すぎるので400",
-			editionID:   editionUUID,
+			description:  "名前が長すぎるので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: longName,
 			},
@@ -730,8 +755,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 		},
 		{
-			description: "URLが正しくないので400",
-			editionID:   editionUUID,
+			description:  "URLが正しくないので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name:          editionName,
 				Questionnaire: &invalidURL,
@@ -740,8 +766,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 		},
 		{
-			description: "ErrInvalidEditionIDなので400",
-			editionID:   editionUUID,
+			description:  "ErrInvalidEditionIDなので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: editionName,
 			},
@@ -754,8 +781,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode:        http.StatusBadRequest,
 		},
 		{
-			description: "ErrDuplicateGameVersionなので500",
-			editionID:   editionUUID,
+			description:  "ErrDuplicateGameVersionなので500",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: editionName,
 			},
@@ -768,8 +796,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode:        http.StatusInternalServerError,
 		},
 		{
-			description: "ErrDuplicateGameなので500",
-			editionID:   editionUUID,
+			description:  "ErrDuplicateGameなので500",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: editionName,
 			},
@@ -782,8 +811,9 @@ func TestPatchEdition(t *testing.T) {
 			statusCode:        http.StatusInternalServerError,
 		},
 		{
-			description: "サービス層でエラーなので500",
-			editionID:   editionUUID,
+			description:  "サービス層でエラーなので500",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEdition{
 				Name: editionName,
 			},
@@ -795,6 +825,15 @@ func TestPatchEdition(t *testing.T) {
 			isErr:             true,
 			statusCode:        http.StatusInternalServerError,
 		},
+		{
+			description: "セッションが無いので401",
+			editionID:   editionUUID,
+			reqBody: &openapi.PatchEdition{
+				Name: editionName,
+			},
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -803,12 +842,14 @@ func TestPatchEdition(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			session := newTestSession(t, ctrl)
+			edition := NewEdition(session, mockEditionService)
 
 			if !testCase.invalidBody && testCase.executeUpdateMock {
 				mockEditionService.
 					EXPECT().
 					UpdateEdition(
+						gomock.Any(),
 						gomock.Any(),
 						testCase.launcherVersionID,
 						testCase.name,
@@ -826,7 +867,11 @@ func TestPatchEdition(t *testing.T) {
 				reqBody = []byte("invalid json")
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/v2/editions/%s", testCase.editionID), withReaderBody(t, bytes.NewReader(reqBody), echo.MIMEApplicationJSON))
+			c, req, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/v2/editions/%s", testCase.editionID), withReaderBody(t, bytes.NewReader(reqBody), echo.MIMEApplicationJSON))
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			err = edition.PatchEdition(c, testCase.editionID)
 
@@ -1265,7 +1310,7 @@ func TestGetEditionGames(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			edition := NewEdition(nil, mockEditionService)
 
 			mockEditionService.
 				EXPECT().
@@ -1323,8 +1368,11 @@ func TestGetEditionGames(t *testing.T) {
 func TestPatchEditionGame(t *testing.T) {
 	t.Parallel()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	type test struct {
 		description           string
+		sessionExist          bool
 		editionID             openapi.EditionIDInPath
 		reqBody               *openapi.PatchEditionGameRequest
 		invalidBody           bool
@@ -1372,8 +1420,9 @@ func TestPatchEditionGame(t *testing.T) {
 
 	testCases := []test{
 		{
-			description: "特に問題ないのでエラーなし",
-			editionID:   editionUUID,
+			description:  "特に問題ないのでエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{
 					uuid.UUID(gameVersionID1),
@@ -1416,8 +1465,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "空のゲームバージョン一覧でもエラーなし",
-			editionID:   editionUUID,
+			description:  "空のゲームバージョン一覧でもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{},
 			},
@@ -1429,15 +1479,17 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode:         http.StatusOK,
 		},
 		{
-			description: "不正なリクエストボディなので400",
-			editionID:   editionUUID,
-			invalidBody: true,
-			isErr:       true,
-			statusCode:  http.StatusBadRequest,
+			description:  "不正なリクエストボディなので400",
+			sessionExist: true,
+			editionID:    editionUUID,
+			invalidBody:  true,
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
 		},
 		{
-			description: "不正なゲームバージョンIDなので400",
-			editionID:   editionUUID,
+			description:  "不正なゲームバージョンIDなので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1449,8 +1501,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode:            http.StatusBadRequest,
 		},
 		{
-			description: "ErrDuplicateGameVersionなので400",
-			editionID:   editionUUID,
+			description:  "ErrDuplicateGameVersionなので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{
 					uuid.UUID(gameVersionID1),
@@ -1465,8 +1518,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode:            http.StatusBadRequest,
 		},
 		{
-			description: "ErrDuplicateGameなので400",
-			editionID:   editionUUID,
+			description:  "ErrDuplicateGameなので400",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1478,8 +1532,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode:            http.StatusBadRequest,
 		},
 		{
-			description: "サービス層でエラーが発生したので500",
-			editionID:   editionUUID,
+			description:  "サービス層でエラーが発生したので500",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1491,8 +1546,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode:            http.StatusInternalServerError,
 		},
 		{
-			description: "windowsでもエラーなし",
-			editionID:   editionUUID,
+			description:  "windowsでもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{
 					uuid.UUID(gameVersionID1),
@@ -1540,8 +1596,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "macファイルでもエラーなし",
-			editionID:   editionUUID,
+			description:  "macファイルでもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1583,8 +1640,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "jarファイルでもエラーなし",
-			editionID:   editionUUID,
+			description:  "jarファイルでもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1626,8 +1684,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "ファイルが複数でもエラーなし",
-			editionID:   editionUUID,
+			description:  "ファイルが複数でもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1671,8 +1730,9 @@ func TestPatchEditionGame(t *testing.T) {
 			statusCode: http.StatusOK,
 		},
 		{
-			description: "urlとファイルでもエラーなし",
-			editionID:   editionUUID,
+			description:  "urlとファイルでもエラーなし",
+			sessionExist: true,
+			editionID:    editionUUID,
 			reqBody: &openapi.PatchEditionGameRequest{
 				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
 			},
@@ -1715,6 +1775,15 @@ func TestPatchEditionGame(t *testing.T) {
 			},
 			statusCode: http.StatusOK,
 		},
+		{
+			description: "セッションが無いので401",
+			editionID:   editionUUID,
+			reqBody: &openapi.PatchEditionGameRequest{
+				GameVersionIDs: []uuid.UUID{uuid.UUID(gameVersionID1)},
+			},
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1723,7 +1792,8 @@ func TestPatchEditionGame(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockEditionService := mock.NewMockEdition(ctrl)
-			edition := NewEdition(mockEditionService)
+			session := newTestSession(t, ctrl)
+			edition := NewEdition(session, mockEditionService)
 
 			var bodyOpt bodyOpt
 			if !testCase.invalidBody {
@@ -1732,12 +1802,17 @@ func TestPatchEditionGame(t *testing.T) {
 				bodyOpt = withStringBody(t, "invalid json")
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/v2/editions/%s/games", testCase.editionID), bodyOpt)
+			c, req, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/v2/editions/%s/games", testCase.editionID), bodyOpt)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			if testCase.executeUpdateMock {
 				mockEditionService.
 					EXPECT().
 					UpdateEditionGameVersions(
+						gomock.Any(),
 						gomock.Any(),
 						testCase.launcherVersionID,
 						testCase.gameVersionIDs,
@@ -11,39 +11,48 @@ import (
 )
 
 // isFileUploadRequest はファイルをアップロードするエンドポイントならtrueを返す。
-// POST /api/v2/games/:gameID/{files,images,videos} へのリクエストが含まれる。
+// POST /api/v2/games/:gameID/{files,images,videos} と
+// PUT /api/v2/games/:gameID/videos/:gameVideoID/poster へのリクエストが含まれる。
 func isFileUploadRequest(c echo.Context) bool {
-	if c.Request().Method != http.MethodPost {
-		return false
-	}
-
 	gameID := c.Param("gameID")
 	if gameID == "" {
 		return false
 	}
 
 	targetPathBase := path.Join("/api/v2/games", gameID)
-	targetPaths := []string{
-		path.Join(targetPathBase, "files"),
-		path.Join(targetPathBase, "images"),
-		path.Join(targetPathBase, "videos"),
-	}
-
 	reqPath := path.Clean(c.Request().URL.Path)
 
-	return slices.Contains(targetPaths, reqPath)
+	switch c.Request().Method {
+	case http.MethodPost:
+		targetPaths := []string{
+			path.Join(targetPathBase, "files"),
+			path.Join(targetPathBase, "images"),
+			path.Join(targetPathBase, "videos"),
+		}
+
+		return slices.Contains(targetPaths, reqPath)
+	case http.MethodPut:
+		gameVideoID := c.Param("gameVideoID")
+		if gameVideoID == "" {
+			return false
+		}
+
+		return reqPath == path.Join(targetPathBase, "videos", gameVideoID, "poster")
+	default:
+		return false
+	}
 }
 
 // fileUploadSkipper はファイルをアップロードするエンドポイントについてバリデーションをスキップする。
 // OapiRequestValidator は 内部の ValidateSecurityRequirements でリクエストボディを全部読んでいる。
 // そのため、画像・動画・ファイルのアップロード時にメモリ不足になる可能性がある。
-// POST /api/v2/games/:gameID/{files,images,videos} へのリクエストは、バリデーションをスキップする。
+// isFileUploadRequest に該当するリクエストは、バリデーションをスキップする。
 func fileUploadSkipper(c echo.Context) bool {
 	return isFileUploadRequest(c)
 }
 
 // fileUploadAuthMiddleware はファイルをアップロードするエンドポイントに対してのみ認証を行うミドルウェアを返す。
-// POST /api/v2/games/:gameID/{files,images,videos} へのリクエストが含まれる。
+// isFileUploadRequest に該当するリクエストが含まれる。
 //
 // IMPORTANT: [(*Checker).GameMaintainerAuthChecker] の第2引数が使われていないことに依存した実装になっている。
 func (checker *Checker) fileUploadAuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -11,10 +11,11 @@ func Test_isFileUploadRequest(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		method string
-		path   string
-		gameID string
-		want   bool
+		method      string
+		path        string
+		gameID      string
+		gameVideoID string
+		want        bool
 	}{
 		"POST /api/v2/games/:gameID/files": {
 			method: http.MethodPost,
@@ -34,6 +35,33 @@ func Test_isFileUploadRequest(t *testing.T) {
 			gameID: "123",
 			want:   true,
 		},
+		"PUT /api/v2/games/:gameID/videos/:gameVideoID/poster": {
+			method:      http.MethodPut,
+			path:        "/api/v2/games/123/videos/456/poster",
+			gameID:      "123",
+			gameVideoID: "456",
+			want:        true,
+		},
+		"GET /api/v2/games/:gameID/videos/:gameVideoID/poster": {
+			method:      http.MethodGet,
+			path:        "/api/v2/games/123/videos/456/poster",
+			gameID:      "123",
+			gameVideoID: "456",
+			want:        false,
+		},
+		"PUT /api/v2/games/:gameID/videos/:gameVideoID/others": {
+			method:      http.MethodPut,
+			path:        "/api/v2/games/123/videos/456/others",
+			gameID:      "123",
+			gameVideoID: "456",
+			want:        false,
+		},
+		"PUT /api/v2/games/:gameID/videos": {
+			method: http.MethodPut,
+			path:   "/api/v2/games/123/videos",
+			gameID: "123",
+			want:   false,
+		},
 		"GET /api/v2/games/:gameID/files": {
 			method: http.MethodGet,
 			path:   "/api/v2/games/123/files",
@@ -59,8 +87,8 @@ func Test_isFileUploadRequest(t *testing.T) {
 			t.Parallel()
 
 			c, _, _ := setupTestRequest(t, testCase.method, testCase.path, nil)
-			c.SetParamNames("gameID")
-			c.SetParamValues(testCase.gameID)
+			c.SetParamNames("gameID", "gameVideoID")
+			c.SetParamValues(testCase.gameID, testCase.gameVideoID)
 
 			got := isFileUploadRequest(c)
 
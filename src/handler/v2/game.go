@@ -3,7 +3,7 @@ package v2
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -11,6 +11,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -77,6 +78,10 @@ func (g *Game) GetGames(ctx echo.Context, params openapi.GetGamesParams) error {
 			sortType = service.GamesSortTypeCreatedAt
 		case openapi.LatestVersion:
 			sortType = service.GamesSortTypeLatestVersion
+		case openapi.PlayCount:
+			sortType = service.GamesSortTypePlayCount
+		case openapi.LatestFeedback:
+			sortType = service.GamesSortTypeLatestFeedback
 		default:
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid sort type")
 		}
@@ -89,6 +94,11 @@ func (g *Game) GetGames(ctx echo.Context, params openapi.GetGamesParams) error {
 		gameName = *params.Name
 	}
 
+	var keyword string
+	if params.Keyword != nil {
+		keyword = *params.Keyword
+	}
+
 	var gameGenreIDs []values.GameGenreID
 	if params.Genre != nil {
 		gameGenreIDs = make([]values.GameGenreID, 0, len(*params.Genre))
@@ -100,15 +110,15 @@ func (g *Game) GetGames(ctx echo.Context, params openapi.GetGamesParams) error {
 	var gameWithGenres []*domain.GameWithGenres
 	var gameNumber int
 	if isAll {
-		gameNumber, gameWithGenres, err = g.gameService.GetGames(ctx.Request().Context(), limit, offset, sortType, visibilities, gameGenreIDs, gameName)
+		gameNumber, gameWithGenres, err = g.gameService.GetGames(ctx.Request().Context(), limit, offset, sortType, visibilities, gameGenreIDs, gameName, keyword)
 		if err != nil {
-			log.Printf("error: failed to get games: %v\n", err)
+			logger.Error(ctx.Request().Context(), "failed to get games", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get games")
 		}
 	} else {
-		gameNumber, gameWithGenres, err = g.gameService.GetMyGames(ctx.Request().Context(), authSession, limit, offset, sortType, visibilities, gameGenreIDs, gameName)
+		gameNumber, gameWithGenres, err = g.gameService.GetMyGames(ctx.Request().Context(), authSession, limit, offset, sortType, visibilities, gameGenreIDs, gameName, keyword)
 		if err != nil {
-			log.Printf("error: failed to get games: %v\n", err)
+			logger.Error(ctx.Request().Context(), "failed to get games", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get my games")
 		}
 	}
@@ -124,7 +134,7 @@ func (g *Game) GetGames(ctx echo.Context, params openapi.GetGamesParams) error {
 		case values.GameVisibilityTypePrivate:
 			visibility = openapi.Private
 		default:
-			log.Printf("error: failed to get game visibility: %v\n", gameWithGenres[i].GetGame().GetVisibility())
+			logger.Error(ctx.Request().Context(), "failed to get game visibility", slog.Any("game_visibility", gameWithGenres[i].GetGame().GetVisibility()))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get games")
 		}
 
@@ -149,9 +159,80 @@ func (g *Game) GetGames(ctx echo.Context, params openapi.GetGamesParams) error {
 		Num:   gameNumber,
 	}
 
+	if params.Facets != nil && *params.Facets {
+		// 自分のゲームのみを取得する場合は、ファセットも自分のゲームについて数える。
+		var facetSession *domain.OIDCSession
+		if !isAll {
+			facetSession = authSession
+		}
+
+		facets, err := g.gameService.GetGameFacets(ctx.Request().Context(), facetSession, visibilities, gameGenreIDs, gameName, keyword)
+		if err != nil {
+			logger.Error(ctx.Request().Context(), "failed to get game facets", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game facets")
+		}
+
+		resFacets, err := convertGameFacets(facets)
+		if err != nil {
+			logger.Error(ctx.Request().Context(), "failed to convert game facets", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game facets")
+		}
+		res.Facets = &resFacets
+	}
+
 	return ctx.JSON(http.StatusOK, res)
 }
 
+func convertGameFacets(facets *domain.GameFacets) (openapi.GameFacets, error) {
+	genres := make([]openapi.GameGenreFacet, 0, len(facets.GetGenres()))
+	for _, genreFacet := range facets.GetGenres() {
+		genres = append(genres, openapi.GameGenreFacet{
+			Id:    openapi.GameGenreID(genreFacet.GetGenre().GetID()),
+			Genre: openapi.GameGenreName(genreFacet.GetGenre().GetName()),
+			Num:   genreFacet.GetGameCount(),
+		})
+	}
+
+	fileTypes := make([]openapi.GameFileTypeFacet, 0, len(facets.GetFileTypes()))
+	for _, fileTypeFacet := range facets.GetFileTypes() {
+		var fileType openapi.GameFileType
+		switch fileTypeFacet.GetFileType() {
+		case values.GameFileTypeJar:
+			fileType = openapi.Jar
+		case values.GameFileTypeWindows:
+			fileType = openapi.Win32
+		case values.GameFileTypeMac:
+			fileType = openapi.Darwin
+		default:
+			return openapi.GameFacets{}, fmt.Errorf("invalid game file type: %v", fileTypeFacet.GetFileType())
+		}
+
+		fileTypes = append(fileTypes, openapi.GameFileTypeFacet{
+			Type: fileType,
+			Num:  fileTypeFacet.GetGameCount(),
+		})
+	}
+
+	visibilities := make([]openapi.GameVisibilityFacet, 0, len(facets.GetVisibilities()))
+	for _, visibilityFacet := range facets.GetVisibilities() {
+		visibility, err := convertGameVisibility(visibilityFacet.GetVisibility())
+		if err != nil {
+			return openapi.GameFacets{}, err
+		}
+
+		visibilities = append(visibilities, openapi.GameVisibilityFacet{
+			Type: visibility,
+			Num:  visibilityFacet.GetGameCount(),
+		})
+	}
+
+	return openapi.GameFacets{
+		Genres:       genres,
+		FileTypes:    fileTypes,
+		Visibilities: visibilities,
+	}, nil
+}
+
 // ゲームの追加
 // (POST /games)
 func (g *Game) PostGame(ctx echo.Context) error {
@@ -179,7 +260,7 @@ func (g *Game) PostGame(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "game name is too long")
 	}
 	if err != nil {
-		log.Printf("error: failed to validate game name: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to validate game name", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate game name")
 	}
 
@@ -231,7 +312,7 @@ func (g *Game) PostGame(ctx echo.Context) error {
 				return echo.NewHTTPError(http.StatusBadRequest, "game genre name is too long")
 			}
 			if err != nil {
-				log.Printf("failed to validate game genre name: %v\n", genreName)
+				logger.Error(ctx.Request().Context(), "failed to validate game genre name", slog.Any("game_genre_name", genreName))
 				return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate game genre name")
 			}
 
@@ -263,7 +344,7 @@ func (g *Game) PostGame(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to add game genre")
 	}
 	if err != nil {
-		log.Printf("error: failed to create game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to create game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create game")
 	}
 
@@ -294,7 +375,7 @@ func (g *Game) PostGame(ctx echo.Context) error {
 	case values.GameVisibilityTypePrivate:
 		resVisibility = openapi.Private
 	default:
-		log.Printf("error: failed to get game visibility: %v\n", gameInfo.Game.GetVisibility())
+		logger.Error(ctx.Request().Context(), "failed to get game visibility", slog.Any("game_visibility", gameInfo.Game.GetVisibility()))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game visibility")
 	}
 
@@ -319,7 +400,7 @@ func (g *Game) DeleteGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	if errors.Is(err, service.ErrNoGame) {
 		return echo.NewHTTPError(http.StatusNotFound, "game not found")
 	} else if err != nil {
-		log.Printf("error: failed to delete game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to delete game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error")
 	}
 
@@ -331,7 +412,7 @@ func (g *Game) DeleteGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 func (g *Game) GetGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	session, err := g.session.get(ctx)
 	if err != nil {
-		log.Printf("error: failed to save session: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to save session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
 	}
 	authSession, err := g.session.getAuthSession(session)
@@ -344,7 +425,7 @@ func (g *Game) GetGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	if errors.Is(err, service.ErrNoGame) {
 		return echo.NewHTTPError(http.StatusNotFound, "game not found")
 	} else if err != nil {
-		log.Printf("error: failed to get game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game")
 	}
 
@@ -371,7 +452,7 @@ func (g *Game) GetGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	case values.GameVisibilityTypePrivate:
 		visibility = openapi.Private
 	default:
-		log.Printf("error: failed to get game visibility: %v\n", gameInfo.Game.GetVisibility())
+		logger.Error(ctx.Request().Context(), "failed to get game visibility", slog.Any("game_visibility", gameInfo.Game.GetVisibility()))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game visibility")
 	}
 
@@ -406,7 +487,7 @@ func (g *Game) PatchGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "game name is too long")
 	}
 	if err != nil {
-		log.Printf("error: failed to validate game name: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to validate game name", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate game name")
 	}
 
@@ -427,8 +508,18 @@ func (g *Game) PatchGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 		visibility = &vis
 	}
 
+	session, err := g.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := g.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
 	game, err := g.gameService.UpdateGame(
 		ctx.Request().Context(),
+		authSession,
 		values.GameID(gameID),
 		gameName,
 		values.GameDescription(req.Description),
@@ -437,7 +528,7 @@ func (g *Game) PatchGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	if errors.Is(err, service.ErrNoGame) {
 		return echo.NewHTTPError(http.StatusNotFound, "game not found")
 	} else if err != nil {
-		log.Printf("error: failed to update game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to update game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game")
 	}
 
@@ -451,7 +542,7 @@ func (g *Game) PatchGame(ctx echo.Context, gameID openapi.GameIDInPath) error {
 	case values.GameVisibilityTypePrivate:
 		apiVisibility = openapi.Private
 	default:
-		log.Printf("error: invalid visibility: %v\n", gameVisibility)
+		logger.Error(ctx.Request().Context(), "invalid visibility", slog.Any("visibility", gameVisibility))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game visibility")
 	}
 
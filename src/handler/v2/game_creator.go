@@ -1,13 +1,18 @@
 package v2
 
 import (
+	"cmp"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -32,7 +37,7 @@ func (gc *GameCreator) GetGameCreatorJobs(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game creator jobs: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game creator jobs", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game creator jobs")
 	}
 
@@ -66,34 +71,13 @@ func (gc *GameCreator) GetGameCreators(c echo.Context, gameID openapi.GameIDInPa
 		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game creators: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game creators", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game creators")
 	}
 
 	res := make([]openapi.GameCreator, 0, len(gameCreators))
 	for _, creator := range gameCreators {
-		jobs := make([]openapi.GameCreatorJob, 0, len(creator.GetJobs())+len(creator.GetCustomJobs()))
-		for _, job := range creator.GetJobs() {
-			jobs = append(jobs, openapi.GameCreatorJob{
-				Id:          openapi.GameCreatorJobID(job.GetID()),
-				DisplayName: openapi.GameCreatorJobDisplayName(job.GetDisplayName()),
-				IsCustomJob: false,
-			})
-		}
-		for _, job := range creator.GetCustomJobs() {
-			jobs = append(jobs, openapi.GameCreatorJob{
-				Id:          openapi.GameCreatorJobID(job.GetID()),
-				DisplayName: openapi.GameCreatorJobDisplayName(job.GetDisplayName()),
-				IsCustomJob: true,
-			})
-		}
-
-		res = append(res, openapi.GameCreator{
-			Id:     openapi.GameCreatorID(creator.GetGameCreator().GetID()),
-			UserID: openapi.UserID(creator.GetGameCreator().GetUserID()),
-			Name:   openapi.UserName(creator.GetGameCreator().GetUserName()),
-			Jobs:   jobs,
-		})
+		res = append(res, convertGameCreatorWithJobs(creator))
 	}
 
 	return c.JSON(http.StatusOK, res)
@@ -113,8 +97,41 @@ func (gc *GameCreator) DeleteGameCreator(c echo.Context, _ openapi.GameIDInPath,
 
 // ゲームクリエイターのjob更新
 // (PUT /games/{gameID}/creators/{creatorID}/jobs)
-func (gc *GameCreator) PutGameCreatorJobs(c echo.Context, _ openapi.GameIDInPath, _ openapi.CreatorIDInPath) error {
-	return c.NoContent(http.StatusNotImplemented)
+func (gc *GameCreator) PutGameCreatorJobs(c echo.Context, gameID openapi.GameIDInPath, creatorID openapi.CreatorIDInPath) error {
+	var req openapi.PutGameCreatorJobsRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	jobIDs := make([]values.GameCreatorJobID, 0, len(req.JobIDs))
+	for _, jobID := range req.JobIDs {
+		jobIDs = append(jobIDs, values.GameCreatorJobID(jobID))
+	}
+
+	creator, err := gc.gameCreatorService.EditGameCreatorJobs(
+		c.Request().Context(),
+		values.NewGameIDFromUUID(gameID),
+		values.GameCreatorID(creatorID),
+		jobIDs,
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameCreatorID) || errors.Is(err, service.ErrInvalidGameCreatorGamePair) {
+		return echo.NewHTTPError(http.StatusNotFound, "Invalid creatorID")
+	}
+	if errors.Is(err, service.ErrInvalidGameCreatorJobID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid job id")
+	}
+	if errors.Is(err, service.ErrDuplicateGameCreatorJobID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "duplicate job id")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to edit game creator jobs", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to edit game creator jobs")
+	}
+
+	return c.JSON(http.StatusOK, convertGameCreatorWithJobs(creator))
 }
 
 // ゲームクリエイターのカスタムジョブ作成
@@ -122,3 +139,176 @@ func (gc *GameCreator) PutGameCreatorJobs(c echo.Context, _ openapi.GameIDInPath
 func (gc *GameCreator) PostGameCreatorCustomJob(c echo.Context, _ openapi.GameIDInPath) error {
 	return c.NoContent(http.StatusNotImplemented)
 }
+
+// 外部のゲームクリエイターの追加
+// (POST /games/{gameID}/creators/external)
+func (gc *GameCreator) PostGameExternalCreator(c echo.Context, gameID openapi.GameIDInPath) error {
+	var req openapi.PostGameExternalCreatorRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	externalName := values.NewGameCreatorExternalName(req.Name)
+	if err := externalName.Validate(); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid name")
+	}
+
+	var externalURL values.GameCreatorExternalURL
+	if req.Url != nil {
+		urlValue, err := url.Parse(*req.Url)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid url")
+		}
+
+		externalURL = values.NewGameCreatorExternalURL(urlValue)
+	}
+
+	creator, err := gc.gameCreatorService.CreateExternalGameCreator(
+		c.Request().Context(),
+		values.NewGameIDFromUUID(gameID),
+		externalName,
+		externalURL,
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to create external game creator", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create external game creator")
+	}
+
+	return c.JSON(http.StatusCreated, convertGameCreatorWithJobs(domain.NewGameCreatorWithJobs(creator, nil, nil)))
+}
+
+// ゲームクリエイターの表示順の変更
+// (PUT /games/{gameID}/creators/order)
+func (gc *GameCreator) PutGameCreatorsOrder(c echo.Context, gameID openapi.GameIDInPath) error {
+	var req openapi.PutGameCreatorsOrderRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	creatorIDs := make([]values.GameCreatorID, 0, len(req.CreatorIDs))
+	for _, creatorID := range req.CreatorIDs {
+		creatorIDs = append(creatorIDs, values.GameCreatorID(creatorID))
+	}
+
+	gameCreators, err := gc.gameCreatorService.UpdateGameCreatorsOrder(
+		c.Request().Context(),
+		values.NewGameIDFromUUID(gameID),
+		creatorIDs,
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameCreatorOrder) {
+		return echo.NewHTTPError(http.StatusBadRequest, "creatorIDs must contain all creators exactly once")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to update game creators order", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game creators order")
+	}
+
+	res := make([]openapi.GameCreator, 0, len(gameCreators))
+	for _, creator := range gameCreators {
+		res = append(res, convertGameCreatorWithJobs(creator))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ゲームのクレジットの取得
+// (GET /games/{gameID}/credits)
+func (gc *GameCreator) GetGameCredits(c echo.Context, gameID openapi.GameIDInPath) error {
+	credits, err := gc.gameCreatorService.GetGameCredits(
+		c.Request().Context(),
+		values.NewGameIDFromUUID(gameID),
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "Invalid gameID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get game credits", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game credits")
+	}
+
+	res := make([]openapi.GameCredit, 0, len(credits))
+	for _, credit := range credits {
+		creators := make([]openapi.GameCreditCreator, 0, len(credit.GetCreators()))
+		for _, creator := range credit.GetCreators() {
+			userID, name, isExternal, externalURL := convertGameCreatorProfile(creator)
+			creators = append(creators, openapi.GameCreditCreator{
+				Id:         openapi.GameCreatorID(creator.GetID()),
+				UserID:     userID,
+				Name:       name,
+				IsExternal: isExternal,
+				Url:        externalURL,
+			})
+		}
+
+		res = append(res, openapi.GameCredit{
+			Job: openapi.GameCreatorJob{
+				Id:          openapi.GameCreatorJobID(credit.GetJobID()),
+				DisplayName: openapi.GameCreatorJobDisplayName(credit.GetJobDisplayName()),
+				IsCustomJob: credit.IsCustomJob(),
+			},
+			Creators: creators,
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// convertGameCreatorWithJobs
+// ジョブはプリセットジョブとカスタムジョブをまとめて、クリエイター内での表示順に並べる。
+func convertGameCreatorWithJobs(creator *domain.GameCreatorWithJobs) openapi.GameCreator {
+	jobs := make([]openapi.GameCreatorJob, 0, len(creator.GetJobs())+len(creator.GetCustomJobs()))
+	for _, job := range creator.GetJobs() {
+		jobs = append(jobs, openapi.GameCreatorJob{
+			Id:          openapi.GameCreatorJobID(job.GetID()),
+			DisplayName: openapi.GameCreatorJobDisplayName(job.GetDisplayName()),
+			IsCustomJob: false,
+		})
+	}
+	for _, job := range creator.GetCustomJobs() {
+		jobs = append(jobs, openapi.GameCreatorJob{
+			Id:          openapi.GameCreatorJobID(job.GetID()),
+			DisplayName: openapi.GameCreatorJobDisplayName(job.GetDisplayName()),
+			IsCustomJob: true,
+		})
+	}
+	slices.SortStableFunc(jobs, func(a, b openapi.GameCreatorJob) int {
+		return cmp.Compare(
+			creator.GetJobDisplayOrder(values.GameCreatorJobID(a.Id)),
+			creator.GetJobDisplayOrder(values.GameCreatorJobID(b.Id)),
+		)
+	})
+
+	userID, name, isExternal, externalURL := convertGameCreatorProfile(creator.GetGameCreator())
+
+	return openapi.GameCreator{
+		Id:         openapi.GameCreatorID(creator.GetGameCreator().GetID()),
+		UserID:     userID,
+		Name:       name,
+		IsExternal: isExternal,
+		Url:        externalURL,
+		Jobs:       jobs,
+	}
+}
+
+// convertGameCreatorProfile
+// traPのメンバーか外部のクリエイターかによって異なる項目を変換する。
+func convertGameCreatorProfile(creator *domain.GameCreator) (*openapi.UserID, openapi.GameCreatorName, bool, *openapi.GameCreatorExternalURL) {
+	if !creator.IsExternal() {
+		userID := openapi.UserID(creator.GetUserID())
+		return &userID, creator.GetDisplayName(), false, nil
+	}
+
+	var externalURL *openapi.GameCreatorExternalURL
+	if creator.GetExternalURL() != nil {
+		urlStr := (*url.URL)(creator.GetExternalURL()).String()
+		externalURL = &urlStr
+	}
+
+	return nil, creator.GetDisplayName(), true, externalURL
+}
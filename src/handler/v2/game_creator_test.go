@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
@@ -136,10 +138,11 @@ func TestGetGameCreators(t *testing.T) {
 	t.Parallel()
 
 	gameID := uuid.New()
+	gameUserID := uuid.New()
 	gameCreator := domain.NewGameCreatorWithJobs(
 		domain.NewGameCreator(
 			values.NewGameCreatorID(),
-			values.NewTrapMemberID(uuid.New()),
+			values.NewTrapMemberID(gameUserID),
 			values.NewGameIDFromUUID(gameID),
 			values.NewTrapMemberName("ikura-hamu"),
 			time.Now(),
@@ -167,7 +170,7 @@ func TestGetGameCreators(t *testing.T) {
 			wantBody: []openapi.GameCreator{
 				{
 					Id:     openapi.GameCreatorID(gameCreator.GetGameCreator().GetID()),
-					UserID: openapi.UserID(gameCreator.GetGameCreator().GetUserID()),
+					UserID: (*openapi.UserID)(&gameUserID),
 					Jobs: []openapi.GameCreatorJob{
 						{
 							Id:          openapi.GameCreatorJobID(gameCreator.GetJobs()[0].GetID()),
@@ -180,7 +183,7 @@ func TestGetGameCreators(t *testing.T) {
 							IsCustomJob: true,
 						},
 					},
-					Name: openapi.UserName(gameCreator.GetGameCreator().GetUserName()),
+					Name: string(gameCreator.GetGameCreator().GetUserName()),
 				},
 			},
 		},
@@ -243,3 +246,469 @@ func TestGetGameCreators(t *testing.T) {
 		})
 	}
 }
+
+func TestPutGameCreatorJobs(t *testing.T) {
+	t.Parallel()
+
+	strURL := "https://example.com"
+
+	gameID := uuid.New()
+	creatorID := uuid.New()
+	presetJob := domain.NewGameCreatorJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Sound"), time.Now())
+	customJob := domain.NewGameCreatorCustomJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Voice"), values.NewGameIDFromUUID(gameID), time.Now())
+	externalURL, err := url.Parse("https://example.com")
+	require.NoError(t, err)
+	creator := domain.NewGameCreatorWithJobs(
+		domain.NewExternalGameCreator(
+			values.GameCreatorID(creatorID),
+			values.NewGameIDFromUUID(gameID),
+			values.NewGameCreatorExternalName("外部の声優"),
+			values.NewGameCreatorExternalURL(externalURL),
+			time.Now(),
+		),
+		[]*domain.GameCreatorJob{presetJob},
+		[]*domain.GameCreatorCustomJob{customJob},
+	)
+	creator.SetJobDisplayOrders(map[values.GameCreatorJobID]int{customJob.GetID(): 0, presetJob.GetID(): 1})
+	jobIDs := []values.GameCreatorJobID{customJob.GetID(), presetJob.GetID()}
+
+	testCases := map[string]struct {
+		invalidRequestBody bool
+		executeEdit        bool
+		creator            *domain.GameCreatorWithJobs
+		editErr            error
+		wantStatus         int
+		wantBody           openapi.GameCreator
+		isError            bool
+	}{
+		"特に問題ないのでエラー無し": {
+			executeEdit: true,
+			creator:     creator,
+			wantStatus:  http.StatusOK,
+			wantBody: openapi.GameCreator{
+				Id:         creatorID,
+				Name:       "外部の声優",
+				IsExternal: true,
+				Url:        &strURL,
+				Jobs: []openapi.GameCreatorJob{
+					{Id: uuid.UUID(customJob.GetID()), DisplayName: "Voice", IsCustomJob: true},
+					{Id: uuid.UUID(presetJob.GetID()), DisplayName: "Sound", IsCustomJob: false},
+				},
+			},
+		},
+		"リクエストボディが不正なので400": {
+			invalidRequestBody: true,
+			wantStatus:         http.StatusBadRequest,
+			isError:            true,
+		},
+		"ゲームが存在しないので404": {
+			executeEdit: true,
+			editErr:     service.ErrInvalidGameID,
+			wantStatus:  http.StatusNotFound,
+			isError:     true,
+		},
+		"クリエイターが存在しないので404": {
+			executeEdit: true,
+			editErr:     service.ErrInvalidGameCreatorID,
+			wantStatus:  http.StatusNotFound,
+			isError:     true,
+		},
+		"クリエイターが別のゲームのものなので404": {
+			executeEdit: true,
+			editErr:     service.ErrInvalidGameCreatorGamePair,
+			wantStatus:  http.StatusNotFound,
+			isError:     true,
+		},
+		"job idが不正なので400": {
+			executeEdit: true,
+			editErr:     service.ErrInvalidGameCreatorJobID,
+			wantStatus:  http.StatusBadRequest,
+			isError:     true,
+		},
+		"job idが重複しているので400": {
+			executeEdit: true,
+			editErr:     service.ErrDuplicateGameCreatorJobID,
+			wantStatus:  http.StatusBadRequest,
+			isError:     true,
+		},
+		"EditGameCreatorJobsがエラーなので500": {
+			executeEdit: true,
+			editErr:     assert.AnError,
+			wantStatus:  http.StatusInternalServerError,
+			isError:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockGameCreatorService := mock.NewMockGameCreator(ctrl)
+			gc := NewGameCreator(mockGameCreatorService)
+
+			if testCase.executeEdit {
+				mockGameCreatorService.EXPECT().
+					EditGameCreatorJobs(gomock.Any(), values.NewGameIDFromUUID(gameID), values.GameCreatorID(creatorID), jobIDs).
+					Return(testCase.creator, testCase.editErr)
+			}
+
+			var bodyOpt bodyOpt
+			if testCase.invalidRequestBody {
+				bodyOpt = withStringBody(t, "invalid request body")
+			} else {
+				bodyOpt = withJSONBody(t, openapi.PutGameCreatorJobsRequest{
+					JobIDs: []openapi.GameCreatorJobID{uuid.UUID(customJob.GetID()), uuid.UUID(presetJob.GetID())},
+				})
+			}
+			c, _, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/games/%s/creators/%s/jobs", gameID, creatorID), bodyOpt)
+
+			err := gc.PutGameCreatorJobs(c, gameID, creatorID)
+
+			if testCase.isError {
+				var httpError *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpError) {
+					assert.Equal(t, testCase.wantStatus, httpError.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, rec.Code)
+
+			var resBody openapi.GameCreator
+			err = json.NewDecoder(rec.Body).Decode(&resBody)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, resBody)
+		})
+	}
+}
+
+func TestPostGameExternalCreator(t *testing.T) {
+	t.Parallel()
+
+	strURL := "https://example.com"
+	invalidURL := ":invalid"
+
+	gameID := uuid.New()
+	creatorID := values.NewGameCreatorID()
+
+	testCases := map[string]struct {
+		invalidRequestBody bool
+		reqBody            openapi.PostGameExternalCreatorRequest
+		executeCreate      bool
+		externalURL        values.GameCreatorExternalURL
+		createErr          error
+		wantStatus         int
+		wantBody           openapi.GameCreator
+		isError            bool
+	}{
+		"特に問題ないのでエラー無し": {
+			reqBody:       openapi.PostGameExternalCreatorRequest{Name: "外部の作曲者", Url: &strURL},
+			executeCreate: true,
+			externalURL:   values.NewGameCreatorExternalURL(&url.URL{Scheme: "https", Host: "example.com"}),
+			wantStatus:    http.StatusCreated,
+			wantBody: openapi.GameCreator{
+				Id:         uuid.UUID(creatorID),
+				Name:       "外部の作曲者",
+				IsExternal: true,
+				Url:        &strURL,
+				Jobs:       []openapi.GameCreatorJob{},
+			},
+		},
+		"URLが無くてもエラー無し": {
+			reqBody:       openapi.PostGameExternalCreatorRequest{Name: "外部の作曲者"},
+			executeCreate: true,
+			wantStatus:    http.StatusCreated,
+			wantBody: openapi.GameCreator{
+				Id:         uuid.UUID(creatorID),
+				Name:       "外部の作曲者",
+				IsExternal: true,
+				Jobs:       []openapi.GameCreatorJob{},
+			},
+		},
+		"リクエストボディが不正なので400": {
+			invalidRequestBody: true,
+			wantStatus:         http.StatusBadRequest,
+			isError:            true,
+		},
+		"名前が空なので400": {
+			reqBody:    openapi.PostGameExternalCreatorRequest{Name: ""},
+			wantStatus: http.StatusBadRequest,
+			isError:    true,
+		},
+		"URLが不正なので400": {
+			reqBody:    openapi.PostGameExternalCreatorRequest{Name: "外部の作曲者", Url: &invalidURL},
+			wantStatus: http.StatusBadRequest,
+			isError:    true,
+		},
+		"ゲームが存在しないので404": {
+			reqBody:       openapi.PostGameExternalCreatorRequest{Name: "外部の作曲者"},
+			executeCreate: true,
+			createErr:     service.ErrInvalidGameID,
+			wantStatus:    http.StatusNotFound,
+			isError:       true,
+		},
+		"CreateExternalGameCreatorがエラーなので500": {
+			reqBody:       openapi.PostGameExternalCreatorRequest{Name: "外部の作曲者"},
+			executeCreate: true,
+			createErr:     assert.AnError,
+			wantStatus:    http.StatusInternalServerError,
+			isError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockGameCreatorService := mock.NewMockGameCreator(ctrl)
+			gc := NewGameCreator(mockGameCreatorService)
+
+			if testCase.executeCreate {
+				var creator *domain.GameCreator
+				if testCase.createErr == nil {
+					creator = domain.NewExternalGameCreator(
+						creatorID,
+						values.NewGameIDFromUUID(gameID),
+						values.NewGameCreatorExternalName(testCase.reqBody.Name),
+						testCase.externalURL,
+						time.Now(),
+					)
+				}
+				mockGameCreatorService.EXPECT().
+					CreateExternalGameCreator(gomock.Any(), values.NewGameIDFromUUID(gameID), values.NewGameCreatorExternalName(testCase.reqBody.Name), testCase.externalURL).
+					Return(creator, testCase.createErr)
+			}
+
+			var bodyOpt bodyOpt
+			if testCase.invalidRequestBody {
+				bodyOpt = withStringBody(t, "invalid request body")
+			} else {
+				bodyOpt = withJSONBody(t, testCase.reqBody)
+			}
+			c, _, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/games/%s/creators/external", gameID), bodyOpt)
+
+			err := gc.PostGameExternalCreator(c, gameID)
+
+			if testCase.isError {
+				var httpError *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpError) {
+					assert.Equal(t, testCase.wantStatus, httpError.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, rec.Code)
+
+			var resBody openapi.GameCreator
+			err = json.NewDecoder(rec.Body).Decode(&resBody)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, resBody)
+		})
+	}
+}
+
+func TestPutGameCreatorsOrder(t *testing.T) {
+	t.Parallel()
+
+	gameID := uuid.New()
+	memberUserID := uuid.New()
+	member := domain.NewGameCreatorWithJobs(
+		domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(memberUserID), values.NewGameIDFromUUID(gameID), values.NewTrapMemberName("ikura-hamu"), time.Now()),
+		nil, nil,
+	)
+	external := domain.NewGameCreatorWithJobs(
+		domain.NewExternalGameCreator(values.NewGameCreatorID(), values.NewGameIDFromUUID(gameID), values.NewGameCreatorExternalName("外部の作曲者"), nil, time.Now()),
+		nil, nil,
+	)
+	creatorIDs := []values.GameCreatorID{external.GetGameCreator().GetID(), member.GetGameCreator().GetID()}
+
+	testCases := map[string]struct {
+		invalidRequestBody bool
+		executeUpdate      bool
+		creators           []*domain.GameCreatorWithJobs
+		updateErr          error
+		wantStatus         int
+		wantBody           []openapi.GameCreator
+		isError            bool
+	}{
+		"特に問題ないのでエラー無し": {
+			executeUpdate: true,
+			creators:      []*domain.GameCreatorWithJobs{external, member},
+			wantStatus:    http.StatusOK,
+			wantBody: []openapi.GameCreator{
+				{Id: uuid.UUID(external.GetGameCreator().GetID()), Name: "外部の作曲者", IsExternal: true, Jobs: []openapi.GameCreatorJob{}},
+				{Id: uuid.UUID(member.GetGameCreator().GetID()), UserID: &memberUserID, Name: "ikura-hamu", IsExternal: false, Jobs: []openapi.GameCreatorJob{}},
+			},
+		},
+		"リクエストボディが不正なので400": {
+			invalidRequestBody: true,
+			wantStatus:         http.StatusBadRequest,
+			isError:            true,
+		},
+		"ゲームが存在しないので404": {
+			executeUpdate: true,
+			updateErr:     service.ErrInvalidGameID,
+			wantStatus:    http.StatusNotFound,
+			isError:       true,
+		},
+		"表示順が不正なので400": {
+			executeUpdate: true,
+			updateErr:     service.ErrInvalidGameCreatorOrder,
+			wantStatus:    http.StatusBadRequest,
+			isError:       true,
+		},
+		"UpdateGameCreatorsOrderがエラーなので500": {
+			executeUpdate: true,
+			updateErr:     assert.AnError,
+			wantStatus:    http.StatusInternalServerError,
+			isError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockGameCreatorService := mock.NewMockGameCreator(ctrl)
+			gc := NewGameCreator(mockGameCreatorService)
+
+			if testCase.executeUpdate {
+				mockGameCreatorService.EXPECT().
+					UpdateGameCreatorsOrder(gomock.Any(), values.NewGameIDFromUUID(gameID), creatorIDs).
+					Return(testCase.creators, testCase.updateErr)
+			}
+
+			var bodyOpt bodyOpt
+			if testCase.invalidRequestBody {
+				bodyOpt = withStringBody(t, "invalid request body")
+			} else {
+				bodyOpt = withJSONBody(t, openapi.PutGameCreatorsOrderRequest{
+					CreatorIDs: []openapi.GameCreatorID{uuid.UUID(creatorIDs[0]), uuid.UUID(creatorIDs[1])},
+				})
+			}
+			c, _, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/games/%s/creators/order", gameID), bodyOpt)
+
+			err := gc.PutGameCreatorsOrder(c, gameID)
+
+			if testCase.isError {
+				var httpError *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpError) {
+					assert.Equal(t, testCase.wantStatus, httpError.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, rec.Code)
+
+			var resBody []openapi.GameCreator
+			err = json.NewDecoder(rec.Body).Decode(&resBody)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, resBody)
+		})
+	}
+}
+
+func TestGetGameCredits(t *testing.T) {
+	t.Parallel()
+
+	strURL := "https://example.com"
+
+	gameID := uuid.New()
+	memberUserID := uuid.New()
+	member := domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(memberUserID), values.NewGameIDFromUUID(gameID), values.NewTrapMemberName("ikura-hamu"), time.Now())
+	external := domain.NewExternalGameCreator(
+		values.NewGameCreatorID(),
+		values.NewGameIDFromUUID(gameID),
+		values.NewGameCreatorExternalName("外部の作曲者"),
+		values.NewGameCreatorExternalURL(&url.URL{Scheme: "https", Host: "example.com"}),
+		time.Now(),
+	)
+	soundJobID := values.NewGameCreatorJobID()
+	voiceJobID := values.NewGameCreatorJobID()
+
+	testCases := map[string]struct {
+		credits    []*domain.GameCredit
+		serviceErr error
+		wantStatus int
+		wantBody   []openapi.GameCredit
+		isError    bool
+	}{
+		"特に問題ないのでエラー無し": {
+			credits: []*domain.GameCredit{
+				domain.NewGameCredit(soundJobID, values.NewGameCreatorJobDisplayName("Sound"), false, []*domain.GameCreator{external, member}),
+				domain.NewGameCredit(voiceJobID, values.NewGameCreatorJobDisplayName("Voice"), true, []*domain.GameCreator{external}),
+			},
+			wantStatus: http.StatusOK,
+			wantBody: []openapi.GameCredit{
+				{
+					Job: openapi.GameCreatorJob{Id: uuid.UUID(soundJobID), DisplayName: "Sound", IsCustomJob: false},
+					Creators: []openapi.GameCreditCreator{
+						{Id: uuid.UUID(external.GetID()), Name: "外部の作曲者", IsExternal: true, Url: &strURL},
+						{Id: uuid.UUID(member.GetID()), UserID: &memberUserID, Name: "ikura-hamu", IsExternal: false},
+					},
+				},
+				{
+					Job: openapi.GameCreatorJob{Id: uuid.UUID(voiceJobID), DisplayName: "Voice", IsCustomJob: true},
+					Creators: []openapi.GameCreditCreator{
+						{Id: uuid.UUID(external.GetID()), Name: "外部の作曲者", IsExternal: true, Url: &strURL},
+					},
+				},
+			},
+		},
+		"クレジットが空でもエラー無し": {
+			credits:    []*domain.GameCredit{},
+			wantStatus: http.StatusOK,
+			wantBody:   []openapi.GameCredit{},
+		},
+		"ゲームが存在しないので404": {
+			serviceErr: service.ErrInvalidGameID,
+			wantStatus: http.StatusNotFound,
+			isError:    true,
+		},
+		"GetGameCreditsがエラーなので500": {
+			serviceErr: assert.AnError,
+			wantStatus: http.StatusInternalServerError,
+			isError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockGameCreatorService := mock.NewMockGameCreator(ctrl)
+			gc := NewGameCreator(mockGameCreatorService)
+
+			mockGameCreatorService.EXPECT().
+				GetGameCredits(gomock.Any(), values.NewGameIDFromUUID(gameID)).
+				Return(testCase.credits, testCase.serviceErr)
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/games/%s/credits", gameID), nil)
+
+			err := gc.GetGameCredits(c, gameID)
+
+			if testCase.isError {
+				var httpError *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpError) {
+					assert.Equal(t, testCase.wantStatus, httpError.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, rec.Code)
+
+			var resBody []openapi.GameCredit
+			err = json.NewDecoder(rec.Body).Decode(&resBody)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, resBody)
+		})
+	}
+}
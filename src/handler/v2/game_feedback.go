@@ -2,12 +2,13 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -32,7 +33,7 @@ func (gf *GameFeedback) GetFeedbackConfig(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusNotFound, "game not found")
 	}
 	if err != nil {
-		log.Printf("error: failed to get feedback config: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get feedback config", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get feedback config")
 	}
 
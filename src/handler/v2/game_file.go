@@ -3,8 +3,9 @@ package v2
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -14,16 +15,19 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
 type GameFile struct {
 	gameFileService service.GameFileV2
+	session         *Session
 }
 
-func NewGameFile(gameFileService service.GameFileV2) *GameFile {
+func NewGameFile(gameFileService service.GameFileV2, session *Session) *GameFile {
 	return &GameFile{
 		gameFileService: gameFileService,
+		session:         session,
 	}
 }
 
@@ -35,7 +39,7 @@ func (gameFile GameFile) GetGameFiles(c echo.Context, gameID openapi.GameIDInPat
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game files: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game files", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game files")
 	}
 
@@ -50,16 +54,23 @@ func (gameFile GameFile) GetGameFiles(c echo.Context, gameID openapi.GameIDInPat
 		case values.GameFileTypeMac:
 			fileType = openapi.Darwin
 		default:
-			log.Printf("error: unknown game file type: %v\n", file.GetFileType())
+			logger.Error(c.Request().Context(), "unknown game file type", slog.Any("game_file_type", file.GetFileType()))
 			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file type")
 		}
 
+		scanStatus, err := convertGameFileScanStatus(file.GetScanStatus())
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to convert game file scan status", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file scan status")
+		}
+
 		resFiles = append(resFiles, openapi.GameFile{
 			Id:         openapi.GameFileID(file.GetID()),
 			Type:       fileType,
 			EntryPoint: string(file.GetEntryPoint()),
 			Md5:        hex.EncodeToString(file.GetHash()),
 			CreatedAt:  file.GetCreatedAt(),
+			ScanStatus: scanStatus,
 		})
 	}
 
@@ -113,8 +124,39 @@ func (gameFile GameFile) PostGameFile(c echo.Context, gameID openapi.GameIDInPat
 		if errors.Is(err, service.ErrInvalidEntryPoint) {
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid entry point")
 		}
+		if errors.Is(err, service.ErrZipTooManyEntries) {
+			return echo.NewHTTPError(http.StatusBadRequest, "too many entries in zip file")
+		}
+		if errors.Is(err, service.ErrZipTooLarge) {
+			return echo.NewHTTPError(http.StatusBadRequest, "uncompressed size of zip file is too large")
+		}
+		var invalidEntriesErr *service.InvalidZipEntriesError
+		if errors.As(err, &invalidEntriesErr) {
+			entries := make([]openapi.GameFileZipEntryError, 0, len(invalidEntriesErr.Entries))
+			for _, entry := range invalidEntriesErr.Entries {
+				reason, err := convertZipEntryErrorReason(entry.Reason)
+				if err != nil {
+					logger.Error(c.Request().Context(), "failed to convert zip entry error reason", slog.Any("error", err))
+					return echo.NewHTTPError(http.StatusInternalServerError, "unknown zip entry error reason")
+				}
+
+				entries = append(entries, openapi.GameFileZipEntryError{
+					Name:   entry.Name,
+					Reason: reason,
+				})
+			}
+
+			// エントリーごとの問題をアップロードした人が確認できるよう、一覧も返す
+			return echo.NewHTTPError(http.StatusBadRequest, openapi.InvalidGameFileError{
+				Message: "invalid entries in zip file",
+				Entries: &entries,
+			})
+		}
+		if errors.Is(err, service.ErrGameStorageQuotaExceeded) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "game storage quota exceeded")
+		}
 		if err != nil {
-			log.Printf("error: failed to save game file: %v\n", err)
+			logger.Error(c.Request().Context(), "failed to save game file", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to save game file")
 		}
 
@@ -140,12 +182,19 @@ func (gameFile GameFile) PostGameFile(c echo.Context, gameID openapi.GameIDInPat
 		return echo.NewHTTPError(http.StatusBadRequest, "no content")
 	}
 
+	scanStatus, err := convertGameFileScanStatus(savedFile.GetScanStatus())
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert game file scan status", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file scan status")
+	}
+
 	return c.JSON(http.StatusCreated, openapi.GameFile{
 		Id:         openapi.GameFileID(savedFile.GetID()),
 		Type:       openapi.GameFileType(headerFileType),
 		EntryPoint: openapi.GameFileEntryPoint(savedFile.GetEntryPoint()),
 		Md5:        openapi.GameFileMd5(hex.EncodeToString(savedFile.GetHash())),
 		CreatedAt:  savedFile.GetCreatedAt(),
+		ScanStatus: scanStatus,
 	})
 }
 
@@ -160,7 +209,7 @@ func (gameFile GameFile) GetGameFile(c echo.Context, gameID openapi.GameIDInPath
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameFileID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game file: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game file", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game file")
 	}
 
@@ -178,7 +227,7 @@ func (gameFile GameFile) GetGameFileMeta(ctx echo.Context, gameID openapi.GameID
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameFileID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game file meta: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game file meta", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game file meta")
 	}
 
@@ -191,15 +240,171 @@ func (gameFile GameFile) GetGameFileMeta(ctx echo.Context, gameID openapi.GameID
 	case values.GameFileTypeMac:
 		fileType = openapi.Darwin
 	default:
-		log.Printf("error: unknown game file type: %v\n", file.GetFileType())
+		logger.Error(ctx.Request().Context(), "unknown game file type", slog.Any("game_file_type", file.GetFileType()))
 		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file type")
 	}
 
+	scanStatus, err := convertGameFileScanStatus(file.GetScanStatus())
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to convert game file scan status", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file scan status")
+	}
+
 	return ctx.JSON(http.StatusOK, openapi.GameFile{
 		Id:         openapi.GameFileID(file.GetID()),
 		Type:       fileType,
 		EntryPoint: openapi.GameFileEntryPoint(file.GetEntryPoint()),
 		Md5:        openapi.GameFileMd5(hex.EncodeToString(file.GetHash())),
 		CreatedAt:  file.GetCreatedAt(),
+		ScanStatus: scanStatus,
+	})
+}
+
+// ゲームファイルの検査の状態の変更
+// (PUT /games/{gameID}/files/{gameFileID}/scan-status)
+func (gameFile GameFile) PutGameFileScanStatus(c echo.Context, gameID openapi.GameIDInPath, gameFileID openapi.GameFileIDInPath) error {
+	session, err := gameFile.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameFile.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var reqBody openapi.PutGameFileScanStatusJSONRequestBody
+	if err := c.Bind(&reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	var scanStatus values.GameFileScanStatus
+	switch reqBody.ScanStatus {
+	case openapi.Clean:
+		scanStatus = values.GameFileScanStatusClean
+	case openapi.Rejected:
+		scanStatus = values.GameFileScanStatusRejected
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid scan status")
+	}
+
+	file, err := gameFile.gameFileService.UpdateGameFileScanStatus(
+		c.Request().Context(),
+		authSession,
+		values.NewGameIDFromUUID(gameID),
+		values.NewGameFileIDFromUUID(gameFileID),
+		scanStatus,
+	)
+	if errors.Is(err, service.ErrInvalidGameFileScanStatus) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid scan status")
+	}
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameFileID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameFileID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to update game file scan status", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game file scan status")
+	}
+
+	return gameFile.gameFileJSON(c, file)
+}
+
+// ゲームファイルの再検査
+// (POST /games/{gameID}/files/{gameFileID}/rescan)
+func (gameFile GameFile) PostGameFileRescan(c echo.Context, gameID openapi.GameIDInPath, gameFileID openapi.GameFileIDInPath) error {
+	session, err := gameFile.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameFile.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	file, err := gameFile.gameFileService.RescanGameFile(
+		c.Request().Context(),
+		authSession,
+		values.NewGameIDFromUUID(gameID),
+		values.NewGameFileIDFromUUID(gameFileID),
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameFileID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameFileID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to rescan game file", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to rescan game file")
+	}
+
+	return gameFile.gameFileJSON(c, file)
+}
+
+// gameFileJSON
+// ゲームファイルのメタ情報をレスポンスとして返す。
+func (GameFile) gameFileJSON(c echo.Context, file *domain.GameFile) error {
+	var fileType openapi.GameFileType
+	switch file.GetFileType() {
+	case values.GameFileTypeJar:
+		fileType = openapi.Jar
+	case values.GameFileTypeWindows:
+		fileType = openapi.Win32
+	case values.GameFileTypeMac:
+		fileType = openapi.Darwin
+	default:
+		logger.Error(c.Request().Context(), "unknown game file type", slog.Any("game_file_type", file.GetFileType()))
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file type")
+	}
+
+	scanStatus, err := convertGameFileScanStatus(file.GetScanStatus())
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert game file scan status", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game file scan status")
+	}
+
+	return c.JSON(http.StatusOK, openapi.GameFile{
+		Id:         openapi.GameFileID(file.GetID()),
+		Type:       fileType,
+		EntryPoint: openapi.GameFileEntryPoint(file.GetEntryPoint()),
+		Md5:        openapi.GameFileMd5(hex.EncodeToString(file.GetHash())),
+		CreatedAt:  file.GetCreatedAt(),
+		ScanStatus: scanStatus,
 	})
 }
+
+func convertGameFileScanStatus(scanStatus values.GameFileScanStatus) (openapi.GameFileScanStatus, error) {
+	switch scanStatus {
+	case values.GameFileScanStatusPending:
+		return openapi.GameFileScanStatusPending, nil
+	case values.GameFileScanStatusClean:
+		return openapi.GameFileScanStatusClean, nil
+	case values.GameFileScanStatusRejected:
+		return openapi.GameFileScanStatusRejected, nil
+	case values.GameFileScanStatusFailed:
+		return openapi.GameFileScanStatusFailed, nil
+	}
+
+	return "", fmt.Errorf("unknown game file scan status: %d", scanStatus)
+}
+
+func convertZipEntryErrorReason(reason service.ZipEntryErrorReason) (openapi.GameFileZipEntryErrorReason, error) {
+	switch reason {
+	case service.ZipEntryErrorReasonAbsolutePath:
+		return openapi.AbsolutePath, nil
+	case service.ZipEntryErrorReasonPathTraversal:
+		return openapi.PathTraversal, nil
+	case service.ZipEntryErrorReasonSymlink:
+		return openapi.Symlink, nil
+	case service.ZipEntryErrorReasonDuplicateName:
+		return openapi.DuplicateName, nil
+	case service.ZipEntryErrorReasonCompressionRatio:
+		return openapi.CompressionRatio, nil
+	}
+
+	return "", fmt.Errorf("unknown zip entry error reason: %d", reason)
+}
@@ -14,8 +14,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/session"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
 	"github.com/traPtitech/trap-collection-server/src/service"
 	"github.com/traPtitech/trap-collection-server/src/service/mock"
@@ -30,7 +33,7 @@ func TestGetGameFiles(t *testing.T) {
 
 	mockGameFileService := mock.NewMockGameFileV2(ctrl)
 
-	gameFile := NewGameFile(mockGameFileService)
+	gameFile := NewGameFile(mockGameFileService, nil)
 
 	type test struct {
 		description     string
@@ -73,6 +76,7 @@ func TestGetGameFiles(t *testing.T) {
 					Md5:        hex.EncodeToString(md5Hash),
 					Type:       openapi.Jar,
 					CreatedAt:  now,
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 			},
 		},
@@ -95,6 +99,7 @@ func TestGetGameFiles(t *testing.T) {
 					Md5:        hex.EncodeToString(md5Hash),
 					Type:       openapi.Win32,
 					CreatedAt:  now,
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 			},
 		},
@@ -117,6 +122,7 @@ func TestGetGameFiles(t *testing.T) {
 					Md5:        hex.EncodeToString(md5Hash),
 					Type:       openapi.Darwin,
 					CreatedAt:  now,
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 			},
 		},
@@ -181,6 +187,7 @@ func TestGetGameFiles(t *testing.T) {
 					EntryPoint: string("path/to/file"),
 					Md5:        hex.EncodeToString(md5Hash),
 					CreatedAt:  now,
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 				{
 					Id:         uuid.UUID(gameFileID5),
@@ -188,6 +195,7 @@ func TestGetGameFiles(t *testing.T) {
 					EntryPoint: string("path/to/file2"),
 					Md5:        hex.EncodeToString(md5Hash),
 					CreatedAt:  now.Add(-10 * time.Hour),
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 			},
 		},
@@ -210,6 +218,7 @@ func TestGetGameFiles(t *testing.T) {
 					Md5:        hex.EncodeToString(md5Hash2),
 					Type:       openapi.Jar,
 					CreatedAt:  now,
+					ScanStatus: openapi.GameFileScanStatusPending,
 				},
 			},
 		},
@@ -259,6 +268,7 @@ func TestGetGameFiles(t *testing.T) {
 				assert.Equal(t, testCase.resFiles[i].Type, resFile.Type)
 				assert.Equal(t, testCase.resFiles[i].EntryPoint, resFile.EntryPoint)
 				assert.Equal(t, testCase.resFiles[i].Md5, resFile.Md5)
+				assert.Equal(t, testCase.resFiles[i].ScanStatus, resFile.ScanStatus)
 				assert.WithinDuration(t, testCase.resFiles[i].CreatedAt, resFile.CreatedAt, time.Second)
 			}
 		})
@@ -273,7 +283,7 @@ func TestPostGameFile(t *testing.T) {
 
 	mockGameFileService := mock.NewMockGameFileV2(ctrl)
 
-	gameFile := NewGameFile(mockGameFileService)
+	gameFile := NewGameFile(mockGameFileService, nil)
 
 	type test struct {
 		description         string
@@ -289,6 +299,9 @@ func TestPostGameFile(t *testing.T) {
 		isErr               bool
 		err                 error
 		statusCode          int
+		// resErrMessage
+		// エラー時のレスポンスに、文字列以外のメッセージが入る場合のみ設定する
+		resErrMessage any
 	}
 
 	gameFileID1 := values.NewGameFileID()
@@ -318,6 +331,7 @@ func TestPostGameFile(t *testing.T) {
 				EntryPoint: string("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -339,6 +353,7 @@ func TestPostGameFile(t *testing.T) {
 				EntryPoint: string("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -360,6 +375,7 @@ func TestPostGameFile(t *testing.T) {
 				EntryPoint: string("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -409,6 +425,78 @@ func TestPostGameFile(t *testing.T) {
 			isErr:               true,
 			statusCode:          http.StatusBadRequest,
 		},
+		{
+			description:         "SaveGameFileがErrZipTooManyEntriesなので400",
+			fileType:            openapi.Jar,
+			gameID:              uuid.UUID(values.NewGameID()),
+			reader:              bytes.NewReader([]byte("test")),
+			executeSaveGameFile: true,
+			saveGameFileErr:     service.ErrZipTooManyEntries,
+			isErr:               true,
+			statusCode:          http.StatusBadRequest,
+		},
+		{
+			description:         "SaveGameFileがErrZipTooLargeなので400",
+			fileType:            openapi.Jar,
+			gameID:              uuid.UUID(values.NewGameID()),
+			reader:              bytes.NewReader([]byte("test")),
+			executeSaveGameFile: true,
+			saveGameFileErr:     service.ErrZipTooLarge,
+			isErr:               true,
+			statusCode:          http.StatusBadRequest,
+		},
+		{
+			description:         "SaveGameFileがInvalidZipEntriesErrorなので、エントリーの一覧付きで400",
+			fileType:            openapi.Jar,
+			gameID:              uuid.UUID(values.NewGameID()),
+			reader:              bytes.NewReader([]byte("test")),
+			executeSaveGameFile: true,
+			saveGameFileErr: fmt.Errorf("failed in transaction: %w", &service.InvalidZipEntriesError{
+				Entries: []*service.ZipEntryError{
+					{Name: "/a", Reason: service.ZipEntryErrorReasonAbsolutePath},
+					{Name: "../b", Reason: service.ZipEntryErrorReasonPathTraversal},
+					{Name: "c", Reason: service.ZipEntryErrorReasonSymlink},
+					{Name: "d", Reason: service.ZipEntryErrorReasonDuplicateName},
+					{Name: "e", Reason: service.ZipEntryErrorReasonCompressionRatio},
+				},
+			}),
+			isErr:      true,
+			statusCode: http.StatusBadRequest,
+			resErrMessage: openapi.InvalidGameFileError{
+				Message: "invalid entries in zip file",
+				Entries: &[]openapi.GameFileZipEntryError{
+					{Name: "/a", Reason: openapi.AbsolutePath},
+					{Name: "../b", Reason: openapi.PathTraversal},
+					{Name: "c", Reason: openapi.Symlink},
+					{Name: "d", Reason: openapi.DuplicateName},
+					{Name: "e", Reason: openapi.CompressionRatio},
+				},
+			},
+		},
+		{
+			description:         "InvalidZipEntriesErrorの理由が不明なので500",
+			fileType:            openapi.Jar,
+			gameID:              uuid.UUID(values.NewGameID()),
+			reader:              bytes.NewReader([]byte("test")),
+			executeSaveGameFile: true,
+			saveGameFileErr: &service.InvalidZipEntriesError{
+				Entries: []*service.ZipEntryError{
+					{Name: "a", Reason: 100},
+				},
+			},
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			description:         "SaveGameFileがErrGameStorageQuotaExceededなので413",
+			fileType:            openapi.Jar,
+			gameID:              uuid.UUID(values.NewGameID()),
+			reader:              bytes.NewReader([]byte("test")),
+			executeSaveGameFile: true,
+			saveGameFileErr:     service.ErrGameStorageQuotaExceeded,
+			isErr:               true,
+			statusCode:          http.StatusRequestEntityTooLarge,
+		},
 		{
 			description:         "SaveGameFileがエラーなので500",
 			fileType:            openapi.Jar,
@@ -488,6 +576,9 @@ func TestPostGameFile(t *testing.T) {
 					var httpError *echo.HTTPError
 					if errors.As(err, &httpError) {
 						assert.Equal(t, testCase.statusCode, httpError.Code)
+						if testCase.resErrMessage != nil {
+							assert.Equal(t, testCase.resErrMessage, httpError.Message)
+						}
 					} else {
 						t.Errorf("error is not *echo.HTTPError")
 					}
@@ -514,6 +605,7 @@ func TestPostGameFile(t *testing.T) {
 			assert.Equal(t, testCase.resFile.Type, resFile.Type)
 			assert.Equal(t, testCase.resFile.EntryPoint, resFile.EntryPoint)
 			assert.Equal(t, testCase.resFile.Md5, resFile.Md5)
+			assert.Equal(t, testCase.resFile.ScanStatus, resFile.ScanStatus)
 			assert.WithinDuration(t, testCase.resFile.CreatedAt, resFile.CreatedAt, time.Second)
 		})
 	}
@@ -527,7 +619,7 @@ func TestGetGameFile(t *testing.T) {
 
 	mockGameFileService := mock.NewMockGameFileV2(ctrl)
 
-	gameFile := NewGameFile(mockGameFileService)
+	gameFile := NewGameFile(mockGameFileService, nil)
 
 	type test struct {
 		description    string
@@ -626,7 +718,7 @@ func TestGetGameFileMeta(t *testing.T) {
 
 	mockGameFileService := mock.NewMockGameFileV2(ctrl)
 
-	gameFile := NewGameFile(mockGameFileService)
+	gameFile := NewGameFile(mockGameFileService, nil)
 
 	type test struct {
 		description        string
@@ -664,6 +756,7 @@ func TestGetGameFileMeta(t *testing.T) {
 				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -682,6 +775,7 @@ func TestGetGameFileMeta(t *testing.T) {
 				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -700,6 +794,7 @@ func TestGetGameFileMeta(t *testing.T) {
 				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
 				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
 				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
 			},
 		},
 		{
@@ -780,6 +875,331 @@ func TestGetGameFileMeta(t *testing.T) {
 			assert.Equal(t, testCase.resFile.Type, resFile.Type)
 			assert.Equal(t, testCase.resFile.EntryPoint, resFile.EntryPoint)
 			assert.Equal(t, testCase.resFile.Md5, resFile.Md5)
+			assert.Equal(t, testCase.resFile.ScanStatus, resFile.ScanStatus)
+			assert.WithinDuration(t, testCase.resFile.CreatedAt, resFile.CreatedAt, time.Second)
+		})
+	}
+}
+
+func newGameFileHandlerWithSessionForTest(t *testing.T, ctrl *gomock.Controller, gameFileService service.GameFileV2) (*GameFile, *Session) {
+	t.Helper()
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	require.NoError(t, err)
+	session, err := NewSession(sess)
+	require.NoError(t, err)
+
+	return NewGameFile(gameFileService, session), session
+}
+
+func TestPutGameFileScanStatus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameFileService := mock.NewMockGameFileV2(ctrl)
+	gameFileHandler, sess := newGameFileHandlerWithSessionForTest(t, ctrl, mockGameFileService)
+
+	gameFileID := values.NewGameFileID()
+	md5Hash := values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6})
+	now := time.Now()
+
+	newFile := func(scanStatus values.GameFileScanStatus) *domain.GameFile {
+		file := domain.NewGameFile(
+			gameFileID,
+			values.GameFileTypeJar,
+			values.NewGameFileEntryPoint("path/to/file"),
+			md5Hash,
+			now,
+		)
+		file.SetScanStatus(scanStatus)
+		return file
+	}
+
+	type test struct {
+		description   string
+		sessionExist  bool
+		reqBody       *openapi.PutGameFileScanStatusJSONRequestBody
+		executeUpdate bool
+		scanStatus    values.GameFileScanStatus
+		file          *domain.GameFile
+		updateErr     error
+		resFile       openapi.GameFile
+		isErr         bool
+		statusCode    int
+	}
+
+	testCases := []test{
+		{
+			description:   "cleanにしてもエラーなし",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusClean,
+			file:          newFile(values.GameFileScanStatusClean),
+			resFile: openapi.GameFile{
+				Id:         uuid.UUID(gameFileID),
+				Type:       openapi.Jar,
+				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
+				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
+				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusClean,
+			},
+		},
+		{
+			description:   "rejectedにしてもエラーなし",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Rejected},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusRejected,
+			file:          newFile(values.GameFileScanStatusRejected),
+			resFile: openapi.GameFile{
+				Id:         uuid.UUID(gameFileID),
+				Type:       openapi.Jar,
+				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
+				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
+				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusRejected,
+			},
+		},
+		{
+			description: "セッションが無いので401",
+			reqBody:     &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			isErr:       true,
+			statusCode:  http.StatusUnauthorized,
+		},
+		{
+			description:  "リクエストボディがおかしいので400",
+			sessionExist: true,
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			description:  "scanStatusが不正なので400",
+			sessionExist: true,
+			reqBody:      &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: "pending"},
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
+		},
+		{
+			description:   "UpdateGameFileScanStatusがErrInvalidGameFileScanStatusなので400",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusClean,
+			updateErr:     service.ErrInvalidGameFileScanStatus,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		{
+			description:   "UpdateGameFileScanStatusがErrInvalidGameIDなので404",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusClean,
+			updateErr:     service.ErrInvalidGameID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		{
+			description:   "UpdateGameFileScanStatusがErrInvalidGameFileIDなので404",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusClean,
+			updateErr:     service.ErrInvalidGameFileID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		{
+			description:   "UpdateGameFileScanStatusがエラーなので500",
+			sessionExist:  true,
+			reqBody:       &openapi.PutGameFileScanStatusJSONRequestBody{ScanStatus: openapi.Clean},
+			executeUpdate: true,
+			scanStatus:    values.GameFileScanStatusClean,
+			updateErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			gameID := uuid.New()
+
+			var opt bodyOpt
+			if testCase.reqBody != nil {
+				opt = withJSONBody(t, *testCase.reqBody)
+			} else {
+				opt = withStringBody(t, "invalid request body")
+			}
+
+			c, req, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/api/v2/games/%s/files/%s/scan-status", gameID, uuid.UUID(gameFileID)), opt)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, sess, authSession)
+			}
+
+			if testCase.executeUpdate {
+				mockGameFileService.
+					EXPECT().
+					UpdateGameFileScanStatus(gomock.Any(), gomock.Any(), values.NewGameIDFromUUID(gameID), gameFileID, testCase.scanStatus).
+					Return(testCase.file, testCase.updateErr)
+			}
+
+			err := gameFileHandler.PutGameFileScanStatus(c, gameID, uuid.UUID(gameFileID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resFile openapi.GameFile
+			err = json.NewDecoder(rec.Body).Decode(&resFile)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resFile.Id, resFile.Id)
+			assert.Equal(t, testCase.resFile.Type, resFile.Type)
+			assert.Equal(t, testCase.resFile.EntryPoint, resFile.EntryPoint)
+			assert.Equal(t, testCase.resFile.Md5, resFile.Md5)
+			assert.Equal(t, testCase.resFile.ScanStatus, resFile.ScanStatus)
+			assert.WithinDuration(t, testCase.resFile.CreatedAt, resFile.CreatedAt, time.Second)
+		})
+	}
+}
+
+func TestPostGameFileRescan(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameFileService := mock.NewMockGameFileV2(ctrl)
+	gameFileHandler, sess := newGameFileHandlerWithSessionForTest(t, ctrl, mockGameFileService)
+
+	gameFileID := values.NewGameFileID()
+	md5Hash := values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6})
+	now := time.Now()
+
+	type test struct {
+		description   string
+		sessionExist  bool
+		executeRescan bool
+		file          *domain.GameFile
+		rescanErr     error
+		resFile       openapi.GameFile
+		isErr         bool
+		statusCode    int
+	}
+
+	testCases := []test{
+		{
+			description:   "特に問題ないのでエラーなし",
+			sessionExist:  true,
+			executeRescan: true,
+			file: domain.NewGameFile(
+				gameFileID,
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("path/to/file"),
+				md5Hash,
+				now,
+			),
+			resFile: openapi.GameFile{
+				Id:         uuid.UUID(gameFileID),
+				Type:       openapi.Jar,
+				EntryPoint: openapi.GameFileEntryPoint("path/to/file"),
+				Md5:        openapi.GameFileMd5(hex.EncodeToString(md5Hash)),
+				CreatedAt:  now,
+				ScanStatus: openapi.GameFileScanStatusPending,
+			},
+		},
+		{
+			description: "セッションが無いので401",
+			isErr:       true,
+			statusCode:  http.StatusUnauthorized,
+		},
+		{
+			description:   "RescanGameFileがErrInvalidGameIDなので404",
+			sessionExist:  true,
+			executeRescan: true,
+			rescanErr:     service.ErrInvalidGameID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		{
+			description:   "RescanGameFileがErrInvalidGameFileIDなので404",
+			sessionExist:  true,
+			executeRescan: true,
+			rescanErr:     service.ErrInvalidGameFileID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		{
+			description:   "RescanGameFileがエラーなので500",
+			sessionExist:  true,
+			executeRescan: true,
+			rescanErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			gameID := uuid.New()
+
+			c, req, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/games/%s/files/%s/rescan", gameID, uuid.UUID(gameFileID)), nil)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, sess, authSession)
+			}
+
+			if testCase.executeRescan {
+				mockGameFileService.
+					EXPECT().
+					RescanGameFile(gomock.Any(), gomock.Any(), values.NewGameIDFromUUID(gameID), gameFileID).
+					Return(testCase.file, testCase.rescanErr)
+			}
+
+			err := gameFileHandler.PostGameFileRescan(c, gameID, uuid.UUID(gameFileID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resFile openapi.GameFile
+			err = json.NewDecoder(rec.Body).Decode(&resFile)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resFile.Id, resFile.Id)
+			assert.Equal(t, testCase.resFile.ScanStatus, resFile.ScanStatus)
 			assert.WithinDuration(t, testCase.resFile.CreatedAt, resFile.CreatedAt, time.Second)
 		})
 	}
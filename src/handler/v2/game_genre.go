@@ -2,13 +2,15 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -34,7 +36,7 @@ func (gameGenre *GameGenre) DeleteGameGenre(c echo.Context, gameGenreID openapi.
 		return echo.NewHTTPError(http.StatusNotFound, "invalid game genre ID")
 	}
 	if err != nil {
-		log.Printf("error: failed to delete game genre: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to delete game genre", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete game genre")
 	}
 
@@ -55,18 +57,13 @@ func (gameGenre *GameGenre) GetGameGenres(ctx echo.Context) error {
 
 	gameGenreInfos, err := gameGenre.gameGenreService.GetGameGenres(ctx.Request().Context(), isLoginUser)
 	if err != nil {
-		log.Printf("error: failed to get game genres: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game genres", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game genres")
 	}
 
 	gameGenresResponse := make([]openapi.GameGenre, len(gameGenreInfos))
 	for i := range gameGenreInfos {
-		gameGenresResponse[i] = openapi.GameGenre{
-			Id:        uuid.UUID(gameGenreInfos[i].GetID()),
-			Genre:     string(gameGenreInfos[i].GetName()),
-			Num:       gameGenreInfos[i].Num,
-			CreatedAt: gameGenreInfos[i].GetCreatedAt(),
-		}
+		gameGenresResponse[i] = convertGameGenreInfo(gameGenreInfos[i])
 	}
 
 	return ctx.JSON(http.StatusOK, gameGenresResponse)
@@ -77,7 +74,7 @@ func (gameGenre *GameGenre) GetGameGenres(ctx echo.Context) error {
 func (gameGenre *GameGenre) PutGameGenres(c echo.Context, gameID openapi.GameIDInPath) error {
 	session, err := gameGenre.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
 	}
 	authSession, err := gameGenre.session.getAuthSession(session)
@@ -114,13 +111,13 @@ func (gameGenre *GameGenre) PutGameGenres(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusBadRequest, "duplicate game genre")
 	}
 	if err != nil {
-		log.Printf("error: failed to update game genres: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to update game genres", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game genres")
 	}
 
 	gameInfo, err := gameGenre.game.GetGame(c.Request().Context(), authSession, values.NewGameIDFromUUID(gameID))
 	if err != nil {
-		log.Printf("error: failed to get game: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game")
 	}
 
@@ -185,7 +182,7 @@ func (gameGenre *GameGenre) PatchGameGenre(c echo.Context, gameGenreID openapi.G
 		if errors.Is(err, values.ErrGameGenreNameTooLong) {
 			return echo.NewHTTPError(http.StatusBadRequest, "genre name is too long")
 		}
-		log.Printf("failed to validate genre name: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to validate genre name", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate genre name")
 	}
 
@@ -202,10 +199,169 @@ func (gameGenre *GameGenre) PatchGameGenre(c echo.Context, gameGenreID openapi.G
 		return echo.NewHTTPError(http.StatusBadRequest, "no game genre updated")
 	}
 	if err != nil {
-		log.Printf("error: failed to update game genre: %v\n", err)
+		logger.Error(ctx, "failed to update game genre", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game genre")
 	}
 
+	return c.JSON(http.StatusOK, convertGameGenreInfo(gameGenreInfo))
+}
+
+// ジャンルの統合
+// (POST /genres/{gameGenreID}/merge)
+func (gameGenre *GameGenre) MergeGameGenre(c echo.Context, gameGenreID openapi.GameGenreIDInPath) error {
+	session, err := gameGenre.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameGenre.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var reqBody openapi.MergeGameGenreJSONRequestBody
+	if err := c.Bind(&reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	gameGenreInfo, err := gameGenre.gameGenreService.MergeGameGenres(
+		c.Request().Context(),
+		authSession,
+		values.GameGenreIDFromUUID(gameGenreID),
+		values.GameGenreIDFromUUID(reqBody.Target),
+	)
+	if errors.Is(err, service.ErrNoGameGenre) {
+		return echo.NewHTTPError(http.StatusNotFound, "game genre not found")
+	}
+	if errors.Is(err, service.ErrCannotMergeSameGameGenre) {
+		return echo.NewHTTPError(http.StatusBadRequest, "cannot merge game genre into itself")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to merge game genres", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to merge game genres")
+	}
+
+	return c.JSON(http.StatusOK, convertGameGenreInfo(gameGenreInfo))
+}
+
+// 親ジャンルの変更
+// (PUT /genres/{gameGenreID}/parent)
+func (gameGenre *GameGenre) PutGameGenreParent(c echo.Context, gameGenreID openapi.GameGenreIDInPath) error {
+	session, err := gameGenre.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameGenre.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var reqBody openapi.PutGameGenreParentJSONRequestBody
+	if err := c.Bind(&reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	var parentID *values.GameGenreID
+	if reqBody.Parent != nil {
+		id := values.GameGenreIDFromUUID(*reqBody.Parent)
+		parentID = &id
+	}
+
+	gameGenreInfo, err := gameGenre.gameGenreService.UpdateGameGenreParent(c.Request().Context(), authSession, values.GameGenreIDFromUUID(gameGenreID), parentID)
+	if errors.Is(err, service.ErrNoGameGenre) {
+		return echo.NewHTTPError(http.StatusNotFound, "game genre not found")
+	}
+	if errors.Is(err, service.ErrInvalidGameGenreParent) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid parent genre")
+	}
+	if errors.Is(err, service.ErrNoGameGenreUpdated) {
+		return echo.NewHTTPError(http.StatusBadRequest, "no game genre updated")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to update game genre parent", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game genre parent")
+	}
+
+	return c.JSON(http.StatusOK, convertGameGenreInfo(gameGenreInfo))
+}
+
+// ジャンルの別名の追加
+// (POST /genres/{gameGenreID}/aliases)
+func (gameGenre *GameGenre) PostGameGenreAlias(c echo.Context, gameGenreID openapi.GameGenreIDInPath) error {
+	session, err := gameGenre.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameGenre.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var reqBody openapi.PostGameGenreAliasJSONRequestBody
+	if err := c.Bind(&reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	aliasName := values.NewGameGenreName(reqBody.Alias)
+	if err := aliasName.Validate(); err != nil {
+		if errors.Is(err, values.ErrGameGenreNameEmpty) {
+			return echo.NewHTTPError(http.StatusBadRequest, "alias must not be empty")
+		}
+		if errors.Is(err, values.ErrGameGenreNameTooLong) {
+			return echo.NewHTTPError(http.StatusBadRequest, "alias is too long")
+		}
+		logger.Error(c.Request().Context(), "failed to validate alias", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate alias")
+	}
+
+	alias, err := gameGenre.gameGenreService.AddGameGenreAlias(c.Request().Context(), authSession, values.GameGenreIDFromUUID(gameGenreID), aliasName)
+	if errors.Is(err, service.ErrNoGameGenre) {
+		return echo.NewHTTPError(http.StatusNotFound, "game genre not found")
+	}
+	if errors.Is(err, service.ErrDuplicateGameGenreName) {
+		return echo.NewHTTPError(http.StatusBadRequest, "duplicate genre name")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to add game genre alias", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to add game genre alias")
+	}
+
+	return c.JSON(http.StatusCreated, convertGameGenreAlias(alias))
+}
+
+// ジャンルの別名の削除
+// (DELETE /genres/{gameGenreID}/aliases/{gameGenreAliasID})
+func (gameGenre *GameGenre) DeleteGameGenreAlias(c echo.Context, gameGenreID openapi.GameGenreIDInPath, gameGenreAliasID openapi.GameGenreAliasIDInPath) error {
+	session, err := gameGenre.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameGenre.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	err = gameGenre.gameGenreService.DeleteGameGenreAlias(
+		c.Request().Context(),
+		authSession,
+		values.GameGenreIDFromUUID(gameGenreID),
+		values.NewGameGenreAliasIDFromUUID(gameGenreAliasID),
+	)
+	if errors.Is(err, service.ErrNoGameGenreAlias) {
+		return echo.NewHTTPError(http.StatusNotFound, "game genre alias not found")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to delete game genre alias", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete game genre alias")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func convertGameGenreInfo(gameGenreInfo *service.GameGenreInfo) openapi.GameGenre {
 	res := openapi.GameGenre{
 		Id:        uuid.UUID(gameGenreInfo.GetID()),
 		Genre:     string(gameGenreInfo.GetName()),
@@ -213,5 +369,27 @@ func (gameGenre *GameGenre) PatchGameGenre(c echo.Context, gameGenreID openapi.G
 		CreatedAt: gameGenreInfo.GetCreatedAt(),
 	}
 
-	return c.JSON(http.StatusOK, res)
+	if gameGenreInfo.ParentID != nil {
+		parentID := uuid.UUID(*gameGenreInfo.ParentID)
+		res.Parent = &parentID
+	}
+
+	if gameGenreInfo.Aliases != nil {
+		aliases := make([]openapi.GameGenreAlias, 0, len(gameGenreInfo.Aliases))
+		for _, alias := range gameGenreInfo.Aliases {
+			aliases = append(aliases, convertGameGenreAlias(alias))
+		}
+		res.Aliases = &aliases
+	}
+
+	return res
+}
+
+func convertGameGenreAlias(alias *domain.GameGenreAlias) openapi.GameGenreAlias {
+	return openapi.GameGenreAlias{
+		Id:        uuid.UUID(alias.GetID()),
+		GenreId:   uuid.UUID(alias.GetGenreID()),
+		Name:      string(alias.GetName()),
+		CreatedAt: alias.GetCreatedAt(),
+	}
 }
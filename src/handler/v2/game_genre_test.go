@@ -168,6 +168,8 @@ func TestGetGameGenres(t *testing.T) {
 	gameGenreNameStr2 := "ジャンル2"
 	gameGenreName2 := values.NewGameGenreName(gameGenreNameStr2)
 
+	aliasID := values.NewGameGenreAliasID()
+
 	now := time.Now()
 
 	testCases := map[string]test{
@@ -202,6 +204,27 @@ func TestGetGameGenres(t *testing.T) {
 			},
 			statusCode: http.StatusOK,
 		},
+		"親ジャンルと別名があってもエラー無し": {
+			gameGenreInfos: []*service.GameGenreInfo{
+				{
+					GameGenre: *domain.NewGameGenre(gameGenreID1, gameGenreName1, now.Add(-time.Hour)),
+					Num:       2,
+					Aliases:   []*domain.GameGenreAlias{domain.NewGameGenreAlias(aliasID, gameGenreID1, "別名", now)},
+				},
+				{GameGenre: *domain.NewGameGenre(gameGenreID2, gameGenreName2, now.Add(-time.Hour*2)), Num: 3, ParentID: &gameGenreID1},
+			},
+			apiGameGenres: []openapi.GameGenre{
+				{
+					Id:        gameGenreUUID1,
+					Genre:     gameGenreNameStr1,
+					CreatedAt: now.Add(-time.Hour),
+					Num:       2,
+					Aliases:   &[]openapi.GameGenreAlias{{Id: uuid.UUID(aliasID), GenreId: gameGenreUUID1, Name: "別名", CreatedAt: now}},
+				},
+				{Id: gameGenreUUID2, Genre: gameGenreNameStr2, CreatedAt: now.Add(-time.Hour * 2), Num: 3, Parent: &gameGenreUUID1},
+			},
+			statusCode: http.StatusOK,
+		},
 		"GetGameGenresがエラーなので500": {
 			GetGameGenresErr: errors.New("test error"),
 			isErr:            true,
@@ -259,6 +282,22 @@ func TestGetGameGenres(t *testing.T) {
 				assert.Equal(t, testCase.apiGameGenres[i].Genre, res[i].Genre)
 				assert.Equal(t, testCase.apiGameGenres[i].Num, res[i].Num)
 				assert.WithinDuration(t, testCase.apiGameGenres[i].CreatedAt, res[i].CreatedAt, time.Second)
+				assert.Equal(t, testCase.apiGameGenres[i].Parent, res[i].Parent)
+				if testCase.apiGameGenres[i].Aliases == nil {
+					assert.Nil(t, res[i].Aliases)
+					continue
+				}
+				if assert.NotNil(t, res[i].Aliases) {
+					assert.Len(t, *res[i].Aliases, len(*testCase.apiGameGenres[i].Aliases))
+					for j := range *res[i].Aliases {
+						expected := (*testCase.apiGameGenres[i].Aliases)[j]
+						actual := (*res[i].Aliases)[j]
+						assert.Equal(t, expected.Id, actual.Id)
+						assert.Equal(t, expected.GenreId, actual.GenreId)
+						assert.Equal(t, expected.Name, actual.Name)
+						assert.WithinDuration(t, expected.CreatedAt, actual.CreatedAt, time.Second)
+					}
+				}
 			}
 		})
 	}
@@ -715,3 +754,595 @@ func TestPatchGameGenre(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeGameGenre(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameGenreService := mock.NewMockGameGenre(ctrl)
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+	session, err := NewSession(sess)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+
+	gameGenreHandler := NewGameGenre(mockGameGenreService, mock.NewMockGameV2(ctrl), session)
+
+	sourceID := uuid.New()
+	targetID := uuid.New()
+	aliasID := values.NewGameGenreAliasID()
+	now := time.Now()
+
+	testCases := map[string]struct {
+		sessionExist       bool
+		invalidRequestBody bool
+		executeMerge       bool
+		gameGenre          *service.GameGenreInfo
+		MergeGameGenresErr error
+		resBody            openapi.GameGenre
+		isErr              bool
+		statusCode         int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist: true,
+			executeMerge: true,
+			gameGenre: &service.GameGenreInfo{
+				GameGenre: *domain.NewGameGenre(values.GameGenreIDFromUUID(targetID), "3D", now),
+				Num:       3,
+				Aliases: []*domain.GameGenreAlias{
+					domain.NewGameGenreAlias(aliasID, values.GameGenreIDFromUUID(targetID), "3DCG", now),
+				},
+			},
+			resBody: openapi.GameGenre{
+				Id:        targetID,
+				Genre:     "3D",
+				Num:       3,
+				CreatedAt: now,
+				Aliases: &[]openapi.GameGenreAlias{
+					{Id: uuid.UUID(aliasID), GenreId: targetID, Name: "3DCG", CreatedAt: now},
+				},
+			},
+			statusCode: http.StatusOK,
+		},
+		"セッションが無いので401": {
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"リクエストボディがおかしいので400": {
+			sessionExist:       true,
+			invalidRequestBody: true,
+			isErr:              true,
+			statusCode:         http.StatusBadRequest,
+		},
+		"MergeGameGenresがErrNoGameGenreなので404": {
+			sessionExist:       true,
+			executeMerge:       true,
+			MergeGameGenresErr: service.ErrNoGameGenre,
+			isErr:              true,
+			statusCode:         http.StatusNotFound,
+		},
+		"MergeGameGenresがErrCannotMergeSameGameGenreなので400": {
+			sessionExist:       true,
+			executeMerge:       true,
+			MergeGameGenresErr: service.ErrCannotMergeSameGameGenre,
+			isErr:              true,
+			statusCode:         http.StatusBadRequest,
+		},
+		"MergeGameGenresがエラーなので500": {
+			sessionExist:       true,
+			executeMerge:       true,
+			MergeGameGenresErr: errors.New("test error"),
+			isErr:              true,
+			statusCode:         http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var bodyOpt bodyOpt
+			if !testCase.invalidRequestBody {
+				bodyOpt = withJSONBody(t, openapi.MergeGameGenreJSONRequestBody{Target: targetID})
+			} else {
+				bodyOpt = withStringBody(t, "invalid request body")
+			}
+
+			c, req, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/genres/%s/merge", sourceID), bodyOpt)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeMerge {
+				mockGameGenreService.
+					EXPECT().
+					MergeGameGenres(gomock.Any(), gomock.Any(), values.GameGenreIDFromUUID(sourceID), values.GameGenreIDFromUUID(targetID)).
+					Return(testCase.gameGenre, testCase.MergeGameGenresErr)
+			}
+
+			err := gameGenreHandler.MergeGameGenre(c, sourceID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameGenre
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody.Id, res.Id)
+			assert.Equal(t, testCase.resBody.Genre, res.Genre)
+			assert.Equal(t, testCase.resBody.Num, res.Num)
+			assert.WithinDuration(t, testCase.resBody.CreatedAt, res.CreatedAt, time.Second)
+			assert.Nil(t, res.Parent)
+			require.NotNil(t, res.Aliases)
+			assert.Len(t, *res.Aliases, len(*testCase.resBody.Aliases))
+			for i := range *res.Aliases {
+				assert.Equal(t, (*testCase.resBody.Aliases)[i].Id, (*res.Aliases)[i].Id)
+				assert.Equal(t, (*testCase.resBody.Aliases)[i].Name, (*res.Aliases)[i].Name)
+			}
+		})
+	}
+}
+
+func TestPutGameGenreParent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameGenreService := mock.NewMockGameGenre(ctrl)
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+	session, err := NewSession(sess)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+
+	gameGenreHandler := NewGameGenre(mockGameGenreService, mock.NewMockGameV2(ctrl), session)
+
+	gameGenreID := uuid.New()
+	parentUUID := uuid.New()
+	parentID := values.GameGenreIDFromUUID(parentUUID)
+	now := time.Now()
+
+	testCases := map[string]struct {
+		sessionExist             bool
+		req                      openapi.PutGameGenreParentJSONRequestBody
+		invalidRequestBody       bool
+		executeUpdateParent      bool
+		parentID                 *values.GameGenreID
+		gameGenre                *service.GameGenreInfo
+		UpdateGameGenreParentErr error
+		resBody                  openapi.GameGenre
+		isErr                    bool
+		statusCode               int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:        true,
+			req:                 openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			executeUpdateParent: true,
+			parentID:            &parentID,
+			gameGenre: &service.GameGenreInfo{
+				GameGenre: *domain.NewGameGenre(values.GameGenreIDFromUUID(gameGenreID), "3D", now),
+				Num:       1,
+				ParentID:  &parentID,
+				Aliases:   []*domain.GameGenreAlias{},
+			},
+			resBody: openapi.GameGenre{
+				Id:        gameGenreID,
+				Genre:     "3D",
+				Num:       1,
+				CreatedAt: now,
+				Parent:    &parentUUID,
+			},
+			statusCode: http.StatusOK,
+		},
+		"親ジャンル無しにしてもエラー無し": {
+			sessionExist:        true,
+			req:                 openapi.PutGameGenreParentJSONRequestBody{},
+			executeUpdateParent: true,
+			gameGenre: &service.GameGenreInfo{
+				GameGenre: *domain.NewGameGenre(values.GameGenreIDFromUUID(gameGenreID), "3D", now),
+				Num:       1,
+				Aliases:   []*domain.GameGenreAlias{},
+			},
+			resBody: openapi.GameGenre{
+				Id:        gameGenreID,
+				Genre:     "3D",
+				Num:       1,
+				CreatedAt: now,
+			},
+			statusCode: http.StatusOK,
+		},
+		"セッションが無いので401": {
+			req:        openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"リクエストボディがおかしいので400": {
+			sessionExist:       true,
+			invalidRequestBody: true,
+			isErr:              true,
+			statusCode:         http.StatusBadRequest,
+		},
+		"UpdateGameGenreParentがErrNoGameGenreなので404": {
+			sessionExist:             true,
+			req:                      openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			executeUpdateParent:      true,
+			parentID:                 &parentID,
+			UpdateGameGenreParentErr: service.ErrNoGameGenre,
+			isErr:                    true,
+			statusCode:               http.StatusNotFound,
+		},
+		"UpdateGameGenreParentがErrInvalidGameGenreParentなので400": {
+			sessionExist:             true,
+			req:                      openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			executeUpdateParent:      true,
+			parentID:                 &parentID,
+			UpdateGameGenreParentErr: service.ErrInvalidGameGenreParent,
+			isErr:                    true,
+			statusCode:               http.StatusBadRequest,
+		},
+		"UpdateGameGenreParentがErrNoGameGenreUpdatedなので400": {
+			sessionExist:             true,
+			req:                      openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			executeUpdateParent:      true,
+			parentID:                 &parentID,
+			UpdateGameGenreParentErr: service.ErrNoGameGenreUpdated,
+			isErr:                    true,
+			statusCode:               http.StatusBadRequest,
+		},
+		"UpdateGameGenreParentがエラーなので500": {
+			sessionExist:             true,
+			req:                      openapi.PutGameGenreParentJSONRequestBody{Parent: &parentUUID},
+			executeUpdateParent:      true,
+			parentID:                 &parentID,
+			UpdateGameGenreParentErr: errors.New("test error"),
+			isErr:                    true,
+			statusCode:               http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var bodyOpt bodyOpt
+			if !testCase.invalidRequestBody {
+				bodyOpt = withJSONBody(t, testCase.req)
+			} else {
+				bodyOpt = withStringBody(t, "invalid request body")
+			}
+
+			c, req, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/api/v2/genres/%s/parent", gameGenreID), bodyOpt)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeUpdateParent {
+				mockGameGenreService.
+					EXPECT().
+					UpdateGameGenreParent(gomock.Any(), gomock.Any(), values.GameGenreIDFromUUID(gameGenreID), testCase.parentID).
+					Return(testCase.gameGenre, testCase.UpdateGameGenreParentErr)
+			}
+
+			err := gameGenreHandler.PutGameGenreParent(c, gameGenreID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameGenre
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody.Id, res.Id)
+			assert.Equal(t, testCase.resBody.Genre, res.Genre)
+			assert.Equal(t, testCase.resBody.Num, res.Num)
+			assert.WithinDuration(t, testCase.resBody.CreatedAt, res.CreatedAt, time.Second)
+			assert.Equal(t, testCase.resBody.Parent, res.Parent)
+		})
+	}
+}
+
+func TestPostGameGenreAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameGenreService := mock.NewMockGameGenre(ctrl)
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+	session, err := NewSession(sess)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+
+	gameGenreHandler := NewGameGenre(mockGameGenreService, mock.NewMockGameV2(ctrl), session)
+
+	gameGenreID := uuid.New()
+	aliasID := values.NewGameGenreAliasID()
+	now := time.Now()
+
+	testCases := map[string]struct {
+		sessionExist         bool
+		req                  openapi.PostGameGenreAliasJSONRequestBody
+		invalidRequestBody   bool
+		executeAddAlias      bool
+		alias                *domain.GameGenreAlias
+		AddGameGenreAliasErr error
+		resBody              openapi.GameGenreAlias
+		isErr                bool
+		statusCode           int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:    true,
+			req:             openapi.PostGameGenreAliasJSONRequestBody{Alias: "3DCG"},
+			executeAddAlias: true,
+			alias:           domain.NewGameGenreAlias(aliasID, values.GameGenreIDFromUUID(gameGenreID), "3DCG", now),
+			resBody: openapi.GameGenreAlias{
+				Id:        uuid.UUID(aliasID),
+				GenreId:   gameGenreID,
+				Name:      "3DCG",
+				CreatedAt: now,
+			},
+			statusCode: http.StatusCreated,
+		},
+		"セッションが無いので401": {
+			req:        openapi.PostGameGenreAliasJSONRequestBody{Alias: "3DCG"},
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"リクエストボディがおかしいので400": {
+			sessionExist:       true,
+			invalidRequestBody: true,
+			isErr:              true,
+			statusCode:         http.StatusBadRequest,
+		},
+		"別名が空なので400": {
+			sessionExist: true,
+			req:          openapi.PostGameGenreAliasJSONRequestBody{Alias: ""},
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
+		},
+		"別名が長すぎるので400": {
+			sessionExist: true,
+			req:          openapi.PostGameGenreAliasJSONRequestBody{Alias: strings.Repeat("a", 100)},
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
+		},
+		"AddGameGenreAliasがErrNoGameGenreなので404": {
+			sessionExist:         true,
+			req:                  openapi.PostGameGenreAliasJSONRequestBody{Alias: "3DCG"},
+			executeAddAlias:      true,
+			AddGameGenreAliasErr: service.ErrNoGameGenre,
+			isErr:                true,
+			statusCode:           http.StatusNotFound,
+		},
+		"AddGameGenreAliasがErrDuplicateGameGenreNameなので400": {
+			sessionExist:         true,
+			req:                  openapi.PostGameGenreAliasJSONRequestBody{Alias: "3DCG"},
+			executeAddAlias:      true,
+			AddGameGenreAliasErr: service.ErrDuplicateGameGenreName,
+			isErr:                true,
+			statusCode:           http.StatusBadRequest,
+		},
+		"AddGameGenreAliasがエラーなので500": {
+			sessionExist:         true,
+			req:                  openapi.PostGameGenreAliasJSONRequestBody{Alias: "3DCG"},
+			executeAddAlias:      true,
+			AddGameGenreAliasErr: errors.New("test error"),
+			isErr:                true,
+			statusCode:           http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var bodyOpt bodyOpt
+			if !testCase.invalidRequestBody {
+				bodyOpt = withJSONBody(t, testCase.req)
+			} else {
+				bodyOpt = withStringBody(t, "invalid request body")
+			}
+
+			c, req, rec := setupTestRequest(t, http.MethodPost, fmt.Sprintf("/api/v2/genres/%s/aliases", gameGenreID), bodyOpt)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeAddAlias {
+				mockGameGenreService.
+					EXPECT().
+					AddGameGenreAlias(gomock.Any(), gomock.Any(), values.GameGenreIDFromUUID(gameGenreID), values.NewGameGenreName(testCase.req.Alias)).
+					Return(testCase.alias, testCase.AddGameGenreAliasErr)
+			}
+
+			err := gameGenreHandler.PostGameGenreAlias(c, gameGenreID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameGenreAlias
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody.Id, res.Id)
+			assert.Equal(t, testCase.resBody.GenreId, res.GenreId)
+			assert.Equal(t, testCase.resBody.Name, res.Name)
+			assert.WithinDuration(t, testCase.resBody.CreatedAt, res.CreatedAt, time.Second)
+		})
+	}
+}
+
+func TestDeleteGameGenreAlias(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameGenreService := mock.NewMockGameGenre(ctrl)
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+	session, err := NewSession(sess)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+		return
+	}
+
+	gameGenreHandler := NewGameGenre(mockGameGenreService, mock.NewMockGameV2(ctrl), session)
+
+	gameGenreID := uuid.New()
+	aliasID := uuid.New()
+
+	testCases := map[string]struct {
+		sessionExist            bool
+		executeDeleteAlias      bool
+		DeleteGameGenreAliasErr error
+		isErr                   bool
+		statusCode              int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:       true,
+			executeDeleteAlias: true,
+			statusCode:         http.StatusOK,
+		},
+		"セッションが無いので401": {
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"DeleteGameGenreAliasがErrNoGameGenreAliasなので404": {
+			sessionExist:            true,
+			executeDeleteAlias:      true,
+			DeleteGameGenreAliasErr: service.ErrNoGameGenreAlias,
+			isErr:                   true,
+			statusCode:              http.StatusNotFound,
+		},
+		"DeleteGameGenreAliasがエラーなので500": {
+			sessionExist:            true,
+			executeDeleteAlias:      true,
+			DeleteGameGenreAliasErr: errors.New("test error"),
+			isErr:                   true,
+			statusCode:              http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, req, rec := setupTestRequest(t, http.MethodDelete, fmt.Sprintf("/api/v2/genres/%s/aliases/%s", gameGenreID, aliasID), nil)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeDeleteAlias {
+				mockGameGenreService.
+					EXPECT().
+					DeleteGameGenreAlias(gomock.Any(), gomock.Any(), values.GameGenreIDFromUUID(gameGenreID), values.NewGameGenreAliasIDFromUUID(aliasID)).
+					Return(testCase.DeleteGameGenreAliasErr)
+			}
+
+			err := gameGenreHandler.DeleteGameGenreAlias(c, gameGenreID, aliasID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+		})
+	}
+}
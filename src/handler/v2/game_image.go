@@ -3,7 +3,7 @@ package v2
 import (
 	"errors"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -13,6 +13,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -34,7 +35,7 @@ func (gameImage *GameImage) GetGameImages(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game images: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game images", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game images")
 	}
 
@@ -49,7 +50,7 @@ func (gameImage *GameImage) GetGameImages(c echo.Context, gameID openapi.GameIDI
 		case values.GameImageTypeGif:
 			mime = openapi.Imagegif
 		default:
-			log.Printf("error: unknown game image type: %v\n", image.GetType())
+			logger.Error(c.Request().Context(), "unknown game image type", slog.Any("game_image_type", image.GetType()))
 			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game image type")
 		}
 
@@ -87,8 +88,11 @@ func (gameImage *GameImage) PostGameImage(c echo.Context, gameID openapi.GameIDI
 		if errors.Is(err, service.ErrInvalidFormat) {
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid image type")
 		}
+		if errors.Is(err, service.ErrGameStorageQuotaExceeded) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "game storage quota exceeded")
+		}
 		if err != nil {
-			log.Printf("error: failed to save game image: %v\n", err)
+			logger.Error(c.Request().Context(), "failed to save game image", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to save game image")
 		}
 
@@ -100,7 +104,7 @@ func (gameImage *GameImage) PostGameImage(c echo.Context, gameID openapi.GameIDI
 		case values.GameImageTypeGif:
 			mime = openapi.Imagegif
 		default:
-			log.Printf("error: unknown game image type: %v\n", image.GetType())
+			logger.Error(c.Request().Context(), "unknown game image type", slog.Any("game_image_type", image.GetType()))
 			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game image type")
 		}
 
@@ -127,8 +131,13 @@ func (gameImage *GameImage) PostGameImage(c echo.Context, gameID openapi.GameIDI
 
 // ゲーム画像のバイナリの取得
 // (GET /games/{gameID}/images/{gameImageID})
-func (gameImage *GameImage) GetGameImage(c echo.Context, gameID openapi.GameIDInPath, gameImageID openapi.GameImageIDInPath) error {
-	tmpURL, err := gameImage.gameImageService.GetGameImage(c.Request().Context(), values.NewGameIDFromUUID(gameID), values.GameImageIDFromUUID(gameImageID))
+func (gameImage *GameImage) GetGameImage(c echo.Context, gameID openapi.GameIDInPath, gameImageID openapi.GameImageIDInPath, params openapi.GetGameImageParams) error {
+	size, webp, err := parseGameImageVariantParams(params.Size, params.Format)
+	if err != nil {
+		return err
+	}
+
+	tmpURL, err := gameImage.gameImageService.GetGameImage(c.Request().Context(), values.NewGameIDFromUUID(gameID), values.GameImageIDFromUUID(gameImageID), size, webp)
 	if errors.Is(err, service.ErrInvalidGameID) {
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 	}
@@ -136,7 +145,7 @@ func (gameImage *GameImage) GetGameImage(c echo.Context, gameID openapi.GameIDIn
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameImageID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game image: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game image", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game image")
 	}
 
@@ -145,8 +154,13 @@ func (gameImage *GameImage) GetGameImage(c echo.Context, gameID openapi.GameIDIn
 
 // ゲーム画像のメタ情報の取得
 // (GET /games/{gameID}/images/{gameImageID}/meta)
-func (gameImage *GameImage) GetGameImageMeta(ctx echo.Context, gameID openapi.GameIDInPath, gameImageID openapi.GameImageIDInPath) error {
-	image, err := gameImage.gameImageService.GetGameImageMeta(ctx.Request().Context(), values.NewGameIDFromUUID(gameID), values.GameImageIDFromUUID(gameImageID))
+func (gameImage *GameImage) GetGameImageMeta(ctx echo.Context, gameID openapi.GameIDInPath, gameImageID openapi.GameImageIDInPath, params openapi.GetGameImageMetaParams) error {
+	size, webp, err := parseGameImageVariantParams(params.Size, params.Format)
+	if err != nil {
+		return err
+	}
+
+	image, variant, err := gameImage.gameImageService.GetGameImageMeta(ctx.Request().Context(), values.NewGameIDFromUUID(gameID), values.GameImageIDFromUUID(gameImageID), size, webp)
 	if errors.Is(err, service.ErrInvalidGameID) {
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 	}
@@ -154,26 +168,89 @@ func (gameImage *GameImage) GetGameImageMeta(ctx echo.Context, gameID openapi.Ga
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameImageID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game image meta: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game image meta", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game image meta")
 	}
 
+	imageType := image.GetType()
+	if variant != nil {
+		imageType = variant.GetType()
+	}
+
 	var mime openapi.GameImageMime
-	switch image.GetType() {
+	switch imageType {
 	case values.GameImageTypeJpeg:
 		mime = openapi.Imagejpeg
 	case values.GameImageTypePng:
 		mime = openapi.Imagepng
 	case values.GameImageTypeGif:
 		mime = openapi.Imagegif
+	case values.GameImageTypeWebp:
+		mime = openapi.Imagewebp
 	default:
-		log.Printf("error: unknown game image type: %v\n", image.GetType())
+		logger.Error(ctx.Request().Context(), "unknown game image type", slog.Any("game_image_type", imageType))
 		return echo.NewHTTPError(http.StatusInternalServerError, "unknown game image type")
 	}
 
-	return ctx.JSON(http.StatusOK, openapi.GameImage{
+	res := openapi.GameImage{
 		Id:        openapi.GameImageID(image.GetID()),
 		Mime:      mime,
 		CreatedAt: image.GetCreatedAt(),
-	})
+	}
+	if variant != nil {
+		var resSize openapi.GameImageSize
+		switch variant.GetSize() {
+		case values.GameImageSizeOriginal:
+			resSize = openapi.Original
+		case values.GameImageSizeSmall:
+			resSize = openapi.Small
+		case values.GameImageSizeMedium:
+			resSize = openapi.Medium
+		case values.GameImageSizeLarge:
+			resSize = openapi.Large
+		default:
+			logger.Error(ctx.Request().Context(), "unknown game image size", slog.Any("game_image_size", variant.GetSize()))
+			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game image size")
+		}
+
+		width := variant.GetWidth()
+		height := variant.GetHeight()
+		res.Size = &resSize
+		res.Width = &width
+		res.Height = &height
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// parseGameImageVariantParams
+// クエリパラメーターから取得する画像のサイズとWebPを要求しているかを取得する。
+func parseGameImageVariantParams(paramSize *openapi.GameImageSizeInQuery, paramFormat *openapi.GameImageFormatInQuery) (values.GameImageSize, bool, error) {
+	size := values.GameImageSizeOriginal
+	if paramSize != nil {
+		switch *paramSize {
+		case openapi.Original:
+			size = values.GameImageSizeOriginal
+		case openapi.Small:
+			size = values.GameImageSizeSmall
+		case openapi.Medium:
+			size = values.GameImageSizeMedium
+		case openapi.Large:
+			size = values.GameImageSizeLarge
+		default:
+			return 0, false, echo.NewHTTPError(http.StatusBadRequest, "invalid size")
+		}
+	}
+
+	webp := false
+	if paramFormat != nil {
+		switch *paramFormat {
+		case openapi.Webp:
+			webp = true
+		default:
+			return 0, false, echo.NewHTTPError(http.StatusBadRequest, "invalid format")
+		}
+	}
+
+	return size, webp, nil
 }
@@ -314,6 +314,15 @@ func TestPostGameImage(t *testing.T) {
 			isErr:                true,
 			statusCode:           http.StatusNotFound,
 		},
+		{
+			description:          "SaveGameImageがErrGameStorageQuotaExceededなので413",
+			gameID:               uuid.UUID(values.NewGameID()),
+			reader:               bytes.NewReader([]byte("test")),
+			executeSaveGameImage: true,
+			saveGameImageErr:     service.ErrGameStorageQuotaExceeded,
+			isErr:                true,
+			statusCode:           http.StatusRequestEntityTooLarge,
+		},
 		{
 			description:          "SaveGameImageがエラーなので500",
 			gameID:               uuid.UUID(values.NewGameID()),
@@ -399,15 +408,19 @@ func TestGetGameImage(t *testing.T) {
 	gameImage := NewGameImage(mockGameImageService)
 
 	type test struct {
-		description     string
-		gameID          openapi.GameIDInPath
-		gameImageID     openapi.GameImageIDInPath
-		tmpURL          values.GameImageTmpURL
-		getGameImageErr error
-		resLocation     string
-		isErr           bool
-		err             error
-		statusCode      int
+		description         string
+		gameID              openapi.GameIDInPath
+		gameImageID         openapi.GameImageIDInPath
+		params              openapi.GetGameImageParams
+		executeGetGameImage bool
+		size                values.GameImageSize
+		webp                bool
+		tmpURL              values.GameImageTmpURL
+		getGameImageErr     error
+		resLocation         string
+		isErr               bool
+		err                 error
+		statusCode          int
 	}
 
 	urlLink, err := url.Parse("https://example.com")
@@ -415,37 +428,88 @@ func TestGetGameImage(t *testing.T) {
 		t.Fatalf("failed to encode image: %v", err)
 	}
 
+	sizeSmall := openapi.Small
+	sizeLarge := openapi.Large
+	sizeInvalid := openapi.GameImageSize("invalid")
+	formatWebp := openapi.Webp
+	formatInvalid := openapi.GameImageFormat("invalid")
+
 	testCases := []test{
 		{
-			description: "特に問題ないのでエラーなし",
+			description:         "特に問題ないのでエラーなし",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			executeGetGameImage: true,
+			size:                values.GameImageSizeOriginal,
+			tmpURL:              values.NewGameImageTmpURL(urlLink),
+			resLocation:         "https://example.com",
+		},
+		{
+			description:         "サイズを指定してもエラーなし",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			params:              openapi.GetGameImageParams{Size: &sizeSmall},
+			executeGetGameImage: true,
+			size:                values.GameImageSizeSmall,
+			tmpURL:              values.NewGameImageTmpURL(urlLink),
+			resLocation:         "https://example.com",
+		},
+		{
+			description:         "WebPを指定してもエラーなし",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			params:              openapi.GetGameImageParams{Size: &sizeLarge, Format: &formatWebp},
+			executeGetGameImage: true,
+			size:                values.GameImageSizeLarge,
+			webp:                true,
+			tmpURL:              values.NewGameImageTmpURL(urlLink),
+			resLocation:         "https://example.com",
+		},
+		{
+			description: "サイズが不正なので400",
 			gameID:      uuid.UUID(values.NewGameID()),
 			gameImageID: uuid.UUID(values.NewGameImageID()),
-			tmpURL:      values.NewGameImageTmpURL(urlLink),
-			resLocation: "https://example.com",
+			params:      openapi.GetGameImageParams{Size: &sizeInvalid},
+			isErr:       true,
+			statusCode:  http.StatusBadRequest,
 		},
 		{
-			description:     "GetGameImageがErrInvalidGameIDなので404",
-			gameID:          uuid.UUID(values.NewGameID()),
-			gameImageID:     uuid.UUID(values.NewGameImageID()),
-			getGameImageErr: service.ErrInvalidGameID,
-			isErr:           true,
-			statusCode:      http.StatusNotFound,
+			description: "形式が不正なので400",
+			gameID:      uuid.UUID(values.NewGameID()),
+			gameImageID: uuid.UUID(values.NewGameImageID()),
+			params:      openapi.GetGameImageParams{Format: &formatInvalid},
+			isErr:       true,
+			statusCode:  http.StatusBadRequest,
 		},
 		{
-			description:     "GetGameImageがErrInvalidGameImageIDなので404",
-			gameID:          uuid.UUID(values.NewGameID()),
-			gameImageID:     uuid.UUID(values.NewGameImageID()),
-			getGameImageErr: service.ErrInvalidGameImageID,
-			isErr:           true,
-			statusCode:      http.StatusNotFound,
+			description:         "GetGameImageがErrInvalidGameIDなので404",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			executeGetGameImage: true,
+			size:                values.GameImageSizeOriginal,
+			getGameImageErr:     service.ErrInvalidGameID,
+			isErr:               true,
+			statusCode:          http.StatusNotFound,
+		},
+		{
+			description:         "GetGameImageがErrInvalidGameImageIDなので404",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			executeGetGameImage: true,
+			size:                values.GameImageSizeOriginal,
+			getGameImageErr:     service.ErrInvalidGameImageID,
+			isErr:               true,
+			statusCode:          http.StatusNotFound,
 		},
 		{
-			description:     "GetGameImageがエラーなので500",
-			gameID:          uuid.UUID(values.NewGameID()),
-			gameImageID:     uuid.UUID(values.NewGameImageID()),
-			getGameImageErr: errors.New("error"),
-			isErr:           true,
-			statusCode:      http.StatusInternalServerError,
+			description:         "GetGameImageがエラーなので500",
+			gameID:              uuid.UUID(values.NewGameID()),
+			gameImageID:         uuid.UUID(values.NewGameImageID()),
+			executeGetGameImage: true,
+			size:                values.GameImageSizeOriginal,
+			getGameImageErr:     errors.New("error"),
+			isErr:               true,
+			statusCode:          http.StatusInternalServerError,
 		},
 	}
 
@@ -453,12 +517,14 @@ func TestGetGameImage(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/games/%s/images", testCase.gameID), nil)
 
-			mockGameImageService.
-				EXPECT().
-				GetGameImage(gomock.Any(), gomock.Any(), gomock.Any()).
-				Return(testCase.tmpURL, testCase.getGameImageErr)
+			if testCase.executeGetGameImage {
+				mockGameImageService.
+					EXPECT().
+					GetGameImage(gomock.Any(), values.NewGameIDFromUUID(testCase.gameID), values.GameImageIDFromUUID(testCase.gameImageID), testCase.size, testCase.webp).
+					Return(testCase.tmpURL, testCase.getGameImageErr)
+			}
 
-			err := gameImage.GetGameImage(c, testCase.gameID, testCase.gameImageID)
+			err := gameImage.GetGameImage(c, testCase.gameID, testCase.gameImageID, testCase.params)
 
 			if testCase.isErr {
 				if testCase.statusCode != 0 {
@@ -498,26 +564,47 @@ func TestGetGameImageMeta(t *testing.T) {
 	gameImage := NewGameImage(mockGameImageService)
 
 	type test struct {
-		description         string
-		gameID              openapi.GameIDInPath
-		gameImageID         openapi.GameImageIDInPath
-		image               *domain.GameImage
-		getGameImageMetaErr error
-		resImage            openapi.GameImage
-		isErr               bool
-		err                 error
-		statusCode          int
+		description             string
+		gameID                  openapi.GameIDInPath
+		gameImageID             openapi.GameImageIDInPath
+		params                  openapi.GetGameImageMetaParams
+		executeGetGameImageMeta bool
+		size                    values.GameImageSize
+		webp                    bool
+		image                   *domain.GameImage
+		variant                 *domain.GameImageVariant
+		getGameImageMetaErr     error
+		resImage                openapi.GameImage
+		isErr                   bool
+		err                     error
+		statusCode              int
 	}
 
 	gameImageID1 := values.NewGameImageID()
 	gameImageID2 := values.NewGameImageID()
 	gameImageID3 := values.NewGameImageID()
+	gameImageID4 := values.NewGameImageID()
+	gameImageID5 := values.NewGameImageID()
+
+	sizeMedium := openapi.Medium
+	sizeInvalid := openapi.GameImageSize("invalid")
+	formatWebp := openapi.Webp
+	formatInvalid := openapi.GameImageFormat("invalid")
+
+	resSizeMedium := openapi.Medium
+	resSizeOriginal := openapi.Original
+	resWidth640 := 640
+	resHeight320 := 320
+	resWidth1000 := 1000
+	resHeight500 := 500
 
 	now := time.Now()
 	testCases := []test{
 		{
-			description: "特に問題ないのでエラーなし",
-			gameID:      uuid.UUID(values.NewGameID()),
+			description:             "特に問題ないのでエラーなし",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
 			image: domain.NewGameImage(
 				gameImageID1,
 				values.GameImageTypeJpeg,
@@ -530,8 +617,10 @@ func TestGetGameImageMeta(t *testing.T) {
 			},
 		},
 		{
-			description: "pngでもエラーなし",
-			gameID:      uuid.UUID(values.NewGameID()),
+			description:             "pngでもエラーなし",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
 			image: domain.NewGameImage(
 				gameImageID2,
 				values.GameImageTypePng,
@@ -544,8 +633,10 @@ func TestGetGameImageMeta(t *testing.T) {
 			},
 		},
 		{
-			description: "gifでもエラーなし",
-			gameID:      uuid.UUID(values.NewGameID()),
+			description:             "gifでもエラーなし",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
 			image: domain.NewGameImage(
 				gameImageID3,
 				values.GameImageTypeGif,
@@ -558,8 +649,81 @@ func TestGetGameImageMeta(t *testing.T) {
 			},
 		},
 		{
-			description: "jpeg,png,gifのいずれでもないので500",
+			description:             "サムネイルが存在するのでサムネイルの情報が返る",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			params:                  openapi.GetGameImageMetaParams{Size: &sizeMedium},
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeMedium,
+			image: domain.NewGameImage(
+				gameImageID4,
+				values.GameImageTypePng,
+				now,
+			),
+			variant: domain.NewGameImageVariant(
+				values.NewGameImageVariantID(),
+				values.GameImageSizeMedium,
+				values.GameImageTypePng,
+				640,
+				320,
+				now,
+			),
+			resImage: openapi.GameImage{
+				Id:        uuid.UUID(gameImageID4),
+				Mime:      openapi.Imagepng,
+				CreatedAt: now,
+				Size:      &resSizeMedium,
+				Width:     &resWidth640,
+				Height:    &resHeight320,
+			},
+		},
+		{
+			description:             "WebPが存在するのでWebPの情報が返る",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			params:                  openapi.GetGameImageMetaParams{Format: &formatWebp},
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
+			webp:                    true,
+			image: domain.NewGameImage(
+				gameImageID5,
+				values.GameImageTypePng,
+				now,
+			),
+			variant: domain.NewGameImageVariant(
+				values.NewGameImageVariantID(),
+				values.GameImageSizeOriginal,
+				values.GameImageTypeWebp,
+				1000,
+				500,
+				now,
+			),
+			resImage: openapi.GameImage{
+				Id:        uuid.UUID(gameImageID5),
+				Mime:      openapi.Imagewebp,
+				CreatedAt: now,
+				Size:      &resSizeOriginal,
+				Width:     &resWidth1000,
+				Height:    &resHeight500,
+			},
+		},
+		{
+			description: "サイズが不正なので400",
+			gameID:      uuid.UUID(values.NewGameID()),
+			params:      openapi.GetGameImageMetaParams{Size: &sizeInvalid},
+			isErr:       true,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "形式が不正なので400",
 			gameID:      uuid.UUID(values.NewGameID()),
+			params:      openapi.GetGameImageMetaParams{Format: &formatInvalid},
+			isErr:       true,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description:             "jpeg,png,gifのいずれでもないので500",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
 			image: domain.NewGameImage(
 				values.NewGameImageID(),
 				values.GameImageType(100),
@@ -569,25 +733,31 @@ func TestGetGameImageMeta(t *testing.T) {
 			statusCode: http.StatusInternalServerError,
 		},
 		{
-			description:         "GetGameImageMetaがErrInvalidGameIDなので404",
-			gameID:              uuid.UUID(values.NewGameID()),
-			getGameImageMetaErr: service.ErrInvalidGameID,
-			isErr:               true,
-			statusCode:          http.StatusNotFound,
+			description:             "GetGameImageMetaがErrInvalidGameIDなので404",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
+			getGameImageMetaErr:     service.ErrInvalidGameID,
+			isErr:                   true,
+			statusCode:              http.StatusNotFound,
 		},
 		{
-			description:         "GetGameImageMetaがErrInvalidGameImageIDなので404",
-			gameID:              uuid.UUID(values.NewGameID()),
-			getGameImageMetaErr: service.ErrInvalidGameImageID,
-			isErr:               true,
-			statusCode:          http.StatusNotFound,
+			description:             "GetGameImageMetaがErrInvalidGameImageIDなので404",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
+			getGameImageMetaErr:     service.ErrInvalidGameImageID,
+			isErr:                   true,
+			statusCode:              http.StatusNotFound,
 		},
 		{
-			description:         "GetGameImagesがエラーなので500",
-			gameID:              uuid.UUID(values.NewGameID()),
-			getGameImageMetaErr: errors.New("error"),
-			isErr:               true,
-			statusCode:          http.StatusInternalServerError,
+			description:             "GetGameImagesがエラーなので500",
+			gameID:                  uuid.UUID(values.NewGameID()),
+			executeGetGameImageMeta: true,
+			size:                    values.GameImageSizeOriginal,
+			getGameImageMetaErr:     errors.New("error"),
+			isErr:                   true,
+			statusCode:              http.StatusInternalServerError,
 		},
 	}
 
@@ -595,12 +765,14 @@ func TestGetGameImageMeta(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/games/%s/images", testCase.gameID), nil)
 
-			mockGameImageService.
-				EXPECT().
-				GetGameImageMeta(gomock.Any(), gomock.Any(), gomock.Any()).
-				Return(testCase.image, testCase.getGameImageMetaErr)
+			if testCase.executeGetGameImageMeta {
+				mockGameImageService.
+					EXPECT().
+					GetGameImageMeta(gomock.Any(), values.NewGameIDFromUUID(testCase.gameID), values.GameImageIDFromUUID(testCase.gameImageID), testCase.size, testCase.webp).
+					Return(testCase.image, testCase.variant, testCase.getGameImageMetaErr)
+			}
 
-			err := gameImage.GetGameImageMeta(c, testCase.gameID, testCase.gameImageID)
+			err := gameImage.GetGameImageMeta(c, testCase.gameID, testCase.gameImageID, testCase.params)
 
 			if testCase.isErr {
 				if testCase.statusCode != 0 {
@@ -632,6 +804,9 @@ func TestGetGameImageMeta(t *testing.T) {
 			assert.Equal(t, testCase.resImage.Id, resImage.Id)
 			assert.Equal(t, testCase.resImage.Mime, resImage.Mime)
 			assert.WithinDuration(t, testCase.resImage.CreatedAt, resImage.CreatedAt, time.Second)
+			assert.Equal(t, testCase.resImage.Size, resImage.Size)
+			assert.Equal(t, testCase.resImage.Width, resImage.Width)
+			assert.Equal(t, testCase.resImage.Height, resImage.Height)
 		})
 	}
 }
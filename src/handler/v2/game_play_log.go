@@ -2,7 +2,7 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -10,15 +10,18 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
 type GamePlayLog struct {
+	context            *Context
 	gamePlayLogService service.GamePlayLogV2
 }
 
-func NewGamePlayLog(gamePlayLogService service.GamePlayLogV2) *GamePlayLog {
+func NewGamePlayLog(context *Context, gamePlayLogService service.GamePlayLogV2) *GamePlayLog {
 	return &GamePlayLog{
+		context:            context,
 		gamePlayLogService: gamePlayLogService,
 	}
 }
@@ -48,7 +51,7 @@ func (gpl *GamePlayLog) PostGamePlayLogStart(c echo.Context, editionIDPath opena
 		return echo.NewHTTPError(http.StatusNotFound, "game version not found")
 	}
 	if err != nil {
-		log.Printf("error: failed to create game play log: %v\n", err)
+		logger.Error(ctx, "failed to create game play log", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to post game play log start")
 	}
 
@@ -83,13 +86,88 @@ func (gpl *GamePlayLog) PatchGamePlayLogEnd(c echo.Context, editionIDPath openap
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid play log edition and game pair")
 	}
 	if err != nil {
-		log.Printf("error: failed to update game play log end time: %v\n", err)
+		logger.Error(ctx, "failed to update game play log end time", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to patch game play log end")
 	}
 
 	return c.NoContent(http.StatusOK)
 }
 
+// ゲームプレイログのまとめての記録
+// (POST /editions/{editionID}/plays)
+func (gpl *GamePlayLog) PostGamePlayLogs(c echo.Context, editionIDPath openapi.EditionIDInPath) error {
+	ctx := c.Request().Context()
+	var body openapi.PostGamePlayLogsJSONRequestBody
+	if err := c.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad request body")
+	}
+
+	accessToken, err := gpl.context.GetAccessToken(c)
+	if err != nil {
+		logger.Error(ctx, "failed to get access token", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get access token")
+	}
+
+	editionID := values.NewEditionIDFromUUID(editionIDPath)
+
+	records := make([]*service.GamePlayLogRecord, 0, len(body.Plays))
+	for _, play := range body.Plays {
+		records = append(records, &service.GamePlayLogRecord{
+			ID:            values.GamePlayLogIDFromUUID(uuid.UUID(play.Id)),
+			GameID:        values.NewGameIDFromUUID(play.GameID),
+			GameVersionID: values.NewGameVersionIDFromUUID(play.GameVersionID),
+			StartTime:     play.StartTime,
+			EndTime:       play.EndTime,
+		})
+	}
+
+	results, err := gpl.gamePlayLogService.CreatePlayLogs(ctx, accessToken, editionID, records)
+	if errors.Is(err, service.ErrTooManyPlayLogRecords) {
+		return echo.NewHTTPError(http.StatusBadRequest, "too many play log records")
+	}
+	if errors.Is(err, service.ErrInvalidAccessToken) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+	}
+	if errors.Is(err, service.ErrForbidden) {
+		return echo.NewHTTPError(http.StatusForbidden, "forbidden")
+	}
+	if err != nil {
+		logger.Error(ctx, "failed to create game play logs", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to post game play logs")
+	}
+
+	resResults := make([]openapi.GamePlayLogRecordResult, 0, len(results))
+	for _, result := range results {
+		var status openapi.GamePlayLogRecordStatus
+		switch result.Status {
+		case service.GamePlayLogRecordStatusCreated:
+			status = openapi.Created
+		case service.GamePlayLogRecordStatusDuplicated:
+			status = openapi.Duplicated
+		case service.GamePlayLogRecordStatusInvalid:
+			status = openapi.Invalid
+		default:
+			logger.Error(ctx, "unknown game play log record status", slog.Any("game_play_log_record_status", result.Status))
+			return echo.NewHTTPError(http.StatusInternalServerError, "unknown game play log record status")
+		}
+
+		var reason *string
+		if result.Reason != "" {
+			reason = &result.Reason
+		}
+
+		resResults = append(resResults, openapi.GamePlayLogRecordResult{
+			Id:     openapi.GamePlayLogID(result.ID),
+			Status: status,
+			Reason: reason,
+		})
+	}
+
+	return c.JSON(http.StatusOK, openapi.PostGamePlayLogsResponse{
+		Results: resResults,
+	})
+}
+
 // ゲームプレイ統計の取得
 // (GET /games/{gameID}/play-stats)
 func (gpl *GamePlayLog) GetGamePlayStats(c echo.Context, gameIDPath openapi.GameIDInPath, params openapi.GetGamePlayStatsParams) error {
@@ -127,7 +205,7 @@ func (gpl *GamePlayLog) GetGamePlayStats(c echo.Context, gameIDPath openapi.Game
 		return echo.NewHTTPError(http.StatusBadRequest, "time period too long")
 	}
 	if err != nil {
-		log.Printf("get game play stats: %v\n", err)
+		logger.Error(ctx, "get game play stats", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "get game play stats")
 	}
 
@@ -181,7 +259,7 @@ func (gpl *GamePlayLog) GetEditionPlayStats(c echo.Context, editionIDPath openap
 		return echo.NewHTTPError(http.StatusBadRequest, "time period too long")
 	}
 	if err != nil {
-		log.Printf("error: failed to get edition play stats: %v\n", err)
+		logger.Error(ctx, "failed to get edition play stats", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get edition play stats")
 	}
 
@@ -222,7 +300,7 @@ func (gpl *GamePlayLog) DeleteGamePlayLog(c echo.Context, editionIDPath openapi.
 		return echo.NewHTTPError(http.StatusNotFound, "play log not found")
 	}
 	if err != nil {
-		log.Printf("error: failed to delete game play log: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to delete game play log", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete game play log")
 	}
 
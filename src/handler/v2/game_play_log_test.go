@@ -109,7 +109,7 @@ func TestPostGamePlayLogStart(t *testing.T) {
 			t.Parallel()
 
 			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
-			h := NewGamePlayLog(serviceMock)
+			h := NewGamePlayLog(NewContext(), serviceMock)
 
 			gameVersionID := values.NewGameVersionIDFromUUID(testCase.reqBody.GameVersionID)
 
@@ -157,6 +157,200 @@ func TestPostGamePlayLogStart(t *testing.T) {
 
 }
 
+func TestPostGamePlayLogs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	editionID := values.NewEditionID()
+	gameID := values.NewGameID()
+	gameVersionID := values.NewGameVersionID()
+	accessToken := values.NewLauncherSessionAccessTokenFromString("access-token")
+	now := time.Now()
+
+	playLogID1 := values.NewGamePlayLogID()
+	playLogID2 := values.NewGamePlayLogID()
+	playLogID3 := values.NewGamePlayLogID()
+	reqBody := openapi.PostGamePlayLogsRequest{
+		Plays: []openapi.GamePlayLogRecord{
+			{
+				Id:            openapi.GamePlayLogID(playLogID1),
+				GameID:        openapi.GameID(gameID),
+				GameVersionID: openapi.GameVersionID(gameVersionID),
+				StartTime:     now.Add(-time.Hour),
+				EndTime:       now.Add(-30 * time.Minute),
+			},
+			{
+				Id:            openapi.GamePlayLogID(playLogID2),
+				GameID:        openapi.GameID(gameID),
+				GameVersionID: openapi.GameVersionID(gameVersionID),
+				StartTime:     now.Add(-20 * time.Minute),
+				EndTime:       now.Add(-10 * time.Minute),
+			},
+			{
+				Id:            openapi.GamePlayLogID(playLogID3),
+				GameID:        openapi.GameID(gameID),
+				GameVersionID: openapi.GameVersionID(gameVersionID),
+				StartTime:     now.Add(-5 * time.Minute),
+				EndTime:       now.Add(-10 * time.Minute),
+			},
+		},
+	}
+	reason := "end time is before start time"
+
+	testCases := map[string]struct {
+		invalidReqBody        bool
+		noAccessToken         bool
+		reqBody               openapi.PostGamePlayLogsRequest
+		executeCreatePlayLogs bool
+		results               []*service.GamePlayLogRecordResult
+		CreatePlayLogsErr     error
+		isError               bool
+		statusCode            int
+		resBody               openapi.PostGamePlayLogsResponse
+	}{
+		"request bodyが不正なのでエラー": {
+			invalidReqBody: true,
+			isError:        true,
+			statusCode:     http.StatusBadRequest,
+		},
+		"アクセストークンがcontextに無いので500": {
+			noAccessToken: true,
+			reqBody:       reqBody,
+			isError:       true,
+			statusCode:    http.StatusInternalServerError,
+		},
+		"CreatePlayLogsがErrTooManyPlayLogRecordsなので400": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			CreatePlayLogsErr:     service.ErrTooManyPlayLogRecords,
+			isError:               true,
+			statusCode:            http.StatusBadRequest,
+		},
+		"CreatePlayLogsがErrInvalidAccessTokenなので401": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			CreatePlayLogsErr:     service.ErrInvalidAccessToken,
+			isError:               true,
+			statusCode:            http.StatusUnauthorized,
+		},
+		"CreatePlayLogsがErrForbiddenなので403": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			CreatePlayLogsErr:     service.ErrForbidden,
+			isError:               true,
+			statusCode:            http.StatusForbidden,
+		},
+		"CreatePlayLogsがその他のエラーなので500": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			CreatePlayLogsErr:     assert.AnError,
+			isError:               true,
+			statusCode:            http.StatusInternalServerError,
+		},
+		"statusが不明なので500": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			results: []*service.GamePlayLogRecordResult{
+				{ID: playLogID1, Status: service.GamePlayLogRecordStatus(100)},
+			},
+			isError:    true,
+			statusCode: http.StatusInternalServerError,
+		},
+		"CreatePlayLogsが成功するので200": {
+			reqBody:               reqBody,
+			executeCreatePlayLogs: true,
+			results: []*service.GamePlayLogRecordResult{
+				{ID: playLogID1, Status: service.GamePlayLogRecordStatusCreated},
+				{ID: playLogID2, Status: service.GamePlayLogRecordStatusDuplicated},
+				{ID: playLogID3, Status: service.GamePlayLogRecordStatusInvalid, Reason: reason},
+			},
+			statusCode: http.StatusOK,
+			resBody: openapi.PostGamePlayLogsResponse{
+				Results: []openapi.GamePlayLogRecordResult{
+					{Id: openapi.GamePlayLogID(playLogID1), Status: openapi.Created},
+					{Id: openapi.GamePlayLogID(playLogID2), Status: openapi.Duplicated},
+					{Id: openapi.GamePlayLogID(playLogID3), Status: openapi.Invalid, Reason: &reason},
+				},
+			},
+		},
+		"記録が空でも200": {
+			reqBody:               openapi.PostGamePlayLogsRequest{Plays: []openapi.GamePlayLogRecord{}},
+			executeCreatePlayLogs: true,
+			results:               []*service.GamePlayLogRecordResult{},
+			statusCode:            http.StatusOK,
+			resBody: openapi.PostGamePlayLogsResponse{
+				Results: []openapi.GamePlayLogRecordResult{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
+			h := NewGamePlayLog(NewContext(), serviceMock)
+
+			if testCase.executeCreatePlayLogs {
+				serviceMock.
+					EXPECT().
+					CreatePlayLogs(
+						gomock.Any(),
+						accessToken,
+						editionID,
+						gomock.Cond(func(records []*service.GamePlayLogRecord) bool {
+							if len(records) != len(testCase.reqBody.Plays) {
+								return false
+							}
+							for i, record := range records {
+								play := testCase.reqBody.Plays[i]
+								if uuid.UUID(record.ID) != play.Id ||
+									uuid.UUID(record.GameID) != play.GameID ||
+									uuid.UUID(record.GameVersionID) != play.GameVersionID ||
+									record.StartTime.Sub(play.StartTime).Abs() >= time.Second || // JSONのエンコードとデコードで精度がずれるため
+									record.EndTime.Sub(play.EndTime).Abs() >= time.Second {
+									return false
+								}
+							}
+							return true
+						}),
+					).
+					Return(testCase.results, testCase.CreatePlayLogsErr)
+			}
+
+			var body bodyOpt
+			if testCase.invalidReqBody {
+				body = withStringBody(t, "invalid")
+			} else {
+				body = withJSONBody(t, testCase.reqBody)
+			}
+
+			url := fmt.Sprintf("/editions/%s/plays", uuid.UUID(editionID).String())
+			c, _, rec := setupTestRequest(t, http.MethodPost, url, body)
+			if !testCase.noAccessToken {
+				c.Set(accessTokenContextKey, accessToken)
+			}
+
+			err := h.PostGamePlayLogs(c, openapi.EditionIDInPath(editionID))
+
+			if testCase.isError {
+				var httpError *echo.HTTPError
+				assert.ErrorAs(t, err, &httpError)
+				assert.Equal(t, testCase.statusCode, httpError.Code)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var resBody openapi.PostGamePlayLogsResponse
+			assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resBody))
+			assert.Equal(t, testCase.resBody, resBody)
+		})
+	}
+}
+
 func TestPatchGamePlayLogEnd(t *testing.T) {
 	t.Parallel()
 
@@ -244,7 +438,7 @@ func TestPatchGamePlayLogEnd(t *testing.T) {
 			t.Parallel()
 
 			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
-			h := NewGamePlayLog(serviceMock)
+			h := NewGamePlayLog(NewContext(), serviceMock)
 
 			if testCase.executeUpdatePlayLogEndTime {
 				serviceMock.
@@ -471,7 +665,7 @@ func TestGetEditionPlayStats(t *testing.T) {
 			t.Parallel()
 
 			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
-			h := NewGamePlayLog(serviceMock)
+			h := NewGamePlayLog(NewContext(), serviceMock)
 
 			if testCase.executeGetEditionStats {
 				serviceMock.
@@ -687,7 +881,7 @@ func TestGetGamePlayStats(t *testing.T) {
 			t.Parallel()
 
 			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
-			h := NewGamePlayLog(serviceMock)
+			h := NewGamePlayLog(NewContext(), serviceMock)
 
 			if tt.executeGetGamePlayStats {
 				serviceMock.
@@ -816,7 +1010,7 @@ func TestDeleteGamePlayLog(t *testing.T) {
 			t.Parallel()
 
 			serviceMock := mock.NewMockGamePlayLogV2(ctrl)
-			h := NewGamePlayLog(serviceMock)
+			h := NewGamePlayLog(NewContext(), serviceMock)
 
 			serviceMock.EXPECT().
 				DeleteGamePlayLog(gomock.Any(), testCase.editionID, testCase.gameID, testCase.playLogID).
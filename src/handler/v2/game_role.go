@@ -2,13 +2,14 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -66,8 +67,11 @@ func (gameRole *GameRole) PatchGameRole(ctx echo.Context, gameID openapi.GameIDI
 		if errors.Is(err, service.ErrCannotEditOwners) {
 			return echo.NewHTTPError(http.StatusBadRequest, "you cannot change the user role because there is only 1 owner")
 		}
+		if errors.Is(err, service.ErrInvalidRole) {
+			return echo.NewHTTPError(http.StatusBadRequest, "the user is not a manager of this game, invite the user instead")
+		}
 		if err != nil {
-			log.Printf("error: failed to edit game management role: %v\n", err)
+			logger.Error(ctx.Request().Context(), "failed to edit game management role", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to edit game management role")
 		}
 	}
@@ -78,7 +82,7 @@ func (gameRole *GameRole) PatchGameRole(ctx echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game")
 	}
 
@@ -95,7 +99,7 @@ func (gameRole *GameRole) PatchGameRole(ctx echo.Context, gameID openapi.GameIDI
 	var visibility openapi.GameVisibility
 	visibility, err = convertGameVisibility(newGameInfo.Game.GetVisibility())
 	if err != nil {
-		log.Printf("error: failed to convert game visibility: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to convert game visibility", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game visibility")
 	}
 
@@ -130,7 +134,7 @@ func (gameRole *GameRole) DeleteGameRole(ctx echo.Context, gameID openapi.GameID
 		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
 	}
 
-	err = gameRole.gameRoleService.RemoveGameManagementRole(ctx.Request().Context(), values.GameID(gameID), values.TraPMemberID(userID))
+	err = gameRole.gameRoleService.RemoveGameManagementRole(ctx.Request().Context(), authSession, values.GameID(gameID), values.TraPMemberID(userID))
 	if errors.Is(err, service.ErrInvalidRole) {
 		return echo.NewHTTPError(http.StatusNotFound, "the user does not have any role")
 	}
@@ -141,7 +145,7 @@ func (gameRole *GameRole) DeleteGameRole(ctx echo.Context, gameID openapi.GameID
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to remove game management role: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to remove game management role", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove game management role")
 	}
 
@@ -151,7 +155,7 @@ func (gameRole *GameRole) DeleteGameRole(ctx echo.Context, gameID openapi.GameID
 		return echo.NewHTTPError(http.StatusNotFound, "no game")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game")
 	}
 
@@ -167,7 +171,7 @@ func (gameRole *GameRole) DeleteGameRole(ctx echo.Context, gameID openapi.GameID
 
 	resVisibility, err := convertGameVisibility(newGameInfo.Game.GetVisibility())
 	if err != nil {
-		log.Printf("error: failed to convert game visibility: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to convert game visibility", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game visibility")
 	}
 
@@ -189,3 +193,259 @@ func (gameRole *GameRole) DeleteGameRole(ctx echo.Context, gameID openapi.GameID
 
 	return ctx.JSON(http.StatusOK, resGame)
 }
+
+// ゲームの管理権限への招待
+// (POST /games/{gameID}/invitations)
+func (gameRole *GameRole) PostGameRoleInvitation(ctx echo.Context, gameID openapi.GameIDInPath) error {
+	session, err := gameRole.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := gameRole.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	req := &openapi.PostGameRoleInvitationJSONRequestBody{}
+	err = ctx.Bind(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad request body")
+	}
+
+	var roleType values.GameManagementRole
+	switch req.Type {
+	case openapi.Owner:
+		roleType = values.GameManagementRoleAdministrator
+	case openapi.Maintainer:
+		roleType = values.GameManagementRoleCollaborator
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "role type is invalid")
+	}
+
+	invitation, err := gameRole.gameRoleService.InviteGameManagementRole(ctx.Request().Context(), authSession, values.GameID(gameID), values.NewTrapMemberID(req.Id), roleType)
+	if errors.Is(err, service.ErrNoGame) {
+		return echo.NewHTTPError(http.StatusNotFound, "no game")
+	}
+	if errors.Is(err, service.ErrInvalidUserID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "userID is invalid or no user")
+	}
+	if errors.Is(err, service.ErrNoGameManagementRoleUpdated) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the user already has the role")
+	}
+	if errors.Is(err, service.ErrDuplicateGameRoleInvitation) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the user has already been invited")
+	}
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to invite game management role", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to invite game management role")
+	}
+
+	return ctx.JSON(http.StatusCreated, openapi.GameRoleInvitation{
+		Id:        uuid.UUID(invitation.GetID()),
+		Inviter:   uuid.UUID(invitation.GetInviterID()),
+		Invitee:   uuid.UUID(invitation.GetInviteeID()),
+		Type:      req.Type,
+		CreatedAt: invitation.GetCreatedAt(),
+		ExpiresAt: invitation.GetExpiresAt(),
+	})
+}
+
+// ログイン中ユーザーへのゲームの管理権限への招待の一覧の取得
+// (GET /users/me/invitations)
+func (gameRole *GameRole) GetMyGameRoleInvitations(ctx echo.Context) error {
+	session, err := gameRole.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := gameRole.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	invitations, err := gameRole.gameRoleService.GetMyGameRoleInvitations(ctx.Request().Context(), authSession)
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to get game role invitations", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game role invitations")
+	}
+
+	res := make([]openapi.MyGameRoleInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		var roleType openapi.GameRoleType
+		switch invitation.Invitation.GetRole() {
+		case values.GameManagementRoleAdministrator:
+			roleType = openapi.Owner
+		case values.GameManagementRoleCollaborator:
+			roleType = openapi.Maintainer
+		default:
+			logger.Error(ctx.Request().Context(), "invalid role", slog.Any("role", invitation.Invitation.GetRole()))
+			return echo.NewHTTPError(http.StatusInternalServerError, "invalid role")
+		}
+
+		visibility, err := convertGameVisibility(invitation.Game.GetVisibility())
+		if err != nil {
+			logger.Error(ctx.Request().Context(), "failed to convert game visibility", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game visibility")
+		}
+
+		res = append(res, openapi.MyGameRoleInvitation{
+			Id: uuid.UUID(invitation.Invitation.GetID()),
+			Game: openapi.GameInfo{
+				Id:          uuid.UUID(invitation.Game.GetID()),
+				Name:        string(invitation.Game.GetName()),
+				Description: string(invitation.Game.GetDescription()),
+				Visibility:  visibility,
+				CreatedAt:   invitation.Game.GetCreatedAt(),
+			},
+			Inviter:   uuid.UUID(invitation.Invitation.GetInviterID()),
+			Type:      roleType,
+			CreatedAt: invitation.Invitation.GetCreatedAt(),
+			ExpiresAt: invitation.Invitation.GetExpiresAt(),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// ゲームの管理権限への招待の承諾
+// (POST /users/me/invitations/{invitationID}/accept)
+func (gameRole *GameRole) PostAcceptGameRoleInvitation(ctx echo.Context, invitationID openapi.InvitationIDInPath) error {
+	session, err := gameRole.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := gameRole.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	err = gameRole.gameRoleService.AcceptGameRoleInvitation(ctx.Request().Context(), authSession, values.NewGameRoleInvitationIDFromUUID(invitationID))
+	if errors.Is(err, service.ErrInvalidGameRoleInvitationID) {
+		return echo.NewHTTPError(http.StatusNotFound, "no invitation")
+	}
+	if errors.Is(err, service.ErrNoGame) {
+		return echo.NewHTTPError(http.StatusNotFound, "no game")
+	}
+	if errors.Is(err, service.ErrGameRoleInvitationAlreadyAnswered) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the invitation has already been answered")
+	}
+	if errors.Is(err, service.ErrGameRoleInvitationExpired) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the invitation has expired")
+	}
+	if errors.Is(err, service.ErrCannotEditOwners) {
+		return echo.NewHTTPError(http.StatusBadRequest, "you cannot change your role because there is only 1 owner")
+	}
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to accept game role invitation", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to accept game role invitation")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ゲームの管理権限への招待の辞退
+// (POST /users/me/invitations/{invitationID}/decline)
+func (gameRole *GameRole) PostDeclineGameRoleInvitation(ctx echo.Context, invitationID openapi.InvitationIDInPath) error {
+	session, err := gameRole.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := gameRole.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	err = gameRole.gameRoleService.DeclineGameRoleInvitation(ctx.Request().Context(), authSession, values.NewGameRoleInvitationIDFromUUID(invitationID))
+	if errors.Is(err, service.ErrInvalidGameRoleInvitationID) {
+		return echo.NewHTTPError(http.StatusNotFound, "no invitation")
+	}
+	if errors.Is(err, service.ErrGameRoleInvitationAlreadyAnswered) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the invitation has already been answered")
+	}
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to decline game role invitation", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to decline game role invitation")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ゲームのownerの譲渡
+// (POST /games/{gameID}/roles/transfer)
+func (gameRole *GameRole) PostGameOwnershipTransfer(ctx echo.Context, gameID openapi.GameIDInPath) error {
+	session, err := gameRole.session.get(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no session")
+	}
+	authSession, err := gameRole.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no auth session")
+	}
+
+	req := &openapi.PostGameOwnershipTransferJSONRequestBody{}
+	err = ctx.Bind(req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad request body")
+	}
+
+	err = gameRole.gameRoleService.TransferGameOwnership(ctx.Request().Context(), authSession, values.GameID(gameID), values.NewTrapMemberID(req.Id))
+	if errors.Is(err, service.ErrNoGame) {
+		return echo.NewHTTPError(http.StatusNotFound, "no game")
+	}
+	if errors.Is(err, service.ErrInvalidUserID) {
+		return echo.NewHTTPError(http.StatusBadRequest, "userID is invalid or no user")
+	}
+	if errors.Is(err, service.ErrNoGameManagementRoleUpdated) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the user is already an owner")
+	}
+	if errors.Is(err, service.ErrInvalidRole) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the user is not a manager of this game")
+	}
+	if errors.Is(err, service.ErrForbidden) {
+		return echo.NewHTTPError(http.StatusForbidden, "you are not an owner of the game")
+	}
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to transfer game ownership", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to transfer game ownership")
+	}
+
+	newGameInfo, err := gameRole.gameService.GetGame(ctx.Request().Context(), authSession, values.GameID(gameID))
+	if errors.Is(err, service.ErrNoGame) {
+		return echo.NewHTTPError(http.StatusNotFound, "no game")
+	}
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to get game", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game")
+	}
+
+	resOwners := make([]string, 0, len(newGameInfo.Owners))
+	for _, owner := range newGameInfo.Owners {
+		resOwners = append(resOwners, string(owner.GetName()))
+	}
+
+	resMaintainers := make([]string, 0, len(newGameInfo.Maintainers))
+	for _, maintainer := range newGameInfo.Maintainers {
+		resMaintainers = append(resMaintainers, string(maintainer.GetName()))
+	}
+
+	resVisibility, err := convertGameVisibility(newGameInfo.Game.GetVisibility())
+	if err != nil {
+		logger.Error(ctx.Request().Context(), "failed to convert game visibility", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game visibility")
+	}
+
+	resGenres := make([]openapi.GameGenreName, 0, len(newGameInfo.Genres))
+	for _, genre := range newGameInfo.Genres {
+		resGenres = append(resGenres, openapi.GameGenreName(genre.GetName()))
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.Game{
+		Id:          uuid.UUID(newGameInfo.Game.GetID()),
+		Name:        string(newGameInfo.Game.GetName()),
+		Description: string(newGameInfo.Game.GetDescription()),
+		CreatedAt:   newGameInfo.Game.GetCreatedAt(),
+		Owners:      resOwners,
+		Maintainers: &resMaintainers,
+		Genres:      &resGenres,
+		Visibility:  resVisibility,
+	})
+}
@@ -245,6 +245,16 @@ func TestPatchGameRole(t *testing.T) {
 			statusCode:                    http.StatusBadRequest,
 			isErr:                         true,
 		},
+		"EditGameManagementRoleがErrInvalidRoleなので400": {
+			gameID:                        openapi.GameIDInPath(game.GetID()),
+			sessionExist:                  true,
+			authSession:                   validAuthSession,
+			reqBody:                       ownerRequestBody,
+			executeEditGameManagementRole: true,
+			EditGameManagementRoleErr:     service.ErrInvalidRole,
+			statusCode:                    http.StatusBadRequest,
+			isErr:                         true,
+		},
 		"EditGameManagementRoleがエラーなので500": {
 			gameID:                        openapi.GameIDInPath(game.GetID()),
 			sessionExist:                  true,
@@ -588,7 +598,7 @@ func TestDeleteGameRole(t *testing.T) {
 			if testCase.executeDeleteGameManagementRole {
 				mockGameRoleService.
 					EXPECT().
-					RemoveGameManagementRole(gomock.Any(), values.GameID(testCase.gameID), values.NewTrapMemberID(testCase.userID)).
+					RemoveGameManagementRole(gomock.Any(), gomock.Any(), values.GameID(testCase.gameID), values.NewTrapMemberID(testCase.userID)).
 					Return(testCase.DeleteGameManagementRoleErr)
 			}
 
@@ -648,3 +658,693 @@ func TestDeleteGameRole(t *testing.T) {
 		})
 	}
 }
+
+func TestPostGameRoleInvitation(t *testing.T) {
+	t.Parallel()
+
+	gameID := values.NewGameID()
+	userID := values.NewTrapMemberID(uuid.New())
+	inviterID := values.NewTrapMemberID(uuid.New())
+	now := time.Now()
+
+	validAuthSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		sessionExist       bool
+		authSession        *domain.OIDCSession
+		reqBody            *openapi.PostGameRoleInvitationJSONRequestBody
+		executeInvite      bool
+		role               values.GameManagementRole
+		invitation         *domain.GameRoleInvitation
+		InviteErr          error
+		isErr              bool
+		statusCode         int
+		expectedInvitation *openapi.GameRoleInvitation
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			invitation: domain.NewGameRoleInvitation(
+				values.NewGameRoleInvitationID(), inviterID, userID,
+				values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			expectedInvitation: &openapi.GameRoleInvitation{
+				Inviter:   uuid.UUID(inviterID),
+				Invitee:   uuid.UUID(userID),
+				Type:      openapi.Maintainer,
+				CreatedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+			},
+		},
+		"ownerへの招待でもエラー無し": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Owner},
+			executeInvite: true,
+			role:          values.GameManagementRoleAdministrator,
+			invitation: domain.NewGameRoleInvitation(
+				values.NewGameRoleInvitationID(), inviterID, userID,
+				values.GameManagementRoleAdministrator, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			expectedInvitation: &openapi.GameRoleInvitation{
+				Inviter:   uuid.UUID(inviterID),
+				Invitee:   uuid.UUID(userID),
+				Type:      openapi.Owner,
+				CreatedAt: now,
+				ExpiresAt: now.Add(time.Hour),
+			},
+		},
+		"セッションが無いので401": {
+			sessionExist: false,
+			reqBody:      &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
+		"roleが不正なので400": {
+			sessionExist: true,
+			authSession:  validAuthSession,
+			reqBody:      &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: "invalid"},
+			isErr:        true,
+			statusCode:   http.StatusBadRequest,
+		},
+		"ゲームが存在しないので404": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			InviteErr:     service.ErrNoGame,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"ユーザーが不正なので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			InviteErr:     service.ErrInvalidUserID,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"既に同じroleを持っているので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			InviteErr:     service.ErrNoGameManagementRoleUpdated,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"既に招待済みなので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			InviteErr:     service.ErrDuplicateGameRoleInvitation,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"InviteGameManagementRoleがエラーなので500": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			reqBody:       &openapi.PostGameRoleInvitationJSONRequestBody{Id: uuid.UUID(userID), Type: openapi.Maintainer},
+			executeInvite: true,
+			role:          values.GameManagementRoleCollaborator,
+			InviteErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			session := newTestSession(t, ctrl)
+
+			mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
+			mockGameService := mock.NewMockGameV2(ctrl)
+
+			gameRole := NewGameRole(mockGameRoleService, mockGameService, session)
+
+			c, req, rec := setupTestRequest(
+				t,
+				http.MethodPost,
+				fmt.Sprintf("/api/v2/games/%s/invitations", uuid.UUID(gameID).String()),
+				withJSONBody(t, testCase.reqBody),
+			)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, testCase.authSession)
+			}
+
+			if testCase.executeInvite {
+				mockGameRoleService.
+					EXPECT().
+					InviteGameManagementRole(gomock.Any(), gomock.Any(), gameID, userID, testCase.role).
+					Return(testCase.invitation, testCase.InviteErr)
+			}
+
+			err := gameRole.PostGameRoleInvitation(c, openapi.GameIDInPath(gameID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var res openapi.GameRoleInvitation
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, uuid.UUID(testCase.invitation.GetID()), res.Id)
+			assert.Equal(t, testCase.expectedInvitation.Inviter, res.Inviter)
+			assert.Equal(t, testCase.expectedInvitation.Invitee, res.Invitee)
+			assert.Equal(t, testCase.expectedInvitation.Type, res.Type)
+			assert.WithinDuration(t, testCase.expectedInvitation.CreatedAt, res.CreatedAt, time.Second)
+			assert.WithinDuration(t, testCase.expectedInvitation.ExpiresAt, res.ExpiresAt, time.Second)
+		})
+	}
+}
+
+func TestGetMyGameRoleInvitations(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	game := domain.NewGame(values.NewGameID(), "game1", "game1 description", values.GameVisibilityTypeLimited, now)
+	inviterID := values.NewTrapMemberID(uuid.New())
+	inviteeID := values.NewTrapMemberID(uuid.New())
+	invitation1 := domain.NewGameRoleInvitation(
+		values.NewGameRoleInvitationID(), inviterID, inviteeID,
+		values.GameManagementRoleAdministrator, values.GameRoleInvitationStatusPending,
+		now, now.Add(time.Hour),
+	)
+	invitation2 := domain.NewGameRoleInvitation(
+		values.NewGameRoleInvitationID(), inviterID, inviteeID,
+		values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending,
+		now.Add(-time.Minute), now.Add(time.Hour),
+	)
+
+	validAuthSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		sessionExist  bool
+		authSession   *domain.OIDCSession
+		executeGet    bool
+		invitations   []*service.GameRoleInvitationInfo
+		GetErr        error
+		isErr         bool
+		statusCode    int
+		expectedTypes []openapi.GameRoleType
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist: true,
+			authSession:  validAuthSession,
+			executeGet:   true,
+			invitations: []*service.GameRoleInvitationInfo{
+				{Invitation: invitation1, Game: game},
+				{Invitation: invitation2, Game: game},
+			},
+			expectedTypes: []openapi.GameRoleType{openapi.Owner, openapi.Maintainer},
+		},
+		"招待が無くてもエラー無し": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeGet:    true,
+			invitations:   []*service.GameRoleInvitationInfo{},
+			expectedTypes: []openapi.GameRoleType{},
+		},
+		"セッションが無いので401": {
+			sessionExist: false,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
+		"GetMyGameRoleInvitationsがエラーなので500": {
+			sessionExist: true,
+			authSession:  validAuthSession,
+			executeGet:   true,
+			GetErr:       errors.New("error"),
+			isErr:        true,
+			statusCode:   http.StatusInternalServerError,
+		},
+		"ゲームのvisibilityが不正なので500": {
+			sessionExist: true,
+			authSession:  validAuthSession,
+			executeGet:   true,
+			invitations: []*service.GameRoleInvitationInfo{
+				{
+					Invitation: invitation1,
+					Game:       domain.NewGame(game.GetID(), game.GetName(), game.GetDescription(), values.GameVisibility(100), now),
+				},
+			},
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			session := newTestSession(t, ctrl)
+
+			mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
+			mockGameService := mock.NewMockGameV2(ctrl)
+
+			gameRole := NewGameRole(mockGameRoleService, mockGameService, session)
+
+			c, req, rec := setupTestRequest(t, http.MethodGet, "/api/v2/users/me/invitations", nil)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, testCase.authSession)
+			}
+
+			if testCase.executeGet {
+				mockGameRoleService.
+					EXPECT().
+					GetMyGameRoleInvitations(gomock.Any(), gomock.Any()).
+					Return(testCase.invitations, testCase.GetErr)
+			}
+
+			err := gameRole.GetMyGameRoleInvitations(c)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			var res []openapi.MyGameRoleInvitation
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			require.Len(t, res, len(testCase.invitations))
+			for i, invitation := range testCase.invitations {
+				assert.Equal(t, uuid.UUID(invitation.Invitation.GetID()), res[i].Id)
+				assert.Equal(t, uuid.UUID(invitation.Invitation.GetInviterID()), res[i].Inviter)
+				assert.Equal(t, testCase.expectedTypes[i], res[i].Type)
+				assert.Equal(t, uuid.UUID(invitation.Game.GetID()), res[i].Game.Id)
+				assert.Equal(t, string(invitation.Game.GetName()), res[i].Game.Name)
+				assert.Equal(t, openapi.Limited, res[i].Game.Visibility)
+				assert.WithinDuration(t, invitation.Invitation.GetCreatedAt(), res[i].CreatedAt, time.Second)
+				assert.WithinDuration(t, invitation.Invitation.GetExpiresAt(), res[i].ExpiresAt, time.Second)
+			}
+		})
+	}
+}
+
+func TestPostAcceptGameRoleInvitation(t *testing.T) {
+	t.Parallel()
+
+	invitationID := values.NewGameRoleInvitationID()
+
+	validAuthSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		sessionExist  bool
+		authSession   *domain.OIDCSession
+		executeAccept bool
+		AcceptErr     error
+		isErr         bool
+		statusCode    int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+		},
+		"セッションが無いので401": {
+			sessionExist: false,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
+		"招待が存在しないので404": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     service.ErrInvalidGameRoleInvitationID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"ゲームが存在しないので404": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     service.ErrNoGame,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"応答済みなので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     service.ErrGameRoleInvitationAlreadyAnswered,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"期限切れなので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     service.ErrGameRoleInvitationExpired,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"ownerがいなくなるので400": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     service.ErrCannotEditOwners,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"AcceptGameRoleInvitationがエラーなので500": {
+			sessionExist:  true,
+			authSession:   validAuthSession,
+			executeAccept: true,
+			AcceptErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			session := newTestSession(t, ctrl)
+
+			mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
+			mockGameService := mock.NewMockGameV2(ctrl)
+
+			gameRole := NewGameRole(mockGameRoleService, mockGameService, session)
+
+			c, req, rec := setupTestRequest(
+				t,
+				http.MethodPost,
+				fmt.Sprintf("/api/v2/users/me/invitations/%s/accept", uuid.UUID(invitationID).String()),
+				nil,
+			)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, testCase.authSession)
+			}
+
+			if testCase.executeAccept {
+				mockGameRoleService.
+					EXPECT().
+					AcceptGameRoleInvitation(gomock.Any(), gomock.Any(), invitationID).
+					Return(testCase.AcceptErr)
+			}
+
+			err := gameRole.PostAcceptGameRoleInvitation(c, openapi.InvitationIDInPath(invitationID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		})
+	}
+}
+
+func TestPostDeclineGameRoleInvitation(t *testing.T) {
+	t.Parallel()
+
+	invitationID := values.NewGameRoleInvitationID()
+
+	validAuthSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		sessionExist   bool
+		authSession    *domain.OIDCSession
+		executeDecline bool
+		DeclineErr     error
+		isErr          bool
+		statusCode     int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:   true,
+			authSession:    validAuthSession,
+			executeDecline: true,
+		},
+		"セッションが無いので401": {
+			sessionExist: false,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
+		"招待が存在しないので404": {
+			sessionExist:   true,
+			authSession:    validAuthSession,
+			executeDecline: true,
+			DeclineErr:     service.ErrInvalidGameRoleInvitationID,
+			isErr:          true,
+			statusCode:     http.StatusNotFound,
+		},
+		"応答済みなので400": {
+			sessionExist:   true,
+			authSession:    validAuthSession,
+			executeDecline: true,
+			DeclineErr:     service.ErrGameRoleInvitationAlreadyAnswered,
+			isErr:          true,
+			statusCode:     http.StatusBadRequest,
+		},
+		"DeclineGameRoleInvitationがエラーなので500": {
+			sessionExist:   true,
+			authSession:    validAuthSession,
+			executeDecline: true,
+			DeclineErr:     errors.New("error"),
+			isErr:          true,
+			statusCode:     http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			session := newTestSession(t, ctrl)
+
+			mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
+			mockGameService := mock.NewMockGameV2(ctrl)
+
+			gameRole := NewGameRole(mockGameRoleService, mockGameService, session)
+
+			c, req, rec := setupTestRequest(
+				t,
+				http.MethodPost,
+				fmt.Sprintf("/api/v2/users/me/invitations/%s/decline", uuid.UUID(invitationID).String()),
+				nil,
+			)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, testCase.authSession)
+			}
+
+			if testCase.executeDecline {
+				mockGameRoleService.
+					EXPECT().
+					DeclineGameRoleInvitation(gomock.Any(), gomock.Any(), invitationID).
+					Return(testCase.DeclineErr)
+			}
+
+			err := gameRole.PostDeclineGameRoleInvitation(c, openapi.InvitationIDInPath(invitationID))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		})
+	}
+}
+
+func TestPostGameOwnershipTransfer(t *testing.T) {
+	t.Parallel()
+
+	game := domain.NewGame(values.NewGameID(), "game1", "game1 description", values.GameVisibilityTypePublic, time.Now())
+	user1 := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "user1", values.TrapMemberStatusActive, false)
+	user2 := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "user2", values.TrapMemberStatusActive, false)
+
+	validAuthSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		sessionExist    bool
+		authSession     *domain.OIDCSession
+		executeTransfer bool
+		TransferErr     error
+		executeGetGame  bool
+		gameInfo        *service.GameInfoV2
+		GetGameErr      error
+		isErr           bool
+		statusCode      int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			executeGetGame:  true,
+			gameInfo: &service.GameInfoV2{
+				Game:        game,
+				Owners:      []*service.UserInfo{user2},
+				Maintainers: []*service.UserInfo{user1},
+			},
+		},
+		"セッションが無いので401": {
+			sessionExist: false,
+			isErr:        true,
+			statusCode:   http.StatusUnauthorized,
+		},
+		"ゲームが存在しないので404": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     service.ErrNoGame,
+			isErr:           true,
+			statusCode:      http.StatusNotFound,
+		},
+		"ユーザーが不正なので400": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     service.ErrInvalidUserID,
+			isErr:           true,
+			statusCode:      http.StatusBadRequest,
+		},
+		"既にownerなので400": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     service.ErrNoGameManagementRoleUpdated,
+			isErr:           true,
+			statusCode:      http.StatusBadRequest,
+		},
+		"譲渡先が管理者でないので400": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     service.ErrInvalidRole,
+			isErr:           true,
+			statusCode:      http.StatusBadRequest,
+		},
+		"ownerでないので403": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     service.ErrForbidden,
+			isErr:           true,
+			statusCode:      http.StatusForbidden,
+		},
+		"TransferGameOwnershipがエラーなので500": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			TransferErr:     errors.New("error"),
+			isErr:           true,
+			statusCode:      http.StatusInternalServerError,
+		},
+		"GetGameがエラーなので500": {
+			sessionExist:    true,
+			authSession:     validAuthSession,
+			executeTransfer: true,
+			executeGetGame:  true,
+			GetGameErr:      errors.New("error"),
+			isErr:           true,
+			statusCode:      http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			session := newTestSession(t, ctrl)
+
+			mockGameRoleService := mock.NewMockGameRoleV2(ctrl)
+			mockGameService := mock.NewMockGameV2(ctrl)
+
+			gameRole := NewGameRole(mockGameRoleService, mockGameService, session)
+
+			c, req, rec := setupTestRequest(
+				t,
+				http.MethodPost,
+				fmt.Sprintf("/api/v2/games/%s/roles/transfer", uuid.UUID(game.GetID()).String()),
+				withJSONBody(t, &openapi.PostGameOwnershipTransferJSONRequestBody{Id: uuid.UUID(user2.GetID())}),
+			)
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, testCase.authSession)
+			}
+
+			if testCase.executeTransfer {
+				mockGameRoleService.
+					EXPECT().
+					TransferGameOwnership(gomock.Any(), gomock.Any(), game.GetID(), user2.GetID()).
+					Return(testCase.TransferErr)
+			}
+
+			if testCase.executeGetGame {
+				mockGameService.
+					EXPECT().
+					GetGame(gomock.Any(), gomock.Any(), game.GetID()).
+					Return(testCase.gameInfo, testCase.GetGameErr)
+			}
+
+			err := gameRole.PostGameOwnershipTransfer(c, openapi.GameIDInPath(game.GetID()))
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			var res openapi.Game
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, uuid.UUID(game.GetID()), res.Id)
+			assert.Equal(t, []openapi.UserName{string(user2.GetName())}, res.Owners)
+			require.NotNil(t, res.Maintainers)
+			assert.Equal(t, []openapi.UserName{string(user1.GetName())}, *res.Maintainers)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+type GameStorage struct {
+	gameStorageService service.GameStorageV2
+	session            *Session
+}
+
+func NewGameStorage(gameStorageService service.GameStorageV2, session *Session) *GameStorage {
+	return &GameStorage{
+		gameStorageService: gameStorageService,
+		session:            session,
+	}
+}
+
+// ゲームのストレージ使用量の取得
+// (GET /games/{gameID}/storage)
+func (gameStorage *GameStorage) GetGameStorage(c echo.Context, gameID openapi.GameIDInPath) error {
+	info, err := gameStorage.gameStorageService.GetGameStorage(c.Request().Context(), values.NewGameIDFromUUID(gameID))
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get game storage", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game storage")
+	}
+
+	return c.JSON(http.StatusOK, convertGameStorageInfo(info))
+}
+
+// ゲームのストレージの上限の設定
+// (PUT /games/{gameID}/storage/quota)
+func (gameStorage *GameStorage) PutGameStorageQuota(c echo.Context, gameID openapi.GameIDInPath) error {
+	session, err := gameStorage.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameStorage.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var reqBody openapi.PutGameStorageQuotaJSONRequestBody
+	if err := c.Bind(&reqBody); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	info, err := gameStorage.gameStorageService.UpdateGameStorageQuota(
+		c.Request().Context(),
+		authSession,
+		values.NewGameIDFromUUID(gameID),
+		values.GameStorageSize(reqBody.Quota),
+	)
+	if errors.Is(err, service.ErrInvalidGameStorageQuota) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid quota")
+	}
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to update game storage quota", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update game storage quota")
+	}
+
+	return c.JSON(http.StatusOK, convertGameStorageInfo(info))
+}
+
+// ゲームのストレージの上限の削除
+// (DELETE /games/{gameID}/storage/quota)
+func (gameStorage *GameStorage) DeleteGameStorageQuota(c echo.Context, gameID openapi.GameIDInPath) error {
+	session, err := gameStorage.session.get(c)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
+	}
+	authSession, err := gameStorage.session.getAuthSession(session)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	info, err := gameStorage.gameStorageService.DeleteGameStorageQuota(
+		c.Request().Context(),
+		authSession,
+		values.NewGameIDFromUUID(gameID),
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if errors.Is(err, service.ErrNoGameStorageQuota) {
+		return echo.NewHTTPError(http.StatusNotFound, "game storage quota is not set")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to delete game storage quota", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete game storage quota")
+	}
+
+	return c.JSON(http.StatusOK, convertGameStorageInfo(info))
+}
+
+func convertGameStorageInfo(info *service.GameStorageInfo) openapi.GameStorage {
+	res := openapi.GameStorage{
+		Usage: openapi.GameStorageUsage{
+			File:  openapi.GameStorageSize(info.Usage.GetFile()),
+			Image: openapi.GameStorageSize(info.Usage.GetImage()),
+			Video: openapi.GameStorageSize(info.Usage.GetVideo()),
+			Total: openapi.GameStorageSize(info.Usage.GetTotal()),
+		},
+		QuotaOverridden: info.IsQuotaOverridden,
+	}
+
+	if quota, ok := info.Quota.Value(); ok {
+		q := openapi.GameStorageSize(quota)
+		res.Quota = &q
+	}
+
+	return res
+}
@@ -0,0 +1,364 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/session"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func newGameStorageHandlerForTest(t *testing.T, ctrl *gomock.Controller, gameStorageService service.GameStorageV2) (*GameStorage, *Session) {
+	t.Helper()
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	require.NoError(t, err)
+	session, err := NewSession(sess)
+	require.NoError(t, err)
+
+	return NewGameStorage(gameStorageService, session), session
+}
+
+func TestGetGameStorage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameStorageService := mock.NewMockGameStorageV2(ctrl)
+	gameStorageHandler, _ := newGameStorageHandlerForTest(t, ctrl, mockGameStorageService)
+
+	quota := openapi.GameStorageSize(10000)
+
+	testCases := map[string]struct {
+		info          *service.GameStorageInfo
+		getStorageErr error
+		resBody       openapi.GameStorage
+		isErr         bool
+		statusCode    int
+	}{
+		"特に問題ないのでエラー無し": {
+			info: &service.GameStorageInfo{
+				Usage:             domain.NewGameStorageUsage(100, 200, 300),
+				Quota:             option.NewOption[values.GameStorageSize](10000),
+				IsQuotaOverridden: true,
+			},
+			resBody: openapi.GameStorage{
+				Usage: openapi.GameStorageUsage{
+					File:  100,
+					Image: 200,
+					Video: 300,
+					Total: 600,
+				},
+				Quota:           &quota,
+				QuotaOverridden: true,
+			},
+			statusCode: http.StatusOK,
+		},
+		"上限がなくてもエラー無し": {
+			info: &service.GameStorageInfo{
+				Usage: domain.NewGameStorageUsage(100, 200, 300),
+			},
+			resBody: openapi.GameStorage{
+				Usage: openapi.GameStorageUsage{
+					File:  100,
+					Image: 200,
+					Video: 300,
+					Total: 600,
+				},
+			},
+			statusCode: http.StatusOK,
+		},
+		"GetGameStorageがErrInvalidGameIDなので404": {
+			getStorageErr: service.ErrInvalidGameID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"GetGameStorageがエラーなので500": {
+			getStorageErr: errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gameID := uuid.New()
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/games/%s/storage", gameID), nil)
+
+			mockGameStorageService.
+				EXPECT().
+				GetGameStorage(gomock.Any(), values.NewGameIDFromUUID(gameID)).
+				Return(testCase.info, testCase.getStorageErr)
+
+			err := gameStorageHandler.GetGameStorage(c, gameID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameStorage
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody, res)
+		})
+	}
+}
+
+func TestPutGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameStorageService := mock.NewMockGameStorageV2(ctrl)
+	gameStorageHandler, session := newGameStorageHandlerForTest(t, ctrl, mockGameStorageService)
+
+	quota := openapi.GameStorageSize(10000)
+
+	testCases := map[string]struct {
+		sessionExist       bool
+		invalidRequestBody bool
+		executeUpdate      bool
+		info               *service.GameStorageInfo
+		updateErr          error
+		resBody            openapi.GameStorage
+		isErr              bool
+		statusCode         int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:  true,
+			executeUpdate: true,
+			info: &service.GameStorageInfo{
+				Usage:             domain.NewGameStorageUsage(100, 200, 300),
+				Quota:             option.NewOption[values.GameStorageSize](10000),
+				IsQuotaOverridden: true,
+			},
+			resBody: openapi.GameStorage{
+				Usage: openapi.GameStorageUsage{
+					File:  100,
+					Image: 200,
+					Video: 300,
+					Total: 600,
+				},
+				Quota:           &quota,
+				QuotaOverridden: true,
+			},
+			statusCode: http.StatusOK,
+		},
+		"セッションが無いので401": {
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"リクエストボディがおかしいので400": {
+			sessionExist:       true,
+			invalidRequestBody: true,
+			isErr:              true,
+			statusCode:         http.StatusBadRequest,
+		},
+		"UpdateGameStorageQuotaがErrInvalidGameStorageQuotaなので400": {
+			sessionExist:  true,
+			executeUpdate: true,
+			updateErr:     service.ErrInvalidGameStorageQuota,
+			isErr:         true,
+			statusCode:    http.StatusBadRequest,
+		},
+		"UpdateGameStorageQuotaがErrInvalidGameIDなので404": {
+			sessionExist:  true,
+			executeUpdate: true,
+			updateErr:     service.ErrInvalidGameID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"UpdateGameStorageQuotaがエラーなので500": {
+			sessionExist:  true,
+			executeUpdate: true,
+			updateErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gameID := uuid.New()
+
+			var bodyOpt bodyOpt
+			if !testCase.invalidRequestBody {
+				bodyOpt = withJSONBody(t, openapi.PutGameStorageQuotaJSONRequestBody{Quota: quota})
+			} else {
+				bodyOpt = withStringBody(t, "invalid request body")
+			}
+
+			c, req, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/api/v2/games/%s/storage/quota", gameID), bodyOpt)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeUpdate {
+				mockGameStorageService.
+					EXPECT().
+					UpdateGameStorageQuota(gomock.Any(), gomock.Any(), values.NewGameIDFromUUID(gameID), values.GameStorageSize(quota)).
+					Return(testCase.info, testCase.updateErr)
+			}
+
+			err := gameStorageHandler.PutGameStorageQuota(c, gameID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameStorage
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody, res)
+		})
+	}
+}
+
+func TestDeleteGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameStorageService := mock.NewMockGameStorageV2(ctrl)
+	gameStorageHandler, session := newGameStorageHandlerForTest(t, ctrl, mockGameStorageService)
+
+	testCases := map[string]struct {
+		sessionExist  bool
+		executeDelete bool
+		info          *service.GameStorageInfo
+		deleteErr     error
+		resBody       openapi.GameStorage
+		isErr         bool
+		statusCode    int
+	}{
+		"特に問題ないのでエラー無し": {
+			sessionExist:  true,
+			executeDelete: true,
+			info: &service.GameStorageInfo{
+				Usage: domain.NewGameStorageUsage(100, 200, 300),
+			},
+			resBody: openapi.GameStorage{
+				Usage: openapi.GameStorageUsage{
+					File:  100,
+					Image: 200,
+					Video: 300,
+					Total: 600,
+				},
+			},
+			statusCode: http.StatusOK,
+		},
+		"セッションが無いので401": {
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
+		"DeleteGameStorageQuotaがErrInvalidGameIDなので404": {
+			sessionExist:  true,
+			executeDelete: true,
+			deleteErr:     service.ErrInvalidGameID,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"DeleteGameStorageQuotaがErrNoGameStorageQuotaなので404": {
+			sessionExist:  true,
+			executeDelete: true,
+			deleteErr:     service.ErrNoGameStorageQuota,
+			isErr:         true,
+			statusCode:    http.StatusNotFound,
+		},
+		"DeleteGameStorageQuotaがエラーなので500": {
+			sessionExist:  true,
+			executeDelete: true,
+			deleteErr:     errors.New("error"),
+			isErr:         true,
+			statusCode:    http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gameID := uuid.New()
+
+			c, req, rec := setupTestRequest(t, http.MethodDelete, fmt.Sprintf("/api/v2/games/%s/storage/quota", gameID), nil)
+
+			authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
+
+			if testCase.executeDelete {
+				mockGameStorageService.
+					EXPECT().
+					DeleteGameStorageQuota(gomock.Any(), gomock.Any(), values.NewGameIDFromUUID(gameID)).
+					Return(testCase.info, testCase.deleteErr)
+			}
+
+			err := gameStorageHandler.DeleteGameStorageQuota(c, gameID)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				} else {
+					t.Errorf("err must be http error, but not http error: %v", err)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.statusCode, rec.Code)
+
+			var res openapi.GameStorage
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.resBody, res)
+		})
+	}
+}
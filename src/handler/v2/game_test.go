@@ -86,22 +86,38 @@ func TestGetGames(t *testing.T) {
 	value3 := 3
 	sortTypeCreatedAt := openapi.CreatedAt
 	sortTypeLatestVersion := openapi.LatestVersion
+	sortTypePlayCount := openapi.PlayCount
+	sortTypeLatestFeedback := openapi.LatestFeedback
 	gameNameStr := "test"
+	keywordStr := "パズル"
+
+	facets := domain.NewGameFacets(
+		[]*domain.GameGenreFacet{domain.NewGameGenreFacet(gameGenre1, 2), domain.NewGameGenreFacet(gameGenre2, 1)},
+		[]*domain.GameFileTypeFacet{domain.NewGameFileTypeFacet(values.GameFileTypeWindows, 2)},
+		[]*domain.GameVisibilityFacet{
+			domain.NewGameVisibilityFacet(values.GameVisibilityTypePublic, 1),
+			domain.NewGameVisibilityFacet(values.GameVisibilityTypeLimited, 1),
+		},
+	)
 
 	type test struct {
-		params            openapi.GetGamesParams
-		sessionExist      bool
-		authSession       *domain.OIDCSession
-		executeGetGames   bool
-		GetGamesErr       error
-		executeGetMyGames bool
-		GetMyGamesErr     error
-		games             []*domain.GameWithGenres
-		gamesNumber       int
-		apiGames          openapi.GetGamesResponse
-		isErr             bool
-		err               error
-		statusCode        int
+		params               openapi.GetGamesParams
+		sessionExist         bool
+		authSession          *domain.OIDCSession
+		executeGetGames      bool
+		GetGamesErr          error
+		executeGetMyGames    bool
+		GetMyGamesErr        error
+		executeGetGameFacets bool
+		GetGameFacetsErr     error
+		facets               *domain.GameFacets
+		games                []*domain.GameWithGenres
+		gamesNumber          int
+		apiGames             openapi.GetGamesResponse
+		apiFacets            *openapi.GameFacets
+		isErr                bool
+		err                  error
+		statusCode           int
 	}
 
 	testCases := map[string]test{
@@ -418,6 +434,181 @@ func TestGetGames(t *testing.T) {
 			isErr:             true,
 			statusCode:        http.StatusInternalServerError,
 		},
+		"sortがPlayCountでもエラー無し": {
+			params: openapi.GetGamesParams{
+				Limit:  &value1,
+				Offset: &value0,
+				All:    &valueTrue,
+				Sort:   &sortTypePlayCount,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+		},
+		"sortがLatestFeedbackでもエラー無し": {
+			params: openapi.GetGamesParams{
+				Limit:  &value1,
+				Offset: &value0,
+				All:    &valueTrue,
+				Sort:   &sortTypeLatestFeedback,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+		},
+		"キーワードの指定があってもエラー無し": {
+			params: openapi.GetGamesParams{
+				Limit:   &value1,
+				Offset:  &value0,
+				All:     &valueTrue,
+				Keyword: &keywordStr,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+		},
+		"facetsがtrueなのでファセットも返す": {
+			params: openapi.GetGamesParams{
+				Limit:  &value1,
+				Offset: &value0,
+				All:    &valueTrue,
+				Facets: &valueTrue,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+			executeGetGameFacets: true,
+			facets:               facets,
+			apiFacets: &openapi.GameFacets{
+				Genres: []openapi.GameGenreFacet{
+					{Id: uuid.UUID(gameGenreID1), Genre: string(gameGenreName1), Num: 2},
+					{Id: uuid.UUID(gameGenreID2), Genre: string(gameGenreName2), Num: 1},
+				},
+				FileTypes: []openapi.GameFileTypeFacet{
+					{Type: openapi.Win32, Num: 2},
+				},
+				Visibilities: []openapi.GameVisibilityFacet{
+					{Type: openapi.Public, Num: 1},
+					{Type: openapi.Limited, Num: 1},
+				},
+			},
+		},
+		"facetsがfalseならファセットは返さない": {
+			params: openapi.GetGamesParams{
+				Limit:  &value1,
+				Offset: &value0,
+				All:    &valueTrue,
+				Facets: &valueFalse,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+		},
+		"GetGameFacetsがエラーなのでエラー": {
+			params: openapi.GetGamesParams{
+				Limit:  &value1,
+				Offset: &value0,
+				All:    &valueTrue,
+				Facets: &valueTrue,
+			},
+			sessionExist:    true,
+			authSession:     domain.NewOIDCSession("token", now.Add(time.Hour)),
+			executeGetGames: true,
+			games:           []*domain.GameWithGenres{domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1})},
+			gamesNumber:     2,
+			apiGames: openapi.GetGamesResponse{
+				Games: []openapi.GameInfoWithGenres{
+					{
+						Id:          uuid.UUID(gameID1),
+						Name:        string(gameName1),
+						Description: string(gameDescription1),
+						Visibility:  openapi.Public,
+						CreatedAt:   now.Add(-time.Hour),
+						Genres:      &[]string{string(gameGenreName1)},
+					},
+				},
+				Num: 2,
+			},
+			executeGetGameFacets: true,
+			GetGameFacetsErr:     errors.New("GetGameFacets error"),
+			isErr:                true,
+			statusCode:           http.StatusInternalServerError,
+		},
 	}
 
 	for description, testCase := range testCases {
@@ -440,7 +631,7 @@ func TestGetGames(t *testing.T) {
 					EXPECT().
 					GetGames(
 						gomock.Any(), limit, offset,
-						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(testCase.gamesNumber, testCase.games, testCase.GetGamesErr)
 			}
 
@@ -451,10 +642,19 @@ func TestGetGames(t *testing.T) {
 						gomock.Any(), gomock.Not(gomock.Nil()),
 						int(*testCase.params.Limit), int(*testCase.params.Offset), gomock.Any(),
 						gomock.InAnyOrder([]values.GameVisibility{values.GameVisibilityTypeLimited, values.GameVisibilityTypePrivate, values.GameVisibilityTypePublic}),
-						gomock.Any(), gomock.Any()).
+						gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(testCase.gamesNumber, testCase.games, testCase.GetMyGamesErr)
 			}
 
+			if testCase.executeGetGameFacets {
+				mockGameService.
+					EXPECT().
+					GetGameFacets(
+						gomock.Any(), gomock.Nil(),
+						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(testCase.facets, testCase.GetGameFacetsErr)
+			}
+
 			err := gameHandler.GetGames(c, testCase.params)
 
 			if testCase.isErr {
@@ -499,6 +699,8 @@ func TestGetGames(t *testing.T) {
 					assert.Equal(t, (*testCase.apiGames.Games[i].Genres)[j], (*games[i].Genres)[j])
 				}
 			}
+
+			assert.Equal(t, testCase.apiFacets, response.Facets)
 		})
 	}
 }
@@ -1904,6 +2106,7 @@ func TestPatchGame(t *testing.T) {
 
 	type test struct {
 		description       string
+		sessionExist      bool
 		isBadRequestBody  bool
 		gameID            values.GameID
 		newGame           *openapi.PatchGameJSONRequestBody
@@ -1918,14 +2121,17 @@ func TestPatchGame(t *testing.T) {
 
 	gameID := values.NewGameID()
 
+	authSession := domain.NewOIDCSession(values.NewOIDCAccessToken("token"), time.Now().Add(time.Hour))
+
 	now := time.Now()
 
 	openapiLimited := openapi.Limited
 
 	testCases := []test{
 		{
-			description: "特に問題ないのでエラーなし",
-			gameID:      gameID,
+			description:  "特に問題ないのでエラーなし",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "test",
 				Description: "test",
@@ -1948,8 +2154,9 @@ func TestPatchGame(t *testing.T) {
 			},
 		},
 		{
-			description: "visibilityがnilでもエラーなし",
-			gameID:      gameID,
+			description:  "visibilityがnilでもエラーなし",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "test",
 				Description: "test",
@@ -1972,13 +2179,15 @@ func TestPatchGame(t *testing.T) {
 		},
 		{
 			description:      "リクエストボディが正しくないので400",
+			sessionExist:     true,
 			isBadRequestBody: true,
 			isErr:            true,
 			statusCode:       http.StatusBadRequest,
 		},
 		{
-			description: "名前が空なので400",
-			gameID:      gameID,
+			description:  "名前が空なので400",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "",
 				Description: "test",
@@ -1987,8 +2196,9 @@ func TestPatchGame(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 		},
 		{
-			description: "名前が長すぎるので400",
-			gameID:      gameID,
+			description:  "名前が長すぎるので400",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "012345678901234567890123456789012",
 				Description: "test",
@@ -1997,8 +2207,9 @@ func TestPatchGame(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 		},
 		{
-			description: "説明が空文字でもエラーなし",
-			gameID:      gameID,
+			description:  "説明が空文字でもエラーなし",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "test",
 				Description: "",
@@ -2020,8 +2231,9 @@ func TestPatchGame(t *testing.T) {
 			},
 		},
 		{
-			description: "ゲームが存在しないので404",
-			gameID:      gameID,
+			description:  "ゲームが存在しないので404",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "test",
 				Description: "test",
@@ -2032,8 +2244,9 @@ func TestPatchGame(t *testing.T) {
 			statusCode:        http.StatusNotFound,
 		},
 		{
-			description: "UpdateGameがエラーなので500",
-			gameID:      gameID,
+			description:  "UpdateGameがエラーなので500",
+			sessionExist: true,
+			gameID:       gameID,
 			newGame: &openapi.PatchGameJSONRequestBody{
 				Name:        "test",
 				Description: "test",
@@ -2043,6 +2256,16 @@ func TestPatchGame(t *testing.T) {
 			isErr:             true,
 			statusCode:        http.StatusInternalServerError,
 		},
+		{
+			description: "セッションが無いので401",
+			gameID:      gameID,
+			newGame: &openapi.PatchGameJSONRequestBody{
+				Name:        "test",
+				Description: "test",
+			},
+			isErr:      true,
+			statusCode: http.StatusUnauthorized,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2060,7 +2283,11 @@ func TestPatchGame(t *testing.T) {
 				reqBody = bytes.NewBufferString("bad request body")
 			}
 
-			c, _, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/game/%s", uuid.UUID(testCase.gameID)), withReaderBody(t, reqBody, "application/json"))
+			c, req, rec := setupTestRequest(t, http.MethodPatch, fmt.Sprintf("/api/game/%s", uuid.UUID(testCase.gameID)), withReaderBody(t, reqBody, "application/json"))
+
+			if testCase.sessionExist {
+				setTestSession(t, c, req, rec, session, authSession)
+			}
 
 			if testCase.executeUpdateGame {
 				var visibility *values.GameVisibility
@@ -2081,7 +2308,7 @@ func TestPatchGame(t *testing.T) {
 
 				mockGameService.
 					EXPECT().
-					UpdateGame(gomock.Any(), gomock.Any(), values.NewGameName(testCase.newGame.Name), values.NewGameDescription(testCase.newGame.Description), visibility).
+					UpdateGame(gomock.Any(), gomock.Any(), gomock.Any(), values.NewGameName(testCase.newGame.Name), values.NewGameDescription(testCase.newGame.Description), visibility).
 					Return(testCase.game, testCase.UpdateGameErr)
 			}
 
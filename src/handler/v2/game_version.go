@@ -1,9 +1,10 @@
 package v2
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -58,7 +60,7 @@ func (gameVersion *GameVersion) GetGameVersion(c echo.Context, gameID openapi.Ga
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game versions: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game versions", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game versions")
 	}
 
@@ -181,12 +183,14 @@ func (gameVersion *GameVersion) PostGameVersion(c echo.Context, gameID openapi.G
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid fileID")
 	case errors.Is(err, service.ErrInvalidGameFileType):
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid fileType")
+	case errors.Is(err, service.ErrGameFileNotClean):
+		return echo.NewHTTPError(http.StatusBadRequest, "game file has not passed the scan")
 	case errors.Is(err, service.ErrNoAsset):
 		return echo.NewHTTPError(http.StatusBadRequest, "no assets")
 	case errors.Is(err, service.ErrDuplicateGameVersion):
 		return echo.NewHTTPError(http.StatusBadRequest, "duplicate game version")
 	case err != nil:
-		log.Printf("failed to create game version: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to create game version", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create game version")
 	}
 
@@ -246,7 +250,7 @@ func (gameVersion *GameVersion) GetLatestGameVersion(ctx echo.Context, gameID op
 		return echo.NewHTTPError(http.StatusNotFound, "no game version")
 	}
 	if err != nil {
-		log.Printf("failed to get latest game version: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get latest game version", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get latest game version")
 	}
 
@@ -291,3 +295,136 @@ func (gameVersion *GameVersion) GetLatestGameVersion(ctx echo.Context, gameID op
 		Files:       resFiles,
 	})
 }
+
+// ゲームバージョンのアセットの一括取得
+// (GET /games/{gameID}/versions/{gameVersionID}/assets)
+func (gameVersion *GameVersion) GetGameVersionAssets(c echo.Context, gameID openapi.GameIDInPath, gameVersionID openapi.GameVersionIDInPath) error {
+	assets, err := gameVersion.gameVersionService.GetGameVersionAssets(
+		c.Request().Context(),
+		values.NewGameIDFromUUID(gameID),
+		values.NewGameVersionIDFromUUID(gameVersionID),
+	)
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameVersionID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameVersionID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get game version assets", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game version assets")
+	}
+
+	res, err := convertGameVersionAssets(assets)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert game version assets", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game version assets")
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// エディションのゲームバージョンのアセットの一括取得
+// (GET /editions/{editionID}/games/{gameID}/versions/{gameVersionID}/assets)
+func (gameVersion *GameVersion) GetEditionGameVersionAssets(c echo.Context, editionID openapi.EditionIDInPath, gameID openapi.GameIDInPath, gameVersionID openapi.GameVersionIDInPath) error {
+	assets, err := gameVersion.gameVersionService.GetEditionGameVersionAssets(
+		c.Request().Context(),
+		values.NewEditionIDFromUUID(editionID),
+		values.NewGameIDFromUUID(gameID),
+		values.NewGameVersionIDFromUUID(gameVersionID),
+	)
+	if errors.Is(err, service.ErrInvalidGameVersionID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameVersionID")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get edition game version assets", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game version assets")
+	}
+
+	res, err := convertGameVersionAssets(assets)
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to convert game version assets", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game version assets")
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func convertGameVersionAssets(assets *service.GameVersionAssets) (openapi.GameVersionAssets, error) {
+	imageMime, err := convertPosterType(assets.Image.GetType())
+	if err != nil {
+		return openapi.GameVersionAssets{}, fmt.Errorf("failed to convert image type: %w", err)
+	}
+
+	resVideo, err := convertGameVideo(assets.Video.GameVideo)
+	if err != nil {
+		return openapi.GameVersionAssets{}, fmt.Errorf("failed to convert video: %w", err)
+	}
+
+	var resPosterTmpURL *string
+	if posterTmpURL, ok := assets.Video.PosterTmpURL.Value(); ok {
+		v := (*url.URL)(posterTmpURL).String()
+		resPosterTmpURL = &v
+	}
+
+	resFiles := make([]openapi.GameFileAsset, 0, len(assets.Files))
+	for _, file := range assets.Files {
+		var fileType openapi.GameFileType
+		switch file.GetFileType() {
+		case values.GameFileTypeJar:
+			fileType = openapi.Jar
+		case values.GameFileTypeWindows:
+			fileType = openapi.Win32
+		case values.GameFileTypeMac:
+			fileType = openapi.Darwin
+		default:
+			return openapi.GameVersionAssets{}, fmt.Errorf("unknown game file type: %v", file.GetFileType())
+		}
+
+		scanStatus, err := convertGameFileScanStatus(file.GetScanStatus())
+		if err != nil {
+			return openapi.GameVersionAssets{}, fmt.Errorf("failed to convert scan status: %w", err)
+		}
+
+		resFiles = append(resFiles, openapi.GameFileAsset{
+			Meta: openapi.GameFile{
+				Id:         openapi.GameFileID(file.GetID()),
+				Type:       fileType,
+				EntryPoint: openapi.GameFileEntryPoint(file.GetEntryPoint()),
+				Md5:        openapi.GameFileMd5(hex.EncodeToString(file.GetHash())),
+				CreatedAt:  file.GetCreatedAt(),
+				ScanStatus: scanStatus,
+			},
+			TmpUrl: (*url.URL)(file.TmpURL).String(),
+		})
+	}
+
+	var resURL *openapi.GameURL
+	if urlValue, ok := assets.URL.Value(); ok {
+		v := (*url.URL)(urlValue).String()
+		resURL = &v
+	}
+
+	return openapi.GameVersionAssets{
+		Id:          openapi.GameVersionID(assets.GetID()),
+		Name:        string(assets.GetName()),
+		Description: string(assets.GetDescription()),
+		CreatedAt:   assets.GetCreatedAt(),
+		Url:         resURL,
+		Image: openapi.GameImageAsset{
+			Meta: openapi.GameImage{
+				Id:        openapi.GameImageID(assets.Image.GetID()),
+				Mime:      imageMime,
+				CreatedAt: assets.Image.GetCreatedAt(),
+			},
+			TmpUrl: (*url.URL)(assets.Image.TmpURL).String(),
+		},
+		Video: openapi.GameVideoAsset{
+			Meta:         resVideo,
+			TmpUrl:       (*url.URL)(assets.Video.TmpURL).String(),
+			PosterTmpUrl: resPosterTmpURL,
+		},
+		Files:     resFiles,
+		ExpiresAt: assets.ExpiresAt,
+	}, nil
+}
@@ -1073,6 +1073,30 @@ func TestPostGameVersion(t *testing.T) {
 			isErr:                true,
 			statusCode:           http.StatusBadRequest,
 		},
+		{
+			description: "CreateGameVersionがErrGameFileNotCleanなので400",
+			apiGameVersion: &openapi.NewGameVersion{
+				Name:        "v1.0.0",
+				Description: "リリース",
+				ImageID:     uuid.UUID(imageID),
+				VideoID:     uuid.UUID(videoID),
+				Files: &openapi.GameVersionFiles{
+					Win32: &fileID1UUID,
+				},
+			},
+			executeCreateGameVersion: true,
+			gameID:                   gameID,
+			gameVersionName:          values.NewGameVersionName("v1.0.0"),
+			gameVersionDescription:   values.NewGameVersionDescription("リリース"),
+			imageID:                  imageID,
+			videoID:                  videoID,
+			assets: &service.Assets{
+				Windows: option.NewOption(fileID1),
+			},
+			CreateGameVersionErr: service.ErrGameFileNotClean,
+			isErr:                true,
+			statusCode:           http.StatusBadRequest,
+		},
 		{
 			description: "CreateGameVersionがErrDuplicateGameVersionなので400",
 			apiGameVersion: &openapi.NewGameVersion{
@@ -1445,3 +1469,347 @@ func TestGetLatestGameVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGameVersionAssets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameVersionService := mock.NewMockGameVersionV2(ctrl)
+
+	gameVersionHandler := NewGameVersion(mockGameVersionService)
+
+	type test struct {
+		description             string
+		assets                  *service.GameVersionAssets
+		getGameVersionAssetsErr error
+		expectGameVersionAssets *openapi.GameVersionAssets
+		isErr                   bool
+		statusCode              int
+	}
+
+	gameID := values.NewGameID()
+	gameVersionID := values.NewGameVersionID()
+	imageID := values.NewGameImageID()
+	videoID := values.NewGameVideoID()
+	fileID := values.NewGameFileID()
+	now := time.Now()
+
+	strURL := "https://example.com"
+	urlLink, err := url.Parse(strURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+	strImageURL := "https://example.com/image"
+	imageURL, err := url.Parse(strImageURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+	strVideoURL := "https://example.com/video"
+	videoURL, err := url.Parse(strVideoURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+	strPosterURL := "https://example.com/poster"
+	posterURL, err := url.Parse(strPosterURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+	strFileURL := "https://example.com/file"
+	fileURL, err := url.Parse(strFileURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	video := domain.NewGameVideo(videoID, values.GameVideoTypeMp4, now)
+	video.SetPosterType(values.GameImageTypeJpeg)
+	file := domain.NewGameFile(fileID, values.GameFileTypeJar, "/path/to/game.jar", values.NewGameFileHashFromBytes([]byte{0x01, 0x02}), now)
+	file.SetScanStatus(values.GameFileScanStatusClean)
+	expiresAt := now.Add(time.Minute)
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			assets: &service.GameVersionAssets{
+				GameVersion: domain.NewGameVersion(
+					gameVersionID,
+					values.NewGameVersionName("v1.0.0"),
+					values.NewGameVersionDescription("リリース"),
+					now,
+				),
+				Image: &service.GameImageAsset{
+					GameImage: domain.NewGameImage(imageID, values.GameImageTypePng, now),
+					TmpURL:    values.NewGameImageTmpURL(imageURL),
+				},
+				Video: &service.GameVideoAsset{
+					GameVideo:    video,
+					TmpURL:       values.NewGameVideoTmpURL(videoURL),
+					PosterTmpURL: option.NewOption(values.GameVideoPosterTmpURL(posterURL)),
+				},
+				Files: []*service.GameFileAsset{
+					{
+						GameFile: file,
+						TmpURL:   values.NewGameFileTmpURL(fileURL),
+					},
+				},
+				URL:       option.NewOption(values.NewGameURLLink(urlLink)),
+				ExpiresAt: expiresAt,
+			},
+			expectGameVersionAssets: &openapi.GameVersionAssets{
+				Id:          uuid.UUID(gameVersionID),
+				Name:        "v1.0.0",
+				Description: "リリース",
+				CreatedAt:   now,
+				Url:         &strURL,
+				Image: openapi.GameImageAsset{
+					Meta: openapi.GameImage{
+						Id:        uuid.UUID(imageID),
+						Mime:      openapi.Imagepng,
+						CreatedAt: now,
+					},
+					TmpUrl: strImageURL,
+				},
+				Video: openapi.GameVideoAsset{
+					Meta: openapi.GameVideo{
+						Id:        uuid.UUID(videoID),
+						Mime:      openapi.Videomp4,
+						CreatedAt: now,
+					},
+					TmpUrl:       strVideoURL,
+					PosterTmpUrl: &strPosterURL,
+				},
+				Files: []openapi.GameFileAsset{
+					{
+						Meta: openapi.GameFile{
+							Id:         uuid.UUID(fileID),
+							Type:       openapi.Jar,
+							EntryPoint: "/path/to/game.jar",
+							Md5:        "0102",
+							CreatedAt:  now,
+							ScanStatus: openapi.GameFileScanStatusClean,
+						},
+						TmpUrl: strFileURL,
+					},
+				},
+				ExpiresAt: expiresAt,
+			},
+		},
+		{
+			description:             "GetGameVersionAssetsがErrInvalidGameIDなので404",
+			getGameVersionAssetsErr: service.ErrInvalidGameID,
+			isErr:                   true,
+			statusCode:              http.StatusNotFound,
+		},
+		{
+			description:             "GetGameVersionAssetsがErrInvalidGameVersionIDなので404",
+			getGameVersionAssetsErr: service.ErrInvalidGameVersionID,
+			isErr:                   true,
+			statusCode:              http.StatusNotFound,
+		},
+		{
+			description:             "GetGameVersionAssetsがエラーなので500",
+			getGameVersionAssetsErr: errors.New("error"),
+			isErr:                   true,
+			statusCode:              http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/games/%s/versions/%s/assets", uuid.UUID(gameID), uuid.UUID(gameVersionID)), nil)
+
+			mockGameVersionService.
+				EXPECT().
+				GetGameVersionAssets(gomock.Any(), gameID, gameVersionID).
+				Return(testCase.assets, testCase.getGameVersionAssetsErr)
+
+			err := gameVersionHandler.GetGameVersionAssets(c, uuid.UUID(gameID), uuid.UUID(gameVersionID))
+
+			if testCase.isErr {
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					assert.Equal(t, testCase.statusCode, httpError.Code)
+				} else {
+					t.Errorf("error is not *echo.HTTPError")
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var res openapi.GameVersionAssets
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			if err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			assertGameVersionAssets(t, testCase.expectGameVersionAssets, &res)
+		})
+	}
+}
+
+func TestGetEditionGameVersionAssets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameVersionService := mock.NewMockGameVersionV2(ctrl)
+
+	gameVersionHandler := NewGameVersion(mockGameVersionService)
+
+	type test struct {
+		description                    string
+		assets                         *service.GameVersionAssets
+		getEditionGameVersionAssetsErr error
+		expectGameVersionAssets        *openapi.GameVersionAssets
+		isErr                          bool
+		statusCode                     int
+	}
+
+	editionID := values.NewEditionID()
+	gameID := values.NewGameID()
+	gameVersionID := values.NewGameVersionID()
+	imageID := values.NewGameImageID()
+	videoID := values.NewGameVideoID()
+	now := time.Now()
+
+	strTmpURL := "https://example.com/tmp"
+	tmpURL, err := url.Parse(strTmpURL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			assets: &service.GameVersionAssets{
+				GameVersion: domain.NewGameVersion(
+					gameVersionID,
+					values.NewGameVersionName("v1.0.0"),
+					values.NewGameVersionDescription("リリース"),
+					now,
+				),
+				Image: &service.GameImageAsset{
+					GameImage: domain.NewGameImage(imageID, values.GameImageTypeJpeg, now),
+					TmpURL:    values.NewGameImageTmpURL(tmpURL),
+				},
+				Video: &service.GameVideoAsset{
+					GameVideo: domain.NewGameVideo(videoID, values.GameVideoTypeMp4, now),
+					TmpURL:    values.NewGameVideoTmpURL(tmpURL),
+				},
+				Files:     []*service.GameFileAsset{},
+				ExpiresAt: now,
+			},
+			expectGameVersionAssets: &openapi.GameVersionAssets{
+				Id:          uuid.UUID(gameVersionID),
+				Name:        "v1.0.0",
+				Description: "リリース",
+				CreatedAt:   now,
+				Image: openapi.GameImageAsset{
+					Meta: openapi.GameImage{
+						Id:        uuid.UUID(imageID),
+						Mime:      openapi.Imagejpeg,
+						CreatedAt: now,
+					},
+					TmpUrl: strTmpURL,
+				},
+				Video: openapi.GameVideoAsset{
+					Meta: openapi.GameVideo{
+						Id:        uuid.UUID(videoID),
+						Mime:      openapi.Videomp4,
+						CreatedAt: now,
+					},
+					TmpUrl: strTmpURL,
+				},
+				Files:     []openapi.GameFileAsset{},
+				ExpiresAt: now,
+			},
+		},
+		{
+			description:                    "GetEditionGameVersionAssetsがErrInvalidGameVersionIDなので404",
+			getEditionGameVersionAssetsErr: service.ErrInvalidGameVersionID,
+			isErr:                          true,
+			statusCode:                     http.StatusNotFound,
+		},
+		{
+			description:                    "GetEditionGameVersionAssetsがエラーなので500",
+			getEditionGameVersionAssetsErr: errors.New("error"),
+			isErr:                          true,
+			statusCode:                     http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/editions/%s/games/%s/versions/%s/assets", uuid.UUID(editionID), uuid.UUID(gameID), uuid.UUID(gameVersionID)), nil)
+
+			mockGameVersionService.
+				EXPECT().
+				GetEditionGameVersionAssets(gomock.Any(), editionID, gameID, gameVersionID).
+				Return(testCase.assets, testCase.getEditionGameVersionAssetsErr)
+
+			err := gameVersionHandler.GetEditionGameVersionAssets(c, uuid.UUID(editionID), uuid.UUID(gameID), uuid.UUID(gameVersionID))
+
+			if testCase.isErr {
+				var httpError *echo.HTTPError
+				if errors.As(err, &httpError) {
+					assert.Equal(t, testCase.statusCode, httpError.Code)
+				} else {
+					t.Errorf("error is not *echo.HTTPError")
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var res openapi.GameVersionAssets
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			if err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			assertGameVersionAssets(t, testCase.expectGameVersionAssets, &res)
+		})
+	}
+}
+
+func assertGameVersionAssets(t *testing.T, expected, actual *openapi.GameVersionAssets) {
+	t.Helper()
+
+	assert.Equal(t, expected.Id, actual.Id)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.WithinDuration(t, expected.CreatedAt, actual.CreatedAt, 2*time.Second)
+	assert.Equal(t, expected.Url, actual.Url)
+	assert.WithinDuration(t, expected.ExpiresAt, actual.ExpiresAt, 2*time.Second)
+
+	assert.Equal(t, expected.Image.Meta.Id, actual.Image.Meta.Id)
+	assert.Equal(t, expected.Image.Meta.Mime, actual.Image.Meta.Mime)
+	assert.Equal(t, expected.Image.TmpUrl, actual.Image.TmpUrl)
+
+	assert.Equal(t, expected.Video.Meta.Id, actual.Video.Meta.Id)
+	assert.Equal(t, expected.Video.Meta.Mime, actual.Video.Meta.Mime)
+	assert.Equal(t, expected.Video.TmpUrl, actual.Video.TmpUrl)
+	assert.Equal(t, expected.Video.PosterTmpUrl, actual.Video.PosterTmpUrl)
+
+	if assert.Len(t, actual.Files, len(expected.Files)) {
+		for i, file := range expected.Files {
+			assert.Equal(t, file.Meta.Id, actual.Files[i].Meta.Id)
+			assert.Equal(t, file.Meta.Type, actual.Files[i].Meta.Type)
+			assert.Equal(t, file.Meta.EntryPoint, actual.Files[i].Meta.EntryPoint)
+			assert.Equal(t, file.Meta.Md5, actual.Files[i].Meta.Md5)
+			assert.Equal(t, file.Meta.ScanStatus, actual.Files[i].Meta.ScanStatus)
+			assert.Equal(t, file.TmpUrl, actual.Files[i].TmpUrl)
+		}
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -14,6 +14,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -40,6 +41,100 @@ func convertVideoType(value values.GameVideoType) (openapi.GameVideoMime, error)
 	}
 }
 
+func convertVideoCodec(value values.GameVideoCodec) (openapi.GameVideoCodec, error) {
+	switch value {
+	case values.GameVideoCodecH264:
+		return openapi.H264, nil
+	case values.GameVideoCodecVP8:
+		return openapi.Vp8, nil
+	case values.GameVideoCodecVP9:
+		return openapi.Vp9, nil
+	case values.GameVideoCodecAV1:
+		return openapi.Av1, nil
+	default:
+		return "", fmt.Errorf("invalid video codec: %v", value)
+	}
+}
+
+func convertVideoAudioCodec(value values.GameVideoAudioCodec) (openapi.GameVideoAudioCodec, error) {
+	switch value {
+	case values.GameVideoAudioCodecAAC:
+		return openapi.Aac, nil
+	case values.GameVideoAudioCodecMP3:
+		return openapi.Mp3, nil
+	case values.GameVideoAudioCodecOpus:
+		return openapi.Opus, nil
+	case values.GameVideoAudioCodecVorbis:
+		return openapi.Vorbis, nil
+	case values.GameVideoAudioCodecFLAC:
+		return openapi.Flac, nil
+	default:
+		return "", fmt.Errorf("invalid audio codec: %v", value)
+	}
+}
+
+func convertPosterType(value values.GameImageType) (openapi.GameImageMime, error) {
+	switch value {
+	case values.GameImageTypeJpeg:
+		return openapi.Imagejpeg, nil
+	case values.GameImageTypePng:
+		return openapi.Imagepng, nil
+	case values.GameImageTypeGif:
+		return openapi.Imagegif, nil
+	case values.GameImageTypeWebp:
+		return openapi.Imagewebp, nil
+	default:
+		return "", fmt.Errorf("invalid poster type: %v", value)
+	}
+}
+
+func convertGameVideo(video *domain.GameVideo) (openapi.GameVideo, error) {
+	mime, err := convertVideoType(video.GetType())
+	if err != nil {
+		return openapi.GameVideo{}, fmt.Errorf("failed to convert video type: %w", err)
+	}
+
+	res := openapi.GameVideo{
+		Id:        openapi.GameVideoID(video.GetID()),
+		Mime:      mime,
+		CreatedAt: video.GetCreatedAt(),
+	}
+
+	if metadata, ok := video.GetMetadata().Value(); ok {
+		videoCodec, err := convertVideoCodec(metadata.GetVideoCodec())
+		if err != nil {
+			return openapi.GameVideo{}, fmt.Errorf("failed to convert video codec: %w", err)
+		}
+
+		resMetadata := openapi.GameVideoMetadata{
+			Duration:   metadata.GetDuration().Milliseconds(),
+			Width:      metadata.GetWidth(),
+			Height:     metadata.GetHeight(),
+			VideoCodec: videoCodec,
+		}
+
+		if audioCodec, ok := metadata.GetAudioCodec().Value(); ok {
+			resAudioCodec, err := convertVideoAudioCodec(audioCodec)
+			if err != nil {
+				return openapi.GameVideo{}, fmt.Errorf("failed to convert audio codec: %w", err)
+			}
+			resMetadata.AudioCodec = &resAudioCodec
+		}
+
+		res.Metadata = &resMetadata
+	}
+
+	if posterType, ok := video.GetPosterType().Value(); ok {
+		posterMime, err := convertPosterType(posterType)
+		if err != nil {
+			return openapi.GameVideo{}, fmt.Errorf("failed to convert poster type: %w", err)
+		}
+		res.PosterMime = &posterMime
+	}
+
+	return res, nil
+}
+
 // ゲーム動画一覧の取得
 // (GET /games/{gameID}/videos)
 func (gameVideo *GameVideo) GetGameVideos(c echo.Context, gameID openapi.GameIDInPath) error {
@@ -48,24 +143,19 @@ func (gameVideo *GameVideo) GetGameVideos(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game videos: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game videos", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game videos")
 	}
 
 	resVideos := make([]openapi.GameVideo, 0, len(videos))
 	for _, video := range videos {
-		var mime openapi.GameVideoMime
-		mime, err := convertVideoType(video.GetType())
+		resVideo, err := convertGameVideo(video)
 		if err != nil {
-			log.Printf("error: failed to convert video type: %v\n", err)
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert video type")
+			logger.Error(c.Request().Context(), "failed to convert game video", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game video")
 		}
 
-		resVideos = append(resVideos, openapi.GameVideo{
-			Id:        openapi.GameVideoID(video.GetID()),
-			Mime:      mime,
-			CreatedAt: video.GetCreatedAt(),
-		})
+		resVideos = append(resVideos, resVideo)
 	}
 
 	return c.JSON(http.StatusOK, resVideos)
@@ -81,29 +171,33 @@ func (gameVideo *GameVideo) PostGameVideo(c echo.Context, gameID openapi.GameIDI
 
 	var (
 		noContent = true
-		video     *domain.GameVideo
-		mime      openapi.GameVideoMime
+		resVideo  openapi.GameVideo
 	)
 	err = parser.Register("content", func(file io.Reader, _ formstream.Header) error {
 		noContent = false
 
-		var err error
-		video, err = gameVideo.gameVideoService.SaveGameVideo(c.Request().Context(), file, values.NewGameIDFromUUID(gameID))
+		video, err := gameVideo.gameVideoService.SaveGameVideo(c.Request().Context(), file, values.NewGameIDFromUUID(gameID))
 		if errors.Is(err, service.ErrInvalidGameID) {
 			return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
 		}
 		if errors.Is(err, service.ErrInvalidFormat) {
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid video file type")
 		}
+		if errors.Is(err, service.ErrUnsupportedGameVideoCodec) {
+			return echo.NewHTTPError(http.StatusBadRequest, "unsupported video codec")
+		}
+		if errors.Is(err, service.ErrGameStorageQuotaExceeded) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "game storage quota exceeded")
+		}
 		if err != nil {
-			log.Printf("error: failed to save game video: %v\n", err)
+			logger.Error(c.Request().Context(), "failed to save game video", slog.Any("error", err))
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to save game video")
 		}
 
-		mime, err = convertVideoType(video.GetType())
+		resVideo, err = convertGameVideo(video)
 		if err != nil {
-			log.Printf("error: failed to convert video type: %v\n", err)
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert video type")
+			logger.Error(c.Request().Context(), "failed to convert game video", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game video")
 		}
 
 		return nil
@@ -120,11 +214,7 @@ func (gameVideo *GameVideo) PostGameVideo(c echo.Context, gameID openapi.GameIDI
 		return echo.NewHTTPError(http.StatusBadRequest, "no content")
 	}
 
-	return c.JSON(http.StatusCreated, openapi.GameVideo{
-		Id:        openapi.GameVideoID(video.GetID()),
-		Mime:      mime,
-		CreatedAt: video.GetCreatedAt(),
-	})
+	return c.JSON(http.StatusCreated, resVideo)
 }
 
 // ゲーム動画のバイナリの取得
@@ -138,7 +228,7 @@ func (gameVideo *GameVideo) GetGameVideo(c echo.Context, gameID openapi.GameIDIn
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameVideoID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game video: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get game video", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game video")
 	}
 
@@ -156,20 +246,89 @@ func (gameVideo *GameVideo) GetGameVideoMeta(ctx echo.Context, gameID openapi.Ga
 		return echo.NewHTTPError(http.StatusNotFound, "invalid gameVideoID")
 	}
 	if err != nil {
-		log.Printf("error: failed to get game video meta: %v\n", err)
+		logger.Error(ctx.Request().Context(), "failed to get game video meta", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game video meta")
 	}
 
-	var mime openapi.GameVideoMime
-	mime, err = convertVideoType(video.GetType())
+	resVideo, err := convertGameVideo(video)
 	if err != nil {
-		log.Printf("error: failed to convert video type: %v\n", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert video type")
+		logger.Error(ctx.Request().Context(), "failed to convert game video", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game video")
 	}
 
-	return ctx.JSON(http.StatusOK, openapi.GameVideo{
-		Id:        openapi.GameVideoID(video.GetID()),
-		Mime:      mime,
-		CreatedAt: video.GetCreatedAt(),
+	return ctx.JSON(http.StatusOK, resVideo)
+}
+
+// ゲーム動画のポスター画像の保存
+// (PUT /games/{gameID}/videos/{gameVideoID}/poster)
+func (gameVideo *GameVideo) PutGameVideoPoster(c echo.Context, gameID openapi.GameIDInPath, gameVideoID openapi.GameVideoIDInPath) error {
+	parser, err := echoform.NewParser(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request")
+	}
+
+	var (
+		noContent = true
+		resVideo  openapi.GameVideo
+	)
+	err = parser.Register("content", func(file io.Reader, _ formstream.Header) error {
+		noContent = false
+
+		video, err := gameVideo.gameVideoService.SaveGameVideoPoster(c.Request().Context(), file, values.NewGameIDFromUUID(gameID), values.NewGameVideoIDFromUUID(gameVideoID))
+		if errors.Is(err, service.ErrInvalidGameID) {
+			return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+		}
+		if errors.Is(err, service.ErrInvalidGameVideoID) {
+			return echo.NewHTTPError(http.StatusNotFound, "invalid gameVideoID")
+		}
+		if errors.Is(err, service.ErrInvalidFormat) {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid image file type")
+		}
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to save game video poster", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to save game video poster")
+		}
+
+		resVideo, err = convertGameVideo(video)
+		if err != nil {
+			logger.Error(c.Request().Context(), "failed to convert game video", slog.Any("error", err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to convert game video")
+		}
+
+		return nil
 	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to register content")
+	}
+
+	if err := parser.Parse(); err != nil {
+		return err
+	}
+
+	if noContent {
+		return echo.NewHTTPError(http.StatusBadRequest, "no content")
+	}
+
+	return c.JSON(http.StatusOK, resVideo)
+}
+
+// ゲーム動画のポスター画像の取得
+// (GET /games/{gameID}/videos/{gameVideoID}/poster)
+func (gameVideo *GameVideo) GetGameVideoPoster(c echo.Context, gameID openapi.GameIDInPath, gameVideoID openapi.GameVideoIDInPath) error {
+	tmpURL, err := gameVideo.gameVideoService.GetGameVideoPoster(c.Request().Context(), values.NewGameIDFromUUID(gameID), values.NewGameVideoIDFromUUID(gameVideoID))
+	if errors.Is(err, service.ErrInvalidGameID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameID")
+	}
+	if errors.Is(err, service.ErrInvalidGameVideoID) {
+		return echo.NewHTTPError(http.StatusNotFound, "invalid gameVideoID")
+	}
+	if errors.Is(err, service.ErrNoGameVideoPoster) {
+		return echo.NewHTTPError(http.StatusNotFound, "no poster")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get game video poster", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get game video poster")
+	}
+
+	return c.Redirect(http.StatusSeeOther, (*url.URL)(tmpURL).String())
 }
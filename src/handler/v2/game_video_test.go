@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
@@ -241,6 +242,22 @@ func TestPostGameVideo(t *testing.T) {
 	gameVideoID1 := values.NewGameVideoID()
 
 	now := time.Now()
+
+	audioCodecAac := openapi.Aac
+
+	videoWithMetadata := domain.NewGameVideo(
+		gameVideoID1,
+		values.GameVideoTypeMp4,
+		now,
+	)
+	videoWithMetadata.SetMetadata(domain.NewGameVideoMetadata(
+		12345*time.Millisecond,
+		1920,
+		1080,
+		values.GameVideoCodecH264,
+		option.NewOption(values.GameVideoAudioCodecAAC),
+	))
+
 	testCases := []test{
 		{
 			description:          "特に問題ないのでエラーなし",
@@ -290,6 +307,25 @@ func TestPostGameVideo(t *testing.T) {
 				CreatedAt: now,
 			},
 		},
+		{
+			description:          "メタデータがあるのでメタデータも返る",
+			gameID:               uuid.UUID(values.NewGameID()),
+			reader:               bytes.NewReader([]byte("test")),
+			executeSaveGameVideo: true,
+			video:                videoWithMetadata,
+			resVideo: openapi.GameVideo{
+				Id:        uuid.UUID(gameVideoID1),
+				Mime:      openapi.Videomp4,
+				CreatedAt: now,
+				Metadata: &openapi.GameVideoMetadata{
+					Duration:   12345,
+					Width:      1920,
+					Height:     1080,
+					VideoCodec: openapi.H264,
+					AudioCodec: &audioCodecAac,
+				},
+			},
+		},
 		{
 			// serviceが正しく動作していればあり得ないが、念のため確認
 			description:          "mp4,m4v,mkvでないので500",
@@ -322,6 +358,24 @@ func TestPostGameVideo(t *testing.T) {
 			isErr:                true,
 			statusCode:           http.StatusBadRequest,
 		},
+		{
+			description:          "SaveGameVideoがErrUnsupportedGameVideoCodecなので400",
+			gameID:               uuid.UUID(values.NewGameID()),
+			reader:               bytes.NewReader([]byte("test")),
+			executeSaveGameVideo: true,
+			saveGameVideoErr:     service.ErrUnsupportedGameVideoCodec,
+			isErr:                true,
+			statusCode:           http.StatusBadRequest,
+		},
+		{
+			description:          "SaveGameVideoがErrGameStorageQuotaExceededなので413",
+			gameID:               uuid.UUID(values.NewGameID()),
+			reader:               bytes.NewReader([]byte("test")),
+			executeSaveGameVideo: true,
+			saveGameVideoErr:     service.ErrGameStorageQuotaExceeded,
+			isErr:                true,
+			statusCode:           http.StatusRequestEntityTooLarge,
+		},
 		{
 			description:          "SaveGameVideoがエラーなので500",
 			gameID:               uuid.UUID(values.NewGameID()),
@@ -392,6 +446,7 @@ func TestPostGameVideo(t *testing.T) {
 			assert.Equal(t, testCase.resVideo.Id, resVideo.Id)
 			assert.Equal(t, testCase.resVideo.Mime, resVideo.Mime)
 			assert.WithinDuration(t, testCase.resVideo.CreatedAt, resVideo.CreatedAt, time.Second)
+			assert.Equal(t, testCase.resVideo.Metadata, resVideo.Metadata)
 		})
 	}
 }
@@ -520,6 +575,23 @@ func TestGetGameVideoMeta(t *testing.T) {
 	gameVideoID1 := values.NewGameVideoID()
 
 	now := time.Now()
+
+	posterMimeWebp := openapi.Imagewebp
+
+	videoWithMetadata := domain.NewGameVideo(
+		gameVideoID1,
+		values.GameVideoTypeMkv,
+		now,
+	)
+	videoWithMetadata.SetMetadata(domain.NewGameVideoMetadata(
+		1500*time.Millisecond,
+		640,
+		360,
+		values.GameVideoCodecVP9,
+		option.Option[values.GameVideoAudioCodec]{},
+	))
+	videoWithMetadata.SetPosterType(values.GameImageTypeWebp)
+
 	testCases := []test{
 		{
 			description: "特に問題ないのでエラーなし",
@@ -563,6 +635,23 @@ func TestGetGameVideoMeta(t *testing.T) {
 				CreatedAt: now,
 			},
 		},
+		{
+			description: "メタデータとポスター画像があるのでそれらも返る",
+			gameID:      uuid.UUID(values.NewGameID()),
+			video:       videoWithMetadata,
+			resVideo: openapi.GameVideo{
+				Id:        uuid.UUID(gameVideoID1),
+				Mime:      openapi.Videomkv,
+				CreatedAt: now,
+				Metadata: &openapi.GameVideoMetadata{
+					Duration:   1500,
+					Width:      640,
+					Height:     360,
+					VideoCodec: openapi.Vp9,
+				},
+				PosterMime: &posterMimeWebp,
+			},
+		},
 		{
 			description: "mp4,mkv,m4vでないので500",
 			gameID:      uuid.UUID(values.NewGameID()),
@@ -638,6 +727,273 @@ func TestGetGameVideoMeta(t *testing.T) {
 			assert.Equal(t, testCase.resVideo.Id, resVideo.Id)
 			assert.Equal(t, testCase.resVideo.Mime, resVideo.Mime)
 			assert.WithinDuration(t, testCase.resVideo.CreatedAt, resVideo.CreatedAt, time.Second)
+			assert.Equal(t, testCase.resVideo.Metadata, resVideo.Metadata)
+			assert.Equal(t, testCase.resVideo.PosterMime, resVideo.PosterMime)
+		})
+	}
+}
+
+func TestPutGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameVideoService := mock.NewMockGameVideoV2(ctrl)
+
+	gameVideo := NewGameVideo(mockGameVideoService)
+
+	type test struct {
+		description                string
+		gameID                     openapi.GameIDInPath
+		gameVideoID                openapi.GameVideoIDInPath
+		reader                     *bytes.Reader
+		executeSaveGameVideoPoster bool
+		video                      *domain.GameVideo
+		saveGameVideoPosterErr     error
+		resVideo                   openapi.GameVideo
+		isErr                      bool
+		err                        error
+		statusCode                 int
+	}
+
+	gameVideoID1 := values.NewGameVideoID()
+
+	now := time.Now()
+
+	posterMimePng := openapi.Imagepng
+
+	videoWithPoster := domain.NewGameVideo(
+		gameVideoID1,
+		values.GameVideoTypeMp4,
+		now,
+	)
+	videoWithPoster.SetPosterType(values.GameImageTypePng)
+
+	testCases := []test{
+		{
+			description:                "特に問題ないのでエラーなし",
+			gameID:                     uuid.UUID(values.NewGameID()),
+			gameVideoID:                uuid.UUID(gameVideoID1),
+			reader:                     bytes.NewReader([]byte("test")),
+			executeSaveGameVideoPoster: true,
+			video:                      videoWithPoster,
+			resVideo: openapi.GameVideo{
+				Id:         uuid.UUID(gameVideoID1),
+				Mime:       openapi.Videomp4,
+				CreatedAt:  now,
+				PosterMime: &posterMimePng,
+			},
+		},
+		{
+			description:                "SaveGameVideoPosterがErrInvalidGameIDなので404",
+			gameID:                     uuid.UUID(values.NewGameID()),
+			gameVideoID:                uuid.UUID(values.NewGameVideoID()),
+			reader:                     bytes.NewReader([]byte("test")),
+			executeSaveGameVideoPoster: true,
+			saveGameVideoPosterErr:     service.ErrInvalidGameID,
+			isErr:                      true,
+			statusCode:                 http.StatusNotFound,
+		},
+		{
+			description:                "SaveGameVideoPosterがErrInvalidGameVideoIDなので404",
+			gameID:                     uuid.UUID(values.NewGameID()),
+			gameVideoID:                uuid.UUID(values.NewGameVideoID()),
+			reader:                     bytes.NewReader([]byte("test")),
+			executeSaveGameVideoPoster: true,
+			saveGameVideoPosterErr:     service.ErrInvalidGameVideoID,
+			isErr:                      true,
+			statusCode:                 http.StatusNotFound,
+		},
+		{
+			description:                "SaveGameVideoPosterがErrInvalidFormatなので400",
+			gameID:                     uuid.UUID(values.NewGameID()),
+			gameVideoID:                uuid.UUID(values.NewGameVideoID()),
+			reader:                     bytes.NewReader([]byte("test")),
+			executeSaveGameVideoPoster: true,
+			saveGameVideoPosterErr:     service.ErrInvalidFormat,
+			isErr:                      true,
+			statusCode:                 http.StatusBadRequest,
+		},
+		{
+			description:                "SaveGameVideoPosterがエラーなので500",
+			gameID:                     uuid.UUID(values.NewGameID()),
+			gameVideoID:                uuid.UUID(values.NewGameVideoID()),
+			reader:                     bytes.NewReader([]byte("test")),
+			executeSaveGameVideoPoster: true,
+			saveGameVideoPosterErr:     errors.New("error"),
+			isErr:                      true,
+			statusCode:                 http.StatusInternalServerError,
+		},
+		{
+			description: "contentがrequest bodyにないので400",
+			gameID:      uuid.UUID(values.NewGameID()),
+			gameVideoID: uuid.UUID(values.NewGameVideoID()),
+			isErr:       true,
+			statusCode:  http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			formDatas := []testFormData{}
+			if testCase.reader != nil {
+				formDatas = append(formDatas, testFormData{
+					fieldName: "content",
+					fileName:  "content",
+					body:      testCase.reader,
+					isFile:    true,
+				})
+			}
+			c, _, rec := setupTestRequest(t, http.MethodPut, fmt.Sprintf("/api/v2/games/%s/videos/%s/poster", testCase.gameID, testCase.gameVideoID),
+				withMultipartFormDataBody(t, formDatas))
+
+			if testCase.executeSaveGameVideoPoster {
+				mockGameVideoService.
+					EXPECT().
+					SaveGameVideoPoster(gomock.Any(), gomock.Any(), values.NewGameIDFromUUID(testCase.gameID), values.NewGameVideoIDFromUUID(testCase.gameVideoID)).
+					Return(testCase.video, testCase.saveGameVideoPosterErr)
+			}
+
+			err := gameVideo.PutGameVideoPoster(c, testCase.gameID, testCase.gameVideoID)
+
+			if testCase.isErr {
+				if testCase.statusCode != 0 {
+					var httpError *echo.HTTPError
+					if errors.As(err, &httpError) {
+						assert.Equal(t, testCase.statusCode, httpError.Code)
+					} else {
+						t.Errorf("error is not *echo.HTTPError")
+					}
+				} else if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var resVideo openapi.GameVideo
+			err = json.NewDecoder(rec.Body).Decode(&resVideo)
+			if err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+			assert.Equal(t, testCase.resVideo.Id, resVideo.Id)
+			assert.Equal(t, testCase.resVideo.Mime, resVideo.Mime)
+			assert.WithinDuration(t, testCase.resVideo.CreatedAt, resVideo.CreatedAt, time.Second)
+			assert.Equal(t, testCase.resVideo.PosterMime, resVideo.PosterMime)
+		})
+	}
+}
+
+func TestGetGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGameVideoService := mock.NewMockGameVideoV2(ctrl)
+
+	gameVideo := NewGameVideo(mockGameVideoService)
+
+	type test struct {
+		description           string
+		gameID                openapi.GameIDInPath
+		gameVideoID           openapi.GameVideoIDInPath
+		tmpURL                values.GameVideoPosterTmpURL
+		getGameVideoPosterErr error
+		resLocation           string
+		isErr                 bool
+		err                   error
+		statusCode            int
+	}
+
+	urlLink, err := url.Parse("https://example.com")
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			gameID:      uuid.UUID(values.NewGameID()),
+			gameVideoID: uuid.UUID(values.NewGameVideoID()),
+			tmpURL:      values.NewGameVideoPosterTmpURL(urlLink),
+			resLocation: "https://example.com",
+		},
+		{
+			description:           "GetGameVideoPosterがErrInvalidGameIDなので404",
+			gameID:                uuid.UUID(values.NewGameID()),
+			gameVideoID:           uuid.UUID(values.NewGameVideoID()),
+			getGameVideoPosterErr: service.ErrInvalidGameID,
+			isErr:                 true,
+			statusCode:            http.StatusNotFound,
+		},
+		{
+			description:           "GetGameVideoPosterがErrInvalidGameVideoIDなので404",
+			gameID:                uuid.UUID(values.NewGameID()),
+			gameVideoID:           uuid.UUID(values.NewGameVideoID()),
+			getGameVideoPosterErr: service.ErrInvalidGameVideoID,
+			isErr:                 true,
+			statusCode:            http.StatusNotFound,
+		},
+		{
+			description:           "GetGameVideoPosterがErrNoGameVideoPosterなので404",
+			gameID:                uuid.UUID(values.NewGameID()),
+			gameVideoID:           uuid.UUID(values.NewGameVideoID()),
+			getGameVideoPosterErr: service.ErrNoGameVideoPoster,
+			isErr:                 true,
+			statusCode:            http.StatusNotFound,
+		},
+		{
+			description:           "GetGameVideoPosterがエラーなので500",
+			gameID:                uuid.UUID(values.NewGameID()),
+			gameVideoID:           uuid.UUID(values.NewGameVideoID()),
+			getGameVideoPosterErr: errors.New("error"),
+			isErr:                 true,
+			statusCode:            http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			c, _, rec := setupTestRequest(t, http.MethodGet, fmt.Sprintf("/api/v2/games/%s/videos/%s/poster", testCase.gameID, testCase.gameVideoID), nil)
+
+			mockGameVideoService.
+				EXPECT().
+				GetGameVideoPoster(gomock.Any(), values.NewGameIDFromUUID(testCase.gameID), values.NewGameVideoIDFromUUID(testCase.gameVideoID)).
+				Return(testCase.tmpURL, testCase.getGameVideoPosterErr)
+
+			err := gameVideo.GetGameVideoPoster(c, testCase.gameID, testCase.gameVideoID)
+
+			if testCase.isErr {
+				if testCase.statusCode != 0 {
+					var httpError *echo.HTTPError
+					if errors.As(err, &httpError) {
+						assert.Equal(t, testCase.statusCode, httpError.Code)
+					} else {
+						t.Errorf("error is not *echo.HTTPError")
+					}
+				} else if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, http.StatusSeeOther, rec.Code)
+			assert.Equal(t, testCase.resLocation, rec.Header().Get("Location"))
 		})
 	}
 }
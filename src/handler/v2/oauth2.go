@@ -3,7 +3,7 @@ package v2
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -12,6 +12,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -41,7 +42,7 @@ func (oauth2 *OAuth2) GetCallback(c echo.Context, params openapi.GetCallbackPara
 
 	session, err := oauth2.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
 	}
 
@@ -50,7 +51,7 @@ func (oauth2 *OAuth2) GetCallback(c echo.Context, params openapi.GetCallbackPara
 		return echo.NewHTTPError(http.StatusBadRequest, "no code verifier")
 	}
 	if err != nil {
-		log.Printf("error: failed to get code verifier: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get code verifier", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get code verifier")
 	}
 	codeVerifier := values.NewOIDCCodeVerifierFromString(strCodeVerifier)
@@ -65,7 +66,7 @@ func (oauth2 *OAuth2) GetCallback(c echo.Context, params openapi.GetCallbackPara
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid auth state or code")
 	}
 	if err != nil {
-		log.Printf("error: failed to callback: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to callback", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to callback")
 	}
 
@@ -73,7 +74,7 @@ func (oauth2 *OAuth2) GetCallback(c echo.Context, params openapi.GetCallbackPara
 
 	err = oauth2.session.save(c, session)
 	if err != nil {
-		log.Printf("error: failed to save session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to save session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to save session")
 	}
 
@@ -85,13 +86,13 @@ func (oauth2 *OAuth2) GetCallback(c echo.Context, params openapi.GetCallbackPara
 func (oauth2 *OAuth2) GetCode(c echo.Context) error {
 	client, authState, err := oauth2.oidcService.GenerateAuthState(c.Request().Context())
 	if err != nil {
-		log.Printf("error: failed to generate code: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to generate code", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate code")
 	}
 
 	codeChallenge, err := authState.GetCodeVerifier().GetCodeChallenge(authState.GetCodeChallengeMethod())
 	if err != nil {
-		log.Printf("error: failed to get code challenge: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get code challenge", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get code challenge")
 	}
 
@@ -105,7 +106,7 @@ func (oauth2 *OAuth2) GetCode(c echo.Context) error {
 
 	session, err := oauth2.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
 	}
 
@@ -113,7 +114,7 @@ func (oauth2 *OAuth2) GetCode(c echo.Context) error {
 
 	err = oauth2.session.save(c, session)
 	if err != nil {
-		log.Printf("error: failed to save session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to save session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to save session")
 	}
 
@@ -136,7 +137,7 @@ func (oauth2 *OAuth2) GetCode(c echo.Context) error {
 func (oauth2 *OAuth2) PostLogout(c echo.Context) error {
 	session, err := oauth2.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get session")
 	}
 
@@ -145,13 +146,13 @@ func (oauth2 *OAuth2) PostLogout(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "no auth session")
 	}
 	if err != nil {
-		log.Printf("error: failed to get auth session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get auth session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get auth session")
 	}
 
 	err = oauth2.oidcService.Logout(c.Request().Context(), authSession)
 	if err != nil {
-		log.Printf("error: failed to logout: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to logout", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to logout")
 	}
 
@@ -159,7 +160,7 @@ func (oauth2 *OAuth2) PostLogout(c echo.Context) error {
 
 	err = oauth2.session.save(c, session)
 	if err != nil {
-		log.Printf("error: failed to save session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to save session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to save session")
 	}
 
@@ -58,6 +58,123 @@ func (e AnswerType) Valid() bool {
 	}
 }
 
+// Defines values for AuditLogAction.
+const (
+	ActivateProductKey        AuditLogAction = "activateProductKey"
+	AddAdmin                  AuditLogAction = "addAdmin"
+	AddGameGenreAlias         AuditLogAction = "addGameGenreAlias"
+	DeleteAdmin               AuditLogAction = "deleteAdmin"
+	DeleteEdition             AuditLogAction = "deleteEdition"
+	DeleteGameGenreAlias      AuditLogAction = "deleteGameGenreAlias"
+	DeleteGameStorageQuota    AuditLogAction = "deleteGameStorageQuota"
+	EditGameManagementRole    AuditLogAction = "editGameManagementRole"
+	MergeGameGenres           AuditLogAction = "mergeGameGenres"
+	RemoveGameManagementRole  AuditLogAction = "removeGameManagementRole"
+	RevokeProductKey          AuditLogAction = "revokeProductKey"
+	TransferGameOwnership     AuditLogAction = "transferGameOwnership"
+	UpdateEdition             AuditLogAction = "updateEdition"
+	UpdateEditionGameVersions AuditLogAction = "updateEditionGameVersions"
+	UpdateGame                AuditLogAction = "updateGame"
+	UpdateGameFileScanStatus  AuditLogAction = "updateGameFileScanStatus"
+	UpdateGameGenreParent     AuditLogAction = "updateGameGenreParent"
+	UpdateGameStorageQuota    AuditLogAction = "updateGameStorageQuota"
+)
+
+// Valid indicates whether the value is a known member of the AuditLogAction enum.
+func (e AuditLogAction) Valid() bool {
+	switch e {
+	case ActivateProductKey:
+		return true
+	case AddAdmin:
+		return true
+	case AddGameGenreAlias:
+		return true
+	case DeleteAdmin:
+		return true
+	case DeleteEdition:
+		return true
+	case DeleteGameGenreAlias:
+		return true
+	case DeleteGameStorageQuota:
+		return true
+	case EditGameManagementRole:
+		return true
+	case MergeGameGenres:
+		return true
+	case RemoveGameManagementRole:
+		return true
+	case RevokeProductKey:
+		return true
+	case TransferGameOwnership:
+		return true
+	case UpdateEdition:
+		return true
+	case UpdateEditionGameVersions:
+		return true
+	case UpdateGame:
+		return true
+	case UpdateGameFileScanStatus:
+		return true
+	case UpdateGameGenreParent:
+		return true
+	case UpdateGameStorageQuota:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditLogTargetType.
+const (
+	AuditLogTargetTypeEdition    AuditLogTargetType = "edition"
+	AuditLogTargetTypeGame       AuditLogTargetType = "game"
+	AuditLogTargetTypeGameGenre  AuditLogTargetType = "gameGenre"
+	AuditLogTargetTypeProductKey AuditLogTargetType = "productKey"
+	AuditLogTargetTypeUser       AuditLogTargetType = "user"
+)
+
+// Valid indicates whether the value is a known member of the AuditLogTargetType enum.
+func (e AuditLogTargetType) Valid() bool {
+	switch e {
+	case AuditLogTargetTypeEdition:
+		return true
+	case AuditLogTargetTypeGame:
+		return true
+	case AuditLogTargetTypeGameGenre:
+		return true
+	case AuditLogTargetTypeProductKey:
+		return true
+	case AuditLogTargetTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EditionBundleStatus.
+const (
+	EditionBundleStatusBuilding  EditionBundleStatus = "building"
+	EditionBundleStatusCompleted EditionBundleStatus = "completed"
+	EditionBundleStatusFailed    EditionBundleStatus = "failed"
+	EditionBundleStatusPending   EditionBundleStatus = "pending"
+)
+
+// Valid indicates whether the value is a known member of the EditionBundleStatus enum.
+func (e EditionBundleStatus) Valid() bool {
+	switch e {
+	case EditionBundleStatusBuilding:
+		return true
+	case EditionBundleStatusCompleted:
+		return true
+	case EditionBundleStatusFailed:
+		return true
+	case EditionBundleStatusPending:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FeedbackAnswerFiveScaleAnswerType.
 const (
 	FeedbackAnswerFiveScaleAnswerTypeFiveScale FeedbackAnswerFiveScaleAnswerType = "fiveScale"
@@ -118,6 +235,30 @@ func (e FeedbackAnswerYesNoAnswerType) Valid() bool {
 	}
 }
 
+// Defines values for GameFileScanStatus.
+const (
+	GameFileScanStatusClean    GameFileScanStatus = "clean"
+	GameFileScanStatusFailed   GameFileScanStatus = "failed"
+	GameFileScanStatusPending  GameFileScanStatus = "pending"
+	GameFileScanStatusRejected GameFileScanStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the GameFileScanStatus enum.
+func (e GameFileScanStatus) Valid() bool {
+	switch e {
+	case GameFileScanStatusClean:
+		return true
+	case GameFileScanStatusFailed:
+		return true
+	case GameFileScanStatusPending:
+		return true
+	case GameFileScanStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GameFileType.
 const (
 	Darwin GameFileType = "darwin"
@@ -139,11 +280,54 @@ func (e GameFileType) Valid() bool {
 	}
 }
 
+// Defines values for GameFileZipEntryErrorReason.
+const (
+	AbsolutePath     GameFileZipEntryErrorReason = "absolutePath"
+	CompressionRatio GameFileZipEntryErrorReason = "compressionRatio"
+	DuplicateName    GameFileZipEntryErrorReason = "duplicateName"
+	PathTraversal    GameFileZipEntryErrorReason = "pathTraversal"
+	Symlink          GameFileZipEntryErrorReason = "symlink"
+)
+
+// Valid indicates whether the value is a known member of the GameFileZipEntryErrorReason enum.
+func (e GameFileZipEntryErrorReason) Valid() bool {
+	switch e {
+	case AbsolutePath:
+		return true
+	case CompressionRatio:
+		return true
+	case DuplicateName:
+		return true
+	case PathTraversal:
+		return true
+	case Symlink:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GameImageFormat.
+const (
+	Webp GameImageFormat = "webp"
+)
+
+// Valid indicates whether the value is a known member of the GameImageFormat enum.
+func (e GameImageFormat) Valid() bool {
+	switch e {
+	case Webp:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GameImageMime.
 const (
 	Imagegif  GameImageMime = "image/gif"
 	Imagejpeg GameImageMime = "image/jpeg"
 	Imagepng  GameImageMime = "image/png"
+	Imagewebp GameImageMime = "image/webp"
 )
 
 // Valid indicates whether the value is a known member of the GameImageMime enum.
@@ -155,6 +339,53 @@ func (e GameImageMime) Valid() bool {
 		return true
 	case Imagepng:
 		return true
+	case Imagewebp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GameImageSize.
+const (
+	Large    GameImageSize = "large"
+	Medium   GameImageSize = "medium"
+	Original GameImageSize = "original"
+	Small    GameImageSize = "small"
+)
+
+// Valid indicates whether the value is a known member of the GameImageSize enum.
+func (e GameImageSize) Valid() bool {
+	switch e {
+	case Large:
+		return true
+	case Medium:
+		return true
+	case Original:
+		return true
+	case Small:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GamePlayLogRecordStatus.
+const (
+	Created    GamePlayLogRecordStatus = "created"
+	Duplicated GamePlayLogRecordStatus = "duplicated"
+	Invalid    GamePlayLogRecordStatus = "invalid"
+)
+
+// Valid indicates whether the value is a known member of the GamePlayLogRecordStatus enum.
+func (e GamePlayLogRecordStatus) Valid() bool {
+	switch e {
+	case Created:
+		return true
+	case Duplicated:
+		return true
+	case Invalid:
+		return true
 	default:
 		return false
 	}
@@ -178,6 +409,57 @@ func (e GameRoleType) Valid() bool {
 	}
 }
 
+// Defines values for GameVideoAudioCodec.
+const (
+	Aac    GameVideoAudioCodec = "aac"
+	Flac   GameVideoAudioCodec = "flac"
+	Mp3    GameVideoAudioCodec = "mp3"
+	Opus   GameVideoAudioCodec = "opus"
+	Vorbis GameVideoAudioCodec = "vorbis"
+)
+
+// Valid indicates whether the value is a known member of the GameVideoAudioCodec enum.
+func (e GameVideoAudioCodec) Valid() bool {
+	switch e {
+	case Aac:
+		return true
+	case Flac:
+		return true
+	case Mp3:
+		return true
+	case Opus:
+		return true
+	case Vorbis:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GameVideoCodec.
+const (
+	Av1  GameVideoCodec = "av1"
+	H264 GameVideoCodec = "h264"
+	Vp8  GameVideoCodec = "vp8"
+	Vp9  GameVideoCodec = "vp9"
+)
+
+// Valid indicates whether the value is a known member of the GameVideoCodec enum.
+func (e GameVideoCodec) Valid() bool {
+	switch e {
+	case Av1:
+		return true
+	case H264:
+		return true
+	case Vp8:
+		return true
+	case Vp9:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GameVideoMime.
 const (
 	Videom4v GameVideoMime = "video/m4v"
@@ -256,10 +538,123 @@ func (e SeatStatus) Valid() bool {
 	}
 }
 
+// Defines values for ServerDependencyStatusName.
+const (
+	Cache     ServerDependencyStatusName = "cache"
+	Database  ServerDependencyStatusName = "database"
+	Migration ServerDependencyStatusName = "migration"
+	Storage   ServerDependencyStatusName = "storage"
+)
+
+// Valid indicates whether the value is a known member of the ServerDependencyStatusName enum.
+func (e ServerDependencyStatusName) Valid() bool {
+	switch e {
+	case Cache:
+		return true
+	case Database:
+		return true
+	case Migration:
+		return true
+	case Storage:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ServerDependencyStatusStatus.
+const (
+	Ok          ServerDependencyStatusStatus = "ok"
+	Unavailable ServerDependencyStatusStatus = "unavailable"
+)
+
+// Valid indicates whether the value is a known member of the ServerDependencyStatusStatus enum.
+func (e ServerDependencyStatusStatus) Valid() bool {
+	switch e {
+	case Ok:
+		return true
+	case Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StorageVerificationStatus.
+const (
+	Completed StorageVerificationStatus = "completed"
+	Failed    StorageVerificationStatus = "failed"
+	Running   StorageVerificationStatus = "running"
+)
+
+// Valid indicates whether the value is a known member of the StorageVerificationStatus enum.
+func (e StorageVerificationStatus) Valid() bool {
+	switch e {
+	case Completed:
+		return true
+	case Failed:
+		return true
+	case Running:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StorageVerificationIssueIssueType.
+const (
+	CheckFailed  StorageVerificationIssueIssueType = "checkFailed"
+	HashMismatch StorageVerificationIssueIssueType = "hashMismatch"
+	Missing      StorageVerificationIssueIssueType = "missing"
+)
+
+// Valid indicates whether the value is a known member of the StorageVerificationIssueIssueType enum.
+func (e StorageVerificationIssueIssueType) Valid() bool {
+	switch e {
+	case CheckFailed:
+		return true
+	case HashMismatch:
+		return true
+	case Missing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StorageVerificationIssueObjectKind.
+const (
+	StorageVerificationIssueObjectKindGameFile         StorageVerificationIssueObjectKind = "gameFile"
+	StorageVerificationIssueObjectKindGameImage        StorageVerificationIssueObjectKind = "gameImage"
+	StorageVerificationIssueObjectKindGameImageVariant StorageVerificationIssueObjectKind = "gameImageVariant"
+	StorageVerificationIssueObjectKindGameVideo        StorageVerificationIssueObjectKind = "gameVideo"
+	StorageVerificationIssueObjectKindGameVideoPoster  StorageVerificationIssueObjectKind = "gameVideoPoster"
+)
+
+// Valid indicates whether the value is a known member of the StorageVerificationIssueObjectKind enum.
+func (e StorageVerificationIssueObjectKind) Valid() bool {
+	switch e {
+	case StorageVerificationIssueObjectKindGameFile:
+		return true
+	case StorageVerificationIssueObjectKindGameImage:
+		return true
+	case StorageVerificationIssueObjectKindGameImageVariant:
+		return true
+	case StorageVerificationIssueObjectKindGameVideo:
+		return true
+	case StorageVerificationIssueObjectKindGameVideoPoster:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GetGamesParamsSort.
 const (
-	CreatedAt     GetGamesParamsSort = "createdAt"
-	LatestVersion GetGamesParamsSort = "latestVersion"
+	CreatedAt      GetGamesParamsSort = "createdAt"
+	LatestFeedback GetGamesParamsSort = "latestFeedback"
+	LatestVersion  GetGamesParamsSort = "latestVersion"
+	PlayCount      GetGamesParamsSort = "playCount"
 )
 
 // Valid indicates whether the value is a known member of the GetGamesParamsSort enum.
@@ -267,8 +662,30 @@ func (e GetGamesParamsSort) Valid() bool {
 	switch e {
 	case CreatedAt:
 		return true
+	case LatestFeedback:
+		return true
 	case LatestVersion:
 		return true
+	case PlayCount:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PutGameFileScanStatusJSONBodyScanStatus.
+const (
+	Clean    PutGameFileScanStatusJSONBodyScanStatus = "clean"
+	Rejected PutGameFileScanStatusJSONBodyScanStatus = "rejected"
+)
+
+// Valid indicates whether the value is a known member of the PutGameFileScanStatusJSONBodyScanStatus enum.
+func (e PutGameFileScanStatusJSONBodyScanStatus) Valid() bool {
+	switch e {
+	case Clean:
+		return true
+	case Rejected:
+		return true
 	default:
 		return false
 	}
@@ -277,6 +694,50 @@ func (e GetGamesParamsSort) Valid() bool {
 // AnswerType 回答形式（yesNo: Yes/No回答、fiveScale: 5段階評価）
 type AnswerType string
 
+// AuditLog 管理操作の監査ログです。
+// actorのnameは操作時点でのユーザー名です。
+type AuditLog struct {
+	// Action 監査ログの操作の種類です。
+	Action AuditLogAction `json:"action"`
+
+	// Actor ユーザー
+	Actor User `json:"actor"`
+
+	// After 操作対象の操作前後の状態です。
+	// 追加や削除のように、操作前または操作後の状態が存在しない場合は含まれません。
+	After *AuditLogState `json:"after,omitempty"`
+
+	// Before 操作対象の操作前後の状態です。
+	// 追加や削除のように、操作前または操作後の状態が存在しない場合は含まれません。
+	Before *AuditLogState `json:"before,omitempty"`
+
+	// CreatedAt 操作が行われた時刻です。
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id 監査ログのIDです。
+	Id AuditLogID `json:"id"`
+
+	// TargetID 操作対象のIDです。
+	// targetTypeに応じて、ユーザー・ゲーム・エディション・プロダクトキーのIDとなります。
+	TargetID openapi_types.UUID `json:"targetID"`
+
+	// TargetType 監査ログの操作対象の種類です。
+	TargetType AuditLogTargetType `json:"targetType"`
+}
+
+// AuditLogAction 監査ログの操作の種類です。
+type AuditLogAction string
+
+// AuditLogID 監査ログのIDです。
+type AuditLogID = openapi_types.UUID
+
+// AuditLogState 操作対象の操作前後の状態です。
+// 追加や削除のように、操作前または操作後の状態が存在しない場合は含まれません。
+type AuditLogState map[string]interface{}
+
+// AuditLogTargetType 監査ログの操作対象の種類です。
+type AuditLogTargetType string
+
 // Edition エディションです。
 // questionnaireは工大祭などのアンケートが必要な際のみ存在します。
 type Edition struct {
@@ -317,6 +778,39 @@ type EditionAuthorizeRequest struct {
 	Key ProductKeyValue `json:"key"`
 }
 
+// EditionBundle defines model for EditionBundle.
+type EditionBundle struct {
+	// CreatedAt バンドルの生成が予約された時刻です。
+	CreatedAt EditionBundleCreatedAt `json:"createdAt"`
+
+	// Id エディションのバンドルのIDです。
+	Id EditionBundleID `json:"id"`
+
+	// ProcessedItems バンドルに書き込み終わった画像・動画・ファイルの数です。
+	ProcessedItems int `json:"processedItems"`
+
+	// Size ストレージの容量(バイト)です。
+	Size GameStorageSize `json:"size"`
+
+	// Status バンドルの生成状況です。
+	// pendingは生成待ち、buildingは生成中、completedは生成済み、failedは生成に失敗したことを表します。
+	Status EditionBundleStatus `json:"status"`
+
+	// TotalItems バンドルに含める画像・動画・ファイルの数です。
+	// 生成が始まるまでは0になります。
+	TotalItems int `json:"totalItems"`
+}
+
+// EditionBundleCreatedAt バンドルの生成が予約された時刻です。
+type EditionBundleCreatedAt = time.Time
+
+// EditionBundleID エディションのバンドルのIDです。
+type EditionBundleID = openapi_types.UUID
+
+// EditionBundleStatus バンドルの生成状況です。
+// pendingは生成待ち、buildingは生成中、completedは生成済み、failedは生成に失敗したことを表します。
+type EditionBundleStatus string
+
 // EditionCreatedAt エディションが作成された時刻です。
 type EditionCreatedAt = time.Time
 
@@ -539,21 +1033,37 @@ type Game struct {
 // GameCreatedAt ゲームがtraP Collectionに追加された時刻です。
 type GameCreatedAt = time.Time
 
-// GameCreator defines model for GameCreator.
+// GameCreator ゲームクリエイターを表します。
+// 一覧で返される場合は、クリエイターの表示順に並びます。
 type GameCreator struct {
 	// Id ゲームクリエイターのIDを表します。
-	Id   GameCreatorID    `json:"id"`
+	Id GameCreatorID `json:"id"`
+
+	// IsExternal traPのメンバーでない、外部のクリエイターかどうかを表します。
+	// 外部のクリエイターの場合、userIDは含まれません。
+	IsExternal bool `json:"isExternal"`
+
+	// Jobs クリエイター内での表示順に並んだジョブの一覧です。
 	Jobs []GameCreatorJob `json:"jobs"`
 
-	// Name ユーザー名です。
-	// traQのユーザーのUUIDでないmazreanなどのIDと対応します。
-	Name UserName `json:"name"`
+	// Name クレジットに表示するゲームクリエイターの名前です。
+	// traPのメンバーの場合はユーザー名、外部のクリエイターの場合は設定された表示名です。
+	Name GameCreatorName `json:"name"`
+
+	// Url 外部のゲームクリエイターのWebサイトなどのURLです。
+	Url *GameCreatorExternalURL `json:"url,omitempty"`
 
 	// UserID ユーザーのIDです。
 	// traQのユーザーのUUIDと対応します。
-	UserID UserID `json:"userID"`
+	UserID *UserID `json:"userID,omitempty"`
 }
 
+// GameCreatorExternalName 外部のゲームクリエイターの表示名です。
+type GameCreatorExternalName = string
+
+// GameCreatorExternalURL 外部のゲームクリエイターのWebサイトなどのURLです。
+type GameCreatorExternalURL = string
+
 // GameCreatorID ゲームクリエイターのIDを表します。
 type GameCreatorID = openapi_types.UUID
 
@@ -574,12 +1084,60 @@ type GameCreatorJobDisplayName = string
 // GameCreatorJobID ゲームクリエイターのjobのIDを表します。
 type GameCreatorJobID = openapi_types.UUID
 
-// GameDescription ゲームの説明です。
-// ランチャーでも表示されます。
-type GameDescription = string
+// GameCreatorName クレジットに表示するゲームクリエイターの名前です。
+// traPのメンバーの場合はユーザー名、外部のクリエイターの場合は設定された表示名です。
+type GameCreatorName = string
 
-// GameFeedback defines model for GameFeedback.
-type GameFeedback struct {
+// GameCredit スタッフロールのうち、1つのジョブの欄を表します。
+type GameCredit struct {
+	// Creators このジョブを担当したクリエイターの一覧です。
+	// クリエイターの表示順に並びます。
+	Creators []GameCreditCreator `json:"creators"`
+
+	// Job ゲームクリエイターのjob情報を表します。
+	Job GameCreatorJob `json:"job"`
+}
+
+// GameCreditCreator スタッフロールに表示するゲームクリエイターを表します。
+type GameCreditCreator struct {
+	// Id ゲームクリエイターのIDを表します。
+	Id GameCreatorID `json:"id"`
+
+	// IsExternal traPのメンバーでない、外部のクリエイターかどうかを表します。
+	IsExternal bool `json:"isExternal"`
+
+	// Name クレジットに表示するゲームクリエイターの名前です。
+	// traPのメンバーの場合はユーザー名、外部のクリエイターの場合は設定された表示名です。
+	Name GameCreatorName `json:"name"`
+
+	// Url 外部のゲームクリエイターのWebサイトなどのURLです。
+	Url *GameCreatorExternalURL `json:"url,omitempty"`
+
+	// UserID ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	UserID *UserID `json:"userID,omitempty"`
+}
+
+// GameDescription ゲームの説明です。
+// ランチャーでも表示されます。
+type GameDescription = string
+
+// GameFacets 条件に一致するゲームの、ジャンル・ファイルの種類・公開範囲ごとの数です。
+// limit、offsetは適用されません。数が0のものは含まれません。
+type GameFacets struct {
+	// FileTypes ファイルの種類ごとの、その種類のファイルを持つゲームの数です。
+	// 対応OSでの絞り込みに使います。
+	FileTypes []GameFileTypeFacet `json:"fileTypes"`
+
+	// Genres ジャンルごとのゲームの数です。ゲームの数が多い順に並びます。
+	Genres []GameGenreFacet `json:"genres"`
+
+	// Visibilities 公開範囲ごとのゲームの数です。
+	Visibilities []GameVisibilityFacet `json:"visibilities"`
+}
+
+// GameFeedback defines model for GameFeedback.
+type GameFeedback struct {
 	CreatedAt time.Time `json:"createdAt"`
 
 	// Id ゲームフィードバックID
@@ -626,6 +1184,13 @@ type GameFile struct {
 	// Md5 ゲームファイルのmd5ハッシュ値です。
 	Md5 GameFileMd5 `json:"md5"`
 
+	// ScanStatus ゲームファイルの内容の検査の状態です。
+	// pendingは未検査、cleanは検査を通過したもの、rejectedは検査で問題が見つかったもの、failedは検査を繰り返し失敗したものです。
+	// アップロード直後はpendingで、数分以内に検査されます。
+	// failedのゲームファイルは、adminが再検査を指示するまで検査されません。
+	// cleanのゲームファイルのみ、ゲームバージョンに紐づけたり、ランチャーからダウンロードしたりできます。
+	ScanStatus GameFileScanStatus `json:"scanStatus"`
+
 	// Type ゲームファイルのタイプです。
 	// jarはJavaで起動しWindows、OSXの両方で実行できるもの、
 	// windowsはWindows用の実行ファイル、
@@ -633,6 +1198,15 @@ type GameFile struct {
 	Type GameFileType `json:"type"`
 }
 
+// GameFileAsset defines model for GameFileAsset.
+type GameFileAsset struct {
+	// Meta ゲームのファイルのメタ情報です。
+	Meta GameFile `json:"meta"`
+
+	// TmpUrl ファイルの一時URLです。
+	TmpUrl string `json:"tmpUrl"`
+}
+
 // GameFileContent ゲームの実行ファイルやデータをzipしたバイナリです。
 type GameFileContent = openapi_types.File
 
@@ -648,14 +1222,51 @@ type GameFileID = openapi_types.UUID
 // GameFileMd5 ゲームファイルのmd5ハッシュ値です。
 type GameFileMd5 = string
 
+// GameFileScanStatus ゲームファイルの内容の検査の状態です。
+// pendingは未検査、cleanは検査を通過したもの、rejectedは検査で問題が見つかったもの、failedは検査を繰り返し失敗したものです。
+// アップロード直後はpendingで、数分以内に検査されます。
+// failedのゲームファイルは、adminが再検査を指示するまで検査されません。
+// cleanのゲームファイルのみ、ゲームバージョンに紐づけたり、ランチャーからダウンロードしたりできます。
+type GameFileScanStatus string
+
 // GameFileType ゲームファイルのタイプです。
 // jarはJavaで起動しWindows、OSXの両方で実行できるもの、
 // windowsはWindows用の実行ファイル、
 // macはOSX用の実行ファイルです。
 type GameFileType string
 
-// GameGenre defines model for GameGenre.
+// GameFileTypeFacet defines model for GameFileTypeFacet.
+type GameFileTypeFacet struct {
+	// Num 条件に一致するゲームのうち、この種類のファイルを持つゲームの数です。
+	Num int `json:"num"`
+
+	// Type ゲームファイルのタイプです。
+	// jarはJavaで起動しWindows、OSXの両方で実行できるもの、
+	// windowsはWindows用の実行ファイル、
+	// macはOSX用の実行ファイルです。
+	Type GameFileType `json:"type"`
+}
+
+// GameFileZipEntryError defines model for GameFileZipEntryError.
+type GameFileZipEntryError struct {
+	// Name zipファイル内でのエントリーの名前です。
+	Name string `json:"name"`
+
+	// Reason zipファイルのエントリーが不正である理由です。
+	// absolutePathは絶対パス、pathTraversalは..を含むパス、symlinkはシンボリックリンク、
+	// duplicateNameは同じ名前のエントリーが既にあるもの、compressionRatioは圧縮率が上限を超えているものです。
+	Reason GameFileZipEntryErrorReason `json:"reason"`
+}
+
+// GameFileZipEntryErrorReason zipファイルのエントリーが不正である理由です。
+// absolutePathは絶対パス、pathTraversalは..を含むパス、symlinkはシンボリックリンク、
+// duplicateNameは同じ名前のエントリーが既にあるもの、compressionRatioは圧縮率が上限を超えているものです。
+type GameFileZipEntryErrorReason string
+
+// GameGenre parentは親ジャンルがある場合のみ含まれます。
 type GameGenre struct {
+	// Aliases ジャンルの別名です。
+	Aliases   *[]GameGenreAlias  `json:"aliases,omitempty"`
 	CreatedAt GameGenreCreatedAt `json:"createdAt"`
 
 	// Genre ジャンルの名前です。32文字以下です。
@@ -666,11 +1277,44 @@ type GameGenre struct {
 
 	// Num そのジャンルが含まれるゲームの数です。
 	Num int `json:"num"`
+
+	// Parent ジャンルのID(UUID)です。
+	Parent *GameGenreID `json:"parent,omitempty"`
+}
+
+// GameGenreAlias ジャンルの別名です。
+type GameGenreAlias struct {
+	// CreatedAt 別名が追加された日時です。
+	CreatedAt time.Time `json:"createdAt"`
+
+	// GenreId ジャンルのID(UUID)です。
+	GenreId GameGenreID `json:"genreId"`
+
+	// Id ジャンルの別名のID(UUID)です。
+	Id GameGenreAliasID `json:"id"`
+
+	// Name ジャンルの名前です。32文字以下です。
+	Name GameGenreName `json:"name"`
 }
 
+// GameGenreAliasID ジャンルの別名のID(UUID)です。
+type GameGenreAliasID = openapi_types.UUID
+
 // GameGenreCreatedAt defines model for GameGenreCreatedAt.
 type GameGenreCreatedAt = time.Time
 
+// GameGenreFacet defines model for GameGenreFacet.
+type GameGenreFacet struct {
+	// Genre ジャンルの名前です。32文字以下です。
+	Genre GameGenreName `json:"genre"`
+
+	// Id ジャンルのID(UUID)です。
+	Id GameGenreID `json:"id"`
+
+	// Num 条件に一致するゲームのうち、このジャンルのゲームの数です。
+	Num int `json:"num"`
+}
+
 // GameGenreID ジャンルのID(UUID)です。
 type GameGenreID = openapi_types.UUID
 
@@ -681,15 +1325,38 @@ type GameGenreName = string
 type GameID = openapi_types.UUID
 
 // GameImage ゲームの画像のメタ情報です。
+// size, width, heightはサイズ・形式を指定して取得し、サムネイル・WebPの画像が存在した場合のみ含まれます。
 type GameImage struct {
 	// CreatedAt ゲーム画像の作成時刻です。
 	CreatedAt GameImageCreatedAt `json:"createdAt"`
 
+	// Height ゲーム画像の高さ(px)です。
+	Height *GameImageHeight `json:"height,omitempty"`
+
 	// Id ゲーム画像のIDです。
 	Id GameImageID `json:"id"`
 
 	// Mime ゲーム画像のmimeです。
+	// image/webpはサイズ・形式を指定して取得した場合のみ返されます。
 	Mime GameImageMime `json:"mime"`
+
+	// Size ゲーム画像のサイズです。
+	// small, medium, largeはそれぞれ幅320px, 640px, 1280pxのサムネイルです。
+	// 元の画像の幅がサムネイルの幅以下の場合、そのサイズのサムネイルは作成されません。
+	Size *GameImageSize `json:"size,omitempty"`
+
+	// Width ゲーム画像の幅(px)です。
+	Width *GameImageWidth `json:"width,omitempty"`
+}
+
+// GameImageAsset defines model for GameImageAsset.
+type GameImageAsset struct {
+	// Meta ゲームの画像のメタ情報です。
+	// size, width, heightはサイズ・形式を指定して取得し、サムネイル・WebPの画像が存在した場合のみ含まれます。
+	Meta GameImage `json:"meta"`
+
+	// TmpUrl 画像の一時URLです。
+	TmpUrl string `json:"tmpUrl"`
 }
 
 // GameImageContent ゲーム画像のバイナリです。
@@ -698,12 +1365,27 @@ type GameImageContent = openapi_types.File
 // GameImageCreatedAt ゲーム画像の作成時刻です。
 type GameImageCreatedAt = time.Time
 
+// GameImageFormat ゲーム画像の形式です。
+type GameImageFormat string
+
+// GameImageHeight ゲーム画像の高さ(px)です。
+type GameImageHeight = int
+
 // GameImageID ゲーム画像のIDです。
 type GameImageID = openapi_types.UUID
 
 // GameImageMime ゲーム画像のmimeです。
+// image/webpはサイズ・形式を指定して取得した場合のみ返されます。
 type GameImageMime string
 
+// GameImageSize ゲーム画像のサイズです。
+// small, medium, largeはそれぞれ幅320px, 640px, 1280pxのサムネイルです。
+// 元の画像の幅がサムネイルの幅以下の場合、そのサイズのサムネイルは作成されません。
+type GameImageSize string
+
+// GameImageWidth ゲーム画像の幅(px)です。
+type GameImageWidth = int
+
 // GameInfo ゲームの情報です。
 type GameInfo struct {
 	// CreatedAt ゲームがtraP Collectionに追加された時刻です。
@@ -754,9 +1436,57 @@ type GameInfoWithGenres struct {
 // GameName ゲームの名前です。
 type GameName = string
 
+// GameOwnershipTransferRequest ゲームのownerの譲渡のリクエストです。
+// idには譲渡先のユーザーのIDを指定します。
+type GameOwnershipTransferRequest struct {
+	// Id ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	Id UserID `json:"id"`
+}
+
 // GamePlayLogID ゲームプレイログのID(UUID)です。
 type GamePlayLogID = openapi_types.UUID
 
+// GamePlayLogRecord ランチャーが記録した終了済みのプレイ記録です。
+// idはランチャーが生成し、再送時の重複排除に使われます。
+type GamePlayLogRecord struct {
+	// EndTime ゲーム終了時刻です。
+	EndTime time.Time `json:"endTime"`
+
+	// GameID ゲームのIDです。
+	GameID GameID `json:"gameID"`
+
+	// GameVersionID ゲームのバージョンのIDです。
+	GameVersionID GameVersionID `json:"gameVersionID"`
+
+	// Id ゲームプレイログのID(UUID)です。
+	Id GamePlayLogID `json:"id"`
+
+	// StartTime ゲーム起動時刻です。
+	StartTime time.Time `json:"startTime"`
+}
+
+// GamePlayLogRecordResult プレイ記録の処理結果です。
+type GamePlayLogRecordResult struct {
+	// Id ゲームプレイログのID(UUID)です。
+	Id GamePlayLogID `json:"id"`
+
+	// Reason statusがinvalidの場合の理由です。
+	Reason *string `json:"reason,omitempty"`
+
+	// Status プレイ記録の処理結果の状態です。
+	// - created: 新たに作成された
+	// - duplicated: 同じIDの記録が既に存在した
+	// - invalid: 不正な記録のため作成されなかった
+	Status GamePlayLogRecordStatus `json:"status"`
+}
+
+// GamePlayLogRecordStatus プレイ記録の処理結果の状態です。
+// - created: 新たに作成された
+// - duplicated: 同じIDの記録が既に存在した
+// - invalid: 不正な記録のため作成されなかった
+type GamePlayLogRecordStatus string
+
 // GamePlayStats ゲームのプレイ統計データです。
 type GamePlayStats struct {
 	// GameID ゲームのIDです。
@@ -784,6 +1514,54 @@ type GamePlayStatsInEdition struct {
 	PlayTime int `json:"playTime"`
 }
 
+// GameRoleInvitation ゲームの管理権限への招待です。
+type GameRoleInvitation struct {
+	// CreatedAt 招待が作成された時刻です。
+	CreatedAt GameRoleInvitationCreatedAt `json:"createdAt"`
+
+	// ExpiresAt 招待の有効期限です。
+	// この時刻を過ぎると招待を承諾できなくなります。
+	ExpiresAt GameRoleInvitationExpiresAt `json:"expiresAt"`
+
+	// Id ゲームの管理権限への招待のIDです。
+	Id GameRoleInvitationID `json:"id"`
+
+	// Invitee ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	Invitee UserID `json:"invitee"`
+
+	// Inviter ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	Inviter UserID `json:"inviter"`
+
+	// Type ゲームの管理権限の種類です。
+	// ownerはゲームの所有者で、ゲーム情報の変更や管理者の変更ができます。
+	// maintainerはゲームのメンテナーで、ゲーム情報の変更のみできます。
+	Type GameRoleType `json:"type"`
+}
+
+// GameRoleInvitationCreatedAt 招待が作成された時刻です。
+type GameRoleInvitationCreatedAt = time.Time
+
+// GameRoleInvitationExpiresAt 招待の有効期限です。
+// この時刻を過ぎると招待を承諾できなくなります。
+type GameRoleInvitationExpiresAt = time.Time
+
+// GameRoleInvitationID ゲームの管理権限への招待のIDです。
+type GameRoleInvitationID = openapi_types.UUID
+
+// GameRoleInvitationRequest ゲームの管理権限への招待のリクエストです。
+type GameRoleInvitationRequest struct {
+	// Id ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	Id UserID `json:"id"`
+
+	// Type ゲームの管理権限の種類です。
+	// ownerはゲームの所有者で、ゲーム情報の変更や管理者の変更ができます。
+	// maintainerはゲームのメンテナーで、ゲーム情報の変更のみできます。
+	Type GameRoleType `json:"type"`
+}
+
 // GameRoleRequest ゲームのロールを指定するリクエストです。
 type GameRoleRequest struct {
 	// Id ユーザーのIDです。
@@ -801,6 +1579,42 @@ type GameRoleRequest struct {
 // maintainerはゲームのメンテナーで、ゲーム情報の変更のみできます。
 type GameRoleType string
 
+// GameStorage ゲームのストレージの使用量と上限です。
+// quotaは上限がない場合は含まれません。
+type GameStorage struct {
+	// Quota ストレージの容量(バイト)です。
+	Quota *GameStorageSize `json:"quota,omitempty"`
+
+	// QuotaOverridden adminがゲームごとに設定した上限が適用されている場合にtrueです。
+	// falseの場合、デフォルトの上限が適用されています。
+	QuotaOverridden bool `json:"quotaOverridden"`
+
+	// Usage ゲームのファイル・画像・動画の容量の合計です。
+	// 画像の派生画像と動画のポスター画像は含まれません。
+	// 容量の記録の導入前にアップロードされたものは0として数えられます。
+	Usage GameStorageUsage `json:"usage"`
+}
+
+// GameStorageSize ストレージの容量(バイト)です。
+type GameStorageSize = int64
+
+// GameStorageUsage ゲームのファイル・画像・動画の容量の合計です。
+// 画像の派生画像と動画のポスター画像は含まれません。
+// 容量の記録の導入前にアップロードされたものは0として数えられます。
+type GameStorageUsage struct {
+	// File ストレージの容量(バイト)です。
+	File GameStorageSize `json:"file"`
+
+	// Image ストレージの容量(バイト)です。
+	Image GameStorageSize `json:"image"`
+
+	// Total ストレージの容量(バイト)です。
+	Total GameStorageSize `json:"total"`
+
+	// Video ストレージの容量(バイト)です。
+	Video GameStorageSize `json:"video"`
+}
+
 // GameURL ゲームのURLの値です。
 type GameURL = string
 
@@ -834,6 +1648,32 @@ type GameVersion struct {
 	VideoID GameVideoID `json:"videoID"`
 }
 
+// GameVersionAssets ゲームバージョンに紐づくアセットと、そのダウンロード用の一時URLです。
+type GameVersionAssets struct {
+	// CreatedAt ゲームのバージョンが作成された時刻です。
+	CreatedAt GameVersionCreatedAt `json:"createdAt"`
+
+	// Description ゲームのバージョンの説明です。
+	// 主にゲームの開発者向けの情報で、ランチャーでは表示されません。
+	Description GameVersionDescription `json:"description"`
+
+	// ExpiresAt 各アセットの一時URLの有効期限です。
+	ExpiresAt time.Time       `json:"expiresAt"`
+	Files     []GameFileAsset `json:"files"`
+
+	// Id ゲームのバージョンのIDです。
+	Id    GameVersionID  `json:"id"`
+	Image GameImageAsset `json:"image"`
+
+	// Name ゲームのバージョン名です。
+	// セマンティックバージョニングに沿った文字列が許容されます。
+	Name GameVersionName `json:"name"`
+
+	// Url ゲームのURLの値です。
+	Url   *GameURL       `json:"url,omitempty"`
+	Video GameVideoAsset `json:"video"`
+}
+
 // GameVersionCreatedAt ゲームのバージョンが作成された時刻です。
 type GameVersionCreatedAt = time.Time
 
@@ -891,6 +1731,8 @@ type GameVersionPlayStats struct {
 }
 
 // GameVideo ゲームの動画のメタ情報です。
+// metadataはコンテナから長さや解像度を読み取れた場合のみ含まれます。
+// posterMimeはポスター画像が設定されている場合のみ含まれます。
 type GameVideo struct {
 	// CreatedAt ゲーム紹介動画の作成時刻です。
 	CreatedAt GameVideoCreatedAt `json:"createdAt"`
@@ -898,22 +1740,82 @@ type GameVideo struct {
 	// Id ゲーム紹介動画のIDです。
 	Id GameVideoID `json:"id"`
 
+	// Metadata 動画のコンテナから読み取った情報です。
+	// audioCodecは音声トラックがある場合のみ含まれます。
+	Metadata *GameVideoMetadata `json:"metadata,omitempty"`
+
 	// Mime ゲーム紹介動画のmimeです。
 	Mime GameVideoMime `json:"mime"`
+
+	// PosterMime ゲーム画像のmimeです。
+	// image/webpはサイズ・形式を指定して取得した場合のみ返されます。
+	PosterMime *GameImageMime `json:"posterMime,omitempty"`
 }
 
+// GameVideoAsset defines model for GameVideoAsset.
+type GameVideoAsset struct {
+	// Meta ゲームの動画のメタ情報です。
+	// metadataはコンテナから長さや解像度を読み取れた場合のみ含まれます。
+	// posterMimeはポスター画像が設定されている場合のみ含まれます。
+	Meta GameVideo `json:"meta"`
+
+	// PosterTmpUrl ポスター画像の一時URLです。ポスター画像が設定されている場合のみ含まれます。
+	PosterTmpUrl *string `json:"posterTmpUrl,omitempty"`
+
+	// TmpUrl 動画の一時URLです。
+	TmpUrl string `json:"tmpUrl"`
+}
+
+// GameVideoAudioCodec ゲーム紹介動画の音声のコーデックです。
+// ブラウザで再生できるコーデックのみ受け付けます。
+type GameVideoAudioCodec string
+
+// GameVideoCodec ゲーム紹介動画の映像のコーデックです。
+// ブラウザで再生できるコーデックのみ受け付けます。
+type GameVideoCodec string
+
 // GameVideoContent ゲーム紹介動画のバイナリです。
 type GameVideoContent = openapi_types.File
 
 // GameVideoCreatedAt ゲーム紹介動画の作成時刻です。
 type GameVideoCreatedAt = time.Time
 
+// GameVideoDuration ゲーム紹介動画の長さ(ミリ秒)です。
+type GameVideoDuration = int64
+
+// GameVideoHeight ゲーム紹介動画の高さ(px)です。
+type GameVideoHeight = int
+
 // GameVideoID ゲーム紹介動画のIDです。
 type GameVideoID = openapi_types.UUID
 
+// GameVideoMetadata 動画のコンテナから読み取った情報です。
+// audioCodecは音声トラックがある場合のみ含まれます。
+type GameVideoMetadata struct {
+	// AudioCodec ゲーム紹介動画の音声のコーデックです。
+	// ブラウザで再生できるコーデックのみ受け付けます。
+	AudioCodec *GameVideoAudioCodec `json:"audioCodec,omitempty"`
+
+	// Duration ゲーム紹介動画の長さ(ミリ秒)です。
+	Duration GameVideoDuration `json:"duration"`
+
+	// Height ゲーム紹介動画の高さ(px)です。
+	Height GameVideoHeight `json:"height"`
+
+	// VideoCodec ゲーム紹介動画の映像のコーデックです。
+	// ブラウザで再生できるコーデックのみ受け付けます。
+	VideoCodec GameVideoCodec `json:"videoCodec"`
+
+	// Width ゲーム紹介動画の幅(px)です。
+	Width GameVideoWidth `json:"width"`
+}
+
 // GameVideoMime ゲーム紹介動画のmimeです。
 type GameVideoMime string
 
+// GameVideoWidth ゲーム紹介動画の幅(px)です。
+type GameVideoWidth = int
+
 // GameVisibility ゲームの公開設定です。
 // publicは全てのユーザーが全ての情報・ファイルにアクセスできます。
 // limitedは部員は全ての情報・ファイルに、部員以外はファイル以外にアクセスできます。
@@ -921,6 +1823,28 @@ type GameVideoMime string
 // ゲーム作成時、指定がない場合はprivateになります
 type GameVisibility string
 
+// GameVisibilityFacet defines model for GameVisibilityFacet.
+type GameVisibilityFacet struct {
+	// Num 条件に一致するゲームのうち、この公開範囲のゲームの数です。
+	Num int `json:"num"`
+
+	// Type ゲームの公開設定です。
+	// publicは全てのユーザーが全ての情報・ファイルにアクセスできます。
+	// limitedは部員は全ての情報・ファイルに、部員以外はファイル以外にアクセスできます。
+	// privateは部員はアクセスできます。
+	// ゲーム作成時、指定がない場合はprivateになります
+	Type GameVisibility `json:"type"`
+}
+
+// GetAuditLogsResponse defines model for GetAuditLogsResponse.
+type GetAuditLogsResponse struct {
+	// Logs limit、offsetが適用された後の監査ログの一覧です。
+	Logs []AuditLog `json:"logs"`
+
+	// Num limit、offsetが適用される前の監査ログの数です。
+	Num int `json:"num"`
+}
+
 // GetGameVersionsResponse ゲームバージョンの一覧を取得します。
 // ページングのために、limit、offsetを適用する前のゲームバージョンの数もnumで返しています。
 type GetGameVersionsResponse struct {
@@ -933,7 +1857,12 @@ type GetGameVersionsResponse struct {
 
 // GetGamesResponse ゲームの一覧を取得します。
 // ページングのために、limit、offsetを適用する前のゲームの数をnumで返しています。
+// facetsにtrueを指定した場合のみ、facetsが含まれます。
 type GetGamesResponse struct {
+	// Facets 条件に一致するゲームの、ジャンル・ファイルの種類・公開範囲ごとの数です。
+	// limit、offsetは適用されません。数が0のものは含まれません。
+	Facets *GameFacets `json:"facets,omitempty"`
+
 	// Games limit、offsetが適用された後のゲームの一覧です。
 	Games []GameInfoWithGenres `json:"games"`
 
@@ -953,6 +1882,39 @@ type HourlyPlayStats struct {
 	StartTime time.Time `json:"startTime"`
 }
 
+// InvalidGameFileError defines model for InvalidGameFileError.
+type InvalidGameFileError struct {
+	// Entries zipファイルに含まれる不正なエントリーの一覧です。
+	// 不正なエントリーが原因でない場合は含まれません。
+	Entries *[]GameFileZipEntryError `json:"entries,omitempty"`
+	Message string                   `json:"message"`
+}
+
+// MyGameRoleInvitation ログイン中のユーザーへのゲームの管理権限への招待です。
+type MyGameRoleInvitation struct {
+	// CreatedAt 招待が作成された時刻です。
+	CreatedAt GameRoleInvitationCreatedAt `json:"createdAt"`
+
+	// ExpiresAt 招待の有効期限です。
+	// この時刻を過ぎると招待を承諾できなくなります。
+	ExpiresAt GameRoleInvitationExpiresAt `json:"expiresAt"`
+
+	// Game ゲームの情報です。
+	Game GameInfo `json:"game"`
+
+	// Id ゲームの管理権限への招待のIDです。
+	Id GameRoleInvitationID `json:"id"`
+
+	// Inviter ユーザーのIDです。
+	// traQのユーザーのUUIDと対応します。
+	Inviter UserID `json:"inviter"`
+
+	// Type ゲームの管理権限の種類です。
+	// ownerはゲームの所有者で、ゲーム情報の変更や管理者の変更ができます。
+	// maintainerはゲームのメンテナーで、ゲーム情報の変更のみできます。
+	Type GameRoleType `json:"type"`
+}
+
 // NewEdition エディションを新しく作成する際に必要な情報です。
 // questionnaireは工大祭などのアンケートが必要な際のみ存在します。
 type NewEdition struct {
@@ -1045,6 +2007,12 @@ type NewGameVideo struct {
 	Content GameVideoContent `json:"content"`
 }
 
+// NewGameVideoPoster ゲーム動画のポスター画像を保存する際に必要な情報です。
+type NewGameVideoPoster struct {
+	// Content ゲーム画像のバイナリです。
+	Content GameImageContent `json:"content"`
+}
+
 // PatchEdition エディションの情報を修正する際に必要な情報です。
 type PatchEdition struct {
 	// Name エディション名です。
@@ -1100,6 +2068,15 @@ type PostGameCreatorCustomJobRequest struct {
 	DisplayName GameCreatorJobDisplayName `json:"displayName"`
 }
 
+// PostGameExternalCreatorRequest defines model for PostGameExternalCreatorRequest.
+type PostGameExternalCreatorRequest struct {
+	// Name 外部のゲームクリエイターの表示名です。
+	Name GameCreatorExternalName `json:"name"`
+
+	// Url 外部のゲームクリエイターのWebサイトなどのURLです。
+	Url *GameCreatorExternalURL `json:"url,omitempty"`
+}
+
 // PostGameFeedbackRequest defines model for PostGameFeedbackRequest.
 type PostGameFeedbackRequest struct {
 	// Answers 質問に対する回答の配列
@@ -1133,6 +2110,18 @@ type PostGamePlayLogStartResponse struct {
 	PlayLogID GamePlayLogID `json:"playLogID"`
 }
 
+// PostGamePlayLogsRequest ゲームプレイログのまとめての記録リクエストです。
+type PostGamePlayLogsRequest struct {
+	// Plays 終了済みのプレイ記録の一覧です。
+	Plays []GamePlayLogRecord `json:"plays"`
+}
+
+// PostGamePlayLogsResponse ゲームプレイログのまとめての記録のレスポンスです。
+type PostGamePlayLogsResponse struct {
+	// Results リクエストと同じ順番の、各プレイ記録の処理結果です。
+	Results []GamePlayLogRecordResult `json:"results"`
+}
+
 // PostSeatRequest 席数を変更するためのリクエストです。
 type PostSeatRequest struct {
 	// Num 席数です。
@@ -1175,10 +2164,17 @@ type PutFeedbackQuestionsRequest struct {
 // PutGameCreatorJobsRequest defines model for PutGameCreatorJobsRequest.
 type PutGameCreatorJobsRequest struct {
 	// JobIDs ゲームクリエイターに紐づけるジョブIDの配列です。
+	// 配列の順番がクリエイター内でのジョブの表示順になります。
 	// 空の配列を指定すると、ゲームクリエイターからすべてのジョブの関連を削除します。
 	JobIDs []GameCreatorJobID `json:"jobIDs"`
 }
 
+// PutGameCreatorsOrderRequest defines model for PutGameCreatorsOrderRequest.
+type PutGameCreatorsOrderRequest struct {
+	// CreatorIDs 表示順に並べたゲームクリエイターIDの配列です。
+	CreatorIDs []GameCreatorID `json:"creatorIDs"`
+}
+
 // Seat 席の情報です。
 type Seat struct {
 	// Id 席のIDです。
@@ -1196,6 +2192,145 @@ type SeatID = int
 // in-useは使用中、emptyは空席です。
 type SeatStatus string
 
+// ServerBuildInfo defines model for ServerBuildInfo.
+type ServerBuildInfo struct {
+	// GoVersion ビルドに使用したGoのバージョンです。
+	GoVersion string `json:"goVersion"`
+
+	// Revision ビルド元のコミットのハッシュです。取得できない場合は空文字列になります。
+	Revision string `json:"revision"`
+
+	// Version サーバーのバージョンです。
+	Version string `json:"version"`
+}
+
+// ServerDependencyStatus defines model for ServerDependencyStatus.
+type ServerDependencyStatus struct {
+	// Error 利用できない場合のエラーの内容です。
+	Error *string `json:"error,omitempty"`
+
+	// Name 依存しているサービスの名前です。
+	Name ServerDependencyStatusName `json:"name"`
+
+	// Status 依存しているサービスの状態です。
+	Status ServerDependencyStatusStatus `json:"status"`
+}
+
+// ServerDependencyStatusName 依存しているサービスの名前です。
+type ServerDependencyStatusName string
+
+// ServerDependencyStatusStatus 依存しているサービスの状態です。
+type ServerDependencyStatusStatus string
+
+// ServerFeatures defines model for ServerFeatures.
+type ServerFeatures struct {
+	// V1Write v1 APIの書き込みが有効かどうかです。
+	V1Write bool `json:"v1Write"`
+
+	// V2 v2 APIが有効かどうかです。
+	V2 bool `json:"v2"`
+}
+
+// ServerJobStatus defines model for ServerJobStatus.
+type ServerJobStatus struct {
+	// Error 最後の実行が失敗した場合のエラーの内容です。
+	Error *string `json:"error,omitempty"`
+
+	// LastFinishedAt 最後に実行を終了した時刻です。
+	LastFinishedAt time.Time `json:"lastFinishedAt"`
+
+	// LastStartedAt 最後に実行を開始した時刻です。
+	LastStartedAt time.Time `json:"lastStartedAt"`
+
+	// Name 定期実行ジョブの名前です。
+	Name string `json:"name"`
+
+	// Succeeded 最後の実行が成功したかどうかです。
+	Succeeded bool `json:"succeeded"`
+}
+
+// ServerMigrationStatus defines model for ServerMigrationStatus.
+type ServerMigrationStatus struct {
+	// Current 適用済みの最新のマイグレーションのバージョンです。
+	Current string `json:"current"`
+
+	// Latest サーバーが持つ最新のマイグレーションのバージョンです。
+	Latest string `json:"latest"`
+}
+
+// ServerStatus サーバーの状態です。
+// migrationはマイグレーションの状態を取得できなかった場合は含まれません。
+type ServerStatus struct {
+	Build        ServerBuildInfo          `json:"build"`
+	Dependencies []ServerDependencyStatus `json:"dependencies"`
+	Features     ServerFeatures           `json:"features"`
+	Jobs         []ServerJobStatus        `json:"jobs"`
+	Migration    *ServerMigrationStatus   `json:"migration,omitempty"`
+}
+
+// StorageVerification defines model for StorageVerification.
+type StorageVerification struct {
+	// CheckFailed ストレージへのアクセスに失敗し、検証できなかったオブジェクトの数です。
+	CheckFailed int `json:"checkFailed"`
+
+	// CheckedObjects 検証済みのオブジェクトの数です。
+	CheckedObjects int `json:"checkedObjects"`
+
+	// FinishedAt 検証を終了した時刻です。実行中の場合は含まれません。
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// HashMismatch ハッシュ値が一致しなかったゲームファイルの数です。
+	HashMismatch int `json:"hashMismatch"`
+
+	// Id 検証のIDです。
+	Id openapi_types.UUID `json:"id"`
+
+	// Issues 見つかった問題です。
+	Issues []StorageVerificationIssue `json:"issues"`
+
+	// Missing ストレージに存在しなかったオブジェクトの数です。
+	Missing int `json:"missing"`
+
+	// StartedAt 検証を開始した時刻です。
+	StartedAt time.Time `json:"startedAt"`
+
+	// Status 検証の状態です。
+	// failedは、オブジェクトの一覧の取得の失敗や中断により、検証が最後まで行われなかったことを表します。
+	Status StorageVerificationStatus `json:"status"`
+
+	// TotalObjects 検証対象のオブジェクトの数です。
+	TotalObjects int `json:"totalObjects"`
+}
+
+// StorageVerificationStatus 検証の状態です。
+// failedは、オブジェクトの一覧の取得の失敗や中断により、検証が最後まで行われなかったことを表します。
+type StorageVerificationStatus string
+
+// StorageVerificationIssue defines model for StorageVerificationIssue.
+type StorageVerificationIssue struct {
+	// Detail ハッシュ値の不一致やアクセスの失敗の内容です。
+	Detail string `json:"detail"`
+
+	// GameId ゲームのIDです。
+	GameId GameID `json:"gameId"`
+
+	// IssueType 問題の種類です。
+	IssueType StorageVerificationIssueIssueType `json:"issueType"`
+
+	// ObjectId 問題が見つかったオブジェクトのIDです。
+	// gameVideoPosterの場合は動画のIDになります。
+	ObjectId openapi_types.UUID `json:"objectId"`
+
+	// ObjectKind 問題が見つかったオブジェクトの種類です。
+	ObjectKind StorageVerificationIssueObjectKind `json:"objectKind"`
+}
+
+// StorageVerificationIssueIssueType 問題の種類です。
+type StorageVerificationIssueIssueType string
+
+// StorageVerificationIssueObjectKind 問題が見つかったオブジェクトの種類です。
+type StorageVerificationIssueObjectKind string
+
 // User ユーザー
 type User struct {
 	// Id ユーザーのIDです。
@@ -1228,21 +2363,35 @@ type AuthorizationCodeInQuery = string
 // CreatorIDInPath ゲームクリエイターのIDを表します。
 type CreatorIDInPath = GameCreatorID
 
+// EditionBundleIDInPath defines model for editionBundleIDInPath.
+type EditionBundleIDInPath = openapi_types.UUID
+
 // EditionIDInPath defines model for editionIDInPath.
 type EditionIDInPath = openapi_types.UUID
 
 // GameFileIDInPath ゲームファイルのIDです。
 type GameFileIDInPath = GameFileID
 
+// GameGenreAliasIDInPath ジャンルの別名のID(UUID)です。
+type GameGenreAliasIDInPath = GameGenreAliasID
+
 // GameGenreIDInPath defines model for gameGenreIDInPath.
 type GameGenreIDInPath = openapi_types.UUID
 
 // GameIDInPath ゲームのIDです。
 type GameIDInPath = GameID
 
+// GameImageFormatInQuery ゲーム画像の形式です。
+type GameImageFormatInQuery = GameImageFormat
+
 // GameImageIDInPath ゲーム画像のIDです。
 type GameImageIDInPath = GameImageID
 
+// GameImageSizeInQuery ゲーム画像のサイズです。
+// small, medium, largeはそれぞれ幅320px, 640px, 1280pxのサムネイルです。
+// 元の画像の幅がサムネイルの幅以下の場合、そのサイズのサムネイルは作成されません。
+type GameImageSizeInQuery = GameImageSize
+
 // GameVersionIDInPath defines model for gameVersionIDInPath.
 type GameVersionIDInPath = openapi_types.UUID
 
@@ -1252,6 +2401,9 @@ type GameVersionIDInQuery = openapi_types.UUID
 // GameVideoIDInPath ゲーム紹介動画のIDです。
 type GameVideoIDInPath = GameVideoID
 
+// InvitationIDInPath ゲームの管理権限への招待のIDです。
+type InvitationIDInPath = GameRoleInvitationID
+
 // PeriodEndInQuery defines model for periodEndInQuery.
 type PeriodEndInQuery = time.Time
 
@@ -1286,9 +2438,15 @@ type EditionForbidden = Error
 // GameInfoForbidden defines model for GameInfoForbidden.
 type GameInfoForbidden = Error
 
+// GameStorageQuotaExceeded defines model for GameStorageQuotaExceeded.
+type GameStorageQuotaExceeded = Error
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
 // TraPUnauthorized defines model for TraPUnauthorized.
 type TraPUnauthorized = Error
 
@@ -1337,14 +2495,50 @@ type gameVideoVisibilityAuthContextKey string
 // trapMemberAuthContextKey is the context key for TrapMemberAuth security scheme
 type trapMemberAuthContextKey string
 
-// GetProductKeysParams defines parameters for GetProductKeys.
-type GetProductKeysParams struct {
-	// Status プロダクトキーのステータスを示すクエリパラメータです。
-	// 指定がない場合は全てのステータスのプロダクトキーが返されます。
-	Status *ProductKeyStatusInQuery `form:"status,omitempty" json:"status,omitempty"`
+// GetStorageVerificationParams defines parameters for GetStorageVerification.
+type GetStorageVerificationParams struct {
+	// Limit 取得する問題の上限数を指定します。
+	// 指定なしの場合は100、0の場合はすべての問題が取得されます。
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// PostProductKeyParams defines parameters for PostProductKey.
+// GetAuditLogsParams defines parameters for GetAuditLogs.
+type GetAuditLogsParams struct {
+	// Limit 取得する監査ログの上限数を指定します。
+	// 指定なしの場合はすべての監査ログが取得されます。
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset 取得する監査ログの開始位置を指定します。
+	// 指定なしの場合は0となります。
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Actor 操作を行ったユーザーのIDを指定します。指定なしの場合は操作者による絞り込みを行いません。
+	Actor *UserID `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action 操作の種類を指定します。指定なしの場合は操作の種類による絞り込みを行いません。
+	Action *AuditLogAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// TargetType 操作対象の種類を指定します。指定なしの場合は操作対象の種類による絞り込みを行いません。
+	TargetType *AuditLogTargetType `form:"targetType,omitempty" json:"targetType,omitempty"`
+
+	// TargetID 操作対象のIDを指定します。指定なしの場合は操作対象のIDによる絞り込みを行いません。
+	TargetID *openapi_types.UUID `form:"targetID,omitempty" json:"targetID,omitempty"`
+
+	// Since 指定した時刻以降の監査ログのみを取得します。
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until 指定した時刻より前の監査ログのみを取得します。
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetProductKeysParams defines parameters for GetProductKeys.
+type GetProductKeysParams struct {
+	// Status プロダクトキーのステータスを示すクエリパラメータです。
+	// 指定がない場合は全てのステータスのプロダクトキーが返されます。
+	Status *ProductKeyStatusInQuery `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// PostProductKeyParams defines parameters for PostProductKey.
 type PostProductKeyParams struct {
 	// Num 生成するプロダクトキーの数を示すクエリパラメータです。
 	Num ProductKeyNumInQuery `form:"num" json:"num"`
@@ -1386,8 +2580,18 @@ type GetGamesParams struct {
 	// Name ゲームの名前を指定すると部分一致するゲームを返します。指定なしの場合は名前による絞り込みを行いません。
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
+	// Keyword キーワードを指定すると、ゲームの名前・説明・作成者名のいずれかに一致するゲームを返します。
+	// 空白区切りで複数の単語を指定した場合、名前・説明はすべての単語を含むものが一致します。
+	// 指定なしの場合はキーワードによる絞り込みを行いません。
+	Keyword *string `form:"keyword,omitempty" json:"keyword,omitempty"`
+
 	// Sort 取得するゲームの並び順を指定します。指定なしの場合は、各ゲームの最新のバージョンが新しい順に取得します。
+	// playCountはプレイ回数の多い順、latestFeedbackは最新のフィードバックが新しい順です。
 	Sort *GetGamesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Facets trueを指定すると、条件に一致するゲームのジャンル・ファイルの種類・公開範囲ごとの数をfacetsで返します。
+	// デフォルトはfalseです。
+	Facets *bool `form:"facets,omitempty" json:"facets,omitempty"`
 }
 
 // GetGamesParamsSort defines parameters for GetGames.
@@ -1402,11 +2606,48 @@ type GetGameFeedbacksParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// PutGameFileScanStatusJSONBody defines parameters for PutGameFileScanStatus.
+type PutGameFileScanStatusJSONBody struct {
+	// ScanStatus 変更後の検査の状態です。cleanかrejectedのみ指定できます。
+	ScanStatus PutGameFileScanStatusJSONBodyScanStatus `json:"scanStatus"`
+}
+
+// PutGameFileScanStatusJSONBodyScanStatus defines parameters for PutGameFileScanStatus.
+type PutGameFileScanStatusJSONBodyScanStatus string
+
 // PutGameGenresJSONBody defines parameters for PutGameGenres.
 type PutGameGenresJSONBody struct {
 	Genres *[]GameGenreName `json:"genres,omitempty"`
 }
 
+// GetGameImageParams defines parameters for GetGameImage.
+type GetGameImageParams struct {
+	// Size 取得するゲーム画像のサイズを示すクエリパラメータです。
+	// 指定なしの場合はoriginalとなります。
+	// 指定したサイズのサムネイルが存在しない場合は元の画像が返されます。
+	// サムネイルはアップロード後にバックグラウンドで作成されるため、アップロード直後は存在しない場合があります。
+	Size *GameImageSizeInQuery `form:"size,omitempty" json:"size,omitempty"`
+
+	// Format 取得するゲーム画像の形式を示すクエリパラメータです。
+	// webpを指定した場合、WebPの画像が存在すればWebPの画像が返されます。
+	// 指定なしの場合は元の画像と同じ形式となります。
+	Format *GameImageFormatInQuery `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetGameImageMetaParams defines parameters for GetGameImageMeta.
+type GetGameImageMetaParams struct {
+	// Size 取得するゲーム画像のサイズを示すクエリパラメータです。
+	// 指定なしの場合はoriginalとなります。
+	// 指定したサイズのサムネイルが存在しない場合は元の画像が返されます。
+	// サムネイルはアップロード後にバックグラウンドで作成されるため、アップロード直後は存在しない場合があります。
+	Size *GameImageSizeInQuery `form:"size,omitempty" json:"size,omitempty"`
+
+	// Format 取得するゲーム画像の形式を示すクエリパラメータです。
+	// webpを指定した場合、WebPの画像が存在すればWebPの画像が返されます。
+	// 指定なしの場合は元の画像と同じ形式となります。
+	Format *GameImageFormatInQuery `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // GetGamePlayStatsParams defines parameters for GetGamePlayStats.
 type GetGamePlayStatsParams struct {
 	// GameVersionID ゲームバージョンのIDを示すクエリパラメータです。
@@ -1429,6 +2670,12 @@ type GetGamePlayStats200JSONResponseBody struct {
 	union json.RawMessage
 }
 
+// PutGameStorageQuotaJSONBody defines parameters for PutGameStorageQuota.
+type PutGameStorageQuotaJSONBody struct {
+	// Quota ストレージの容量(バイト)です。
+	Quota GameStorageSize `json:"quota"`
+}
+
 // GetGameVersionParams defines parameters for GetGameVersion.
 type GetGameVersionParams struct {
 	// Limit 取得するゲームバージョンの上限数を指定します。
@@ -1455,6 +2702,24 @@ type PatchGameGenreJSONBody struct {
 	Genre GameGenreName `json:"genre"`
 }
 
+// PostGameGenreAliasJSONBody defines parameters for PostGameGenreAlias.
+type PostGameGenreAliasJSONBody struct {
+	// Alias ジャンルの名前です。32文字以下です。
+	Alias GameGenreName `json:"alias"`
+}
+
+// MergeGameGenreJSONBody defines parameters for MergeGameGenre.
+type MergeGameGenreJSONBody struct {
+	// Target ジャンルのID(UUID)です。
+	Target GameGenreID `json:"target"`
+}
+
+// PutGameGenreParentJSONBody defines parameters for PutGameGenreParent.
+type PutGameGenreParentJSONBody struct {
+	// Parent ジャンルのID(UUID)です。
+	Parent *GameGenreID `json:"parent,omitempty"`
+}
+
 // GetCallbackParams defines parameters for GetCallback.
 type GetCallbackParams struct {
 	// Code OAuth 2.0のAuthorization Codeです。
@@ -1493,6 +2758,9 @@ type PostGamePlayLogStartJSONRequestBody = PostGamePlayLogStartRequest
 // PatchGamePlayLogEndJSONRequestBody defines body for PatchGamePlayLogEnd for application/json ContentType.
 type PatchGamePlayLogEndJSONRequestBody = PatchGamePlayLogEndRequest
 
+// PostGamePlayLogsJSONRequestBody defines body for PostGamePlayLogs for application/json ContentType.
+type PostGamePlayLogsJSONRequestBody = PostGamePlayLogsRequest
+
 // PostGameJSONRequestBody defines body for PostGame for application/json ContentType.
 type PostGameJSONRequestBody = NewGame
 
@@ -1505,6 +2773,12 @@ type PostGameCreatorJSONRequestBody = UserRequest
 // PostGameCreatorCustomJobJSONRequestBody defines body for PostGameCreatorCustomJob for application/json ContentType.
 type PostGameCreatorCustomJobJSONRequestBody = PostGameCreatorCustomJobRequest
 
+// PostGameExternalCreatorJSONRequestBody defines body for PostGameExternalCreator for application/json ContentType.
+type PostGameExternalCreatorJSONRequestBody = PostGameExternalCreatorRequest
+
+// PutGameCreatorsOrderJSONRequestBody defines body for PutGameCreatorsOrder for application/json ContentType.
+type PutGameCreatorsOrderJSONRequestBody = PutGameCreatorsOrderRequest
+
 // PutGameCreatorJobsJSONRequestBody defines body for PutGameCreatorJobs for application/json ContentType.
 type PutGameCreatorJobsJSONRequestBody = PutGameCreatorJobsRequest
 
@@ -1520,24 +2794,48 @@ type PostGameFeedbackJSONRequestBody = PostGameFeedbackRequest
 // PostGameFileMultipartRequestBody defines body for PostGameFile for multipart/form-data ContentType.
 type PostGameFileMultipartRequestBody = NewGameFile
 
+// PutGameFileScanStatusJSONRequestBody defines body for PutGameFileScanStatus for application/json ContentType.
+type PutGameFileScanStatusJSONRequestBody PutGameFileScanStatusJSONBody
+
 // PutGameGenresJSONRequestBody defines body for PutGameGenres for application/json ContentType.
 type PutGameGenresJSONRequestBody PutGameGenresJSONBody
 
 // PostGameImageMultipartRequestBody defines body for PostGameImage for multipart/form-data ContentType.
 type PostGameImageMultipartRequestBody = NewGameImage
 
+// PostGameRoleInvitationJSONRequestBody defines body for PostGameRoleInvitation for application/json ContentType.
+type PostGameRoleInvitationJSONRequestBody = GameRoleInvitationRequest
+
 // PatchGameRoleJSONRequestBody defines body for PatchGameRole for application/json ContentType.
 type PatchGameRoleJSONRequestBody = GameRoleRequest
 
+// PostGameOwnershipTransferJSONRequestBody defines body for PostGameOwnershipTransfer for application/json ContentType.
+type PostGameOwnershipTransferJSONRequestBody = GameOwnershipTransferRequest
+
+// PutGameStorageQuotaJSONRequestBody defines body for PutGameStorageQuota for application/json ContentType.
+type PutGameStorageQuotaJSONRequestBody PutGameStorageQuotaJSONBody
+
 // PostGameVersionJSONRequestBody defines body for PostGameVersion for application/json ContentType.
 type PostGameVersionJSONRequestBody = NewGameVersion
 
 // PostGameVideoMultipartRequestBody defines body for PostGameVideo for multipart/form-data ContentType.
 type PostGameVideoMultipartRequestBody = NewGameVideo
 
+// PutGameVideoPosterMultipartRequestBody defines body for PutGameVideoPoster for multipart/form-data ContentType.
+type PutGameVideoPosterMultipartRequestBody = NewGameVideoPoster
+
 // PatchGameGenreJSONRequestBody defines body for PatchGameGenre for application/json ContentType.
 type PatchGameGenreJSONRequestBody PatchGameGenreJSONBody
 
+// PostGameGenreAliasJSONRequestBody defines body for PostGameGenreAlias for application/json ContentType.
+type PostGameGenreAliasJSONRequestBody PostGameGenreAliasJSONBody
+
+// MergeGameGenreJSONRequestBody defines body for MergeGameGenre for application/json ContentType.
+type MergeGameGenreJSONRequestBody MergeGameGenreJSONBody
+
+// PutGameGenreParentJSONRequestBody defines body for PutGameGenreParent for application/json ContentType.
+type PutGameGenreParentJSONRequestBody PutGameGenreParentJSONBody
+
 // PostSeatJSONRequestBody defines body for PostSeat for application/json ContentType.
 type PostSeatJSONRequestBody = PostSeatRequest
 
@@ -1786,6 +3084,12 @@ func (t *GetGamePlayStats200JSONResponseBody) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// サーバーの状態の取得
+	// (GET /admin/status)
+	GetAdminStatus(ctx echo.Context) error
+	// ストレージの検証結果の取得
+	// (GET /admin/storage-verification)
+	GetStorageVerification(ctx echo.Context, params GetStorageVerificationParams) error
 	// traPの管理者一覧取得
 	// (GET /admins)
 	GetAdmins(ctx echo.Context) error
@@ -1795,6 +3099,9 @@ type ServerInterface interface {
 	// traP Collection全体の管理者削除
 	// (DELETE /admins/{userID})
 	DeleteAdmin(ctx echo.Context, userID UserIDInPath) error
+	// 監査ログの取得
+	// (GET /audit-logs)
+	GetAuditLogs(ctx echo.Context, params GetAuditLogsParams) error
 	// エディション一覧の取得
 	// (GET /editions)
 	GetEditions(ctx echo.Context) error
@@ -1816,6 +3123,18 @@ type ServerInterface interface {
 	// エディション情報の変更
 	// (PATCH /editions/{editionID})
 	PatchEdition(ctx echo.Context, editionID EditionIDInPath) error
+	// エディションのバンドルの一覧の取得
+	// (GET /editions/{editionID}/bundles)
+	GetEditionBundles(ctx echo.Context, editionID EditionIDInPath) error
+	// エディションのバンドルの生成
+	// (POST /editions/{editionID}/bundles)
+	PostEditionBundle(ctx echo.Context, editionID EditionIDInPath) error
+	// エディションのバンドルの情報の取得
+	// (GET /editions/{editionID}/bundles/{editionBundleID})
+	GetEditionBundle(ctx echo.Context, editionID EditionIDInPath, editionBundleID EditionBundleIDInPath) error
+	// エディションのバンドルのダウンロード
+	// (GET /editions/{editionID}/bundles/{editionBundleID}/download)
+	GetEditionBundleDownload(ctx echo.Context, editionID EditionIDInPath, editionBundleID EditionBundleIDInPath) error
 	// エディションに紐づくゲームの一覧の取得
 	// (GET /editions/{editionID}/games)
 	GetEditionGames(ctx echo.Context, editionID EditionIDInPath) error
@@ -1831,6 +3150,9 @@ type ServerInterface interface {
 	// ゲーム終了ログの記録
 	// (PATCH /editions/{editionID}/games/{gameID}/plays/{playLogID}/end)
 	PatchGamePlayLogEnd(ctx echo.Context, editionID EditionIDInPath, gameID GameIDInPath, playLogID PlayLogIDInPath) error
+	// エディションのゲームバージョンのアセットの一括取得
+	// (GET /editions/{editionID}/games/{gameID}/versions/{gameVersionID}/assets)
+	GetEditionGameVersionAssets(ctx echo.Context, editionID EditionIDInPath, gameID GameIDInPath, gameVersionID GameVersionIDInPath) error
 	// プロダクトキーの一覧の取得
 	// (GET /editions/{editionID}/keys)
 	GetProductKeys(ctx echo.Context, editionID EditionIDInPath, params GetProductKeysParams) error
@@ -1846,6 +3168,9 @@ type ServerInterface interface {
 	// エディションのプレイ統計取得
 	// (GET /editions/{editionID}/play-stats)
 	GetEditionPlayStats(ctx echo.Context, editionID EditionIDInPath, params GetEditionPlayStatsParams) error
+	// ゲームプレイログのまとめての記録
+	// (POST /editions/{editionID}/plays)
+	PostGamePlayLogs(ctx echo.Context, editionID EditionIDInPath) error
 	// ゲーム一覧の取得
 	// (GET /games)
 	GetGames(ctx echo.Context, params GetGamesParams) error
@@ -1870,15 +3195,24 @@ type ServerInterface interface {
 	// ゲームクリエイターのカスタムジョブの作成
 	// (POST /games/{gameID}/creators/custom-jobs)
 	PostGameCreatorCustomJob(ctx echo.Context, gameID GameIDInPath) error
+	// 外部のゲームクリエイターの追加
+	// (POST /games/{gameID}/creators/external)
+	PostGameExternalCreator(ctx echo.Context, gameID GameIDInPath) error
 	// ゲームクリエイターのジョブ一覧の取得
 	// (GET /games/{gameID}/creators/jobs)
 	GetGameCreatorJobs(ctx echo.Context, gameID GameIDInPath) error
+	// ゲームクリエイターの表示順の変更
+	// (PUT /games/{gameID}/creators/order)
+	PutGameCreatorsOrder(ctx echo.Context, gameID GameIDInPath) error
 	// ゲームクリエイターの削除
 	// (DELETE /games/{gameID}/creators/{creatorID})
 	DeleteGameCreator(ctx echo.Context, gameID GameIDInPath, creatorID CreatorIDInPath) error
 	// ゲームクリエイターのジョブの更新
 	// (PUT /games/{gameID}/creators/{creatorID}/jobs)
 	PutGameCreatorJobs(ctx echo.Context, gameID GameIDInPath, creatorID CreatorIDInPath) error
+	// ゲームのクレジットの取得
+	// (GET /games/{gameID}/credits)
+	GetGameCredits(ctx echo.Context, gameID GameIDInPath) error
 	// フィードバック設定の取得
 	// (GET /games/{gameID}/feedback-config)
 	GetFeedbackConfig(ctx echo.Context, gameID GameIDInPath) error
@@ -1909,6 +3243,12 @@ type ServerInterface interface {
 	// ゲームファイルのメタ情報の取得
 	// (GET /games/{gameID}/files/{gameFileID}/meta)
 	GetGameFileMeta(ctx echo.Context, gameID GameIDInPath, gameFileID GameFileIDInPath) error
+	// ゲームファイルの再検査
+	// (POST /games/{gameID}/files/{gameFileID}/rescan)
+	PostGameFileRescan(ctx echo.Context, gameID GameIDInPath, gameFileID GameFileIDInPath) error
+	// ゲームファイルの検査の状態の変更
+	// (PUT /games/{gameID}/files/{gameFileID}/scan-status)
+	PutGameFileScanStatus(ctx echo.Context, gameID GameIDInPath, gameFileID GameFileIDInPath) error
 	// ゲームのジャンル編集
 	// (PUT /games/{gameID}/genres)
 	PutGameGenres(ctx echo.Context, gameID GameIDInPath) error
@@ -1920,19 +3260,34 @@ type ServerInterface interface {
 	PostGameImage(ctx echo.Context, gameID GameIDInPath) error
 	// ゲーム画像のバイナリの取得
 	// (GET /games/{gameID}/images/{gameImageID})
-	GetGameImage(ctx echo.Context, gameID GameIDInPath, gameImageID GameImageIDInPath) error
+	GetGameImage(ctx echo.Context, gameID GameIDInPath, gameImageID GameImageIDInPath, params GetGameImageParams) error
 	// ゲーム画像のメタ情報の取得
 	// (GET /games/{gameID}/images/{gameImageID}/meta)
-	GetGameImageMeta(ctx echo.Context, gameID GameIDInPath, gameImageID GameImageIDInPath) error
+	GetGameImageMeta(ctx echo.Context, gameID GameIDInPath, gameImageID GameImageIDInPath, params GetGameImageMetaParams) error
+	// ゲームの管理権限への招待
+	// (POST /games/{gameID}/invitations)
+	PostGameRoleInvitation(ctx echo.Context, gameID GameIDInPath) error
 	// ゲームのプレイ統計取得
 	// (GET /games/{gameID}/play-stats)
 	GetGamePlayStats(ctx echo.Context, gameID GameIDInPath, params GetGamePlayStatsParams) error
 	// ゲームの管理権限の変更
 	// (PATCH /games/{gameID}/roles)
 	PatchGameRole(ctx echo.Context, gameID GameIDInPath) error
+	// ゲームのownerの譲渡
+	// (POST /games/{gameID}/roles/transfer)
+	PostGameOwnershipTransfer(ctx echo.Context, gameID GameIDInPath) error
 	// ゲームの管理権限の削除
 	// (DELETE /games/{gameID}/roles/{userID})
 	DeleteGameRole(ctx echo.Context, gameID GameIDInPath, userID UserIDInPath) error
+	// ゲームのストレージ使用量の取得
+	// (GET /games/{gameID}/storage)
+	GetGameStorage(ctx echo.Context, gameID GameIDInPath) error
+	// ゲームのストレージの上限の削除
+	// (DELETE /games/{gameID}/storage/quota)
+	DeleteGameStorageQuota(ctx echo.Context, gameID GameIDInPath) error
+	// ゲームのストレージの上限の設定
+	// (PUT /games/{gameID}/storage/quota)
+	PutGameStorageQuota(ctx echo.Context, gameID GameIDInPath) error
 	// ゲームバージョン一覧の取得
 	// (GET /games/{gameID}/versions)
 	GetGameVersion(ctx echo.Context, gameID GameIDInPath, params GetGameVersionParams) error
@@ -1942,6 +3297,9 @@ type ServerInterface interface {
 	// ゲームの最新バージョンの取得
 	// (GET /games/{gameID}/versions/latest)
 	GetLatestGameVersion(ctx echo.Context, gameID GameIDInPath) error
+	// ゲームバージョンのアセットの一括取得
+	// (GET /games/{gameID}/versions/{gameVersionID}/assets)
+	GetGameVersionAssets(ctx echo.Context, gameID GameIDInPath, gameVersionID GameVersionIDInPath) error
 	// ゲームバージョンのフィードバック一覧取得
 	// (GET /games/{gameID}/versions/{gameVersionID}/feedbacks)
 	GetGameVersionFeedbacks(ctx echo.Context, gameID GameIDInPath, gameVersionID GameVersionIDInPath, params GetGameVersionFeedbacksParams) error
@@ -1957,6 +3315,12 @@ type ServerInterface interface {
 	// ゲーム動画のメタ情報の取得
 	// (GET /games/{gameID}/videos/{gameVideoID}/meta)
 	GetGameVideoMeta(ctx echo.Context, gameID GameIDInPath, gameVideoID GameVideoIDInPath) error
+	// ゲーム動画のポスター画像の取得
+	// (GET /games/{gameID}/videos/{gameVideoID}/poster)
+	GetGameVideoPoster(ctx echo.Context, gameID GameIDInPath, gameVideoID GameVideoIDInPath) error
+	// ゲーム動画のポスター画像の保存
+	// (PUT /games/{gameID}/videos/{gameVideoID}/poster)
+	PutGameVideoPoster(ctx echo.Context, gameID GameIDInPath, gameVideoID GameVideoIDInPath) error
 	// 全てのジャンルの取得
 	// (GET /genres)
 	GetGameGenres(ctx echo.Context) error
@@ -1966,6 +3330,18 @@ type ServerInterface interface {
 	// ジャンル情報の変更
 	// (PATCH /genres/{gameGenreID})
 	PatchGameGenre(ctx echo.Context, gameGenreID GameGenreIDInPath) error
+	// ジャンルの別名の追加
+	// (POST /genres/{gameGenreID}/aliases)
+	PostGameGenreAlias(ctx echo.Context, gameGenreID GameGenreIDInPath) error
+	// ジャンルの別名の削除
+	// (DELETE /genres/{gameGenreID}/aliases/{gameGenreAliasID})
+	DeleteGameGenreAlias(ctx echo.Context, gameGenreID GameGenreIDInPath, gameGenreAliasID GameGenreAliasIDInPath) error
+	// ジャンルの統合
+	// (POST /genres/{gameGenreID}/merge)
+	MergeGameGenre(ctx echo.Context, gameGenreID GameGenreIDInPath) error
+	// 親ジャンルの変更
+	// (PUT /genres/{gameGenreID}/parent)
+	PutGameGenreParent(ctx echo.Context, gameGenreID GameGenreIDInPath) error
 	// traQのOAuth 2.0のコールバック
 	// (GET /oauth2/callback)
 	GetCallback(ctx echo.Context, params GetCallbackParams) error
@@ -1990,6 +3366,15 @@ type ServerInterface interface {
 	// ログイン中ユーザーの情報の取得
 	// (GET /users/me)
 	GetMe(ctx echo.Context) error
+	// ログイン中ユーザーへのゲームの管理権限への招待の一覧の取得
+	// (GET /users/me/invitations)
+	GetMyGameRoleInvitations(ctx echo.Context) error
+	// ゲームの管理権限への招待の承諾
+	// (POST /users/me/invitations/{invitationID}/accept)
+	PostAcceptGameRoleInvitation(ctx echo.Context, invitationID InvitationIDInPath) error
+	// ゲームの管理権限への招待の辞退
+	// (POST /users/me/invitations/{invitationID}/decline)
+	PostDeclineGameRoleInvitation(ctx echo.Context, invitationID InvitationIDInPath) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1997,6 +3382,37 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminStatus(ctx)
+	return err
+}
+
+// GetStorageVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStorageVerification(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStorageVerificationParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStorageVerification(ctx, params)
+	return err
+}
+
 // GetAdmins converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAdmins(ctx echo.Context) error {
 	var err error
@@ -2037,6 +3453,75 @@ func (w *ServerInterfaceWrapper) DeleteAdmin(ctx echo.Context) error {
 	return err
 }
 
+// GetAuditLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditLogs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actor", ctx.QueryParams(), &params.Actor, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "action", ctx.QueryParams(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "targetType" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "targetType", ctx.QueryParams(), &params.TargetType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetType: %s", err))
+	}
+
+	// ------------- Optional query parameter "targetID" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "targetID", ctx.QueryParams(), &params.TargetID, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "since", ctx.QueryParams(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "until", ctx.QueryParams(), &params.Until, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuditLogs(ctx, params)
+	return err
+}
+
 // GetEditions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEditions(ctx echo.Context) error {
 	var err error
@@ -2133,6 +3618,94 @@ func (w *ServerInterfaceWrapper) PatchEdition(ctx echo.Context) error {
 	return err
 }
 
+// GetEditionBundles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEditionBundles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEditionBundles(ctx, editionID)
+	return err
+}
+
+// PostEditionBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) PostEditionBundle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostEditionBundle(ctx, editionID)
+	return err
+}
+
+// GetEditionBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEditionBundle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	// ------------- Path parameter "editionBundleID" -------------
+	var editionBundleID EditionBundleIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionBundleID", ctx.Param("editionBundleID"), &editionBundleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionBundleID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEditionBundle(ctx, editionID, editionBundleID)
+	return err
+}
+
+// GetEditionBundleDownload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEditionBundleDownload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	// ------------- Path parameter "editionBundleID" -------------
+	var editionBundleID EditionBundleIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionBundleID", ctx.Param("editionBundleID"), &editionBundleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionBundleID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEditionBundleDownload(ctx, editionID, editionBundleID)
+	return err
+}
+
 // GetEditionGames converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEditionGames(ctx echo.Context) error {
 	var err error
@@ -2265,6 +3838,40 @@ func (w *ServerInterfaceWrapper) PatchGamePlayLogEnd(ctx echo.Context) error {
 	return err
 }
 
+// GetEditionGameVersionAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEditionGameVersionAssets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameVersionID" -------------
+	var gameVersionID GameVersionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameVersionID", ctx.Param("gameVersionID"), &gameVersionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameVersionID: %s", err))
+	}
+
+	ctx.Set(string(EditionGameFileAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEditionGameVersionAssets(ctx, editionID, gameID, gameVersionID)
+	return err
+}
+
 // GetProductKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetProductKeys(ctx echo.Context) error {
 	var err error
@@ -2405,6 +4012,24 @@ func (w *ServerInterfaceWrapper) GetEditionPlayStats(ctx echo.Context) error {
 	return err
 }
 
+// PostGamePlayLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGamePlayLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "editionID" -------------
+	var editionID EditionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "editionID", ctx.Param("editionID"), &editionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter editionID: %s", err))
+	}
+
+	ctx.Set(string(EditionAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGamePlayLogs(ctx, editionID)
+	return err
+}
+
 // GetGames converts echo context to params.
 func (w *ServerInterfaceWrapper) GetGames(ctx echo.Context) error {
 	var err error
@@ -2446,6 +4071,13 @@ func (w *ServerInterfaceWrapper) GetGames(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "keyword" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "keyword", ctx.QueryParams(), &params.Keyword, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
+	}
+
 	// ------------- Optional query parameter "sort" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", ctx.QueryParams(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
@@ -2453,6 +4085,13 @@ func (w *ServerInterfaceWrapper) GetGames(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
 
+	// ------------- Optional query parameter "facets" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "facets", ctx.QueryParams(), &params.Facets, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter facets: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetGames(ctx, params)
 	return err
@@ -2577,8 +4216,8 @@ func (w *ServerInterfaceWrapper) PostGameCreatorCustomJob(ctx echo.Context) erro
 	return err
 }
 
-// GetGameCreatorJobs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetGameCreatorJobs(ctx echo.Context) error {
+// PostGameExternalCreator converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGameExternalCreator(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "gameID" -------------
 	var gameID GameIDInPath
@@ -2588,15 +4227,15 @@ func (w *ServerInterfaceWrapper) GetGameCreatorJobs(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
 	}
 
-	ctx.Set(string(TrapMemberAuthScopes), []string{})
+	ctx.Set(string(GameMaintainerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGameCreatorJobs(ctx, gameID)
+	err = w.Handler.PostGameExternalCreator(ctx, gameID)
 	return err
 }
 
-// DeleteGameCreator converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteGameCreator(ctx echo.Context) error {
+// GetGameCreatorJobs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGameCreatorJobs(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "gameID" -------------
 	var gameID GameIDInPath
@@ -2606,10 +4245,46 @@ func (w *ServerInterfaceWrapper) DeleteGameCreator(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
 	}
 
-	// ------------- Path parameter "creatorID" -------------
-	var creatorID CreatorIDInPath
+	ctx.Set(string(TrapMemberAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "creatorID", ctx.Param("creatorID"), &creatorID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameCreatorJobs(ctx, gameID)
+	return err
+}
+
+// PutGameCreatorsOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) PutGameCreatorsOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(GameMaintainerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutGameCreatorsOrder(ctx, gameID)
+	return err
+}
+
+// DeleteGameCreator converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGameCreator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "creatorID" -------------
+	var creatorID CreatorIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "creatorID", ctx.Param("creatorID"), &creatorID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creatorID: %s", err))
 	}
@@ -2647,6 +4322,24 @@ func (w *ServerInterfaceWrapper) PutGameCreatorJobs(ctx echo.Context) error {
 	return err
 }
 
+// GetGameCredits converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGameCredits(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(GameCreatorsVisibilityAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameCredits(ctx, gameID)
+	return err
+}
+
 // GetFeedbackConfig converts echo context to params.
 func (w *ServerInterfaceWrapper) GetFeedbackConfig(ctx echo.Context) error {
 	var err error
@@ -2867,6 +4560,58 @@ func (w *ServerInterfaceWrapper) GetGameFileMeta(ctx echo.Context) error {
 	return err
 }
 
+// PostGameFileRescan converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGameFileRescan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameFileID" -------------
+	var gameFileID GameFileIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameFileID", ctx.Param("gameFileID"), &gameFileID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameFileID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGameFileRescan(ctx, gameID, gameFileID)
+	return err
+}
+
+// PutGameFileScanStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PutGameFileScanStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameFileID" -------------
+	var gameFileID GameFileIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameFileID", ctx.Param("gameFileID"), &gameFileID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameFileID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutGameFileScanStatus(ctx, gameID, gameFileID)
+	return err
+}
+
 // PutGameGenres converts echo context to params.
 func (w *ServerInterfaceWrapper) PutGameGenres(ctx echo.Context) error {
 	var err error
@@ -2944,8 +4689,24 @@ func (w *ServerInterfaceWrapper) GetGameImage(ctx echo.Context) error {
 
 	ctx.Set(string(EditionGameImageAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGameImageParams
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", ctx.QueryParams(), &params.Size, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGameImage(ctx, gameID, gameImageID)
+	err = w.Handler.GetGameImage(ctx, gameID, gameImageID, params)
 	return err
 }
 
@@ -2972,8 +4733,42 @@ func (w *ServerInterfaceWrapper) GetGameImageMeta(ctx echo.Context) error {
 
 	ctx.Set(string(EditionGameImageAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGameImageMetaParams
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", ctx.QueryParams(), &params.Size, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", ctx.QueryParams(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameImageMeta(ctx, gameID, gameImageID, params)
+	return err
+}
+
+// PostGameRoleInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGameRoleInvitation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(GameOwnerAuthScopes), []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetGameImageMeta(ctx, gameID, gameImageID)
+	err = w.Handler.PostGameRoleInvitation(ctx, gameID)
 	return err
 }
 
@@ -3036,6 +4831,24 @@ func (w *ServerInterfaceWrapper) PatchGameRole(ctx echo.Context) error {
 	return err
 }
 
+// PostGameOwnershipTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGameOwnershipTransfer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(GameOwnerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGameOwnershipTransfer(ctx, gameID)
+	return err
+}
+
 // DeleteGameRole converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteGameRole(ctx echo.Context) error {
 	var err error
@@ -3062,6 +4875,60 @@ func (w *ServerInterfaceWrapper) DeleteGameRole(ctx echo.Context) error {
 	return err
 }
 
+// GetGameStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGameStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(GameMaintainerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameStorage(ctx, gameID)
+	return err
+}
+
+// DeleteGameStorageQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGameStorageQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteGameStorageQuota(ctx, gameID)
+	return err
+}
+
+// PutGameStorageQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) PutGameStorageQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutGameStorageQuota(ctx, gameID)
+	return err
+}
+
 // GetGameVersion converts echo context to params.
 func (w *ServerInterfaceWrapper) GetGameVersion(ctx echo.Context) error {
 	var err error
@@ -3130,6 +4997,32 @@ func (w *ServerInterfaceWrapper) GetLatestGameVersion(ctx echo.Context) error {
 	return err
 }
 
+// GetGameVersionAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGameVersionAssets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameVersionID" -------------
+	var gameVersionID GameVersionIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameVersionID", ctx.Param("gameVersionID"), &gameVersionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameVersionID: %s", err))
+	}
+
+	ctx.Set(string(GameFileVisibilityAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameVersionAssets(ctx, gameID, gameVersionID)
+	return err
+}
+
 // GetGameVersionFeedbacks converts echo context to params.
 func (w *ServerInterfaceWrapper) GetGameVersionFeedbacks(ctx echo.Context) error {
 	var err error
@@ -3264,6 +5157,60 @@ func (w *ServerInterfaceWrapper) GetGameVideoMeta(ctx echo.Context) error {
 	return err
 }
 
+// GetGameVideoPoster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGameVideoPoster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameVideoID" -------------
+	var gameVideoID GameVideoIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameVideoID", ctx.Param("gameVideoID"), &gameVideoID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameVideoID: %s", err))
+	}
+
+	ctx.Set(string(GameVideoVisibilityAuthScopes), []string{})
+
+	ctx.Set(string(EditionGameVideoAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGameVideoPoster(ctx, gameID, gameVideoID)
+	return err
+}
+
+// PutGameVideoPoster converts echo context to params.
+func (w *ServerInterfaceWrapper) PutGameVideoPoster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameID" -------------
+	var gameID GameIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", ctx.Param("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameID: %s", err))
+	}
+
+	// ------------- Path parameter "gameVideoID" -------------
+	var gameVideoID GameVideoIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameVideoID", ctx.Param("gameVideoID"), &gameVideoID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameVideoID: %s", err))
+	}
+
+	ctx.Set(string(GameMaintainerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutGameVideoPoster(ctx, gameID, gameVideoID)
+	return err
+}
+
 // GetGameGenres converts echo context to params.
 func (w *ServerInterfaceWrapper) GetGameGenres(ctx echo.Context) error {
 	var err error
@@ -3309,6 +5256,86 @@ func (w *ServerInterfaceWrapper) PatchGameGenre(ctx echo.Context) error {
 	return err
 }
 
+// PostGameGenreAlias converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGameGenreAlias(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameGenreID" -------------
+	var gameGenreID GameGenreIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameGenreID", ctx.Param("gameGenreID"), &gameGenreID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameGenreID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGameGenreAlias(ctx, gameGenreID)
+	return err
+}
+
+// DeleteGameGenreAlias converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteGameGenreAlias(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameGenreID" -------------
+	var gameGenreID GameGenreIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameGenreID", ctx.Param("gameGenreID"), &gameGenreID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameGenreID: %s", err))
+	}
+
+	// ------------- Path parameter "gameGenreAliasID" -------------
+	var gameGenreAliasID GameGenreAliasIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameGenreAliasID", ctx.Param("gameGenreAliasID"), &gameGenreAliasID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameGenreAliasID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteGameGenreAlias(ctx, gameGenreID, gameGenreAliasID)
+	return err
+}
+
+// MergeGameGenre converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeGameGenre(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameGenreID" -------------
+	var gameGenreID GameGenreIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameGenreID", ctx.Param("gameGenreID"), &gameGenreID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameGenreID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MergeGameGenre(ctx, gameGenreID)
+	return err
+}
+
+// PutGameGenreParent converts echo context to params.
+func (w *ServerInterfaceWrapper) PutGameGenreParent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "gameGenreID" -------------
+	var gameGenreID GameGenreIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameGenreID", ctx.Param("gameGenreID"), &gameGenreID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter gameGenreID: %s", err))
+	}
+
+	ctx.Set(string(AdminAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutGameGenreParent(ctx, gameGenreID)
+	return err
+}
+
 // GetCallback converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCallback(ctx echo.Context) error {
 	var err error
@@ -3429,6 +5456,53 @@ func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
 	return err
 }
 
+// GetMyGameRoleInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMyGameRoleInvitations(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(TrapMemberAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMyGameRoleInvitations(ctx)
+	return err
+}
+
+// PostAcceptGameRoleInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAcceptGameRoleInvitation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "invitationID" -------------
+	var invitationID InvitationIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationID", ctx.Param("invitationID"), &invitationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter invitationID: %s", err))
+	}
+
+	ctx.Set(string(TrapMemberAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAcceptGameRoleInvitation(ctx, invitationID)
+	return err
+}
+
+// PostDeclineGameRoleInvitation converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDeclineGameRoleInvitation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "invitationID" -------------
+	var invitationID InvitationIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationID", ctx.Param("invitationID"), &invitationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter invitationID: %s", err))
+	}
+
+	ctx.Set(string(TrapMemberAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDeclineGameRoleInvitation(ctx, invitationID)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -3476,9 +5550,12 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 		Handler: si,
 	}
 
+	router.GET(options.BaseURL+"/admin/status", wrapper.GetAdminStatus, options.OperationMiddlewares["getAdminStatus"]...)
+	router.GET(options.BaseURL+"/admin/storage-verification", wrapper.GetStorageVerification, options.OperationMiddlewares["getStorageVerification"]...)
 	router.GET(options.BaseURL+"/admins", wrapper.GetAdmins, options.OperationMiddlewares["getAdmins"]...)
 	router.POST(options.BaseURL+"/admins", wrapper.PostAdmin, options.OperationMiddlewares["postAdmin"]...)
 	router.DELETE(options.BaseURL+"/admins/:userID", wrapper.DeleteAdmin, options.OperationMiddlewares["deleteAdmin"]...)
+	router.GET(options.BaseURL+"/audit-logs", wrapper.GetAuditLogs, options.OperationMiddlewares["getAuditLogs"]...)
 	router.GET(options.BaseURL+"/editions", wrapper.GetEditions, options.OperationMiddlewares["getEditions"]...)
 	router.POST(options.BaseURL+"/editions", wrapper.PostEdition, options.OperationMiddlewares["postEdition"]...)
 	router.POST(options.BaseURL+"/editions/authorize", wrapper.PostEditionAuthorize, options.OperationMiddlewares["postEditionAuthorize"]...)
@@ -3486,16 +5563,22 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/editions/:editionID", wrapper.DeleteEdition, options.OperationMiddlewares["deleteEdition"]...)
 	router.GET(options.BaseURL+"/editions/:editionID", wrapper.GetEdition, options.OperationMiddlewares["getEdition"]...)
 	router.PATCH(options.BaseURL+"/editions/:editionID", wrapper.PatchEdition, options.OperationMiddlewares["patchEdition"]...)
+	router.GET(options.BaseURL+"/editions/:editionID/bundles", wrapper.GetEditionBundles, options.OperationMiddlewares["getEditionBundles"]...)
+	router.POST(options.BaseURL+"/editions/:editionID/bundles", wrapper.PostEditionBundle, options.OperationMiddlewares["postEditionBundle"]...)
+	router.GET(options.BaseURL+"/editions/:editionID/bundles/:editionBundleID", wrapper.GetEditionBundle, options.OperationMiddlewares["getEditionBundle"]...)
+	router.GET(options.BaseURL+"/editions/:editionID/bundles/:editionBundleID/download", wrapper.GetEditionBundleDownload, options.OperationMiddlewares["getEditionBundleDownload"]...)
 	router.GET(options.BaseURL+"/editions/:editionID/games", wrapper.GetEditionGames, options.OperationMiddlewares["getEditionGames"]...)
 	router.PATCH(options.BaseURL+"/editions/:editionID/games", wrapper.PatchEditionGame, options.OperationMiddlewares["patchEditionGame"]...)
 	router.POST(options.BaseURL+"/editions/:editionID/games/:gameID/plays/start", wrapper.PostGamePlayLogStart, options.OperationMiddlewares["postGamePlayLogStart"]...)
 	router.DELETE(options.BaseURL+"/editions/:editionID/games/:gameID/plays/:playLogID", wrapper.DeleteGamePlayLog, options.OperationMiddlewares["deleteGamePlayLog"]...)
 	router.PATCH(options.BaseURL+"/editions/:editionID/games/:gameID/plays/:playLogID/end", wrapper.PatchGamePlayLogEnd, options.OperationMiddlewares["patchGamePlayLogEnd"]...)
+	router.GET(options.BaseURL+"/editions/:editionID/games/:gameID/versions/:gameVersionID/assets", wrapper.GetEditionGameVersionAssets, options.OperationMiddlewares["getEditionGameVersionAssets"]...)
 	router.GET(options.BaseURL+"/editions/:editionID/keys", wrapper.GetProductKeys, options.OperationMiddlewares["getProductKeys"]...)
 	router.POST(options.BaseURL+"/editions/:editionID/keys", wrapper.PostProductKey, options.OperationMiddlewares["postProductKey"]...)
 	router.POST(options.BaseURL+"/editions/:editionID/keys/:productKeyID/activate", wrapper.PostActivateProductKey, options.OperationMiddlewares["postActivateProductKey"]...)
 	router.POST(options.BaseURL+"/editions/:editionID/keys/:productKeyID/revoke", wrapper.PostRevokeProductKey, options.OperationMiddlewares["postRevokeProductKey"]...)
 	router.GET(options.BaseURL+"/editions/:editionID/play-stats", wrapper.GetEditionPlayStats, options.OperationMiddlewares["getEditionPlayStats"]...)
+	router.POST(options.BaseURL+"/editions/:editionID/plays", wrapper.PostGamePlayLogs, options.OperationMiddlewares["postGamePlayLogs"]...)
 	router.GET(options.BaseURL+"/games", wrapper.GetGames, options.OperationMiddlewares["getGames"]...)
 	router.POST(options.BaseURL+"/games", wrapper.PostGame, options.OperationMiddlewares["postGame"]...)
 	router.DELETE(options.BaseURL+"/games/:gameID", wrapper.DeleteGame, options.OperationMiddlewares["deleteGame"]...)
@@ -3504,9 +5587,12 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/games/:gameID/creators", wrapper.GetGameCreators, options.OperationMiddlewares["getGameCreators"]...)
 	router.POST(options.BaseURL+"/games/:gameID/creators", wrapper.PostGameCreator, options.OperationMiddlewares["postGameCreator"]...)
 	router.POST(options.BaseURL+"/games/:gameID/creators/custom-jobs", wrapper.PostGameCreatorCustomJob, options.OperationMiddlewares["postGameCreatorCustomJob"]...)
+	router.POST(options.BaseURL+"/games/:gameID/creators/external", wrapper.PostGameExternalCreator, options.OperationMiddlewares["postGameExternalCreator"]...)
 	router.GET(options.BaseURL+"/games/:gameID/creators/jobs", wrapper.GetGameCreatorJobs, options.OperationMiddlewares["getGameCreatorJobs"]...)
+	router.PUT(options.BaseURL+"/games/:gameID/creators/order", wrapper.PutGameCreatorsOrder, options.OperationMiddlewares["putGameCreatorsOrder"]...)
 	router.DELETE(options.BaseURL+"/games/:gameID/creators/:creatorID", wrapper.DeleteGameCreator, options.OperationMiddlewares["deleteGameCreator"]...)
 	router.PUT(options.BaseURL+"/games/:gameID/creators/:creatorID/jobs", wrapper.PutGameCreatorJobs, options.OperationMiddlewares["putGameCreatorJobs"]...)
+	router.GET(options.BaseURL+"/games/:gameID/credits", wrapper.GetGameCredits, options.OperationMiddlewares["getGameCredits"]...)
 	router.GET(options.BaseURL+"/games/:gameID/feedback-config", wrapper.GetFeedbackConfig, options.OperationMiddlewares["getFeedbackConfig"]...)
 	router.PATCH(options.BaseURL+"/games/:gameID/feedback-config", wrapper.PatchFeedbackConfig, options.OperationMiddlewares["patchFeedbackConfig"]...)
 	router.GET(options.BaseURL+"/games/:gameID/feedback-questions", wrapper.GetFeedbackQuestions, options.OperationMiddlewares["getFeedbackQuestions"]...)
@@ -3517,25 +5603,39 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/games/:gameID/files", wrapper.PostGameFile, options.OperationMiddlewares["postGameFile"]...)
 	router.GET(options.BaseURL+"/games/:gameID/files/:gameFileID", wrapper.GetGameFile, options.OperationMiddlewares["getGameFile"]...)
 	router.GET(options.BaseURL+"/games/:gameID/files/:gameFileID/meta", wrapper.GetGameFileMeta, options.OperationMiddlewares["getGameFileMeta"]...)
+	router.POST(options.BaseURL+"/games/:gameID/files/:gameFileID/rescan", wrapper.PostGameFileRescan, options.OperationMiddlewares["postGameFileRescan"]...)
+	router.PUT(options.BaseURL+"/games/:gameID/files/:gameFileID/scan-status", wrapper.PutGameFileScanStatus, options.OperationMiddlewares["putGameFileScanStatus"]...)
 	router.PUT(options.BaseURL+"/games/:gameID/genres", wrapper.PutGameGenres, options.OperationMiddlewares["putGameGenres"]...)
 	router.GET(options.BaseURL+"/games/:gameID/images", wrapper.GetGameImages, options.OperationMiddlewares["getGameImages"]...)
 	router.POST(options.BaseURL+"/games/:gameID/images", wrapper.PostGameImage, options.OperationMiddlewares["postGameImage"]...)
 	router.GET(options.BaseURL+"/games/:gameID/images/:gameImageID", wrapper.GetGameImage, options.OperationMiddlewares["getGameImage"]...)
 	router.GET(options.BaseURL+"/games/:gameID/images/:gameImageID/meta", wrapper.GetGameImageMeta, options.OperationMiddlewares["getGameImageMeta"]...)
+	router.POST(options.BaseURL+"/games/:gameID/invitations", wrapper.PostGameRoleInvitation, options.OperationMiddlewares["postGameRoleInvitation"]...)
 	router.GET(options.BaseURL+"/games/:gameID/play-stats", wrapper.GetGamePlayStats, options.OperationMiddlewares["getGamePlayStats"]...)
 	router.PATCH(options.BaseURL+"/games/:gameID/roles", wrapper.PatchGameRole, options.OperationMiddlewares["patchGameRole"]...)
+	router.POST(options.BaseURL+"/games/:gameID/roles/transfer", wrapper.PostGameOwnershipTransfer, options.OperationMiddlewares["postGameOwnershipTransfer"]...)
 	router.DELETE(options.BaseURL+"/games/:gameID/roles/:userID", wrapper.DeleteGameRole, options.OperationMiddlewares["deleteGameRole"]...)
+	router.GET(options.BaseURL+"/games/:gameID/storage", wrapper.GetGameStorage, options.OperationMiddlewares["getGameStorage"]...)
+	router.DELETE(options.BaseURL+"/games/:gameID/storage/quota", wrapper.DeleteGameStorageQuota, options.OperationMiddlewares["deleteGameStorageQuota"]...)
+	router.PUT(options.BaseURL+"/games/:gameID/storage/quota", wrapper.PutGameStorageQuota, options.OperationMiddlewares["putGameStorageQuota"]...)
 	router.GET(options.BaseURL+"/games/:gameID/versions", wrapper.GetGameVersion, options.OperationMiddlewares["getGameVersion"]...)
 	router.POST(options.BaseURL+"/games/:gameID/versions", wrapper.PostGameVersion, options.OperationMiddlewares["postGameVersion"]...)
 	router.GET(options.BaseURL+"/games/:gameID/versions/latest", wrapper.GetLatestGameVersion, options.OperationMiddlewares["getLatestGameVersion"]...)
+	router.GET(options.BaseURL+"/games/:gameID/versions/:gameVersionID/assets", wrapper.GetGameVersionAssets, options.OperationMiddlewares["getGameVersionAssets"]...)
 	router.GET(options.BaseURL+"/games/:gameID/versions/:gameVersionID/feedbacks", wrapper.GetGameVersionFeedbacks, options.OperationMiddlewares["getGameVersionFeedbacks"]...)
 	router.GET(options.BaseURL+"/games/:gameID/videos", wrapper.GetGameVideos, options.OperationMiddlewares["getGameVideos"]...)
 	router.POST(options.BaseURL+"/games/:gameID/videos", wrapper.PostGameVideo, options.OperationMiddlewares["postGameVideo"]...)
 	router.GET(options.BaseURL+"/games/:gameID/videos/:gameVideoID", wrapper.GetGameVideo, options.OperationMiddlewares["getGameVideo"]...)
 	router.GET(options.BaseURL+"/games/:gameID/videos/:gameVideoID/meta", wrapper.GetGameVideoMeta, options.OperationMiddlewares["getGameVideoMeta"]...)
+	router.GET(options.BaseURL+"/games/:gameID/videos/:gameVideoID/poster", wrapper.GetGameVideoPoster, options.OperationMiddlewares["getGameVideoPoster"]...)
+	router.PUT(options.BaseURL+"/games/:gameID/videos/:gameVideoID/poster", wrapper.PutGameVideoPoster, options.OperationMiddlewares["putGameVideoPoster"]...)
 	router.GET(options.BaseURL+"/genres", wrapper.GetGameGenres, options.OperationMiddlewares["getGameGenres"]...)
 	router.DELETE(options.BaseURL+"/genres/:gameGenreID", wrapper.DeleteGameGenre, options.OperationMiddlewares["deleteGameGenre"]...)
 	router.PATCH(options.BaseURL+"/genres/:gameGenreID", wrapper.PatchGameGenre, options.OperationMiddlewares["patchGameGenre"]...)
+	router.POST(options.BaseURL+"/genres/:gameGenreID/aliases", wrapper.PostGameGenreAlias, options.OperationMiddlewares["postGameGenreAlias"]...)
+	router.DELETE(options.BaseURL+"/genres/:gameGenreID/aliases/:gameGenreAliasID", wrapper.DeleteGameGenreAlias, options.OperationMiddlewares["deleteGameGenreAlias"]...)
+	router.POST(options.BaseURL+"/genres/:gameGenreID/merge", wrapper.MergeGameGenre, options.OperationMiddlewares["mergeGameGenre"]...)
+	router.PUT(options.BaseURL+"/genres/:gameGenreID/parent", wrapper.PutGameGenreParent, options.OperationMiddlewares["putGameGenreParent"]...)
 	router.GET(options.BaseURL+"/oauth2/callback", wrapper.GetCallback, options.OperationMiddlewares["getCallback"]...)
 	router.GET(options.BaseURL+"/oauth2/code", wrapper.GetCode, options.OperationMiddlewares["getCode"]...)
 	router.POST(options.BaseURL+"/oauth2/logout", wrapper.PostLogout, options.OperationMiddlewares["postLogout"]...)
@@ -3544,6 +5644,9 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	router.PATCH(options.BaseURL+"/seats/:seatID", wrapper.PatchSeatStatus, options.OperationMiddlewares["patchSeatStatus"]...)
 	router.GET(options.BaseURL+"/users", wrapper.GetUsers, options.OperationMiddlewares["getUsers"]...)
 	router.GET(options.BaseURL+"/users/me", wrapper.GetMe, options.OperationMiddlewares["getMe"]...)
+	router.GET(options.BaseURL+"/users/me/invitations", wrapper.GetMyGameRoleInvitations, options.OperationMiddlewares["getMyGameRoleInvitations"]...)
+	router.POST(options.BaseURL+"/users/me/invitations/:invitationID/accept", wrapper.PostAcceptGameRoleInvitation, options.OperationMiddlewares["postAcceptGameRoleInvitation"]...)
+	router.POST(options.BaseURL+"/users/me/invitations/:invitationID/decline", wrapper.PostDeclineGameRoleInvitation, options.OperationMiddlewares["postDeclineGameRoleInvitation"]...)
 
 }
 
@@ -3552,272 +5655,444 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7H1rVxRXuvBfYfWcD8k5IA1qzsisrFmOmhxncjExZs55o+9MQRfYpm/TXW3ieHhXVzUqSBMICnhBkQSl",
-	"hdCtMSYoKD+murqbT/6Fd+1b1a6qXVW7+kbj9JdEoPbtue1nP9fLgaF4NBGPiTEpFRi4HEgISSEqSmIS",
-	"/iSkpfPxZPifghSOx47FQ+LJ2GdpMXkJ/C0kpoaS4QT4S2Ag8OnRtHS+q/9AUJULR+lRXWCYKq+q8m01",
-	"o5yNBboDYTDgH3Ce7kBMiIqBgcBQPCQGugNJ8R/pcFIMBQakZFrsDqSGzotRASwnXUqA71JSMhwbCYyO",
-	"dgeGkqIgxZMnj5+MnRKk8/Y9qcrPanZbzT5QlaKaXVOVvKqsqMqOmt0+eVxVZisrL8Gust+rygvw3+xj",
-	"NbsMRig7jA0nwBrGfsnirpv+t6Q4HBgI/K7XAHIv+muq90MhKh7TZwEHEkNhsHO3A+XV7DVV+VFVflOz",
-	"q2r2mSoX6j6KvqzrUYbjyaggBQYC6XQ4FOhm4GNEiIofhCMiD0LkgpqdU5VlgJDseiNOYaxeF0bwFOQ8",
-	"H4qxpOuBNtXsjwAP2XV9/yePv3PmzMnj7+pbdt4wnr4BgOcDekOgXCeEKeiejAojnDuv3NzSstMNOwJa",
-	"uL5z4DnIYb4UkykP7iXHyc7AvW42jodNG2gAOVGHcRD4nKdRilBorTkfqJy7phXuqPKCKi9pD37RZsZV",
-	"uViZeAF+aZu68vxpNT8OhCCaRpnVpue11wtgeEamplpT5TF9Nu1K3t9U8muP68oKb9/wDYfEOB/la5Nz",
-	"lZtbDSMStHBdlE/mAIdJiMlwPHQiFnIkFAucCZALledK6eXV8sLD8m3FD730dDHR/Gb7TmX6tbaYL99W",
-	"tPEtVc7BJedU5TEQztlxY0n8wToYrlxHyLbMu6RPSn45pyo5VV4ig1+r8qonBTmSjxgLsYkmJEhijxSO",
-	"ikzKQcA+LQlJyTe4d+cntdXJ5oF7UlUm+g+Vbyu78ze0iSkm/PEeGgF/sFzt8E8BENaEgYhw6aP4CJeQ",
-	"X1CzP0H9ZkNVnjSCf/XF6xTwiWQ8lB6S/iJecjkH2P6Gms1A3XlcVTbAJhtxCGrxhp3jk3TUmSFuLpXH",
-	"ZyCxTzqdqjz3xA9POFBVLB11PVFU+DYcTUcDA33BYHcgGo7hn/SzhWOSOCImLYc7LQlSOuV8ETucCeLm",
-	"KmGNF7VcyTnGPSo/YswN1HnmLnLVnZuEd73u1BQ8Z4D3JjplARCEWkoUJGei1jY3GkHCaJGar9DTaDjY",
-	"bjolur1fs4/gjn5txIMVLVXzps+g4aNg10kxlYjHUiI0ERwNRcOxD+LJwXAoJMbAb4biMUmMSdB+kEhE",
-	"wkPQDtB7IRWHf+Zb70QyGU+i5cxAEcB68LQ0aa4z6Wy0O3ACPW5buME/iUJSTFbXpqp5xIY/QI7bgjgb",
-	"h9gqQg00V11bUeUfIUeNAeGUkc/GVEWB19e0KhfLCz+o8np5cUK7/qK8uLR7e0aVc9r4NXhKPMgTAPCp",
-	"EhuOtxACJu11ZgpoAxm5uvZT+dZ3akbGL7mMTBTbNVV+DLQBGlAAv1OcKD4Zk8RkTIicFpMXxSTaVdPP",
-	"WHo1pyoTQA+RC6WX4+XFJV1BgtL1MRJ/ldsvKzeXzI8b5kFU+QaUoj9BMrkHCASAgZafZIKMrCrPIYBn",
-	"sKTPzgANQxmD2sYzoHRlHwN1686iVgBPHW26WM2+KmdWVTm3u34L7JESF6PdgS+SwqkzMWLtA7Kh2fCT",
-	"ksJnqlygzIarqlwgXJMjZ4ZUjqBq5Q7yLQCtKucQswDyyWYp85hPfhkl8hDJtljqGzH5BbycbXfJ3fuV",
-	"jZvaqx+07ek32+OXxNQn8YGu/xFTvZ/E0d/UjDwcviieHhIi4kDX4XLh+e6d76qP50qvl99sTwS6AyJQ",
-	"GAa+CsCxge6A/nXgnE3d0SUZxEcI/VuInErGE2JSCgNZPCxEUmI3h8lQR/0/0mIKfBcTwkkR3O+/PdRW",
-	"VisPNwhTQukFSPEppLdxAM+dK9VHsiqv7d65Cz6Qd7SNW9pi3vZsTlBbu4zspWLoqORJMehox/TvR7sD",
-	"4RDnKHBDkRuPa8An4NPR7oAJEpxjP6PHnPn8owC6G8nt+lUAqq1wM93U+Q3cxgcviEMShdujQ0NiKvVF",
-	"/GvRG81m8ArmkRy7p9b6UoikIRTEbxPhpJjiRhI1xwl9qBUK9NboJfjgcILekpW0nW7WgvnOpDUjnnce",
-	"ax8IRj72YCjTdxa06d8qd8aAeAL3wjOgJYPLca06+bQ890TbWDj4Xnn+mraxYN6r+K0QTUTAzvr6Dx46",
-	"/N5//v5IUBgcConDrJ8D3eCB8ZEYGwG65MH34AuD/jEhSOCiDAwEvgr2HBF6/nm05/+cu3zwvVE3CJAr",
-	"4XMRsohf6YPPK0MrOVKHrPKonL2iPXiKXu7VtSltugivtDX8TkFwNcHFTPpfi5f4nwqY1C0kCqZwIcdj",
-	"tOzyFq+50qtF+OK0mCxqJkOgwn2OdW6IgEjk0+HAwFccNurYcDww2u1LlFxEZk0uQyD+1ApPMoUdpud4",
-	"7qf1yi8zqvxQlb8HKhaEIbj6daWSYcxFRGSGsRM6Tx7n96yxkca2SOgLfILvH68ltJkp8wI0//Y7z38q",
-	"IsA3b6oBukBBt1NZrWmU98pMICINRu5LWTTDxsfdPCJERf24HNSjzawDTUTJgTeVQTU3AeHYzf60k04S",
-	"oykeutcRcDJGtLJRHV1CMilcAj+fj6eTkUsOO8eG0vGHLluyGVBXVbnYh0Zaz6PM6sbX8atQtzXUMd6j",
-	"/RfcsEFdjDNJcUmIgC+OxdMxhjxEliNw8c7f0K5eAfv7bVonMe3u/fLcExrkVsMXtcJpcSgeC6X8roGA",
-	"8GZ7vLI6+2Z7wm0xi9Si/eA0tdpOzdgkTaVmzAMZGJbgLW5jX2cZZdMt+SSWTV0vnPn8IychlgwzZRh5",
-	"P/u4MqJiKiWMQL42dBbyLO9C7/IuNDHLjksjgUzFuo4/EMXQoDD0NXqWQZCEAUii4ZggoU1HhUQCTDtw",
-	"mXpNOZC7eboP9M+78YOMa9j/wE9HdYhcQgIuIBhPx9HuQDwmctzY7Jn9jDEOMXrOBjDjjz7fFga4WS/g",
-	"zMqb7fE+NbN4WJULjFeubvU+7G7z7qZhBigJv47dX8Xk4eZ9GxFgfGaMoMZ/IX7LEGfVZ2va3HR5/pon",
-	"3VL7sExqOhf5gYO+T8YSaanBRA7nrJHS4djmkbtpet8DXQnf8kWH+jH1u5FwHTSLkNh4KAcHuj6Jqxm5",
-	"D5rZLODto8Ab5Acvov/9ANoOVNtVXB+Lx4bDI74tI3NAdwNq2gR8zmZVpVh+vFTNvlLlQjW/oRXu2F9e",
-	"MWEwgqzzPmbLIWsY9FU8VuWrqjxpwGcwHo+Igv0JT5ZyO/hxURLCkZpoEv6T61FiUfoYb5KheDQqsh4j",
-	"1WtrlZtPq/lb1Z0nqvIM+kqfqdnxQHcglo5EwAGJE9RGqCZ7NZ+1xttKDQNM8XkYUgIaFQh8vEzGVv6o",
-	"CQ2Ecd02fdR0tXsf0p1xP02GWBKpupyvrLzcfXBVeznNfBY2ivEhjN0Y3rxRHsgz7UkshkS7hCLH05Rk",
-	"W4Rog/sAx944ogxd/Yff4xXWdnTxoCdlMp3WKaHRIUqbmeqjVZt4Jvv0L9x0JraJNwdQpJhH/xAb13zZ",
-	"BA1fPcuIWqMXT09uIC4806reY49Tn492B0bEWFJMecTf68EGuv3M9NdcZWyZDmYiHncdy+sA0eD3ColK",
-	"vKsqN3yaBmFEP7FcWm8qvhsCMVNUCMckIRzDd6Uz1owPVbmAKJNCIf3XnO5MNyIJHIBg9uliOKDQIh5I",
-	"nEmJSScg8HhnARjI+Pg33jCA37CO34gNXwynwoPhSFi6xBccrX/t5g+mz2JaQj+wlwJgZjE38OSkpHCq",
-	"61g8EhGHwF9h9MMr7fqDBrioqCwmmD5mEhd89E4lQXUHLsQH+cUnNfrP8cFaiY3GPY6S4w2GY+BXj7PD",
-	"iIYHcsVfPMn2SDkmr5EYxuqyPerCU7GwwMznuhfig/iWYC9vxn8onEpEhEufcHK8sa3j1EBuuWkMR6QU",
-	"Th1Lp6R4lH1MKrZSlXNa4U7l9WMcTKSsw3jZHTX74EJ8kH43OZza4y0FEUHDwrw3D+KwgMNkY8dRt8oT",
-	"6Gi/r2a3zcrVe4c8KcA/7UGY1EmBx83qgLNkx2GDhmSyhRSsqoqCng+sQGMDVtqVCVWZLd+YKr1ahJt+",
-	"tJv5WVUyakY+eBzH3gGKeEEtr68KBsvF6q9Xdtdv7WaW8F/kHLxC7wHVY/yqNv6r9noZB5rLBVXO7d67",
-	"r21uqvL67t0fSdzamhHySUX/gdcpWnv2oPZ9Hk2CPX3KbGX9V0B/yg4M8HsAg052kEKqZn/AKqq8DpSX",
-	"jKwqaxBAj0k0IISXsgH/+wSAYGajuj3B8Dn3BYNBB3wRRdXnC2TPXtHeV6dPIwZf8psuG7WZdUBazCfE",
-	"42eVX54E/nXsIuZcOf6wEizFG0IP1nw9XiuLZW63dB8Gqvme+vQaNT9WyT60K/nSK4tOb2wI6cdvtseZ",
-	"dFvauqXKU8gEbCbOYbI9X6qZhcWcwgk4rSfIz1+ee+LtyTe2S5ZwxG04Us+L2ZLEDjhJ2WnwMxps0fSU",
-	"FmNS8tKpeDjGPfyEMYKfo3AifHcgGjrMO+Dj0GEDzXxDkNGJxbMSMsiB5U2H5uJZADQjgN0lP6GwVF3O",
-	"mfCojNEphf8MJ7BamJ2BH1wHGhH7pTQYjgkwo4nN4yZEeogSnagaF03IoAbeTRSqqz9q16a01zk2yADp",
-	"f0/yNhi616lTpw6I37ruylu6mopF+AvMo+mTe5Vo6LCanSbpBA+1zIrT8Q4ODg0NDwYP/+cRYfBw6Pd9",
-	"/b8/MnTo8BFB+P3QEaFvMBigg2//L4q+HT53+WD/6L+57ZadeOC0XaIe0kHEF4SkKhf/LFwUVHm1+vw3",
-	"bXJOlRf+Go6F4t+k1Iz86en/hlaT5fI8wB3GLEq+USZhtkUBJl58g4fIRTy4cjPPJgXwdVQYUuXip6f/",
-	"2/ErMyCx2/CCkAx0B74Jxw72gweTkPwmHGN6EXVDm93k4Eu0wjlMsnWEzOrLzscnUUmxj9FumClqRyx8",
-	"SFiMlg7BjAWPODqmDgTP1o3TVM0yFEeoGYB1kKsWiDnqgNb5jCFu6GTLAJOJl1Vcxcb+1tWhHua8rFO8",
-	"rptt+WA/itgvbT0sbU7Su6HEwnFGTK91byTE0LS77sC3PXgeQNWjJI/vuGd5F/9yEdYxqUMH0iuzNEX7",
-	"gbvzmQxkqs7SHYiG+cxPcMjH4GMm+0TDHIk8xpY9lQ8KbnUqFhYYcSyJdIr6VAkCYY7laqfLj8NRkWcF",
-	"gBz2pRIG0/ReSIiAq9APiZjx75HwsOMVA7Mn3kZ3mh8/lF93Tau9JRz8GBuO/zUsnf9QdyLWhtA864Le",
-	"F07T1nsv9z/VOCkFttR2pydPUkxJ8c+FS4xwByoxr89B9pzSa734KzHjTzs6RVWUcdxGbalGtJHEb4bR",
-	"iK7r8FFbJ9Nmf2Xa6FUEeRJrHJJpzNTpwMSMRK16U+YQHOw82DTiTvihAV8EAGb+gqlguUzcAKwnKITr",
-	"e3BCrYE5Bxx/Ho/Ump1sElIbOP5FmSXlj1DFKO5EZO/7kcQN8FtIweGcLKTnXADiZTqSC5XCcmXmajn/",
-	"GKbIFyr5wu7yfep4OKSmaNJoJzLlxYlq5gr4LiPrfyI6UEFbmSjf/UVVxtDs8EvyS5kYlgy3Jx2cVDRj",
-	"A7mkrsJX0bbHcrAKhW1y6g0AzxKgY6ocdX6HXDtjazChrmAzBnql1dHp0vVQqSXxWd9COhmB9UYiYsoC",
-	"S4LZdW1nUZVvEd/zHWhOnlTlXGPrd1AHrUeXxVNYVFp4Ph/DP4Dfc+uuZsej8b71YWzgVXzxUnrcUTLC",
-	"MwpWGQGqLCrA6bNWJ5+eHNZr417Ua4V6q8s2lHsUf7bQcWO9HAzi8bcde9hJaRNWrKQG7c5PVm6/rGau",
-	"aDPfq/L3pqdgRmaEqchFW5gKHVhpPB16usrzT1D2Rh/YC4zVgwUyya/7qV+b3xeHHUM4CEvU6Wl2qszr",
-	"4m9ugDt5H7iSTSKnMeEkVCmMMeiBNDl8vC0P2IXiy9t7QUj6G4D8Nf5Kq7sB0MvIzaw37d+8SAtgP+uZ",
-	"i3bAemP3sa4C6AzFZNEjJnHglbxe/nkHBn8tIdeBNr6gyrlq/qlWeOEeunax70DwgMWLePGd4P9+1ddz",
-	"5NzZs6F/f/fs2QOuP7/zx4Ged9754wD1u/8F//kKlQHqOWeUBOo5Bz8HM3B//+6/v/vuH+Gg/3iH/st/",
-	"oIlMv4Lf/psHWuq3OzAEVLNfavUFOnWMGG1uxOgmdY0+qUXHYzyG6YA0/XFMr1G3gcTGTU6iF2h6dbxL",
-	"9Br1TfEBwt3V4APUdV9+HyAc0gAfINqytw/wlxelrUkKenV6Ai2Q4l64Ef7AL41HCd+itV3bOoK413H2",
-	"DcIHTm80cYg8dnqjhy4a//76oqOZ4EuTvyIkDgvpiASrm4cvCpL1UWVhlis/7c5PohRval+J9GAkPGSq",
-	"sm3JUSC/x+yV3TIrhMwKurpCEQlHw5IYUuXibjav3VimF3KcMCOjj0tbD7WVeVUu0h+QX7qviyFCr+v6",
-	"vQ4pnSapxh7WguT65JZeAhSOEVgD3QEMACiKEJKYyBUlSnDWHY5rV0Nwihq724iavUM+RzpjAeajyAgX",
-	"8ARqRo4PD6dESVVmd+XHMMLqtqpMQldUwWVhWONeiaWjqrxKbnxLGoJNTDPjlCzbkHNkG3MoTIlrJ6xK",
-	"ffabNuV3dXkJxSh6I8B/jqCp4qFHni6KsdJPwbwmEKXVT2KtoynSKMEfEQHVpgGIrBNzligAVq5gI4id",
-	"g7qZpIKAxKITq5rtj0zAnT79BD0cPF9A2swY+v7N9njp9eSb7Tv9wf7DPcG+nmCfKhf6DqG/gjuMSGDj",
-	"gy/6Dg0EgwPB4H8EjwwEg7gjjOnPh48MHD6C/gx1beM1Yn+CmGnIxQmGUpj1nTXIA+Y0q6/3Amwz4za/",
-	"DREFrfi6+nRZX9fcMQes1QDUvIHZX5zKnYVajSN5+/GslMsg7k/EbxpW11yZLc8/QWl2xJoMhBisU76u",
-	"ly23vU1aWASdevT5i8sxGQqY0SO+Mp5bUfwc+xZMRz7HpoC6ylbUiHW7rbRJBSooQyI7oLiwe2UKmgLr",
-	"qlpRWZQrcw/tbSVMk63vXpuqrlyDerSCTJH6xIeCQapxRZ7Wp33fta5hZA0qbGHuC2YqalF5/JLAFHHk",
-	"o/LEU1WZtoKG0iwQ5SizJAMCJfxQzy+S0ond4nkCSRQjYH6nGaLAaNlxNtYoALdNbY0WYwAlN2PyXyPh",
-	"GFS1Z6NZyDT4t6IQXNlADf+5xJzO555ytuWdUb7eFJQ3LHKS4Qx2kdeNTJxslAinOtZwJVXizxuQUknC",
-	"eQyXDcplctelcIKjObURb8oF9A1K2NgDqJsyI6zQ4Dh5bQE05Jhj7l5EAgSXszc7yGaPY2Te6oAXzlgX",
-	"N+prjJNkD/jO5I3ww3enBGnofOMaTxX0+hilnUJ548caT95WTxwvsKHmLbWFpzIr/BsPHxL+iArOIJue",
-	"n845Jh9kHQ9SV0uoZRFHcJmr6dbabohVbwU7Wgrlu78AzjPDp0E1dnHHqd7K2LJ2/UV95XUhOBpTyrFO",
-	"RqvvTbxnOUCcmqwOZ5wFcyIWqjOOHPUX17Nxqvlbu7mfnRiSQXmhLzwcm6b+5YwUH347HlmMMte5wMMJ",
-	"eqdFQULdd2uDHGrIW7mX0TY3Ktd/LV+ZbIBYw32EObrwUo2DLTZO8GsmzcRTElU/TS/ixnv8ZtTHs+ye",
-	"ntTtCETg1rZ1qlwVq8wvkDPF19iLhLphEkMX76OX1Q2jEVWu3myPl7a2ymPTeq17PTePXf+snrAuj6gf",
-	"AkU3PGFuhF3/65RPuAJIrfKppr5frQ2ic/O4mKHQGClKtYzSI7isSDb2RAtbF+xyE0N9jmMLNdh6D2PI",
-	"6DmiyE2nTf3i6anTE1d5Gqmhj62QNabxBBqGggPUgJyv+XJC7u56LySmYxnPbu3/h/tuQEnk1i+G4URm",
-	"ChG982U9lRv5emv6jNAzBiLWraWPZzfnZW8MdLjyYcAZ2IE+o1eUH+vcjDcDrM+azcCo8HFYhXO7kdku",
-	"JiDyLu8/8s4GP6rZizAkhS+CzSXFi/GvTW8a1gROjXQdtmpOtOPppXsYt9JVZtXsNLQwz6nZZ1ruJewE",
-	"fl2VVw+r8kpp85Eqv4DcDItcuTXe7Tn6p2PHT/TAfrs9H3z4Xyf/3POXjz7+5FNWWS/UVPfwaE8dPzIx",
-	"kJYYjQ0a90jGWluhtJkpT/6E38weT2VTvwO2Fki8RhmF+IMKqMmHKufI8L/FkyExafeP+FUUza0y6uuk",
-	"cCotmTXuVG1q8oX4IDat+KiwTPe/nSQm6nnAtFZf8dlY5fFL49eW1GU5T2fNslaaVJUJWMj4BY44JYvB",
-	"0JMfdjM/gutv4vru7RWb6bqGCu0Mc1F3IB0L/yMtnkSzScm0aMUUhiELTeByr+nJ6W378L7AwOK61lnf",
-	"ixNKXZdnJ16KpUQwxLl7nzlqGw7z4Ze4MWc41pNOiapcLL3aqdzMlzY31IwsRhPSJVUuVh6/hMOYxZfg",
-	"QPAL8DHzZjiTEpO+JZjhgq0rC99fdwDHDFoWxs7ojQTc6s6bUSclhc9sYd+FM2fAR3mt+Br6lvxWW9f3",
-	"77oVc1ab20ZWkas/KvwzKQoxPUbKbY/GdYpHcbS6Btuu9X4zhW00vZgDT2EGICPEoXQyLF06DYajNY6G",
-	"omHYYd+OG1vHjgJVUWEVtqiZJKE7sPqBa0i9OWQBYXdVuzZVeW7ooHrYQl954WFp6yFMlVrXpqfKtx6w",
-	"shPDYJtD8fjXYZHwwUAgJaZQpJch5RNh8PgwOhuzz8tW/5RZbRxF2y6o8iPt9QLuBw9uReq82XH4cREF",
-	"AVJDlqprU9X8tilf02GcnINmclijPyMja355cQkWx8hBzZEOMNHjsda5wM9+BCB92Y4AbeqZ9nLVFfiQ",
-	"BqF/QRSSIhVpcF6SEhSwaTtfuwIe6CDmFRg93WE4DbKwqPKqOfJoiW51Q9dL9cEge4uhcETc/9ihQ3qY",
-	"WDLnFpG74ra5sO2+RCAMh9j/GESBQSzckTqbbxXWYFTE/scaCithYY1kQL41WDt5/O3QHmBI2ArEnu5B",
-	"AGub0GTHd660malew4bodtdAqJd/yvDVO+CPilwYwmOoR7p7gilJK0VacU6VnxgJtMa86wDgAPKPOCYz",
-	"0l1p+4k1czeHUku7oQURNXcq6lm2BQM/buvVokgTlcEPVG3dGuhE8zzK2qfSkNob4k2HLrzP/YDXoZZ3",
-	"B7BWwMaG437ginNkMjKpx4WtDe0uGYgYyMgtAuzHeq6MN1CNvBrYvOA6gCcqA6nMlnOy0Z9OmUSKPcqW",
-	"kItvoVECwO7Tb7jABrNJOhDTNWdffMyucNKRjxRgv0gKiY/F6KATLWKj7Kfgr139B4IW/RbXsFWe4LaP",
-	"yix07KxCeCzo9LmvqG10FAxBTRiG4jFJGJKM+G9oIw3gTAKodqYGentHwtL59OCBoXi0F/xdCkvi0Hnw",
-	"z0TPkM6HPSkxeRF5Q1zNrl0X+6mC6cw/XiQpKYH+A4cO9MGkuoQYExLhwEDg4IHggYPIS3weWnx7hVA0",
-	"jPylI6LkafbVm/nR5XRdiz0E4PJJAb4pQoGBwIeidBSt2R1I4lAZuH5/MGjJIhASiUh4CA7tvZBCIb/I",
-	"2M0dGg6dOXbPqxec7eckyYYFcsj18viMdn0JPcxQIDOsGWDNd7UFMfkAqZxjTQnOcyjY53R0Hai9XySF",
-	"U2diQlo6H0+G/ymGwMDDCMruA0/GJDEZEyKnIVWeSCbjSZPLIDDw1WWbePjq3Oi57kAqHY0KyUsYpHYI",
-	"IvABIhZGUjBaAxBD4NxodyART9VEgcosaR5uqjICMymPnjqpykUatLAyc44IKruYNFPrqXgKkWsAOVXE",
-	"lPSneOiSL0L1ok/iVRodRa6bfcMTes3XOrgBhbKh0ircTOjCFsGGoYaQ/ajdn2dx2hW0Vz9o29Mmowsy",
-	"q2RkXfPCJmnjDjt53GoIc8zaNjl42lgkUP5DljRwxS2iJIZgGO0mt1TvZdTLfhRJiYgoibXJC1YASWPk",
-	"xXG4K0Ni7CdeJlDp8HKHl+vjZURJ7EteSApRUYKZGl+xN2p80ov4/WTslCCdD4yC8b3YPu2ssjKzFH3r",
-	"qCfIMq3gYpLQysHIzNPVrZNSUFliZ3kaFSnajGFzpc0pyKoW68X6Plad7SiwPD8o1sL84KJBMytTkYDv",
-	"BS/t12h20wz9lyq3xVR/+xpHUcYyXDylV1+olacoCDvxFF2a+F+WrQ4FD3oPhLfRB/HkYDgUEmMtu+lc",
-	"KIPJgvQF1asfE2YhsVnT2pSjYF8REQiR0IXq2pQ2XbTiS5mF9YDGTBRpQalOyXQstqP/mEnkqDqRs7Ma",
-	"6shjqrz0J+iENfzVYCm3g6G+3ED7NoyIaJs3YL2kHN9TnQrpQ5BvjtSyLkO94OnYSxg7Xqf+wLONoSEx",
-	"lfoi/rXIlm410xi/7LOuYEb/OorSJpqxJ5ntK6nHIbwwnhoovgwBZQM9Uz7YhRWUdxaBRSzbDmq1I9fD",
-	"eIM8dEG4lVjxqXrDZsfN5x4+hcDMILx84TBNh+wbcGubore9722jRZ+DAs1iiMt6MJSryYlEuTloeo4J",
-	"SyzTEa1u2wmf5y3oz4rT0TAdNcxDwUPNBwtNOzChjhlnZ7Ef0bYogu85I6SPA5Z7pz3bbEP0A5Z589Ag",
-	"MqIT2ZAyP6p83zltc9+0yKjTeYB2+LxpNivPK7cGg7DO/7pNGAYzDJ2vU2wYAgOX1nC3i9FVCJvzxDQt",
-	"0QDPcEMlE4ZRnY6ijmTqKC77RXGxdPj2Nv5RT4devd8Mt79KL7gwzd1Vx0WrgV19WunIQiVGcRmkGp1a",
-	"TBDknbsnNVpfclvzrfKGHWz+Nq2RotgwqMzuZu7syt8R264uIfRkJJbt0sliva4q46oyaTI9OqxABUS7",
-	"GsHXvRKg8pRioSdD8Tv5O2K6Jr2z+7I1/47n5ekqU/dKSWVnUVIllVmhja766Ieo1myzdVK6inSrAhcb",
-	"eLuYGqQ1SJ8l/eFcSG/MerU4dAVs0+CojlP4Ej891aAj9l5GFSVGexMR4VKqFxbq9OE9htWzbFVFwT32",
-	"/bYqP9OuvdTbBMJAx1VW4xtzNdI8qrqlv49xiVZ5Qc3ICddCnA4uWmu5zEDd0rXbcwiCKiWNmyIdXQqo",
-	"cjmG+5q8FSIxWRJSF0O4q6BehBX1+yNYz5U3ftQ2N6FLly4Y+RrJzD2TTNlFzJGGiFrjFU7N3anuA9dW",
-	"npbnFkxNuJrgX2u5TskQhnTeGh0069gB13d4bMu8iG5lqin5PmLwmm8Zf1mXohYfI8s7SDF16+Wm9/f6",
-	"UUyi1suNiUf59V3uCzXoX8L8Z7s1fDB0k7W2BnNorxiDZficXnOuCpneLMOnQmZqsmHWwCxdgMFfr61p",
-	"k3PV/HilsMDzajS319g/QqVJr1t2txH+uD5uvQoh1a5X4Z5A7aZXKWNq9ntI3sskM76jae2plIUmx3ZX",
-	"nJj9h/wrTl+Ll3z6T/ReL05l3H37UoyC8SnfUjJhqVZ/MvZZWkxectSPGms2o1ou1O+LcaqK31Dni8Mi",
-	"nSSkNjeMufOaZ+xlQ+zsPixl3JHwlZt5p4p+lZtLfHlUFBfWLj4+SUddZEffnssOBwKo3H4J01Nqlg5k",
-	"go50ePukA+Ig3wHZUCnovZyg+s2M9sLWLwKynzT9IUMv7S2BatBSlFnt6hSq3anl5jlkzFF8fJOsaVrY",
-	"GC0b+GWB+UicEsHkYHOYuBMttq+ixRwqwLpEIpQXfgAEA4mH59W5p1KNJvPGyDZUG/etkWwrT7XrL95B",
-	"h3qXQ7Z9Dr9sa8kGj9SRaR2Z5lemEcpZaLc4WFdK9y/WEhHhUk9KEiRnaw4CanlxaXf+BqwUeR11HatM",
-	"vADAZsoaqmi8PZBBu3sftrXM63ENcOZC5fnTan7cqPTJtgTZF0RFbio3t3bv/QCrPKDUZguKzsZ+97su",
-	"cooCoRSjEOjZWE8XjO5Q5bwYC6nKbGlzuTz/gqIpw6D3ZvtOZfq1tpgnQRng9dp/CB1Fm0BZ8avMM0Hy",
-	"wQei1iyo8g5Nvvo6loL9yPtPL2veCPe64Izcq5q7itd9WCcAO66v481rCYhlbfzXyi9j+iGLcNXS1sPd",
-	"21MA9UYnTSgpca/7HPzxurFXtAVtugi7968ZpLOY0VZWd+d+U+ViX1B78Qv89Spe3rpBuQjWm36iyjdR",
-	"RjbpMjlBojevg1UzsjYzhr58sz1eej35ZvtO3yEytNh3aCAYHAgG1cxi36GBw0cGDh95sz2hwwN2wKRa",
-	"NXDFoJ+KCND4mWqFiykhJsPxEAx70c0lvKNOxEINM89yBDMacOGNXLTh3HAeEQn2NjqPavHQ7OMgmr30",
-	"7fhP9SPKz0+qsoKFhNXUa3f1eKXFcCa+aDNj9LflxUx5/ok9xqj0apGIQb29cWH39tTug6tvtsehz3VB",
-	"lcfgjxOqvFp5PlO+v+igeptLdeeM2pFgi49xnC8QwFNA1loqdyehjQZan1Gn3yK1xB01I0N9ZV1VFLOM",
-	"vasqNxzFLMnxschWax5GWmT2ZGVULc/IZ2OwnSG7iStV2bsEe3RaKh6aq19RfT9k6zWKTb7XYEeQx2p2",
-	"HT4xinCvVEAEXUqcUpQtmEA7llft9ZF2M6gsvu4SxivgauXliaeqMs2uD/4PeBvo5cGFSIT0m4Hsj63i",
-	"g/F4RBRigJ1sXUwx2VraHRVKm9d3b8+gnu6UPNA3QH63Bn+tl3ovmjvkUu2TrPLf9RywJ4TpJO793XlP",
-	"hZTG0qupyquCz4MFIT5MTZcdtx8fHk6JDvsP1rN/KDV+hP6ideb+HdGSkU1jSUpS5fl9Vblefb2Nid+o",
-	"1KWzNH4QggmewdH36f7gpMkyptXqyrXy3BOyi1UkZcy/pGMfiqp8D76O7J2VjZ1qr27A3xehwJk0p6Dc",
-	"tPdaZiBkRIwlRRM+uDsyfwiGMpoxM7DG6MZiE1BAKIxfpZtTuZzHCZt4cm4kOkAlhvJxDKAYzWdVeRaK",
-	"zIy9Ta4fGfJIlZ/tPrjql1TZ9ybqiGG+OunLkdIyF0yN0RlHT8WTZv4kXZhhSy0xdBT8NSJIYkr6Enct",
-	"sDdkbqoSTi5OvuD4FiSb6ivky/eWS1u/AqS+zEBt4rYJW0BFaFer4FpT4z3tUUbO8QUjsBu2Y2VUQtjT",
-	"Xhl3pPBhHsrLCYBIpIlA1cJQ+Uw6UPFsDEVjVu6MqfI6bNfjcL+x02SaV3P1Q9Q/vKkFV8kabvxUd/sA",
-	"HYNkJnsH3rY3oq+5R7jvg2rFVoTaOVB/7+nB1fwF1fDsOHvZYFQfVdV0buKP2G3jSmo0RCihm1lpnUOm",
-	"ySfE4tbS60zv9d+2qfXUU6x9M+otLebc2NlWrE2/UD0rtTnxbQ0FQV24t2U3laWYVRM0vza5phoiXDgM",
-	"r6T7ZltULto3fMvqV+rIwM4F2Ay92JcrxpZHzVF6jUMOlHYKpNkKR7ZSoMnpP82utkbQyC1xCHhq70QA",
-	"JyDlKdpZ4tDpwnsedNJqHcdog6tmZKzwFGB74WVou1uF/oKO/tMIOWptT+ymBDnXfnN83PSSpvEecS5e",
-	"IhLG3axBJliBfWm3fect0W3vA3UL/OZnK1H75UtX0h+EDqDyqbK1TgDYNmxTjG+ryiTu6yzn3Fn/reP6",
-	"xvM84QJu/cmTpCyigJCti8GRh+vX7etyFwLDlkOykwbwe9N7nrayMI5JuPgSJv6NlRTCllwnbiv1y9zU",
-	"sGH6mDmR2RQpgEJgXSFkXUFVlLfLsAUUO9RqskhpgR1zV+vVPWfGdxT2Lupf71A6JcWjPRfigynnOm7M",
-	"W0GVV01NZ00+bMYmVWUdcCb48QFx3s77afRHycZjcNd/jg+25wXitNu9v1QAyJgyloUb3z0GzTFVzCnb",
-	"yHjYkHtDm8mp8q3qcr6y8lKbmXKkc3KNEDl0u3NddK6LPbou3Lm9pmuE3B8ekbLs3VA7cDMewADeNVXZ",
-	"UrNZGNegj8ubTRIOp4PpFT4SA8xic7/ZJqCkr8s8QWKmGSCvx3KxXysH8EcZuFI57wvdjdsu4381IErB",
-	"XAGO+bBnhTG4HpYuZG7wH6rTqyqKWYDyREY0xlrgnWCjg9VXeUh3xLdpyUgHn4bzUUzu1k4UR3voKd5o",
-	"Y+oyTBUGtZ2gNDf3WR3ehOva0/tG+kOrE4O4I0ecObVegayrQok090uaU4Yqs2pGNs9gU53k1cqrgipP",
-	"lafvqvI4p/ZkndXAprmK9pLTG94U6u/2jE83WKuqVaY34c1vO9oeNd2uy4RMUxSp9Mn5+EefW5347W5O",
-	"dr3wMrLVOGC6KzGofN+MyGZgHm/OjsHs1jEQdC7e/XPx1muUsEoePzfxsCiGBoWhr3uG4rHh8Ii/qAb4",
-	"0J1TFVh5MTsB84uyqlIsP16CxQ4KqFBSb2VsWbv+AufJ8gc4fID3dgxtbW/NCG6cYNkoM0eAASaSOFyz",
-	"MaC9yi63TDZyBk7oW2p5zr+jObSlUREMsWL0VwMfELJ1KhHkTbIWSUMmxAGo/BGk/gQJECEwgbZ8d8es",
-	"q7NjSxsvR5oUpGre6B6pwfUKM1/a737pK9QRuh2h2xhdjoN3nKWqmwIHhUU4Hkv51uH0wpvszT1b0+am",
-	"3R1Myg/Q4r0Oc2fny5vjqryjZreQ1o5/lAtoJpiAz6x+Yi7AhuzrikPnFYXu7UqKa63yaJOf6XBqf4VS",
-	"36trGrsn1joaZkfY7RsNk0W4rnpmut7nKpZLymxpM1Oe/EmVV7XfCqq8YFMvSbWWwu6Dq9rLaUgf92Aq",
-	"x2tVfk0E8N/iyZCYhDUhLfUAwiFzKY8lXSKWF37QNm4ZMlKZJWrUgpqR4bjKolyZe2gdN/+k+mja1rKR",
-	"WK4tATpUQU1ALJOobJJ1bblokef0xG+2x3fl77TvtgBu7t6vbNwE8nx7TpWnKr/eUeUphDAkkd9sTzia",
-	"s5sijptinWYI4z1VzOu9FJDPozz5E1E7Opp65/LqXF4+bicLB9Wkr6caY2p1KZ3I/BzcSkVLISg9W5QV",
-	"4MXW7Y3LwigIj68Da3FD7OQylWEc1+tEUaUaJ1R5lWlwdwgx+0CHZN2uUJeCXGwo4uq95bkn3CX4QuKw",
-	"kI5IgYHDwe5AVPgW1+MLgp9qqM5nqsUnF1QFvd6wl5q/sp6+LXofrCp755rsetXR6fta25OgujYLtG2Z",
-	"6PRTM8gFWxx6fQ2d8c39Kdjicjcjl3aWzeLSWZjNAmGmZKA7Ng/vVM9QDZzDoB+mrTMtyC73MMNCBxQv",
-	"v4M7AyOxo7q2TnX9F9QT+U0ZRj9gN4L1pymGI2L9ZQZ+gAoB6vYBNkWMs3OqsgxVunXdtGuv3ezY2D9P",
-	"ouy+pzoQ4OgJem6TNqhMVhTYRedZvjw27a7bwbO3KvIfrOYv5p8+ov/KUU5IcZhezS6ryg7S0omuk0Na",
-	"s5PK/C/RQKiT69QALc0uCJyMroBJGlFZqp76CSbRwsyAZZenNzFUUc3IfeWFh6Wth9rVK6YUdW85Z9w/",
-	"qASsKq/y5mXoOheApJtaF01HpHBCSEq9w/FktCckSILvIrBIpjW/ECxZh1dW+syMZdS88kawSWLu7x5r",
-	"pDLxOJgGHJWqT8ySQP8MJ8ywwJGh8L+PaRdpR/a2RUgnizvYktdJRUQ/go9wWpsPqyK1ugXEZknrpyaV",
-	"Lt64Vbf4kCRKPSkpKQpR/9LnGJ60iQobZ6lPqxCagf++Dlh3z4WQGdE1qGkt8FxISeEzVS58Cnimq/9A",
-	"EL9TldndzJ1d+Ttie7kDuX1SlVdQBLjZJAQLaxTo8BAoPbdh8PQzvevDn0QhKSYdVsAiRe/egTuAOE6p",
-	"FV9rO4ukhQOjkyEO5zcRiGWUkwqSMztt2z/G33TIdhfUQIAwyoZZwhjCEdGfHDfzfhMV6m6u79HloCvh",
-	"XDdJb1REamcbXCcfg6002//gU5E165itulLaSa/tXCmdK6VzpbTuSmHIm3a+UmDXsJT/tvZ2i1Hao4uk",
-	"pa9bafM7VR7Xe0KSqL3V0ub18t1NyI2mDlfMVOsP0e5r974lkmBeKUxs+AQY/lqnfQLrltubp5FfxAcv",
-	"iEOSV8VxGkCQtXSYGF6Tljdh+PQvb22LnnZubm/hDsRtAASPf4atAi1t6HxLW9wdFcN0YS8juBw4oPJb",
-	"fvfuVYvohNzGtrKEo8JIjZ44D0dP5eaWlp2uxQG37uaAM09fqw/uJDp2q5xwcDlfXjgT9BrvhcPQc/C/",
-	"IUudpd9wxx3XMQnX5Y5jkrRFUiFG2WNPHBEt/D44wk4N8r4BvNXqgEMQbLYHDgu05rvg9IU8JGXTvG9M",
-	"Sbm//W4dWdgW7jEL4TpIQkedDf8M/u3bN4aWNkNWl3p+DJiGtGmBQwwuxuMR0yHbHMMlJRLaxwtmoLRj",
-	"rNwLYyUhirfUTEmO1/atBoGM8LRQwq94xTOPv6sxeiufdRKLfDfzJOuGqMHn5XJNWPShGm6NVvi9fGiP",
-	"LXF5taUy2bk5OjdH5+Zozs3h7dZqs5sjEREu9aQkQfKyCZcXl3bnb0Aivg6NGJOViRewBiLVV//5b9rk",
-	"nHb3fnnuiSrn0Y/l2wocWKg8f1rNjwOuAPS943CHgI19KSZT4Ao5bi4xQVM/ZEX5tiq/gPRTsLKNMgs+",
-	"U6ZV+a4qL9W99JJ53XvgOOj4VnZt9DEp8JprCq1SFZvpRjJFJ1FiGV56tQOFJL2r3/2ui+C5QOZeBxJW",
-	"HlPlR2djPV0pSUhKqpwXYyHonVwuz79g7v3N9p3K9GttMU/MypOqMtF/CFGDNoHSDleZ8KJdB9SaBVXe",
-	"saPkzfYdS8FclE1ML2veCPe64Izcq1aeK6WXVxt2WCcAO66v481rCYhlbfzXyi9j+iGLcNXS1sPd21MA",
-	"9fgURC3YuVJ9JMOEUUUvAgPGoi2QilVrBunAhPLdud9UudgX1F78An+9ipe3blAugvWmn6jyTehvW9dy",
-	"L2FNRN0XdB2smpG1mTH05Zvt8dLryTfbd/oOkaHFvkMDweBAMKhmFvsODRw+MnD4CEzDx/CAreF9dX05",
-	"FREunYaCsRWiWpcFJ2OfwTx3jnEJMRmOh04D1PkedSIW0sfUmw0fj4mfDjsChtbPDZg6bZT+GsOEGnTO",
-	"IzbARlq58saP2uYmICoshHW9AjDT3mfTKmNq9nuouy7re+bJr21M3W/3gaQHfOv9+g65s7aC23ZdmTu5",
-	"du+y1HBHpZ9UZQXLJpaeCKj+o/gI2yKcjEcaE43ko66tLR8X6RPVzBWHvr+o1x2Zq2BtqAor+7yDm61g",
-	"zBpl4N+FV8GCKj+q3N7azf3s8FYowveZ9SrERYNW8T/ANcqIlnIqsQvI/vO4R1pbfXYKMD1VpWAvIpbs",
-	"mCRgwx3k67GFwAlQFwiE4IxMVfjXC1GQLbSJk82hHVJ9LYUhE9DtDfC0mPDzUFD9aEQcmbmEDnbxagRR",
-	"2syUXr6E2JrU42fwMjl6B+sEwXACZVyVr+rcZnmKv3U9JzrtJ1vaYslBtljuOihtnS+63svplNiQPnfm",
-	"3bC72mE6KfaVXr4sbT0sbV4HQgBB2PQQmoQryfAG0hu8QbPidcJ1hA2nYflM+omehzrfNhYApsqaLj3w",
-	"9Gupc1m8jZeFhYroGCVCUWahYKGrjrjuiOsGi2tWOzwsrpttFkFC3814fREZCOouLGR+RrqWhzeHyq7S",
-	"0bKV5zPl+4tOgsnByoRtHHYbk0sZR8fnb6G0eX339kx57onZomxoo/h3a/DXBf0+0sZ/hfhGv6dLz/PV",
-	"g6yl6KPnYUxVIf2dJ+hwr3LWkdy72pEmonCvHukAuJpDyHmTOO00h6PI26epfyc6cr8VbnIjYcvtQyTm",
-	"npdvsjocWdHjjmHbhtxvho0JR22TRVoQt00t5Zq8ZxcfzamhxFjqLXwwOOXXVvKF3eX7qpz/JhwLxb9J",
-	"dYeE5DfhWPcFIQnYCsed5KprK7TtCS+lKEQbd3uk7sMnBfWqzMj4fVGAlaSWVWUCQBwcvfPcaHk+o4NQ",
-	"cBT8Lk+B3oggiUiq1/EiKC9myvNPGDElnEGPH8FNWOV8E402nOKXfa6m6YqOC+5vXfGtYn08NCMfPXWy",
-	"62IfzPpEvjz4YUb2dLJSW4MwhI14eExS9UkcpihxJ/BmaZJu8uiyKbqj9p4ZtiP5aaHRvo0vMGQa2P/C",
-	"X7BNp21GG7XNsBJDp3tGLV3o6wiKaY/3f33dNljCOBwS480qP69NzlVubnn0FK2h9MU0+LeiEJJer6nu",
-	"xZfo5K2qewGX81X3AkFPNyM2uu6FPn2n7kX7yzKCrLfCpmkSC04KKGSXPTZkYqj7qH5B8NQG1S8QBJtd",
-	"/QKLtRZYUclCHPKyKXZTprzsVL/oSMQGWfss5OsgDx1VOPyaBv/2XQMDLc2Er79sZkPmtKAGBlyMpwaG",
-	"Dtnm2PIowdA+NTAMlHYymf1mMtedxkwo4i1NY94vohcKCM80ZvgVr2zmKYDRGNWV01yH5L2ruZVxPdRQ",
-	"AMPljqirAAbcUisKYPhQIFtSAKMt9cnOtbG3BTA6N8fbe3N4F8DY85tDr17OvBe0K3lcUcK5KLtd9DsJ",
-	"fqryeh1SvzHl1+122e5ALB1llaOnTisXYDGPVf2cdu8U1WP5KzhjN9njOY7a7qh0eoN8wRT2TGdgUqKp",
-	"TDbcMFIg4O890o2sFGFLJJKSwqmuY/FIRBwCQ1S5IISi4RgqKAGEAx5hasHtkvqDNssmJFf06Uklbd1e",
-	"2s9V/NaWs2fcKTQqG3mhNPY6gfByuiQY1OjAiLVcCZhZvZParVKN6PGogj4X664j7q3mn2rTRROsnTtw",
-	"kIxyg38b1oLDZ+MNi6BGU5zj6r7Bhpw9I6PpvTfwRca7R7lAsMsl/dDHKBXRSrewfkxHYLanwMSFHzhk",
-	"ZruJRENXZiVG0xpKHKClv3dIiERgpIOTAgtejzBpeVWVC+hhByl6HT0rJ1Vl4izcGEAxxEbXsXhIVOUc",
-	"pq/smrUkTXablAGjwhTQm3NNzWagsegn+Aoc5wr8OkbOwKPO4LepnKM52CMqHoZA0e9OqqqCboMo7dzT",
-	"Nm4ZcY7WUTlYhCoHE71h+a7shqo8QS2LK9d/LV+ZhFCxBMbr8ZQAqF3HzguRiBgbEUnP4qKTh7N1wa/m",
-	"Y1I6BIso0AFvwPj4SUhQK/C8pBYYoAKCoXVt5Wl5boEDQ2SWgnb1ilZ4AfMgLPakQlRMpQQAuHXt2kvt",
-	"+t1mhpBaLS5QjDyDz791PfyI4k3EizVoLAINYgBhowgXzePxkOjI38YmlVlt/DGp4fdI53YAMVyoYO3U",
-	"X46dUOUCpMUvxWR4OAxT6is3l7DvF3KxjV+q+Q1dEUWyxELNytixSFiMSUDiQsJWZukxGJ5nPv9IlTdZ",
-	"QsLLaAqWs0qHg+gicidnuUD2gfhtnVtq4D1X16aAcpe9g41QQHjwCLnzohCCRHA58FEcsayZW8VvhWgi",
-	"AjSt85KUSA309v7jgJQUEgcuJHqFRLj34kGCfv3+/SM5/9+AjvY+IIuz6WCw/70hCPy/hUPvg58PDhFk",
-	"wJ/IN/GQ+LchgjHyoQmNzp//LSpK5+Oh90/3H37PePOnpGQ4NgJ557Qo9RyLx78Oi06nTIkpGAH9vjA4",
-	"FOrrP3joD11ARX+/9w9dJ75NhJNi6v2/iqHuruChro+FS139wf7+rr73BvoPDfT1dX348Rd/6PpY+Lbn",
-	"6Ij4fv/hI/3BYPAPXf8lSYlPY5FLf+g6Da5akbGz0cYJBVoamBkI01bBRnybFP3lEUFtQrOYlYJYsoQS",
-	"AJH4SBw1CmRH9thfKObinuCSJ9fVD6ryyMZzhCtu4yZn9rvPRyDOR2i3tsv8EINdrZtar/1Wz7XbVdru",
-	"l2XNT4DWhc9xETZgKBMZOXFTSnSra6y9XNU2N1wDd1lX02kR1QRtfkQtWIknmNZ0EN+ePPPoHLuy2CNV",
-	"fgZjY4va5kY4BF7Yt66hYNn2pzDDocEmOib8KJoCZIR0PaYw1jY3UMUNUgrJXgSReoQic9GStrnxTun1",
-	"5EB/UNvcQHTdF0T/3qSqKq2pygQAd67v//WDewh8kJHJl7DKMPon48N3VblwNla5l9E2N8h7pWgq/YZd",
-	"iPegWeu1Kr8u7dwr52Q+qQ+psznp62R6qkQibbuSkmlxtK0YEFGA72JYdOmrBvJg24dkEnjlqj8/IA4m",
-	"/AbtCwbBw6b66xVVHncF3j650Sy0YRcr+kXVexn8D/ud/D0r0UBvGzgUFYRKldnqco42XjsVRAVscFoS",
-	"pHSqWfxuXqWJbO/N7Uzurpu1/+UDqff2gqcwyGbAdAq/3Z1MqqdwdEP2GY5P0QsfsTXHv4djQ5F0SDyd",
-	"TiXEWEgM/V1VZv8OSPjv8J1Aaf0ZWbs2VXk+g1OXnArAus4tF8r3lktbv6IQHWzKOHrqpCoXuv5OzAvw",
-	"kH/vAjT8cr48+cBb1z0DweJRm2xYiKREvfrWYFxSldnd2yvayrwlCy57Dab1PYZGtXFIdEX4OUw2VmTv",
-	"Wl2DcYfMVQBY/Z4ejMcjohBjJc6C74xCYS6QZ+yJuX9n1BWpCe6CV6HLuawIZR8SAppxynOt0IUAKfDo",
-	"Qkg87qPnpoWxHZJMAe/QsqI36myhpR0FJSh6zJRR8B1D+nFTq74i1NpRWb22po1fpb2pdQeJ0qChC06z",
-	"AeR2XbY9bVmpgH3CgiOlwfXA+kjmppMRynQ8pJtIzDbkfhgZ5/RtT0i8CL+Xwgckceg8e8xAb28kPiRE",
-	"zsdT0sDBYDBo/0z/zTl93z7cFGaTTkEPPoXq6FV4a9ElKEnhAmTZsct0y3Q0oHfnf9jN/EiuQsakaSTV",
-	"POyr2pV86dUNuuWB58QwYoQxsx5V6DnDCAzacJ7AUqyVaz5YudV9Tto5zTUniS28zF/2jWtevaSRx8xG",
-	"4TOuaT8Ie4IA9XPjmg03nPTY4g0YCb3Gd2ycQm+f0RpP/U55YdUS9G2B87ueK4pIbWatZ5lZN73a9oFE",
-	"GbkasAONd2UoOxkFTjY3EHF7zpNCNhpnBJBeK0geM+dzPiueBHWyU7NbqMsX9PLcgl1J8rgZnLXxELkq",
-	"2Y9sCt+kz4vbERRUJjAPTgHDMZinMM97LCkKUjzpDhpGkQ0HgDuCiDlHRi7tLAPgZGRr0zqnIfM/o8eU",
-	"B7j0Qh+j50b/fwAAAP//",
+	"7P37VxRXujCO/yusPueH5D0YwEvOhLNmneVoksNMLiYmmfd9E78zBV1gJU030114ia/f1dUNitIEoyJe",
+	"UCRBQTo0GmOCoPLHFNUNP+Vf+Kz97EvtqtpVtasv0Jhea1ZGoPbt2c9tP9dzsb7U4FAqqSb1TKz7XGxI",
+	"SSuDqq6m4SdlWD+ZSmvfKrqWSh5JxdWe5CfDavos+ltczfSltSH0l1h37OPDw/rJtv1vdZpG6TA/qg0N",
+	"M40F07hlZnNfJWPtMQ0N+BfM0x5LKoNqrDvWl4qrsfZYWv3XsJZW47FuPT2stscyfSfVQQUtp58dQt9l",
+	"9LSWHIidP98e60urip5K9xztSR5T9JPePZm5n838CzN/38ytmPklM7do5ubN3IaZf9Fz1MxdrcyvoV3l",
+	"vzdzz9F/84/M/BwakdsQbHgIrWHvly4euOl/T6v9se7Yv3XYQO7Af810vK8MqkfYLOhAalxDO//LcDKe",
+	"UIOOtWjmL5q5H83cb2Z+wcw/NY2Smb+C/pG/ZOaLplGq+XyuvQSesj+VHlT0WHdseFiLx9oFV0Vmi3im",
+	"eh2i5u0PKIPqe1pClUE1dBVTZm4OoVp9rsJevSZcI1PQ87yvJtPq4YSmZIJOtWrmfwS8Qiexxh5YVybY",
+	"aXqOvvH55z1H32QH8N8+v1hNh3BM5DiK5Cmq3XxdcEgOf+qCMDXCmYNuz6AyoL4H5/Pl/tbkDevVNNpV",
+	"bpwdpXJ93cpPIrx5+YP1YtI+VG4FyH3J/1yn1d4hM3e1XLholW6bxrRpzFr3f7GujJlZ4+9q7zHTKNHZ",
+	"C9byTWtmEeYtmMZj95+3Nq6bxhT87RWdns67BFOXyNTGijWa54YuWlcKpnGT7N5YRN/nLnPT+IgygguR",
+	"wG3D2Al3OYxhkK4P6uCFa8MfMofjMMe1b9UqUcjMPQPZvRYFi/yuOZXWBrSkkhDdKY9y9qJ4A/n7Zn6C",
+	"8nWGd9OwwIgPEgnxzzPZipn7wcznzfy0mV+Go1+yXhVMowhiPQ9nfYxOmXtIpLyxsPlypjx2hcydG4f9",
+	"GmbW8E5VufMLzLbis+WCaeSkMDujfatGx2t07QwRvlDTmRBNgOI1OvoLYN710gccG6gDP+cO44PVkqeR",
+	"x2WOFRorlUvP0S89U1eePdlaHEMKFZ4md5VS17SZNbipXIi7GG2qUHxxwzsyfLW4mpJjgdb4VOX6et2Q",
+	"BC9cEwukc6DDaMlTmq7okohvlCqlucqVC+XFR9u3rpjGqmmUyuN3rFej9Tgfv5eaDvhpKqH28JOhkw6p",
+	"aS0VfzcZ9yUJF0ZRdCpVnuU21y6Upx+Ub+WiUMa+NiFC//7idmXylTWzWL6Vs8bWEZdDS06ZuUeI5ebH",
+	"7CXJB0VeGLjmnWWT0l9ilj5LB78yjYVQWvElFDUZF5NHXNHVfbo2qAppBAP7uK6k9cjg3r4xbi2MNw7c",
+	"42bu0v6D5Vu57RvXrEsTQviTPdQD/mi56uGfQSCs6gYSytkPUgNS4mzazP8EAn8ZCfM6UDJbvEZRNpRO",
+	"xYf79L+pZwPOQfSJLNhSxswc6Bb1OAS3eN3O8dHwoD9BXJ8FvQkUTZ9TlaceR6EJH6xKDg8GnmhQOaMN",
+	"Dg/Gurs6O9tjg1qS/MTOpiV1dUBNuw53XFf04Yzv+fzOBHdzgZLG82qUj4JAYzAeCuY2Sj67EKrD/kSp",
+	"D2ek9c1jLgAB1DKqovsjtbW6XA8UxotULUuP4+Fou8MZNciemX8IO/q1HgZMvFTVm/4cDz+Pdp1WM0Op",
+	"ZEYFk/Hh+KCWfC+V7tXicTWJftOXSupqUkf/VIaGElof6AsdX2dS8Ge59d5Np1NpvJwTKApaD07Lo2ZR",
+	"iGfn22PvYpPgDm7wL6qSVtNbSxNbi5gMfwCKW4c7G4PbWgFdu7C1NG8aPwJFjSDmlDXQUzEH4mvSNFbK",
+	"0z+YRrE8c8m6/Lw8Mwu6YcEauwinJINCAQDPsmR/agch4NDTr0wgbSBrbC39VL75nZk1yEs5a1AVfsk0",
+	"HiFtgAcUut8JyStGJzyup9LKgPrJcEpX3j3Tp6pxNd74g25u3LWWbxLRYizy56a3/RN5XxmlzZcbleuL",
+	"2xeRkWBz9TK6zdzVrV9HTWNM5h57krqaTiqJ42r6lJrGW2r8AV9OmblLSNsySptrY+WZWaYGggx5hJl8",
+	"5dZa5fqs87EqPIhpXANZ8ROA5y4ig9xzp5SwDX9gNXlBnqjY45F7bOZGQKd6ilTL/COkVN6esUro6WpN",
+	"rmzlX5azC6ZR2C7eRHvkmOL59thnqdSHSvLsp+q/htWMnmk8+MAJBdIWY4NRsOZvox0Z34Vd+aeqnj67",
+	"73C/rqbN/E0w8GQxHCoLV5G6YhQqzwrbxncA9YdYIbYuTFhrDz2r4g8mTeM+WsYYoVLipKrEieOPW84r",
+	"h8o/zYFpyjkt0ranTeP7zfWbpvE9UMArADjboW1p97j1bE0HQe2ztHLs8yR1Pu4E5epp5RPTKHFeTLRx",
+	"yrQL9DqAyWJ0dzNn+i0YDo0C5tWIe+XznE8rIrs+T0GFRWsyc1pNfwYQ86gyd+5Vlq9jW/XvL8bOqpmP",
+	"Ut1t/0fNdHyUwn8zs0a/dko93qck1O62Q+XSs+3b3209mtp8Nff7i0ux9piK9NXuL2MwNtYeY1/HTni0",
+	"7fbY4eG4pn+QGoALiWOpqiSOpVNDalrX1Eysu19JZFQ3oIll49rE5ssZhBp3fizPrtMnEaNOpU9PpU2j",
+	"hHQVJPjg8/KtXIWIghKvCiGflEPbGeI2cS6m9OGlgzGDHucw/vp8ewz2IKMGwceUUmTWQPqpikb1qv2p",
+	"tBp5GPie1fhhXUCaBLCFrbmCmZt0vpFt+pN53bbHtLjs1pAm2B7TlfSAilRZn21ZK6+2nsxhjdu+MDwK",
+	"YbVpFK2NGdO4icgja/B3bObXuZf0usBdnF8PeKKKnTchL8r2mL01WUB8Zo84f57Xrb+MwRIYqdopUjqW",
+	"4ADI37FNfKner9U+nSe+wwy3XVTmIKuSTW6Lpe25e05qoWSvxOOgu8cQySZUXaU/IS860qk+VJLKgDqo",
+	"JnVk+YOXw2DqlCr80/AQwiz0J/YD0bzdP79v24gzbGn7WwSoU4qu2q87WPhU6hvnr/S0ksz0q2k03cen",
+	"k2o6c1IbirXHBtX0gMo8xxnH1uBXx5Q0Eivt6PxODzPbjefX9hS8nun43vUHewRywx/vU5LkkRrEWnuO",
+	"ht6sg5JkUNrJTHx5N34KBhIx+fHSBPiWSpXLv5ZHx7ndbG28tC7fN3Mj1qXL27fmQQceM40LSCxmDTYa",
+	"SHKWMXnnZP5OtitFNJCIyztm7hqFgC+tfOYgZgl6YScNohr0jI5hb0WMxbnEeHNNjAtSEF43RfZoglQU",
+	"McM2CAqtlkomFS2NBKj12wNrfqHyYJm+ruAZirTtJ8BTQRXdGN16aJjG0vbtO+gDY4MD/itf8eoQRoG6",
+	"Fz7aEfa9lIR5l4XwnKemC6kBH6FPz7fHHJCQHPsJP+bzTz8Qs/IkvvJgRk1mPNzXp2Yyn6W+UcOv2a29",
+	"OEZK7J5b6wslMQxQUM8MaWk1c1iPPse7bKgbCvzW+CXk4PAuvyU3avuZSEpO44eQ8wWqNH4wirAH2yp6",
+	"e9qa/K1yewQeQ4/QH9Gb7L5pLG2NPylPPbaWpw+8Xb5x0VqedjGPM8rgUALtrGv/gYOH3v7PP73TqfT2",
+	"xdV+0c9IiilnPlCTA8goeOBtMBXzPw4pOrIFxLpjX3bue0fZ9+3hff/3xLkDb58PggB9XJHXb1TuQ85r",
+	"QGQVtmu5+VE5P2rdf4JdMFtLE9bkCvrM/Rj1V9y/Uc/K23wJqrtQFE0RgI44ujEiRUZleHiR6tgeC7/E",
+	"DgCEtGq8R1cHMyLrMB8BWizfWTWNia1XL0xjo/Ishx4DyLY4S8xt+XVibsuvu+IV+ac63ApzTHR6HRPt",
+	"OCZEwltMFCIcDNJOjftRwEDN+u0xPaUrCTkwIEUhZ5i58Yjnpl6igrUAhgzbnLHS6XJVysBJJECYf4M7",
+	"juea22nQjZScceNaCHRK7JSba2OVX0Y8XtWqGSxD3Gqjl6NptSI8kTs70jSfGtxyQ2oyriUHUHQNfABR",
+	"F3Nm1ugd1hKOv2yuLptZA2EtUvrj7Pfl1THT2EDmFkVLcL9H2Dj/pDw1TY1E18BGfHVrzqtjUfWS7CbW",
+	"HqPLI1SgSyLQwBpBemUQNgjuouCIK6sDKkCoCPEQAatNJD7uj3V/KRE9luxPxc63R+LOp/BTUipAh3zq",
+	"Jk46hZfOTsgo4cXKL1dM4wHYQC9hGH6VtI0XhiDICktKJ4z9SFyapqoloo+Ikh22hMfsxisp+/3nP5ZQ",
+	"wEObqcODp8SiKtyxH5yxy4kgKg9G6ZeH6oRNhAfIAMg+clwJ7LFftlwcrGlcBxtWKeCYGpWHYXjPLqAn",
+	"SfYaO8+uS0mnlbPo55Op4XTirM/OSVjP2IOALXnCfZDs7MIj3efJXWWhQmMXwBRu80PZo/0PbNjGLsGZ",
+	"QNCiL46khpMi6ynEOaDXxY1r1gUUbVf5bZKhmHXnnsuN4dWG2ArH1b5UMp6JugYGwu8vxioLV39/cSlo",
+	"MRfX4nNdeGz1nFqwSR5LnTePeKCmw1PFQ77+PMrzgJbUAtw2idLnn37gx8TSmpCHUT9oBJExqGYyygDQ",
+	"tf0wo+7VNuxfbcMTi6KO+EugU4lUtPdUNd6r9H2DvTgAEg2BZFBLKsTTMKgMDaFpu89xzhcfdHdO9x77",
+	"vJ34b6SG/R/49DyDyFnM4GKK7Wk63x5LJVUJiS2eOcoY+xDnT3gAZv8xogHFBrfIYZad//3FWJeZnTlk",
+	"GiWBU4zFaB0KjtBq52HWfY4pcMFONGqdCpdGFBif2CO48Z+pZwTsbOvpkjU1Wb5xMRRvuX24JnWci/4g",
+	"gd89yaFhvc5IDnNWiekwtnHo7pg+8sBAxHd90cJ+O9jcF4VrwFl8ifWHcmd320cpM2t0gVfeBd6uMCuL",
+	"GLwY//cCaFtQbVZ2fSSV7NcGIpt/p5DuBtllLEWt/Gh2K/8Shc0sLlul296XV1LpTeBgngizFbDJH2LO",
+	"HoH7cNyGT28qlVAV7xOeLhV08KOqrmiJqnAS/in1KHEpfYI3SV9qcFAVPUa2Li5Vrj/ZWry5tfHYzD2F",
+	"yN6nZn4s1h5LDicS6IDUT+tBVIeNul7BHuC9JucRcAkc30DgE2avdNNHVdcgFZjhEO3hhwwm3I/TcRFH",
+	"2ppbrMyvbd+/YK1NCp+F9SJ8gHEQwTs3KgP5nqOSBIl3CSwn1JTkWYRqg3vgjsPviDN07T/0tiyz9l6X",
+	"zPVkHKbTGjk0PsTmanbr4YKHPdN9RmdujIg97M0HFBnh0d9XBiOfkouwFhlRq/TcsdIs1GHnWDV87FHu",
+	"c2QCxKFHoeU1cGg8PYDzr4XKyBwfAEMjp9ktF9FFGytcKjeJioliGoQgFWq5dEsqOQmBiWlQ0ZK6oiXV",
+	"tPDc9q3ZH6KQcsBM7gr5vxZY7K0dEe4DBGfgCh8dJAUJFNTpBwSZEBQEBjo+dTocBqnTPsevx4ZPaRmt",
+	"V0to+lm5pGX2dVDQC38WxxLswGEKgJPEgsBT0NPKsbYjqURChWhHCJaG0LLaXVRcDaZI5aNEXjt2e3Yo",
+	"tx3fvQL5C55pjBJTHUyjuLn60DSeBgVbyZEgV1WqPaZl3j2DTZneEyLIAgFh1RInViywAHdr/sZ2Hlnp",
+	"RTu31XERMAKHlhgB4yQ0mXg+pum3x75O9QoJyr0QWNcXBDDOXUOpD8T/dqMWwuOg/ddUby38gsxCqXg4",
+	"nYgwit4wxKrRLELpDD5/Mudwh4A9kJTtjYh9iBxS+FMWvS6Pe9G2zbN5kKv6zs9b2VGnUvb2QUeIVFcw",
+	"4fPAq3bLf1d7SbGY/BgLsYzsvHDSbiR+RLJYRaEEocq6C4kjrvt1qpdoXuLlnQwsrmVQ1vpH0ajir6ne",
+	"o9xAaV3EHk554ZHhjJ4aFB+TTzcwClbpduXVI1oIqAgZ0xtm/v7Xqd4w5hdun4CL4GHh3FsIlbnA4fBb",
+	"kSQIXC0of8/Mv/DSRggGRMc9gEl9MNAv+mAF/KOrkPgG+VLAJFzlosTCxqlWf5UUSj27OpQ7tShEDtoD",
+	"sdmLaSU+bEzyLuKaUCvCSJiHZMdlovKiWO0LOEapyzRwoL0t28o/jUhRJiloKZKsxjXHnLmr5fFR6+U1",
+	"ShsCmHgEalW6TxQRHNd0qsgJpPDXqV7JeZgod1EsmqHdBlIAhXI7kb1AaWyWucg9oyMKlbvXRV/yQ5Cj",
+	"TkuC/6OQ5MdzJOQOuV4wczmKON6KGpy6NHoJ0SzJAUPJv9vZn81c1swaB46S9GuEW8+55dmqaLCxsvXr",
+	"6Hbx5nZ2lvzFKMDr+y4UBb1gjf1qvZqjae8llG599561umoaxe07P9IM2SW7tgFfCe8pXfvqAet7XMZx",
+	"nAQJ5a5Wir8i/EFFReaBIn4g5IOQ7Qdi3TKK9H21BAB6RBPCAV4oC/ApJIpfLV9Z3npxScCIuzo7O31Y",
+	"8XtKnxo5VK18d25z/Vfgatmti7+46doowW45844nGJmkHOXXrdGftm+MV1ZGrDs/s6gpZ6hyQhvUdDNr",
+	"pPr7M6puGivbxqPK9UUOKch7CoYVOoFYc+i/Qe8uJ1Pp1xIqsllmfIzFnp3TrTI8ob93lsdFNT4NJLR4",
+	"Q57jdNbKK2tj5uPjJJX82T0zdxlHtSP4vtxw4lMUqfEeORNcsUhqyNnt6EF9TuD5Pc75HxGKvci2Ot+9",
+	"M5uMJjqBEKv8ThBlU7b9yGdnLu45QLMybQRz7d2PkVLjcy3ZGzvpGQs3h0V0TMoVmmRvM0iEyIrdAo+e",
+	"Vn55HPvj+DqddSnlQ8V7jtYPH9y1MWU9p665gwrOCa5azn3Hr1G1A4ruwxpd3HzpstPbG8Ivhd9fjAnx",
+	"FmqJTOCwDpdAotuTRk8BifmFCEt6RHHsbnnqcXh0rr1duoTv3WqJWrxgLmmMKCm3UWfXGNqiwz2mJvX0",
+	"2WMpLSk9/F17hDxFaTQVbjB+SHbAh/FDMaiiwjLuJQdyOfoMTeR1CjHNwyx4+w6g8TTv2GsQkhzOZFQ9",
+	"cqizLt0rAI49OPR5OhGq822uZsu3cry9M9Ta6QmchjIJZL2gYx+x6wAFVBkrzaICKA5Nc4QvDPqtNkTM",
+	"F6iK1LyZv4wer2Jrba+WVKAuoZhPagk5R5YDZPXLshJQlOwmSlsLP1oXSf0GAciMEikt6GOHP3bs2Fvq",
+	"mcBdhUsoR6OMaAlLPI1LrzIYP2TmJ2lVpgdWdt7veAd6+/r6ezsP/ec7Su+h+J+69v/pnb6Dh95RlD/1",
+	"vaN09XbG+Mzr/x9Ove4/ce7A/vP/HrTb4w5WJLdp68KoVUJlNcvzM6hQhaDchp23WJ5ZIp9ljT5kV0G/",
+	"w7/IXd3O3qZFwmbJKzBrpFVEZWrc/tBYsKYmt+cKqJLQw3GwKo7j/GE2iGU3srkrzx+jxxmk7zgyHfEQ",
+	"3hroW6KeHWMBFQmZemyNXdhcfwCuvCLdm8vYQTdS8oEesg2QKpEF68IE2265cNE2ukFyr2cB9iAmcPRb",
+	"ooQTPv2rvXNJgQgel9HHbnsOqtgG1Ytwjf9lat6YJkNIDcTgNFHYaKw9Rq80OEXUIbKksZHaY/iqBl8r",
+	"adNY+atySkEe8Ge/WeNTpjH9dy0ZT53OmFnj4+P/GwTFXPkG4ieE2+Aj5cYJhqCaaqfJEGOFDAZThog9",
+	"oa8HlT7TWPn4+P/2/UpYteVrJR1rj53Wkgf2x9pjcSV9WkuGAgi/aKMJXFjvXGTzELXnY9N7daYTcX5a",
+	"rXoMUWHQuYKk9P/VhkAgVZOPlRS6gJDE5o7PnPuQSfYUSnQsCR0+Dp7+1lsd6H+9SuZkuk/EpdOqkpEL",
+	"8vIc81M81A0xYhsmE0sD7VO2kUA4iCBQ2FydKC//CBDIodIHVy5UrvPqv9KbSSWGdRWVGUbp6c9+tVZe",
+	"EVmfNVCd4M/SCkqDRj1SVt56izMfkG8yZwcTWvIb6FzyG6w+A6vniRsAmVxXgELjw7hYI0REgcURmuqQ",
+	"OxJsnlRONHI8X0B3kFYz6LX8qaJrKTTRzEJltVT57qKgfioroeuWPHy9Mw4IWJTbp461x8gJEXvgT0DS",
+	"7/m9+DIOXPLJc4NDUHEMWdUfLrmC/PCpqUcRajA5DLTCjGYFVSVTM7Ltu6ox63HVz0RmnSiPR5jK8Xoc",
+	"oGCKFJ2oxaWHkOJRIlaMbdOuW/BJwQ7nrvhmI+1LaBpCfyRM1m0OIsm4bI6YDz/hbiw6XgTYBlx2ZDK0",
+	"4I7Iw20lIlechJP3RL3ZCKjAWsfJexo5rPO9rB7fgmDu+6IlBEMureeo9LWVRK3sPG8n4T56jto7EbCu",
+	"I+GWVfe09pCgiavRpHaTS0RV2Fw3FYGHhLGDE0GYI4E0VeFKCJr4hewEBbcf2I/rom2uP9hcHRfrageO",
+	"CoqKuPdGaxw4dtceO7OPzINw5zzZbbAxokoDBLQ4q8Fga7e4E5tqUfmn9rbTWlw/2d52UtUGTuqgddHW",
+	"dPl11l6R65fzkG/45W43l1/366HIF2wXax+12o4BXA7xj88kPfZ/8Ofy+Qi0E2F7bFAbVKWHfKhhDiJb",
+	"34xrdYdelnH9pPSov8PXQtof1CSKTLKJGmkShgWCbMIMkXfKGoxRKdQczBFYjaZeF+5KLImtvLUZd/ne",
+	"oBJL0naloicPaqsaOxG0zv8wYgxbBzoqTL0xdOZNn2qBwjIBPEVKLFI9R/5QG1RlVkAExq2hobEdCE7R",
+	"mKyTbfo0OaLXgBf5ekhFkgr/MJS0/z2g9bN/h9/Yce1bqYPah+Fky6CSSLS3DapxbXiwvS2BKiSjcxt3",
+	"Ye/3zFzBej56YH/n0Jn2trcPwv917f9T59AZUUdULmaM74Basp6Poqet+3P0eyr+S1xK113nZr3rrDh9",
+	"J664JQpk2uQ11h6DYyLUhHPG2mNw0GCw/p1y8FB6ez5aFREk+1OvZbJjlCzBqMl0O53LJiF2k/2pv2v6",
+	"yfdZqFh1F7ooMkTsiZTWnc8t3ftY4/di8rTJ8nO8ptWMnvpUOStIRpdIfGL9ET4jTROqrAEtSGHdWv65",
+	"vDoXWOdZi+OIXfypNTrmaudCM0k4QVtLsLt/2Lbf7RxjbTWjdfOM9rK2V/G5JfLBp2pfKh2vtTx3YWvx",
+	"5nbhZ6yqkLa3uEwtV0OTfuO4qhXvXLScLXpbWhcmtrMGGPtK2xcntuYvlr+7Cu0eIDiXtKDxv0A1Gf8s",
+	"RFVzNOmNbFFk73455rIToYH21eNC2Gk9BATYn1odCPxiD3uOkn84GnSzzbSzmzkhxFuClsEkhD/6VM0M",
+	"J6IXmHchZcm6+BA5sp5dKd+b8TdWV3EDaR9PG67PbRoFLXlKSWhxW001Si6XmgjzMtLhZg5occ1L/SqG",
+	"B90IAbbMvfjXyQ4GvTf2ZF8bkXndbeUbj6G2ddEV4IQ+Yo60eHcbdgOi912JLkM8f7wtCo0i0O9uo17N",
+	"JbYvtFLOcK60xCJVHM8BskHenReHxqgweSBIj7M67X5surpyxnzQZtQqxlHZWqua796q5ss4tEzxXp+C",
+	"vU7sDOAIrmLQtZblxnDwqkoNQ+6hKDgQCQHQzGLZHDBxHW59iLtwtge/q7VvzueOUU+2nuQpTVequl/O",
+	"V4GbNy4+gu47qIltefwONEio0wvVuVNnjLlsCyPvPFwLI1kVzTkBUe7Qz6oq++CgA9LyA2Sjsz5N+URn",
+	"afGYvay9YxZ5zoeZBzdsCroNLzEQLKhvVLPfJfou79cdCjtlyWZyVyH+9TvcopkMzV0tX9rYKr7i+kxP",
+	"BnaMjLj5MM+jP2FVZwJ3rl778z5gfxEaOsk/1utFCrpfmT06tHbQ2Gn8tskCwgOaFy5BAAmLBHbjgrc5",
+	"IjEFrTjM1Zey5ZlLW9lRHNfN/kQNnCVr/lL5zi9mbgTPDl/SXxoFT+gzXxduxXkbOHPwArj5XoQsh+O2",
+	"A+Kq4Swxvpydr9eA9LeqBZOCWsQvkhBHvsdjSleQN4T8oSDdItNd9zEl5wF2dfCCcR+fUtNpLR5XBe9n",
+	"GnPv6WJSpJVS0BOPbd+Zte7uj42SP7nDAzAdfiP3+6QUNHFIEYph2g9CEiSfw/duSsOzeAHlR348fIWV",
+	"Q5zIYZWeb1+cfIN6tcfElkctqb99MLSJm+csdUpAzK+7267RfaN/XBlD7wG+5xrxqZV/eVW5TjvVGYts",
+	"qJm/SyuovKB/9cNztoxtxXj8nTX6AMzqRW8GClNaWG2ETkBW5OeFd8IYbefkb8zs1xJR0IZSkjaoDFQz",
+	"juWqRhx3Sourqcjj3BmtGrRgwHunc4Ylt/r0grGxB6JGSp6kLJnKaV/Ynb+qRl1X1g7bwnA6Ae3zE2rG",
+	"JXCo+OM6lyP+chswxdXBuE4hVOSgtXjzyBQupx6cL8Lw9+B76TeV01JuB4FEiOCSdf2RpSJWByLlgACV",
+	"JY3/5FN5TyE9t72MjMOQnAfiuzJ1qgzBJaVNQmGddVpcbdGOw/Cko+E8K2+IV9Miter/brSujDgPzp9L",
+	"/JiUfgMyWopUjgYuuHr/uJfCpOmLrbxzJCZNYGRv0jTGWznc8gnfi4z1w4ORgYLLQ1z1tYUIcDvadrz1",
+	"xDZX10ERsgehkkC31rayo9aV7yFTlQtAEeSrIg+Ap/6YIxSLBSzsQ34h3NGjC+0FskW+SnK/3s/92hnV",
+	"cKizMxgotVYqqVx6jl4jHogF1CupQzmSPVCKxCHm6y50RqD6grMFc2i8E0nVjVQtBCX7RhqA84IjDDkf",
+	"DMAw658X9aoy+vEcOcp6zhKlIA7vESNK7keCXo4R46SinlEs/7yBfa04m8Mam4ZQkydQrCCoJuGprrc6",
+	"33JVUDj1Ruf/+7Jr3zsnvvoq/r/e/OqrtwJ/fuO/u/e98cZ/d3O/+3/oP1/i/vf7Tti98PedgM/RDNLf",
+	"v/m/3nzzv2HQf7zB/+U/8ESOX8G3/x5yLbX7iQUMqtGetdqiYVpO5yZ3OrfHTjl5RiSlT+C85GOJmDOT",
+	"X6Nmh7aHmvxYL9Uwq7QFcDYnYVoWSlGJK2CAhYJ11OYMVTy2p34D9jeCCuzkJ621hyg1fWkZZVRN3sD6",
+	"YFia1VAqo6vpDyE9YUVk+Co46027DKaNyd4CqDreaFpcehxJwiKAkx72IR0QIYMLDyQZXDYkIyZ/1ZSQ",
+	"xb1cGpOQBQvY5/vMt1SXF3UE7/d6YZiEsc4vgYyR3E4lkOErGo5rqSOpuNoXFIP6y/PN9XG2we3Zp9aP",
+	"j8FlA+WL8xeJmsRXa74BlYgfoqBmY8G6MFG5PsvVu3GNA2BOTpvG91Dy8HuxO0pR+hAKDh2ItcdSQxCT",
+	"diqV7tXQP/oTSp+vcwrTbbRDlm/ep5lDO3rIk/vBYXFq6E/w33di7THlVFfY0UKzAJ2HqzkX0MUJpReu",
+	"R0YgrH10OK2E2AHcWAtS6Q0zP2vmlyoLV9/0WV/eZQQbCU8ZdG2j6sTBL2zDrNxS1T2jHEInmgZh45dH",
+	"J7DFP34wuRUKhfEhVMEbMxjk8HtEqUeqOIxAwCsOBidncLOHIDMsh2lSwxlqRsru5pGJmgijbZvtWDr9",
+	"GoaJ06/Zqel07CyOvQXKlrAkVCe2ulJROZYI63UMDh2ka3cMHjxl//ubU8HcMTSX0bmPKjMav3CkWcXV",
+	"fgWi/mNDae2UoruttC5tG+qRU3WDrTs03JvQED1Yo4ugf5TcTXro7wk5uevog58XReGs0zKWfKAHFM6H",
+	"Eobb+UXr2hy/kO+EWQN/jKoSzt8A3dz+gP4yeF0CEX7dwO8ZpJjwQLURSayRO+yDTV7kA9ic1QIBrLH2",
+	"GAEAsAwYFYBHzrruu1AFz1mzvtSQsncByX9hhe9UHXFN/YPUgLwd2gmlRGpA8Nh3d3dwhbPM4uqtlTs/",
+	"osqVNBmt6oZ29AzCVnbDg1G3lxvHVd5c23N2eAg3WSdx1jaCjw/sOdNAzQXLvYY2As7cVS7h36bO/G36",
+	"ObaKkrQUzCxc8MldpfC5xYATsDACVC6XHB6kbSWnRQFMEsQmd00hOwm8MmbnqRqHwy+gugaNBC9Ce1Fg",
+	"LGOnCMC02lFs53CKINHVQCTqhy43NMzOkXvrMFRB2V/8aUFK+exn7XNCfSn4S2J0rgMS1Yg1rsz++vJD",
+	"/3Y3sswQA0mEo24jdsTWRbdy1uRjbJYP9S9YV0bw97+/GNt8Nf77i9v7O/cf2tfZta8TuXm7DuK/8k3y",
+	"7A8+6zrY3dnZ3dn5H53vdHd24leS88+H3uk+9A7+M1iybVu/18DvxLuAlCA7G2DyMX/I2vKB/GaNZI0P",
+	"ygU2rtnnty+iZK282noyx9bdvjFuLYzz5oU6XM3v0DSrunxjPqM4LKvJjbkC5O7B+Zqs6H0V5YXVpJ4W",
+	"NkXyVNYt8oVAWfapt+Cwh8/4f1uwvpu17txnHfQkorUjBRA56y4LmNagmqEBvrY3lmTBthHQtGnJtm+1",
+	"oTYS4hlmasUTiljRh2drTz/DOiN0dNtcXfY8AVdd3PR1SFEbkPTGIRFVj5S2Hc9QGyDtElmiWuT0tI/U",
+	"0/VKV0Xqzo3HuEshjdlCitT27TsQ1ju69dAwjSWPwQ4Sh7RUMqloaShs/dsDa36h8mCZNYCGIPOnZu4J",
+	"YOcYon46G0wOJjyZ8GDOtRotptDhjhdWhonUqJyAnMb8OSAgOfYTfgxEA4rLpTuO7IMB75MtV6WBV3nr",
+	"3oikOtVRCsoLElZSLW2PTkDADWs0yH9WqIzM8fKFFKa1Y8eKkPOwUpkxKlMPPME6zsmKuLILsUGD/ZpN",
+	"fLCzE2jqEbDjRVHWZH1KRNk5WCHwsj8UyWb6tGHVIkZYDlHl0RqFKU38uPTEU8LG8cLAmJO7SvtZ4JZC",
+	"nICilfNJVtwihSROEXQaM71FWFEB/XoBGDFxP9hGLakFpwm5htTp5rgB3BuWoP8SzcbkdDvyGMY5vw/N",
+	"XI7elQfU8M9Z4XQR91TwLO9/5cWGXHndqqIJQrAD+HU929vVi4X32d5jqdZ35PM6NL6j6pStiuPONMEq",
+	"N9GWnA3kyKYCQF+nStW7AHVHpV83NCROXl1qGD3mSHCsLgVCwNkbnT62y9lfr3Uql2QWVxD21ScUcRfo",
+	"zhFbUw3dofHHIDyt6u6xAbm/uaubG3et5ZtNzICOKXrfybq9VJlbGp28BCamqk7eVG+9MLCBOaHKMh0C",
+	"CPIvQFoGAncAxA6WKAVNHCHPNbzMg1ukOxfxBRdNKDqSSvZrA1VCTNgenIRllMp3fkEsyAkfQUVP1K47",
+	"Lpm4hLO/WLplR2Vkzrr8XFCVwQUVuoovOGoyDtSL0GozDuxaoWNJlZ7BmZRLfDcZr7GeDq71yuIESOkG",
+	"H4Lc4VqyHgT0lEcNgIcf9I6rio7rS1YHOQtM4pW7WWt1mZTlrJ2tyVUutbcucvr4da9G2gCrRZ5KHxnO",
+	"6KnBv6Z6ZY/voi8tgxxJsukrZNG/pnqPcgPdu+cnDTrCu2d0NZ1UEmTW6k6QjLZ1umZEddg1OposJsel",
+	"8qW6cyrJzGmhyWjr6ZI1NYnY6sorEsFw515l+TozcMoaO+j+DsNKPcmhYWEmfV9qcFAYKr51caly/cnW",
+	"4s2tjccQvosrSY0hB+r6enlk8vcXl1z11juFqcm1JM2F5FRRKAbdE2E+x5HjtUZ2TPr4VsuO8YLhMHiX",
+	"fbjjBbt3uPq2DRKpIty8bAm4XWlkqC1oyYUNEN/wHD3L8k9p1CqCDKutjcM0rIlfQiM1WLX9CHW7XZC1",
+	"pwkFGoFCONQyNZKPt0kAgGERBPLD6ASFzijgoGHl/N0W+CgeGWe1d/DCnOnBg7t47id+uuAN+19IRh6D",
+	"6xDVKXcZ/ojtuY801FoXVlB33egiLne+ff9CZWoJdzRGxWeka9xXd1+kFnzYA5MeI/CegokG6YJVK7A4",
+	"PrFWpVUYjUdmdwwbVM6QTAZA4KDEBkHknVDyplPx4T79b+rZiEqRdOCJvULE5Ft7IJZ336hn5Yd8oSSG",
+	"VflWBvbAoB4GaAdsxrBEWtG5xf0KlqFU1QpCktwy6YJerwI8DiDKLh89+cwDP3jSkszPPl07hdvIn0p9",
+	"47B7iCbANye9VWe9vfLtaWvyt8rtEeR6JEV/smAVWdoaf1KeemwtTx/CBT/M3FUTGWLnkW0n/9QqrFlj",
+	"F8FXuXDINOY3Vx+axnOupqK4nVHX/gMHD+07/JcjR9/d9/Z//umdzn3vvf8/PX/d97cPPvzoY2eNELvu",
+	"xolzh87vq+FH4Q0M6/QVQW2XmXoa0shTBxKcx38idrUQcxo1vAY8naiAz+ao87y0ff+CtYZSuOnwf6TS",
+	"cTXtdSZHfV1RuPi8r1wUb29eSN3DuvNVnqnubfl1qrfnqAA+nKl3BcC8iDAVPAhcHaLvIe0I+/NuQEMS",
+	"V2DNV0kerCDAC94ZoRDHAg3XQXMhnQLKY23fvyDy4lcerdmLuYpH4xp8QftH0dLoY+M5Vl/4Zbdv/LCd",
+	"/REJ1UuXoRfTdJUBOfbVCAzV7bHhpPavYZXogyh1wH3/5GbCLz/zMULP6q6/D08hRAH+Ajhu5AtX8f1X",
+	"AbLq4MUdRQQzpGZVZSAMt1SHqxJocfZors0+6G5mJDqnSNTiw7gFa3BqKrcNn/k87Yy05L7hjAqNRlH9",
+	"bRR2nDXUwSH9LAqYe7QGw0R5ungg+gX6WCijj6vpU2r6L8NaIi7VAtTlcEpxoQxuiXONBvUV8b5x3NH7",
+	"qYDKtiIhmFaRHyFwCdzgFYxks6x2JlIEkJj7zcw/YAvQ5CbWVsGOSKw8WuOqhgnkkmdnp3zPjlrEviCH",
+	"jHJaF17SBTggtHMwF6Mqus+j6pCajKvJPk51i3CtKk1jcKHnGE4d8gKvxIWHlawLo1BvLeBOk8KacJuv",
+	"sPd8mtV2oYC8Bu9edxdKDtNRbYReBXB9UBtgmfIZUgS/Pdan9J0UpxNnfIgxdDcuOuV2k/oGmKtyStES",
+	"yC0YOxF208TDFciD0MW+pyr6cFqNeqGnuv6e1nQByE91tR0+1gO+1FXTmNh69QKMNgXs/YSQn0eQ9zwu",
+	"uE+uQv2p/YK59+O5I07mpoH9sXa2f3/A/DXVW09UL89kcf4eDZksWPNPylOe3trR0D6hZPT3tKSWOenT",
+	"t4asWiSr5q4SB6Qx7X0wSj8X0apgapRdFCdt1baomMJxmTi6kK0gegnbS6bDfX2qGlfj/iewL6s8dsW6",
+	"PItPUCXeEYp0As9zhfy+/JHzQ8qSqkLRvuF0WughwqmczNBanslCRFYJ1ctECuRj2p6BizaJIHgTiq5m",
+	"BMs6pVuhXDBMY76+i7vVUAIBtid/UEtCOFBeexQwJlKgvIbv+WyHt1vLGHclKcj2QulFelm4YutU4aBS",
+	"OZH/WoSi3z6qg8Bn2c9JofAZmcxCtW9TvVE3ZHN2wU5sYS81l5sQ3YiG4c0d0AVKcgAh+mFV4ws1rfVr",
+	"fWxPUej8pNr3zXuKJg5WcvdbWSUJXXaVlqItorJGeX5ma/GFFwXN3BJiublVM7dArG4S9Upga2r8Yzit",
+	"qAIorGa7fKpapD9IMuLjBApEzP1xUmYwpUlLsZNK5uSHWmYQhcaI3h/2CwNMlgVaOmbaCXLm8nFWlw4D",
+	"iBb3BYTj1SljzNUymWFRYtfWw3HTmLc51NTk9lwhsqtHgPw9aEExyWYyaFPhKM633q0VgzMBChDFrXro",
+	"PX6vCXZvHuHSDxRP0oJEZ6Pu0hIVKyVC6LmRzdXl8o1lSPcZQ69Vm+4LVClCPQRB25p0tSOGnKFF5BSf",
+	"88bz05dMejiZRCeDOJWhhIqrM+EtC19TUD03hE/gGgFV8okA041rdQ/bsnHPRdntDt7LIwsjHEmWj7E+",
+	"YtAYrrgfzl5Km6sThMPkRpy8n6KE1DMEIj3i8iEtAAJxIz7KL9xt9zgUkoK5CJUwoHvi/qsWXNxLiE8O",
+	"89yAMwvAISn4Ko3FwG6bfkwW7/hvWrLWPftDc4DmrJFrJO1E2L+/UNKaktTJr74gXUZcxw63R3An4S6C",
+	"oQ6PE+0UgUUkgjL+Iruq7MTEmlpTyoQy2hmJvt1c/I4l9r3ae3ejnp5WPvGUiyh9/jn6aNFaeQUZV9MR",
+	"MY7tP3Arzo4KQRshZUAGlW/TqpJklQOC9mj7TckoZ1Digf0+267WkelIZm54h1OZbqVI7Kt9w2lNP3sc",
+	"DcdrHEbtJg8Piypd6mnlWNuRVCKh9unwqixxbUYXvHVVg6sxOhN58e0uWBcnKs/sYAOWzNtVnn6AqkIi",
+	"72DRmpwo37wv6oyhoW32pVLfaCqlg+5YRs3g+ge2UjekoSiT8+0xEjMpPq/Yz5+7Sg3KyMSKNBvc0DA3",
+	"7jgvUglfwMCnziGzW0sT9I1DQOE3zihAzgTKVUM6kqOTVgFCBPi0a2bWLkqBXxztgQMjvBdgTTy11hYC",
+	"gQ84CLYpVUmrXP7tSV0f4oDNR0E3K+CRW9i5gjcHqghJ5jj+1DQWnPn43POJz1KPRCC7e0Ooj9qevx3+",
+	"7Sq8JVelKiIrXDVU9+QF4n50e/4GcZKq6O7wX16zWyOFw/f6reFXiejW8F9eo1vrOfp6aA/wtJuH22P5",
+	"FWhtxzV577vA151udg2ED5WyEzd97o9LYyUBRRkuBii4NjmtSI614oJpPLZrr9vzFuFFPYJ+Hz6ZXSmd",
+	"D2lzF30v4Krk7RAqCldvrLAC7SX7foLWq0aRpipDFKi6DMvOZiKLuGMUV6SzuSHecOhiO0kE8LI2RS3A",
+	"BgM22Z+KAlfi9c8atBcssTY0O2egbCBr7BBgP2QV5MKBaleb21x/sLl6GcETVx9F1hIDjI40ngkUe1xD",
+	"zFh5DY0SCHYfn5YCW+p0C2J8j5ZIdCzuYtXijxxgP0srQx+qg71+uEiMsh+jv7btf6vTpd8CcO3KwyjP",
+	"xbgFGyvyEYp7CtvOQ8FfHHOMahYpfbpdgQBspDFSUADUzkx3R8eApp8c7n2rLzXYgf6ua7radxL9c2hf",
+	"H6PDfRmI9fB07HebXdsgtJCmGAr/yCJ8Y/vfOvjWfjRlakhNKkNarDt24K3Otw7gdKCTYPHtUJDJt8N2",
+	"AQ+oeuTg4KwRHmGUNUiZGpTl9Ah9ifiSdMDqol8YXnkmW3lG4ihI0qdPPwioNwnRnSsczyvh4FFnnDCP",
+	"IcgGj/2kcdQ/VdXBSH6cum/TJK0TgLe/s9NVFUsZGkoQP2vH1xkcX4Pt9XLRPyzo57xkABjzuBf5gEJc",
+	"dQcq/rtp4Hx77GBnl99u2PE6Pksrxz5PKsP6yVRa+1aN44EHwgcCvN5DPRbjcRXWO9TZGT6sJ4kLbWA4",
+	"kGLsnNsi1v2lw2Hx5YnzJ1Bo4+AgajkYBh9ERQrqFfRlDCggdgLNzagBnOT7TrkCo4S0YUcRumNBSjh0",
+	"IBgrfQbhPs1m1jj6F3RxJLnZ0URUHKOTX2e/J28A7jdU6hX841Zw6hTRGnyigPyDiOjo3NXKD2tbSxPO",
+	"w9KjrWxnkarchZr6Zg2mm0AyBG6bfonYmzC5u3G2bqQsCoBDzDGtDKo6lFr50uMTJ5cIFg8aSbC5enn7",
+	"1hWchM31fXnlLte7BL+2/fddnZ1m1ujkf8XnozH/O13UR379a1iFLptEDIGuH2vn+ExQG0pENY1jYgIA",
+	"C3lZIOlUydTqdwzKfbwbd/tvC6xvBdPLvBvcDZ57sPPgDoDDl5sVgNhzOS6sS+C79LvLHRIYUkgYJDn8",
+	"NSiXqmaNLm6+vOZ8fQW3s/LVRGpWQqTLYAtSlc+3Rz2nJzJQkqS9FUUigNQo1F31qR9Kup9ZbrxE5/RC",
+	"0BcZcTPzajAQ1Rp6aV2+3wi9GQVxAbrGcHCKmtH/koqfrRtL4qNzzp/HITB7hiZQljuBfNXUgGu/4Jwi",
+	"aSIsNJfwLFkvf7BeTDqcV9g9xWuJWcNpC4DYR4enyrcnhJSwaQKWECClQu8WY1KglOo4NwyhYucxl0Ah",
+	"0tXxC1FthPrwi6OwK5tj7CVaplBp0XKLlmujZYxJYiHvfKSKNmp/0oHpvSd5TEFt2k8AK0BtivfRhslC",
+	"pRXvo3xtYvPljLsDsVhLpd8WOD2f5aWUtm9NbN+/8PuLMdYWAn5EHR2pki3E4vqZ7mh76SivfE9f6Ore",
+	"+vzD3jll/Z73wvprEc6Gs4k2X05UXpYiHq/Tr6+S4AS4sau8hcJzBIJmKA+oQFISPFHsou37bR7PB0iF",
+	"05LGK8/umbnLpLYAWWfElY8nOprSp6fSjpPJRWz7nJClVUQ/Dd8ZpeYzkdIUUoeiRHa4j5h7fA7HUqqq",
+	"PqJ7hloPqivpAVUnGSLRDvuZPTT8wFVhJz+6PgfFlXLZMUMSNwSnsk9AePzm+oPtW4Jm9Xh7XoHhs72M",
+	"luxTxXsLrBMcvkGcc2hdqn2Pw0ldS0TfYyONrbyAY9VGBbqa6+A1G2I4mM16hCVWThfLd+c2139FaL+W",
+	"Bd3vlutLSKEstIy5zeNAE6IJr4sSXCNPSxI3GuREFrSSiWzzfJcusxOvQrKYzMNQeLq6kpZwBa5/YrMS",
+	"jyuqqLiHTbHeK3CZsznyIPQQYJEV9lGmFXenw6ypFDcbY0/lmkMLzald9cMoexkpmmK9AqulKQ7CfjSF",
+	"YsBoROcflqyaO7jDFzOEJMgLqA52TLRPH9IkNZsN6OD8gpZNc6yIEYQVuNhamrAmV9z35VDKKUa6rpRh",
+	"Ml+21jevw99AEpBEAgYS1CH3L5AcYeeRZI3gg8FAML3YwX14m1CJwyjIuX64VFsM+cZwLfcynEeIz4mG",
+	"srENVMTpNvr61Ezms9Q3qpi7VY1j8rzPvYLz+lmRU2xpDUWzPcX1JJgXuSdnnMT+dyTYZSr1oZI8S9Ar",
+	"Uzvbsxmb58qEfMXL5IBPuhgdjVT1Ucd9uQXkDy2ClSGof2ZElR0KvTWe6uQUCSdhydKTzzR/YHKpn7R3",
+	"VGMIl/cU8r6Kt4ggzrHkxkDXJ283EirpPjXhRS5MXk33Ir7MGzKaN7Glme5uCByPO1AJX5g36/Jj8j5R",
+	"et+OWN9miZDzxU+fh684YpoDESNIH0g5H2ORZU7TyJsdMga1Hq4tOm+YrStU5FYRmMDon8UmoBmEpUsj",
+	"sQ2bYZCeaMH2NL7FfGOepo4l6hChWFfORGBUY8BSizO1FJe9orjYvAxQN9xoyD0dOnqHk/GEGtHTBZlo",
+	"kNoEOVTVOr7+QtbeQfcXXlLKCSY8Yx1UH2zaLU8/KN/KIe7OxY95QdvyibU4TVM9kYLJonF6lI/XEWfj",
+	"Qwu8/ATtPVUsf/eg8uttvlPS5ovb1v1fgPo2oBcJ7Sgrchq4jvnGt9oQnzX6JtSkmUWOt9zVzbWxyi8j",
+	"TpbnhFGR1j/wLlPka4RxGaquPh0llv1Kkl7z6+X5GRTQkLu6nb29bXxH1BVuk7jm3MufrSsTm+s3AQ7Q",
+	"GyQ/Dl8tEDLOGmbeQD9CR0rIM77P5xo56/eTRoX47MYK62QJyfKPzNxDcmy+5HnW2M7+XJ6Ypt/buOPS",
+	"wVHkLEm0jeCGIQzdI0L211sDpZIjVFJQ8JQoalQvKWzcmvWBXUsotIRC7UIBjT2wE+ji4T9GCfOo7Yln",
+	"CJlJRRh27svQ1fQ2sCkO/3NXKZF5e+H5gKfOcg+vX5WqzX6HOQqx34uVb9GiqPrDU8M0FglfrVLt3gFz",
+	"ojTL9EiCloNgjzI6x70GsTihOkR7MI80Y0Z9OFPYEaNiu+wQyl4cmVLRGFNHPHU6mUgpcf+0KsKHC1ap",
+	"wBpDed8I5Vu5zz/9ABrIL0Gl1HnQdnC7jUhs6yjdkYt9Heg84N2d/0aYlibJacLO4E13Oqkqcbjjc7EP",
+	"UnbdHZvi3OH051vMrMXMYgc739kJDBC+WHgi3ov8F6gSv0GhBnP+0p5hwaj7QjQjLMOvSb6yYrWm2Pdh",
+	"/R00xKIF7cSe6nIShCBY9JpP6me7dbqtg9Z8rQy3O/A2dBfgJHGdtoHLfukB52U13kWhp34BxyTV0BE5",
+	"6rMCV2c2MIa5GFZXfpHz77oaVMpIg5a5oir3f/s5d1sDGYESyFN3K1ZAuFF7f+JKR4FhAe/j3tqNDg3A",
+	"LH5n6xjVUbqUHBWL6xNWgOughKmLTtHi4xZo0loprZyes/L4FM1+CDpixzncqOt8x1BCOZvpgF6pEZJ/",
+	"xqFPC9nI1rPfrPEp8EMXre9fmMZT6+IauaXcOBQp4dqz2Ltn46AWyuL2jR+2sz+yMCVa4RX1wUab/CA1",
+	"AFUCtjauWxO/SKQDIuo9hgdC7//YDmjsGKocN24IdxQcLlJeT1eDt+KfaM+xoWngbvOuSiv01lGNJGt1",
+	"FTJycHIiI3rEM3eNM+VnCEXKpcI7mFNjd8pSmOwG8sasxNaqzQraaZ1SwAz5dgAOO4qfCzxytawdS+Zw",
+	"sFJGE5gaOP4+YNNaZB5/jnFRV6qHKEmDI+qd55vh37OjOFhtWDYJGRU1hWRPqEF/CIOqR2pEIOgGa211",
+	"ptAOFbcf93vNBSpklWe5zbUL0RUyNg7qAjk0MAb58q3c9o1r6K8Xl6zxqa3FsUppWubVyHGUd6Eh+R5h",
+	"Kg163TrBET0tW1qvwpfq1avKd36BoNEm06tyI2b+e0DvOdpwqKVp7SqX7Tkaic/ujuKEsbzeihPpHER+",
+	"8wX+Cf1ByWRUPcDT4mTP1pXvTeN73Ay2DgGcWYMEcGYNR6ymO6lkkRig78L13IP/CrxbuKCF7ZpGXale",
+	"odE5A/VkFdU0nVkikaOc1k1+YyywHh1bD8G8bow7mq+JO6isOENESbG+UGcTuZLD+DoaWUnOs1jw69Z9",
+	"dWD6X6ehxc0boeTBzp6j/DOr5yi7b7/D9hzlOHZE893u8W3H87FqL00h5MFqLFZQUb+lpvGX1XBWxMyc",
+	"x/V/ddu03Tz+qoD9io+FiDiH/mssSJ6j7kKQthWuylwcxpOQBBj/aXeDzqKq7A6xHB4f8Y16NmJ4hLXy",
+	"ipCAuD939FCJY+lUfLhP/xvaSlSIDrGxuAteT/ITqMF6/sROeMXsndch1MIHnPWNrfBZpJUO1+R+r2Ba",
+	"C61w08BUMaHdRbpOGVK1hWezUxFC3VocFVbPPj4aHgzgHV27zjt8EKByaw2KB1bNHegELe7w+nEHv2Sa",
+	"kLJXoBR0nLNpA/0OFfg/pWD3SMOVHn5piWTV6FpK7qp1YaI8c8m6/Nwq3JDgMYfJ8R28pmFva543yPMC",
+	"55EkOYIjfsZn4lZS5N4KrxfeYlCgYXn6B4QwgDwyRuVd5Wo8mteHt6XVU6lvXh/ONv/Euvz8DXyoNyV4",
+	"26fwZVNzNjhSi6e1eFpUnkYxZ7rZaoAEYnp0toY8tfsyuhLggsFALc/MIm+1UTSNy8gFkxuvXHqOgC3v",
+	"haFxitade9CRZZGFLcLMpcqzJ1uLY2g27K7062rvXhC3batcX9+++wOuKAKOGNcVfZX8t39ro6coUUwp",
+	"gl8FpYR9ldzXBsGbKEUgGUelRFbnyjeeczhlmyp/f3G7MvnKmlmkMZfo9br/ID4KtAB6BaQoOBOgDzkQ",
+	"tybqEcSjL1uH/hIfZxYH9/HLOjcivS46o/SqjniG2g/rB2Df9dm9hS0Bt2yN/Vr5ZYQdcgVWZf2j6Cko",
+	"p9wY3XpoQLX5HOswh8biLViTK1v5l6axZKPOTNaaX9ie+g2a7FvPf4FfL5Dl3Rs0VtB6k49N4zque20V",
+	"1qyxixBkwmoqXEaeyCsj+MvfX4xtvhr//cXtroN06ErXwe7Ozm7U0X+m62D3oXe6D70DvQ0JPKzsvE9t",
+	"mACvH3LiHgfK3wFz9JCa1lJxiGpl5hLZUe8m43Uzz0rkKthwkU1M8Ny5HRviab/4GsWGVBOAsYdjZHcz",
+	"dCN6QVWq/KDoE8Ik3KZeyUgOiKiLkNFQMHNLZn4K/R7KgW2uLgO8HiFIsUA4Y9bMGkSsrI5Bt1V7w+Qz",
+	"Z/zEdtbY3JjDbxhn5J11ZYRODB0MV7x7so3CiNMWTOMm6Id0WMG6MLGdNegtshikle2LE1vzF4FzI1Ww",
+	"fOkJK6MFMV+zuJ6YJ7SehV045XWAS5yYZB3NDhmE6HBe1m+uPwDhGyTHaK0Ea+2haRQpBCGxMscyBFa6",
+	"Ojs7UQc9IscXZJNB6iA5diaxI7NLzVq82/BP6LCujLjx3yhZFx8i1ZY2MPY8+xYxLm/fv1CZWkJl3oSl",
+	"Mghft5HdVhRwWYGHZi5nZo3N9RscTXiRepcfuQEZI7kRPrKWgQ83frTmb6O9G9/tifiZvdxIwzd0lufj",
+	"ckGFYQUYJEssIKrivi3PZBHb9sSpQMfnS3VqKb6dX7SuzSH2PH8D+PE1u83V5COSUYokCmLw1uhP2zfG",
+	"aaG1wlAa3AVciMwKtwQqvAY4XTRzuQhxfrSaRGBfcsQMud7BdsMwa3QRXxufI/RVsl9JZMQDyLXb0r/k",
+	"arXvbJNnXw9pNcy96Ij38SLEOD4y80VgeyuwV1tQ8QvyNhvXTeAdGwveRmog+3/kBT9ewSP2Q/uPKwln",
+	"517ioO1NpRKqkgzrme7E69qbwXPz7WIneP5Ue6UNvN/+gWv8CNplMVKnbQj+5MZK99lmlS/QBE9h9D1q",
+	"RLhsGgvboxPW2DTD1a35i+Wpx3QXC5jLOH/JS5sVEtWcu+TEG8dOrZfX4PcrwHDGncUOrntcBaILGVCT",
+	"aWffbaloB8S53kdDUSt7T7hDe5BcsK5MIP3Yw6AQUxi7QCt/3Ao+j99tkslrbZYO/8cDRT2jDA4l0J9M",
+	"4yqwzKxMN3JiB86vwH8vibmyFzb59a2ln8o3vzPz65glb2VHrStQmhht/zZwinGUOiwHra+SlUdrlVsv",
+	"efTEqIfWnLi5teRqxW8HDbt35ORjbCwSerkslY18/ZZwvuiCUQQC9Lm9b9Szp1PpuN8F5r83c2tmvihz",
+	"gf5C4KFpPEWl6CPyGrHiY5S8uo+z4H1RpEUhE8CR1HBSR3NT5Y6a8UugX8PorJFQdDWjv6eq8V6l7xtk",
+	"/bQXnkK6O4Y9K1TtXn4hhI1kUmknV1eTiKV/GetLq4quxg+jv+JNkGhWpPbQ7bO/0Q3GTkjcja9iRBrP",
+	"+5GHi43m1105JpXF0vbcPVRFHHTAysqIdednaicuYcHfr/Speoa+7IKVIqbeBIMQTxmspDTSzEr1Ubnq",
+	"BjtQLYytsEguNHe1vJZF3xi3+M/wa7JJ/b5LYRnNu9x7lMLRP/IUvfgCOppTPjEZVmqJNixepKUI75KH",
+	"QX7M8QJzPElWvkriNNzKbVQhPnU6qaZ91E2xSaxxvdJh9gY3SqdrBNEhhXXV5MdukM5EjK0OGmvy8Iql",
+	"4NIGVcZU7KSjwH2hXgpk5heWvinf0JTLbivxhBqhqymjJvlU7SbuZMpDhGPW2XnbcN/oUJ0Gn5CwW/su",
+	"CPNcaPKaipxlpHlLKSJi+Ph0UoacPc1SmUAN7ZTqR7dVNOQOoN4dk1TVti+Q1xibREzVhblIOBUQ0FGL",
+	"9aZo3bJn6BZB7Asto/VqCU0/G0LA/r0KbL04kqvVU0BPovWpBB/Y3CgBlsmVqYk1uO5Lo7ud0muU5jgU",
+	"PNX3mIIJaF3SZuY4HLXtfjjyTus4g4qW1BUNKTpZgyg8JYhxmQNT+gKYKFv6Tz346IcM1uFKkH/vVd/H",
+	"TQeYDlPpsAjoMBYJgTxLQATzuAVi5Ix2dNojdDc1M/zG57Fz+5VLZGcPQh9QRVTZdrC6h3vDHsUY2X7L",
+	"i4+2b10Bn38Q6b92VF9/mqdUIK0/haKUixVQtA0wOMpQfdG7rnQFeGI5pDupA73XX9P6PKOmd6kisoO5",
+	"RGIm0Y2V3IXNBk7cVOoXZ8Gupz7mQHxn4A5OjgqEkHsFM5d7vQxbuRzxQxgrnBbYMnftvLrnT/i+zD5A",
+	"/evoG87oqcF9X6d6A8LdfSpaLVhjjyDrjMRWB2/SzBURZaIf71NX/A30uLbj4qTlxhHY9V9Tvc0pQPx2",
+	"u/tCBYFMyGNFd2OU6N3INih0hDgKp2wi42Fd5AaORd+aW6zMr5EoIp+DkxxbwodutcRFS1zskrgIpvaq",
+	"xIh6Bu88ogzxviyyhp5WjpFitPmnJHKLYo70w8Op23phgDLereWbQgsGej29Kpg5FOmICVsUPyyWT+8S",
+	"ODT1+8Zns8355LHmb2znF52e9T/k2+ePa3duyZRmkCmRCLEqIUIfISHZT2KRxomxIAs0RPIu2YVr7XGL",
+	"Tru2j4iE6g0R6g44de+9ZuCG50JNNm6a4SwAeS3m771amFA+VC0Qy2XNvEHUlkrH1TToa8PyT36xuoS1",
+	"JByw71vX2zMQGCTN4i3S5pYOYg1c2BpFiW5oSeR/vGgaF0zjUZd1555p3DaNeT503T9P3KvJDTs8Uh8D",
+	"lJpTjRPstIE5341wjtmIE7m7KV+3jJumiJJHctdM476El+R1U+7qQi/ivvstE0VLndxJi7aHMVQl486R",
+	"f9UhnNvZI01oiBDFewcek2/1beuYuJOtmcs5r1EmhLw+ZofwGlUMrJEaKAZfeZM2VfQJ/gpWa/4gT/K9",
+	"wy3Dr03IUYWMFPfF4uRH8Kw+mnTRenLPT9g2UYi9P6XWypDZcz/C+0OShwpeIR7zgLGAijEYE+XJO6Yx",
+	"Jmkh8HvbuPtMz/o5Ox0lCqRfIXWwHFTL0xv9bEFH26VCVTXF2vAYRXthSr5c8OfuaOdmj7sJFHhZw/1w",
+	"cchKAqqeo1U5V53jnVU9XKXrWs+UluBtfsFbq/fWzXkiSuK4pkcM+4ZVsTTLQ5VLXFSkiAUttyFS7jes",
+	"Ayg3ApcICnwBij6wLozi8ss8NOwhxgIqWDx2F6kHk69IEWlmHjKeuoul2XMIncXhexRMHOANgBvYO56A",
+	"uKbLeQFWILVntbo+pa249lZcu8t8KUCmKLyunxS82deXSvZrA9F5nqh6T/nRLNRGL+G+Kh2VkTnr8nNS",
+	"y1A+64UW4zmCt7a7zCAIH10bFdGTCEwEINU795qrGOmOMSdJrsO2tOMlwn15zo6yFIEK1e7sc0vQ1q+j",
+	"SDjKujiNXT3rfLS04miMBLEQqCJXvrPhtEuIE47rz0calLns3OguPflrZWaRXvrNWDO6xXRbTLdx71YJ",
+	"2vHnqkEKHDAL1KQhsg7H+vSJN/d0yZqaDA4Yy/2ABiF77ryZv0H6NuTXsVpNfjRKeCYokiqsUO3s14R9",
+	"iTlxP+Bcjm+YQHvxLMhok58wODW/Qsn2GlgTMfTWWhpmi9ntGQ1ThLiBeuZwrc9VvCT0HcuWx39CSXu/",
+	"lUxj2qNe0oraKCLPWpsE/LhLuxi8ogz4HxA1CCY+V5FILe6qcsw4Iku1YHuhahRqTAPjKjNGZeqBe9yN",
+	"x1sPJ30acngCpbj+ewhZxnFpe/faxoqLn/MT//5ibNv4zvoO9UGw7tyrLF9H/PzFlGlMVH69bRoT+MIw",
+	"R0Y9Efxcdw1hxw3xxAmY8a4q5rUKBezfLY//RNWOlqbeEl4t4RVBOrkoqCp9PVMfU2tAexvh59CVzFXr",
+	"nZUQEyVsiHV7W1jY/aOJOMjlRNM4W+WMsarsXDudSz59qvycRO8xSNYc9hFQc18MRdLsszz1WLpNSlzt",
+	"V4YTeqz7UGd7bFA5Q3qmdHa22x1IInRQcfRLQb4A/HojETny3U/YtjrbgzuhnGhwmAm7zshibVeSZJrM",
+	"m7VjrDNKIemA25LQ6+W6SjpDjh3t7MXskjY7dKRC+zIz2lgEhZ4sgkwNDUsjmcPsME2d30x3uYuJzQxQ",
+	"svSOZAa5xJbqunOq6x9QT5Q3ZbDNBiJsNE1RS6i11578ARSCaRqPdIkaZ+2OM8y0620lY5/K1RxokUYU",
+	"f881LCeRYvzcDm0wN15BpWyL5aeL5ZHJYN0Ozr5T8TtotWg5vM6GPVHLiftdis/0Zn7OzG1gLZ2l5WGt",
+	"2U9l3v2GGDuQ1dHKLquDluZlBH5GV0Qk9Sg3XkvpGwdrEZZFE7cQ5cdBrGJXefoB6v95YdRRtzCcz9ny",
+	"B/cFQtZiyRw0pnMhSAapdYPDCV0bUtJ6R38qPbgvruhK5M5AmKc1vjsQXUeWV0YslyYohB5+wQ6OuUM5",
+	"Aj3JU0pCi1OQNIhj0u5VY2ia/AtHDysRQ/pWG3KCZsH/TyXX5NBobsR6MoVMDSQT4xn6OLcGu0ZdaJHW",
+	"8OsoeEkIk6Mt/Zkv1rNOkenC7tPwFigbEIhc1aSe1tQMmK9YW1KWplSwRh+4Sha0JIsTFl2S3TyO66m0",
+	"MqB+MpzSlXfP9KlqvN6dqKJF94uYh1gw+WnQHefoRyTDOYLRlVvddUdOQRSljjvj/tKabapPV/V9GT2t",
+	"KoPRmfMRMmkD9VnJ9jhuHn0F/n0ZEf+u93VzXnQVWuwOOHb0tPKJaZQ+RjTTtv+tTvKMRyat29vGd9Q0",
+	"dRvYxbhpzONkIKfFDIrRlvjoGeC/L9CP+aes+e1fVCWtpn1WIDyJNaAm2R++U1orr6yNGdoGdZEYRXK/",
+	"US2rSDK7nGLCOcpPQys4fdrBeUp1gIWxQI8rROtCeWapPD9Tnl3nmTb5jbFgTU1uoz7Cha2HcEHEB+9k",
+	"47kc16ryEVpF1HCyCQSV8+RNXmwD8UJBdoUrYEVLqNFEkpONNfDp1C71PZZz7LklJRQ7BlVdaRLJ+CHa",
+	"SqM9TRGfLM7XxE5Jxx1/wbSk4x9cOrZESrOIFAG/2WMiJa1m+hTAlR3foK/r2EdEMY3tjSE1GdeSA28C",
+	"b1/HsZfWhQlr7SFV4KawaQJxAVQarsgLNlSUu+1IKpFQ+9CKplFSUJVM+KpkGhtbi0+syRUHxnLxMWQB",
+	"h0vOb8fAT56buWVgWpdpPe5H5ekFMNQU6WzTFVQt8BYlC2yZKW6+3HAu3oe6+/sL8ZwbDDbHA+6DJlrw",
+	"50GrplHizKgjYhc+QvusmXsIfyJuEc7fvgTVDGSbriOM+BRj4C7pEtaFCXoJpXLhIhRIr75BOntTMMSs",
+	"g+aws/Vhd12wrO9BIQNgjCY8GOLVYCXrQJSzL6Mr+nBmt1i4MKqfUlTl2ZXyvRnTKG7f+MHMTQL/uO3/",
+	"KC/ZAy//Wh4dR+8STyndmtj31tJ8eX6mMvuAq24l5t2E12LJ8XB7dALiWFh3ICZX+OYnfnzbryQWgubx",
+	"PiV5HN9g9RFEQ2k0v65h3pmxp/RcDV9q1g1t6gsgRx9Pq1+rfboax3D1L/elJlEs4pcxGAenwONiJ5ir",
+	"PqOnteRAzBm09CW/U/vbVC8aLu6fEL752PldkiSeDUWtAix4gHLn3RsP0N1u59CSds0p7fyIQ1r4DajJ",
+	"tFqzkPMTWM5QrFVQeJ+S2IXV70xjjEYVzNLUsoXN1cvlO6vAC23Z5Mfo38e7rxeDt4EhHToFO/gIOq57",
+	"4qckOK8fgHDdewqTHeLAog1+/Lcm4HJLzdToYUdYm4s6MLUhEDz62Xp5zW31is7VwKMyQWE6vZtpRj4U",
+	"UPltcfvOBRcfA2oTMzJtUBmoMlw0JBqxcn3dyk9WEyVaDIoSdU5fbaBoDz72TkWKwnKRQkUd0Kt/qCiB",
+	"nk+QKI6XKN/KWWPrLNOqFTPaihmtKWZUiNIuToUJZZfDRSlrkQ8UJSPqFSKK7q3aKFEMwUaHiRKG1vg4",
+	"UbZQCKdsWIiokFP+oV+2rSjHJolydGG+Dyv1VfrIz+jfkUMc8dLOq2FsM0rwhs2udiCuERaTCWxkkG1M",
+	"0AbHU5onmNG+0lagxm4EalCkeE1DNOjxmj04A3hEaHQGfCXLnmVi/eqj+Mp5zQjLr2LQce1btSf5CVSH",
+	"iDLuvVR6UNHZyBOSMqmKCMMAweRS4aqQUzsRZRhB4d2RAMOm1H9bsqolq1qyqjGyKjyIsCWrkqc0XWFV",
+	"cxtvqso/BIL6Ff571a/SFy6Eu5UdfYP0ECSoanc3ghDF8TvWq1Gn1KO/o0Eo/HpGoXxpY6v4io/ao7Xi",
+	"VjbXb4LPZcpTlZdOuUKtKihc7z/L0w8gMG12+9YVKMZeEBW8JNtf6dpcW9tcf7C5ehkxLmPE0256HCBg",
+	"AIdjTS6BLV4mcxSo5wCHAXLZoYFZMn7WtU9TCbWH3X6sMUV2vAtxZXYabXBznVDEN8nNRjW47Xpj0ZqM",
+	"ZvTUQARO+giSEhcnKs+u+EkJn5ZqAUuVp39A8OYYACFFxBUMQVKY7yKkAZxjehRya23MVJavw/pzKFKC",
+	"7IWs7ALUa9cTrtWkekdboFKZReXJKsM4l8qBuJLYljmUUM5CzGmYExsJnRvXAIcvg9dlvHLpOQIvt5ut",
+	"Z79Z41PWnXuoiIGxiH8s38rBwFLl2ZOtxTGkEyOS2fB5QaKNfaGmM0h0HHVKax6zQBE3bpnGc7ihkltp",
+	"RiXkxszcJBKpxmzNS88610Xlp8nxXevW/Ziey6aV+he4nq+3nKJZ/JBwDd98uQFPJH5X//ZvbfSeS3Tu",
+	"ImTMjpjGw6+S+9oyupLWTWNRTcYhnGqufOO5cO+/v7hdmXxlzSxSPzhSYPYfxNhgXZog+QsiePGxDtya",
+	"fNiqfSW/v7jtarmJa3Tyyzo3Ir0uOqP0qpVnuc21C3U7rB+Afddn9xa2BNyyNfZr5ZcRdsgVWHVz/cH2",
+	"rQl09eQUVLA7lMbL9l7xFmgfiCUbdaBM6/bUb0gJ7bSe/wK/XiDLuzdorKD1Jh+zJo1WYQ2UWxa8chmt",
+	"mjWsKyP4y99fjG2+Gv/9xe2ug3ToStfB7s7O7s5OMzvTdbD70Dvdh96B4rYEHlZ23qfUrp/J6lhCOXsc",
+	"GONOPNQYL4jw5hpS01oqfhxdXeRR7ybj9hutRptcKql+3O8LGF47tmF6vj38awITbtCJkGBGD2oVyss/",
+	"WqurCKkIE2aSGxHT7teoRIlZ38NLao7tWaZq5U4EWIP1I9mf2t0Y66CWvV5LmXTJyt2r/YaUlWkwH88T",
+	"3iSyEiGs/yA1INba0qlEfcKnI3SL81S5ZPYSxPFBAw0wnIhyf75KclMs0DKWDlvN1sZL6/J9t4KTNbCW",
+	"+8axj49/1hZgXnoTopA26MNu0jTuIzQwRjACCPvW0Vd8g60TgTaJxkdY+z8i6pvogjEia9i4YFd3plto",
+	"kqCghpg9/MyQ9bRFBCzi+1D3kKj37e6z2uZqdnNtDVYbZ3HF1FzIz1ekiAQTkBy7RbvBecsQ0jKENMgQ",
+	"Is5H8reCgDzt0NNKMtOvphvmlEDh5LnHuHbk5uoyaAI8uaJXoz8xF71olru6tfxzedVdVx9+Z42OeRYo",
+	"4GHMZ5A1wrbk4BBeV4P/UivU4ukmEBfL8X1gwvx4+H4wQTDBtGJdKZRv5RAKzhUgEVkqtBcwKXNSG/qM",
+	"3nPjJLxnrSYS9wQHShR1WnK+djkfQHL1dm8ELIVJzpZAudqmC6VdGXWhJcNbMlxShjsZU0ThfW44o6ZJ",
+	"IHZcTai6Wtu7linjFICBYW5HYUXH07H1oHsdGb0rTILPe6JvICcxugInWmyyxSbr/NSBnYu5ZaM9F5jn",
+	"BhWpy+Ccn9oa6hQ4XyW5GmpH/4kYgI2SVXq+fXGS1Gnj61Xk10l4Xn7dGp+qXF9njiZPUvW28QjWcWCB",
+	"dylahT/nmcDPmURSnxotGOgyQm7oOQUAFcHMWCQnihwF3cqFayRDiFbFwHG/3OWKo1BrzxL2p/aOf6EU",
+	"vyBNjBT5KtBmyJj8/eiM08EQbV8E8n4EtD1mf2ZX6atHbbEAFY/PY2wuimZUTLWUqjU+mIDWqpLhHK2S",
+	"hwFcRchMHEqp710yEplylfJothJRRsn/EGIlpS6FCoZr6NLny284tlSXOoUcl6MgWfHlY/CwsEaWwCbj",
+	"dvWAhYejP5+OQMZDpJ2QZSGXhYatMS8q6w0doizlrnrLoUSsDzusC9lmPYpnMUkjyUhRWoOncCGeRKZm",
+	"IQXprSC9cMdKZ1UnHOgZqhYOeII6CYdWIcM9IsP2VsHCQGmEEdgrjQQa7Skcg1ZzR1hnpBIr6yUKDXaW",
+	"j1rgK0jRCryFKB37SRidN4wxoP++b4QVASJ0qeODlj3BCEvwa1vcWGO/AvDx7xe4Tcs18q+mW3/oYRzt",
+	"/KOdp9Mn8Ul0mlR/f0b1Oc6ON/13IEVw238fwFVdVk22J4sX5/CCzWNPblUM2nMdd4NQ2CUKKMfc9b67",
+	"7pwWUUU133gHm+83IsqBVDKji+xAaiW3VGBBWy/7aEzzW8FSr6HDy68GdGWxtD13zzQWT2vJeOp0pj2u",
+	"pE9ryfavFeTCpYUNCltL89X22muOXn97yyXHeWWzBvWnQx+DOWjXsABHb7nrdtw678OUfAVPwFOkI6Ho",
+	"akav8UVSnskiC5A3bVKyqs8HsAm3nGmgsUOS/YvP1TBd1XfBva2rvlakT4ZmjcPHetpOdUElZpxWAh9m",
+	"De/lude3twYwNH5kmZghIR3735GQH6nUh0ryLAkRzdTOqYQsKJgwGqUBB/Gxc47Ex/MdSiaj6pmoLVE9",
+	"+dfYfp01iP3aFQ3grtGzSCzj6OruwX8FLdFwLanN1Wz5Vu7zTz9ANnF0z4tmzjCNh0IjDvsWQf6Sdfk5",
+	"rtpiGivqmSEtrWYO604zSJDt5jCGzM5wWLJYBIMArbO1Dm6CsWrDCHaaL4akE+5N1hk5R9JV38x/nF/d",
+	"JFApS/JxYDvSDVTKCuDFW0S24z81lCVGzgevgpP2q2q8V+n7pmZmCqzzR+L0Q3/Nw1NSqCWCm/CFmSsC",
+	"p71BWiYYpa2nS9YUqXZHMjnv3KssX3c0n+AMrtQvuX3/wu8vxvrSqqKr8cM6s4NDWv9CFRbw9xhUduUG",
+	"g43UQkDTUgrlqcfShvK42q8MJ/RY96HO9tigcoZYzTs7222jcwQbusNEjuhlCbZKSEbe4M221dm+i8Zv",
+	"ATIEWsBFt1Kd+Xtv24DrmXnfHBZgP+aGb1fc/ZmgjM/zXIurqZr9hD6dZ7AuG+g2rKoh0KQJkSIUpYtV",
+	"dQP6Ap98p7oBwXKRugHRsN8Sf7n16wbEpm91A2p+XkYv67XwajnYgt9THshll11ZLJZMuicQvacm6AmE",
+	"IdjonkCEre2AH40uJMEvG+I5E/LLVk+g148j7tXOQC7892GovjogeY6jf0fuDISXFl5QtI4LNtPagc5A",
+	"sJhMZyAG2ca4YzjO0jydgewrbXVbiNptoeZWCxQjXtNWC3tBm2UMIrTVAnwly5tl2gLVR/eVtPdhfh9o",
+	"rxWIhyqa9ATIiJqa9MCWdqJJTwQNdEea9DSlQtoSG7vbpKclOV5fyRHepGcvSA5kkFHTdZUddxG55DaA",
+	"MqtoRwpbO4a31SxNSb1nqoMYEc7aenS0pEdLeoTnugtopxmy3BsmbXwZUBPJnCiZ9EEypCiUIZsbd63l",
+	"m67EuukfxJ/bSOWo+r25erl8ZxVodtrBm70TrDDgby0to+T8Vy8gFKVQeZaDeqHjrCEH6kyRNTbXf4Tf",
+	"X4aaoouV+TW8OJza4Do2LoQlubslYMM9BmStHagr6v9qE2E4vfLqRWzLXdByoO6otT8Aj4NcAGoyrfrH",
+	"fViji6RDlSPMYxU0mKdmvihUsf0U7PfxYjVSurOEhn0A6RAM2IY3BKM9lhwe9ILAcVqjBM3BFtg5vYFo",
+	"jtocaMZ2ukeZEh0f/60JAu+5W3ecXSj3MTR5bOo4x34fUtLUjUmeYqW+hWtKWCTSEVgb9kM/u/gV3qwY",
+	"AQOvPVJNqj3Bx/9IlUH4q6wn59/B0iACbPQhxGoUcEKs4c113NyQWuorvy1u37lQp5pT/q1tbPqtR/kl",
+	"YFfSEuMjXLvYyeDxFDKc3Q9y3rIdDa+8RASg7B6NEr1dKe6HP6YFlpx4C33sWgyzORkm6cUowTObjSXa",
+	"1nBR55QwDaVDSWhKptqWZALWKW6f4oBo0Rp7YF2Z4JqE1alaX5CiTiqpI9sAJl62Cb4VK84qJH+CxCn4",
+	"Pb97Usy4fOmJfAMTgNNhBOm68W+4t9r4N55Cqnge38zNDbdA5t1Vf+aN4SiynJNrK9Ht7k2FNTfCoyCP",
+	"fPCb8c31G4jayQeL2xcntuYvOk1uLZV3z7Bv7ioJ3lbJwbnfAoVEeX6yPUR6h1ahzLpeozZLDHuSMhi1",
+	"HqN7lzIZU3M/Q41xriU69lgIKWSh+Wm40c/TdvlBhA04oxNEHGRQTeNGDw3UAPPfY+eA7y1D+wddSQ+o",
+	"uoeJFyvPnsCN10lRxNNZo3mvoggdPOdtTTJr8B+Ta3b9cvmKc7sr9K8IRzfXb5rG9+U7G6YxRrJbnMNd",
+	"Y31yXPxGANbxC5Z8MLMYXu75Q4QH9Tc14EuV1u96jno0VTKDjKrqC4mmtjZQ/JYzMgRc9l4xMoCSyx1k",
+	"kcPvgnWlYBo3nUf7A8tRDjTEVuuPAXtA3cWbj6rlDilpAuG6CKnhUCtFaevhko8RoU5SCB8JGZBnjMrU",
+	"A2aBcC1cGcFdYgU9XF075FzvaNZn98zcZRRBkcsSu8byFWu5GC7zxOU9/AInALrH8PXUS2TYtx1FZITb",
+	"MR4uNbFM8FxntP5/fK+/PS0Wti4uba0VbceUD9YicDnbsJNJsoYHkgVr/hKY6y5JscXX/jnmhA4BtABq",
+	"zSdM/IgkQJ6k0B3t7+hTEgkoAuIX8IEiWzdXL+PwMhx0CtRU1CHkFfX4/ip5mNw3XE3bkVRcBSMd4HZ+",
+	"CT1zUKTrHASJbpDu38a8o4IHjoddgmJ18xA+tQI0IVET6Qg9g4zNBB8BmbE57hFSMhiqA/ExsVxjYRa/",
+	"RSPEaBFG96iCNXYRVyIh0Xpcq/LK5V/Lo+MiacaKPSKgth05qSQSanJARd8Zj/wfRjtYgc55TM53LkIK",
+	"fMBrwHLGAaHm4by0VTrCAnpDRWv+SXlqWuKG6Cwl68KoVXoOQbmu+LvSoJrJKAhwRevimnX5TgNCthg5",
+	"uqPBgU0/BT2iyCrzcLSJabEKU4jCgxhBuCf5CZSuwnYNSuOpuH/HSnuTuavW2COuL2XJvgjS0H7p2N+O",
+	"vGsaJcDFL9S01q9Bv9zK9VlSFgGo2EMvroZRprHgwubcyJGEpib1nqMEsR09q2YJPKH85KqISYQlc6Dl",
+	"3NzhQOcBLzQ8e2fthTC9FaW5Btnz1tIE0nXzt2nblqIckzupKnFAgnOxD1KYZJ3Uqp5RBocSaqw7dlLX",
+	"hzLdHR3/ektPK0NvfT3UoQxpHacO0Otnwvi/6fn/gXTCPyO0+Gq4s3P/230A/H9o8T+jnw/00cuAn+g3",
+	"qbj6jz56Y/RDxzX6f/6PQVU/mYr/+fj+Q2/bMXIZPa0lB4B2jqv6viOp1Dea6nfKjJqB4oB/Vnr74l37",
+	"Dxz8rzb0cPlzx3+1vYsrkP7572q8va3zYNuHytm2/Z3797d1vd29/2B3V1fb+x9+9l9tHypn9h0eUP+8",
+	"/9A7+zs7O/+r7X90fejjZOLsf7UdR3JWFezsfP2YAs8NnAREcKvkQb5VDv8WMUKhX3kxSMRLOAaQSA2k",
+	"8NtObIf0PthwU97K9fXtuz9gIU/F1Q9QS9ZFc5QqqLfYK/si1Kj5AO/WI8wPigyork0Vq5fqhWYTpc0u",
+	"LKt+D+xcZSkpxDZKLjTyo6aMqgTUc7bWFqzV5cCadiLRdBwm3Ylic2glmTpzjoNETi50jhZmNWyuPjSN",
+	"p1A2bsVaXdbiKLLs5kX4xULzY5idPCVGOiH8OJxCaIR1PSEztlaXcTsyr2GNZhlxL1BsPZu1Vpff2Hw1",
+	"3r2/01pdxnjd1Yn/vcqZTJbM3CUE7kLX/38/kkPog6zR1WmPIhMIPnzTNEpfJSt3s9bqMn2vrNCJ8Q3f",
+	"Zm05YcuvNjfughVNhusDdjamtw+dngTGx847PSl6elg931QEiDEgoqXrqyRv66ojDTZ9+hGFV2Hr5/s0",
+	"IYO8Qbs6Ozu5ZrKNav2+cxLNhRtetsIEVcc59H8k4CXasxIPDI/9BlZBsTR3dWuu4LTli8O2ERkc1xV9",
+	"ONMoeneu0kCyD6d2IXXXTNp/+KTB3RXwq8shBDicUdP+miLSSkn+Z/4pyZ03SoGa4z+1ZF9iOK4eH84M",
+	"qcm4Gv+nmbv6T4TC/4R3gsPQb12cQJ1VcVVfzlTpzMsPmtsole/Oba7/issHEFPG4WM9plFq+yc1L8Ah",
+	"/9mGcHjtRnn8friu+zmAJaRxa7+SyKisNWlvCvkCUfP++RvuTGlXw/EF01iBz8FRlzPCG5n2pnyKuiPA",
+	"Mjndm0olVCUpqimPvmNbDYK8YE/C/ftf3Qo3wR30Kgw4l/tCxYcEQAtOeWIndCGECjK6EGaPe+i56SJs",
+	"n/rriHZ4XtEx6G+h5R0Fm8B6nJhRilwd7UO1kSXR8NUKPLkXl6yxC3wWUR3q1tigsZ9DuXExgILEZdPj",
+	"lhsLxCcsyWBah5Y8pelwtZmqsQ6elRszleXr1qtR05hDmxi/g/6du8r3PEHPVQFa4vZRxCXGhhorTgHF",
+	"MzovFp9FgQOfphJqD3eenWBeopWlmBk5Zala48rrgKurruIB2CJXXnwE7cRWOWQo+dtQQrC645z9A2QD",
+	"9PWpQ1XESfGzSETzhlOMTSKXNraKr7DLjJ6XpNsRUOSubq7f3Fz9TqI98mE4ngAnZWzoDNp0S83W5Ixu",
+	"sEiMX8B0WDumwDcGHVpwsZuwXocMFGZujPTb5ar/k0a0rkCQSfTf3HjY1HuwVgqDYkCifATkl+qs5qIJ",
+	"RzPNwJz8ALDvRpeeQP6G0ax6thZX+xJaUm0mvrb16t52NivBs47ivdfKtOh6rxHTanGIXei9WCeKxugo",
+	"oGhYD62P6XM4neAiN/qYh9IZwrEfMpv8vt0XV0/B97r2lq72nRSP6e7oSKT6lMTJVEbvPtDZ2en9jP3m",
+	"BNt3hCghp0e1xOpSgjX4AhiN+DJztKUedqx6TSqu6Xgc2b7xw3b2R2qJEkw6jI0KIeEN1uji5strbOdb",
+	"2dHQiSF+XTAzw4rQGVD4ZdAELrSSmg/xzZA5+ahQqTlpKazASZ3lPqXmZe3OQ2a2WxtLTYvapYaBFUqf",
+	"Sc0GxXdDt3gNiqQuyR2bNHfzzugutfpGeXrBVQ/WBec3Q1dUsdVatJ5rZhb54NkHtiTQdymJX5NdGXin",
+	"d3XkMQbkDp0ng12k/heA2tb9BAiCpIlwPv+zkkm2nv2GOmfl1yvPcptrFyDI6uZ24WcoY3EXJ+NsLY6B",
+	"gRYCltk7Xezj4u77WEI5+0FqIPAIKGJrCbwm8zgaWngK57xH0qqip9LBoBG0f/QBuC+IhHNkjc2NOZws",
+	"4+TOC75DbvyMfRkh4GItKAVy4M6P5dn1gGv+Ksn4t13dIr9OXge5EX+JzYVDGI/sv16b2Hw5g/765EF5",
+	"+Rd/cyqVCcNxTYe7PnH+/xsA",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
@@ -0,0 +1,262 @@
+package v2
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+)
+
+// maxRateLimitKeyBodySize
+// レート制限のキーを取り出すために読み込むリクエストボディの最大サイズ
+const maxRateLimitKeyBodySize = 4 << 10
+
+var rateLimitRejectedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "handler_trap_collection",
+	Subsystem: "rate_limit",
+	Name:      "rejected_count",
+	Help:      "The number of requests rejected by rate limit",
+}, []string{"group", "by"})
+
+type RateLimit struct {
+	store  RateLimitStore
+	groups []*rateLimitGroup
+}
+
+// rateLimitGroup
+// 同じ制限を共有するルートの集まり
+type rateLimitGroup struct {
+	name   string
+	routes []rateLimitRoute
+	perIP  config.RateLimit
+	// perKey keyFuncがnilの場合は使われない
+	perKey config.RateLimit
+	// keyFunc
+	// IPアドレス以外で制限する場合のキーを返す。
+	// 空文字列を返した場合は、IPアドレスによる制限のみ行う。
+	keyFunc func(c echo.Context) (string, error)
+}
+
+type rateLimitRoute struct {
+	method string
+	// path echoのルートのパス(例: /api/v2/games/:gameID)
+	path string
+}
+
+func NewRateLimit(conf config.HandlerRateLimit, store RateLimitStore) (*RateLimit, error) {
+	editionAuthorizePerIP, editionAuthorizePerKey, err := conf.EditionAuthorize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition authorize rate limit: %w", err)
+	}
+
+	publicPerIP, err := conf.Public()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public rate limit: %w", err)
+	}
+
+	groups := []*rateLimitGroup{
+		{
+			name: "edition_authorize",
+			routes: []rateLimitRoute{
+				{method: http.MethodPost, path: "/api/v2/editions/authorize"},
+			},
+			perIP:   editionAuthorizePerIP,
+			perKey:  editionAuthorizePerKey,
+			keyFunc: productKeyRateLimitKey,
+		},
+		{
+			name: "public",
+			routes: []rateLimitRoute{
+				{method: http.MethodGet, path: "/api/v2/games"},
+				{method: http.MethodGet, path: "/api/v2/games/:gameID/versions/latest"},
+				{method: http.MethodGet, path: "/api/v2/genres"},
+			},
+			perIP: publicPerIP,
+		},
+	}
+
+	for _, group := range groups {
+		if err := validateRateLimit(group.perIP); err != nil {
+			return nil, fmt.Errorf("invalid %s per ip rate limit: %w", group.name, err)
+		}
+
+		if group.keyFunc != nil {
+			if err := validateRateLimit(group.perKey); err != nil {
+				return nil, fmt.Errorf("invalid %s per key rate limit: %w", group.name, err)
+			}
+		}
+	}
+
+	return &RateLimit{
+		store:  store,
+		groups: groups,
+	}, nil
+}
+
+// IPExtractor
+// IPアドレスごとの制限に使う、クライアントのIPアドレスの取り出し方を返す。
+// X-Forwarded-Forはクライアントが自由に設定できるので、trustedProxiesからの接続の場合のみ信頼する。
+// trustedProxiesが空の場合は、接続元のIPアドレスをそのまま使う。
+func IPExtractor(trustedProxies []*net.IPNet) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	// デフォルトではループバック・リンクローカル・プライベートネットワークのアドレスも信頼されるので、
+	// 設定した範囲のみ信頼するようにする
+	trustOptions := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, trustedProxy := range trustedProxies {
+		trustOptions = append(trustOptions, echo.TrustIPRange(trustedProxy))
+	}
+
+	return echo.ExtractIPFromXFFHeader(trustOptions...)
+}
+
+func validateRateLimit(limit config.RateLimit) error {
+	if limit.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+
+	if limit.Burst < 1 {
+		return errors.New("burst must be at least 1")
+	}
+
+	return nil
+}
+
+// rateLimitMiddleware
+// ルートグループごとに、IPアドレスごとの制限とキーごとの制限を行うミドルウェア。
+// ルーティング後のc.Path()を使うので、Groupに対して設定する必要がある。
+// IPアドレスはc.RealIP()で取り出すので、echoのIPExtractorにIPExtractorを設定しておく必要がある。
+func (rateLimit *RateLimit) rateLimitMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		group := rateLimit.findGroup(c)
+		if group == nil {
+			return next(c)
+		}
+
+		ok, err := rateLimit.take(c, group, "ip", c.RealIP(), group.perIP)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+		}
+
+		if group.keyFunc != nil {
+			key, err := group.keyFunc(c)
+			if err != nil {
+				logger.Error(c.Request().Context(), "failed to get rate limit key", slog.Any("error", err))
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get rate limit key")
+			}
+
+			if key != "" {
+				ok, err := rateLimit.take(c, group, "key", key, group.perKey)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+				}
+			}
+		}
+
+		return next(c)
+	}
+}
+
+func (rateLimit *RateLimit) findGroup(c echo.Context) *rateLimitGroup {
+	method := c.Request().Method
+	path := c.Path()
+
+	for _, group := range rateLimit.groups {
+		for _, route := range group.routes {
+			if route.method == method && route.path == path {
+				return group
+			}
+		}
+	}
+
+	return nil
+}
+
+// take
+// トークンを取り出せなかった場合は、Retry-Afterヘッダーを設定してfalseを返す
+func (rateLimit *RateLimit) take(c echo.Context, group *rateLimitGroup, by string, key string, limit config.RateLimit) (bool, error) {
+	ok, retryAfter, err := rateLimit.store.Take(
+		c.Request().Context(),
+		fmt.Sprintf("%s:%s:%s", group.name, by, key),
+		limit,
+	)
+	if err != nil {
+		// ストアの障害でサービス全体を止めないよう、制限せずに通す
+		logger.Error(c.Request().Context(), "failed to take rate limit token", slog.Any("error", err))
+		return true, nil
+	}
+
+	if !ok {
+		rateLimitRejectedCounter.WithLabelValues(group.name, by).Inc()
+
+		retryAfterSeconds := max(int(math.Ceil(retryAfter.Seconds())), 1)
+		c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// productKeyRateLimitKey
+// POST /editions/authorizeのリクエストボディからプロダクトキーを取り出す。
+// 読み込んだリクエストボディはハンドラーで再度読めるように戻す。
+func productKeyRateLimitKey(c echo.Context) (string, error) {
+	req := c.Request()
+	if req.Body == nil {
+		return "", nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(req.Body, maxRateLimitKeyBodySize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(buf), req.Body),
+		Closer: req.Body,
+	}
+
+	var body openapi.EditionAuthorizeRequest
+	err = json.Unmarshal(buf, &body)
+	if err != nil || body.Key == "" {
+		// 不正なリクエストボディはハンドラーで400にするので、ここではIPアドレスによる制限のみ行う
+		return "", nil
+	}
+
+	// プロダクトキーをそのままストアに置かないよう、ハッシュ値をキーにする
+	hash := sha256.Sum256([]byte(body.Key))
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
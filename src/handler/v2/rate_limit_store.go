@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+// RateLimitStore
+// レート制限のトークンバケットの保存先。
+// 複数のレプリカで制限を共有する場合は、キャッシュのバックエンドを使った実装に差し替える。
+type RateLimitStore interface {
+	// Take
+	// keyのバケットからトークンを1つ取り出す。
+	// トークンが無い場合は、falseと次にトークンが補充されるまでの時間を返す。
+	Take(ctx context.Context, key string, limit config.RateLimit) (bool, time.Duration, error)
+}
+
+// rateLimitSweepInterval
+// 使われなくなったバケットを削除する間隔
+const rateLimitSweepInterval = time.Minute
+
+// MemoryRateLimitStore
+// プロセス内でトークンバケットを保持するRateLimitStore
+type MemoryRateLimitStore struct {
+	locker    sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tokenBucket struct {
+	tokens   float64
+	limit    config.RateLimit
+	updateAt time.Time
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: map[string]*tokenBucket{},
+		now:     time.Now,
+	}
+}
+
+func (store *MemoryRateLimitStore) Take(_ context.Context, key string, limit config.RateLimit) (bool, time.Duration, error) {
+	store.locker.Lock()
+	defer store.locker.Unlock()
+
+	now := store.now()
+	store.sweep(now)
+
+	bucket, ok := store.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			tokens:   float64(limit.Burst),
+			limit:    limit,
+			updateAt: now,
+		}
+		store.buckets[key] = bucket
+	}
+	bucket.refill(now)
+
+	if bucket.tokens < 1 {
+		wait := time.Duration(math.Ceil((1 - bucket.tokens) / limit.Rate * float64(time.Second)))
+		return false, wait, nil
+	}
+	bucket.tokens--
+
+	return true, 0, nil
+}
+
+// sweep
+// トークンが満タンまで補充されたバケットは新しく作ったものと同じなので削除する
+func (store *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	store.lastSweep = now
+
+	for key, bucket := range store.buckets {
+		bucket.refill(now)
+		if bucket.tokens >= float64(bucket.limit.Burst) {
+			delete(store.buckets, key)
+		}
+	}
+}
+
+func (bucket *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(bucket.updateAt)
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens = min(float64(bucket.limit.Burst), bucket.tokens+elapsed.Seconds()*bucket.limit.Rate)
+	bucket.updateAt = now
+}
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+func TestMemoryRateLimitStoreTake(t *testing.T) {
+	t.Parallel()
+
+	limit := config.RateLimit{
+		Rate:  1,
+		Burst: 2,
+	}
+
+	type take struct {
+		key        string
+		elapsed    time.Duration
+		ok         bool
+		retryAfter time.Duration
+	}
+
+	testCases := map[string]struct {
+		takes []take
+	}{
+		"バーストまでは取り出せる": {
+			takes: []take{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, retryAfter: time.Second},
+			},
+		},
+		"時間が経つと補充される": {
+			takes: []take{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", elapsed: 500 * time.Millisecond, ok: false, retryAfter: 500 * time.Millisecond},
+				{key: "a", elapsed: 500 * time.Millisecond, ok: true},
+				{key: "a", ok: false, retryAfter: time.Second},
+			},
+		},
+		"バーストより多くは補充されない": {
+			takes: []take{
+				{key: "a", ok: true},
+				{key: "a", elapsed: time.Hour, ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, retryAfter: time.Second},
+			},
+		},
+		"キーごとに別のバケット": {
+			takes: []take{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, retryAfter: time.Second},
+				{key: "b", ok: true},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			store := NewMemoryRateLimitStore()
+			store.now = func() time.Time { return now }
+
+			for _, take := range testCase.takes {
+				now = now.Add(take.elapsed)
+
+				ok, retryAfter, err := store.Take(context.Background(), take.key, limit)
+				assert.NoError(t, err)
+				assert.Equal(t, take.ok, ok)
+				assert.Equal(t, take.retryAfter, retryAfter)
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimitStoreSweep(t *testing.T) {
+	t.Parallel()
+
+	limit := config.RateLimit{
+		Rate:  1,
+		Burst: 2,
+	}
+
+	now := time.Now()
+	store := NewMemoryRateLimitStore()
+	store.now = func() time.Time { return now }
+
+	_, _, err := store.Take(context.Background(), "a", limit)
+	assert.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+
+	// 満タンまで補充されたバケットは削除される
+	now = now.Add(rateLimitSweepInterval)
+	_, _, err = store.Take(context.Background(), "b", limit)
+	assert.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+	assert.Contains(t, store.buckets, "b")
+}
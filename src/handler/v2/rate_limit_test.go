@@ -0,0 +1,283 @@
+package v2
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"go.uber.org/mock/gomock"
+)
+
+type errRateLimitStore struct{}
+
+func (errRateLimitStore) Take(context.Context, string, config.RateLimit) (bool, time.Duration, error) {
+	return false, 0, assert.AnError
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		method     string
+		path       string
+		ip         string
+		xff        string
+		xRealIP    string
+		productKey string
+		statusCode int
+		retryAfter string
+	}
+
+	_, trustedProxy, err := net.ParseCIDR("198.51.100.0/24")
+	if err != nil {
+		t.Fatalf("failed to parse cidr: %v", err)
+	}
+
+	testCases := map[string]struct {
+		store          RateLimitStore
+		trustedProxies []*net.IPNet
+		requests       []request
+	}{
+		"制限の無いルートは制限されない": {
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games/:gameID", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games/:gameID", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games/:gameID", ip: "192.0.2.1", statusCode: http.StatusOK},
+			},
+		},
+		"メソッドが異なるルートは制限されない": {
+			requests: []request{
+				{method: http.MethodPost, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodPost, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodPost, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+			},
+		},
+		"IPアドレスごとの制限を超えると429": {
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/genres", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.2", statusCode: http.StatusOK},
+			},
+		},
+		"ルートグループごとに別の制限": {
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.1", productKey: "key1", statusCode: http.StatusOK},
+			},
+		},
+		"authorizeはIPアドレスごとの制限が厳しい": {
+			requests: []request{
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.1", productKey: "key1", statusCode: http.StatusOK},
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.1", productKey: "key2", statusCode: http.StatusTooManyRequests, retryAfter: "10"},
+			},
+		},
+		"authorizeはプロダクトキーごとにも制限される": {
+			requests: []request{
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.1", productKey: "key1", statusCode: http.StatusOK},
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.2", productKey: "key1", statusCode: http.StatusTooManyRequests, retryAfter: "60"},
+				{method: http.MethodPost, path: "/api/v2/editions/authorize", ip: "192.0.2.3", productKey: "key2", statusCode: http.StatusOK},
+			},
+		},
+		"X-Forwarded-Forを変えても同じ接続元なら429": {
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.2", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.3", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+			},
+		},
+		"信頼しないプロキシからのX-Forwarded-Forは無視される": {
+			trustedProxies: []*net.IPNet{trustedProxy},
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.2", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xff: "203.0.113.3", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+			},
+		},
+		"信頼するプロキシからのX-Forwarded-Forはクライアントごとに制限される": {
+			trustedProxies: []*net.IPNet{trustedProxy},
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "203.0.113.1", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "203.0.113.2", statusCode: http.StatusOK},
+			},
+		},
+		"信頼するプロキシ経由でもX-Forwarded-Forの先頭を変えるだけでは回避できない": {
+			trustedProxies: []*net.IPNet{trustedProxy},
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "10.0.0.1, 203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "10.0.0.2, 203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "198.51.100.1", xff: "10.0.0.3, 203.0.113.1", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+			},
+		},
+		"X-Real-IPを変えても同じ接続元なら429": {
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xRealIP: "203.0.113.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xRealIP: "203.0.113.2", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", xRealIP: "203.0.113.3", statusCode: http.StatusTooManyRequests, retryAfter: "1"},
+			},
+		},
+		"ストアがエラーの場合は制限しない": {
+			store: errRateLimitStore{},
+			requests: []request{
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+				{method: http.MethodGet, path: "/api/v2/games", ip: "192.0.2.1", statusCode: http.StatusOK},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockConf := mockConfig.NewMockHandlerRateLimit(ctrl)
+			mockConf.
+				EXPECT().
+				EditionAuthorize().
+				Return(
+					config.RateLimit{Rate: 0.1, Burst: 1},
+					config.RateLimit{Rate: 1.0 / 60, Burst: 1},
+					nil,
+				)
+			mockConf.
+				EXPECT().
+				Public().
+				Return(config.RateLimit{Rate: 1, Burst: 2}, nil)
+
+			store := testCase.store
+			if store == nil {
+				memoryStore := NewMemoryRateLimitStore()
+				now := time.Now()
+				memoryStore.now = func() time.Time { return now }
+				store = memoryStore
+			}
+
+			rateLimit, err := NewRateLimit(mockConf, store)
+			if err != nil {
+				t.Fatalf("failed to create rate limit: %v", err)
+			}
+
+			for _, req := range testCase.requests {
+				var body bodyOpt
+				if req.productKey != "" {
+					body = withJSONBody(t, openapi.EditionAuthorizeRequest{Key: req.productKey})
+				}
+
+				c, httpReq, rec := setupTestRequest(t, req.method, req.path, body)
+				c.Echo().IPExtractor = IPExtractor(testCase.trustedProxies)
+				httpReq.RemoteAddr = net.JoinHostPort(req.ip, "12345")
+				if req.xRealIP != "" {
+					httpReq.Header.Set(echo.HeaderXRealIP, req.xRealIP)
+				}
+				if req.xff != "" {
+					httpReq.Header.Set(echo.HeaderXForwardedFor, req.xff)
+				}
+				c.SetPath(req.path)
+
+				var reqBody []byte
+				next := func(c echo.Context) error {
+					// ハンドラーでもリクエストボディを読めること
+					body, err := io.ReadAll(c.Request().Body)
+					if err != nil {
+						return err
+					}
+					reqBody = body
+
+					return c.NoContent(http.StatusOK)
+				}
+
+				err := rateLimit.rateLimitMiddleware(next)(c)
+
+				if req.statusCode != http.StatusOK {
+					var httpErr *echo.HTTPError
+					if assert.ErrorAs(t, err, &httpErr) {
+						assert.Equal(t, req.statusCode, httpErr.Code)
+					}
+					assert.Equal(t, req.retryAfter, rec.Header().Get("Retry-After"))
+					continue
+				}
+
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				if req.productKey != "" {
+					assert.Contains(t, string(reqBody), req.productKey)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRateLimit(t *testing.T) {
+	t.Parallel()
+
+	validLimit := config.RateLimit{Rate: 1, Burst: 1}
+
+	testCases := map[string]struct {
+		perIP  config.RateLimit
+		perKey config.RateLimit
+		public config.RateLimit
+		isErr  bool
+	}{
+		"正しい設定なのでエラー無し": {
+			perIP:  validLimit,
+			perKey: validLimit,
+			public: validLimit,
+		},
+		"rateが0なのでエラー": {
+			perIP:  config.RateLimit{Rate: 0, Burst: 1},
+			perKey: validLimit,
+			public: validLimit,
+			isErr:  true,
+		},
+		"burstが0なのでエラー": {
+			perIP:  validLimit,
+			perKey: config.RateLimit{Rate: 1, Burst: 0},
+			public: validLimit,
+			isErr:  true,
+		},
+		"publicの設定が不正なのでエラー": {
+			perIP:  validLimit,
+			perKey: validLimit,
+			public: config.RateLimit{Rate: -1, Burst: 1},
+			isErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockConf := mockConfig.NewMockHandlerRateLimit(ctrl)
+			mockConf.
+				EXPECT().
+				EditionAuthorize().
+				Return(testCase.perIP, testCase.perKey, nil)
+			mockConf.
+				EXPECT().
+				Public().
+				Return(testCase.public, nil)
+
+			_, err := NewRateLimit(mockConf, NewMemoryRateLimitStore())
+			if testCase.isErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
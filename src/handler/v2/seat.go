@@ -2,12 +2,13 @@ package v2
 
 import (
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -26,7 +27,7 @@ func NewSeat(seatService service.Seat) *Seat {
 func (seat *Seat) GetSeats(c echo.Context) error {
 	seats, err := seat.seatService.GetSeats(c.Request().Context())
 	if err != nil {
-		log.Printf("error: failed to get seats: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get seats", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get seats")
 	}
 
@@ -39,7 +40,7 @@ func (seat *Seat) GetSeats(c echo.Context) error {
 		case values.SeatStatusInUse:
 			status = openapi.InUse
 		default:
-			log.Printf("error: invalid seat status: %v\n", seat.Status())
+			logger.Error(c.Request().Context(), "invalid seat status", slog.Any("seat_status", seat.Status()))
 			continue
 		}
 
@@ -58,7 +59,7 @@ func (seat *Seat) PostSeat(c echo.Context) error {
 	var req openapi.PostSeatRequest
 	err := c.Bind(&req)
 	if err != nil {
-		log.Printf("error: failed to bind request: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to bind request", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to bind request")
 	}
 
@@ -68,7 +69,7 @@ func (seat *Seat) PostSeat(c echo.Context) error {
 
 	seats, err := seat.seatService.UpdateSeatNum(c.Request().Context(), uint(req.Num))
 	if err != nil {
-		log.Printf("error: failed to post seat: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to post seat", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to post seat")
 	}
 
@@ -81,7 +82,7 @@ func (seat *Seat) PostSeat(c echo.Context) error {
 		case values.SeatStatusInUse:
 			status = openapi.InUse
 		default:
-			log.Printf("error: invalid seat status: %v\n", seat.Status())
+			logger.Error(c.Request().Context(), "invalid seat status", slog.Any("seat_status", seat.Status()))
 			continue
 		}
 
@@ -100,7 +101,7 @@ func (seat *Seat) PatchSeatStatus(c echo.Context, seatID openapi.SeatIDInPath) e
 	var req openapi.PatchSeatStatusRequest
 	err := c.Bind(&req)
 	if err != nil {
-		log.Printf("error: failed to bind request: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to bind request", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusBadRequest, "failed to bind request")
 	}
 
@@ -111,7 +112,7 @@ func (seat *Seat) PatchSeatStatus(c echo.Context, seatID openapi.SeatIDInPath) e
 	case openapi.InUse:
 		status = values.SeatStatusInUse
 	default:
-		log.Printf("error: invalid seat status: %v\n", req.Status)
+		logger.Error(c.Request().Context(), "invalid seat status", slog.Any("seat_status", req.Status))
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid seat status")
 	}
 
@@ -120,7 +121,7 @@ func (seat *Seat) PatchSeatStatus(c echo.Context, seatID openapi.SeatIDInPath) e
 		return echo.NewHTTPError(http.StatusNotFound, "no seat")
 	}
 	if err != nil {
-		log.Printf("error: failed to patch seat status: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to patch seat status", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to patch seat status")
 	}
 
@@ -131,7 +132,7 @@ func (seat *Seat) PatchSeatStatus(c echo.Context, seatID openapi.SeatIDInPath) e
 	case values.SeatStatusInUse:
 		resStatus = openapi.InUse
 	default:
-		log.Printf("error: invalid seat status: %v\n", domainSeat.Status())
+		logger.Error(c.Request().Context(), "invalid seat status", slog.Any("seat_status", domainSeat.Status()))
 		return echo.NewHTTPError(http.StatusInternalServerError, "invalid seat status")
 	}
 
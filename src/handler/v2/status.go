@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+type Status struct {
+	statusService service.Status
+}
+
+func NewStatus(statusService service.Status) *Status {
+	return &Status{
+		statusService: statusService,
+	}
+}
+
+// サーバーの状態の取得
+// (GET /admin/status)
+func (s *Status) GetAdminStatus(c echo.Context) error {
+	status := s.statusService.GetServerStatus(c.Request().Context())
+
+	var migration *openapi.ServerMigrationStatus
+	if status.Migration != nil {
+		migration = &openapi.ServerMigrationStatus{
+			Current: status.Migration.Current,
+			Latest:  status.Migration.Latest,
+		}
+	}
+
+	dependencies := make([]openapi.ServerDependencyStatus, 0, len(status.Dependencies))
+	for _, dependency := range status.Dependencies {
+		res := openapi.ServerDependencyStatus{
+			Name:   openapi.ServerDependencyStatusName(dependency.Name),
+			Status: openapi.Ok,
+		}
+		if dependency.Err != nil {
+			errMessage := dependency.Err.Error()
+			res.Status = openapi.Unavailable
+			res.Error = &errMessage
+		}
+
+		dependencies = append(dependencies, res)
+	}
+
+	jobs := make([]openapi.ServerJobStatus, 0, len(status.Jobs))
+	for _, job := range status.Jobs {
+		res := openapi.ServerJobStatus{
+			Name:           job.Name,
+			LastStartedAt:  job.LastStartedAt,
+			LastFinishedAt: job.LastFinishedAt,
+			Succeeded:      job.LastErr == nil,
+		}
+		if job.LastErr != nil {
+			errMessage := job.LastErr.Error()
+			res.Error = &errMessage
+		}
+
+		jobs = append(jobs, res)
+	}
+
+	return c.JSON(http.StatusOK, openapi.ServerStatus{
+		Build: openapi.ServerBuildInfo{
+			Version:   status.Build.Version,
+			Revision:  status.Build.Revision,
+			GoVersion: status.Build.GoVersion,
+		},
+		Migration: migration,
+		Features: openapi.ServerFeatures{
+			V2:      status.FeatureV2,
+			V1Write: status.FeatureV1Write,
+		},
+		Dependencies: dependencies,
+		Jobs:         jobs,
+	})
+}
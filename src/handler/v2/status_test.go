@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAdminStatus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+	errMessage := "error"
+
+	testCases := map[string]struct {
+		serverStatus *service.ServerStatus
+		expect       openapi.ServerStatus
+	}{
+		"全て正常なので問題なし": {
+			serverStatus: &service.ServerStatus{
+				Build: service.BuildInfo{
+					Version:   "v1.0.0",
+					Revision:  "abcdef",
+					GoVersion: "go1.25.0",
+				},
+				Migration: &service.MigrationVersions{
+					Current: "20261019000010",
+					Latest:  "20261019000010",
+				},
+				FeatureV2:      true,
+				FeatureV1Write: false,
+				Dependencies: []*service.DependencyStatus{
+					{Name: service.DependencyDatabase},
+					{Name: service.DependencyMigration},
+				},
+				Jobs: []*service.JobStatus{
+					{
+						Name:           "deleteLongLogs",
+						LastStartedAt:  now.Add(-time.Minute),
+						LastFinishedAt: now,
+					},
+				},
+			},
+			expect: openapi.ServerStatus{
+				Build: openapi.ServerBuildInfo{
+					Version:   "v1.0.0",
+					Revision:  "abcdef",
+					GoVersion: "go1.25.0",
+				},
+				Migration: &openapi.ServerMigrationStatus{
+					Current: "20261019000010",
+					Latest:  "20261019000010",
+				},
+				Features: openapi.ServerFeatures{
+					V2:      true,
+					V1Write: false,
+				},
+				Dependencies: []openapi.ServerDependencyStatus{
+					{Name: openapi.Database, Status: openapi.Ok},
+					{Name: openapi.Migration, Status: openapi.Ok},
+				},
+				Jobs: []openapi.ServerJobStatus{
+					{
+						Name:           "deleteLongLogs",
+						LastStartedAt:  now.Add(-time.Minute),
+						LastFinishedAt: now,
+						Succeeded:      true,
+					},
+				},
+			},
+		},
+		"利用できないサービスと失敗したジョブがあってもエラーなし": {
+			serverStatus: &service.ServerStatus{
+				Dependencies: []*service.DependencyStatus{
+					{Name: service.DependencyMigration, Err: errors.New(errMessage)},
+					{Name: service.DependencyStorage, Err: errors.New(errMessage)},
+				},
+				Jobs: []*service.JobStatus{
+					{
+						Name:           "scanGameFiles",
+						LastStartedAt:  now.Add(-time.Minute),
+						LastFinishedAt: now,
+						LastErr:        errors.New(errMessage),
+					},
+				},
+			},
+			expect: openapi.ServerStatus{
+				Dependencies: []openapi.ServerDependencyStatus{
+					{Name: openapi.Migration, Status: openapi.Unavailable, Error: &errMessage},
+					{Name: openapi.Storage, Status: openapi.Unavailable, Error: &errMessage},
+				},
+				Jobs: []openapi.ServerJobStatus{
+					{
+						Name:           "scanGameFiles",
+						LastStartedAt:  now.Add(-time.Minute),
+						LastFinishedAt: now,
+						Succeeded:      false,
+						Error:          &errMessage,
+					},
+				},
+			},
+		},
+		"ジョブが1度も実行されていなくても空配列": {
+			serverStatus: &service.ServerStatus{},
+			expect: openapi.ServerStatus{
+				Dependencies: []openapi.ServerDependencyStatus{},
+				Jobs:         []openapi.ServerJobStatus{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStatusService := mock.NewMockStatus(ctrl)
+			status := NewStatus(mockStatusService)
+
+			mockStatusService.
+				EXPECT().
+				GetServerStatus(gomock.Any()).
+				Return(testCase.serverStatus)
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, "/api/v2/admin/status", nil)
+
+			err := status.GetAdminStatus(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var res openapi.ServerStatus
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expect.Build, res.Build)
+			assert.Equal(t, testCase.expect.Migration, res.Migration)
+			assert.Equal(t, testCase.expect.Features, res.Features)
+			assert.Equal(t, testCase.expect.Dependencies, res.Dependencies)
+			require.Len(t, res.Jobs, len(testCase.expect.Jobs))
+			for i, job := range res.Jobs {
+				expected := testCase.expect.Jobs[i]
+				assert.Equal(t, expected.Name, job.Name)
+				assert.WithinDuration(t, expected.LastStartedAt, job.LastStartedAt, time.Second)
+				assert.WithinDuration(t, expected.LastFinishedAt, job.LastFinishedAt, time.Second)
+				assert.Equal(t, expected.Succeeded, job.Succeeded)
+				assert.Equal(t, expected.Error, job.Error)
+			}
+		})
+	}
+}
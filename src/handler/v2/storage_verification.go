@@ -0,0 +1,141 @@
+package v2
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+// defaultStorageVerificationIssueLimit
+// limitの指定がない場合に返す問題の数。
+// 多数のオブジェクトが失われた場合にレスポンスが大きくなりすぎないようにする。
+const defaultStorageVerificationIssueLimit = 100
+
+type StorageVerification struct {
+	storageVerificationService service.StorageVerification
+}
+
+func NewStorageVerification(storageVerificationService service.StorageVerification) *StorageVerification {
+	return &StorageVerification{
+		storageVerificationService: storageVerificationService,
+	}
+}
+
+// ストレージの検証結果の取得
+// (GET /admin/storage-verification)
+func (sv *StorageVerification) GetStorageVerification(c echo.Context, params openapi.GetStorageVerificationParams) error {
+	limit := defaultStorageVerificationIssueLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	verification, issues, err := sv.storageVerificationService.GetLatestStorageVerification(c.Request().Context(), limit)
+	if errors.Is(err, service.ErrInvalidLimit) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+	}
+	if errors.Is(err, service.ErrNoStorageVerification) {
+		return echo.NewHTTPError(http.StatusNotFound, "no storage verification")
+	}
+	if err != nil {
+		logger.Error(c.Request().Context(), "failed to get storage verification", slog.Any("error", err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get storage verification")
+	}
+
+	status, ok := storageVerificationStatusToOpenAPI(verification.GetStatus())
+	if !ok {
+		logger.Error(c.Request().Context(), "unknown storage verification status", slog.Any("status", verification.GetStatus()))
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown storage verification status")
+	}
+
+	resIssues := make([]openapi.StorageVerificationIssue, 0, len(issues))
+	for _, issue := range issues {
+		resIssue, ok := storageVerificationIssueToOpenAPI(issue)
+		if !ok {
+			logger.Error(c.Request().Context(), "unknown storage verification issue",
+				slog.Any("objectKind", issue.GetObjectKind()),
+				slog.Any("issueType", issue.GetIssueType()),
+			)
+			return echo.NewHTTPError(http.StatusInternalServerError, "unknown storage verification issue")
+		}
+
+		resIssues = append(resIssues, resIssue)
+	}
+
+	var finishedAt *time.Time
+	if t, ok := verification.GetFinishedAt().Value(); ok {
+		finishedAt = &t
+	}
+
+	return c.JSON(http.StatusOK, openapi.StorageVerification{
+		Id:             uuid.UUID(verification.GetID()),
+		Status:         status,
+		TotalObjects:   verification.GetTotalObjects(),
+		CheckedObjects: verification.GetCheckedObjects(),
+		Missing:        verification.GetIssueCount(values.StorageVerificationIssueTypeMissing),
+		HashMismatch:   verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch),
+		CheckFailed:    verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed),
+		StartedAt:      verification.GetStartedAt(),
+		FinishedAt:     finishedAt,
+		Issues:         resIssues,
+	})
+}
+
+func storageVerificationStatusToOpenAPI(status values.StorageVerificationStatus) (openapi.StorageVerificationStatus, bool) {
+	switch status {
+	case values.StorageVerificationStatusRunning:
+		return openapi.Running, true
+	case values.StorageVerificationStatusCompleted:
+		return openapi.Completed, true
+	case values.StorageVerificationStatusFailed:
+		return openapi.Failed, true
+	}
+
+	return "", false
+}
+
+func storageVerificationIssueToOpenAPI(issue *domain.StorageVerificationIssue) (openapi.StorageVerificationIssue, bool) {
+	var objectKind openapi.StorageVerificationIssueObjectKind
+	switch issue.GetObjectKind() {
+	case values.StorageVerificationObjectKindGameFile:
+		objectKind = openapi.StorageVerificationIssueObjectKindGameFile
+	case values.StorageVerificationObjectKindGameImage:
+		objectKind = openapi.StorageVerificationIssueObjectKindGameImage
+	case values.StorageVerificationObjectKindGameImageVariant:
+		objectKind = openapi.StorageVerificationIssueObjectKindGameImageVariant
+	case values.StorageVerificationObjectKindGameVideo:
+		objectKind = openapi.StorageVerificationIssueObjectKindGameVideo
+	case values.StorageVerificationObjectKindGameVideoPoster:
+		objectKind = openapi.StorageVerificationIssueObjectKindGameVideoPoster
+	default:
+		return openapi.StorageVerificationIssue{}, false
+	}
+
+	var issueType openapi.StorageVerificationIssueIssueType
+	switch issue.GetIssueType() {
+	case values.StorageVerificationIssueTypeMissing:
+		issueType = openapi.Missing
+	case values.StorageVerificationIssueTypeHashMismatch:
+		issueType = openapi.HashMismatch
+	case values.StorageVerificationIssueTypeCheckFailed:
+		issueType = openapi.CheckFailed
+	default:
+		return openapi.StorageVerificationIssue{}, false
+	}
+
+	return openapi.StorageVerificationIssue{
+		ObjectKind: objectKind,
+		ObjectId:   issue.GetObjectID(),
+		GameId:     uuid.UUID(issue.GetGameID()),
+		IssueType:  issueType,
+		Detail:     issue.GetDetail(),
+	}, true
+}
@@ -0,0 +1,202 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetStorageVerification(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+
+	runningVerification := domain.NewStorageVerification(
+		values.NewStorageVerificationID(),
+		values.StorageVerificationStatusRunning,
+		now,
+	)
+	runningVerification.SetProgress(1, 3)
+
+	completedVerification := domain.NewStorageVerification(
+		values.NewStorageVerificationID(),
+		values.StorageVerificationStatusRunning,
+		now,
+	)
+	completedVerification.SetProgress(3, 3)
+	completedVerification.AddIssue(values.StorageVerificationIssueTypeMissing)
+	completedVerification.AddIssue(values.StorageVerificationIssueTypeHashMismatch)
+	completedVerification.Finish(values.StorageVerificationStatusCompleted, now.Add(time.Minute))
+
+	gameID := values.NewGameID()
+	missingIssue := domain.NewStorageVerificationIssue(
+		values.StorageVerificationObjectKindGameVideoPoster,
+		uuid.New(),
+		gameID,
+		values.StorageVerificationIssueTypeMissing,
+		"",
+	)
+	hashMismatchIssue := domain.NewStorageVerificationIssue(
+		values.StorageVerificationObjectKindGameFile,
+		uuid.New(),
+		gameID,
+		values.StorageVerificationIssueTypeHashMismatch,
+		"expected aaaa, actual bbbb",
+	)
+	invalidIssue := domain.NewStorageVerificationIssue(
+		values.StorageVerificationObjectKind(100),
+		uuid.New(),
+		gameID,
+		values.StorageVerificationIssueTypeMissing,
+		"",
+	)
+
+	limit := 10
+	zeroLimit := 0
+
+	testCases := map[string]struct {
+		params       openapi.GetStorageVerificationParams
+		limit        int
+		verification *domain.StorageVerification
+		issues       []*domain.StorageVerificationIssue
+		getErr       error
+		expectStatus openapi.StorageVerificationStatus
+		expectIssues []openapi.StorageVerificationIssue
+		isErr        bool
+		statusCode   int
+	}{
+		"limitなしなので100件取得する": {
+			limit:        defaultStorageVerificationIssueLimit,
+			verification: completedVerification,
+			issues:       []*domain.StorageVerificationIssue{hashMismatchIssue, missingIssue},
+			expectStatus: openapi.Completed,
+			expectIssues: []openapi.StorageVerificationIssue{
+				{
+					ObjectKind: openapi.StorageVerificationIssueObjectKindGameFile,
+					ObjectId:   hashMismatchIssue.GetObjectID(),
+					GameId:     uuid.UUID(gameID),
+					IssueType:  openapi.HashMismatch,
+					Detail:     "expected aaaa, actual bbbb",
+				},
+				{
+					ObjectKind: openapi.StorageVerificationIssueObjectKindGameVideoPoster,
+					ObjectId:   missingIssue.GetObjectID(),
+					GameId:     uuid.UUID(gameID),
+					IssueType:  openapi.Missing,
+					Detail:     "",
+				},
+			},
+		},
+		"limitを指定できる": {
+			params:       openapi.GetStorageVerificationParams{Limit: &limit},
+			limit:        limit,
+			verification: completedVerification,
+			issues:       []*domain.StorageVerificationIssue{},
+			expectStatus: openapi.Completed,
+			expectIssues: []openapi.StorageVerificationIssue{},
+		},
+		"limitが0なので全て取得する": {
+			params:       openapi.GetStorageVerificationParams{Limit: &zeroLimit},
+			limit:        0,
+			verification: completedVerification,
+			issues:       []*domain.StorageVerificationIssue{},
+			expectStatus: openapi.Completed,
+			expectIssues: []openapi.StorageVerificationIssue{},
+		},
+		"実行中の検証も取得できる": {
+			limit:        defaultStorageVerificationIssueLimit,
+			verification: runningVerification,
+			issues:       []*domain.StorageVerificationIssue{},
+			expectStatus: openapi.Running,
+			expectIssues: []openapi.StorageVerificationIssue{},
+		},
+		"検証が行われていないので404": {
+			limit:      defaultStorageVerificationIssueLimit,
+			getErr:     service.ErrNoStorageVerification,
+			isErr:      true,
+			statusCode: http.StatusNotFound,
+		},
+		"ErrInvalidLimitなので400": {
+			limit:      defaultStorageVerificationIssueLimit,
+			getErr:     service.ErrInvalidLimit,
+			isErr:      true,
+			statusCode: http.StatusBadRequest,
+		},
+		"GetLatestStorageVerificationがエラーなので500": {
+			limit:      defaultStorageVerificationIssueLimit,
+			getErr:     errors.New("error"),
+			isErr:      true,
+			statusCode: http.StatusInternalServerError,
+		},
+		"不正な種類の問題があるので500": {
+			limit:        defaultStorageVerificationIssueLimit,
+			verification: completedVerification,
+			issues:       []*domain.StorageVerificationIssue{invalidIssue},
+			isErr:        true,
+			statusCode:   http.StatusInternalServerError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStorageVerificationService := mock.NewMockStorageVerification(ctrl)
+			storageVerification := NewStorageVerification(mockStorageVerificationService)
+
+			mockStorageVerificationService.
+				EXPECT().
+				GetLatestStorageVerification(gomock.Any(), testCase.limit).
+				Return(testCase.verification, testCase.issues, testCase.getErr)
+
+			c, _, rec := setupTestRequest(t, http.MethodGet, "/api/v2/admin/storage-verification", nil)
+
+			err := storageVerification.GetStorageVerification(c, testCase.params)
+
+			if testCase.isErr {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr) {
+					assert.Equal(t, testCase.statusCode, httpErr.Code)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var res openapi.StorageVerification
+			err = json.NewDecoder(rec.Body).Decode(&res)
+			require.NoError(t, err)
+
+			verification := testCase.verification
+			assert.Equal(t, uuid.UUID(verification.GetID()), res.Id)
+			assert.Equal(t, testCase.expectStatus, res.Status)
+			assert.Equal(t, verification.GetTotalObjects(), res.TotalObjects)
+			assert.Equal(t, verification.GetCheckedObjects(), res.CheckedObjects)
+			assert.Equal(t, verification.GetIssueCount(values.StorageVerificationIssueTypeMissing), res.Missing)
+			assert.Equal(t, verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch), res.HashMismatch)
+			assert.Equal(t, verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed), res.CheckFailed)
+			assert.WithinDuration(t, verification.GetStartedAt(), res.StartedAt, time.Second)
+			if finishedAt, ok := verification.GetFinishedAt().Value(); ok {
+				require.NotNil(t, res.FinishedAt)
+				assert.WithinDuration(t, finishedAt, *res.FinishedAt, time.Second)
+			} else {
+				assert.Nil(t, res.FinishedAt)
+			}
+			assert.Equal(t, testCase.expectIssues, res.Issues)
+		})
+	}
+}
@@ -12,7 +12,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/handler/session"
+	"go.uber.org/mock/gomock"
 )
 
 // setupTestRequestは、テスト用にEchoのContextを用意する。
@@ -136,6 +139,28 @@ type sessionValue struct {
 	value any
 }
 
+// newTestSessionは、テスト用のSessionを作成する。
+func newTestSession(t *testing.T, ctrl *gomock.Controller) *Session {
+	t.Helper()
+
+	mockConf := mockConfig.NewMockHandler(ctrl)
+	mockConf.
+		EXPECT().
+		SessionKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		SessionSecret().
+		Return("secret", nil)
+	sess, err := session.NewSession(mockConf)
+	require.NoError(t, err, "create session")
+
+	s, err := NewSession(sess)
+	require.NoError(t, err, "create session")
+
+	return s
+}
+
 // setTestSessionは、テスト用にCookieにセッションを設定する。
 // authSessionがnilでなければ、OIDCセッションのアクセストークンと有効期限をセッションに保存する。
 func setTestSession(t *testing.T, c echo.Context, req *http.Request, rec *httptest.ResponseRecorder,
@@ -1,13 +1,14 @@
 package v2
 
 import (
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi "github.com/traPtitech/trap-collection-server/src/handler/v2/openapi"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -26,20 +27,20 @@ func NewUser(session *Session, oidcService service.OIDCV2) *User {
 func (u *User) GetMe(c echo.Context) error {
 	session, err := u.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
 	authSession, err := u.session.getAuthSession(session)
 	if err != nil {
 		// middlewareでログイン済みなことは確認しているので、ここではエラーになりえないはず
-		log.Printf("error: failed to get auth session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get auth session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
 	userInfo, err := u.oidcService.GetMe(c.Request().Context(), authSession)
 	if err != nil {
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get user info", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -52,14 +53,14 @@ func (u *User) GetMe(c echo.Context) error {
 func (u *User) GetUsers(c echo.Context, _ openapi.GetUsersParams) error {
 	session, err := u.session.get(c)
 	if err != nil {
-		log.Printf("error: failed to get session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
 	authSession, err := u.session.getAuthSession(session)
 	if err != nil {
 		// middlewareでログイン済みなことは確認しているので、ここではエラーになりえないはず
-		log.Printf("error: failed to get auth session: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get auth session", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
@@ -74,7 +75,7 @@ func (u *User) GetUsers(c echo.Context, _ openapi.GetUsersParams) error {
 
 	userInfos, err := u.oidcService.GetActiveUsers(c.Request().Context(), authSession, includeBot)
 	if err != nil {
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(c.Request().Context(), "failed to get user info", slog.Any("error", err))
 		return echo.NewHTTPError(http.StatusInternalServerError)
 	}
 
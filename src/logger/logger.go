@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/tracing"
+)
+
+// New
+// 設定に従いログの出力先をwとするロガーを作成する。
+// contextにスパンがある場合はtrace_idとspan_idも出力する。
+func New(conf config.Log, w io.Writer) (*slog.Logger, error) {
+	level, err := conf.Level()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log level: %w", err)
+	}
+
+	format, err := conf.Format()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log format: %w", err)
+	}
+
+	opts := &slog.HandlerOptions{
+		Level: level,
+	}
+
+	var handler slog.Handler
+	switch format {
+	case config.LogFormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	case config.LogFormatText:
+		handler = slog.NewTextHandler(w, opts)
+	default:
+		return nil, errors.New("invalid log format")
+	}
+
+	return slog.New(tracing.NewLogHandler(handler)), nil
+}
+
+type contextKey struct{}
+
+// WithContext
+// ロガーを持たせたcontextを返す。
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext
+// contextに持たせたロガーを返す。
+// ロガーが無い場合はslog.Default()を返す。
+func FromContext(ctx context.Context) *slog.Logger {
+	logger, ok := ctx.Value(contextKey{}).(*slog.Logger)
+	if !ok || logger == nil {
+		return slog.Default()
+	}
+
+	return logger
+}
+
+// With
+// contextのロガーに属性を追加したcontextを返す。
+// リクエストIDやユーザーなど、以降のログ全てに出力したい情報を追加するのに使う。
+func With(ctx context.Context, args ...any) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
+
+func Debug(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).DebugContext(ctx, msg, args...)
+}
+
+func Info(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).InfoContext(ctx, msg, args...)
+}
+
+func Warn(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).WarnContext(ctx, msg, args...)
+}
+
+func Error(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).ErrorContext(ctx, msg, args...)
+}
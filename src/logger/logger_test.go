@@ -0,0 +1,119 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	testCases := map[string]struct {
+		level  slog.Level
+		format config.LogFormat
+		isErr  bool
+		isJSON bool
+	}{
+		"JSONで出力できる": {
+			level:  slog.LevelInfo,
+			format: config.LogFormatJSON,
+			isJSON: true,
+		},
+		"テキストで出力できる": {
+			level:  slog.LevelInfo,
+			format: config.LogFormatText,
+		},
+		"不正なフォーマットなのでエラー": {
+			level:  slog.LevelInfo,
+			format: 100,
+			isErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockConf := mock.NewMockLog(ctrl)
+			mockConf.
+				EXPECT().
+				Level().
+				Return(testCase.level, nil)
+			mockConf.
+				EXPECT().
+				Format().
+				Return(testCase.format, nil)
+
+			buf := &bytes.Buffer{}
+			logger, err := New(mockConf, buf)
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			logger.Info("message", slog.String("key", "value"))
+			logger.Debug("debug message")
+
+			assert.NotContains(t, buf.String(), "debug message")
+			if testCase.isJSON {
+				var log map[string]any
+				err := json.Unmarshal(buf.Bytes(), &log)
+				assert.NoError(t, err)
+				assert.Equal(t, "message", log["msg"])
+				assert.Equal(t, "value", log["key"])
+			} else {
+				assert.Contains(t, buf.String(), "msg=message")
+				assert.Contains(t, buf.String(), "key=value")
+			}
+		})
+	}
+}
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	ctx := WithContext(context.Background(), slog.New(slog.NewTextHandler(buf, nil)))
+
+	ctx = With(ctx, slog.String("request_id", "id"))
+	Info(ctx, "first")
+
+	// 元のcontextのロガーには影響しない
+	_ = With(ctx, slog.String("user_id", "user"))
+	Error(ctx, "second")
+
+	ctx = With(ctx, slog.String("user_id", "user"))
+	Warn(ctx, "third")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if !assert.Len(t, lines, 3) {
+		return
+	}
+
+	assert.Contains(t, string(lines[0]), "request_id=id")
+	assert.NotContains(t, string(lines[0]), "user_id")
+	assert.Contains(t, string(lines[1]), "request_id=id")
+	assert.NotContains(t, string(lines[1]), "user_id")
+	assert.Contains(t, string(lines[2]), "request_id=id")
+	assert.Contains(t, string(lines[2]), "user_id=user")
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, slog.Default(), FromContext(context.Background()))
+
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	assert.Equal(t, logger, FromContext(WithContext(context.Background(), logger)))
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock -typed
+
+type AuditLog interface {
+	// CreateAuditLog
+	// 監査ログを追加する。
+	CreateAuditLog(ctx context.Context, auditLog *domain.AuditLog) error
+	// GetAuditLogs
+	// 取得する個数の上限(limit>=0)と開始位置(offset>=0)を指定して、新しい順に監査ログを取得する。
+	// limitが0のときは、すべての監査ログを取得する。
+	// 返り値のintはlimitとoffsetをかけないときの監査ログの数。
+	// limitが負のとき、ErrNegativeLimitを返す。
+	GetAuditLogs(ctx context.Context, limit int, offset int, params GetAuditLogsParams) ([]*domain.AuditLog, int, error)
+}
+
+// GetAuditLogsParams
+// 監査ログの絞り込み条件。
+// 値が設定されていない条件では絞り込みを行わない。
+type GetAuditLogsParams struct {
+	ActorID    option.Option[values.TraPMemberID]
+	Action     option.Option[values.AuditLogAction]
+	TargetType option.Option[values.AuditLogTargetType]
+	TargetID   option.Option[uuid.UUID]
+	// Since 指定した時刻以降の監査ログのみを取得する。
+	Since option.Option[time.Time]
+	// Until 指定した時刻より前の監査ログのみを取得する。
+	Until option.Option[time.Time]
+}
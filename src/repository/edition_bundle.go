@@ -0,0 +1,49 @@
+package repository
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+type EditionBundle interface {
+	// SaveEditionBundle
+	// バンドルの保存。
+	SaveEditionBundle(ctx context.Context, bundle *domain.EditionBundle) error
+	// GetEditionBundle
+	// バンドルの取得。
+	// バンドルが存在しない場合、ErrRecordNotFoundを返す。
+	GetEditionBundle(ctx context.Context, bundleID values.EditionBundleID, lockType LockType) (*domain.EditionBundle, error)
+	// GetEditionBundles
+	// エディションのバンドル一覧の取得。
+	// 並び順はCreatedAtの降順。
+	GetEditionBundles(ctx context.Context, editionID values.EditionID) ([]*domain.EditionBundle, error)
+	// GetEditionBundlesByStatus
+	// 状態を指定してバンドルを取得する。
+	// 並び順はCreatedAtの昇順。
+	GetEditionBundlesByStatus(ctx context.Context, status values.EditionBundleStatus) ([]*domain.EditionBundle, error)
+	// ClaimEditionBundle
+	// 未生成のバンドルを生成中にし、ownerが生成することを記録する。
+	// 状態の確認と更新を1つの更新で行うので、複数のサーバーが同時に呼んでも1つだけが成功する。
+	// バンドルが存在しない、もしくは未生成でない場合、ErrNoRecordUpdatedを返す。
+	ClaimEditionBundle(ctx context.Context, bundleID values.EditionBundleID, owner uuid.UUID, now time.Time) error
+	// UpdateOwnedEditionBundle
+	// ownerが生成中のバンドルの状態・進捗・容量の更新。
+	// 生存を報告した日時もnowに更新する。
+	// バンドルが存在しない、もしくはownerが生成中でない場合、ErrNoRecordUpdatedを返す。
+	UpdateOwnedEditionBundle(ctx context.Context, bundle *domain.EditionBundle, owner uuid.UUID, now time.Time) error
+	// HeartbeatEditionBundle
+	// ownerが生成中のバンドルについて、生存を報告した日時をnowに更新する。
+	// バンドルが存在しない、もしくはownerが生成中でない場合、ErrNoRecordUpdatedを返す。
+	HeartbeatEditionBundle(ctx context.Context, bundleID values.EditionBundleID, owner uuid.UUID, now time.Time) error
+	// FailStaleEditionBundles
+	// 生存を報告した日時がstaleBeforeより前の生成中のバンドルを、生成に失敗したものにする。
+	// 失敗にしたバンドルの数を返す。
+	FailStaleEditionBundles(ctx context.Context, staleBefore time.Time) (int, error)
+}
@@ -12,6 +12,7 @@ import (
 type GameCreator interface {
 	// GetGameCreatorsByGameID
 	// ゲームIDに紐づくゲームクリエイターとそのジョブ一覧を取得する
+	// クリエイターは表示順、ジョブはクリエイター内での表示順に並ぶ。
 	GetGameCreatorsByGameID(ctx context.Context, gameID values.GameID) ([]*domain.GameCreatorWithJobs, error)
 	// GetGameCreatorPresetJobs
 	// あらかじめ用意されている、プリセットのゲームクリエイターのジョブ一覧を取得する
@@ -50,4 +51,13 @@ type GameCreator interface {
 	// DeleteGameCreatorPresetJobs
 	// creator に紐づいた preset job を削除する。
 	DeleteGameCreatorPresetJobs(ctx context.Context, creatorID values.GameCreatorID) error
+	// UpdateGameCreatorDisplayOrders
+	// creatorIDsの順番をゲーム内でのクリエイターの表示順として保存する。
+	// creatorIDsに含まれないクリエイターの表示順は変更しない。
+	UpdateGameCreatorDisplayOrders(ctx context.Context, gameID values.GameID, creatorIDs []values.GameCreatorID) error
+	// UpdateGameCreatorJobDisplayOrders
+	// jobIDsの順番をクリエイター内でのジョブの表示順として保存する。
+	// preset job と custom job のどちらも指定できる。
+	// creator に紐づいていない job の id は無視される。
+	UpdateGameCreatorJobDisplayOrders(ctx context.Context, creatorID values.GameCreatorID, jobIDs []values.GameCreatorJobID) error
 }
@@ -44,9 +44,41 @@ type GameGenre interface {
 	// GetGamesByGenreID
 	// ゲームジャンルのIDからそのジャンルに含まれるゲームを取得する。
 	GetGamesByGenreID(ctx context.Context, gameGenreID values.GameGenreID) ([]*domain.Game, error)
+	// GetGameGenreParentIDs
+	// 親ジャンルを持つ全てのゲームジャンルについて、ジャンルのIDから親ジャンルのIDへのmapを返す。
+	GetGameGenreParentIDs(ctx context.Context) (map[values.GameGenreID]values.GameGenreID, error)
+	// UpdateGameGenreParent
+	// ゲームジャンルの親ジャンルを変更する。parentIDがnilのときは親ジャンル無しにする。
+	// ゲームジャンルが存在しない場合、または変更が起きなかった場合は、ErrNoRecordUpdatedを返す。
+	UpdateGameGenreParent(ctx context.Context, gameGenreID values.GameGenreID, parentID *values.GameGenreID) error
+	// MergeGameGenre
+	// sourceのジャンルを持つゲーム、sourceの別名、sourceの子ジャンルをtargetに付け替える。
+	// 既にtargetのジャンルを持つゲームは、targetのジャンルを1つだけ持つようにする。
+	// source自体は削除しない。
+	MergeGameGenre(ctx context.Context, sourceID values.GameGenreID, targetID values.GameGenreID) error
+	// GetGameGenreAliases
+	// 全てのゲームジャンルの別名を取得する。
+	GetGameGenreAliases(ctx context.Context) ([]*domain.GameGenreAlias, error)
+	// GetGameGenreAliasesWithNames
+	// 名前の配列を指定してゲームジャンルの別名を取得する。
+	// 該当する別名が存在しない場合は、空配列を返す。
+	GetGameGenreAliasesWithNames(ctx context.Context, names []values.GameGenreName) ([]*domain.GameGenreAlias, error)
+	// GetGameGenreAlias
+	// 別名のIDから別名を取得する。
+	// 別名が存在しない場合は、ErrRecordNotFoundを返す。
+	GetGameGenreAlias(ctx context.Context, aliasID values.GameGenreAliasID) (*domain.GameGenreAlias, error)
+	// SaveGameGenreAlias
+	// ゲームジャンルの別名を作成する。
+	// 名前が重複する別名が存在するとき、ErrDuplicatedUniqueKeyを返す。
+	SaveGameGenreAlias(ctx context.Context, alias *domain.GameGenreAlias) error
+	// RemoveGameGenreAlias
+	// ゲームジャンルの別名を削除する。
+	// 別名が存在しない場合は、ErrNoRecordDeletedを返す。
+	RemoveGameGenreAlias(ctx context.Context, aliasID values.GameGenreAliasID) error
 }
 
 type GameGenreInfo struct {
 	domain.GameGenre
-	Num int //そのジャンルに含まれるゲームの数
+	Num      int                 //そのジャンルに含まれるゲームの数
+	ParentID *values.GameGenreID // 親ジャンルのID。親ジャンルが無いときはnil
 }
@@ -0,0 +1,37 @@
+package repository
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+type GameRoleInvitation interface {
+	// SaveGameRoleInvitation
+	// ゲームの管理権限への招待を保存する。
+	SaveGameRoleInvitation(ctx context.Context, gameID values.GameID, invitation *domain.GameRoleInvitation) error
+	// UpdateGameRoleInvitationStatus
+	// 招待の状態を更新する。
+	// 招待が存在しない場合、ErrNoRecordUpdatedを返す。
+	UpdateGameRoleInvitationStatus(ctx context.Context, invitationID values.GameRoleInvitationID, status values.GameRoleInvitationStatus) error
+	// GetGameRoleInvitation
+	// 招待をIDで取得する。
+	// 招待が存在しない場合、ErrRecordNotFoundを返す。
+	GetGameRoleInvitation(ctx context.Context, invitationID values.GameRoleInvitationID, lockType LockType) (*GameRoleInvitationInfo, error)
+	// GetPendingGameRoleInvitationsByInviteeID
+	// 指定したユーザーへの応答待ちの招待を新しい順に取得する。
+	// 有効期限切れの招待も含む。
+	GetPendingGameRoleInvitationsByInviteeID(ctx context.Context, inviteeID values.TraPMemberID) ([]*GameRoleInvitationInfo, error)
+	// GetPendingGameRoleInvitationsByGameID
+	// 指定したゲームへの応答待ちの招待を新しい順に取得する。
+	// 有効期限切れの招待も含む。
+	GetPendingGameRoleInvitationsByGameID(ctx context.Context, gameID values.GameID, lockType LockType) ([]*domain.GameRoleInvitation, error)
+}
+
+type GameRoleInvitationInfo struct {
+	*domain.GameRoleInvitation
+	GameID values.GameID
+}
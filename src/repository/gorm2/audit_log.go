@@ -0,0 +1,296 @@
+package gorm2
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+var _ repository.AuditLog = &AuditLog{}
+
+type AuditLog struct {
+	db *DB
+}
+
+func NewAuditLog(db *DB) *AuditLog {
+	return &AuditLog{
+		db: db,
+	}
+}
+
+func (a *AuditLog) CreateAuditLog(ctx context.Context, auditLog *domain.AuditLog) error {
+	db, err := a.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	action, err := convertAuditLogAction(auditLog.GetAction())
+	if err != nil {
+		return fmt.Errorf("failed to convert action: %w", err)
+	}
+
+	targetType, err := convertAuditLogTargetType(auditLog.GetTargetType())
+	if err != nil {
+		return fmt.Errorf("failed to convert target type: %w", err)
+	}
+
+	auditLogTable := schema.AuditLogTable{
+		ID:         uuid.UUID(auditLog.GetID()),
+		ActorID:    uuid.UUID(auditLog.GetActorID()),
+		ActorName:  string(auditLog.GetActorName()),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   auditLog.GetTargetID(),
+		Before:     rawMessageToNullString(auditLog.GetBefore()),
+		After:      rawMessageToNullString(auditLog.GetAfter()),
+		CreatedAt:  auditLog.GetCreatedAt(),
+	}
+
+	err = db.Create(&auditLogTable).Error
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return repository.ErrDuplicatedUniqueKey
+		}
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	return nil
+}
+
+func (a *AuditLog) GetAuditLogs(ctx context.Context, limit int, offset int, params repository.GetAuditLogsParams) ([]*domain.AuditLog, int, error) {
+	db, err := a.db.getDB(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	if limit < 0 {
+		return nil, 0, repository.ErrNegativeLimit
+	}
+	if limit == 0 && offset != 0 {
+		return nil, 0, errors.New("bad limit and offset")
+	}
+
+	tx := db.Model(&schema.AuditLogTable{})
+
+	if actorID, ok := params.ActorID.Value(); ok {
+		tx = tx.Where("actor_id = ?", uuid.UUID(actorID))
+	}
+	if action, ok := params.Action.Value(); ok {
+		actionName, err := convertAuditLogAction(action)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to convert action: %w", err)
+		}
+		tx = tx.Where("action = ?", actionName)
+	}
+	if targetType, ok := params.TargetType.Value(); ok {
+		targetTypeName, err := convertAuditLogTargetType(targetType)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to convert target type: %w", err)
+		}
+		tx = tx.Where("target_type = ?", targetTypeName)
+	}
+	if targetID, ok := params.TargetID.Value(); ok {
+		tx = tx.Where("target_id = ?", targetID)
+	}
+	if since, ok := params.Since.Value(); ok {
+		tx = tx.Where("created_at >= ?", since)
+	}
+	if until, ok := params.Until.Value(); ok {
+		tx = tx.Where("created_at < ?", until)
+	}
+
+	// 同時刻のログの順序を安定させるため、idでもソートする
+	txSelect := tx.Session(&gorm.Session{}).Order("created_at DESC").Order("id")
+	if limit > 0 {
+		txSelect = txSelect.Limit(limit).Offset(offset)
+	}
+
+	var auditLogs []schema.AuditLogTable
+	err = txSelect.Find(&auditLogs).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+
+	auditLogsDomain := make([]*domain.AuditLog, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		action, err := parseAuditLogAction(auditLog.Action)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse action: %w", err)
+		}
+
+		targetType, err := parseAuditLogTargetType(auditLog.TargetType)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse target type: %w", err)
+		}
+
+		auditLogsDomain = append(auditLogsDomain, domain.NewAuditLog(
+			values.NewAuditLogIDFromUUID(auditLog.ID),
+			values.NewTrapMemberID(auditLog.ActorID),
+			values.NewTrapMemberName(auditLog.ActorName),
+			action,
+			targetType,
+			auditLog.TargetID,
+			nullStringToRawMessage(auditLog.Before),
+			nullStringToRawMessage(auditLog.After),
+			auditLog.CreatedAt,
+		))
+	}
+
+	var count int64
+	err = tx.Count(&count).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	return auditLogsDomain, int(count), nil
+}
+
+func rawMessageToNullString(message json.RawMessage) sql.NullString {
+	if message == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{
+		String: string(message),
+		Valid:  true,
+	}
+}
+
+func nullStringToRawMessage(str sql.NullString) json.RawMessage {
+	if !str.Valid {
+		return nil
+	}
+
+	return json.RawMessage(str.String)
+}
+
+func convertAuditLogAction(action values.AuditLogAction) (string, error) {
+	switch action {
+	case values.AuditLogActionAddAdmin:
+		return schema.AuditLogActionAddAdmin, nil
+	case values.AuditLogActionDeleteAdmin:
+		return schema.AuditLogActionDeleteAdmin, nil
+	case values.AuditLogActionEditGameManagementRole:
+		return schema.AuditLogActionEditGameManagementRole, nil
+	case values.AuditLogActionRemoveGameManagementRole:
+		return schema.AuditLogActionRemoveGameManagementRole, nil
+	case values.AuditLogActionUpdateGame:
+		return schema.AuditLogActionUpdateGame, nil
+	case values.AuditLogActionUpdateEdition:
+		return schema.AuditLogActionUpdateEdition, nil
+	case values.AuditLogActionUpdateEditionGameVersions:
+		return schema.AuditLogActionUpdateEditionGameVersions, nil
+	case values.AuditLogActionDeleteEdition:
+		return schema.AuditLogActionDeleteEdition, nil
+	case values.AuditLogActionActivateProductKey:
+		return schema.AuditLogActionActivateProductKey, nil
+	case values.AuditLogActionRevokeProductKey:
+		return schema.AuditLogActionRevokeProductKey, nil
+	case values.AuditLogActionTransferGameOwnership:
+		return schema.AuditLogActionTransferGameOwnership, nil
+	case values.AuditLogActionMergeGameGenres:
+		return schema.AuditLogActionMergeGameGenres, nil
+	case values.AuditLogActionUpdateGameGenreParent:
+		return schema.AuditLogActionUpdateGameGenreParent, nil
+	case values.AuditLogActionAddGameGenreAlias:
+		return schema.AuditLogActionAddGameGenreAlias, nil
+	case values.AuditLogActionDeleteGameGenreAlias:
+		return schema.AuditLogActionDeleteGameGenreAlias, nil
+	case values.AuditLogActionUpdateGameStorageQuota:
+		return schema.AuditLogActionUpdateGameStorageQuota, nil
+	case values.AuditLogActionDeleteGameStorageQuota:
+		return schema.AuditLogActionDeleteGameStorageQuota, nil
+	case values.AuditLogActionUpdateGameFileScanStatus:
+		return schema.AuditLogActionUpdateGameFileScanStatus, nil
+	default:
+		return "", fmt.Errorf("invalid audit log action: %d", action)
+	}
+}
+
+func parseAuditLogAction(action string) (values.AuditLogAction, error) {
+	switch action {
+	case schema.AuditLogActionAddAdmin:
+		return values.AuditLogActionAddAdmin, nil
+	case schema.AuditLogActionDeleteAdmin:
+		return values.AuditLogActionDeleteAdmin, nil
+	case schema.AuditLogActionEditGameManagementRole:
+		return values.AuditLogActionEditGameManagementRole, nil
+	case schema.AuditLogActionRemoveGameManagementRole:
+		return values.AuditLogActionRemoveGameManagementRole, nil
+	case schema.AuditLogActionUpdateGame:
+		return values.AuditLogActionUpdateGame, nil
+	case schema.AuditLogActionUpdateEdition:
+		return values.AuditLogActionUpdateEdition, nil
+	case schema.AuditLogActionUpdateEditionGameVersions:
+		return values.AuditLogActionUpdateEditionGameVersions, nil
+	case schema.AuditLogActionDeleteEdition:
+		return values.AuditLogActionDeleteEdition, nil
+	case schema.AuditLogActionActivateProductKey:
+		return values.AuditLogActionActivateProductKey, nil
+	case schema.AuditLogActionRevokeProductKey:
+		return values.AuditLogActionRevokeProductKey, nil
+	case schema.AuditLogActionTransferGameOwnership:
+		return values.AuditLogActionTransferGameOwnership, nil
+	case schema.AuditLogActionMergeGameGenres:
+		return values.AuditLogActionMergeGameGenres, nil
+	case schema.AuditLogActionUpdateGameGenreParent:
+		return values.AuditLogActionUpdateGameGenreParent, nil
+	case schema.AuditLogActionAddGameGenreAlias:
+		return values.AuditLogActionAddGameGenreAlias, nil
+	case schema.AuditLogActionDeleteGameGenreAlias:
+		return values.AuditLogActionDeleteGameGenreAlias, nil
+	case schema.AuditLogActionUpdateGameStorageQuota:
+		return values.AuditLogActionUpdateGameStorageQuota, nil
+	case schema.AuditLogActionDeleteGameStorageQuota:
+		return values.AuditLogActionDeleteGameStorageQuota, nil
+	case schema.AuditLogActionUpdateGameFileScanStatus:
+		return values.AuditLogActionUpdateGameFileScanStatus, nil
+	default:
+		return 0, fmt.Errorf("invalid audit log action: %s", action)
+	}
+}
+
+func convertAuditLogTargetType(targetType values.AuditLogTargetType) (string, error) {
+	switch targetType {
+	case values.AuditLogTargetTypeUser:
+		return schema.AuditLogTargetTypeUser, nil
+	case values.AuditLogTargetTypeGame:
+		return schema.AuditLogTargetTypeGame, nil
+	case values.AuditLogTargetTypeEdition:
+		return schema.AuditLogTargetTypeEdition, nil
+	case values.AuditLogTargetTypeProductKey:
+		return schema.AuditLogTargetTypeProductKey, nil
+	case values.AuditLogTargetTypeGameGenre:
+		return schema.AuditLogTargetTypeGameGenre, nil
+	default:
+		return "", fmt.Errorf("invalid audit log target type: %d", targetType)
+	}
+}
+
+func parseAuditLogTargetType(targetType string) (values.AuditLogTargetType, error) {
+	switch targetType {
+	case schema.AuditLogTargetTypeUser:
+		return values.AuditLogTargetTypeUser, nil
+	case schema.AuditLogTargetTypeGame:
+		return values.AuditLogTargetTypeGame, nil
+	case schema.AuditLogTargetTypeEdition:
+		return values.AuditLogTargetTypeEdition, nil
+	case schema.AuditLogTargetTypeProductKey:
+		return values.AuditLogTargetTypeProductKey, nil
+	case schema.AuditLogTargetTypeGameGenre:
+		return values.AuditLogTargetTypeGameGenre, nil
+	default:
+		return 0, fmt.Errorf("invalid audit log target type: %s", targetType)
+	}
+}
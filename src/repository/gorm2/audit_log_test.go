@@ -0,0 +1,328 @@
+package gorm2
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+)
+
+func TestCreateAuditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	auditLogRepository := NewAuditLog(testDB)
+
+	type test struct {
+		description     string
+		auditLog        *domain.AuditLog
+		beforeAuditLogs []schema.AuditLogTable
+		expectAuditLog  schema.AuditLogTable
+		isErr           bool
+		err             error
+	}
+
+	auditLogID1 := values.NewAuditLogID()
+	auditLogID2 := values.NewAuditLogID()
+	auditLogID3 := values.NewAuditLogID()
+	auditLogID4 := values.NewAuditLogID()
+
+	actorID := values.NewTrapMemberID(uuid.New())
+	targetID := uuid.New()
+
+	now := time.Now()
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			auditLog: domain.NewAuditLog(
+				auditLogID1,
+				actorID,
+				values.NewTrapMemberName("mazrean"),
+				values.AuditLogActionUpdateGame,
+				values.AuditLogTargetTypeGame,
+				targetID,
+				json.RawMessage(`{"name":"before"}`),
+				json.RawMessage(`{"name":"after"}`),
+				now,
+			),
+			expectAuditLog: schema.AuditLogTable{
+				ID:         uuid.UUID(auditLogID1),
+				ActorID:    uuid.UUID(actorID),
+				ActorName:  "mazrean",
+				Action:     schema.AuditLogActionUpdateGame,
+				TargetType: schema.AuditLogTargetTypeGame,
+				TargetID:   targetID,
+				Before:     sql.NullString{String: `{"name":"before"}`, Valid: true},
+				After:      sql.NullString{String: `{"name":"after"}`, Valid: true},
+				CreatedAt:  now,
+			},
+		},
+		{
+			description: "beforeがnilでもエラーなし",
+			auditLog: domain.NewAuditLog(
+				auditLogID2,
+				actorID,
+				values.NewTrapMemberName("mazrean"),
+				values.AuditLogActionAddAdmin,
+				values.AuditLogTargetTypeUser,
+				targetID,
+				nil,
+				json.RawMessage(`{}`),
+				now,
+			),
+			expectAuditLog: schema.AuditLogTable{
+				ID:         uuid.UUID(auditLogID2),
+				ActorID:    uuid.UUID(actorID),
+				ActorName:  "mazrean",
+				Action:     schema.AuditLogActionAddAdmin,
+				TargetType: schema.AuditLogTargetTypeUser,
+				TargetID:   targetID,
+				After:      sql.NullString{String: `{}`, Valid: true},
+				CreatedAt:  now,
+			},
+		},
+		{
+			description: "actionが不正なのでエラー",
+			auditLog: domain.NewAuditLog(
+				auditLogID3,
+				actorID,
+				values.NewTrapMemberName("mazrean"),
+				values.AuditLogAction(100),
+				values.AuditLogTargetTypeUser,
+				targetID,
+				nil,
+				nil,
+				now,
+			),
+			isErr: true,
+		},
+		{
+			description: "IDが重複しているのでErrDuplicatedUniqueKey",
+			auditLog: domain.NewAuditLog(
+				auditLogID4,
+				actorID,
+				values.NewTrapMemberName("mazrean"),
+				values.AuditLogActionDeleteAdmin,
+				values.AuditLogTargetTypeUser,
+				targetID,
+				nil,
+				nil,
+				now,
+			),
+			beforeAuditLogs: []schema.AuditLogTable{
+				{
+					ID:         uuid.UUID(auditLogID4),
+					ActorID:    uuid.UUID(actorID),
+					ActorName:  "mazrean",
+					Action:     schema.AuditLogActionDeleteAdmin,
+					TargetType: schema.AuditLogTargetTypeUser,
+					TargetID:   targetID,
+					CreatedAt:  now,
+				},
+			},
+			isErr: true,
+			err:   repository.ErrDuplicatedUniqueKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeAuditLogs) != 0 {
+				err := db.Create(&testCase.beforeAuditLogs).Error
+				require.NoError(t, err)
+			}
+
+			err := auditLogRepository.CreateAuditLog(ctx, testCase.auditLog)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !assert.ErrorIs(t, err, testCase.err) {
+					t.Logf("error: %v", err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			var auditLog schema.AuditLogTable
+			err = db.
+				Where("id = ?", uuid.UUID(testCase.auditLog.GetID())).
+				Take(&auditLog).Error
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectAuditLog.ID, auditLog.ID)
+			assert.Equal(t, testCase.expectAuditLog.ActorID, auditLog.ActorID)
+			assert.Equal(t, testCase.expectAuditLog.ActorName, auditLog.ActorName)
+			assert.Equal(t, testCase.expectAuditLog.Action, auditLog.Action)
+			assert.Equal(t, testCase.expectAuditLog.TargetType, auditLog.TargetType)
+			assert.Equal(t, testCase.expectAuditLog.TargetID, auditLog.TargetID)
+			assert.Equal(t, testCase.expectAuditLog.Before, auditLog.Before)
+			assert.Equal(t, testCase.expectAuditLog.After, auditLog.After)
+			assert.WithinDuration(t, testCase.expectAuditLog.CreatedAt, auditLog.CreatedAt, time.Second)
+		})
+	}
+}
+
+func TestGetAuditLogs(t *testing.T) {
+	// 他のテストで作られた監査ログが結果に含まれないよう、actorIDで絞り込んで確認する
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	auditLogRepository := NewAuditLog(testDB)
+
+	actorID := values.NewTrapMemberID(uuid.New())
+	targetID1 := uuid.New()
+	targetID2 := uuid.New()
+
+	now := time.Now().Truncate(time.Second)
+
+	auditLogID1 := values.NewAuditLogID()
+	auditLogID2 := values.NewAuditLogID()
+	auditLogID3 := values.NewAuditLogID()
+
+	err = db.Create([]schema.AuditLogTable{
+		{
+			ID:         uuid.UUID(auditLogID1),
+			ActorID:    uuid.UUID(actorID),
+			ActorName:  "mazrean",
+			Action:     schema.AuditLogActionUpdateGame,
+			TargetType: schema.AuditLogTargetTypeGame,
+			TargetID:   targetID1,
+			Before:     sql.NullString{String: `{"name":"a"}`, Valid: true},
+			After:      sql.NullString{String: `{"name":"b"}`, Valid: true},
+			CreatedAt:  now.Add(-2 * time.Hour),
+		},
+		{
+			ID:         uuid.UUID(auditLogID2),
+			ActorID:    uuid.UUID(actorID),
+			ActorName:  "mazrean",
+			Action:     schema.AuditLogActionRevokeProductKey,
+			TargetType: schema.AuditLogTargetTypeProductKey,
+			TargetID:   targetID2,
+			CreatedAt:  now.Add(-1 * time.Hour),
+		},
+		{
+			ID:         uuid.UUID(auditLogID3),
+			ActorID:    uuid.UUID(actorID),
+			ActorName:  "mazrean",
+			Action:     schema.AuditLogActionUpdateGame,
+			TargetType: schema.AuditLogTargetTypeGame,
+			TargetID:   targetID1,
+			CreatedAt:  now,
+		},
+	}).Error
+	require.NoError(t, err)
+
+	type test struct {
+		description string
+		limit       int
+		offset      int
+		params      repository.GetAuditLogsParams
+		expectIDs   []values.AuditLogID
+		expectNum   int
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "actorで絞り込むと新しい順に全て取得できる",
+			params: repository.GetAuditLogsParams{
+				ActorID: option.NewOption(actorID),
+			},
+			expectIDs: []values.AuditLogID{auditLogID3, auditLogID2, auditLogID1},
+			expectNum: 3,
+		},
+		{
+			description: "limitとoffsetが効く",
+			limit:       1,
+			offset:      1,
+			params: repository.GetAuditLogsParams{
+				ActorID: option.NewOption(actorID),
+			},
+			expectIDs: []values.AuditLogID{auditLogID2},
+			expectNum: 3,
+		},
+		{
+			description: "actionで絞り込める",
+			params: repository.GetAuditLogsParams{
+				ActorID: option.NewOption(actorID),
+				Action:  option.NewOption(values.AuditLogActionRevokeProductKey),
+			},
+			expectIDs: []values.AuditLogID{auditLogID2},
+			expectNum: 1,
+		},
+		{
+			description: "対象で絞り込める",
+			params: repository.GetAuditLogsParams{
+				ActorID:    option.NewOption(actorID),
+				TargetType: option.NewOption(values.AuditLogTargetTypeGame),
+				TargetID:   option.NewOption(targetID1),
+			},
+			expectIDs: []values.AuditLogID{auditLogID3, auditLogID1},
+			expectNum: 2,
+		},
+		{
+			description: "期間で絞り込める",
+			params: repository.GetAuditLogsParams{
+				ActorID: option.NewOption(actorID),
+				Since:   option.NewOption(now.Add(-90 * time.Minute)),
+				Until:   option.NewOption(now),
+			},
+			expectIDs: []values.AuditLogID{auditLogID2},
+			expectNum: 1,
+		},
+		{
+			description: "limitが負なのでErrNegativeLimit",
+			limit:       -1,
+			isErr:       true,
+			err:         repository.ErrNegativeLimit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			auditLogs, num, err := auditLogRepository.GetAuditLogs(ctx, testCase.limit, testCase.offset, testCase.params)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !assert.ErrorIs(t, err, testCase.err) {
+					t.Logf("error: %v", err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expectNum, num)
+			require.Len(t, auditLogs, len(testCase.expectIDs))
+			for i, auditLog := range auditLogs {
+				assert.Equal(t, testCase.expectIDs[i], auditLog.GetID())
+				assert.Equal(t, actorID, auditLog.GetActorID())
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 	"gorm.io/plugin/prometheus"
 )
 
@@ -21,7 +22,26 @@ type DB struct {
 	db *gorm.DB
 }
 
+// NewDB
+// DBに接続し、スキーマのマイグレーションを適用する。
 func NewDB(appConf config.App, conf config.RepositoryGorm2, migrationConf config.Migration) (*DB, error) {
+	db, err := NewDBWithoutMigration(appConf, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = schema.Migrate(context.Background(), conf, migrationConf, db.db)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+
+	return db, nil
+}
+
+// NewDBWithoutMigration
+// マイグレーションを適用せずにDBに接続する。
+// マイグレーションの状態の確認など、マイグレーション自体を管理する場合に使う。
+func NewDBWithoutMigration(appConf config.App, conf config.RepositoryGorm2) (*DB, error) {
 	appStatus, err := appConf.Status()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get app status: %w", err)
@@ -78,11 +98,6 @@ func NewDB(appConf config.App, conf config.RepositoryGorm2, migrationConf config
 
 	db = db.Set("gorm:table_options", "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci")
 
-	err = schema.Migrate(context.Background(), conf, migrationConf, db)
-	if err != nil {
-		return nil, fmt.Errorf("migrate: %w", err)
-	}
-
 	// err = migrate.Migrate(db, appConf.FeatureV2())
 	// if err != nil {
 	// 	return nil, fmt.Errorf("failed to migrate: %w", err)
@@ -106,6 +121,17 @@ func NewDB(appConf config.App, conf config.RepositoryGorm2, migrationConf config
 		return nil, fmt.Errorf("failed to use prometheus plugin: %w", err)
 	}
 
+	// SQL文をスパンに記録する。
+	// プレースホルダーの値にはトークンなどが含まれるため記録しない。
+	// メトリクスはprometheusのプラグインで取っているので無効にする。
+	err = db.Use(gormtracing.NewPlugin(
+		gormtracing.WithoutQueryVariables(),
+		gormtracing.WithoutMetrics(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to use tracing plugin: %w", err)
+	}
+
 	return &DB{
 		db: db,
 	}, nil
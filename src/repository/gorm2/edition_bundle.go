@@ -0,0 +1,354 @@
+package gorm2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+var _ repository.EditionBundle = (*EditionBundle)(nil)
+
+type EditionBundle struct {
+	db *DB
+}
+
+func NewEditionBundle(db *DB) *EditionBundle {
+	return &EditionBundle{
+		db: db,
+	}
+}
+
+func (eb *EditionBundle) SaveEditionBundle(ctx context.Context, bundle *domain.EditionBundle) error {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusID, err := eb.getStatusID(db, bundle.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	err = db.Create(&schema.EditionBundleTable{
+		ID:             uuid.UUID(bundle.GetID()),
+		EditionID:      uuid.UUID(bundle.GetEditionID()),
+		StatusID:       statusID,
+		TotalItems:     bundle.GetTotalItems(),
+		ProcessedItems: bundle.GetProcessedItems(),
+		Size:           int64(bundle.GetSize()),
+		CreatedAt:      bundle.GetCreatedAt(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to save edition bundle: %w", err)
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) GetEditionBundle(ctx context.Context, bundleID values.EditionBundleID, lockType repository.LockType) (*domain.EditionBundle, error) {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	db, err = eb.db.setLock(db, lockType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set lock: %w", err)
+	}
+
+	var bundle schema.EditionBundleTable
+	err = db.
+		Joins("Status").
+		Where("edition_bundles.id = ?", uuid.UUID(bundleID)).
+		Take(&bundle).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundle: %w", err)
+	}
+
+	status, err := parseEditionBundleStatus(bundle.Status.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertEditionBundle(&bundle, status), nil
+}
+
+func (eb *EditionBundle) GetEditionBundles(ctx context.Context, editionID values.EditionID) ([]*domain.EditionBundle, error) {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var bundles []schema.EditionBundleTable
+	err = db.
+		Joins("Status").
+		Where("edition_bundles.edition_id = ?", uuid.UUID(editionID)).
+		Order("edition_bundles.created_at DESC").
+		Find(&bundles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundles: %w", err)
+	}
+
+	return convertEditionBundles(bundles), nil
+}
+
+func (eb *EditionBundle) GetEditionBundlesByStatus(ctx context.Context, status values.EditionBundleStatus) ([]*domain.EditionBundle, error) {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusName, err := convertEditionBundleStatus(status)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundles []schema.EditionBundleTable
+	err = db.
+		Joins("Status").
+		Where("Status.name = ?", statusName).
+		Order("edition_bundles.created_at").
+		Find(&bundles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundles: %w", err)
+	}
+
+	return convertEditionBundles(bundles), nil
+}
+
+func (eb *EditionBundle) ClaimEditionBundle(ctx context.Context, bundleID values.EditionBundleID, owner uuid.UUID, now time.Time) error {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	pendingStatusID, err := eb.getStatusID(db, values.EditionBundleStatusPending)
+	if err != nil {
+		return err
+	}
+
+	buildingStatusID, err := eb.getStatusID(db, values.EditionBundleStatusBuilding)
+	if err != nil {
+		return err
+	}
+
+	// 未生成であることを条件に含めて更新し、他のサーバーが先に生成を始めていれば更新されないようにする
+	result := db.
+		Model(&schema.EditionBundleTable{}).
+		Where("id = ? AND status_id = ?", uuid.UUID(bundleID), pendingStatusID).
+		Updates(map[string]any{
+			"status_id":    buildingStatusID,
+			"owner":        owner,
+			"heartbeat_at": now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to claim edition bundle: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) UpdateOwnedEditionBundle(ctx context.Context, bundle *domain.EditionBundle, owner uuid.UUID, now time.Time) error {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusID, err := eb.getStatusID(db, bundle.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	buildingStatusID, err := eb.getStatusID(db, values.EditionBundleStatusBuilding)
+	if err != nil {
+		return err
+	}
+
+	// 0の値も更新するため、mapで指定する
+	result := db.
+		Model(&schema.EditionBundleTable{}).
+		Where("id = ? AND owner = ? AND status_id = ?", uuid.UUID(bundle.GetID()), owner, buildingStatusID).
+		Updates(map[string]any{
+			"status_id":       statusID,
+			"total_items":     bundle.GetTotalItems(),
+			"processed_items": bundle.GetProcessedItems(),
+			"size":            int64(bundle.GetSize()),
+			"heartbeat_at":    now,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update edition bundle: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return eb.checkEditionBundleOwned(db, bundle.GetID(), owner, buildingStatusID)
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) HeartbeatEditionBundle(ctx context.Context, bundleID values.EditionBundleID, owner uuid.UUID, now time.Time) error {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	buildingStatusID, err := eb.getStatusID(db, values.EditionBundleStatusBuilding)
+	if err != nil {
+		return err
+	}
+
+	result := db.
+		Model(&schema.EditionBundleTable{}).
+		Where("id = ? AND owner = ? AND status_id = ?", uuid.UUID(bundleID), owner, buildingStatusID).
+		Update("heartbeat_at", now)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update edition bundle heartbeat: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return eb.checkEditionBundleOwned(db, bundleID, owner, buildingStatusID)
+	}
+
+	return nil
+}
+
+// checkEditionBundleOwned
+// 更新された行数が0のとき、ownerが生成中のバンドルであればnilを、そうでなければErrNoRecordUpdatedを返す。
+// datetimeは秒単位なので、同じ秒に同じ値で更新すると、ownerが生成中であっても更新された行数が0になる。
+func (*EditionBundle) checkEditionBundleOwned(db *gorm.DB, bundleID values.EditionBundleID, owner uuid.UUID, buildingStatusID int) error {
+	var count int64
+	err := db.
+		Model(&schema.EditionBundleTable{}).
+		Where("id = ? AND owner = ? AND status_id = ?", uuid.UUID(bundleID), owner, buildingStatusID).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to check edition bundle owner: %w", err)
+	}
+
+	if count == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) FailStaleEditionBundles(ctx context.Context, staleBefore time.Time) (int, error) {
+	db, err := eb.db.getDB(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	buildingStatusID, err := eb.getStatusID(db, values.EditionBundleStatusBuilding)
+	if err != nil {
+		return 0, err
+	}
+
+	failedStatusID, err := eb.getStatusID(db, values.EditionBundleStatusFailed)
+	if err != nil {
+		return 0, err
+	}
+
+	// 生存を報告する仕組みの導入前から生成中のバンドルは、heartbeat_atがNULLになっている
+	result := db.
+		Model(&schema.EditionBundleTable{}).
+		Where("status_id = ? AND (heartbeat_at IS NULL OR heartbeat_at < ?)", buildingStatusID, staleBefore).
+		Update("status_id", failedStatusID)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to fail stale edition bundles: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (eb *EditionBundle) getStatusID(db *gorm.DB, status values.EditionBundleStatus) (int, error) {
+	statusName, err := convertEditionBundleStatus(status)
+	if err != nil {
+		return 0, err
+	}
+
+	var dbStatus schema.EditionBundleStatusTable
+	err = db.
+		Where("name = ?", statusName).
+		Select("id").
+		Take(&dbStatus).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get edition bundle status: %w", err)
+	}
+
+	return dbStatus.ID, nil
+}
+
+func convertEditionBundles(bundles []schema.EditionBundleTable) []*domain.EditionBundle {
+	resBundles := make([]*domain.EditionBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		status, err := parseEditionBundleStatus(bundle.Status.Name)
+		if err != nil {
+			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
+			slog.Error("failed to parse edition bundle status", slog.Any("error", err))
+			continue
+		}
+
+		resBundles = append(resBundles, convertEditionBundle(&bundle, status))
+	}
+
+	return resBundles
+}
+
+func convertEditionBundle(bundle *schema.EditionBundleTable, status values.EditionBundleStatus) *domain.EditionBundle {
+	resBundle := domain.NewEditionBundle(
+		values.NewEditionBundleIDFromUUID(bundle.ID),
+		values.NewEditionIDFromUUID(bundle.EditionID),
+		status,
+		bundle.CreatedAt,
+	)
+	resBundle.SetProgress(bundle.ProcessedItems, bundle.TotalItems)
+	resBundle.SetSize(values.GameStorageSize(bundle.Size))
+
+	return resBundle
+}
+
+func convertEditionBundleStatus(status values.EditionBundleStatus) (string, error) {
+	switch status {
+	case values.EditionBundleStatusPending:
+		return schema.EditionBundleStatusPending, nil
+	case values.EditionBundleStatusBuilding:
+		return schema.EditionBundleStatusBuilding, nil
+	case values.EditionBundleStatusCompleted:
+		return schema.EditionBundleStatusCompleted, nil
+	case values.EditionBundleStatusFailed:
+		return schema.EditionBundleStatusFailed, nil
+	}
+
+	return "", fmt.Errorf("invalid edition bundle status: %d", status)
+}
+
+func parseEditionBundleStatus(name string) (values.EditionBundleStatus, error) {
+	switch name {
+	case schema.EditionBundleStatusPending:
+		return values.EditionBundleStatusPending, nil
+	case schema.EditionBundleStatusBuilding:
+		return values.EditionBundleStatusBuilding, nil
+	case schema.EditionBundleStatusCompleted:
+		return values.EditionBundleStatusCompleted, nil
+	case schema.EditionBundleStatusFailed:
+		return values.EditionBundleStatusFailed, nil
+	}
+
+	return 0, fmt.Errorf("unknown edition bundle status: %s", name)
+}
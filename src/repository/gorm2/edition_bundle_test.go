@@ -0,0 +1,872 @@
+package gorm2
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+func getEditionBundleStatusIDs(t *testing.T, db *gorm.DB) map[string]int {
+	t.Helper()
+
+	var statuses []*schema.EditionBundleStatusTable
+	err := db.
+		Session(&gorm.Session{}).
+		Find(&statuses).Error
+	if err != nil {
+		t.Fatalf("failed to get edition bundle statuses: %v\n", err)
+	}
+
+	statusMap := make(map[string]int, len(statuses))
+	for _, status := range statuses {
+		statusMap[status.Name] = status.ID
+	}
+
+	return statusMap
+}
+
+func createEditionForBundleTest(t *testing.T, db *gorm.DB, name string) values.EditionID {
+	t.Helper()
+
+	editionID := values.NewEditionID()
+	err := db.
+		Session(&gorm.Session{}).
+		Create(&schema.EditionTable{
+			ID:        uuid.UUID(editionID),
+			Name:      name,
+			CreatedAt: time.Now(),
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create edition: %v\n", err)
+	}
+
+	return editionID
+}
+
+func TestSaveEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_save_test")
+
+	type test struct {
+		description string
+		bundle      *domain.EditionBundle
+		isErr       bool
+	}
+
+	now := time.Now()
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			bundle: domain.NewEditionBundle(
+				values.NewEditionBundleID(),
+				editionID,
+				values.EditionBundleStatusPending,
+				now,
+			),
+		},
+		{
+			description: "存在しないエディションなのでエラー",
+			bundle: domain.NewEditionBundle(
+				values.NewEditionBundleID(),
+				values.NewEditionID(),
+				values.EditionBundleStatusPending,
+				now,
+			),
+			isErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := editionBundleRepository.SaveEditionBundle(ctx, testCase.bundle)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var bundle schema.EditionBundleTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.bundle.GetID())).
+				Take(&bundle).Error
+			if err != nil {
+				t.Fatalf("failed to get edition bundle: %v\n", err)
+			}
+
+			assert.Equal(t, uuid.UUID(editionID), bundle.EditionID)
+			assert.Equal(t, statusMap[schema.EditionBundleStatusPending], bundle.StatusID)
+			assert.Zero(t, bundle.TotalItems)
+			assert.Zero(t, bundle.ProcessedItems)
+			assert.Zero(t, bundle.Size)
+			assert.WithinDuration(t, now, bundle.CreatedAt, time.Second)
+		})
+	}
+}
+
+func TestUpdateOwnedEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_update_test")
+
+	type test struct {
+		description  string
+		beforeBundle *schema.EditionBundleTable
+		bundle       *domain.EditionBundle
+		isErr        bool
+		err          error
+	}
+
+	owner := uuid.New()
+	now := time.Now()
+	heartbeatAt := now.Add(-time.Minute)
+
+	bundleID1 := values.NewEditionBundleID()
+	bundleID2 := values.NewEditionBundleID()
+	bundleID3 := values.NewEditionBundleID()
+	bundleID4 := values.NewEditionBundleID()
+	bundleID5 := values.NewEditionBundleID()
+
+	completedBundle := domain.NewEditionBundle(bundleID1, editionID, values.EditionBundleStatusCompleted, now)
+	completedBundle.SetProgress(10, 10)
+	completedBundle.SetSize(1000)
+
+	buildingBundle := domain.NewEditionBundle(bundleID2, editionID, values.EditionBundleStatusBuilding, now)
+	buildingBundle.SetProgress(0, 10)
+
+	testCases := []test{
+		{
+			description: "状態・進捗・容量を更新できる",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:             uuid.UUID(bundleID1),
+				EditionID:      uuid.UUID(editionID),
+				StatusID:       statusMap[schema.EditionBundleStatusBuilding],
+				TotalItems:     10,
+				ProcessedItems: 5,
+				CreatedAt:      now,
+				Owner:          uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt:    sql.NullTime{Time: heartbeatAt, Valid: true},
+			},
+			bundle: completedBundle,
+		},
+		{
+			description: "0の値にも更新できる",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:             uuid.UUID(bundleID2),
+				EditionID:      uuid.UUID(editionID),
+				StatusID:       statusMap[schema.EditionBundleStatusBuilding],
+				TotalItems:     10,
+				ProcessedItems: 5,
+				CreatedAt:      now,
+				Owner:          uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt:    sql.NullTime{Time: heartbeatAt, Valid: true},
+			},
+			bundle: buildingBundle,
+		},
+		{
+			description: "他のサーバーが生成中なのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID3),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				HeartbeatAt: sql.NullTime{Time: heartbeatAt, Valid: true},
+			},
+			bundle: domain.NewEditionBundle(bundleID3, editionID, values.EditionBundleStatusCompleted, now),
+			isErr:  true,
+			err:    repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "既に失敗扱いにされているのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID4),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusFailed],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt: sql.NullTime{Time: heartbeatAt, Valid: true},
+			},
+			bundle: domain.NewEditionBundle(bundleID4, editionID, values.EditionBundleStatusCompleted, now),
+			isErr:  true,
+			err:    repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "値が変わらなくてもエラーなし",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID5),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now, Valid: true},
+			},
+			bundle: domain.NewEditionBundle(bundleID5, editionID, values.EditionBundleStatusBuilding, now),
+		},
+		{
+			description: "バンドルが存在しないのでErrNoRecordUpdated",
+			bundle:      domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusBuilding, now),
+			isErr:       true,
+			err:         repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.beforeBundle != nil {
+				err := db.
+					Session(&gorm.Session{}).
+					Create(testCase.beforeBundle).Error
+				if err != nil {
+					t.Fatalf("failed to create edition bundle: %v\n", err)
+				}
+			}
+
+			err := editionBundleRepository.UpdateOwnedEditionBundle(ctx, testCase.bundle, owner, now)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var bundle schema.EditionBundleTable
+			err = db.
+				Session(&gorm.Session{}).
+				Joins("Status").
+				Where("edition_bundles.id = ?", uuid.UUID(testCase.bundle.GetID())).
+				Take(&bundle).Error
+			if err != nil {
+				t.Fatalf("failed to get edition bundle: %v\n", err)
+			}
+
+			status, err := parseEditionBundleStatus(bundle.Status.Name)
+			if err != nil {
+				t.Fatalf("failed to parse edition bundle status: %v\n", err)
+			}
+
+			assert.Equal(t, testCase.bundle.GetStatus(), status)
+			assert.Equal(t, testCase.bundle.GetTotalItems(), bundle.TotalItems)
+			assert.Equal(t, testCase.bundle.GetProcessedItems(), bundle.ProcessedItems)
+			assert.Equal(t, int64(testCase.bundle.GetSize()), bundle.Size)
+			assert.True(t, bundle.HeartbeatAt.Valid)
+			assert.WithinDuration(t, now, bundle.HeartbeatAt.Time, time.Second)
+		})
+	}
+}
+
+func TestGetEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_get_test")
+
+	now := time.Now()
+	bundleID := values.NewEditionBundleID()
+
+	err = db.
+		Session(&gorm.Session{}).
+		Create(&schema.EditionBundleTable{
+			ID:             uuid.UUID(bundleID),
+			EditionID:      uuid.UUID(editionID),
+			StatusID:       statusMap[schema.EditionBundleStatusCompleted],
+			TotalItems:     3,
+			ProcessedItems: 3,
+			Size:           100,
+			CreatedAt:      now,
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create edition bundle: %v\n", err)
+	}
+
+	type test struct {
+		description string
+		bundleID    values.EditionBundleID
+		lockType    repository.LockType
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			bundleID:    bundleID,
+			lockType:    repository.LockTypeNone,
+		},
+		{
+			description: "行ロックを取ってもエラーなし",
+			bundleID:    bundleID,
+			lockType:    repository.LockTypeRecord,
+		},
+		{
+			description: "バンドルが存在しないのでErrRecordNotFound",
+			bundleID:    values.NewEditionBundleID(),
+			lockType:    repository.LockTypeNone,
+			isErr:       true,
+			err:         repository.ErrRecordNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			bundle, err := editionBundleRepository.GetEditionBundle(ctx, testCase.bundleID, testCase.lockType)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, bundleID, bundle.GetID())
+			assert.Equal(t, editionID, bundle.GetEditionID())
+			assert.Equal(t, values.EditionBundleStatusCompleted, bundle.GetStatus())
+			assert.Equal(t, 3, bundle.GetTotalItems())
+			assert.Equal(t, 3, bundle.GetProcessedItems())
+			assert.Equal(t, values.GameStorageSize(100), bundle.GetSize())
+			assert.WithinDuration(t, now, bundle.GetCreatedAt(), time.Second)
+		})
+	}
+}
+
+func TestGetEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID1 := createEditionForBundleTest(t, db, "bundle_list_test1")
+	editionID2 := createEditionForBundleTest(t, db, "bundle_list_test2")
+	editionID3 := createEditionForBundleTest(t, db, "bundle_list_test3")
+
+	now := time.Now()
+	bundleID1 := values.NewEditionBundleID()
+	bundleID2 := values.NewEditionBundleID()
+	bundleID3 := values.NewEditionBundleID()
+
+	err = db.
+		Session(&gorm.Session{}).
+		Create([]*schema.EditionBundleTable{
+			{
+				ID:        uuid.UUID(bundleID1),
+				EditionID: uuid.UUID(editionID1),
+				StatusID:  statusMap[schema.EditionBundleStatusCompleted],
+				CreatedAt: now.Add(-time.Hour),
+			},
+			{
+				ID:        uuid.UUID(bundleID2),
+				EditionID: uuid.UUID(editionID1),
+				StatusID:  statusMap[schema.EditionBundleStatusPending],
+				CreatedAt: now,
+			},
+			{
+				ID:        uuid.UUID(bundleID3),
+				EditionID: uuid.UUID(editionID2),
+				StatusID:  statusMap[schema.EditionBundleStatusPending],
+				CreatedAt: now,
+			},
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create edition bundles: %v\n", err)
+	}
+
+	type test struct {
+		description string
+		editionID   values.EditionID
+		expectIDs   []values.EditionBundleID
+	}
+
+	testCases := []test{
+		{
+			description: "作成日時の降順で取得できる",
+			editionID:   editionID1,
+			expectIDs:   []values.EditionBundleID{bundleID2, bundleID1},
+		},
+		{
+			description: "他のエディションのバンドルは含まれない",
+			editionID:   editionID2,
+			expectIDs:   []values.EditionBundleID{bundleID3},
+		},
+		{
+			description: "バンドルが存在しなくてもエラーなし",
+			editionID:   editionID3,
+			expectIDs:   []values.EditionBundleID{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			bundles, err := editionBundleRepository.GetEditionBundles(ctx, testCase.editionID)
+			assert.NoError(t, err)
+
+			bundleIDs := make([]values.EditionBundleID, 0, len(bundles))
+			for _, bundle := range bundles {
+				bundleIDs = append(bundleIDs, bundle.GetID())
+			}
+
+			assert.Equal(t, testCase.expectIDs, bundleIDs)
+		})
+	}
+}
+
+func TestGetEditionBundlesByStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_status_test")
+
+	now := time.Now()
+	pendingBundleID1 := values.NewEditionBundleID()
+	pendingBundleID2 := values.NewEditionBundleID()
+	failedBundleID := values.NewEditionBundleID()
+
+	err = db.
+		Session(&gorm.Session{}).
+		Create([]*schema.EditionBundleTable{
+			{
+				ID:        uuid.UUID(pendingBundleID1),
+				EditionID: uuid.UUID(editionID),
+				StatusID:  statusMap[schema.EditionBundleStatusPending],
+				CreatedAt: now,
+			},
+			{
+				ID:        uuid.UUID(pendingBundleID2),
+				EditionID: uuid.UUID(editionID),
+				StatusID:  statusMap[schema.EditionBundleStatusPending],
+				CreatedAt: now.Add(-time.Hour),
+			},
+			{
+				ID:        uuid.UUID(failedBundleID),
+				EditionID: uuid.UUID(editionID),
+				StatusID:  statusMap[schema.EditionBundleStatusFailed],
+				CreatedAt: now,
+			},
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create edition bundles: %v\n", err)
+	}
+
+	bundles, err := editionBundleRepository.GetEditionBundlesByStatus(ctx, values.EditionBundleStatusPending)
+	if err != nil {
+		t.Fatalf("failed to get edition bundles: %v\n", err)
+	}
+
+	// 他のテストで作成したバンドルも含まれるので、このテストで作成したものだけを比較する
+	bundleIDs := make([]values.EditionBundleID, 0, len(bundles))
+	for _, bundle := range bundles {
+		if bundle.GetEditionID() != editionID {
+			continue
+		}
+
+		assert.Equal(t, values.EditionBundleStatusPending, bundle.GetStatus())
+		bundleIDs = append(bundleIDs, bundle.GetID())
+	}
+
+	assert.Equal(t, []values.EditionBundleID{pendingBundleID2, pendingBundleID1}, bundleIDs)
+}
+
+func TestClaimEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_claim_test")
+
+	type test struct {
+		description  string
+		beforeBundle *schema.EditionBundleTable
+		bundleID     values.EditionBundleID
+		isErr        bool
+		err          error
+	}
+
+	owner := uuid.New()
+	now := time.Now()
+
+	bundleID1 := values.NewEditionBundleID()
+	bundleID2 := values.NewEditionBundleID()
+	bundleID3 := values.NewEditionBundleID()
+
+	testCases := []test{
+		{
+			description: "未生成なので生成中にできる",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:        uuid.UUID(bundleID1),
+				EditionID: uuid.UUID(editionID),
+				StatusID:  statusMap[schema.EditionBundleStatusPending],
+				CreatedAt: now,
+			},
+			bundleID: bundleID1,
+		},
+		{
+			description: "他のサーバーが生成中なのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID2),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now, Valid: true},
+			},
+			bundleID: bundleID2,
+			isErr:    true,
+			err:      repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "生成済みなのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:        uuid.UUID(bundleID3),
+				EditionID: uuid.UUID(editionID),
+				StatusID:  statusMap[schema.EditionBundleStatusCompleted],
+				CreatedAt: now,
+			},
+			bundleID: bundleID3,
+			isErr:    true,
+			err:      repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "バンドルが存在しないのでErrNoRecordUpdated",
+			bundleID:    values.NewEditionBundleID(),
+			isErr:       true,
+			err:         repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.beforeBundle != nil {
+				err := db.
+					Session(&gorm.Session{}).
+					Create(testCase.beforeBundle).Error
+				if err != nil {
+					t.Fatalf("failed to create edition bundle: %v\n", err)
+				}
+			}
+
+			err := editionBundleRepository.ClaimEditionBundle(ctx, testCase.bundleID, owner, now)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var bundle schema.EditionBundleTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.bundleID)).
+				Take(&bundle).Error
+			if err != nil {
+				t.Fatalf("failed to get edition bundle: %v\n", err)
+			}
+
+			assert.Equal(t, statusMap[schema.EditionBundleStatusBuilding], bundle.StatusID)
+			assert.Equal(t, uuid.NullUUID{UUID: owner, Valid: true}, bundle.Owner)
+			assert.True(t, bundle.HeartbeatAt.Valid)
+			assert.WithinDuration(t, now, bundle.HeartbeatAt.Time, time.Second)
+
+			// 2回目は既に生成中なので失敗する
+			err = editionBundleRepository.ClaimEditionBundle(ctx, testCase.bundleID, uuid.New(), now)
+			assert.ErrorIs(t, err, repository.ErrNoRecordUpdated)
+		})
+	}
+}
+
+func TestHeartbeatEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_heartbeat_test")
+
+	type test struct {
+		description  string
+		beforeBundle *schema.EditionBundleTable
+		bundleID     values.EditionBundleID
+		isErr        bool
+		err          error
+	}
+
+	owner := uuid.New()
+	now := time.Now()
+
+	bundleID1 := values.NewEditionBundleID()
+	bundleID2 := values.NewEditionBundleID()
+	bundleID3 := values.NewEditionBundleID()
+	bundleID4 := values.NewEditionBundleID()
+
+	testCases := []test{
+		{
+			description: "生成中なので更新できる",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID1),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			},
+			bundleID: bundleID1,
+		},
+		{
+			description: "同じ日時でもエラーなし",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID2),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now, Valid: true},
+			},
+			bundleID: bundleID2,
+		},
+		{
+			description: "他のサーバーが生成中なのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID3),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			},
+			bundleID: bundleID3,
+			isErr:    true,
+			err:      repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "既に失敗扱いにされているのでErrNoRecordUpdated",
+			beforeBundle: &schema.EditionBundleTable{
+				ID:          uuid.UUID(bundleID4),
+				EditionID:   uuid.UUID(editionID),
+				StatusID:    statusMap[schema.EditionBundleStatusFailed],
+				CreatedAt:   now,
+				Owner:       uuid.NullUUID{UUID: owner, Valid: true},
+				HeartbeatAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			},
+			bundleID: bundleID4,
+			isErr:    true,
+			err:      repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := db.
+				Session(&gorm.Session{}).
+				Create(testCase.beforeBundle).Error
+			if err != nil {
+				t.Fatalf("failed to create edition bundle: %v\n", err)
+			}
+
+			err = editionBundleRepository.HeartbeatEditionBundle(ctx, testCase.bundleID, owner, now)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var bundle schema.EditionBundleTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.bundleID)).
+				Take(&bundle).Error
+			if err != nil {
+				t.Fatalf("failed to get edition bundle: %v\n", err)
+			}
+
+			assert.WithinDuration(t, now, bundle.HeartbeatAt.Time, time.Second)
+		})
+	}
+}
+
+func TestFailStaleEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	editionBundleRepository := NewEditionBundle(testDB)
+
+	statusMap := getEditionBundleStatusIDs(t, db)
+	editionID := createEditionForBundleTest(t, db, "bundle_fail_stale_test")
+
+	now := time.Now()
+	// 他のテストのバンドルに影響しないよう、十分に過去の日時を使う
+	staleBefore := now.Add(-24 * time.Hour)
+
+	staleBundleID := values.NewEditionBundleID()
+	noHeartbeatBundleID := values.NewEditionBundleID()
+	aliveBundleID := values.NewEditionBundleID()
+	pendingBundleID := values.NewEditionBundleID()
+
+	bundles := []*schema.EditionBundleTable{
+		{
+			ID:          uuid.UUID(staleBundleID),
+			EditionID:   uuid.UUID(editionID),
+			StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+			CreatedAt:   now,
+			Owner:       uuid.NullUUID{UUID: uuid.New(), Valid: true},
+			HeartbeatAt: sql.NullTime{Time: staleBefore.Add(-time.Hour), Valid: true},
+		},
+		{
+			ID:        uuid.UUID(noHeartbeatBundleID),
+			EditionID: uuid.UUID(editionID),
+			StatusID:  statusMap[schema.EditionBundleStatusBuilding],
+			CreatedAt: now,
+		},
+		{
+			ID:          uuid.UUID(aliveBundleID),
+			EditionID:   uuid.UUID(editionID),
+			StatusID:    statusMap[schema.EditionBundleStatusBuilding],
+			CreatedAt:   now,
+			Owner:       uuid.NullUUID{UUID: uuid.New(), Valid: true},
+			HeartbeatAt: sql.NullTime{Time: now, Valid: true},
+		},
+		{
+			ID:        uuid.UUID(pendingBundleID),
+			EditionID: uuid.UUID(editionID),
+			StatusID:  statusMap[schema.EditionBundleStatusPending],
+			CreatedAt: now,
+		},
+	}
+	err = db.
+		Session(&gorm.Session{}).
+		Create(&bundles).Error
+	if err != nil {
+		t.Fatalf("failed to create edition bundles: %v\n", err)
+	}
+
+	count, err := editionBundleRepository.FailStaleEditionBundles(ctx, staleBefore)
+	assert.NoError(t, err)
+	// 他のテストで作られた、生存の報告がない生成中のバンドルも失敗扱いになりうる
+	assert.GreaterOrEqual(t, count, 2)
+
+	expectedStatuses := map[values.EditionBundleID]int{
+		staleBundleID:       statusMap[schema.EditionBundleStatusFailed],
+		noHeartbeatBundleID: statusMap[schema.EditionBundleStatusFailed],
+		aliveBundleID:       statusMap[schema.EditionBundleStatusBuilding],
+		pendingBundleID:     statusMap[schema.EditionBundleStatusPending],
+	}
+	for bundleID, expectedStatus := range expectedStatuses {
+		var bundle schema.EditionBundleTable
+		err = db.
+			Session(&gorm.Session{}).
+			Where("id = ?", uuid.UUID(bundleID)).
+			Take(&bundle).Error
+		if err != nil {
+			t.Fatalf("failed to get edition bundle: %v\n", err)
+		}
+
+		assert.Equal(t, expectedStatus, bundle.StatusID)
+	}
+}
@@ -1,9 +1,13 @@
 package gorm2
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
@@ -11,6 +15,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -36,14 +41,26 @@ func (gc *GameCreator) GetGameCreatorsByGameID(ctx context.Context, gameID value
 	err = db.Preload("CreatorJobs").
 		Preload("CustomCreatorJobs").
 		Where("game_creators.game_id = ?", uuid.UUID(gameID)).
+		Order("game_creators.display_order ASC").
 		Order("game_creators.created_at ASC").
 		Find(&gameCreators).Error
 	if err != nil {
 		return nil, fmt.Errorf("find game creators: %w", err)
 	}
 
+	creatorIDs := make([]uuid.UUID, 0, len(gameCreators))
+	for _, gameCreator := range gameCreators {
+		creatorIDs = append(creatorIDs, gameCreator.ID)
+	}
+	jobDisplayOrders, err := gc.getJobDisplayOrders(ctx, creatorIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get job display orders: %w", err)
+	}
+
 	result := make([]*domain.GameCreatorWithJobs, 0, len(gameCreators))
 	for _, gc := range gameCreators {
+		displayOrders := jobDisplayOrders[values.GameCreatorID(gc.ID)]
+
 		jobs := make([]*domain.GameCreatorJob, 0, len(gc.CreatorJobs))
 		for _, job := range gc.CreatorJobs {
 			jobs = append(jobs,
@@ -53,6 +70,10 @@ func (gc *GameCreator) GetGameCreatorsByGameID(ctx context.Context, gameID value
 					job.CreatedAt,
 				))
 		}
+		slices.SortStableFunc(jobs, func(a, b *domain.GameCreatorJob) int {
+			return cmp.Compare(displayOrders[a.GetID()], displayOrders[b.GetID()])
+		})
+
 		customJobs := make([]*domain.GameCreatorCustomJob, 0, len(gc.CustomCreatorJobs))
 		for _, job := range gc.CustomCreatorJobs {
 			customJobs = append(customJobs,
@@ -62,22 +83,100 @@ func (gc *GameCreator) GetGameCreatorsByGameID(ctx context.Context, gameID value
 					job.CreatedAt,
 				))
 		}
+		slices.SortStableFunc(customJobs, func(a, b *domain.GameCreatorCustomJob) int {
+			return cmp.Compare(displayOrders[a.GetID()], displayOrders[b.GetID()])
+		})
 
-		result = append(result, domain.NewGameCreatorWithJobs(
-			domain.NewGameCreator(
-				values.GameCreatorID(gc.ID),
-				values.TraPMemberID(gc.UserID),
-				values.GameID(gc.GameID),
-				values.TraPMemberName(gc.UserName),
-				gc.CreatedAt),
-			jobs,
-			customJobs,
-		))
+		creator, err := convertGameCreatorTable(&gc)
+		if err != nil {
+			return nil, fmt.Errorf("convert game creator: %w", err)
+		}
+
+		creatorWithJobs := domain.NewGameCreatorWithJobs(creator, jobs, customJobs)
+		creatorWithJobs.SetJobDisplayOrders(displayOrders)
+
+		result = append(result, creatorWithJobs)
 	}
 
 	return result, nil
 }
 
+// getJobDisplayOrders
+// creatorのpreset job、custom jobの表示順をまとめて取得する。
+func (gc *GameCreator) getJobDisplayOrders(ctx context.Context, creatorIDs []uuid.UUID) (map[values.GameCreatorID]map[values.GameCreatorJobID]int, error) {
+	if len(creatorIDs) == 0 {
+		return map[values.GameCreatorID]map[values.GameCreatorJobID]int{}, nil
+	}
+
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get db: %w", err)
+	}
+
+	var presetRelations []schema.GameCreatorJobRelationTable
+	err = db.Where("game_creator_id IN ?", creatorIDs).
+		Find(&presetRelations).Error
+	if err != nil {
+		return nil, fmt.Errorf("find game creator job relations: %w", err)
+	}
+
+	var customRelations []schema.GameCreatorCustomJobRelationTable
+	err = db.Where("game_creator_id IN ?", creatorIDs).
+		Find(&customRelations).Error
+	if err != nil {
+		return nil, fmt.Errorf("find game creator custom job relations: %w", err)
+	}
+
+	displayOrders := make(map[values.GameCreatorID]map[values.GameCreatorJobID]int, len(creatorIDs))
+	setDisplayOrder := func(creatorID uuid.UUID, jobID uuid.UUID, displayOrder int) {
+		if _, ok := displayOrders[values.GameCreatorID(creatorID)]; !ok {
+			displayOrders[values.GameCreatorID(creatorID)] = map[values.GameCreatorJobID]int{}
+		}
+		displayOrders[values.GameCreatorID(creatorID)][values.GameCreatorJobID(jobID)] = displayOrder
+	}
+	for _, relation := range presetRelations {
+		setDisplayOrder(relation.GameCreatorID, relation.JobID, relation.DisplayOrder)
+	}
+	for _, relation := range customRelations {
+		setDisplayOrder(relation.GameCreatorID, relation.CustomJobID, relation.DisplayOrder)
+	}
+
+	return displayOrders, nil
+}
+
+func convertGameCreatorTable(creator *schema.GameCreatorTable) (*domain.GameCreator, error) {
+	var domainCreator *domain.GameCreator
+	if creator.UserID.Valid {
+		domainCreator = domain.NewGameCreator(
+			values.GameCreatorID(creator.ID),
+			values.TraPMemberID(creator.UserID.UUID),
+			values.GameID(creator.GameID),
+			values.TraPMemberName(creator.UserName.String),
+			creator.CreatedAt,
+		)
+	} else {
+		var externalURL values.GameCreatorExternalURL
+		if creator.ExternalURL.Valid {
+			u, err := url.Parse(creator.ExternalURL.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse external url: %w", err)
+			}
+			externalURL = values.NewGameCreatorExternalURL(u)
+		}
+
+		domainCreator = domain.NewExternalGameCreator(
+			values.GameCreatorID(creator.ID),
+			values.GameID(creator.GameID),
+			values.NewGameCreatorExternalName(creator.ExternalName.String),
+			externalURL,
+			creator.CreatedAt,
+		)
+	}
+	domainCreator.SetDisplayOrder(creator.DisplayOrder)
+
+	return domainCreator, nil
+}
+
 func (gc *GameCreator) GetGameCreatorPresetJobs(ctx context.Context) ([]*domain.GameCreatorJob, error) {
 	db, err := gc.db.getDB(ctx)
 	if err != nil {
@@ -174,13 +273,23 @@ func (gc *GameCreator) CreateGameCreators(ctx context.Context, creators []*domai
 
 	gameCreatorTable := make([]schema.GameCreatorTable, 0, len(creators))
 	for _, creator := range creators {
-		gameCreatorTable = append(gameCreatorTable, schema.GameCreatorTable{
-			ID:        uuid.UUID(creator.GetID()),
-			UserID:    uuid.UUID(creator.GetUserID()),
-			GameID:    uuid.UUID(creator.GetGameID()),
-			UserName:  string(creator.GetUserName()),
-			CreatedAt: creator.GetCreatedAt(),
-		})
+		creatorTable := schema.GameCreatorTable{
+			ID:           uuid.UUID(creator.GetID()),
+			GameID:       uuid.UUID(creator.GetGameID()),
+			DisplayOrder: creator.GetDisplayOrder(),
+			CreatedAt:    creator.GetCreatedAt(),
+		}
+		if creator.IsExternal() {
+			creatorTable.ExternalName = sql.NullString{String: string(creator.GetExternalName()), Valid: true}
+			if externalURL := creator.GetExternalURL(); externalURL != nil {
+				creatorTable.ExternalURL = sql.NullString{String: (*url.URL)(externalURL).String(), Valid: true}
+			}
+		} else {
+			creatorTable.UserID = uuid.NullUUID{UUID: uuid.UUID(creator.GetUserID()), Valid: true}
+			creatorTable.UserName = sql.NullString{String: string(creator.GetUserName()), Valid: true}
+		}
+
+		gameCreatorTable = append(gameCreatorTable, creatorTable)
 	}
 
 	err = db.Create(&gameCreatorTable).Error
@@ -248,8 +357,52 @@ func (gc *GameCreator) UpsertGameCreatorPresetJobsRelations(ctx context.Context,
 	return nil
 }
 
-func (gc *GameCreator) UpsertGameCreatorCustomJobsRelations(_ context.Context, _ map[values.GameCreatorID][]values.GameCreatorJobID) error {
-	return nil // TODO: implement
+func (gc *GameCreator) UpsertGameCreatorCustomJobsRelations(ctx context.Context, creatorJobsRelations map[values.GameCreatorID][]values.GameCreatorJobID) error {
+	if len(creatorJobsRelations) == 0 {
+		return nil
+	}
+
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("getDB: %w", err)
+	}
+
+	relationCount := 0
+	for _, jobs := range creatorJobsRelations {
+		relationCount += len(jobs)
+	}
+
+	relations := make([]map[string]any, 0, relationCount)
+	for creatorID, jobs := range creatorJobsRelations {
+		creatorUUID := uuid.UUID(creatorID)
+		for _, job := range jobs {
+			relations = append(relations, map[string]any{
+				"game_creator_id": creatorUUID,
+				"custom_job_id":   uuid.UUID(job),
+			})
+		}
+	}
+
+	if len(relations) == 0 {
+		return nil
+	}
+
+	err = db.Table("game_creator_custom_job_relations").
+		Clauses(&clause.OnConflict{
+			// UpsertGameCreatorPresetJobsRelations と同様に、DoUpdateで同じ値を設定する。
+			DoUpdates: clause.AssignmentColumns([]string{"game_creator_id"}),
+		}).
+		Create(&relations).Error
+	if mysqlErr, ok := errors.AsType[*mysql.MySQLError](err); ok {
+		if mysqlErr.Number == 1452 {
+			return repository.ErrForeignKeyViolated
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("create game creator custom job relations: %w", err)
+	}
+
+	return nil
 }
 
 func (gc *GameCreator) GetGameCreatorsByUserIDs(ctx context.Context, gameID values.GameID, userIDs []values.TraPMemberID) ([]*domain.GameCreator, error) {
@@ -274,30 +427,134 @@ func (gc *GameCreator) GetGameCreatorsByUserIDs(ctx context.Context, gameID valu
 
 	gameCreatorsResult := make([]*domain.GameCreator, 0, len(gameCreators))
 	for _, gc := range gameCreators {
-		gameCreatorsResult = append(gameCreatorsResult, domain.NewGameCreator(
-			values.GameCreatorID(gc.ID),
-			values.TraPMemberID(gc.UserID),
-			values.GameID(gc.GameID),
-			values.TraPMemberName(gc.UserName),
-			gc.CreatedAt,
-		))
+		creator, err := convertGameCreatorTable(&gc)
+		if err != nil {
+			return nil, fmt.Errorf("convert game creator: %w", err)
+		}
+		gameCreatorsResult = append(gameCreatorsResult, creator)
 	}
 
 	return gameCreatorsResult, nil
 }
 
-func (gc *GameCreator) GetGameCreatorByID(_ context.Context, _ values.GameCreatorID) (*domain.GameCreator, error) {
-	return nil, nil
+func (gc *GameCreator) GetGameCreatorByID(ctx context.Context, creatorID values.GameCreatorID) (*domain.GameCreator, error) {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get db: %w", err)
+	}
+
+	var gameCreator schema.GameCreatorTable
+	err = db.Where("id = ?", uuid.UUID(creatorID)).
+		Take(&gameCreator).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get game creator: %w", err)
+	}
+
+	creator, err := convertGameCreatorTable(&gameCreator)
+	if err != nil {
+		return nil, fmt.Errorf("convert game creator: %w", err)
+	}
+
+	return creator, nil
+}
+
+func (gc *GameCreator) DeleteGameCreator(ctx context.Context, gameID values.GameID, creatorID values.GameCreatorID) error {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+
+	result := db.Where("id = ? AND game_id = ?", uuid.UUID(creatorID), uuid.UUID(gameID)).
+		Delete(&schema.GameCreatorTable{})
+	err = result.Error
+	if mysqlErr, ok := errors.AsType[*mysql.MySQLError](err); ok {
+		if mysqlErr.Number == 1451 {
+			return repository.ErrForeignKeyViolated
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("delete game creator: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordDeleted
+	}
+
+	return nil
+}
+
+func (gc *GameCreator) DeleteGameCreatorCustomJobs(ctx context.Context, creatorID values.GameCreatorID) error {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+
+	err = db.Where("game_creator_id = ?", uuid.UUID(creatorID)).
+		Delete(&schema.GameCreatorCustomJobRelationTable{}).Error
+	if err != nil {
+		return fmt.Errorf("delete game creator custom job relations: %w", err)
+	}
+
+	return nil
 }
 
-func (gc *GameCreator) DeleteGameCreator(_ context.Context, _ values.GameID, _ values.GameCreatorID) error {
+func (gc *GameCreator) DeleteGameCreatorPresetJobs(ctx context.Context, creatorID values.GameCreatorID) error {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+
+	err = db.Where("game_creator_id = ?", uuid.UUID(creatorID)).
+		Delete(&schema.GameCreatorJobRelationTable{}).Error
+	if err != nil {
+		return fmt.Errorf("delete game creator job relations: %w", err)
+	}
+
 	return nil
 }
 
-func (gc *GameCreator) DeleteGameCreatorCustomJobs(_ context.Context, _ values.GameCreatorID) error {
+func (gc *GameCreator) UpdateGameCreatorDisplayOrders(ctx context.Context, gameID values.GameID, creatorIDs []values.GameCreatorID) error {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+
+	for i, creatorID := range creatorIDs {
+		err = db.Model(&schema.GameCreatorTable{}).
+			Where("id = ? AND game_id = ?", uuid.UUID(creatorID), uuid.UUID(gameID)).
+			Update("display_order", i).Error
+		if err != nil {
+			return fmt.Errorf("update game creator display order: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func (gc *GameCreator) DeleteGameCreatorPresetJobs(_ context.Context, _ values.GameCreatorID) error {
+func (gc *GameCreator) UpdateGameCreatorJobDisplayOrders(ctx context.Context, creatorID values.GameCreatorID, jobIDs []values.GameCreatorJobID) error {
+	db, err := gc.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("get db: %w", err)
+	}
+
+	// preset job と custom job の id は重複しないので、両方のテーブルを更新する。
+	for i, jobID := range jobIDs {
+		err = db.Model(&schema.GameCreatorJobRelationTable{}).
+			Where("game_creator_id = ? AND job_id = ?", uuid.UUID(creatorID), uuid.UUID(jobID)).
+			Update("display_order", i).Error
+		if err != nil {
+			return fmt.Errorf("update game creator job display order: %w", err)
+		}
+
+		err = db.Model(&schema.GameCreatorCustomJobRelationTable{}).
+			Where("game_creator_id = ? AND custom_job_id = ?", uuid.UUID(creatorID), uuid.UUID(jobID)).
+			Update("display_order", i).Error
+		if err != nil {
+			return fmt.Errorf("update game creator custom job display order: %w", err)
+		}
+	}
+
 	return nil
 }
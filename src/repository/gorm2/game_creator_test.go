@@ -2,6 +2,8 @@ package gorm2
 
 import (
 	"context"
+	"database/sql"
+	"net/url"
 	"slices"
 	"testing"
 	"time"
@@ -87,8 +89,8 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 
 	creator1 := &schema.GameCreatorTable{
 		ID:       uuid.New(),
-		UserID:   uuid.New(),
-		UserName: "creator1",
+		UserID:   uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName: sql.NullString{String: "creator1", Valid: true},
 		GameID:   uuid.UUID(gameID1),
 		CreatorJobs: []schema.GameCreatorJobTable{
 			*job1,
@@ -100,8 +102,8 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 	}
 	creator2 := &schema.GameCreatorTable{
 		ID:       uuid.New(),
-		UserID:   uuid.New(),
-		UserName: "creator2",
+		UserID:   uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName: sql.NullString{String: "creator2", Valid: true},
 		GameID:   uuid.UUID(gameID2),
 		CreatorJobs: []schema.GameCreatorJobTable{
 			*job2,
@@ -114,8 +116,8 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 	}
 	creator3 := &schema.GameCreatorTable{
 		ID:        uuid.New(),
-		UserID:    uuid.New(),
-		UserName:  "creator3",
+		UserID:    uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName:  sql.NullString{String: "creator3", Valid: true},
 		GameID:    uuid.UUID(gameID2),
 		CreatedAt: now.Add(1 * time.Hour),
 	}
@@ -133,9 +135,9 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 				domain.NewGameCreatorWithJobs(
 					domain.NewGameCreator(
 						values.GameCreatorID(creator1.ID),
-						values.TraPMemberID(creator1.UserID),
+						values.TraPMemberID(creator1.UserID.UUID),
 						values.NewGameIDFromUUID(creator1.GameID),
-						values.TraPMemberName(creator1.UserName),
+						values.TraPMemberName(creator1.UserName.String),
 						creator1.CreatedAt),
 					[]*domain.GameCreatorJob{
 						domain.NewGameCreatorJob(
@@ -162,9 +164,9 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 				domain.NewGameCreatorWithJobs(
 					domain.NewGameCreator(
 						values.GameCreatorID(creator2.ID),
-						values.TraPMemberID(creator2.UserID),
+						values.TraPMemberID(creator2.UserID.UUID),
 						values.NewGameIDFromUUID(creator2.GameID),
-						values.TraPMemberName(creator2.UserName),
+						values.TraPMemberName(creator2.UserName.String),
 						creator2.CreatedAt),
 					[]*domain.GameCreatorJob{
 						domain.NewGameCreatorJob(
@@ -191,9 +193,9 @@ func TestGetGameCreatorsByGameID(t *testing.T) {
 				domain.NewGameCreatorWithJobs(
 					domain.NewGameCreator(
 						values.GameCreatorID(creator3.ID),
-						values.TraPMemberID(creator3.UserID),
+						values.TraPMemberID(creator3.UserID.UUID),
 						values.NewGameIDFromUUID(creator3.GameID),
-						values.TraPMemberName(creator3.UserName),
+						values.TraPMemberName(creator3.UserName.String),
 						creator3.CreatedAt),
 					[]*domain.GameCreatorJob{},
 					[]*domain.GameCreatorCustomJob{},
@@ -747,6 +749,24 @@ func TestCreateGameCreators(t *testing.T) {
 		values.NewTrapMemberName("ぽてと"),
 		now.Add(1*time.Hour),
 	)
+	externalURL, err := url.Parse("https://example.com")
+	require.NoError(t, err)
+	externalCreator1 := domain.NewExternalGameCreator(
+		values.NewGameCreatorID(),
+		gameID,
+		values.NewGameCreatorExternalName("外部の作曲者"),
+		values.NewGameCreatorExternalURL(externalURL),
+		now,
+	)
+	externalCreator1.SetDisplayOrder(1)
+	externalCreator2 := domain.NewExternalGameCreator(
+		values.NewGameCreatorID(),
+		gameID,
+		values.NewGameCreatorExternalName("外部の声優"),
+		nil,
+		now,
+	)
+	externalCreator2.SetDisplayOrder(2)
 
 	testCases := map[string]struct {
 		addCreators       []*domain.GameCreator
@@ -760,6 +780,12 @@ func TestCreateGameCreators(t *testing.T) {
 			wantDeltaCreators: 2,
 			err:               nil,
 		},
+		"外部のクリエイターを複数作成できる": {
+			addCreators:       []*domain.GameCreator{externalCreator1, externalCreator2},
+			beforeCreators:    []*schema.GameCreatorTable{},
+			wantDeltaCreators: 2,
+			err:               nil,
+		},
 		"空の配列を渡した場合は何もしない": {
 			addCreators:       []*domain.GameCreator{},
 			beforeCreators:    []*schema.GameCreatorTable{},
@@ -792,8 +818,8 @@ func TestCreateGameCreators(t *testing.T) {
 			beforeCreators: []*schema.GameCreatorTable{
 				{
 					ID:        uuid.UUID(creator1.GetID()),
-					UserID:    uuid.UUID(creator1.GetUserID()),
-					UserName:  string(creator1.GetUserName()),
+					UserID:    uuid.NullUUID{UUID: uuid.UUID(creator1.GetUserID()), Valid: true},
+					UserName:  sql.NullString{String: string(creator1.GetUserName()), Valid: true},
 					GameID:    uuid.UUID(creator1.GetGameID()),
 					CreatedAt: creator1.GetCreatedAt(),
 				},
@@ -860,9 +886,21 @@ func TestCreateGameCreators(t *testing.T) {
 						continue
 					}
 					assert.Equal(t, c.GetID(), values.GameCreatorID(actual.ID))
-					assert.Equal(t, uuid.UUID(c.GetUserID()), actual.UserID)
 					assert.Equal(t, uuid.UUID(c.GetGameID()), actual.GameID)
-					assert.Equal(t, string(c.GetUserName()), actual.UserName)
+					assert.Equal(t, c.GetDisplayOrder(), actual.DisplayOrder)
+					if c.IsExternal() {
+						assert.False(t, actual.UserID.Valid)
+						assert.False(t, actual.UserName.Valid)
+						assert.Equal(t, sql.NullString{String: string(c.GetExternalName()), Valid: true}, actual.ExternalName)
+						if c.GetExternalURL() == nil {
+							assert.False(t, actual.ExternalURL.Valid)
+						} else {
+							assert.Equal(t, sql.NullString{String: (*url.URL)(c.GetExternalURL()).String(), Valid: true}, actual.ExternalURL)
+						}
+					} else {
+						assert.Equal(t, uuid.NullUUID{UUID: uuid.UUID(c.GetUserID()), Valid: true}, actual.UserID)
+						assert.Equal(t, sql.NullString{String: string(c.GetUserName()), Valid: true}, actual.UserName)
+					}
 					assert.WithinDuration(t, c.GetCreatedAt(), actual.CreatedAt, time.Second)
 				}
 			} else {
@@ -881,8 +919,8 @@ func TestCreateGameCreators(t *testing.T) {
 func TestGetGameCreatorsByUserIDs(t *testing.T) {
 	creatorSchema1 := &schema.GameCreatorTable{
 		ID:        uuid.New(),
-		UserID:    uuid.New(),
-		UserName:  "user",
+		UserID:    uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName:  sql.NullString{String: "user", Valid: true},
 		CreatedAt: time.Now(),
 		Game: schema.GameTable2{
 			ID:               uuid.New(),
@@ -892,15 +930,15 @@ func TestGetGameCreatorsByUserIDs(t *testing.T) {
 	}
 	creator1 := domain.NewGameCreator(
 		values.GameCreatorID(creatorSchema1.ID),
-		values.NewTrapMemberID(creatorSchema1.UserID),
+		values.NewTrapMemberID(creatorSchema1.UserID.UUID),
 		values.GameID(creatorSchema1.Game.ID),
-		values.NewTrapMemberName(creatorSchema1.UserName),
+		values.NewTrapMemberName(creatorSchema1.UserName.String),
 		creatorSchema1.CreatedAt,
 	)
 	creatorSchema2 := &schema.GameCreatorTable{
 		ID:        uuid.New(),
-		UserID:    uuid.New(),
-		UserName:  "user",
+		UserID:    uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName:  sql.NullString{String: "user", Valid: true},
 		CreatedAt: time.Now().Add(-time.Hour),
 		Game: schema.GameTable2{
 			ID:               creatorSchema1.Game.ID,
@@ -910,15 +948,15 @@ func TestGetGameCreatorsByUserIDs(t *testing.T) {
 	}
 	creator2 := domain.NewGameCreator(
 		values.GameCreatorID(creatorSchema2.ID),
-		values.NewTrapMemberID(creatorSchema2.UserID),
+		values.NewTrapMemberID(creatorSchema2.UserID.UUID),
 		values.GameID(creatorSchema2.Game.ID),
-		values.NewTrapMemberName(creatorSchema2.UserName),
+		values.NewTrapMemberName(creatorSchema2.UserName.String),
 		creatorSchema2.CreatedAt,
 	)
 	creatorSchema3 := &schema.GameCreatorTable{
 		ID:        uuid.New(),
 		UserID:    creatorSchema1.UserID,
-		UserName:  "user3",
+		UserName:  sql.NullString{String: "user3", Valid: true},
 		CreatedAt: time.Now().Add(-time.Hour),
 		Game: schema.GameTable2{
 			ID:               uuid.New(),
@@ -1004,8 +1042,8 @@ func TestUpsertGameCreatorPresetJobsRelations(t *testing.T) {
 
 	creator1 := schema.GameCreatorTable{
 		ID:        creatorID1,
-		UserID:    userID1,
-		UserName:  "user1",
+		UserID:    uuid.NullUUID{UUID: userID1, Valid: true},
+		UserName:  sql.NullString{String: "user1", Valid: true},
 		GameID:    gameID1,
 		CreatedAt: time.Now(),
 		Game: schema.GameTable2{
@@ -1023,8 +1061,8 @@ func TestUpsertGameCreatorPresetJobsRelations(t *testing.T) {
 	}
 	creator2 := schema.GameCreatorTable{
 		ID:        creatorID2,
-		UserID:    userID2,
-		UserName:  "user2",
+		UserID:    uuid.NullUUID{UUID: userID2, Valid: true},
+		UserName:  sql.NullString{String: "user2", Valid: true},
 		GameID:    gameID1,
 		CreatedAt: time.Now().Add(time.Hour),
 		Game: schema.GameTable2{
@@ -1188,3 +1226,134 @@ func TestUpsertGameCreatorPresetJobsRelations(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGameCreatorDisplayOrders(t *testing.T) {
+	ctx := t.Context()
+	db, err := testDB.getDB(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	gameID := values.NewGameID()
+	game := &schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "Test Game",
+		VisibilityTypeID: 1,
+	}
+	err = db.Create(game).Error
+	require.NoError(t, err)
+
+	memberCreator := &schema.GameCreatorTable{
+		ID:        uuid.New(),
+		GameID:    uuid.UUID(gameID),
+		UserID:    uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName:  sql.NullString{String: "member", Valid: true},
+		CreatedAt: now,
+	}
+	externalCreator := &schema.GameCreatorTable{
+		ID:           uuid.New(),
+		GameID:       uuid.UUID(gameID),
+		ExternalName: sql.NullString{String: "外部の作曲者", Valid: true},
+		ExternalURL:  sql.NullString{String: "https://example.com", Valid: true},
+		DisplayOrder: 1,
+		CreatedAt:    now.Add(time.Hour),
+	}
+	err = db.Create([]*schema.GameCreatorTable{memberCreator, externalCreator}).Error
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db, err := testDB.getDB(context.Background())
+		require.NoError(t, err)
+		err = db.Delete([]*schema.GameCreatorTable{memberCreator, externalCreator}).Error
+		require.NoError(t, err)
+		err = db.Delete(game).Error
+		require.NoError(t, err)
+	})
+
+	repo := NewGameCreator(testDB)
+
+	err = repo.UpdateGameCreatorDisplayOrders(ctx, gameID, []values.GameCreatorID{
+		values.GameCreatorID(externalCreator.ID),
+		values.GameCreatorID(memberCreator.ID),
+	})
+	require.NoError(t, err)
+
+	creators, err := repo.GetGameCreatorsByGameID(ctx, gameID)
+	require.NoError(t, err)
+	require.Len(t, creators, 2)
+
+	actualExternal := creators[0].GetGameCreator()
+	assert.Equal(t, values.GameCreatorID(externalCreator.ID), actualExternal.GetID())
+	assert.True(t, actualExternal.IsExternal())
+	assert.Equal(t, values.NewGameCreatorExternalName("外部の作曲者"), actualExternal.GetExternalName())
+	require.NotNil(t, actualExternal.GetExternalURL())
+	assert.Equal(t, "https://example.com", (*url.URL)(actualExternal.GetExternalURL()).String())
+	assert.Equal(t, 0, actualExternal.GetDisplayOrder())
+
+	actualMember := creators[1].GetGameCreator()
+	assert.Equal(t, values.GameCreatorID(memberCreator.ID), actualMember.GetID())
+	assert.False(t, actualMember.IsExternal())
+	assert.Equal(t, values.TraPMemberID(memberCreator.UserID.UUID), actualMember.GetUserID())
+	assert.Equal(t, 1, actualMember.GetDisplayOrder())
+}
+
+func TestUpdateGameCreatorJobDisplayOrders(t *testing.T) {
+	ctx := t.Context()
+	db, err := testDB.getDB(ctx)
+	require.NoError(t, err)
+
+	gameID := values.NewGameID()
+	game := &schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "Test Game",
+		VisibilityTypeID: 1,
+	}
+	err = db.Create(game).Error
+	require.NoError(t, err)
+
+	presetJob1 := schema.GameCreatorJobTable{ID: uuid.New(), DisplayName: "preset job1"}
+	presetJob2 := schema.GameCreatorJobTable{ID: uuid.New(), DisplayName: "preset job2"}
+	customJob := schema.GameCreatorCustomJobTable{ID: uuid.New(), GameID: uuid.UUID(gameID), DisplayName: "custom job"}
+	creator := &schema.GameCreatorTable{
+		ID:                uuid.New(),
+		GameID:            uuid.UUID(gameID),
+		UserID:            uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		UserName:          sql.NullString{String: "member", Valid: true},
+		CreatorJobs:       []schema.GameCreatorJobTable{presetJob1, presetJob2},
+		CustomCreatorJobs: []schema.GameCreatorCustomJobTable{customJob},
+	}
+	err = db.Create(creator).Error
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db, err := testDB.getDB(context.Background())
+		require.NoError(t, err)
+		err = db.Select(clause.Associations).Delete(creator).Error
+		require.NoError(t, err)
+		err = db.Delete([]schema.GameCreatorJobTable{presetJob1, presetJob2}).Error
+		require.NoError(t, err)
+		err = db.Delete(&customJob).Error
+		require.NoError(t, err)
+		err = db.Delete(game).Error
+		require.NoError(t, err)
+	})
+
+	repo := NewGameCreator(testDB)
+
+	err = repo.UpdateGameCreatorJobDisplayOrders(ctx, values.GameCreatorID(creator.ID), []values.GameCreatorJobID{
+		values.GameCreatorJobID(presetJob2.ID),
+		values.GameCreatorJobID(customJob.ID),
+		values.GameCreatorJobID(presetJob1.ID),
+	})
+	require.NoError(t, err)
+
+	creators, err := repo.GetGameCreatorsByGameID(ctx, gameID)
+	require.NoError(t, err)
+	require.Len(t, creators, 1)
+
+	actual := creators[0]
+	require.Len(t, actual.GetJobs(), 2)
+	assert.Equal(t, values.GameCreatorJobID(presetJob2.ID), actual.GetJobs()[0].GetID())
+	assert.Equal(t, values.GameCreatorJobID(presetJob1.ID), actual.GetJobs()[1].GetID())
+	assert.Equal(t, 0, actual.GetJobDisplayOrder(values.GameCreatorJobID(presetJob2.ID)))
+	assert.Equal(t, 1, actual.GetJobDisplayOrder(values.GameCreatorJobID(customJob.ID)))
+	assert.Equal(t, 2, actual.GetJobDisplayOrder(values.GameCreatorJobID(presetJob1.ID)))
+}
@@ -216,13 +216,20 @@ func (gameGenre *GameGenre) GetGameGenres(ctx context.Context, visibilities []va
 	result := make([]*repository.GameGenreInfo, 0, len(gameGenreInfos))
 
 	for i := range gameGenreInfos {
+		var parentID *values.GameGenreID
+		if gameGenreInfos[i].ParentID.Valid {
+			id := values.GameGenreIDFromUUID(gameGenreInfos[i].ParentID.UUID)
+			parentID = &id
+		}
+
 		result = append(result, &repository.GameGenreInfo{
 			GameGenre: *domain.NewGameGenre(
 				values.GameGenreID(gameGenreInfos[i].ID),
 				values.GameGenreName(gameGenreInfos[i].Name),
 				gameGenreInfos[i].CreatedAt,
 			),
-			Num: gameGenreInfos[i].Num,
+			Num:      gameGenreInfos[i].Num,
+			ParentID: parentID,
 		})
 	}
 
@@ -319,3 +326,216 @@ func (gameGenre *GameGenre) GetGamesByGenreID(ctx context.Context, gameGenreID v
 
 	return result, nil
 }
+
+func (gameGenre *GameGenre) GetGameGenreParentIDs(ctx context.Context) (map[values.GameGenreID]values.GameGenreID, error) {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var genres []schema.GameGenreTable
+	err = db.
+		Select("id", "parent_id").
+		Where("parent_id IS NOT NULL").
+		Find(&genres).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre parents: %w", err)
+	}
+
+	parentIDs := make(map[values.GameGenreID]values.GameGenreID, len(genres))
+	for _, genre := range genres {
+		parentIDs[values.GameGenreIDFromUUID(genre.ID)] = values.GameGenreIDFromUUID(genre.ParentID.UUID)
+	}
+
+	return parentIDs, nil
+}
+
+func (gameGenre *GameGenre) UpdateGameGenreParent(ctx context.Context, gameGenreID values.GameGenreID, parentID *values.GameGenreID) error {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var parent uuid.NullUUID
+	if parentID != nil {
+		parent = uuid.NullUUID{UUID: uuid.UUID(*parentID), Valid: true}
+	}
+
+	result := db.
+		Model(&schema.GameGenreTable{}).
+		Where("id = ?", uuid.UUID(gameGenreID)).
+		Update("parent_id", parent)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update game genre parent: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (gameGenre *GameGenre) MergeGameGenre(ctx context.Context, sourceID values.GameGenreID, targetID values.GameGenreID) error {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	// 既にtargetのジャンルを持つゲームに重複して付けないように、持っていないゲームにだけtargetのジャンルを付ける。
+	err = db.Exec(
+		"INSERT INTO game_genre_relations (game_id, genre_id) "+
+			"SELECT src.game_id, ? FROM game_genre_relations AS src "+
+			"WHERE src.genre_id = ? AND NOT EXISTS ("+
+			"SELECT 1 FROM game_genre_relations AS dst WHERE dst.game_id = src.game_id AND dst.genre_id = ?)",
+		uuid.UUID(targetID), uuid.UUID(sourceID), uuid.UUID(targetID),
+	).Error
+	if err != nil {
+		return fmt.Errorf("failed to add target genre to games: %w", err)
+	}
+
+	err = db.Exec("DELETE FROM game_genre_relations WHERE genre_id = ?", uuid.UUID(sourceID)).Error
+	if err != nil {
+		return fmt.Errorf("failed to remove source genre from games: %w", err)
+	}
+
+	err = db.
+		Model(&schema.GameGenreAliasTable{}).
+		Where("genre_id = ?", uuid.UUID(sourceID)).
+		Update("genre_id", uuid.UUID(targetID)).Error
+	if err != nil {
+		return fmt.Errorf("failed to move game genre aliases: %w", err)
+	}
+
+	// targetがsourceの子ジャンルの場合に、targetが自分自身の親にならないようにする。
+	err = db.
+		Model(&schema.GameGenreTable{}).
+		Where("parent_id = ?", uuid.UUID(sourceID)).
+		Where("id <> ?", uuid.UUID(targetID)).
+		Update("parent_id", uuid.UUID(targetID)).Error
+	if err != nil {
+		return fmt.Errorf("failed to move child game genres: %w", err)
+	}
+
+	return nil
+}
+
+func (gameGenre *GameGenre) GetGameGenreAliases(ctx context.Context) ([]*domain.GameGenreAlias, error) {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var aliases []schema.GameGenreAliasTable
+	err = db.
+		Order("created_at").
+		Find(&aliases).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre aliases: %w", err)
+	}
+
+	return convertGameGenreAliases(aliases), nil
+}
+
+func (gameGenre *GameGenre) GetGameGenreAliasesWithNames(ctx context.Context, names []values.GameGenreName) ([]*domain.GameGenreAlias, error) {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	if len(names) == 0 {
+		return []*domain.GameGenreAlias{}, nil
+	}
+
+	var aliases []schema.GameGenreAliasTable
+	err = db.
+		Where("name IN ?", names).
+		Find(&aliases).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre aliases with names: %w", err)
+	}
+
+	return convertGameGenreAliases(aliases), nil
+}
+
+func (gameGenre *GameGenre) GetGameGenreAlias(ctx context.Context, aliasID values.GameGenreAliasID) (*domain.GameGenreAlias, error) {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var alias schema.GameGenreAliasTable
+	err = db.
+		Where("id = ?", uuid.UUID(aliasID)).
+		Take(&alias).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre alias: %w", err)
+	}
+
+	return domain.NewGameGenreAlias(
+		values.NewGameGenreAliasIDFromUUID(alias.ID),
+		values.GameGenreIDFromUUID(alias.GenreID),
+		values.NewGameGenreName(alias.Name),
+		alias.CreatedAt,
+	), nil
+}
+
+func (gameGenre *GameGenre) SaveGameGenreAlias(ctx context.Context, alias *domain.GameGenreAlias) error {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	err = db.Create(&schema.GameGenreAliasTable{
+		ID:        uuid.UUID(alias.GetID()),
+		GenreID:   uuid.UUID(alias.GetGenreID()),
+		Name:      string(alias.GetName()),
+		CreatedAt: alias.GetCreatedAt(),
+	}).Error
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return repository.ErrDuplicatedUniqueKey
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save game genre alias: %w", err)
+	}
+
+	return nil
+}
+
+func (gameGenre *GameGenre) RemoveGameGenreAlias(ctx context.Context, aliasID values.GameGenreAliasID) error {
+	db, err := gameGenre.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	result := db.
+		Where("id = ?", uuid.UUID(aliasID)).
+		Delete(&schema.GameGenreAliasTable{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove game genre alias: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordDeleted
+	}
+
+	return nil
+}
+
+func convertGameGenreAliases(aliases []schema.GameGenreAliasTable) []*domain.GameGenreAlias {
+	result := make([]*domain.GameGenreAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		result = append(result, domain.NewGameGenreAlias(
+			values.NewGameGenreAliasIDFromUUID(alias.ID),
+			values.GameGenreIDFromUUID(alias.GenreID),
+			values.NewGameGenreName(alias.Name),
+			alias.CreatedAt,
+		))
+	}
+
+	return result
+}
@@ -1276,6 +1276,326 @@ func TestGetGamesByGenreID(t *testing.T) {
 
 }
 
+func TestUpdateGameGenreParent(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameGenreRepository := NewGameGenre(testDB)
+
+	parentID := values.NewGameGenreID()
+	childID := values.NewGameGenreID()
+	otherID := values.NewGameGenreID()
+
+	gameGenres := []schema.GameGenreTable{
+		{ID: uuid.UUID(parentID), Name: "parent", CreatedAt: time.Now()},
+		{ID: uuid.UUID(childID), Name: "child", CreatedAt: time.Now()},
+		{ID: uuid.UUID(otherID), Name: "other", CreatedAt: time.Now()},
+	}
+
+	require.NoError(t, db.Create(&gameGenres).Error)
+	t.Cleanup(func() {
+		cleanupGameGenresTable(t)
+	})
+
+	// テストケースの順番に依存するので、mapではなくsliceで持つ
+	testCases := []struct {
+		description string
+		gameGenreID values.GameGenreID
+		parentID    *values.GameGenreID
+		parentIDs   map[values.GameGenreID]values.GameGenreID
+		isError     bool
+		wantErr     error
+	}{
+		{
+			description: "親ジャンルを設定できる",
+			gameGenreID: childID,
+			parentID:    &parentID,
+			parentIDs:   map[values.GameGenreID]values.GameGenreID{childID: parentID},
+		},
+		{
+			description: "変更が無いのでErrNoRecordUpdated",
+			gameGenreID: childID,
+			parentID:    &parentID,
+			parentIDs:   map[values.GameGenreID]values.GameGenreID{childID: parentID},
+			isError:     true,
+			wantErr:     repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "親ジャンルを変更できる",
+			gameGenreID: childID,
+			parentID:    &otherID,
+			parentIDs:   map[values.GameGenreID]values.GameGenreID{childID: otherID},
+		},
+		{
+			description: "親ジャンル無しにできる",
+			gameGenreID: childID,
+			parentIDs:   map[values.GameGenreID]values.GameGenreID{},
+		},
+		{
+			description: "存在しないジャンルなのでErrNoRecordUpdated",
+			gameGenreID: values.NewGameGenreID(),
+			parentID:    &parentID,
+			parentIDs:   map[values.GameGenreID]values.GameGenreID{},
+			isError:     true,
+			wantErr:     repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := gameGenreRepository.UpdateGameGenreParent(ctx, testCase.gameGenreID, testCase.parentID)
+
+			if testCase.isError {
+				if testCase.wantErr != nil {
+					assert.ErrorIs(t, err, testCase.wantErr)
+				} else {
+					assert.Error(t, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			parentIDs, err := gameGenreRepository.GetGameGenreParentIDs(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.parentIDs, parentIDs)
+		})
+	}
+}
+
+func TestMergeGameGenre(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameGenreRepository := NewGameGenre(testDB)
+
+	now := time.Now()
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	require.NoError(t, err)
+
+	sourceID := values.NewGameGenreID()
+	targetID := values.NewGameGenreID()
+	childID := values.NewGameGenreID()
+
+	source := schema.GameGenreTable{ID: uuid.UUID(sourceID), Name: "source", CreatedAt: now}
+	target := schema.GameGenreTable{ID: uuid.UUID(targetID), Name: "target", CreatedAt: now}
+	child := schema.GameGenreTable{
+		ID:        uuid.UUID(childID),
+		Name:      "child",
+		CreatedAt: now,
+		ParentID:  uuid.NullUUID{UUID: uuid.UUID(sourceID), Valid: true},
+	}
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+
+	games := []schema.GameTable2{
+		{
+			ID:               uuid.UUID(gameID1),
+			Name:             "test1",
+			Description:      "test1",
+			CreatedAt:        now,
+			VisibilityTypeID: gameVisibilityPublic.ID,
+			GameGenres:       []*schema.GameGenreTable{&source},
+		},
+		{
+			ID:               uuid.UUID(gameID2),
+			Name:             "test2",
+			Description:      "test2",
+			CreatedAt:        now,
+			VisibilityTypeID: gameVisibilityPublic.ID,
+			GameGenres:       []*schema.GameGenreTable{&source, &target},
+		},
+	}
+
+	require.NoError(t, db.Create(&games).Error)
+	require.NoError(t, db.Create(&child).Error)
+
+	aliasID := values.NewGameGenreAliasID()
+	require.NoError(t, db.Create(&schema.GameGenreAliasTable{
+		ID:        uuid.UUID(aliasID),
+		GenreID:   uuid.UUID(sourceID),
+		Name:      "source alias",
+		CreatedAt: now,
+	}).Error)
+
+	t.Cleanup(func() {
+		cleanupGameGenresTable(t)
+		require.NoError(t, db.
+			Session(&gorm.Session{AllowGlobalUpdate: true}).Unscoped().Delete(&schema.GameTable2{}).Error)
+	})
+
+	err = gameGenreRepository.MergeGameGenre(ctx, sourceID, targetID)
+	require.NoError(t, err)
+
+	sourceGames, err := gameGenreRepository.GetGamesByGenreID(ctx, sourceID)
+	require.NoError(t, err)
+	assert.Empty(t, sourceGames)
+
+	// 既にtargetを持っていたゲームも、targetを1つだけ持つ
+	targetGames, err := gameGenreRepository.GetGamesByGenreID(ctx, targetID)
+	require.NoError(t, err)
+	assert.Len(t, targetGames, 2)
+
+	for _, gameID := range []values.GameID{gameID1, gameID2} {
+		genres, err := gameGenreRepository.GetGenresByGameID(ctx, gameID)
+		require.NoError(t, err)
+		require.Len(t, genres, 1)
+		assert.Equal(t, targetID, genres[0].GetID())
+	}
+
+	alias, err := gameGenreRepository.GetGameGenreAlias(ctx, aliasID)
+	require.NoError(t, err)
+	assert.Equal(t, targetID, alias.GetGenreID())
+
+	parentIDs, err := gameGenreRepository.GetGameGenreParentIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[values.GameGenreID]values.GameGenreID{childID: targetID}, parentIDs)
+
+	// source自体は削除しない
+	_, err = gameGenreRepository.GetGameGenre(ctx, sourceID)
+	assert.NoError(t, err)
+}
+
+func TestSaveGameGenreAlias(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameGenreRepository := NewGameGenre(testDB)
+
+	genreID := values.NewGameGenreID()
+	require.NoError(t, db.Create(&schema.GameGenreTable{ID: uuid.UUID(genreID), Name: "genre", CreatedAt: time.Now()}).Error)
+	t.Cleanup(func() {
+		cleanupGameGenresTable(t)
+	})
+
+	now := time.Now()
+	alias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), genreID, "alias", now)
+
+	testCases := []struct {
+		description string
+		alias       *domain.GameGenreAlias
+		isError     bool
+		wantErr     error
+	}{
+		{
+			description: "特に問題ないのでエラー無し",
+			alias:       alias,
+		},
+		{
+			description: "名前が重複するのでErrDuplicatedUniqueKey",
+			alias:       domain.NewGameGenreAlias(values.NewGameGenreAliasID(), genreID, "alias", now),
+			isError:     true,
+			wantErr:     repository.ErrDuplicatedUniqueKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := gameGenreRepository.SaveGameGenreAlias(ctx, testCase.alias)
+
+			if testCase.isError {
+				if testCase.wantErr != nil {
+					assert.ErrorIs(t, err, testCase.wantErr)
+				} else {
+					assert.Error(t, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	aliases, err := gameGenreRepository.GetGameGenreAliases(ctx)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	assert.Equal(t, alias.GetID(), aliases[0].GetID())
+	assert.Equal(t, alias.GetGenreID(), aliases[0].GetGenreID())
+	assert.Equal(t, alias.GetName(), aliases[0].GetName())
+	assert.WithinDuration(t, alias.GetCreatedAt(), aliases[0].GetCreatedAt(), time.Second)
+
+	aliases, err = gameGenreRepository.GetGameGenreAliasesWithNames(ctx, []values.GameGenreName{"alias", "genre"})
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	assert.Equal(t, alias.GetID(), aliases[0].GetID())
+
+	aliases, err = gameGenreRepository.GetGameGenreAliasesWithNames(ctx, []values.GameGenreName{"genre"})
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+}
+
+func TestRemoveGameGenreAlias(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameGenreRepository := NewGameGenre(testDB)
+
+	genreID := values.NewGameGenreID()
+	aliasID := values.NewGameGenreAliasID()
+	require.NoError(t, db.Create(&schema.GameGenreTable{ID: uuid.UUID(genreID), Name: "genre", CreatedAt: time.Now()}).Error)
+	require.NoError(t, db.Create(&schema.GameGenreAliasTable{ID: uuid.UUID(aliasID), GenreID: uuid.UUID(genreID), Name: "alias", CreatedAt: time.Now()}).Error)
+	t.Cleanup(func() {
+		cleanupGameGenresTable(t)
+	})
+
+	testCases := []struct {
+		description string
+		aliasID     values.GameGenreAliasID
+		isError     bool
+		wantErr     error
+	}{
+		{
+			description: "特に問題ないのでエラー無し",
+			aliasID:     aliasID,
+		},
+		{
+			description: "既に削除されているのでErrNoRecordDeleted",
+			aliasID:     aliasID,
+			isError:     true,
+			wantErr:     repository.ErrNoRecordDeleted,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := gameGenreRepository.RemoveGameGenreAlias(ctx, testCase.aliasID)
+
+			if testCase.isError {
+				if testCase.wantErr != nil {
+					assert.ErrorIs(t, err, testCase.wantErr)
+				} else {
+					assert.Error(t, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			_, err = gameGenreRepository.GetGameGenreAlias(ctx, testCase.aliasID)
+			assert.ErrorIs(t, err, repository.ErrRecordNotFound)
+		})
+	}
+}
+
 // game_genresテーブルとgame_genre_relationsテーブルを削除する。gamesテーブルは削除されない。
 func cleanupGameGenresTable(t *testing.T) {
 	t.Helper()
@@ -0,0 +1,264 @@
+package gorm2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+var _ repository.GameRoleInvitation = &GameRoleInvitation{}
+
+type GameRoleInvitation struct {
+	db *DB
+}
+
+func NewGameRoleInvitation(db *DB) *GameRoleInvitation {
+	return &GameRoleInvitation{
+		db: db,
+	}
+}
+
+func (gri *GameRoleInvitation) SaveGameRoleInvitation(ctx context.Context, gameID values.GameID, invitation *domain.GameRoleInvitation) error {
+	db, err := gri.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var roleTypeName string
+	switch invitation.GetRole() {
+	case values.GameManagementRoleAdministrator:
+		roleTypeName = gameManagementRoleTypeAdministrator
+	case values.GameManagementRoleCollaborator:
+		roleTypeName = gameManagementRoleTypeCollaborator
+	default:
+		return errors.New("invalid role")
+	}
+
+	var roleType schema.GameManagementRoleTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where("name = ?", roleTypeName).
+		Select("id").
+		Take(&roleType).Error
+	if err != nil {
+		return fmt.Errorf("failed to get role type: %w", err)
+	}
+
+	status, err := convertGameRoleInvitationStatus(invitation.GetStatus())
+	if err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+
+	invitationTable := schema.GameRoleInvitationTable{
+		ID:         uuid.UUID(invitation.GetID()),
+		GameID:     uuid.UUID(gameID),
+		InviterID:  uuid.UUID(invitation.GetInviterID()),
+		InviteeID:  uuid.UUID(invitation.GetInviteeID()),
+		RoleTypeID: roleType.ID,
+		Status:     status,
+		CreatedAt:  invitation.GetCreatedAt(),
+		ExpiresAt:  invitation.GetExpiresAt(),
+	}
+
+	err = db.
+		Session(&gorm.Session{}).
+		Create(&invitationTable).Error
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return repository.ErrDuplicatedUniqueKey
+		}
+		return fmt.Errorf("failed to create game role invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (gri *GameRoleInvitation) UpdateGameRoleInvitationStatus(ctx context.Context, invitationID values.GameRoleInvitationID, status values.GameRoleInvitationStatus) error {
+	db, err := gri.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusName, err := convertGameRoleInvitationStatus(status)
+	if err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+
+	result := db.
+		Model(&schema.GameRoleInvitationTable{}).
+		Where("id = ?", uuid.UUID(invitationID)).
+		Update("status", statusName)
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to update game role invitation status: %w", err)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (gri *GameRoleInvitation) GetGameRoleInvitation(ctx context.Context, invitationID values.GameRoleInvitationID, lockType repository.LockType) (*repository.GameRoleInvitationInfo, error) {
+	db, err := gri.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	db, err = gri.db.setLock(db, lockType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set lock: %w", err)
+	}
+
+	var invitation schema.GameRoleInvitationTable
+	err = db.
+		Joins("RoleTypeTable").
+		Where("game_role_invitations.id = ?", uuid.UUID(invitationID)).
+		Take(&invitation).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game role invitation: %w", err)
+	}
+
+	invitationInfo, err := convertGameRoleInvitationTable(&invitation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert game role invitation: %w", err)
+	}
+
+	return invitationInfo, nil
+}
+
+func (gri *GameRoleInvitation) GetPendingGameRoleInvitationsByInviteeID(ctx context.Context, inviteeID values.TraPMemberID) ([]*repository.GameRoleInvitationInfo, error) {
+	db, err := gri.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var invitations []schema.GameRoleInvitationTable
+	err = db.
+		Joins("RoleTypeTable").
+		Joins("INNER JOIN games ON games.id = game_role_invitations.game_id").
+		Where("games.deleted_at IS NULL").
+		Where("game_role_invitations.invitee_id = ?", uuid.UUID(inviteeID)).
+		Where("game_role_invitations.status = ?", schema.GameRoleInvitationStatusPending).
+		Order("game_role_invitations.created_at DESC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game role invitations: %w", err)
+	}
+
+	invitationInfos := make([]*repository.GameRoleInvitationInfo, 0, len(invitations))
+	for _, invitation := range invitations {
+		invitationInfo, err := convertGameRoleInvitationTable(&invitation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert game role invitation: %w", err)
+		}
+
+		invitationInfos = append(invitationInfos, invitationInfo)
+	}
+
+	return invitationInfos, nil
+}
+
+func (gri *GameRoleInvitation) GetPendingGameRoleInvitationsByGameID(ctx context.Context, gameID values.GameID, lockType repository.LockType) ([]*domain.GameRoleInvitation, error) {
+	db, err := gri.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	db, err = gri.db.setLock(db, lockType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set lock: %w", err)
+	}
+
+	var invitations []schema.GameRoleInvitationTable
+	err = db.
+		Joins("RoleTypeTable").
+		Where("game_role_invitations.game_id = ?", uuid.UUID(gameID)).
+		Where("game_role_invitations.status = ?", schema.GameRoleInvitationStatusPending).
+		Order("game_role_invitations.created_at DESC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game role invitations: %w", err)
+	}
+
+	invitationsDomain := make([]*domain.GameRoleInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		invitationInfo, err := convertGameRoleInvitationTable(&invitation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert game role invitation: %w", err)
+		}
+
+		invitationsDomain = append(invitationsDomain, invitationInfo.GameRoleInvitation)
+	}
+
+	return invitationsDomain, nil
+}
+
+func convertGameRoleInvitationTable(invitation *schema.GameRoleInvitationTable) (*repository.GameRoleInvitationInfo, error) {
+	var role values.GameManagementRole
+	switch invitation.RoleTypeTable.Name {
+	case gameManagementRoleTypeAdministrator:
+		role = values.GameManagementRoleAdministrator
+	case gameManagementRoleTypeCollaborator:
+		role = values.GameManagementRoleCollaborator
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	status, err := parseGameRoleInvitationStatus(invitation.Status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse status: %w", err)
+	}
+
+	return &repository.GameRoleInvitationInfo{
+		GameRoleInvitation: domain.NewGameRoleInvitation(
+			values.NewGameRoleInvitationIDFromUUID(invitation.ID),
+			values.NewTrapMemberID(invitation.InviterID),
+			values.NewTrapMemberID(invitation.InviteeID),
+			role,
+			status,
+			invitation.CreatedAt,
+			invitation.ExpiresAt,
+		),
+		GameID: values.NewGameIDFromUUID(invitation.GameID),
+	}, nil
+}
+
+func convertGameRoleInvitationStatus(status values.GameRoleInvitationStatus) (string, error) {
+	switch status {
+	case values.GameRoleInvitationStatusPending:
+		return schema.GameRoleInvitationStatusPending, nil
+	case values.GameRoleInvitationStatusAccepted:
+		return schema.GameRoleInvitationStatusAccepted, nil
+	case values.GameRoleInvitationStatusDeclined:
+		return schema.GameRoleInvitationStatusDeclined, nil
+	default:
+		return "", fmt.Errorf("invalid game role invitation status: %d", status)
+	}
+}
+
+func parseGameRoleInvitationStatus(status string) (values.GameRoleInvitationStatus, error) {
+	switch status {
+	case schema.GameRoleInvitationStatusPending:
+		return values.GameRoleInvitationStatusPending, nil
+	case schema.GameRoleInvitationStatusAccepted:
+		return values.GameRoleInvitationStatusAccepted, nil
+	case schema.GameRoleInvitationStatusDeclined:
+		return values.GameRoleInvitationStatusDeclined, nil
+	default:
+		return 0, fmt.Errorf("invalid game role invitation status: %s", status)
+	}
+}
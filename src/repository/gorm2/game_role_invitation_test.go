@@ -0,0 +1,454 @@
+package gorm2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+func TestSaveGameRoleInvitation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameRoleInvitationRepository := NewGameRoleInvitation(testDB)
+
+	roleTypeMap := getGameManagementRoleTypeMapForTest(t, db)
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypePublic)
+
+	type test struct {
+		description      string
+		gameID           values.GameID
+		invitation       *domain.GameRoleInvitation
+		beforeInvitation *schema.GameRoleInvitationTable
+		expectInvitation schema.GameRoleInvitationTable
+		isErr            bool
+		err              error
+	}
+
+	gameID := values.NewGameID()
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		VisibilityTypeID: gameVisibilityTypeIDPublic,
+		CreatedAt:        time.Now(),
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game: %+v\n", err)
+	}
+
+	invitationID1 := values.NewGameRoleInvitationID()
+	invitationID2 := values.NewGameRoleInvitationID()
+	invitationID3 := values.NewGameRoleInvitationID()
+	invitationID4 := values.NewGameRoleInvitationID()
+
+	inviterID := values.NewTrapMemberID(uuid.New())
+	inviteeID := values.NewTrapMemberID(uuid.New())
+
+	now := time.Now()
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			gameID:      gameID,
+			invitation: domain.NewGameRoleInvitation(
+				invitationID1, inviterID, inviteeID,
+				values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			expectInvitation: schema.GameRoleInvitationTable{
+				ID:         uuid.UUID(invitationID1),
+				GameID:     uuid.UUID(gameID),
+				InviterID:  uuid.UUID(inviterID),
+				InviteeID:  uuid.UUID(inviteeID),
+				RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+				Status:     schema.GameRoleInvitationStatusPending,
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+		},
+		{
+			description: "ownerへの招待でもエラーなし",
+			gameID:      gameID,
+			invitation: domain.NewGameRoleInvitation(
+				invitationID2, inviterID, inviteeID,
+				values.GameManagementRoleAdministrator, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			expectInvitation: schema.GameRoleInvitationTable{
+				ID:         uuid.UUID(invitationID2),
+				GameID:     uuid.UUID(gameID),
+				InviterID:  uuid.UUID(inviterID),
+				InviteeID:  uuid.UUID(inviteeID),
+				RoleTypeID: roleTypeMap[gameManagementRoleTypeAdministrator],
+				Status:     schema.GameRoleInvitationStatusPending,
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+		},
+		{
+			description: "roleが不正なのでエラー",
+			gameID:      gameID,
+			invitation: domain.NewGameRoleInvitation(
+				invitationID3, inviterID, inviteeID,
+				100, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			isErr: true,
+		},
+		{
+			description: "IDが重複しているのでErrDuplicatedUniqueKey",
+			gameID:      gameID,
+			invitation: domain.NewGameRoleInvitation(
+				invitationID4, inviterID, inviteeID,
+				values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending,
+				now, now.Add(time.Hour),
+			),
+			beforeInvitation: &schema.GameRoleInvitationTable{
+				ID:         uuid.UUID(invitationID4),
+				GameID:     uuid.UUID(gameID),
+				InviterID:  uuid.UUID(inviterID),
+				InviteeID:  uuid.UUID(inviteeID),
+				RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+				Status:     schema.GameRoleInvitationStatusPending,
+				CreatedAt:  now,
+				ExpiresAt:  now.Add(time.Hour),
+			},
+			isErr: true,
+			err:   repository.ErrDuplicatedUniqueKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.beforeInvitation != nil {
+				err := db.Create(testCase.beforeInvitation).Error
+				require.NoError(t, err)
+			}
+
+			err := gameRoleInvitationRepository.SaveGameRoleInvitation(ctx, testCase.gameID, testCase.invitation)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var invitation schema.GameRoleInvitationTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.invitation.GetID())).
+				Take(&invitation).Error
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectInvitation.ID, invitation.ID)
+			assert.Equal(t, testCase.expectInvitation.GameID, invitation.GameID)
+			assert.Equal(t, testCase.expectInvitation.InviterID, invitation.InviterID)
+			assert.Equal(t, testCase.expectInvitation.InviteeID, invitation.InviteeID)
+			assert.Equal(t, testCase.expectInvitation.RoleTypeID, invitation.RoleTypeID)
+			assert.Equal(t, testCase.expectInvitation.Status, invitation.Status)
+			assert.WithinDuration(t, testCase.expectInvitation.CreatedAt, invitation.CreatedAt, time.Second)
+			assert.WithinDuration(t, testCase.expectInvitation.ExpiresAt, invitation.ExpiresAt, time.Second)
+		})
+	}
+}
+
+func TestUpdateGameRoleInvitationStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameRoleInvitationRepository := NewGameRoleInvitation(testDB)
+
+	roleTypeMap := getGameManagementRoleTypeMapForTest(t, db)
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypePublic)
+
+	gameID := values.NewGameID()
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		VisibilityTypeID: gameVisibilityTypeIDPublic,
+		CreatedAt:        time.Now(),
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game: %+v\n", err)
+	}
+
+	invitationID1 := values.NewGameRoleInvitationID()
+	invitationID2 := values.NewGameRoleInvitationID()
+
+	err = db.Create(&[]schema.GameRoleInvitationTable{
+		{
+			ID:         uuid.UUID(invitationID1),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.New(),
+			InviteeID:  uuid.New(),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		},
+		{
+			ID:         uuid.UUID(invitationID2),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.New(),
+			InviteeID:  uuid.New(),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game role invitations: %+v\n", err)
+	}
+
+	type test struct {
+		description  string
+		invitationID values.GameRoleInvitationID
+		status       values.GameRoleInvitationStatus
+		expectStatus string
+		isErr        bool
+		err          error
+	}
+
+	testCases := []test{
+		{
+			description:  "承諾済みにできる",
+			invitationID: invitationID1,
+			status:       values.GameRoleInvitationStatusAccepted,
+			expectStatus: schema.GameRoleInvitationStatusAccepted,
+		},
+		{
+			description:  "辞退済みにできる",
+			invitationID: invitationID2,
+			status:       values.GameRoleInvitationStatusDeclined,
+			expectStatus: schema.GameRoleInvitationStatusDeclined,
+		},
+		{
+			description:  "招待が存在しないのでErrNoRecordUpdated",
+			invitationID: values.NewGameRoleInvitationID(),
+			status:       values.GameRoleInvitationStatusAccepted,
+			isErr:        true,
+			err:          repository.ErrNoRecordUpdated,
+		},
+		{
+			description:  "statusが不正なのでエラー",
+			invitationID: invitationID1,
+			status:       100,
+			isErr:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := gameRoleInvitationRepository.UpdateGameRoleInvitationStatus(ctx, testCase.invitationID, testCase.status)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var invitation schema.GameRoleInvitationTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.invitationID)).
+				Take(&invitation).Error
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectStatus, invitation.Status)
+		})
+	}
+}
+
+func TestGetPendingGameRoleInvitationsByInviteeID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameRoleInvitationRepository := NewGameRoleInvitation(testDB)
+
+	roleTypeMap := getGameManagementRoleTypeMapForTest(t, db)
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypePublic)
+
+	gameID := values.NewGameID()
+	deletedGameID := values.NewGameID()
+	err = db.Create(&[]schema.GameTable2{
+		{
+			ID:               uuid.UUID(gameID),
+			Name:             "test",
+			Description:      "test",
+			VisibilityTypeID: gameVisibilityTypeIDPublic,
+			CreatedAt:        time.Now(),
+		},
+		{
+			ID:               uuid.UUID(deletedGameID),
+			Name:             "deleted",
+			Description:      "deleted",
+			VisibilityTypeID: gameVisibilityTypeIDPublic,
+			CreatedAt:        time.Now(),
+			DeletedAt:        gorm.DeletedAt{Time: time.Now(), Valid: true},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create games: %+v\n", err)
+	}
+
+	inviterID := values.NewTrapMemberID(uuid.New())
+	inviteeID := values.NewTrapMemberID(uuid.New())
+	now := time.Now()
+
+	invitationID1 := values.NewGameRoleInvitationID()
+	invitationID2 := values.NewGameRoleInvitationID()
+
+	err = db.Create(&[]schema.GameRoleInvitationTable{
+		{
+			ID:         uuid.UUID(invitationID1),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.UUID(inviterID),
+			InviteeID:  uuid.UUID(inviteeID),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  now.Add(-time.Hour),
+			ExpiresAt:  now.Add(time.Hour),
+		},
+		{
+			ID:         uuid.UUID(invitationID2),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.UUID(inviterID),
+			InviteeID:  uuid.UUID(inviteeID),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeAdministrator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(time.Hour),
+		},
+		// 応答済みの招待は含まない
+		{
+			ID:         uuid.New(),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.UUID(inviterID),
+			InviteeID:  uuid.UUID(inviteeID),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusDeclined,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(time.Hour),
+		},
+		// 削除されたゲームへの招待は含まない
+		{
+			ID:         uuid.New(),
+			GameID:     uuid.UUID(deletedGameID),
+			InviterID:  uuid.UUID(inviterID),
+			InviteeID:  uuid.UUID(inviteeID),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(time.Hour),
+		},
+		// 他のユーザーへの招待は含まない
+		{
+			ID:         uuid.New(),
+			GameID:     uuid.UUID(gameID),
+			InviterID:  uuid.UUID(inviterID),
+			InviteeID:  uuid.New(),
+			RoleTypeID: roleTypeMap[gameManagementRoleTypeCollaborator],
+			Status:     schema.GameRoleInvitationStatusPending,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(time.Hour),
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game role invitations: %+v\n", err)
+	}
+
+	invitations, err := gameRoleInvitationRepository.GetPendingGameRoleInvitationsByInviteeID(ctx, inviteeID)
+	require.NoError(t, err)
+
+	require.Len(t, invitations, 2)
+
+	assert.Equal(t, invitationID2, invitations[0].GetID())
+	assert.Equal(t, values.GameManagementRoleAdministrator, invitations[0].GetRole())
+	assert.Equal(t, gameID, invitations[0].GameID)
+
+	assert.Equal(t, invitationID1, invitations[1].GetID())
+	assert.Equal(t, values.GameManagementRoleCollaborator, invitations[1].GetRole())
+	assert.Equal(t, values.GameRoleInvitationStatusPending, invitations[1].GetStatus())
+	assert.Equal(t, inviterID, invitations[1].GetInviterID())
+	assert.Equal(t, inviteeID, invitations[1].GetInviteeID())
+	assert.WithinDuration(t, now.Add(time.Hour), invitations[1].GetExpiresAt(), time.Second)
+}
+
+func getGameManagementRoleTypeMapForTest(t *testing.T, db *gorm.DB) map[string]int {
+	t.Helper()
+
+	var roleTypes []*schema.GameManagementRoleTypeTable
+	err := db.
+		Session(&gorm.Session{}).
+		Find(&roleTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get role type table: %+v\n", err)
+	}
+
+	roleTypeMap := make(map[string]int, len(roleTypes))
+	for _, roleType := range roleTypes {
+		roleTypeMap[roleType.Name] = roleType.ID
+	}
+
+	return roleTypeMap
+}
+
+func getGameVisibilityTypeIDForTest(t *testing.T, db *gorm.DB, visibility string) int {
+	t.Helper()
+
+	var gameVisibility schema.GameVisibilityTypeTable
+	err := db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: visibility}).
+		Find(&gameVisibility).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	return gameVisibility.ID
+}
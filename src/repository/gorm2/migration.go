@@ -0,0 +1,69 @@
+package gorm2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+var _ repository.Migration = &Migration{}
+
+type Migration struct {
+	db            *DB
+	conf          config.RepositoryGorm2
+	migrationConf config.Migration
+}
+
+func NewMigration(db *DB, conf config.RepositoryGorm2, migrationConf config.Migration) *Migration {
+	return &Migration{
+		db:            db,
+		conf:          conf,
+		migrationConf: migrationConf,
+	}
+}
+
+// atlasRevisionTable
+// atlasが適用済みのマイグレーションを記録するテーブル
+const atlasRevisionTable = "atlas_schema_revisions"
+
+func (m *Migration) GetMigrationVersions(ctx context.Context) (string, string, error) {
+	db, err := m.db.getDB(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get db: %w", err)
+	}
+
+	latest, err := schema.LatestMigrationVersion()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get latest migration version: %w", err)
+	}
+
+	// 途中で失敗したマイグレーションは適用済みとしない。
+	// また、.から始まるバージョンはatlasが内部の状態の記録に使うものなので除外する。
+	var current string
+	err = db.
+		Table(atlasRevisionTable).
+		Where("version NOT LIKE ?", ".%").
+		Where("applied = total").
+		Order("version DESC").
+		Limit(1).
+		Pluck("version", &current).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", "", fmt.Errorf("failed to get current migration version: %w", err)
+	}
+
+	return current, latest, nil
+}
+
+func (m *Migration) Migrate(ctx context.Context) error {
+	err := schema.Migrate(ctx, m.conf, m.migrationConf, m.db.db)
+	if err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package gorm2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMigrationVersions(t *testing.T) {
+	t.Parallel()
+
+	// マイグレーションの適用は行わないので、設定は不要
+	migrationRepository := NewMigration(testDB, nil, nil)
+
+	current, latest, err := migrationRepository.GetMigrationVersions(context.Background())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// テスト用のDBには起動時に全てのマイグレーションが適用されている
+	assert.NotEmpty(t, latest)
+	assert.Equal(t, latest, current)
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
 	"gorm.io/gorm"
@@ -54,7 +55,7 @@ func (productKey *ProductKey) SaveProductKeys(ctx context.Context, editionID val
 		case schema.ProductKeyStatusActive:
 			statusMap[values.LauncherUserStatusActive] = status.ID
 		default:
-			log.Printf("invalid product key status: %s\n", status.Name)
+			logger.Error(ctx, "invalid product key status", slog.String("product_key_status", status.Name))
 		}
 	}
 
@@ -166,7 +167,7 @@ func (productKey *ProductKey) GetProductKeys(ctx context.Context, editionID valu
 		case schema.ProductKeyStatusActive:
 			status = values.LauncherUserStatusActive
 		default:
-			log.Printf("invalid product key status: %s\n", dbProductKey.Status.Name)
+			logger.Error(ctx, "invalid product key status", slog.String("product_key_status", dbProductKey.Status.Name))
 			continue
 		}
 		keyValue := domain.NewProductKey(
@@ -212,7 +213,7 @@ func (productKey *ProductKey) GetProductKey(ctx context.Context, productKeyID va
 	case schema.ProductKeyStatusActive:
 		status = values.LauncherUserStatusActive
 	default:
-		log.Printf("invalid product key status: %s\n", dbProductKey.Status.Name)
+		logger.Error(ctx, "invalid product key status", slog.String("product_key_status", dbProductKey.Status.Name))
 	}
 
 	keyValue := domain.NewProductKey(
@@ -255,7 +256,7 @@ func (productKey *ProductKey) GetProductKeyByKey(ctx context.Context, productKey
 	case schema.ProductKeyStatusActive:
 		status = values.LauncherUserStatusActive
 	default:
-		log.Printf("invalid product key status: %s\n", dbProductKey.Status.Name)
+		logger.Error(ctx, "invalid product key status", slog.String("product_key_status", dbProductKey.Status.Name))
 	}
 	keyValue := domain.NewProductKey(
 		values.NewLauncherUserIDFromUUID(dbProductKey.ID),
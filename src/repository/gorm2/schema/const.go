@@ -23,10 +23,52 @@ const (
 	GameFileTypeMac     = "mac"
 )
 
+const (
+	GameFileScanStatusPending  = "pending"
+	GameFileScanStatusClean    = "clean"
+	GameFileScanStatusRejected = "rejected"
+	GameFileScanStatusFailed   = "failed"
+)
+
+const (
+	EditionBundleStatusPending   = "pending"
+	EditionBundleStatusBuilding  = "building"
+	EditionBundleStatusCompleted = "completed"
+	EditionBundleStatusFailed    = "failed"
+)
+
+const (
+	StorageVerificationStatusRunning   = "running"
+	StorageVerificationStatusCompleted = "completed"
+	StorageVerificationStatusFailed    = "failed"
+)
+
+const (
+	StorageVerificationObjectKindGameFile         = "game_file"
+	StorageVerificationObjectKindGameImage        = "game_image"
+	StorageVerificationObjectKindGameImageVariant = "game_image_variant"
+	StorageVerificationObjectKindGameVideo        = "game_video"
+	StorageVerificationObjectKindGameVideoPoster  = "game_video_poster"
+)
+
+const (
+	StorageVerificationIssueTypeMissing      = "missing"
+	StorageVerificationIssueTypeHashMismatch = "hash_mismatch"
+	StorageVerificationIssueTypeCheckFailed  = "check_failed"
+)
+
 const (
 	GameImageTypeJpeg = "jpeg"
 	GameImageTypePng  = "png"
 	GameImageTypeGif  = "gif"
+	GameImageTypeWebp = "webp"
+)
+
+const (
+	GameImageSizeOriginal = "original"
+	GameImageSizeSmall    = "small"
+	GameImageSizeMedium   = "medium"
+	GameImageSizeLarge    = "large"
 )
 
 const (
@@ -35,6 +77,21 @@ const (
 	GameVideoTypeM4v = "m4v"
 )
 
+const (
+	GameVideoCodecH264 = "h264"
+	GameVideoCodecVP8  = "vp8"
+	GameVideoCodecVP9  = "vp9"
+	GameVideoCodecAV1  = "av1"
+)
+
+const (
+	GameVideoAudioCodecAAC    = "aac"
+	GameVideoAudioCodecMP3    = "mp3"
+	GameVideoAudioCodecOpus   = "opus"
+	GameVideoAudioCodecVorbis = "vorbis"
+	GameVideoAudioCodecFLAC   = "flac"
+)
+
 const (
 	GameManagementRoleTypeAdministrator = "administrator"
 	GameManagementRoleTypeCollaborator  = "collaborator"
@@ -46,3 +103,38 @@ const (
 	GameFeedbackAnswerNo  GameFeedbackAnswerValue = 0
 	GameFeedbackAnswerYes GameFeedbackAnswerValue = 1
 )
+
+const (
+	AuditLogActionAddAdmin                  = "add_admin"
+	AuditLogActionDeleteAdmin               = "delete_admin"
+	AuditLogActionEditGameManagementRole    = "edit_game_management_role"
+	AuditLogActionRemoveGameManagementRole  = "remove_game_management_role"
+	AuditLogActionUpdateGame                = "update_game"
+	AuditLogActionUpdateEdition             = "update_edition"
+	AuditLogActionUpdateEditionGameVersions = "update_edition_game_versions"
+	AuditLogActionDeleteEdition             = "delete_edition"
+	AuditLogActionActivateProductKey        = "activate_product_key"
+	AuditLogActionRevokeProductKey          = "revoke_product_key"
+	AuditLogActionTransferGameOwnership     = "transfer_game_ownership"
+	AuditLogActionMergeGameGenres           = "merge_game_genres"
+	AuditLogActionUpdateGameGenreParent     = "update_game_genre_parent"
+	AuditLogActionAddGameGenreAlias         = "add_game_genre_alias"
+	AuditLogActionDeleteGameGenreAlias      = "delete_game_genre_alias"
+	AuditLogActionUpdateGameStorageQuota    = "update_game_storage_quota"
+	AuditLogActionDeleteGameStorageQuota    = "delete_game_storage_quota"
+	AuditLogActionUpdateGameFileScanStatus  = "update_game_file_scan_status"
+)
+
+const (
+	AuditLogTargetTypeUser       = "user"
+	AuditLogTargetTypeGame       = "game"
+	AuditLogTargetTypeEdition    = "edition"
+	AuditLogTargetTypeProductKey = "product_key"
+	AuditLogTargetTypeGameGenre  = "game_genre"
+)
+
+const (
+	GameRoleInvitationStatusPending  = "pending"
+	GameRoleInvitationStatusAccepted = "accepted"
+	GameRoleInvitationStatusDeclined = "declined"
+)
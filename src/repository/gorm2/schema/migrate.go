@@ -3,6 +3,9 @@ package schema
 import (
 	"context"
 	"fmt"
+	"io/fs"
+	"path"
+	"strings"
 
 	"ariga.io/atlas-go-sdk/atlasexec"
 	"github.com/traPtitech/trap-collection-server/migrations"
@@ -92,3 +95,28 @@ func Migrate(ctx context.Context, dbConf config.RepositoryGorm2, migrationConf c
 
 	return nil
 }
+
+// LatestMigrationVersion
+// アプリケーションに含まれるマイグレーションのうち、最新のバージョンを返す。
+// バージョンはファイル名の先頭のタイムスタンプ。
+func LatestMigrationVersion() (string, error) {
+	entries, err := fs.ReadDir(migrations.MigrationDir, ".")
+	if err != nil {
+		return "", fmt.Errorf("read migration dir: %w", err)
+	}
+
+	var latest string
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		// 説明の無いファイル名(20250327121655.sqlなど)もある
+		version, _, _ := strings.Cut(strings.TrimSuffix(entry.Name(), ".sql"), "_")
+		if version > latest {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
@@ -10,8 +10,8 @@ import (
 
 type GameTable2 struct {
 	ID                     uuid.UUID                 `gorm:"type:varchar(36);not null;primaryKey"`
-	Name                   string                    `gorm:"type:varchar(256);size:256;not null"`
-	Description            string                    `gorm:"type:text;not null"`
+	Name                   string                    `gorm:"type:varchar(256);size:256;not null;index:idx_games_name_description,class:FULLTEXT"`
+	Description            string                    `gorm:"type:text;not null;index:idx_games_name_description,class:FULLTEXT"`
 	VisibilityTypeID       int                       `gorm:"type:tinyint;not null"`
 	CreatedAt              time.Time                 `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
 	DeletedAt              gorm.DeletedAt            `gorm:"type:DATETIME NULL;default:NULL"`
@@ -76,37 +76,99 @@ func (*GameVersionTable2) TableName() string {
 }
 
 type GameFileTable2 struct {
-	ID           uuid.UUID         `gorm:"type:varchar(36);not null;primaryKey"`
-	GameID       uuid.UUID         `gorm:"type:varchar(36);not null"`
-	FileTypeID   int               `gorm:"type:tinyint;not null"`
-	Hash         string            `gorm:"type:char(32);size:32;not null"`
-	EntryPoint   string            `gorm:"type:text;not null"`
-	CreatedAt    time.Time         `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
-	GameFileType GameFileTypeTable `gorm:"foreignKey:FileTypeID"`
+	ID           uuid.UUID               `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID       uuid.UUID               `gorm:"type:varchar(36);not null"`
+	FileTypeID   int                     `gorm:"type:tinyint;not null"`
+	Hash         string                  `gorm:"type:char(32);size:32;not null"`
+	EntryPoint   string                  `gorm:"type:text;not null"`
+	Size         int64                   `gorm:"type:bigint;not null;default:0"` // 容量の記録の導入前に保存されたファイルは0
+	ScanStatusID int                     `gorm:"type:tinyint;not null;default:1"`
+	ScanAttempts int                     `gorm:"type:int;not null;default:0"` // 検査に失敗した回数。検査の状態が変わると0に戻る
+	CreatedAt    time.Time               `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	GameFileType GameFileTypeTable       `gorm:"foreignKey:FileTypeID"`
+	ScanStatus   GameFileScanStatusTable `gorm:"foreignKey:ScanStatusID"`
 }
 
 func (*GameFileTable2) TableName() string {
 	return "v2_game_files"
 }
 
+type GameFileScanStatusTable struct {
+	ID     int    `gorm:"type:TINYINT AUTO_INCREMENT;not null;primaryKey"`
+	Name   string `gorm:"type:varchar(32);size:32;not null;unique"`
+	Active bool   `gorm:"type:boolean;default:true"`
+}
+
+func (*GameFileScanStatusTable) TableName() string {
+	return "game_file_scan_statuses"
+}
+
+// GameFileBlobTable2
+// ハッシュ値をキーとしてストレージに保存されたゲームファイルの実体。
+// 重複排除の導入前にファイルIDをキーとして保存されたファイルは含まない。
+// ゲームファイルは削除されないので、参照数は記録せず、実体も削除しない。
+type GameFileBlobTable2 struct {
+	Hash      string    `gorm:"type:char(32);size:32;not null;primaryKey"`
+	CreatedAt time.Time `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*GameFileBlobTable2) TableName() string {
+	return "v2_game_file_blobs"
+}
+
 type GameImageTable2 struct {
-	ID            uuid.UUID          `gorm:"type:varchar(36);not null;primaryKey"`
-	GameID        uuid.UUID          `gorm:"type:varchar(36);not null"`
-	ImageTypeID   int                `gorm:"type:tinyint;not null"`
-	CreatedAt     time.Time          `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
-	GameImageType GameImageTypeTable `gorm:"foreignKey:ImageTypeID"`
+	ID          uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID      uuid.UUID `gorm:"type:varchar(36);not null"`
+	ImageTypeID int       `gorm:"type:tinyint;not null"`
+	Size        int64     `gorm:"type:bigint;not null;default:0"` // 容量の記録の導入前に保存された画像は0
+	// VariantsGenerated
+	// 派生画像の生成が済んだか。
+	// 小さい画像など、生成する派生画像が無い場合もtrueになる。
+	VariantsGenerated bool               `gorm:"type:boolean;not null;default:false"`
+	CreatedAt         time.Time          `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	GameImageType     GameImageTypeTable `gorm:"foreignKey:ImageTypeID"`
 }
 
 func (*GameImageTable2) TableName() string {
 	return "v2_game_images"
 }
 
-type GameVideoTable2 struct {
+type GameImageVariantTable2 struct {
 	ID            uuid.UUID          `gorm:"type:varchar(36);not null;primaryKey"`
-	GameID        uuid.UUID          `gorm:"type:varchar(36);not null"`
-	VideoTypeID   int                `gorm:"type:tinyint;not null"`
+	GameImageID   uuid.UUID          `gorm:"type:varchar(36);not null;uniqueIndex:idx_game_image_variant_unique"`
+	Size          string             `gorm:"type:varchar(16);not null;uniqueIndex:idx_game_image_variant_unique"`
+	ImageTypeID   int                `gorm:"type:tinyint;not null;uniqueIndex:idx_game_image_variant_unique"`
+	Width         int                `gorm:"type:int;not null"`
+	Height        int                `gorm:"type:int;not null"`
 	CreatedAt     time.Time          `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
-	GameVideoType GameVideoTypeTable `gorm:"foreignKey:VideoTypeID"`
+	GameImage     GameImageTable2    `gorm:"foreignKey:GameImageID"`
+	GameImageType GameImageTypeTable `gorm:"foreignKey:ImageTypeID"`
+}
+
+func (*GameImageVariantTable2) TableName() string {
+	return "v2_game_image_variants"
+}
+
+type GameVideoTable2 struct {
+	ID          uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID      uuid.UUID `gorm:"type:varchar(36);not null"`
+	VideoTypeID int       `gorm:"type:tinyint;not null"`
+	Size        int64     `gorm:"type:bigint;not null;default:0"` // 容量の記録の導入前に保存された動画は0
+	// DurationMs, Width, Height, VideoCodec, AudioCodec
+	// コンテナから取得したメタデータ。
+	// メタデータの抽出機能の追加前に保存された動画ではNULL。
+	// AudioCodecは音声トラックがない場合もNULL。
+	DurationMs sql.NullInt64  `gorm:"type:bigint;default:NULL"`
+	Width      sql.NullInt32  `gorm:"type:int;default:NULL"`
+	Height     sql.NullInt32  `gorm:"type:int;default:NULL"`
+	VideoCodec sql.NullString `gorm:"type:varchar(16);default:NULL"`
+	AudioCodec sql.NullString `gorm:"type:varchar(16);default:NULL"`
+	// PosterImageTypeID
+	// ポスター画像の形式。ポスター画像が設定されていない場合はNULL。
+	PosterImageTypeID sql.NullInt32       `gorm:"type:tinyint;default:NULL"`
+	CreatedAt         time.Time           `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	GameVideoType     GameVideoTypeTable  `gorm:"foreignKey:VideoTypeID"`
+	PosterImageType   *GameImageTypeTable `gorm:"foreignKey:PosterImageTypeID"`
 }
 
 func (*GameVideoTable2) TableName() string {
@@ -195,9 +257,14 @@ func (*SeatStatusTable) TableName() string {
 }
 
 type GameGenreTable struct {
-	ID        uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
-	Name      string    `gorm:"type:varchar(32);not null;unique"`
-	CreatedAt time.Time `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	ID   uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	Name string    `gorm:"type:varchar(32);not null;unique"`
+	// ParentID
+	// 親ジャンルのID。親ジャンルが無いときはNULL。
+	// 親ジャンルが削除されたときは、親ジャンル無しになる。
+	ParentID  uuid.NullUUID   `gorm:"type:varchar(36);default:NULL;index"`
+	CreatedAt time.Time       `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	Parent    *GameGenreTable `gorm:"foreignKey:ParentID;constraint:OnDelete:SET NULL"`
 	// 後方参照を使っているためポインタになっている。
 	// 参考: https://gorm.io/ja_JP/docs/many_to_many.html#%E5%BE%8C%E6%96%B9%E5%8F%82%E7%85%A7%EF%BC%88Back-Reference%EF%BC%89
 	Games []*GameTable2 `gorm:"many2many:game_genre_relations;joinForeignKey:GenreID;joinReferences:GameID"`
@@ -207,6 +274,18 @@ func (*GameGenreTable) TableName() string {
 	return "game_genres"
 }
 
+type GameGenreAliasTable struct {
+	ID        uuid.UUID      `gorm:"type:varchar(36);not null;primaryKey"`
+	GenreID   uuid.UUID      `gorm:"type:varchar(36);not null;index"`
+	Name      string         `gorm:"type:varchar(32);not null;unique"`
+	CreatedAt time.Time      `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	Genre     GameGenreTable `gorm:"foreignKey:GenreID;constraint:OnDelete:CASCADE"`
+}
+
+func (*GameGenreAliasTable) TableName() string {
+	return "game_genre_aliases"
+}
+
 type GameVisibilityTypeTable struct {
 	ID        int       `gorm:"type:tinyint;not null;primaryKey"`
 	Name      string    `gorm:"type:varchar(32);not null;unique"`
@@ -255,14 +334,19 @@ func (*GameCreatorJobTable) TableName() string {
 }
 
 type GameCreatorTable struct {
-	ID     uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
-	GameID uuid.UUID `gorm:"type:varchar(36);not null;uniqueIndex:idx_unique_game_id_user_id;index"` // GameIDとUserIDの組み合わせをuniqueにする
-	UserID uuid.UUID `gorm:"type:varchar(36);not null;uniqueIndex:idx_unique_game_id_user_id"`
+	ID     uuid.UUID     `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID uuid.UUID     `gorm:"type:varchar(36);not null;uniqueIndex:idx_unique_game_id_user_id;index"` // GameIDとUserIDの組み合わせをuniqueにする
+	UserID uuid.NullUUID `gorm:"type:varchar(36);default:NULL;uniqueIndex:idx_unique_game_id_user_id"`   // traP外部のクリエイターの場合はNULL
 	// UserName を含めることで正規化が崩れているが、
 	// Creatorは認証を通らないAPIからも取得されるため、traQのAPIからユーザー名を持ってくることができない。
 	// Creatorの作成は必ず認証を通るので、その際にtraQから情報を取得してUserNameに含める。
-	UserName  string    `gorm:"type:varchar(32);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	UserName sql.NullString `gorm:"type:varchar(32);default:NULL"`
+	// ExternalName, ExternalURL
+	// traP外部のクリエイターの場合のみ使う。
+	ExternalName sql.NullString `gorm:"type:varchar(64);default:NULL"`
+	ExternalURL  sql.NullString `gorm:"type:text;default:NULL"`
+	DisplayOrder int            `gorm:"type:int;not null;default:0"`
+	CreatedAt    time.Time      `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
 
 	Game              GameTable2                  `gorm:"foreignKey:GameID"`
 	CreatorJobs       []GameCreatorJobTable       `gorm:"many2many:game_creator_job_relations;joinForeignKey:GameCreatorID;joinReferences:JobID"`
@@ -273,6 +357,32 @@ func (*GameCreatorTable) TableName() string {
 	return "game_creators"
 }
 
+// GameCreatorJobRelationTable
+// GameCreatorTable.CreatorJobsの中間テーブル。
+// ジョブの表示順を持たせるために定義している。
+type GameCreatorJobRelationTable struct {
+	GameCreatorID uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	JobID         uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	DisplayOrder  int       `gorm:"type:int;not null;default:0"`
+}
+
+func (*GameCreatorJobRelationTable) TableName() string {
+	return "game_creator_job_relations"
+}
+
+// GameCreatorCustomJobRelationTable
+// GameCreatorTable.CustomCreatorJobsの中間テーブル。
+// ジョブの表示順を持たせるために定義している。
+type GameCreatorCustomJobRelationTable struct {
+	GameCreatorID uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	CustomJobID   uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	DisplayOrder  int       `gorm:"type:int;not null;default:0"`
+}
+
+func (*GameCreatorCustomJobRelationTable) TableName() string {
+	return "game_creator_custom_job_relations"
+}
+
 type GameCreatorCustomJobTable struct {
 	ID          uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
 	GameID      uuid.UUID `gorm:"type:varchar(36);not null;index"`
@@ -336,3 +446,136 @@ type GameFeedbackAnswerTable struct {
 func (*GameFeedbackAnswerTable) TableName() string {
 	return "game_feedback_answers"
 }
+
+type AuditLogTable struct {
+	ID      uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	ActorID uuid.UUID `gorm:"type:varchar(36);not null;index"`
+	// ActorName は操作時点でのtraQのユーザー名。
+	// 後からユーザー名が変わっても監査ログの内容が変わらないように、操作時に保存する。
+	ActorName  string         `gorm:"type:varchar(32);not null"`
+	Action     string         `gorm:"type:varchar(64);not null;index"`
+	TargetType string         `gorm:"type:varchar(32);not null;index:idx_audit_logs_target"`
+	TargetID   uuid.UUID      `gorm:"type:varchar(36);not null;index:idx_audit_logs_target"`
+	Before     sql.NullString `gorm:"type:text;default:NULL"` // 変更前の状態(JSON)
+	After      sql.NullString `gorm:"type:text;default:NULL"` // 変更後の状態(JSON)
+	CreatedAt  time.Time      `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP;index"`
+}
+
+func (*AuditLogTable) TableName() string {
+	return "audit_logs"
+}
+
+type GameRoleInvitationTable struct {
+	ID            uuid.UUID                   `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID        uuid.UUID                   `gorm:"type:varchar(36);not null;index"`
+	InviterID     uuid.UUID                   `gorm:"type:varchar(36);not null"`
+	InviteeID     uuid.UUID                   `gorm:"type:varchar(36);not null;index"`
+	RoleTypeID    int                         `gorm:"type:tinyint;not null"`
+	Status        string                      `gorm:"type:varchar(32);not null"`
+	CreatedAt     time.Time                   `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	ExpiresAt     time.Time                   `gorm:"type:datetime;not null"`
+	Game          GameTable2                  `gorm:"foreignKey:GameID"`
+	RoleTypeTable GameManagementRoleTypeTable `gorm:"foreignKey:RoleTypeID"`
+}
+
+func (*GameRoleInvitationTable) TableName() string {
+	return "game_role_invitations"
+}
+
+// GameStorageQuotaTable2
+// 管理者がゲームごとに設定したストレージの上限。
+// 設定されていないゲームにはデフォルトの上限が適用される。
+type GameStorageQuotaTable2 struct {
+	GameID    uuid.UUID  `gorm:"type:varchar(36);not null;primaryKey"`
+	Quota     int64      `gorm:"type:bigint;not null"` // バイト単位
+	CreatedAt time.Time  `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time  `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	Game      GameTable2 `gorm:"foreignKey:GameID"`
+}
+
+func (*GameStorageQuotaTable2) TableName() string {
+	return "v2_game_storage_quotas"
+}
+
+// EditionBundleTable
+// オフライン配布用に生成するエディションのバンドル。
+// バンドルの実体はストレージに保存する。
+type EditionBundleTable struct {
+	ID             uuid.UUID `gorm:"type:varchar(36);not null;primaryKey"`
+	EditionID      uuid.UUID `gorm:"type:varchar(36);not null"`
+	StatusID       int       `gorm:"type:tinyint;not null"`
+	TotalItems     int       `gorm:"type:int;not null;default:0"`
+	ProcessedItems int       `gorm:"type:int;not null;default:0"`
+	Size           int64     `gorm:"type:bigint;not null;default:0"` // バイト単位
+	CreatedAt      time.Time `gorm:"type:datetime;not null;default:CURRENT_TIMESTAMP"`
+	// Owner
+	// 生成中のバンドルを生成しているサーバーのID。
+	// 複数のサーバーが同じバンドルを生成しないよう、生成を始めるときに設定する。
+	Owner uuid.NullUUID `gorm:"type:varchar(36);default:NULL"`
+	// HeartbeatAt
+	// 生成中のサーバーが最後に生存を報告した日時。
+	// 更新が途絶えたバンドルは、生成中にサーバーが停止したものとして扱う。
+	HeartbeatAt sql.NullTime             `gorm:"type:datetime;default:NULL"`
+	Edition     EditionTable             `gorm:"foreignKey:EditionID"`
+	Status      EditionBundleStatusTable `gorm:"foreignKey:StatusID"`
+}
+
+func (*EditionBundleTable) TableName() string {
+	return "edition_bundles"
+}
+
+type EditionBundleStatusTable struct {
+	ID     int    `gorm:"type:TINYINT AUTO_INCREMENT;not null;primaryKey"`
+	Name   string `gorm:"type:varchar(32);size:32;not null;unique"`
+	Active bool   `gorm:"type:boolean;default:true"`
+}
+
+func (*EditionBundleStatusTable) TableName() string {
+	return "edition_bundle_statuses"
+}
+
+// StorageVerificationTable
+// DBに記録されているゲームファイル・画像・動画が、ストレージに存在するかの検証の結果。
+type StorageVerificationTable struct {
+	ID                  uuid.UUID                      `gorm:"type:varchar(36);not null;primaryKey"`
+	StatusID            int                            `gorm:"type:tinyint;not null"`
+	TotalObjects        int                            `gorm:"type:int;not null;default:0"`
+	CheckedObjects      int                            `gorm:"type:int;not null;default:0"`
+	MissingObjects      int                            `gorm:"type:int;not null;default:0"`
+	HashMismatchObjects int                            `gorm:"type:int;not null;default:0"`
+	CheckFailedObjects  int                            `gorm:"type:int;not null;default:0"`
+	StartedAt           time.Time                      `gorm:"type:datetime;not null;index"`
+	FinishedAt          sql.NullTime                   `gorm:"type:datetime;default:NULL"`
+	Status              StorageVerificationStatusTable `gorm:"foreignKey:StatusID"`
+}
+
+func (*StorageVerificationTable) TableName() string {
+	return "storage_verifications"
+}
+
+type StorageVerificationStatusTable struct {
+	ID     int    `gorm:"type:TINYINT AUTO_INCREMENT;not null;primaryKey"`
+	Name   string `gorm:"type:varchar(32);size:32;not null;unique"`
+	Active bool   `gorm:"type:boolean;default:true"`
+}
+
+func (*StorageVerificationStatusTable) TableName() string {
+	return "storage_verification_statuses"
+}
+
+// StorageVerificationIssueTable
+// ストレージの検証で問題が見つかったオブジェクト。
+// 検証したオブジェクトが後から削除されても結果を残せるよう、オブジェクトへの外部キーは張らない。
+type StorageVerificationIssueTable struct {
+	StorageVerificationID uuid.UUID                `gorm:"type:varchar(36);not null;primaryKey"`
+	ObjectKind            string                   `gorm:"type:varchar(32);not null;primaryKey"`
+	ObjectID              uuid.UUID                `gorm:"type:varchar(36);not null;primaryKey"`
+	GameID                uuid.UUID                `gorm:"type:varchar(36);not null"`
+	IssueType             string                   `gorm:"type:varchar(32);not null"`
+	Detail                string                   `gorm:"type:text;not null"`
+	StorageVerification   StorageVerificationTable `gorm:"foreignKey:StorageVerificationID"`
+}
+
+func (*StorageVerificationIssueTable) TableName() string {
+	return "storage_verification_issues"
+}
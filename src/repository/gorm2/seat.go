@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
 	"gorm.io/gorm"
@@ -148,7 +149,7 @@ func (s *Seat) GetActiveSeats(ctx context.Context, lockType repository.LockType)
 		case schema.SeatStatusInUse:
 			status = values.SeatStatusInUse
 		default:
-			log.Printf("invalid product key status: %s\n", dbSeat.SeatStatus.Name)
+			logger.Error(ctx, "invalid product key status", slog.String("product_key_status", dbSeat.SeatStatus.Name))
 			continue
 		}
 
@@ -193,7 +194,7 @@ func (s *Seat) GetSeats(ctx context.Context, lockType repository.LockType) ([]*d
 			status = values.SeatStatusInUse
 		default:
 			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
-			log.Printf("error: invalid seat status: %s\n", dbSeat.SeatStatus.Name)
+			logger.Error(ctx, "invalid seat status", slog.String("seat_status", dbSeat.SeatStatus.Name))
 			continue
 		}
 
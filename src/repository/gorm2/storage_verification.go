@@ -0,0 +1,336 @@
+package gorm2
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+var _ repository.StorageVerification = (*StorageVerification)(nil)
+
+type StorageVerification struct {
+	db *DB
+}
+
+func NewStorageVerification(db *DB) *StorageVerification {
+	return &StorageVerification{
+		db: db,
+	}
+}
+
+func (sv *StorageVerification) SaveStorageVerification(ctx context.Context, verification *domain.StorageVerification) error {
+	db, err := sv.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusID, err := sv.getStatusID(db, verification.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	finishedAt := sql.NullTime{}
+	if t, ok := verification.GetFinishedAt().Value(); ok {
+		finishedAt = sql.NullTime{Time: t, Valid: true}
+	}
+
+	err = db.Create(&schema.StorageVerificationTable{
+		ID:                  uuid.UUID(verification.GetID()),
+		StatusID:            statusID,
+		TotalObjects:        verification.GetTotalObjects(),
+		CheckedObjects:      verification.GetCheckedObjects(),
+		MissingObjects:      verification.GetIssueCount(values.StorageVerificationIssueTypeMissing),
+		HashMismatchObjects: verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch),
+		CheckFailedObjects:  verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed),
+		StartedAt:           verification.GetStartedAt(),
+		FinishedAt:          finishedAt,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to save storage verification: %w", err)
+	}
+
+	return nil
+}
+
+func (sv *StorageVerification) UpdateStorageVerification(ctx context.Context, verification *domain.StorageVerification) error {
+	db, err := sv.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	statusID, err := sv.getStatusID(db, verification.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	finishedAt := sql.NullTime{}
+	if t, ok := verification.GetFinishedAt().Value(); ok {
+		finishedAt = sql.NullTime{Time: t, Valid: true}
+	}
+
+	// 0の値も更新するため、mapで指定する
+	result := db.
+		Model(&schema.StorageVerificationTable{}).
+		Where("id = ?", uuid.UUID(verification.GetID())).
+		Updates(map[string]any{
+			"status_id":             statusID,
+			"total_objects":         verification.GetTotalObjects(),
+			"checked_objects":       verification.GetCheckedObjects(),
+			"missing_objects":       verification.GetIssueCount(values.StorageVerificationIssueTypeMissing),
+			"hash_mismatch_objects": verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch),
+			"check_failed_objects":  verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed),
+			"finished_at":           finishedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update storage verification: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (sv *StorageVerification) SaveStorageVerificationIssues(ctx context.Context, verificationID values.StorageVerificationID, issues []*domain.StorageVerificationIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	db, err := sv.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	issueTables := make([]schema.StorageVerificationIssueTable, 0, len(issues))
+	for _, issue := range issues {
+		objectKind, err := convertStorageVerificationObjectKind(issue.GetObjectKind())
+		if err != nil {
+			return err
+		}
+
+		issueType, err := convertStorageVerificationIssueType(issue.GetIssueType())
+		if err != nil {
+			return err
+		}
+
+		issueTables = append(issueTables, schema.StorageVerificationIssueTable{
+			StorageVerificationID: uuid.UUID(verificationID),
+			ObjectKind:            objectKind,
+			ObjectID:              issue.GetObjectID(),
+			GameID:                uuid.UUID(issue.GetGameID()),
+			IssueType:             issueType,
+			Detail:                issue.GetDetail(),
+		})
+	}
+
+	err = db.Create(&issueTables).Error
+	if err != nil {
+		return fmt.Errorf("failed to save storage verification issues: %w", err)
+	}
+
+	return nil
+}
+
+func (sv *StorageVerification) GetLatestStorageVerification(ctx context.Context) (*domain.StorageVerification, error) {
+	db, err := sv.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var verification schema.StorageVerificationTable
+	err = db.
+		Joins("Status").
+		Order("storage_verifications.started_at DESC").
+		Take(&verification).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage verification: %w", err)
+	}
+
+	status, err := parseStorageVerificationStatus(verification.Status.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resVerification := domain.NewStorageVerification(
+		values.NewStorageVerificationIDFromUUID(verification.ID),
+		status,
+		verification.StartedAt,
+	)
+	resVerification.SetProgress(verification.CheckedObjects, verification.TotalObjects)
+	resVerification.SetIssueCount(values.StorageVerificationIssueTypeMissing, verification.MissingObjects)
+	resVerification.SetIssueCount(values.StorageVerificationIssueTypeHashMismatch, verification.HashMismatchObjects)
+	resVerification.SetIssueCount(values.StorageVerificationIssueTypeCheckFailed, verification.CheckFailedObjects)
+	if verification.FinishedAt.Valid {
+		resVerification.Finish(status, verification.FinishedAt.Time)
+	}
+
+	return resVerification, nil
+}
+
+func (sv *StorageVerification) GetStorageVerificationIssues(ctx context.Context, verificationID values.StorageVerificationID, limit int) ([]*domain.StorageVerificationIssue, error) {
+	db, err := sv.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	if limit < 0 {
+		return nil, repository.ErrNegativeLimit
+	}
+
+	query := db.
+		Where("storage_verification_id = ?", uuid.UUID(verificationID)).
+		Order("object_kind").
+		Order("object_id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var issues []schema.StorageVerificationIssueTable
+	err = query.Find(&issues).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage verification issues: %w", err)
+	}
+
+	resIssues := make([]*domain.StorageVerificationIssue, 0, len(issues))
+	for _, issue := range issues {
+		objectKind, err := parseStorageVerificationObjectKind(issue.ObjectKind)
+		if err != nil {
+			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
+			slog.Error("failed to parse storage verification object kind", slog.Any("error", err))
+			continue
+		}
+
+		issueType, err := parseStorageVerificationIssueType(issue.IssueType)
+		if err != nil {
+			slog.Error("failed to parse storage verification issue type", slog.Any("error", err))
+			continue
+		}
+
+		resIssues = append(resIssues, domain.NewStorageVerificationIssue(
+			objectKind,
+			issue.ObjectID,
+			values.NewGameIDFromUUID(issue.GameID),
+			issueType,
+			issue.Detail,
+		))
+	}
+
+	return resIssues, nil
+}
+
+func (sv *StorageVerification) getStatusID(db *gorm.DB, status values.StorageVerificationStatus) (int, error) {
+	statusName, err := convertStorageVerificationStatus(status)
+	if err != nil {
+		return 0, err
+	}
+
+	var dbStatus schema.StorageVerificationStatusTable
+	err = db.
+		Where("name = ?", statusName).
+		Select("id").
+		Take(&dbStatus).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage verification status: %w", err)
+	}
+
+	return dbStatus.ID, nil
+}
+
+func convertStorageVerificationStatus(status values.StorageVerificationStatus) (string, error) {
+	switch status {
+	case values.StorageVerificationStatusRunning:
+		return schema.StorageVerificationStatusRunning, nil
+	case values.StorageVerificationStatusCompleted:
+		return schema.StorageVerificationStatusCompleted, nil
+	case values.StorageVerificationStatusFailed:
+		return schema.StorageVerificationStatusFailed, nil
+	}
+
+	return "", fmt.Errorf("invalid storage verification status: %d", status)
+}
+
+func parseStorageVerificationStatus(name string) (values.StorageVerificationStatus, error) {
+	switch name {
+	case schema.StorageVerificationStatusRunning:
+		return values.StorageVerificationStatusRunning, nil
+	case schema.StorageVerificationStatusCompleted:
+		return values.StorageVerificationStatusCompleted, nil
+	case schema.StorageVerificationStatusFailed:
+		return values.StorageVerificationStatusFailed, nil
+	}
+
+	return 0, fmt.Errorf("unknown storage verification status: %s", name)
+}
+
+func convertStorageVerificationObjectKind(kind values.StorageVerificationObjectKind) (string, error) {
+	switch kind {
+	case values.StorageVerificationObjectKindGameFile:
+		return schema.StorageVerificationObjectKindGameFile, nil
+	case values.StorageVerificationObjectKindGameImage:
+		return schema.StorageVerificationObjectKindGameImage, nil
+	case values.StorageVerificationObjectKindGameImageVariant:
+		return schema.StorageVerificationObjectKindGameImageVariant, nil
+	case values.StorageVerificationObjectKindGameVideo:
+		return schema.StorageVerificationObjectKindGameVideo, nil
+	case values.StorageVerificationObjectKindGameVideoPoster:
+		return schema.StorageVerificationObjectKindGameVideoPoster, nil
+	}
+
+	return "", fmt.Errorf("invalid storage verification object kind: %d", kind)
+}
+
+func parseStorageVerificationObjectKind(name string) (values.StorageVerificationObjectKind, error) {
+	switch name {
+	case schema.StorageVerificationObjectKindGameFile:
+		return values.StorageVerificationObjectKindGameFile, nil
+	case schema.StorageVerificationObjectKindGameImage:
+		return values.StorageVerificationObjectKindGameImage, nil
+	case schema.StorageVerificationObjectKindGameImageVariant:
+		return values.StorageVerificationObjectKindGameImageVariant, nil
+	case schema.StorageVerificationObjectKindGameVideo:
+		return values.StorageVerificationObjectKindGameVideo, nil
+	case schema.StorageVerificationObjectKindGameVideoPoster:
+		return values.StorageVerificationObjectKindGameVideoPoster, nil
+	}
+
+	return 0, fmt.Errorf("unknown storage verification object kind: %s", name)
+}
+
+func convertStorageVerificationIssueType(issueType values.StorageVerificationIssueType) (string, error) {
+	switch issueType {
+	case values.StorageVerificationIssueTypeMissing:
+		return schema.StorageVerificationIssueTypeMissing, nil
+	case values.StorageVerificationIssueTypeHashMismatch:
+		return schema.StorageVerificationIssueTypeHashMismatch, nil
+	case values.StorageVerificationIssueTypeCheckFailed:
+		return schema.StorageVerificationIssueTypeCheckFailed, nil
+	}
+
+	return "", fmt.Errorf("invalid storage verification issue type: %d", issueType)
+}
+
+func parseStorageVerificationIssueType(name string) (values.StorageVerificationIssueType, error) {
+	switch name {
+	case schema.StorageVerificationIssueTypeMissing:
+		return values.StorageVerificationIssueTypeMissing, nil
+	case schema.StorageVerificationIssueTypeHashMismatch:
+		return values.StorageVerificationIssueTypeHashMismatch, nil
+	case schema.StorageVerificationIssueTypeCheckFailed:
+		return values.StorageVerificationIssueTypeCheckFailed, nil
+	}
+
+	return 0, fmt.Errorf("unknown storage verification issue type: %s", name)
+}
@@ -0,0 +1,347 @@
+package gorm2
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+func getStorageVerificationStatusIDs(t *testing.T, db *gorm.DB) map[string]int {
+	t.Helper()
+
+	var statuses []*schema.StorageVerificationStatusTable
+	err := db.
+		Session(&gorm.Session{}).
+		Find(&statuses).Error
+	if err != nil {
+		t.Fatalf("failed to get storage verification statuses: %v\n", err)
+	}
+
+	statusMap := make(map[string]int, len(statuses))
+	for _, status := range statuses {
+		statusMap[status.Name] = status.ID
+	}
+
+	return statusMap
+}
+
+func TestSaveStorageVerification(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	storageVerificationRepository := NewStorageVerification(testDB)
+
+	statusMap := getStorageVerificationStatusIDs(t, db)
+
+	now := time.Now()
+
+	verification := domain.NewStorageVerification(
+		values.NewStorageVerificationID(),
+		values.StorageVerificationStatusRunning,
+		now,
+	)
+
+	err = storageVerificationRepository.SaveStorageVerification(ctx, verification)
+	assert.NoError(t, err)
+
+	var verificationTable schema.StorageVerificationTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where("id = ?", uuid.UUID(verification.GetID())).
+		Take(&verificationTable).Error
+	if err != nil {
+		t.Fatalf("failed to get storage verification: %v\n", err)
+	}
+
+	assert.Equal(t, statusMap[schema.StorageVerificationStatusRunning], verificationTable.StatusID)
+	assert.Zero(t, verificationTable.TotalObjects)
+	assert.Zero(t, verificationTable.CheckedObjects)
+	assert.WithinDuration(t, now, verificationTable.StartedAt, time.Second)
+	assert.False(t, verificationTable.FinishedAt.Valid)
+}
+
+func TestUpdateStorageVerification(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	storageVerificationRepository := NewStorageVerification(testDB)
+
+	statusMap := getStorageVerificationStatusIDs(t, db)
+
+	type test struct {
+		description        string
+		beforeVerification *schema.StorageVerificationTable
+		verification       *domain.StorageVerification
+		isErr              bool
+		err                error
+	}
+
+	now := time.Now()
+
+	verificationID := values.NewStorageVerificationID()
+	completedVerification := domain.NewStorageVerification(verificationID, values.StorageVerificationStatusRunning, now)
+	completedVerification.SetProgress(10, 10)
+	completedVerification.SetIssueCount(values.StorageVerificationIssueTypeMissing, 2)
+	completedVerification.SetIssueCount(values.StorageVerificationIssueTypeHashMismatch, 1)
+	completedVerification.Finish(values.StorageVerificationStatusCompleted, now.Add(time.Minute))
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			beforeVerification: &schema.StorageVerificationTable{
+				ID:        uuid.UUID(verificationID),
+				StatusID:  statusMap[schema.StorageVerificationStatusRunning],
+				StartedAt: now,
+			},
+			verification: completedVerification,
+		},
+		{
+			description: "存在しない検証なのでErrNoRecordUpdated",
+			verification: domain.NewStorageVerification(
+				values.NewStorageVerificationID(),
+				values.StorageVerificationStatusRunning,
+				now,
+			),
+			isErr: true,
+			err:   repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.beforeVerification != nil {
+				err := db.
+					Session(&gorm.Session{}).
+					Create(testCase.beforeVerification).Error
+				if err != nil {
+					t.Fatalf("failed to create storage verification: %v\n", err)
+				}
+			}
+
+			err := storageVerificationRepository.UpdateStorageVerification(ctx, testCase.verification)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var verificationTable schema.StorageVerificationTable
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.verification.GetID())).
+				Take(&verificationTable).Error
+			if err != nil {
+				t.Fatalf("failed to get storage verification: %v\n", err)
+			}
+
+			assert.Equal(t, statusMap[schema.StorageVerificationStatusCompleted], verificationTable.StatusID)
+			assert.Equal(t, 10, verificationTable.TotalObjects)
+			assert.Equal(t, 10, verificationTable.CheckedObjects)
+			assert.Equal(t, 2, verificationTable.MissingObjects)
+			assert.Equal(t, 1, verificationTable.HashMismatchObjects)
+			assert.Zero(t, verificationTable.CheckFailedObjects)
+			assert.True(t, verificationTable.FinishedAt.Valid)
+			assert.WithinDuration(t, now.Add(time.Minute), verificationTable.FinishedAt.Time, time.Second)
+		})
+	}
+}
+
+func TestSaveAndGetStorageVerificationIssues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	storageVerificationRepository := NewStorageVerification(testDB)
+
+	statusMap := getStorageVerificationStatusIDs(t, db)
+
+	verificationID := values.NewStorageVerificationID()
+	err = db.
+		Session(&gorm.Session{}).
+		Create(&schema.StorageVerificationTable{
+			ID:        uuid.UUID(verificationID),
+			StatusID:  statusMap[schema.StorageVerificationStatusRunning],
+			StartedAt: time.Now(),
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create storage verification: %v\n", err)
+	}
+
+	gameID := values.NewGameID()
+	fileIssue := domain.NewStorageVerificationIssue(
+		values.StorageVerificationObjectKindGameFile,
+		uuid.New(),
+		gameID,
+		values.StorageVerificationIssueTypeHashMismatch,
+		"expected aaaa, actual bbbb",
+	)
+	imageIssue := domain.NewStorageVerificationIssue(
+		values.StorageVerificationObjectKindGameImage,
+		uuid.New(),
+		gameID,
+		values.StorageVerificationIssueTypeMissing,
+		"",
+	)
+
+	err = storageVerificationRepository.SaveStorageVerificationIssues(ctx, verificationID, []*domain.StorageVerificationIssue{imageIssue, fileIssue})
+	assert.NoError(t, err)
+
+	err = storageVerificationRepository.SaveStorageVerificationIssues(ctx, verificationID, nil)
+	assert.NoError(t, err)
+
+	// 存在しない検証の結果には保存できない
+	err = storageVerificationRepository.SaveStorageVerificationIssues(ctx, values.NewStorageVerificationID(), []*domain.StorageVerificationIssue{fileIssue})
+	assert.Error(t, err)
+
+	type test struct {
+		description    string
+		verificationID values.StorageVerificationID
+		limit          int
+		expected       []*domain.StorageVerificationIssue
+		isErr          bool
+		err            error
+	}
+
+	testCases := []test{
+		{
+			description:    "全て取得できる",
+			verificationID: verificationID,
+			expected:       []*domain.StorageVerificationIssue{fileIssue, imageIssue},
+		},
+		{
+			description:    "limitを指定できる",
+			verificationID: verificationID,
+			limit:          1,
+			expected:       []*domain.StorageVerificationIssue{fileIssue},
+		},
+		{
+			description:    "問題が無いので空",
+			verificationID: values.NewStorageVerificationID(),
+			expected:       []*domain.StorageVerificationIssue{},
+		},
+		{
+			description:    "limitが負なのでErrNegativeLimit",
+			verificationID: verificationID,
+			limit:          -1,
+			isErr:          true,
+			err:            repository.ErrNegativeLimit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			issues, err := storageVerificationRepository.GetStorageVerificationIssues(ctx, testCase.verificationID, testCase.limit)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, testCase.expected, issues)
+		})
+	}
+}
+
+// TestGetLatestStorageVerification
+// 最新の検証の結果を取得するので、他のテストで検証の結果が保存されないよう並列に実行しない
+func TestGetLatestStorageVerification(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	storageVerificationRepository := NewStorageVerification(testDB)
+
+	statusMap := getStorageVerificationStatusIDs(t, db)
+
+	_, err = storageVerificationRepository.GetLatestStorageVerification(ctx)
+	assert.ErrorIs(t, err, repository.ErrRecordNotFound)
+
+	now := time.Now()
+	latestID := values.NewStorageVerificationID()
+	err = db.
+		Session(&gorm.Session{}).
+		Create([]*schema.StorageVerificationTable{
+			{
+				ID:        uuid.New(),
+				StatusID:  statusMap[schema.StorageVerificationStatusFailed],
+				StartedAt: now.Add(-time.Hour),
+			},
+			{
+				ID:                  uuid.UUID(latestID),
+				StatusID:            statusMap[schema.StorageVerificationStatusCompleted],
+				TotalObjects:        5,
+				CheckedObjects:      5,
+				MissingObjects:      1,
+				HashMismatchObjects: 2,
+				CheckFailedObjects:  3,
+				StartedAt:           now,
+				FinishedAt:          sql.NullTime{Time: now.Add(time.Minute), Valid: true},
+			},
+		}).Error
+	if err != nil {
+		t.Fatalf("failed to create storage verifications: %v\n", err)
+	}
+
+	verification, err := storageVerificationRepository.GetLatestStorageVerification(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, latestID, verification.GetID())
+	assert.Equal(t, values.StorageVerificationStatusCompleted, verification.GetStatus())
+	assert.Equal(t, 5, verification.GetTotalObjects())
+	assert.Equal(t, 5, verification.GetCheckedObjects())
+	assert.Equal(t, 1, verification.GetIssueCount(values.StorageVerificationIssueTypeMissing))
+	assert.Equal(t, 2, verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch))
+	assert.Equal(t, 3, verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed))
+	assert.WithinDuration(t, now, verification.GetStartedAt(), time.Second)
+	finishedAt, ok := verification.GetFinishedAt().Value()
+	assert.True(t, ok)
+	assert.WithinDuration(t, now.Add(time.Minute), finishedAt, time.Second)
+}
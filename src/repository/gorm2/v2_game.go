@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
@@ -163,7 +165,7 @@ func (g *GameV2) GetGame(ctx context.Context, gameID values.GameID, lockType rep
 
 func (g *GameV2) GetGames(
 	ctx context.Context, limit int, offset int, sort repository.GamesSortType,
-	visibilities []values.GameVisibility, userID *values.TraPMemberID, gameGenreIDs []values.GameGenreID, name string) ([]*domain.GameWithGenres, int, error) {
+	visibilities []values.GameVisibility, userID *values.TraPMemberID, gameGenreIDs []values.GameGenreID, name string, keyword string) ([]*domain.GameWithGenres, int, error) {
 	db, err := g.db.getDB(ctx)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get db: %w", err)
@@ -176,16 +178,212 @@ func (g *GameV2) GetGames(
 		return nil, 0, errors.New("bad limit and offset")
 	}
 
-	var orderBy string
+	tx, err := g.filterGames(db, visibilities, userID, gameGenreIDs, name, keyword)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to filter games: %w", err)
+	}
+
+	txSelect := tx.
+		Session(&gorm.Session{}).
+		Preload("GameGenres").
+		Preload("GameVisibilityType")
+
+	// 並び替えに必要なテーブルは、ゲーム数の取得には不要なのでここでJOINする。
 	switch sort {
 	case repository.GamesSortTypeCreatedAt:
-		orderBy = "games.created_at DESC"
+		txSelect = txSelect.
+			Order("games.created_at DESC")
 	case repository.GamesSortTypeLatestVersion:
-		orderBy = "v2_latest_game_version_times.latest_game_version_created_at DESC"
+		txSelect = txSelect.
+			Joins("LEFT JOIN v2_latest_game_version_times ON v2_latest_game_version_times.game_id = games.id").
+			Order("v2_latest_game_version_times.latest_game_version_created_at DESC")
+	case repository.GamesSortTypePlayCount:
+		// SELECT game_id, COUNT(*) AS play_count FROM game_play_logs
+		// WHERE deleted_at IS NULL GROUP BY game_id
+		playCountQuery := db.
+			Model(&schema.GamePlayLogTable{}).
+			Select("game_id, COUNT(*) AS play_count").
+			Group("game_id")
+
+		txSelect = txSelect.
+			Joins("LEFT JOIN (?) AS game_play_counts ON game_play_counts.game_id = games.id", playCountQuery).
+			Order("COALESCE(game_play_counts.play_count, 0) DESC").
+			Order("games.created_at DESC")
+	case repository.GamesSortTypeLatestFeedback:
+		// フィードバックはゲームバージョンに紐づくので、ゲームバージョン経由でゲームごとの最新のフィードバックの日時を求める。
+		//
+		// SELECT v2_game_versions.game_id, MAX(game_feedbacks.created_at) AS latest_feedback_created_at FROM game_feedbacks
+		// JOIN v2_game_versions ON v2_game_versions.id = game_feedbacks.game_version_id GROUP BY v2_game_versions.game_id
+		latestFeedbackQuery := db.
+			Table("game_feedbacks").
+			Joins("JOIN v2_game_versions ON v2_game_versions.id = game_feedbacks.game_version_id").
+			Select("v2_game_versions.game_id AS game_id, MAX(game_feedbacks.created_at) AS latest_feedback_created_at").
+			Group("v2_game_versions.game_id")
+
+		txSelect = txSelect.
+			Joins("LEFT JOIN (?) AS game_latest_feedback_times ON game_latest_feedback_times.game_id = games.id", latestFeedbackQuery).
+			Order("game_latest_feedback_times.latest_feedback_created_at DESC").
+			Order("games.created_at DESC")
 	default:
 		return nil, 0, fmt.Errorf("invalid sort type: %v", sort)
 	}
 
+	if limit > 0 {
+		txSelect = txSelect.Limit(limit).Offset(offset)
+	}
+
+	var games []schema.GameTable2
+	err = txSelect.Find(&games).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	gamesDomain := make([]*domain.GameWithGenres, 0, len(games))
+	for i := range games {
+		var visibility values.GameVisibility
+		switch games[i].GameVisibilityType.Name {
+		case schema.GameVisibilityTypePublic:
+			visibility = values.GameVisibilityTypePublic
+		case schema.GameVisibilityTypeLimited:
+			visibility = values.GameVisibilityTypeLimited
+		case schema.GameVisibilityTypePrivate:
+			visibility = values.GameVisibilityTypePrivate
+		default:
+			return nil, 0, fmt.Errorf("invalid game visibility: '%s'", games[i].GameVisibilityType.Name)
+		}
+
+		var gameGenresDomain []*domain.GameGenre
+		for j := range games[i].GameGenres {
+			gameGenresDomain = append(gameGenresDomain, domain.NewGameGenre(
+				values.GameGenreIDFromUUID(games[i].GameGenres[j].ID),
+				values.NewGameGenreName(games[i].GameGenres[j].Name),
+				games[i].GameGenres[j].CreatedAt,
+			))
+		}
+
+		gamesDomain = append(gamesDomain, domain.NewGameWithGenres(
+			domain.NewGame(values.GameID(games[i].ID), values.GameName(games[i].Name), values.GameDescription(games[i].Description), visibility, games[i].CreatedAt),
+			gameGenresDomain,
+		))
+	}
+
+	var gamesNumber int64
+	err = tx.Count(&gamesNumber).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get games number: %w", err)
+	}
+
+	return gamesDomain, int(gamesNumber), nil
+}
+
+func (g *GameV2) GetGameFacets(
+	ctx context.Context, visibilities []values.GameVisibility, userID *values.TraPMemberID,
+	gameGenreIDs []values.GameGenreID, name string, keyword string) (*domain.GameFacets, error) {
+	db, err := g.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	tx, err := g.filterGames(db, visibilities, userID, gameGenreIDs, name, keyword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter games: %w", err)
+	}
+
+	// ジャンルの絞り込みのサブクエリと区別するため、別名でJOINする。
+	var genreFacets []gameGenreInfo
+	err = tx.
+		Session(&gorm.Session{}).
+		Joins("JOIN game_genre_relations AS facet_ggr ON facet_ggr.game_id = games.id").
+		Joins("JOIN game_genres AS facet_genres ON facet_genres.id = facet_ggr.genre_id").
+		Select("facet_genres.id AS id, facet_genres.name AS name, facet_genres.created_at AS created_at, COUNT(DISTINCT games.id) AS num").
+		Group("facet_genres.id, facet_genres.name, facet_genres.created_at").
+		Order("num DESC, facet_genres.name").
+		Scan(&genreFacets).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre facets: %w", err)
+	}
+
+	genreFacetsDomain := make([]*domain.GameGenreFacet, 0, len(genreFacets))
+	for _, genreFacet := range genreFacets {
+		genreFacetsDomain = append(genreFacetsDomain, domain.NewGameGenreFacet(
+			domain.NewGameGenre(
+				values.GameGenreIDFromUUID(genreFacet.ID),
+				values.NewGameGenreName(genreFacet.Name),
+				genreFacet.CreatedAt,
+			),
+			genreFacet.Num,
+		))
+	}
+
+	var fileTypeFacets []gameFacetInfo
+	err = tx.
+		Session(&gorm.Session{}).
+		Joins("JOIN v2_game_files AS facet_files ON facet_files.game_id = games.id").
+		Joins("JOIN game_file_types AS facet_file_types ON facet_file_types.id = facet_files.file_type_id").
+		Select("facet_file_types.name AS name, COUNT(DISTINCT games.id) AS num").
+		Group("facet_file_types.name").
+		Scan(&fileTypeFacets).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file type facets: %w", err)
+	}
+
+	fileTypeFacetsDomain := make([]*domain.GameFileTypeFacet, 0, len(fileTypeFacets))
+	for _, fileTypeFacet := range fileTypeFacets {
+		var fileType values.GameFileType
+		switch fileTypeFacet.Name {
+		case schema.GameFileTypeJar:
+			fileType = values.GameFileTypeJar
+		case schema.GameFileTypeWindows:
+			fileType = values.GameFileTypeWindows
+		case schema.GameFileTypeMac:
+			fileType = values.GameFileTypeMac
+		default:
+			return nil, fmt.Errorf("invalid file type: %s", fileTypeFacet.Name)
+		}
+
+		fileTypeFacetsDomain = append(fileTypeFacetsDomain, domain.NewGameFileTypeFacet(fileType, fileTypeFacet.Num))
+	}
+
+	var visibilityFacets []gameFacetInfo
+	err = tx.
+		Session(&gorm.Session{}).
+		Select("game_visibility_types.name AS name, COUNT(DISTINCT games.id) AS num").
+		Group("game_visibility_types.name").
+		Scan(&visibilityFacets).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get visibility facets: %w", err)
+	}
+
+	visibilityFacetsDomain := make([]*domain.GameVisibilityFacet, 0, len(visibilityFacets))
+	for _, visibilityFacet := range visibilityFacets {
+		var visibility values.GameVisibility
+		switch visibilityFacet.Name {
+		case schema.GameVisibilityTypePublic:
+			visibility = values.GameVisibilityTypePublic
+		case schema.GameVisibilityTypeLimited:
+			visibility = values.GameVisibilityTypeLimited
+		case schema.GameVisibilityTypePrivate:
+			visibility = values.GameVisibilityTypePrivate
+		default:
+			return nil, fmt.Errorf("invalid game visibility: '%s'", visibilityFacet.Name)
+		}
+
+		visibilityFacetsDomain = append(visibilityFacetsDomain, domain.NewGameVisibilityFacet(visibility, visibilityFacet.Num))
+	}
+
+	return domain.NewGameFacets(genreFacetsDomain, fileTypeFacetsDomain, visibilityFacetsDomain), nil
+}
+
+type gameFacetInfo struct {
+	Name string
+	Num  int
+}
+
+// filterGames
+// GetGames、GetGameFacetsで共通の、ゲームの絞り込み条件をかけたクエリを作る。
+func (g *GameV2) filterGames(
+	db *gorm.DB, visibilities []values.GameVisibility, userID *values.TraPMemberID,
+	gameGenreIDs []values.GameGenreID, name string, keyword string) (*gorm.DB, error) {
 	// visibilityの指定が無い時は全てのvisibilityを取得する
 	if len(visibilities) == 0 {
 		visibilities = []values.GameVisibility{
@@ -205,17 +403,12 @@ func (g *GameV2) GetGames(
 		case values.GameVisibilityTypePrivate:
 			visibilityNames[i] = schema.GameVisibilityTypePrivate
 		default:
-			return nil, 0, fmt.Errorf("invalid game visibility args: %v", visibilities[i])
+			return nil, fmt.Errorf("invalid game visibility args: %v", visibilities[i])
 		}
 	}
 
-	var games []schema.GameTable2
-
 	tx := db.
 		Model(&schema.GameTable2{}).
-		Preload("GameGenres").
-		Preload("GameVisibilityType").
-		Joins("LEFT JOIN v2_latest_game_version_times ON v2_latest_game_version_times.game_id = games.id").
 		Joins("JOIN game_visibility_types ON game_visibility_types.id = games.visibility_type_id").
 		Where("game_visibility_types.name IN ?", visibilityNames)
 
@@ -226,80 +419,110 @@ func (g *GameV2) GetGames(
 	}
 
 	if len(gameGenreIDs) > 0 {
-		gameGenreUUIDs := make([]uuid.UUID, 0, len(gameGenreIDs))
-		for _, gameGenreID := range gameGenreIDs {
-			gameGenreUUIDs = append(gameGenreUUIDs, uuid.UUID(gameGenreID))
+		descendantGenreIDs, err := g.getDescendantGameGenreIDs(db, gameGenreIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get descendant game genres: %w", err)
 		}
 
-		// サブクエリをJOINする。
-		// 指定されたゲームジャンル全てを持っている必要があるので、
-		// INで少なくとも一つは指定されたジャンルを持つものに絞ったうえで、COUNTでジャンルの数を数えて、
-		// その数が指定されたジャンルの数と一致するものを取得する。
+		// 指定されたゲームジャンル全てを持っている必要があるが、
+		// 親ジャンルの指定は子ジャンルも含むので、ジャンルごとに自身か子孫のジャンルを1つ以上持つゲームに絞る。
 		//
-		// SELECT game_id FROM game_genre_relations WHERE genre_id IN (ジャンルのid全部)
-		// GROUP BY game_id HAVING COUNT(DISTINCT genre_id) = ジャンルの数
-		subQuery := db.
-			Table("game_genre_relations").
-			Where("genre_id IN ?", gameGenreUUIDs).
-			Group("game_id").
-			Having("COUNT(DISTINCT genre_id) = ?", len(gameGenreUUIDs)).
-			Select("game_id")
-
-		tx = tx.
-			Joins("JOIN (?) AS sub ON sub.game_id = games.id", subQuery)
+		// EXISTS (SELECT 1 FROM game_genre_relations
+		// WHERE game_genre_relations.game_id = games.id AND game_genre_relations.genre_id IN (ジャンルと子孫のid全部))
+		for _, gameGenreID := range gameGenreIDs {
+			genreUUIDs := make([]uuid.UUID, 0, len(descendantGenreIDs[gameGenreID]))
+			for _, genreID := range descendantGenreIDs[gameGenreID] {
+				genreUUIDs = append(genreUUIDs, uuid.UUID(genreID))
+			}
+
+			tx = tx.Where(
+				"EXISTS (SELECT 1 FROM game_genre_relations WHERE game_genre_relations.game_id = games.id AND game_genre_relations.genre_id IN ?)",
+				genreUUIDs,
+			)
+		}
 	}
 
 	if name != "" {
 		tx = tx.Where("games.name LIKE ?", "%"+name+"%")
 	}
 
-	txSelect := tx.
-		Order(orderBy)
+	if keyword != "" {
+		// SELECT game_id FROM game_creators WHERE user_name LIKE '%keyword%' OR external_name LIKE '%keyword%'
+		creatorQuery := db.
+			Table("game_creators").
+			Where("user_name LIKE ? OR external_name LIKE ?", "%"+keyword+"%", "%"+keyword+"%").
+			Select("game_id")
 
-	if limit > 0 {
-		txSelect = txSelect.Session(&gorm.Session{}).Limit(limit).Offset(offset)
-	}
-	err = txSelect.Find(&games).Error
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get games: %w", err)
+		// MariaDBにはngramパーサーが無く、FULLTEXTインデックスは空白区切りの単語でしか一致しないため、
+		// 空白で区切られない日本語の部分一致はLIKEで補う。
+		keywordCondition := db.
+			Where("MATCH(games.name, games.description) AGAINST(? IN BOOLEAN MODE)", fullTextBooleanQuery(keyword)).
+			Or("games.name LIKE ?", "%"+keyword+"%").
+			Or("games.description LIKE ?", "%"+keyword+"%").
+			Or("games.id IN (?)", creatorQuery)
+
+		tx = tx.Where(keywordCondition)
 	}
 
-	gamesDomain := make([]*domain.GameWithGenres, 0, len(games))
-	for i := range games {
-		var visibility values.GameVisibility
-		switch games[i].GameVisibilityType.Name {
-		case schema.GameVisibilityTypePublic:
-			visibility = values.GameVisibilityTypePublic
-		case schema.GameVisibilityTypeLimited:
-			visibility = values.GameVisibilityTypeLimited
-		case schema.GameVisibilityTypePrivate:
-			visibility = values.GameVisibilityTypePrivate
-		default:
-			return nil, 0, fmt.Errorf("invalid game visibility: '%s'", games[i].GameVisibilityType.Name)
-		}
+	return tx, nil
+}
 
-		var gameGenresDomain []*domain.GameGenre
-		for j := range games[i].GameGenres {
-			gameGenresDomain = append(gameGenresDomain, domain.NewGameGenre(
-				values.GameGenreIDFromUUID(games[i].GameGenres[j].ID),
-				values.NewGameGenreName(games[i].GameGenres[j].Name),
-				games[i].GameGenres[j].CreatedAt,
-			))
+// getDescendantGameGenreIDs
+// 指定されたゲームジャンルごとに、そのジャンル自身と子孫のジャンルのIDを返す。
+func (g *GameV2) getDescendantGameGenreIDs(db *gorm.DB, gameGenreIDs []values.GameGenreID) (map[values.GameGenreID][]values.GameGenreID, error) {
+	var childGenres []schema.GameGenreTable
+	err := db.
+		Model(&schema.GameGenreTable{}).
+		Select("id", "parent_id").
+		Where("parent_id IS NOT NULL").
+		Find(&childGenres).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child game genres: %w", err)
+	}
+
+	childrenMap := make(map[values.GameGenreID][]values.GameGenreID, len(childGenres))
+	for _, childGenre := range childGenres {
+		parentID := values.GameGenreIDFromUUID(childGenre.ParentID.UUID)
+		childrenMap[parentID] = append(childrenMap[parentID], values.GameGenreIDFromUUID(childGenre.ID))
+	}
+
+	descendantGenreIDs := make(map[values.GameGenreID][]values.GameGenreID, len(gameGenreIDs))
+	for _, gameGenreID := range gameGenreIDs {
+		// 循環していても止まるように、訪問済みのジャンルは飛ばす
+		visited := map[values.GameGenreID]struct{}{gameGenreID: {}}
+		queue := []values.GameGenreID{gameGenreID}
+		for len(queue) > 0 {
+			genreID := queue[0]
+			queue = queue[1:]
+			descendantGenreIDs[gameGenreID] = append(descendantGenreIDs[gameGenreID], genreID)
+
+			for _, childID := range childrenMap[genreID] {
+				if _, ok := visited[childID]; ok {
+					continue
+				}
+				visited[childID] = struct{}{}
+				queue = append(queue, childID)
+			}
 		}
-
-		gamesDomain = append(gamesDomain, domain.NewGameWithGenres(
-			domain.NewGame(values.GameID(games[i].ID), values.GameName(games[i].Name), values.GameDescription(games[i].Description), visibility, games[i].CreatedAt),
-			gameGenresDomain,
-		))
 	}
 
-	var gamesNumber int64
-	err = tx.Count(&gamesNumber).Error
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get games number: %w", err)
+	return descendantGenreIDs, nil
+}
+
+// fullTextBooleanQuery
+// 検索キーワードを、全ての単語に前方一致するBOOLEAN MODEの全文検索クエリに変換する。
+// 利用者の入力に含まれる演算子は取り除く。
+func fullTextBooleanQuery(keyword string) string {
+	words := strings.FieldsFunc(keyword, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(`+-<>()~*"@`, r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, "+"+word+"*")
 	}
 
-	return gamesDomain, int(gamesNumber), nil
+	return strings.Join(terms, " ")
 }
 
 func (g *GameV2) GetGamesByIDs(ctx context.Context, gameIDs []values.GameID, lockType repository.LockType) ([]*domain.Game, error) {
@@ -5,14 +5,16 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/google/uuid"
-	"github.com/labstack/gommon/log"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GameFileV2 struct {
@@ -53,14 +55,30 @@ func (gameFile *GameFileV2) SaveGameFile(ctx context.Context, gameID values.Game
 	}
 	fileTypeID := fileType.ID
 
+	scanStatusName, err := convertGameFileScanStatus(file.GetScanStatus())
+	if err != nil {
+		return err
+	}
+
+	var scanStatus schema.GameFileScanStatusTable
+	err = db.
+		Where("name = ?", scanStatusName).
+		Select("id").
+		Take(&scanStatus).Error
+	if err != nil {
+		return fmt.Errorf("failed to get scan status: %w", err)
+	}
+
 	err = db.
 		Create(&schema.GameFileTable2{
-			ID:         uuid.UUID(file.GetID()),
-			GameID:     uuid.UUID(gameID),
-			EntryPoint: string(file.GetEntryPoint()),
-			Hash:       file.GetHash().String(),
-			FileTypeID: fileTypeID,
-			CreatedAt:  file.GetCreatedAt(),
+			ID:           uuid.UUID(file.GetID()),
+			GameID:       uuid.UUID(gameID),
+			EntryPoint:   string(file.GetEntryPoint()),
+			Hash:         file.GetHash().String(),
+			FileTypeID:   fileTypeID,
+			Size:         int64(file.GetSize()),
+			ScanStatusID: scanStatus.ID,
+			CreatedAt:    file.GetCreatedAt(),
 		}).Error
 	if err != nil {
 		return fmt.Errorf("failed to create game file: %w", err)
@@ -83,6 +101,7 @@ func (gameFile *GameFileV2) GetGameFile(ctx context.Context, gameFileID values.G
 	var file schema.GameFileTable2
 	err = db.
 		Joins("GameFileType").
+		Joins("ScanStatus").
 		Where("v2_game_files.id = ?", uuid.UUID(gameFileID)).
 		Take(&file).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -104,20 +123,29 @@ func (gameFile *GameFileV2) GetGameFile(ctx context.Context, gameFileID values.G
 		return nil, fmt.Errorf("invalid file type: %s", file.GameFileType.Name)
 	}
 
+	scanStatus, err := parseGameFileScanStatus(file.ScanStatus.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	byteHash, err := hex.DecodeString(file.Hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode string to hash: %w", err)
 	}
 
+	resFile := domain.NewGameFile(
+		values.NewGameFileIDFromUUID(file.ID),
+		fileType,
+		values.GameFileEntryPoint(file.EntryPoint),
+		values.NewGameFileHashFromBytes(byteHash),
+		file.CreatedAt,
+	)
+	resFile.SetSize(values.GameStorageSize(file.Size))
+	resFile.SetScanStatus(scanStatus)
+
 	return &repository.GameFileInfo{
-		GameFile: domain.NewGameFile(
-			values.NewGameFileIDFromUUID(file.ID),
-			fileType,
-			values.GameFileEntryPoint(file.EntryPoint),
-			values.NewGameFileHashFromBytes(byteHash),
-			file.CreatedAt,
-		),
-		GameID: values.NewGameIDFromUUID(file.GameID),
+		GameFile: resFile,
+		GameID:   values.NewGameIDFromUUID(file.GameID),
 	}, nil
 }
 
@@ -135,6 +163,7 @@ func (gameFile *GameFileV2) GetGameFiles(ctx context.Context, gameID values.Game
 	var files []schema.GameFileTable2
 	err = db.
 		Joins("GameFileType").
+		Joins("ScanStatus").
 		Where("game_id = ?", uuid.UUID(gameID)).
 		Order("created_at DESC").
 		Find(&files).Error
@@ -154,7 +183,13 @@ func (gameFile *GameFileV2) GetGameFiles(ctx context.Context, gameID values.Game
 			fileType = values.GameFileTypeMac
 		default:
 			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
-			log.Printf("error: unknown game file type: %s\n", file.GameFileType.Name)
+			logger.Error(ctx, "unknown game file type", slog.String("game_file_type", file.GameFileType.Name))
+			continue
+		}
+
+		scanStatus, err := parseGameFileScanStatus(file.ScanStatus.Name)
+		if err != nil {
+			logger.Error(ctx, "failed to parse game file scan status", slog.Any("error", err))
 			continue
 		}
 
@@ -163,13 +198,17 @@ func (gameFile *GameFileV2) GetGameFiles(ctx context.Context, gameID values.Game
 			return nil, fmt.Errorf("failed to decode string to hash: %w", err)
 		}
 
-		gameFiles = append(gameFiles, domain.NewGameFile(
+		resFile := domain.NewGameFile(
 			values.NewGameFileIDFromUUID(file.ID),
 			fileType,
 			values.GameFileEntryPoint(file.EntryPoint),
 			values.GameFileHash(byteHash),
 			file.CreatedAt,
-		))
+		)
+		resFile.SetSize(values.GameStorageSize(file.Size))
+		resFile.SetScanStatus(scanStatus)
+
+		gameFiles = append(gameFiles, resFile)
 	}
 
 	return gameFiles, nil
@@ -198,6 +237,7 @@ func (gameFile *GameFileV2) GetGameFilesWithoutTypes(ctx context.Context, fileID
 	var gameFiles []*schema.GameFileTable2
 	err = db.
 		Joins("GameFileType").
+		Joins("ScanStatus").
 		Where("v2_game_files.id IN ?", uuidFileIDs).
 		Order("created_at DESC").
 		Find(&gameFiles).Error
@@ -217,7 +257,13 @@ func (gameFile *GameFileV2) GetGameFilesWithoutTypes(ctx context.Context, fileID
 			fileType = values.GameFileTypeJar
 		default:
 			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
-			log.Printf("error: unknown game file type: %s\n", gameFile.GameFileType.Name)
+			logger.Error(ctx, "unknown game file type", slog.String("game_file_type", gameFile.GameFileType.Name))
+			continue
+		}
+
+		scanStatus, err := parseGameFileScanStatus(gameFile.ScanStatus.Name)
+		if err != nil {
+			logger.Error(ctx, "failed to parse game file scan status", slog.Any("error", err))
 			continue
 		}
 
@@ -226,17 +272,203 @@ func (gameFile *GameFileV2) GetGameFilesWithoutTypes(ctx context.Context, fileID
 			return nil, fmt.Errorf("failed to decode hash: %w", err)
 		}
 
+		file := domain.NewGameFile(
+			values.NewGameFileIDFromUUID(gameFile.ID),
+			fileType,
+			values.NewGameFileEntryPoint(gameFile.EntryPoint),
+			values.NewGameFileHashFromBytes(bytesHash),
+			gameFile.CreatedAt,
+		)
+		file.SetSize(values.GameStorageSize(gameFile.Size))
+		file.SetScanStatus(scanStatus)
+
 		gameFileInfos = append(gameFileInfos, &repository.GameFileInfo{
-			GameFile: domain.NewGameFile(
-				values.NewGameFileIDFromUUID(gameFile.ID),
-				fileType,
-				values.NewGameFileEntryPoint(gameFile.EntryPoint),
-				values.NewGameFileHashFromBytes(bytesHash),
-				gameFile.CreatedAt,
-			),
-			GameID: values.NewGameIDFromUUID(gameFile.GameID),
+			GameFile: file,
+			GameID:   values.NewGameIDFromUUID(gameFile.GameID),
 		})
 	}
 
 	return gameFileInfos, nil
 }
+
+func (gameFile *GameFileV2) SaveGameFileBlob(ctx context.Context, hash values.GameFileHash) (bool, error) {
+	db, err := gameFile.db.getDB(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	// 既に記録されている場合もON DUPLICATE KEY UPDATEで行ロックを取り、
+	// 同時に保存しようとしているトランザクションの終了を待つ
+	result := db.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&schema.GameFileBlobTable2{
+			Hash: hash.String(),
+		})
+	err = result.Error
+	if err != nil {
+		return false, fmt.Errorf("failed to save game file blob: %w", err)
+	}
+
+	// 挿入したときは1、既に記録されていて値が変わらなかったときは0がRowsAffectedになる
+	return result.RowsAffected == 1, nil
+}
+
+func (gameFile *GameFileV2) GetGameFilesByScanStatus(ctx context.Context, scanStatus values.GameFileScanStatus) ([]*repository.GameFileInfo, error) {
+	db, err := gameFile.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	scanStatusName, err := convertGameFileScanStatus(scanStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []schema.GameFileTable2
+	err = db.
+		Joins("GameFileType").
+		Joins("ScanStatus").
+		Where("ScanStatus.name = ?", scanStatusName).
+		Order("v2_game_files.created_at").
+		Find(&files).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game files: %w", err)
+	}
+
+	gameFileInfos := make([]*repository.GameFileInfo, 0, len(files))
+	for _, file := range files {
+		var fileType values.GameFileType
+		switch file.GameFileType.Name {
+		case schema.GameFileTypeJar:
+			fileType = values.GameFileTypeJar
+		case schema.GameFileTypeWindows:
+			fileType = values.GameFileTypeWindows
+		case schema.GameFileTypeMac:
+			fileType = values.GameFileTypeMac
+		default:
+			// 1つ不正な値が格納されるだけで機能停止すると困るので、エラーを返さずにログを出力する
+			logger.Error(ctx, "unknown game file type", slog.String("game_file_type", file.GameFileType.Name))
+			continue
+		}
+
+		byteHash, err := hex.DecodeString(file.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode string to hash: %w", err)
+		}
+
+		resFile := domain.NewGameFile(
+			values.NewGameFileIDFromUUID(file.ID),
+			fileType,
+			values.GameFileEntryPoint(file.EntryPoint),
+			values.NewGameFileHashFromBytes(byteHash),
+			file.CreatedAt,
+		)
+		resFile.SetSize(values.GameStorageSize(file.Size))
+		resFile.SetScanStatus(scanStatus)
+
+		gameFileInfos = append(gameFileInfos, &repository.GameFileInfo{
+			GameFile: resFile,
+			GameID:   values.NewGameIDFromUUID(file.GameID),
+		})
+	}
+
+	return gameFileInfos, nil
+}
+
+func (gameFile *GameFileV2) UpdateGameFileScanStatus(ctx context.Context, gameFileID values.GameFileID, scanStatus values.GameFileScanStatus) error {
+	db, err := gameFile.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	scanStatusName, err := convertGameFileScanStatus(scanStatus)
+	if err != nil {
+		return err
+	}
+
+	var dbScanStatus schema.GameFileScanStatusTable
+	err = db.
+		Where("name = ?", scanStatusName).
+		Select("id").
+		Take(&dbScanStatus).Error
+	if err != nil {
+		return fmt.Errorf("failed to get scan status: %w", err)
+	}
+
+	result := db.
+		Model(&schema.GameFileTable2{}).
+		Where("id = ?", uuid.UUID(gameFileID)).
+		Updates(map[string]any{
+			"scan_status_id": dbScanStatus.ID,
+			"scan_attempts":  0,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update game file scan status: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
+func (gameFile *GameFileV2) IncrementGameFileScanAttempts(ctx context.Context, gameFileID values.GameFileID) (int, error) {
+	db, err := gameFile.db.getDB(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	result := db.
+		Model(&schema.GameFileTable2{}).
+		Where("id = ?", uuid.UUID(gameFileID)).
+		Update("scan_attempts", gorm.Expr("scan_attempts + 1"))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to increment game file scan attempts: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, repository.ErrNoRecordUpdated
+	}
+
+	var file schema.GameFileTable2
+	err = db.
+		Where("id = ?", uuid.UUID(gameFileID)).
+		Select("scan_attempts").
+		Take(&file).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get game file scan attempts: %w", err)
+	}
+
+	return file.ScanAttempts, nil
+}
+
+func convertGameFileScanStatus(scanStatus values.GameFileScanStatus) (string, error) {
+	switch scanStatus {
+	case values.GameFileScanStatusPending:
+		return schema.GameFileScanStatusPending, nil
+	case values.GameFileScanStatusClean:
+		return schema.GameFileScanStatusClean, nil
+	case values.GameFileScanStatusRejected:
+		return schema.GameFileScanStatusRejected, nil
+	case values.GameFileScanStatusFailed:
+		return schema.GameFileScanStatusFailed, nil
+	}
+
+	return "", fmt.Errorf("invalid scan status: %d", scanStatus)
+}
+
+func parseGameFileScanStatus(name string) (values.GameFileScanStatus, error) {
+	switch name {
+	case schema.GameFileScanStatusPending:
+		return values.GameFileScanStatusPending, nil
+	case schema.GameFileScanStatusClean:
+		return values.GameFileScanStatusClean, nil
+	case schema.GameFileScanStatusRejected:
+		return values.GameFileScanStatusRejected, nil
+	case schema.GameFileScanStatusFailed:
+		return values.GameFileScanStatusFailed, nil
+	}
+
+	return 0, fmt.Errorf("invalid scan status: %s", name)
+}
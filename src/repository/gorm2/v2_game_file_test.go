@@ -601,6 +601,7 @@ func TestSaveGameFileV2(t *testing.T) {
 				assert.Equal(t, expectFile.FileTypeID, actualFile.FileTypeID)
 				assert.Equal(t, expectFile.EntryPoint, actualFile.EntryPoint)
 				assert.Equal(t, expectFile.Hash, actualFile.Hash)
+				assert.Equal(t, expectFile.Size, actualFile.Size)
 				assert.WithinDuration(t, expectFile.CreatedAt, actualFile.CreatedAt, 2*time.Second)
 			}
 		})
@@ -1129,3 +1130,542 @@ func TestGetGameFilesV2(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveGameFileBlob(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameFileRepository := NewGameFileV2(testDB)
+
+	type test struct {
+		description   string
+		hash          values.GameFileHash
+		beforeBlobs   []schema.GameFileBlobTable2
+		expectCreated bool
+	}
+
+	newHash := func() values.GameFileHash {
+		id := uuid.New()
+		return values.NewGameFileHashFromBytes(id[:])
+	}
+
+	hash1 := newHash()
+	hash2 := newHash()
+	hash3 := newHash()
+	hash4 := newHash()
+
+	testCases := []test{
+		{
+			description:   "実体が存在しないので作成される",
+			hash:          hash1,
+			expectCreated: true,
+		},
+		{
+			description: "実体が存在するので作成されない",
+			hash:        hash2,
+			beforeBlobs: []schema.GameFileBlobTable2{
+				{Hash: hash2.String(), CreatedAt: time.Now()},
+			},
+		},
+		{
+			description: "他の実体があっても作成される",
+			hash:        hash3,
+			beforeBlobs: []schema.GameFileBlobTable2{
+				{Hash: hash4.String(), CreatedAt: time.Now()},
+			},
+			expectCreated: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeBlobs) != 0 {
+				err := db.Create(&testCase.beforeBlobs).Error
+				if err != nil {
+					t.Fatalf("failed to create blobs: %+v\n", err)
+				}
+			}
+
+			created, err := gameFileRepository.SaveGameFileBlob(ctx, testCase.hash)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectCreated, created)
+
+			var count int64
+			err = db.
+				Session(&gorm.Session{}).
+				Model(&schema.GameFileBlobTable2{}).
+				Where("hash = ?", testCase.hash.String()).
+				Count(&count).Error
+			if err != nil {
+				t.Fatalf("failed to count blobs: %+v\n", err)
+			}
+			assert.Equal(t, int64(1), count)
+		})
+	}
+}
+
+func TestGetGameFilesByScanStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameFileRepository := NewGameFileV2(testDB)
+
+	var fileTypes []*schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&fileTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get file types: %v\n", err)
+	}
+
+	fileTypeMap := make(map[string]int, len(fileTypes))
+	for _, fileType := range fileTypes {
+		fileTypeMap[fileType.Name] = fileType.ID
+	}
+
+	var scanStatuses []*schema.GameFileScanStatusTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&scanStatuses).Error
+	if err != nil {
+		t.Fatalf("failed to get scan statuses: %v\n", err)
+	}
+
+	scanStatusMap := make(map[string]int, len(scanStatuses))
+	for _, scanStatus := range scanStatuses {
+		scanStatusMap[scanStatus.Name] = scanStatus.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	gameID := values.NewGameID()
+	pendingFileID := values.NewGameFileID()
+	cleanFileID := values.NewGameFileID()
+	rejectedFileID1 := values.NewGameFileID()
+	rejectedFileID2 := values.NewGameFileID()
+
+	now := time.Now()
+
+	err = db.Create(&schema.GameTable2{
+		ID:          uuid.UUID(gameID),
+		Name:        "test",
+		Description: "test",
+		CreatedAt:   now,
+		GameFiles: []schema.GameFileTable2{
+			{
+				ID:           uuid.UUID(pendingFileID),
+				GameID:       uuid.UUID(gameID),
+				FileTypeID:   fileTypeMap[schema.GameFileTypeJar],
+				Hash:         "68617368",
+				EntryPoint:   "/path/to/game.jar",
+				ScanStatusID: scanStatusMap[schema.GameFileScanStatusPending],
+				CreatedAt:    now,
+			},
+			{
+				ID:           uuid.UUID(cleanFileID),
+				GameID:       uuid.UUID(gameID),
+				FileTypeID:   fileTypeMap[schema.GameFileTypeJar],
+				Hash:         "68617368",
+				EntryPoint:   "/path/to/game.jar",
+				ScanStatusID: scanStatusMap[schema.GameFileScanStatusClean],
+				CreatedAt:    now,
+			},
+			{
+				ID:           uuid.UUID(rejectedFileID1),
+				GameID:       uuid.UUID(gameID),
+				FileTypeID:   fileTypeMap[schema.GameFileTypeWindows],
+				Hash:         "68617368",
+				EntryPoint:   "/path/to/game.exe",
+				Size:         100,
+				ScanStatusID: scanStatusMap[schema.GameFileScanStatusRejected],
+				CreatedAt:    now.Add(-time.Hour),
+			},
+			{
+				ID:           uuid.UUID(rejectedFileID2),
+				GameID:       uuid.UUID(gameID),
+				FileTypeID:   fileTypeMap[schema.GameFileTypeMac],
+				Hash:         "68617368",
+				EntryPoint:   "/path/to/game.app",
+				ScanStatusID: scanStatusMap[schema.GameFileScanStatusRejected],
+				CreatedAt:    now,
+			},
+		},
+		VisibilityTypeID: gameVisibilityPublic.ID,
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game: %+v\n", err)
+	}
+
+	files, err := gameFileRepository.GetGameFilesByScanStatus(ctx, values.GameFileScanStatusRejected)
+	assert.NoError(t, err)
+
+	// 他のテストで作成されたファイルも含まれるので、このテストで作成したファイルのみを確認する
+	actualFiles := make([]*repository.GameFileInfo, 0, 2)
+	for _, file := range files {
+		assert.Equal(t, values.GameFileScanStatusRejected, file.GetScanStatus())
+
+		if file.GameID == gameID {
+			actualFiles = append(actualFiles, file)
+		}
+	}
+
+	if assert.Len(t, actualFiles, 2) {
+		assert.Equal(t, rejectedFileID1, actualFiles[0].GetID())
+		assert.Equal(t, values.GameFileTypeWindows, actualFiles[0].GetFileType())
+		assert.Equal(t, values.NewGameFileEntryPoint("/path/to/game.exe"), actualFiles[0].GetEntryPoint())
+		assert.Equal(t, values.NewGameFileHashFromBytes([]byte("hash")), actualFiles[0].GetHash())
+		assert.Equal(t, values.GameStorageSize(100), actualFiles[0].GetSize())
+		assert.WithinDuration(t, now.Add(-time.Hour), actualFiles[0].GetCreatedAt(), time.Second)
+
+		assert.Equal(t, rejectedFileID2, actualFiles[1].GetID())
+		assert.Equal(t, values.GameFileTypeMac, actualFiles[1].GetFileType())
+	}
+}
+
+func TestUpdateGameFileScanStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameFileRepository := NewGameFileV2(testDB)
+
+	var fileTypes []*schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&fileTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get file types: %v\n", err)
+	}
+
+	fileTypeMap := make(map[string]int, len(fileTypes))
+	for _, fileType := range fileTypes {
+		fileTypeMap[fileType.Name] = fileType.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	type test struct {
+		description  string
+		fileID       values.GameFileID
+		beforeFiles  []schema.GameFileTable2
+		scanStatus   values.GameFileScanStatus
+		expectStatus string
+		isErr        bool
+		err          error
+	}
+
+	fileID1 := values.NewGameFileID()
+	fileID2 := values.NewGameFileID()
+	fileID3 := values.NewGameFileID()
+	fileID4 := values.NewGameFileID()
+	fileID5 := values.NewGameFileID()
+	fileID6 := values.NewGameFileID()
+
+	now := time.Now()
+
+	newFile := func(fileID values.GameFileID) schema.GameFileTable2 {
+		return schema.GameFileTable2{
+			ID:         uuid.UUID(fileID),
+			FileTypeID: fileTypeMap[schema.GameFileTypeJar],
+			Hash:       "68617368",
+			EntryPoint: "/path/to/game.jar",
+			CreatedAt:  now,
+		}
+	}
+
+	fileWithAttempts := newFile(fileID6)
+	fileWithAttempts.ScanAttempts = 3
+
+	testCases := []test{
+		{
+			description:  "検査を通過したのでclean",
+			fileID:       fileID1,
+			beforeFiles:  []schema.GameFileTable2{newFile(fileID1)},
+			scanStatus:   values.GameFileScanStatusClean,
+			expectStatus: schema.GameFileScanStatusClean,
+		},
+		{
+			description:  "検査で問題が見つかったのでrejected",
+			fileID:       fileID2,
+			beforeFiles:  []schema.GameFileTable2{newFile(fileID2)},
+			scanStatus:   values.GameFileScanStatusRejected,
+			expectStatus: schema.GameFileScanStatusRejected,
+		},
+		{
+			description: "他のファイルは変更されない",
+			fileID:      fileID3,
+			beforeFiles: []schema.GameFileTable2{
+				newFile(fileID3),
+				newFile(fileID4),
+			},
+			scanStatus:   values.GameFileScanStatusClean,
+			expectStatus: schema.GameFileScanStatusClean,
+		},
+		{
+			description:  "検査に失敗し続けたのでfailed",
+			fileID:       fileID5,
+			beforeFiles:  []schema.GameFileTable2{newFile(fileID5)},
+			scanStatus:   values.GameFileScanStatusFailed,
+			expectStatus: schema.GameFileScanStatusFailed,
+		},
+		{
+			description:  "検査の失敗回数はリセットされる",
+			fileID:       fileID6,
+			beforeFiles:  []schema.GameFileTable2{fileWithAttempts},
+			scanStatus:   values.GameFileScanStatusClean,
+			expectStatus: schema.GameFileScanStatusClean,
+		},
+		{
+			description: "ファイルが存在しないのでErrNoRecordUpdated",
+			fileID:      values.NewGameFileID(),
+			scanStatus:  values.GameFileScanStatusClean,
+			isErr:       true,
+			err:         repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "不正な状態なのでエラー",
+			fileID:      values.NewGameFileID(),
+			scanStatus:  100,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeFiles) != 0 {
+				gameID := uuid.New()
+				for i := range testCase.beforeFiles {
+					testCase.beforeFiles[i].GameID = gameID
+				}
+
+				err := db.Create(&schema.GameTable2{
+					ID:               gameID,
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					GameFiles:        testCase.beforeFiles,
+					VisibilityTypeID: gameVisibilityPublic.ID,
+				}).Error
+				if err != nil {
+					t.Fatalf("failed to create game: %+v\n", err)
+				}
+			}
+
+			err := gameFileRepository.UpdateGameFileScanStatus(ctx, testCase.fileID, testCase.scanStatus)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			var files []schema.GameFileTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Joins("ScanStatus").
+				Where("v2_game_files.game_id = ?", testCase.beforeFiles[0].GameID).
+				Find(&files).Error
+			if err != nil {
+				t.Fatalf("failed to get game files: %+v\n", err)
+			}
+
+			for _, file := range files {
+				if file.ID == uuid.UUID(testCase.fileID) {
+					assert.Equal(t, testCase.expectStatus, file.ScanStatus.Name)
+					assert.Equal(t, 0, file.ScanAttempts)
+				} else {
+					assert.Equal(t, schema.GameFileScanStatusPending, file.ScanStatus.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestIncrementGameFileScanAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameFileRepository := NewGameFileV2(testDB)
+
+	var fileTypes []*schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&fileTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get file types: %v\n", err)
+	}
+
+	fileTypeMap := make(map[string]int, len(fileTypes))
+	for _, fileType := range fileTypes {
+		fileTypeMap[fileType.Name] = fileType.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	type test struct {
+		description    string
+		fileID         values.GameFileID
+		beforeFiles    []schema.GameFileTable2
+		expectAttempts int
+		isErr          bool
+		err            error
+	}
+
+	fileID1 := values.NewGameFileID()
+	fileID2 := values.NewGameFileID()
+	fileID3 := values.NewGameFileID()
+	fileID4 := values.NewGameFileID()
+
+	now := time.Now()
+
+	newFile := func(fileID values.GameFileID, attempts int) schema.GameFileTable2 {
+		return schema.GameFileTable2{
+			ID:           uuid.UUID(fileID),
+			FileTypeID:   fileTypeMap[schema.GameFileTypeJar],
+			Hash:         "68617368",
+			EntryPoint:   "/path/to/game.jar",
+			CreatedAt:    now,
+			ScanAttempts: attempts,
+		}
+	}
+
+	testCases := []test{
+		{
+			description:    "初めての失敗なので1",
+			fileID:         fileID1,
+			beforeFiles:    []schema.GameFileTable2{newFile(fileID1, 0)},
+			expectAttempts: 1,
+		},
+		{
+			description:    "既に失敗しているので増える",
+			fileID:         fileID2,
+			beforeFiles:    []schema.GameFileTable2{newFile(fileID2, 4)},
+			expectAttempts: 5,
+		},
+		{
+			description: "他のファイルは変更されない",
+			fileID:      fileID3,
+			beforeFiles: []schema.GameFileTable2{
+				newFile(fileID3, 0),
+				newFile(fileID4, 2),
+			},
+			expectAttempts: 1,
+		},
+		{
+			description: "ファイルが存在しないのでErrNoRecordUpdated",
+			fileID:      values.NewGameFileID(),
+			isErr:       true,
+			err:         repository.ErrNoRecordUpdated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			beforeAttempts := make(map[uuid.UUID]int, len(testCase.beforeFiles))
+			if len(testCase.beforeFiles) != 0 {
+				gameID := uuid.New()
+				for i := range testCase.beforeFiles {
+					testCase.beforeFiles[i].GameID = gameID
+					beforeAttempts[testCase.beforeFiles[i].ID] = testCase.beforeFiles[i].ScanAttempts
+				}
+
+				err := db.Create(&schema.GameTable2{
+					ID:               gameID,
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					GameFiles:        testCase.beforeFiles,
+					VisibilityTypeID: gameVisibilityPublic.ID,
+				}).Error
+				if err != nil {
+					t.Fatalf("failed to create game: %+v\n", err)
+				}
+			}
+
+			attempts, err := gameFileRepository.IncrementGameFileScanAttempts(ctx, testCase.fileID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, testCase.expectAttempts, attempts)
+
+			var files []schema.GameFileTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Where("game_id = ?", testCase.beforeFiles[0].GameID).
+				Find(&files).Error
+			if err != nil {
+				t.Fatalf("failed to get game files: %+v\n", err)
+			}
+
+			for _, file := range files {
+				if file.ID == uuid.UUID(testCase.fileID) {
+					assert.Equal(t, testCase.expectAttempts, file.ScanAttempts)
+				} else {
+					assert.Equal(t, beforeAttempts[file.ID], file.ScanAttempts)
+				}
+			}
+		})
+	}
+}
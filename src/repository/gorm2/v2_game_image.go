@@ -1,10 +1,13 @@
 package gorm2
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
@@ -56,6 +59,7 @@ func (gameImage *GameImageV2) SaveGameImage(ctx context.Context, gameID values.G
 			ID:          uuid.UUID(image.GetID()),
 			GameID:      uuid.UUID(gameID),
 			ImageTypeID: imageTypeID,
+			Size:        int64(image.GetSize()),
 			CreatedAt:   image.GetCreatedAt(),
 		}).Error
 	if err != nil {
@@ -100,13 +104,16 @@ func (gameImage *GameImageV2) GetGameImage(ctx context.Context, gameImageID valu
 		return nil, fmt.Errorf("invalid image type: %s", image.GameImageType.Name)
 	}
 
+	resImage := domain.NewGameImage(
+		values.GameImageIDFromUUID(image.ID),
+		imageType,
+		image.CreatedAt,
+	)
+	resImage.SetSize(values.GameStorageSize(image.Size))
+
 	return &repository.GameImageInfo{
-		GameImage: domain.NewGameImage(
-			values.GameImageIDFromUUID(image.ID),
-			imageType,
-			image.CreatedAt,
-		),
-		GameID: values.NewGameIDFromUUID(image.GameID),
+		GameImage: resImage,
+		GameID:    values.NewGameIDFromUUID(image.GameID),
 	}, nil
 }
 
@@ -145,12 +152,250 @@ func (gameImage *GameImageV2) GetGameImages(ctx context.Context, gameID values.G
 			return nil, fmt.Errorf("invalid image type: %s", image.GameImageType.Name)
 		}
 
-		gameImages = append(gameImages, domain.NewGameImage(
+		resImage := domain.NewGameImage(
 			values.GameImageIDFromUUID(image.ID),
 			imageType,
 			image.CreatedAt,
-		))
+		)
+		resImage.SetSize(values.GameStorageSize(image.Size))
+
+		gameImages = append(gameImages, resImage)
 	}
 
 	return gameImages, nil
 }
+
+func (gameImage *GameImageV2) GetGameImagesWithPendingVariants(ctx context.Context) ([]*repository.GameImageInfo, error) {
+	db, err := gameImage.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var images []schema.GameImageTable2
+	err = db.
+		Joins("GameImageType").
+		Where("v2_game_images.variants_generated = ?", false).
+		Order("v2_game_images.created_at").
+		Find(&images).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game images: %w", err)
+	}
+
+	gameImages := make([]*repository.GameImageInfo, 0, len(images))
+	for _, image := range images {
+		imageType, err := parseGameImageType(image.GameImageType.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		resImage := domain.NewGameImage(
+			values.GameImageIDFromUUID(image.ID),
+			imageType,
+			image.CreatedAt,
+		)
+		resImage.SetSize(values.GameStorageSize(image.Size))
+
+		gameImages = append(gameImages, &repository.GameImageInfo{
+			GameImage: resImage,
+			GameID:    values.NewGameIDFromUUID(image.GameID),
+		})
+	}
+
+	return gameImages, nil
+}
+
+func (gameImage *GameImageV2) SaveGameImageVariants(ctx context.Context, gameImageID values.GameImageID, variants []*domain.GameImageVariant) error {
+	db, err := gameImage.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	if len(variants) != 0 {
+		err = gameImage.createGameImageVariants(db, gameImageID, variants)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 既に生成済みの場合は更新される行が無いので、RowsAffectedは確認しない
+	err = db.
+		Model(&schema.GameImageTable2{}).
+		Where("id = ?", uuid.UUID(gameImageID)).
+		Update("variants_generated", true).Error
+	if err != nil {
+		return fmt.Errorf("failed to update variants generated: %w", err)
+	}
+
+	return nil
+}
+
+func (gameImage *GameImageV2) createGameImageVariants(db *gorm.DB, gameImageID values.GameImageID, variants []*domain.GameImageVariant) error {
+	var imageTypes []schema.GameImageTypeTable
+	err := db.
+		Select("id", "name").
+		Find(&imageTypes).Error
+	if err != nil {
+		return fmt.Errorf("failed to get image types: %w", err)
+	}
+
+	imageTypeIDMap := make(map[string]int, len(imageTypes))
+	for _, imageType := range imageTypes {
+		imageTypeIDMap[imageType.Name] = imageType.ID
+	}
+
+	variantTables := make([]schema.GameImageVariantTable2, 0, len(variants))
+	for _, variant := range variants {
+		imageTypeName, err := convertGameImageType(variant.GetType())
+		if err != nil {
+			return err
+		}
+
+		imageTypeID, ok := imageTypeIDMap[imageTypeName]
+		if !ok {
+			return fmt.Errorf("image type not found: %s", imageTypeName)
+		}
+
+		sizeName, err := convertGameImageSize(variant.GetSize())
+		if err != nil {
+			return err
+		}
+
+		variantTables = append(variantTables, schema.GameImageVariantTable2{
+			ID:          uuid.UUID(variant.GetID()),
+			GameImageID: uuid.UUID(gameImageID),
+			Size:        sizeName,
+			ImageTypeID: imageTypeID,
+			Width:       variant.GetWidth(),
+			Height:      variant.GetHeight(),
+			CreatedAt:   variant.GetCreatedAt(),
+		})
+	}
+
+	err = db.Create(&variantTables).Error
+	if mysqlErr, ok := errors.AsType[*mysql.MySQLError](err); ok {
+		switch mysqlErr.Number {
+		case 1062:
+			return repository.ErrDuplicatedUniqueKey
+		case 1452:
+			return repository.ErrForeignKeyViolated
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create game image variants: %w", err)
+	}
+
+	return nil
+}
+
+func (gameImage *GameImageV2) GetGameImageVariants(ctx context.Context, gameImageID values.GameImageID, lockType repository.LockType) ([]*domain.GameImageVariant, error) {
+	db, err := gameImage.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	db, err = gameImage.db.setLock(db, lockType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set lock: %w", err)
+	}
+
+	var variantTables []schema.GameImageVariantTable2
+	err = db.
+		Joins("GameImageType").
+		Where("game_image_id = ?", uuid.UUID(gameImageID)).
+		Find(&variantTables).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game image variants: %w", err)
+	}
+
+	variants := make([]*domain.GameImageVariant, 0, len(variantTables))
+	for _, variantTable := range variantTables {
+		imageType, err := parseGameImageType(variantTable.GameImageType.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := parseGameImageSize(variantTable.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, domain.NewGameImageVariant(
+			values.GameImageVariantIDFromUUID(variantTable.ID),
+			size,
+			imageType,
+			variantTable.Width,
+			variantTable.Height,
+			variantTable.CreatedAt,
+		))
+	}
+
+	slices.SortFunc(variants, func(a, b *domain.GameImageVariant) int {
+		if a.GetSize() != b.GetSize() {
+			return cmp.Compare(a.GetSize(), b.GetSize())
+		}
+
+		return cmp.Compare(a.GetType(), b.GetType())
+	})
+
+	return variants, nil
+}
+
+func convertGameImageType(imageType values.GameImageType) (string, error) {
+	switch imageType {
+	case values.GameImageTypeJpeg:
+		return schema.GameImageTypeJpeg, nil
+	case values.GameImageTypePng:
+		return schema.GameImageTypePng, nil
+	case values.GameImageTypeGif:
+		return schema.GameImageTypeGif, nil
+	case values.GameImageTypeWebp:
+		return schema.GameImageTypeWebp, nil
+	}
+
+	return "", fmt.Errorf("invalid image type: %d", imageType)
+}
+
+func parseGameImageType(name string) (values.GameImageType, error) {
+	switch name {
+	case schema.GameImageTypeJpeg:
+		return values.GameImageTypeJpeg, nil
+	case schema.GameImageTypePng:
+		return values.GameImageTypePng, nil
+	case schema.GameImageTypeGif:
+		return values.GameImageTypeGif, nil
+	case schema.GameImageTypeWebp:
+		return values.GameImageTypeWebp, nil
+	}
+
+	return 0, fmt.Errorf("invalid image type: %s", name)
+}
+
+func convertGameImageSize(size values.GameImageSize) (string, error) {
+	switch size {
+	case values.GameImageSizeOriginal:
+		return schema.GameImageSizeOriginal, nil
+	case values.GameImageSizeSmall:
+		return schema.GameImageSizeSmall, nil
+	case values.GameImageSizeMedium:
+		return schema.GameImageSizeMedium, nil
+	case values.GameImageSizeLarge:
+		return schema.GameImageSizeLarge, nil
+	}
+
+	return "", fmt.Errorf("invalid image size: %d", size)
+}
+
+func parseGameImageSize(name string) (values.GameImageSize, error) {
+	switch name {
+	case schema.GameImageSizeOriginal:
+		return values.GameImageSizeOriginal, nil
+	case schema.GameImageSizeSmall:
+		return values.GameImageSizeSmall, nil
+	case schema.GameImageSizeMedium:
+		return values.GameImageSizeMedium, nil
+	case schema.GameImageSizeLarge:
+		return values.GameImageSizeLarge, nil
+	}
+
+	return 0, fmt.Errorf("invalid image size: %s", name)
+}
@@ -729,3 +729,502 @@ func TestGetGameImages(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGameImagesWithPendingVariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameImageRepository := NewGameImageV2(testDB)
+
+	var imageTypes []*schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&imageTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get role type table: %+v\n", err)
+	}
+
+	imageTypeMap := make(map[string]int, len(imageTypes))
+	for _, imageType := range imageTypes {
+		imageTypeMap[imageType.Name] = imageType.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	now := time.Now()
+
+	gameID := values.NewGameID()
+	imageID1 := values.NewGameImageID()
+	imageID2 := values.NewGameImageID()
+
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		CreatedAt:        now,
+		VisibilityTypeID: gameVisibilityPublic.ID,
+		GameImage2s: []schema.GameImageTable2{
+			{
+				ID:          uuid.UUID(imageID1),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+			{
+				// 生成する派生画像が無かった画像
+				ID:                uuid.UUID(imageID2),
+				GameID:            uuid.UUID(gameID),
+				ImageTypeID:       imageTypeMap[schema.GameImageTypePng],
+				VariantsGenerated: true,
+				CreatedAt:         now,
+			},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game table: %+v\n", err)
+	}
+
+	images, err := gameImageRepository.GetGameImagesWithPendingVariants(ctx)
+	assert.NoError(t, err)
+
+	imageMap := make(map[values.GameImageID]*repository.GameImageInfo, len(images))
+	for _, image := range images {
+		imageMap[image.GetID()] = image
+	}
+
+	// 他のテストで作成された画像も含まれるため、このテストで作成した画像のみ確認する
+	image1, ok := imageMap[imageID1]
+	if assert.True(t, ok) {
+		assert.Equal(t, gameID, image1.GameID)
+		assert.Equal(t, values.GameImageTypeJpeg, image1.GetType())
+		assert.WithinDuration(t, now, image1.GetCreatedAt(), 2*time.Second)
+	}
+
+	_, ok = imageMap[imageID2]
+	assert.False(t, ok)
+}
+
+func TestSaveGameImageVariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameImageRepository := NewGameImageV2(testDB)
+
+	var imageTypes []*schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&imageTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get role type table: %+v\n", err)
+	}
+
+	imageTypeMap := make(map[string]int, len(imageTypes))
+	for _, imageType := range imageTypes {
+		imageTypeMap[imageType.Name] = imageType.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	now := time.Now()
+
+	gameID := values.NewGameID()
+	imageID1 := values.NewGameImageID()
+	imageID2 := values.NewGameImageID()
+	imageID3 := values.NewGameImageID()
+	imageID4 := values.NewGameImageID()
+
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		CreatedAt:        now,
+		VisibilityTypeID: gameVisibilityPublic.ID,
+		GameImage2s: []schema.GameImageTable2{
+			{
+				ID:          uuid.UUID(imageID1),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+			{
+				ID:          uuid.UUID(imageID2),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+			{
+				ID:          uuid.UUID(imageID3),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game table: %+v\n", err)
+	}
+
+	type test struct {
+		description    string
+		imageID        values.GameImageID
+		beforeVariants []schema.GameImageVariantTable2
+		variants       []*domain.GameImageVariant
+		expectVariants []schema.GameImageVariantTable2
+		// 画像が存在する場合のみ確認する
+		expectVariantsGenerated bool
+		isErr                   bool
+		err                     error
+	}
+
+	variantID1 := values.NewGameImageVariantID()
+	variantID2 := values.NewGameImageVariantID()
+	variantID3 := values.NewGameImageVariantID()
+	variantID4 := values.NewGameImageVariantID()
+	variantID5 := values.NewGameImageVariantID()
+
+	testCases := []test{
+		{
+			description: "特に問題ないので問題なし",
+			imageID:     imageID1,
+			variants: []*domain.GameImageVariant{
+				domain.NewGameImageVariant(variantID1, values.GameImageSizeSmall, values.GameImageTypeJpeg, 320, 180, now),
+				domain.NewGameImageVariant(variantID2, values.GameImageSizeSmall, values.GameImageTypeWebp, 320, 180, now),
+			},
+			expectVariants: []schema.GameImageVariantTable2{
+				{
+					ID:          uuid.UUID(variantID1),
+					GameImageID: uuid.UUID(imageID1),
+					Size:        schema.GameImageSizeSmall,
+					ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+					Width:       320,
+					Height:      180,
+					CreatedAt:   now,
+				},
+				{
+					ID:          uuid.UUID(variantID2),
+					GameImageID: uuid.UUID(imageID1),
+					Size:        schema.GameImageSizeSmall,
+					ImageTypeID: imageTypeMap[schema.GameImageTypeWebp],
+					Width:       320,
+					Height:      180,
+					CreatedAt:   now,
+				},
+			},
+			expectVariantsGenerated: true,
+		},
+		{
+			// 生成する派生画像が無い場合も、生成済みとして記録する
+			description:             "派生画像が空でも問題なし",
+			imageID:                 imageID2,
+			variants:                []*domain.GameImageVariant{},
+			expectVariants:          []schema.GameImageVariantTable2{},
+			expectVariantsGenerated: true,
+		},
+		{
+			description: "同じサイズ・形式の派生画像が既に存在するのでErrDuplicatedUniqueKey",
+			imageID:     imageID3,
+			beforeVariants: []schema.GameImageVariantTable2{
+				{
+					ID:          uuid.UUID(variantID3),
+					GameImageID: uuid.UUID(imageID3),
+					Size:        schema.GameImageSizeOriginal,
+					ImageTypeID: imageTypeMap[schema.GameImageTypeWebp],
+					Width:       1920,
+					Height:      1080,
+					CreatedAt:   now.Add(-time.Hour),
+				},
+			},
+			variants: []*domain.GameImageVariant{
+				domain.NewGameImageVariant(variantID4, values.GameImageSizeOriginal, values.GameImageTypeWebp, 1920, 1080, now),
+			},
+			expectVariants: []schema.GameImageVariantTable2{
+				{
+					ID:          uuid.UUID(variantID3),
+					GameImageID: uuid.UUID(imageID3),
+					Size:        schema.GameImageSizeOriginal,
+					ImageTypeID: imageTypeMap[schema.GameImageTypeWebp],
+					Width:       1920,
+					Height:      1080,
+					CreatedAt:   now.Add(-time.Hour),
+				},
+			},
+			isErr: true,
+			err:   repository.ErrDuplicatedUniqueKey,
+		},
+		{
+			description: "画像が存在しないのでErrForeignKeyViolated",
+			imageID:     imageID4,
+			variants: []*domain.GameImageVariant{
+				domain.NewGameImageVariant(variantID5, values.GameImageSizeOriginal, values.GameImageTypeWebp, 1920, 1080, now),
+			},
+			expectVariants: []schema.GameImageVariantTable2{},
+			isErr:          true,
+			err:            repository.ErrForeignKeyViolated,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeVariants) != 0 {
+				err := db.Create(&testCase.beforeVariants).Error
+				if err != nil {
+					t.Fatalf("failed to create game image variant table: %+v\n", err)
+				}
+			}
+
+			err := gameImageRepository.SaveGameImageVariants(ctx, testCase.imageID, testCase.variants)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var variants []schema.GameImageVariantTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Where("game_image_id = ?", uuid.UUID(testCase.imageID)).
+				Find(&variants).Error
+			if err != nil {
+				t.Fatalf("failed to get game image variant table: %+v\n", err)
+			}
+
+			assert.Len(t, variants, len(testCase.expectVariants))
+
+			variantMap := make(map[uuid.UUID]schema.GameImageVariantTable2, len(variants))
+			for _, variant := range variants {
+				variantMap[variant.ID] = variant
+			}
+
+			for _, expectVariant := range testCase.expectVariants {
+				actualVariant, ok := variantMap[expectVariant.ID]
+				if !ok {
+					t.Errorf("not found variant: %+v", expectVariant)
+					continue
+				}
+
+				assert.Equal(t, expectVariant.GameImageID, actualVariant.GameImageID)
+				assert.Equal(t, expectVariant.Size, actualVariant.Size)
+				assert.Equal(t, expectVariant.ImageTypeID, actualVariant.ImageTypeID)
+				assert.Equal(t, expectVariant.Width, actualVariant.Width)
+				assert.Equal(t, expectVariant.Height, actualVariant.Height)
+				assert.WithinDuration(t, expectVariant.CreatedAt, actualVariant.CreatedAt, 2*time.Second)
+			}
+
+			var images []schema.GameImageTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.imageID)).
+				Find(&images).Error
+			if err != nil {
+				t.Fatalf("failed to get game image table: %+v\n", err)
+			}
+
+			if len(images) != 0 {
+				assert.Equal(t, testCase.expectVariantsGenerated, images[0].VariantsGenerated)
+			}
+		})
+	}
+}
+
+func TestGetGameImageVariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameImageRepository := NewGameImageV2(testDB)
+
+	var imageTypes []*schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&imageTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get role type table: %+v\n", err)
+	}
+
+	imageTypeMap := make(map[string]int, len(imageTypes))
+	for _, imageType := range imageTypes {
+		imageTypeMap[imageType.Name] = imageType.ID
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	now := time.Now()
+
+	gameID := values.NewGameID()
+	imageID1 := values.NewGameImageID()
+	imageID2 := values.NewGameImageID()
+
+	variantID1 := values.NewGameImageVariantID()
+	variantID2 := values.NewGameImageVariantID()
+	variantID3 := values.NewGameImageVariantID()
+
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		CreatedAt:        now,
+		VisibilityTypeID: gameVisibilityPublic.ID,
+		GameImage2s: []schema.GameImageTable2{
+			{
+				ID:          uuid.UUID(imageID1),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+			{
+				ID:          uuid.UUID(imageID2),
+				GameID:      uuid.UUID(gameID),
+				ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+				CreatedAt:   now,
+			},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game table: %+v\n", err)
+	}
+
+	err = db.Create(&[]schema.GameImageVariantTable2{
+		{
+			ID:          uuid.UUID(variantID1),
+			GameImageID: uuid.UUID(imageID1),
+			Size:        schema.GameImageSizeMedium,
+			ImageTypeID: imageTypeMap[schema.GameImageTypeWebp],
+			Width:       640,
+			Height:      360,
+			CreatedAt:   now,
+		},
+		{
+			ID:          uuid.UUID(variantID2),
+			GameImageID: uuid.UUID(imageID1),
+			Size:        schema.GameImageSizeSmall,
+			ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+			Width:       320,
+			Height:      180,
+			CreatedAt:   now,
+		},
+		{
+			ID:          uuid.UUID(variantID3),
+			GameImageID: uuid.UUID(imageID1),
+			Size:        schema.GameImageSizeMedium,
+			ImageTypeID: imageTypeMap[schema.GameImageTypeJpeg],
+			Width:       640,
+			Height:      360,
+			CreatedAt:   now,
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game image variant table: %+v\n", err)
+	}
+
+	type test struct {
+		description string
+		imageID     values.GameImageID
+		lockType    repository.LockType
+		variants    []*domain.GameImageVariant
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "サイズ・形式の昇順で取得できる",
+			imageID:     imageID1,
+			lockType:    repository.LockTypeNone,
+			variants: []*domain.GameImageVariant{
+				domain.NewGameImageVariant(variantID2, values.GameImageSizeSmall, values.GameImageTypeJpeg, 320, 180, now),
+				domain.NewGameImageVariant(variantID3, values.GameImageSizeMedium, values.GameImageTypeJpeg, 640, 360, now),
+				domain.NewGameImageVariant(variantID1, values.GameImageSizeMedium, values.GameImageTypeWebp, 640, 360, now),
+			},
+		},
+		{
+			description: "派生画像が存在しないので空",
+			imageID:     imageID2,
+			lockType:    repository.LockTypeNone,
+			variants:    []*domain.GameImageVariant{},
+		},
+		{
+			description: "行ロックでも取得できる",
+			imageID:     imageID1,
+			lockType:    repository.LockTypeRecord,
+			variants: []*domain.GameImageVariant{
+				domain.NewGameImageVariant(variantID2, values.GameImageSizeSmall, values.GameImageTypeJpeg, 320, 180, now),
+				domain.NewGameImageVariant(variantID3, values.GameImageSizeMedium, values.GameImageTypeJpeg, 640, 360, now),
+				domain.NewGameImageVariant(variantID1, values.GameImageSizeMedium, values.GameImageTypeWebp, 640, 360, now),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			variants, err := gameImageRepository.GetGameImageVariants(ctx, testCase.imageID, testCase.lockType)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Len(t, variants, len(testCase.variants))
+			for i, expectVariant := range testCase.variants {
+				actualVariant := variants[i]
+
+				assert.Equal(t, expectVariant.GetID(), actualVariant.GetID())
+				assert.Equal(t, expectVariant.GetSize(), actualVariant.GetSize())
+				assert.Equal(t, expectVariant.GetType(), actualVariant.GetType())
+				assert.Equal(t, expectVariant.GetWidth(), actualVariant.GetWidth())
+				assert.Equal(t, expectVariant.GetHeight(), actualVariant.GetHeight())
+				assert.WithinDuration(t, expectVariant.GetCreatedAt(), actualVariant.GetCreatedAt(), 2*time.Second)
+			}
+		})
+	}
+}
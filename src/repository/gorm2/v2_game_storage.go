@@ -0,0 +1,234 @@
+package gorm2
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ repository.GameStorageV2 = &GameStorageV2{}
+
+type GameStorageV2 struct {
+	db *DB
+}
+
+func NewGameStorageV2(db *DB) *GameStorageV2 {
+	return &GameStorageV2{
+		db: db,
+	}
+}
+
+func (gameStorage *GameStorageV2) GetGameStorageUsage(ctx context.Context, gameID values.GameID) (*domain.GameStorageUsage, error) {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	// 行がないとSUMはNULLになるので、COALESCEで0にする
+	var fileSize int64
+	err = db.
+		Model(&schema.GameFileTable2{}).
+		Where("game_id = ?", uuid.UUID(gameID)).
+		Select("COALESCE(SUM(size), 0)").
+		Scan(&fileSize).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game file size: %w", err)
+	}
+
+	var imageSize int64
+	err = db.
+		Model(&schema.GameImageTable2{}).
+		Where("game_id = ?", uuid.UUID(gameID)).
+		Select("COALESCE(SUM(size), 0)").
+		Scan(&imageSize).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game image size: %w", err)
+	}
+
+	var videoSize int64
+	err = db.
+		Model(&schema.GameVideoTable2{}).
+		Where("game_id = ?", uuid.UUID(gameID)).
+		Select("COALESCE(SUM(size), 0)").
+		Scan(&videoSize).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game video size: %w", err)
+	}
+
+	return domain.NewGameStorageUsage(
+		values.GameStorageSize(fileSize),
+		values.GameStorageSize(imageSize),
+		values.GameStorageSize(videoSize),
+	), nil
+}
+
+func (gameStorage *GameStorageV2) GetGameStorageQuota(ctx context.Context, gameID values.GameID, lockType repository.LockType) (values.GameStorageSize, error) {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	db, err = gameStorage.db.setLock(db, lockType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set lock: %w", err)
+	}
+
+	var quota schema.GameStorageQuotaTable2
+	err = db.
+		Where("game_id = ?", uuid.UUID(gameID)).
+		Take(&quota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, repository.ErrRecordNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get game storage quota: %w", err)
+	}
+
+	return values.GameStorageSize(quota.Quota), nil
+}
+
+func (gameStorage *GameStorageV2) SaveGameStorageQuota(ctx context.Context, gameID values.GameID, quota values.GameStorageSize) error {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	err = db.
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"quota"}),
+		}).
+		Create(&schema.GameStorageQuotaTable2{
+			GameID: uuid.UUID(gameID),
+			Quota:  int64(quota),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to save game storage quota: %w", err)
+	}
+
+	return nil
+}
+
+func (gameStorage *GameStorageV2) DeleteGameStorageQuota(ctx context.Context, gameID values.GameID) error {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	result := db.
+		Where("game_id = ?", uuid.UUID(gameID)).
+		Delete(&schema.GameStorageQuotaTable2{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete game storage quota: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordDeleted
+	}
+
+	return nil
+}
+
+func (gameStorage *GameStorageV2) GetGameStorageObjectsWithoutSize(ctx context.Context) ([]*repository.GameStorageObject, error) {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var files []schema.GameFileTable2
+	err = db.
+		Select("id", "hash").
+		Where("size = 0").
+		Order("created_at").
+		Find(&files).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game files: %w", err)
+	}
+
+	var images []schema.GameImageTable2
+	err = db.
+		Select("id").
+		Where("size = 0").
+		Order("created_at").
+		Find(&images).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game images: %w", err)
+	}
+
+	var videos []schema.GameVideoTable2
+	err = db.
+		Select("id").
+		Where("size = 0").
+		Order("created_at").
+		Find(&videos).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game videos: %w", err)
+	}
+
+	objects := make([]*repository.GameStorageObject, 0, len(files)+len(images)+len(videos))
+	for _, file := range files {
+		hash, err := hex.DecodeString(file.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode string to hash: %w", err)
+		}
+
+		objects = append(objects, &repository.GameStorageObject{
+			Kind: repository.GameStorageObjectKindFile,
+			ID:   file.ID,
+			Hash: values.GameFileHash(hash),
+		})
+	}
+	for _, image := range images {
+		objects = append(objects, &repository.GameStorageObject{
+			Kind: repository.GameStorageObjectKindImage,
+			ID:   image.ID,
+		})
+	}
+	for _, video := range videos {
+		objects = append(objects, &repository.GameStorageObject{
+			Kind: repository.GameStorageObjectKindVideo,
+			ID:   video.ID,
+		})
+	}
+
+	return objects, nil
+}
+
+func (gameStorage *GameStorageV2) UpdateGameStorageObjectSize(ctx context.Context, kind repository.GameStorageObjectKind, id uuid.UUID, size values.GameStorageSize) error {
+	db, err := gameStorage.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	var model any
+	switch kind {
+	case repository.GameStorageObjectKindFile:
+		model = &schema.GameFileTable2{}
+	case repository.GameStorageObjectKindImage:
+		model = &schema.GameImageTable2{}
+	case repository.GameStorageObjectKindVideo:
+		model = &schema.GameVideoTable2{}
+	default:
+		return fmt.Errorf("invalid game storage object kind: %d", kind)
+	}
+
+	// アップロード時に記録された容量を上書きしないよう、0の場合のみ更新する
+	err = db.
+		Model(model).
+		Where("id = ?", id).
+		Where("size = 0").
+		Update("size", int64(size)).Error
+	if err != nil {
+		return fmt.Errorf("failed to update size: %w", err)
+	}
+
+	return nil
+}
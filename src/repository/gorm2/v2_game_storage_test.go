@@ -0,0 +1,807 @@
+package gorm2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
+	"gorm.io/gorm"
+)
+
+func getGameVisibilityTypeIDPublic(t *testing.T, db *gorm.DB) int {
+	t.Helper()
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err := db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+
+	return gameVisibilityPublic.ID
+}
+
+func TestGetGameStorageUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	type test struct {
+		description string
+		gameID      values.GameID
+		beforeGames []schema.GameTable2
+		expect      *domain.GameStorageUsage
+		isErr       bool
+		err         error
+	}
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	var fileType schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameFileTypeTable{Name: schema.GameFileTypeJar}).
+		Take(&fileType).Error
+	if err != nil {
+		t.Fatalf("failed to get file type: %v\n", err)
+	}
+
+	var imageType schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameImageTypeTable{Name: schema.GameImageTypePng}).
+		Take(&imageType).Error
+	if err != nil {
+		t.Fatalf("failed to get image type: %v\n", err)
+	}
+
+	var videoType schema.GameVideoTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVideoTypeTable{Name: schema.GameVideoTypeMp4}).
+		Take(&videoType).Error
+	if err != nil {
+		t.Fatalf("failed to get video type: %v\n", err)
+	}
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+	gameID4 := values.NewGameID()
+
+	now := time.Now()
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			gameID:      gameID1,
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+					GameFiles: []schema.GameFileTable2{
+						{
+							ID:         uuid.New(),
+							FileTypeID: fileType.ID,
+							Hash:       "68617368",
+							EntryPoint: "/path/to/game.jar",
+							Size:       100,
+							CreatedAt:  now,
+						},
+						{
+							ID:         uuid.New(),
+							FileTypeID: fileType.ID,
+							Hash:       "68617368",
+							EntryPoint: "/path/to/game.jar",
+							Size:       200,
+							CreatedAt:  now.Add(-time.Hour),
+						},
+					},
+					GameImage2s: []schema.GameImageTable2{
+						{
+							ID:          uuid.New(),
+							ImageTypeID: imageType.ID,
+							Size:        10,
+							CreatedAt:   now,
+						},
+					},
+					GameVideo2s: []schema.GameVideoTable2{
+						{
+							ID:          uuid.New(),
+							VideoTypeID: videoType.ID,
+							Size:        1000,
+							CreatedAt:   now,
+						},
+					},
+				},
+			},
+			expect: domain.NewGameStorageUsage(300, 10, 1000),
+		},
+		{
+			description: "ファイル・画像・動画がなくてもエラーなし",
+			gameID:      gameID2,
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+			},
+			expect: domain.NewGameStorageUsage(0, 0, 0),
+		},
+		{
+			description: "他のゲームのファイルは含まない",
+			gameID:      gameID3,
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID3),
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID4),
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+					GameFiles: []schema.GameFileTable2{
+						{
+							ID:         uuid.New(),
+							FileTypeID: fileType.ID,
+							Hash:       "68617368",
+							EntryPoint: "/path/to/game.jar",
+							Size:       100,
+							CreatedAt:  now,
+						},
+					},
+				},
+			},
+			expect: domain.NewGameStorageUsage(0, 0, 0),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeGames) != 0 {
+				err := db.Create(&testCase.beforeGames).Error
+				if err != nil {
+					t.Fatalf("failed to create games: %+v\n", err)
+				}
+			}
+
+			usage, err := gameStorageRepository.GetGameStorageUsage(ctx, testCase.gameID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, usage)
+		})
+	}
+}
+
+func TestGetGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	type test struct {
+		description  string
+		gameID       values.GameID
+		lockType     repository.LockType
+		beforeQuotas []schema.GameStorageQuotaTable2
+		expect       values.GameStorageSize
+		isErr        bool
+		err          error
+	}
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+
+	games := []schema.GameTable2{}
+	for _, gameID := range []values.GameID{gameID1, gameID2, gameID3} {
+		games = append(games, schema.GameTable2{
+			ID:               uuid.UUID(gameID),
+			Name:             "test",
+			Description:      "test",
+			CreatedAt:        time.Now(),
+			VisibilityTypeID: gameVisibilityTypeIDPublic,
+		})
+	}
+	err = db.Create(&games).Error
+	if err != nil {
+		t.Fatalf("failed to create games: %+v\n", err)
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			gameID:      gameID1,
+			lockType:    repository.LockTypeNone,
+			beforeQuotas: []schema.GameStorageQuotaTable2{
+				{GameID: uuid.UUID(gameID1), Quota: 1024},
+			},
+			expect: 1024,
+		},
+		{
+			description: "行ロックでもエラーなし",
+			gameID:      gameID2,
+			lockType:    repository.LockTypeRecord,
+			beforeQuotas: []schema.GameStorageQuotaTable2{
+				{GameID: uuid.UUID(gameID2), Quota: 0},
+			},
+			expect: 0,
+		},
+		{
+			description: "上限が設定されていないのでErrRecordNotFound",
+			gameID:      gameID3,
+			lockType:    repository.LockTypeNone,
+			isErr:       true,
+			err:         repository.ErrRecordNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeQuotas) != 0 {
+				err := db.Create(&testCase.beforeQuotas).Error
+				if err != nil {
+					t.Fatalf("failed to create quotas: %+v\n", err)
+				}
+			}
+
+			quota, err := gameStorageRepository.GetGameStorageQuota(ctx, testCase.gameID, testCase.lockType)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, quota)
+		})
+	}
+}
+
+func TestSaveGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	type test struct {
+		description  string
+		gameID       values.GameID
+		quota        values.GameStorageSize
+		beforeQuotas []schema.GameStorageQuotaTable2
+	}
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+
+	games := []schema.GameTable2{}
+	for _, gameID := range []values.GameID{gameID1, gameID2} {
+		games = append(games, schema.GameTable2{
+			ID:               uuid.UUID(gameID),
+			Name:             "test",
+			Description:      "test",
+			CreatedAt:        time.Now(),
+			VisibilityTypeID: gameVisibilityTypeIDPublic,
+		})
+	}
+	err = db.Create(&games).Error
+	if err != nil {
+		t.Fatalf("failed to create games: %+v\n", err)
+	}
+
+	testCases := []test{
+		{
+			description: "上限が設定されていないので作成される",
+			gameID:      gameID1,
+			quota:       1024,
+		},
+		{
+			description: "上限が設定されているので上書きされる",
+			gameID:      gameID2,
+			quota:       2048,
+			beforeQuotas: []schema.GameStorageQuotaTable2{
+				{GameID: uuid.UUID(gameID2), Quota: 1024},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeQuotas) != 0 {
+				err := db.Create(&testCase.beforeQuotas).Error
+				if err != nil {
+					t.Fatalf("failed to create quotas: %+v\n", err)
+				}
+			}
+
+			err := gameStorageRepository.SaveGameStorageQuota(ctx, testCase.gameID, testCase.quota)
+			assert.NoError(t, err)
+
+			var quotas []schema.GameStorageQuotaTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Where("game_id = ?", uuid.UUID(testCase.gameID)).
+				Find(&quotas).Error
+			if err != nil {
+				t.Fatalf("failed to get quotas: %+v\n", err)
+			}
+
+			assert.Len(t, quotas, 1)
+			assert.Equal(t, int64(testCase.quota), quotas[0].Quota)
+		})
+	}
+}
+
+func TestDeleteGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	type test struct {
+		description  string
+		gameID       values.GameID
+		beforeQuotas []schema.GameStorageQuotaTable2
+		isErr        bool
+		err          error
+	}
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+
+	games := []schema.GameTable2{}
+	for _, gameID := range []values.GameID{gameID1, gameID2} {
+		games = append(games, schema.GameTable2{
+			ID:               uuid.UUID(gameID),
+			Name:             "test",
+			Description:      "test",
+			CreatedAt:        time.Now(),
+			VisibilityTypeID: gameVisibilityTypeIDPublic,
+		})
+	}
+	err = db.Create(&games).Error
+	if err != nil {
+		t.Fatalf("failed to create games: %+v\n", err)
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			gameID:      gameID1,
+			beforeQuotas: []schema.GameStorageQuotaTable2{
+				{GameID: uuid.UUID(gameID1), Quota: 1024},
+			},
+		},
+		{
+			description: "上限が設定されていないのでErrNoRecordDeleted",
+			gameID:      gameID2,
+			isErr:       true,
+			err:         repository.ErrNoRecordDeleted,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if len(testCase.beforeQuotas) != 0 {
+				err := db.Create(&testCase.beforeQuotas).Error
+				if err != nil {
+					t.Fatalf("failed to create quotas: %+v\n", err)
+				}
+			}
+
+			err := gameStorageRepository.DeleteGameStorageQuota(ctx, testCase.gameID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var count int64
+			err = db.
+				Session(&gorm.Session{}).
+				Model(&schema.GameStorageQuotaTable2{}).
+				Where("game_id = ?", uuid.UUID(testCase.gameID)).
+				Count(&count).Error
+			if err != nil {
+				t.Fatalf("failed to count quotas: %+v\n", err)
+			}
+			assert.Zero(t, count)
+		})
+	}
+}
+
+func TestGetGameStorageObjectsWithoutSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	var fileType schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameFileTypeTable{Name: schema.GameFileTypeJar}).
+		Take(&fileType).Error
+	if err != nil {
+		t.Fatalf("failed to get file type: %v\n", err)
+	}
+
+	var imageType schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameImageTypeTable{Name: schema.GameImageTypePng}).
+		Take(&imageType).Error
+	if err != nil {
+		t.Fatalf("failed to get image type: %v\n", err)
+	}
+
+	var videoType schema.GameVideoTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVideoTypeTable{Name: schema.GameVideoTypeMp4}).
+		Take(&videoType).Error
+	if err != nil {
+		t.Fatalf("failed to get video type: %v\n", err)
+	}
+
+	gameID := values.NewGameID()
+	fileID1 := uuid.New()
+	fileID2 := uuid.New()
+	imageID1 := uuid.New()
+	imageID2 := uuid.New()
+	videoID1 := uuid.New()
+	videoID2 := uuid.New()
+
+	now := time.Now()
+
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		CreatedAt:        now,
+		VisibilityTypeID: gameVisibilityTypeIDPublic,
+		GameFiles: []schema.GameFileTable2{
+			{
+				ID:         fileID1,
+				FileTypeID: fileType.ID,
+				Hash:       "68617368",
+				EntryPoint: "/path/to/game.jar",
+				CreatedAt:  now,
+			},
+			{
+				ID:         fileID2,
+				FileTypeID: fileType.ID,
+				Hash:       "68617368",
+				EntryPoint: "/path/to/game.jar",
+				Size:       100,
+				CreatedAt:  now,
+			},
+		},
+		GameImage2s: []schema.GameImageTable2{
+			{
+				ID:          imageID1,
+				ImageTypeID: imageType.ID,
+				CreatedAt:   now,
+			},
+			{
+				ID:          imageID2,
+				ImageTypeID: imageType.ID,
+				Size:        10,
+				CreatedAt:   now,
+			},
+		},
+		GameVideo2s: []schema.GameVideoTable2{
+			{
+				ID:          videoID1,
+				VideoTypeID: videoType.ID,
+				CreatedAt:   now,
+			},
+			{
+				ID:          videoID2,
+				VideoTypeID: videoType.ID,
+				Size:        1000,
+				CreatedAt:   now,
+			},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game: %+v\n", err)
+	}
+
+	objects, err := gameStorageRepository.GetGameStorageObjectsWithoutSize(ctx)
+	assert.NoError(t, err)
+
+	objectMap := make(map[uuid.UUID]*repository.GameStorageObject, len(objects))
+	for _, object := range objects {
+		objectMap[object.ID] = object
+	}
+
+	// 他のテストで作成されたものも含まれるため、このテストで作成したもののみ確認する
+	file, ok := objectMap[fileID1]
+	if assert.True(t, ok) {
+		assert.Equal(t, repository.GameStorageObjectKindFile, file.Kind)
+		assert.Equal(t, values.GameFileHash("hash"), file.Hash)
+	}
+
+	image, ok := objectMap[imageID1]
+	if assert.True(t, ok) {
+		assert.Equal(t, repository.GameStorageObjectKindImage, image.Kind)
+		assert.Nil(t, image.Hash)
+	}
+
+	video, ok := objectMap[videoID1]
+	if assert.True(t, ok) {
+		assert.Equal(t, repository.GameStorageObjectKindVideo, video.Kind)
+		assert.Nil(t, video.Hash)
+	}
+
+	for _, id := range []uuid.UUID{fileID2, imageID2, videoID2} {
+		_, ok := objectMap[id]
+		assert.False(t, ok)
+	}
+}
+
+func TestUpdateGameStorageObjectSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameStorageRepository := NewGameStorageV2(testDB)
+
+	gameVisibilityTypeIDPublic := getGameVisibilityTypeIDPublic(t, db)
+
+	var fileType schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameFileTypeTable{Name: schema.GameFileTypeJar}).
+		Take(&fileType).Error
+	if err != nil {
+		t.Fatalf("failed to get file type: %v\n", err)
+	}
+
+	var imageType schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameImageTypeTable{Name: schema.GameImageTypePng}).
+		Take(&imageType).Error
+	if err != nil {
+		t.Fatalf("failed to get image type: %v\n", err)
+	}
+
+	var videoType schema.GameVideoTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVideoTypeTable{Name: schema.GameVideoTypeMp4}).
+		Take(&videoType).Error
+	if err != nil {
+		t.Fatalf("failed to get video type: %v\n", err)
+	}
+
+	gameID := values.NewGameID()
+	fileID1 := uuid.New()
+	fileID2 := uuid.New()
+	imageID := uuid.New()
+	videoID := uuid.New()
+
+	now := time.Now()
+
+	err = db.Create(&schema.GameTable2{
+		ID:               uuid.UUID(gameID),
+		Name:             "test",
+		Description:      "test",
+		CreatedAt:        now,
+		VisibilityTypeID: gameVisibilityTypeIDPublic,
+		GameFiles: []schema.GameFileTable2{
+			{
+				ID:         fileID1,
+				FileTypeID: fileType.ID,
+				Hash:       "68617368",
+				EntryPoint: "/path/to/game.jar",
+				CreatedAt:  now,
+			},
+			{
+				ID:         fileID2,
+				FileTypeID: fileType.ID,
+				Hash:       "68617368",
+				EntryPoint: "/path/to/game.jar",
+				Size:       100,
+				CreatedAt:  now,
+			},
+		},
+		GameImage2s: []schema.GameImageTable2{
+			{
+				ID:          imageID,
+				ImageTypeID: imageType.ID,
+				CreatedAt:   now,
+			},
+		},
+		GameVideo2s: []schema.GameVideoTable2{
+			{
+				ID:          videoID,
+				VideoTypeID: videoType.ID,
+				CreatedAt:   now,
+			},
+		},
+	}).Error
+	if err != nil {
+		t.Fatalf("failed to create game: %+v\n", err)
+	}
+
+	getSize := func(t *testing.T, kind repository.GameStorageObjectKind, id uuid.UUID) int64 {
+		t.Helper()
+
+		var model any
+		switch kind {
+		case repository.GameStorageObjectKindFile:
+			model = &schema.GameFileTable2{}
+		case repository.GameStorageObjectKindImage:
+			model = &schema.GameImageTable2{}
+		case repository.GameStorageObjectKindVideo:
+			model = &schema.GameVideoTable2{}
+		}
+
+		var size int64
+		err := db.
+			Session(&gorm.Session{}).
+			Model(model).
+			Where("id = ?", id).
+			Select("size").
+			Scan(&size).Error
+		if err != nil {
+			t.Fatalf("failed to get size: %v\n", err)
+		}
+
+		return size
+	}
+
+	type test struct {
+		description string
+		kind        repository.GameStorageObjectKind
+		id          uuid.UUID
+		size        values.GameStorageSize
+		expectSize  int64
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "ゲームファイルの容量を記録できる",
+			kind:        repository.GameStorageObjectKindFile,
+			id:          fileID1,
+			size:        10,
+			expectSize:  10,
+		},
+		{
+			description: "ゲーム画像の容量を記録できる",
+			kind:        repository.GameStorageObjectKindImage,
+			id:          imageID,
+			size:        20,
+			expectSize:  20,
+		},
+		{
+			description: "ゲーム動画の容量を記録できる",
+			kind:        repository.GameStorageObjectKindVideo,
+			id:          videoID,
+			size:        30,
+			expectSize:  30,
+		},
+		{
+			description: "既に容量が記録されているので更新しない",
+			kind:        repository.GameStorageObjectKindFile,
+			id:          fileID2,
+			size:        40,
+			expectSize:  100,
+		},
+		{
+			description: "種類が不正なのでエラー",
+			kind:        0,
+			id:          uuid.New(),
+			size:        50,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			err := gameStorageRepository.UpdateGameStorageObjectSize(ctx, testCase.kind, testCase.id, testCase.size)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expectSize, getSize(t, testCase.kind, testCase.id))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package gorm2
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
@@ -718,11 +719,13 @@ func TestGetGamesV2(t *testing.T) {
 		userID       *values.TraPMemberID
 		gameGenres   []values.GameGenreID
 		gameName     string
+		keyword      string
 
 		// テストデータ
 
-		beforeGames []schema.GameTable2
-		latestTimes []schema.LatestGameVersionTime
+		beforeGames    []schema.GameTable2
+		latestTimes    []schema.LatestGameVersionTime
+		beforeCreators []schema.GameCreatorTable
 
 		// 返り値
 
@@ -745,6 +748,8 @@ func TestGetGamesV2(t *testing.T) {
 	game1 := domain.NewGame(gameID1, gameName1, "test", values.GameVisibilityTypePublic, now.Add(-time.Hour*2))
 	game2 := domain.NewGame(gameID2, gameName2, "test", values.GameVisibilityTypeLimited, now.Add(-time.Hour))
 	game3 := domain.NewGame(gameID3, gameName3, "test", values.GameVisibilityTypePrivate, now)
+	gameWithPuzzleDescription := domain.NewGame(gameID1, gameName1, "a puzzle game", values.GameVisibilityTypePublic, now.Add(-time.Hour*2))
+	gameWithJapaneseDescription := domain.NewGame(gameID2, gameName2, "落ちものパズルです", values.GameVisibilityTypeLimited, now.Add(-time.Hour))
 
 	gameGenreID1 := values.NewGameGenreID()
 	gameGenreID2 := values.NewGameGenreID()
@@ -1124,6 +1129,48 @@ func TestGetGamesV2(t *testing.T) {
 			},
 			expectedNum: 1,
 		},
+		{
+			description:  "親ジャンルを指定すると子ジャンルのゲームも含まれる",
+			limit:        2,
+			offset:       0,
+			sort:         repository.GamesSortTypeCreatedAt,
+			visibilities: nil,
+			userID:       nil,
+			gameGenres:   []values.GameGenreID{gameGenreID1},
+			gameName:     "",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+					GameGenres: []*schema.GameGenreTable{{
+						ID:        uuid.UUID(gameGenreID1),
+						Name:      string(gameGenreName1),
+						CreatedAt: now.Add(-time.Hour),
+					}},
+				},
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+					GameGenres: []*schema.GameGenreTable{{
+						ID:        uuid.UUID(gameGenreID2),
+						Name:      string(gameGenreName2),
+						CreatedAt: now,
+						ParentID:  uuid.NullUUID{UUID: uuid.UUID(gameGenreID1), Valid: true},
+					}},
+				},
+			},
+			games: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game2, []*domain.GameGenre{gameGenre2}),
+				domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1}),
+			},
+			expectedNum: 2,
+		},
 		{
 			description:  "ゲームジャンルの指定が複数あってもエラーなし",
 			limit:        2,
@@ -1253,6 +1300,207 @@ func TestGetGamesV2(t *testing.T) {
 			},
 			expectedNum: 1,
 		},
+		{
+			description: "sortがPlayCountでもエラーなし",
+			limit:       2,
+			offset:      0,
+			sort:        repository.GamesSortTypePlayCount,
+			// プレイ回数が同じときは作成日時の降順
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+				},
+			},
+			games: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game2, nil),
+				domain.NewGameWithGenres(game1, nil),
+			},
+			expectedNum: 2,
+		},
+		{
+			description: "sortがLatestFeedbackでもエラーなし",
+			limit:       2,
+			offset:      0,
+			sort:        repository.GamesSortTypeLatestFeedback,
+			// フィードバックが無いときは作成日時の降順
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+				},
+			},
+			games: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game2, nil),
+				domain.NewGameWithGenres(game1, nil),
+			},
+			expectedNum: 2,
+		},
+		{
+			description: "キーワードが説明の単語と一致するゲームを取得",
+			limit:       0,
+			offset:      0,
+			sort:        repository.GamesSortTypeCreatedAt,
+			keyword:     "puzzle",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "a puzzle game",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID3),
+					Name:             string(gameName3),
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPrivate,
+				},
+			},
+			games:       []*domain.GameWithGenres{domain.NewGameWithGenres(gameWithPuzzleDescription, nil)},
+			expectedNum: 1,
+		},
+		{
+			description: "キーワードが説明の日本語に部分一致するゲームを取得",
+			limit:       0,
+			offset:      0,
+			sort:        repository.GamesSortTypeCreatedAt,
+			keyword:     "パズル",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "落ちものパズルです",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+				},
+				{
+					ID:               uuid.UUID(gameID3),
+					Name:             string(gameName3),
+					Description:      "test",
+					CreatedAt:        now,
+					VisibilityTypeID: gameVisibilityTypeIDPrivate,
+				},
+			},
+			games:       []*domain.GameWithGenres{domain.NewGameWithGenres(gameWithJapaneseDescription, nil)},
+			expectedNum: 1,
+		},
+		{
+			description: "キーワードが作成者名に一致するゲームを取得",
+			limit:       0,
+			offset:      0,
+			sort:        repository.GamesSortTypeCreatedAt,
+			keyword:     "ikura",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+				},
+			},
+			beforeCreators: []schema.GameCreatorTable{
+				{
+					ID:       uuid.New(),
+					GameID:   uuid.UUID(gameID1),
+					UserID:   uuid.NullUUID{UUID: memberUUID1, Valid: true},
+					UserName: sql.NullString{String: "ikura-hamu", Valid: true},
+				},
+				{
+					ID:       uuid.New(),
+					GameID:   uuid.UUID(gameID2),
+					UserID:   uuid.NullUUID{UUID: memberUUID2, Valid: true},
+					UserName: sql.NullString{String: "mazrean", Valid: true},
+				},
+			},
+			games:       []*domain.GameWithGenres{domain.NewGameWithGenres(game1, nil)},
+			expectedNum: 1,
+		},
+		{
+			description: "キーワードが外部の作成者名に一致するゲームを取得",
+			limit:       0,
+			offset:      0,
+			sort:        repository.GamesSortTypeCreatedAt,
+			keyword:     "studio",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+				{
+					ID:               uuid.UUID(gameID2),
+					Name:             string(gameName2),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour),
+					VisibilityTypeID: gameVisibilityTypeIDLimited,
+				},
+			},
+			beforeCreators: []schema.GameCreatorTable{
+				{
+					ID:       uuid.New(),
+					GameID:   uuid.UUID(gameID1),
+					UserID:   uuid.NullUUID{UUID: memberUUID1, Valid: true},
+					UserName: sql.NullString{String: "ikura-hamu", Valid: true},
+				},
+				{
+					ID:           uuid.New(),
+					GameID:       uuid.UUID(gameID2),
+					ExternalName: sql.NullString{String: "sound studio", Valid: true},
+				},
+			},
+			games:       []*domain.GameWithGenres{domain.NewGameWithGenres(game2, nil)},
+			expectedNum: 1,
+		},
+		{
+			description: "キーワードに一致するゲームが無いので空",
+			limit:       0,
+			offset:      0,
+			sort:        repository.GamesSortTypeCreatedAt,
+			keyword:     "存在しない",
+			beforeGames: []schema.GameTable2{
+				{
+					ID:               uuid.UUID(gameID1),
+					Name:             string(gameName1),
+					Description:      "test",
+					CreatedAt:        now.Add(-time.Hour * 2),
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				},
+			},
+			games:       []*domain.GameWithGenres{},
+			expectedNum: 0,
+		},
 		{
 			description: "limitが負なのでErrNegativeLimit",
 			limit:       -1,
@@ -1304,6 +1552,15 @@ func TestGetGamesV2(t *testing.T) {
 					t.Fatalf("failed to delete latest games: %+v\n", err)
 				}
 
+				err = db.
+					Session(&gorm.Session{
+						AllowGlobalUpdate: true,
+					}).
+					Delete(&schema.GameCreatorTable{}).Error
+				if err != nil {
+					t.Fatalf("failed to delete game creators: %+v\n", err)
+				}
+
 				// ゲームとジャンルとロールの削除
 				err = db.
 					Session(&gorm.Session{
@@ -1332,9 +1589,16 @@ func TestGetGamesV2(t *testing.T) {
 				}
 			}
 
+			if len(testCase.beforeCreators) != 0 {
+				err := db.Create(&testCase.beforeCreators).Error
+				if err != nil {
+					t.Fatalf("failed to create game creators: %+v\n", err)
+				}
+			}
+
 			games, n, err := gameRepository.GetGames(
 				ctx, testCase.limit, testCase.offset, testCase.sort,
-				testCase.visibilities, testCase.userID, testCase.gameGenres, testCase.gameName)
+				testCase.visibilities, testCase.userID, testCase.gameGenres, testCase.gameName, testCase.keyword)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -1380,6 +1644,246 @@ func TestGetGamesV2(t *testing.T) {
 	}
 }
 
+func TestGetGameFacetsV2(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameRepository := NewGameV2(testDB)
+
+	type test struct {
+		description  string
+		visibilities []values.GameVisibility
+		gameGenres   []values.GameGenreID
+		gameName     string
+		keyword      string
+		genres       map[values.GameGenreID]int
+		fileTypes    map[values.GameFileType]int
+		visibility   map[values.GameVisibility]int
+		isErr        bool
+	}
+
+	now := time.Now()
+
+	// 他のテストのゲームと混ざらないように、このテスト用のユーザーが管理するゲームのみを数える
+	userID := values.NewTrapMemberID(uuid.New())
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+
+	gameGenreID1 := values.NewGameGenreID()
+	gameGenreID2 := values.NewGameGenreID()
+
+	roleTypeMap := getGameManagementRoleTypeMapForTest(t, db)
+
+	var fileTypes []*schema.GameFileTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&fileTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get file types: %v\n", err)
+	}
+
+	fileTypeMap := make(map[string]int, len(fileTypes))
+	for _, fileType := range fileTypes {
+		fileTypeMap[fileType.Name] = fileType.ID
+	}
+
+	gameGenre1 := &schema.GameGenreTable{
+		ID:        uuid.UUID(gameGenreID1),
+		Name:      "ファセット1",
+		CreatedAt: now,
+	}
+	gameGenre2 := &schema.GameGenreTable{
+		ID:        uuid.UUID(gameGenreID2),
+		Name:      "ファセット2",
+		CreatedAt: now,
+	}
+
+	newGameFile := func(gameID values.GameID, fileType string) schema.GameFileTable2 {
+		return schema.GameFileTable2{
+			ID:         uuid.New(),
+			GameID:     uuid.UUID(gameID),
+			FileTypeID: fileTypeMap[fileType],
+			Hash:       "68617368",
+			EntryPoint: "/path/to/game",
+			CreatedAt:  now,
+		}
+	}
+	newGameManagementRole := func(gameID values.GameID) []schema.GameManagementRoleTable {
+		return []schema.GameManagementRoleTable{
+			{
+				GameID:     uuid.UUID(gameID),
+				UserID:     uuid.UUID(userID),
+				RoleTypeID: roleTypeMap[schema.GameManagementRoleTypeAdministrator],
+			},
+		}
+	}
+
+	games := []schema.GameTable2{
+		{
+			ID:                  uuid.UUID(gameID1),
+			Name:                "facet game1",
+			Description:         "a puzzle game",
+			CreatedAt:           now,
+			VisibilityTypeID:    getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypePublic),
+			GameGenres:          []*schema.GameGenreTable{gameGenre1, gameGenre2},
+			GameFiles:           []schema.GameFileTable2{newGameFile(gameID1, schema.GameFileTypeWindows)},
+			GameManagementRoles: newGameManagementRole(gameID1),
+		},
+		{
+			ID:               uuid.UUID(gameID2),
+			Name:             "facet game2",
+			Description:      "an action game",
+			CreatedAt:        now,
+			VisibilityTypeID: getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypeLimited),
+			GameGenres:       []*schema.GameGenreTable{gameGenre1},
+			GameFiles: []schema.GameFileTable2{
+				newGameFile(gameID2, schema.GameFileTypeJar),
+				newGameFile(gameID2, schema.GameFileTypeWindows),
+			},
+			GameManagementRoles: newGameManagementRole(gameID2),
+		},
+		{
+			ID:                  uuid.UUID(gameID3),
+			Name:                "facet game3",
+			Description:         "a puzzle game for mac",
+			CreatedAt:           now,
+			VisibilityTypeID:    getGameVisibilityTypeIDForTest(t, db, schema.GameVisibilityTypePrivate),
+			GameFiles:           []schema.GameFileTable2{newGameFile(gameID3, schema.GameFileTypeMac)},
+			GameManagementRoles: newGameManagementRole(gameID3),
+		},
+	}
+
+	err = db.Create(&games).Error
+	if err != nil {
+		t.Fatalf("failed to create games: %+v\n", err)
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			genres: map[values.GameGenreID]int{
+				gameGenreID1: 2,
+				gameGenreID2: 1,
+			},
+			fileTypes: map[values.GameFileType]int{
+				values.GameFileTypeJar:     1,
+				values.GameFileTypeWindows: 2,
+				values.GameFileTypeMac:     1,
+			},
+			visibility: map[values.GameVisibility]int{
+				values.GameVisibilityTypePublic:  1,
+				values.GameVisibilityTypeLimited: 1,
+				values.GameVisibilityTypePrivate: 1,
+			},
+		},
+		{
+			description:  "visibilityの指定があるのでそのゲームのみ数える",
+			visibilities: []values.GameVisibility{values.GameVisibilityTypePublic, values.GameVisibilityTypeLimited},
+			genres: map[values.GameGenreID]int{
+				gameGenreID1: 2,
+				gameGenreID2: 1,
+			},
+			fileTypes: map[values.GameFileType]int{
+				values.GameFileTypeJar:     1,
+				values.GameFileTypeWindows: 2,
+			},
+			visibility: map[values.GameVisibility]int{
+				values.GameVisibilityTypePublic:  1,
+				values.GameVisibilityTypeLimited: 1,
+			},
+		},
+		{
+			description: "ジャンルの指定があっても他のジャンルも数える",
+			gameGenres:  []values.GameGenreID{gameGenreID2},
+			genres: map[values.GameGenreID]int{
+				gameGenreID1: 1,
+				gameGenreID2: 1,
+			},
+			fileTypes: map[values.GameFileType]int{
+				values.GameFileTypeWindows: 1,
+			},
+			visibility: map[values.GameVisibility]int{
+				values.GameVisibilityTypePublic: 1,
+			},
+		},
+		{
+			description: "キーワードの指定があるのでそのゲームのみ数える",
+			keyword:     "puzzle",
+			genres: map[values.GameGenreID]int{
+				gameGenreID1: 1,
+				gameGenreID2: 1,
+			},
+			fileTypes: map[values.GameFileType]int{
+				values.GameFileTypeWindows: 1,
+				values.GameFileTypeMac:     1,
+			},
+			visibility: map[values.GameVisibility]int{
+				values.GameVisibilityTypePublic:  1,
+				values.GameVisibilityTypePrivate: 1,
+			},
+		},
+		{
+			description: "一致するゲームが無いので空",
+			gameName:    "存在しない",
+			genres:      map[values.GameGenreID]int{},
+			fileTypes:   map[values.GameFileType]int{},
+			visibility:  map[values.GameVisibility]int{},
+		},
+		{
+			description:  "visibilityの値がおかしいのでエラー",
+			visibilities: []values.GameVisibility{100},
+			isErr:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			facets, err := gameRepository.GetGameFacets(
+				ctx, testCase.visibilities, &userID, testCase.gameGenres, testCase.gameName, testCase.keyword)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			genres := make(map[values.GameGenreID]int, len(facets.GetGenres()))
+			for _, genreFacet := range facets.GetGenres() {
+				genres[genreFacet.GetGenre().GetID()] = genreFacet.GetGameCount()
+			}
+			assert.Equal(t, testCase.genres, genres)
+
+			// ジャンルはゲーム数の降順
+			for i := 1; i < len(facets.GetGenres()); i++ {
+				assert.GreaterOrEqual(t, facets.GetGenres()[i-1].GetGameCount(), facets.GetGenres()[i].GetGameCount())
+			}
+
+			fileTypes := make(map[values.GameFileType]int, len(facets.GetFileTypes()))
+			for _, fileTypeFacet := range facets.GetFileTypes() {
+				fileTypes[fileTypeFacet.GetFileType()] = fileTypeFacet.GetGameCount()
+			}
+			assert.Equal(t, testCase.fileTypes, fileTypes)
+
+			visibilities := make(map[values.GameVisibility]int, len(facets.GetVisibilities()))
+			for _, visibilityFacet := range facets.GetVisibilities() {
+				visibilities[visibilityFacet.GetVisibility()] = visibilityFacet.GetGameCount()
+			}
+			assert.Equal(t, testCase.visibility, visibilities)
+		})
+	}
+}
+
 func TestGetGamesByIDsV2(t *testing.T) {
 	t.Parallel()
 
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/url"
 
 	"github.com/go-sql-driver/mysql"
@@ -12,6 +12,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2/schema"
 	"gorm.io/gorm"
@@ -154,7 +155,7 @@ func (gameVersion *GameVersionV2) GetGameVersions(
 			url, err := url.Parse(gameVersion.URL)
 			if err != nil {
 				// 1つのurlが不正なだけでエラーになると困るのでログを出して続行
-				log.Printf("failed to parse game version url: %v\n", err)
+				logger.Error(ctx, "failed to parse game version url", slog.Any("error", err))
 				continue
 			}
 
@@ -2,10 +2,13 @@ package gorm2
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -30,6 +33,88 @@ func convertGameVideoType(schemaGameVideoType string) (values.GameVideoType, err
 	}
 }
 
+func convertGameVideoCodec(schemaGameVideoCodec string) (values.GameVideoCodec, error) {
+	switch schemaGameVideoCodec {
+	case schema.GameVideoCodecH264:
+		return values.GameVideoCodecH264, nil
+	case schema.GameVideoCodecVP8:
+		return values.GameVideoCodecVP8, nil
+	case schema.GameVideoCodecVP9:
+		return values.GameVideoCodecVP9, nil
+	case schema.GameVideoCodecAV1:
+		return values.GameVideoCodecAV1, nil
+	default:
+		return 0, fmt.Errorf("invalid video codec: %s", schemaGameVideoCodec)
+	}
+}
+
+func convertGameVideoAudioCodec(schemaGameVideoAudioCodec string) (values.GameVideoAudioCodec, error) {
+	switch schemaGameVideoAudioCodec {
+	case schema.GameVideoAudioCodecAAC:
+		return values.GameVideoAudioCodecAAC, nil
+	case schema.GameVideoAudioCodecMP3:
+		return values.GameVideoAudioCodecMP3, nil
+	case schema.GameVideoAudioCodecOpus:
+		return values.GameVideoAudioCodecOpus, nil
+	case schema.GameVideoAudioCodecVorbis:
+		return values.GameVideoAudioCodecVorbis, nil
+	case schema.GameVideoAudioCodecFLAC:
+		return values.GameVideoAudioCodecFLAC, nil
+	default:
+		return 0, fmt.Errorf("invalid audio codec: %s", schemaGameVideoAudioCodec)
+	}
+}
+
+// convertGameVideo
+// Joins("GameVideoType")とJoins("PosterImageType")をしたレコードからドメインの動画を作る。
+func convertGameVideo(video *schema.GameVideoTable2) (*domain.GameVideo, error) {
+	videoType, err := convertGameVideoType(video.GameVideoType.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert video type: %w", err)
+	}
+
+	gameVideo := domain.NewGameVideo(
+		values.NewGameVideoIDFromUUID(video.ID),
+		videoType,
+		video.CreatedAt,
+	)
+	gameVideo.SetSize(values.GameStorageSize(video.Size))
+
+	if video.VideoCodec.Valid {
+		videoCodec, err := convertGameVideoCodec(video.VideoCodec.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert video codec: %w", err)
+		}
+
+		var audioCodec option.Option[values.GameVideoAudioCodec]
+		if video.AudioCodec.Valid {
+			codec, err := convertGameVideoAudioCodec(video.AudioCodec.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert audio codec: %w", err)
+			}
+			audioCodec = option.NewOption(codec)
+		}
+
+		gameVideo.SetMetadata(domain.NewGameVideoMetadata(
+			time.Duration(video.DurationMs.Int64)*time.Millisecond,
+			int(video.Width.Int32),
+			int(video.Height.Int32),
+			videoCodec,
+			audioCodec,
+		))
+	}
+
+	if video.PosterImageTypeID.Valid && video.PosterImageType != nil {
+		posterType, err := parseGameImageType(video.PosterImageType.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert poster image type: %w", err)
+		}
+		gameVideo.SetPosterType(posterType)
+	}
+
+	return gameVideo, nil
+}
+
 func NewGameVideoV2(db *DB) *GameVideoV2 {
 	return &GameVideoV2{
 		db: db,
@@ -65,13 +150,57 @@ func (gameVideo *GameVideoV2) SaveGameVideo(ctx context.Context, gameID values.G
 	}
 	videoTypeID := videoType.ID
 
+	videoRecord := schema.GameVideoTable2{
+		ID:          uuid.UUID(video.GetID()),
+		GameID:      uuid.UUID(gameID),
+		VideoTypeID: videoTypeID,
+		Size:        int64(video.GetSize()),
+		CreatedAt:   video.GetCreatedAt(),
+	}
+
+	if metadata, ok := video.GetMetadata().Value(); ok {
+		var videoCodec string
+		switch metadata.GetVideoCodec() {
+		case values.GameVideoCodecH264:
+			videoCodec = schema.GameVideoCodecH264
+		case values.GameVideoCodecVP8:
+			videoCodec = schema.GameVideoCodecVP8
+		case values.GameVideoCodecVP9:
+			videoCodec = schema.GameVideoCodecVP9
+		case values.GameVideoCodecAV1:
+			videoCodec = schema.GameVideoCodecAV1
+		default:
+			return fmt.Errorf("invalid video codec: %d", metadata.GetVideoCodec())
+		}
+
+		videoRecord.DurationMs = sql.NullInt64{Int64: metadata.GetDuration().Milliseconds(), Valid: true}
+		videoRecord.Width = sql.NullInt32{Int32: int32(metadata.GetWidth()), Valid: true}
+		videoRecord.Height = sql.NullInt32{Int32: int32(metadata.GetHeight()), Valid: true}
+		videoRecord.VideoCodec = sql.NullString{String: videoCodec, Valid: true}
+
+		if audioCodec, ok := metadata.GetAudioCodec().Value(); ok {
+			var audioCodecName string
+			switch audioCodec {
+			case values.GameVideoAudioCodecAAC:
+				audioCodecName = schema.GameVideoAudioCodecAAC
+			case values.GameVideoAudioCodecMP3:
+				audioCodecName = schema.GameVideoAudioCodecMP3
+			case values.GameVideoAudioCodecOpus:
+				audioCodecName = schema.GameVideoAudioCodecOpus
+			case values.GameVideoAudioCodecVorbis:
+				audioCodecName = schema.GameVideoAudioCodecVorbis
+			case values.GameVideoAudioCodecFLAC:
+				audioCodecName = schema.GameVideoAudioCodecFLAC
+			default:
+				return fmt.Errorf("invalid audio codec: %d", audioCodec)
+			}
+
+			videoRecord.AudioCodec = sql.NullString{String: audioCodecName, Valid: true}
+		}
+	}
+
 	err = db.
-		Create(&schema.GameVideoTable2{
-			ID:          uuid.UUID(video.GetID()),
-			GameID:      uuid.UUID(gameID),
-			VideoTypeID: videoTypeID,
-			CreatedAt:   video.GetCreatedAt(),
-		}).Error
+		Create(&videoRecord).Error
 	if err != nil {
 		return fmt.Errorf("failed to create game video: %w", err)
 	}
@@ -93,6 +222,7 @@ func (gameVideo *GameVideoV2) GetGameVideo(ctx context.Context, gameVideoID valu
 	var video schema.GameVideoTable2
 	err = db.
 		Joins("GameVideoType").
+		Joins("PosterImageType").
 		Where("v2_game_videos.id = ?", uuid.UUID(gameVideoID)).
 		Take(&video).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -102,18 +232,14 @@ func (gameVideo *GameVideoV2) GetGameVideo(ctx context.Context, gameVideoID valu
 		return nil, fmt.Errorf("failed to get game video: %w", err)
 	}
 
-	videoType, err := convertGameVideoType(video.GameVideoType.Name)
+	domainVideo, err := convertGameVideo(&video)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert video type: %w", err)
+		return nil, fmt.Errorf("failed to convert game video: %w", err)
 	}
 
 	return &repository.GameVideoInfo{
-		GameVideo: domain.NewGameVideo(
-			values.NewGameVideoIDFromUUID(video.ID),
-			videoType,
-			video.CreatedAt,
-		),
-		GameID: values.NewGameIDFromUUID(video.GameID),
+		GameVideo: domainVideo,
+		GameID:    values.NewGameIDFromUUID(video.GameID),
 	}, nil
 }
 
@@ -131,6 +257,7 @@ func (gameVideo *GameVideoV2) GetGameVideos(ctx context.Context, gameID values.G
 	var videos []schema.GameVideoTable2
 	err = db.
 		Joins("GameVideoType").
+		Joins("PosterImageType").
 		Where("game_id = ?", uuid.UUID(gameID)).
 		Order("created_at DESC").
 		Find(&videos).Error
@@ -140,17 +267,49 @@ func (gameVideo *GameVideoV2) GetGameVideos(ctx context.Context, gameID values.G
 
 	gameVideos := make([]*domain.GameVideo, 0, len(videos))
 	for _, video := range videos {
-		videoType, err := convertGameVideoType(video.GameVideoType.Name)
+		domainVideo, err := convertGameVideo(&video)
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert video type: %w", err)
+			return nil, fmt.Errorf("failed to convert game video: %w", err)
 		}
 
-		gameVideos = append(gameVideos, domain.NewGameVideo(
-			values.NewGameVideoIDFromUUID(video.ID),
-			videoType,
-			video.CreatedAt,
-		))
+		gameVideos = append(gameVideos, domainVideo)
 	}
 
 	return gameVideos, nil
 }
+
+func (gameVideo *GameVideoV2) UpdateGameVideoPosterType(ctx context.Context, gameVideoID values.GameVideoID, posterType values.GameImageType) error {
+	db, err := gameVideo.db.getDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	posterTypeName, err := convertGameImageType(posterType)
+	if err != nil {
+		return fmt.Errorf("failed to convert poster image type: %w", err)
+	}
+
+	var imageType schema.GameImageTypeTable
+	err = db.
+		Where("name = ?", posterTypeName).
+		Where("active = ?", true).
+		Select("id").
+		Take(&imageType).Error
+	if err != nil {
+		return fmt.Errorf("failed to get poster image type: %w", err)
+	}
+
+	result := db.
+		Model(&schema.GameVideoTable2{}).
+		Where("id = ?", uuid.UUID(gameVideoID)).
+		Update("poster_image_type_id", imageType.ID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update poster image type: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repository.ErrNoRecordUpdated
+	}
+
+	return nil
+}
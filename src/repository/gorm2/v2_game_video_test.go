@@ -2,12 +2,14 @@ package gorm2
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -43,6 +45,7 @@ func TestSaveGameVideoV2(t *testing.T) {
 	gameID4 := values.NewGameID()
 	gameID5 := values.NewGameID()
 	gameID6 := values.NewGameID()
+	gameID7 := values.NewGameID()
 
 	videoID1 := values.NewGameVideoID()
 	videoID2 := values.NewGameVideoID()
@@ -51,6 +54,7 @@ func TestSaveGameVideoV2(t *testing.T) {
 	videoID5 := values.NewGameVideoID()
 	videoID6 := values.NewGameVideoID()
 	videoID7 := values.NewGameVideoID()
+	videoID8 := values.NewGameVideoID()
 
 	var videoTypes []*schema.GameVideoTypeTable
 	err = db.
@@ -77,6 +81,19 @@ func TestSaveGameVideoV2(t *testing.T) {
 
 	now := time.Now()
 
+	videoWithMetadata := domain.NewGameVideo(
+		videoID8,
+		values.GameVideoTypeMp4,
+		now,
+	)
+	videoWithMetadata.SetMetadata(domain.NewGameVideoMetadata(
+		12500*time.Millisecond,
+		1920,
+		1080,
+		values.GameVideoCodecH264,
+		option.NewOption(values.GameVideoAudioCodecAAC),
+	))
+
 	testCases := []test{
 		{
 			description: "特に問題ないので問題なし",
@@ -132,6 +149,25 @@ func TestSaveGameVideoV2(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:  "メタデータがあっても問題なし",
+			gameID:       gameID7,
+			video:        videoWithMetadata,
+			beforeVideos: []schema.GameVideoTable2{},
+			expectVideos: []schema.GameVideoTable2{
+				{
+					ID:          uuid.UUID(videoID8),
+					GameID:      uuid.UUID(gameID7),
+					VideoTypeID: videoTypeMap[schema.GameVideoTypeMp4],
+					DurationMs:  sql.NullInt64{Int64: 12500, Valid: true},
+					Width:       sql.NullInt32{Int32: 1920, Valid: true},
+					Height:      sql.NullInt32{Int32: 1080, Valid: true},
+					VideoCodec:  sql.NullString{String: schema.GameVideoCodecH264, Valid: true},
+					AudioCodec:  sql.NullString{String: schema.GameVideoAudioCodecAAC, Valid: true},
+					CreatedAt:   now,
+				},
+			},
+		},
 		{
 			description: "想定外の動画の種類なのでエラー",
 			gameID:      gameID2,
@@ -253,6 +289,11 @@ func TestSaveGameVideoV2(t *testing.T) {
 
 				assert.Equal(t, expectVideo.GameID, actualVideo.GameID)
 				assert.Equal(t, expectVideo.VideoTypeID, actualVideo.VideoTypeID)
+				assert.Equal(t, expectVideo.DurationMs, actualVideo.DurationMs)
+				assert.Equal(t, expectVideo.Width, actualVideo.Width)
+				assert.Equal(t, expectVideo.Height, actualVideo.Height)
+				assert.Equal(t, expectVideo.VideoCodec, actualVideo.VideoCodec)
+				assert.Equal(t, expectVideo.AudioCodec, actualVideo.AudioCodec)
 				assert.WithinDuration(t, expectVideo.CreatedAt, actualVideo.CreatedAt, 2*time.Second)
 			}
 		})
@@ -286,6 +327,7 @@ func TestGetGameVideo(t *testing.T) {
 	gameID3 := values.NewGameID()
 	gameID4 := values.NewGameID()
 	gameID5 := values.NewGameID()
+	gameID6 := values.NewGameID()
 
 	videoID1 := values.NewGameVideoID()
 	videoID2 := values.NewGameVideoID()
@@ -294,6 +336,7 @@ func TestGetGameVideo(t *testing.T) {
 	videoID5 := values.NewGameVideoID()
 	videoID6 := values.NewGameVideoID()
 	videoID7 := values.NewGameVideoID()
+	videoID8 := values.NewGameVideoID()
 
 	var videoTypes []*schema.GameVideoTypeTable
 	err = db.
@@ -318,8 +361,31 @@ func TestGetGameVideo(t *testing.T) {
 	}
 	gameVisibilityTypeIDPublic := gameVisibilityPublic.ID
 
+	var imageTypePng schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameImageTypeTable{Name: schema.GameImageTypePng}).
+		Find(&imageTypePng).Error
+	if err != nil {
+		t.Fatalf("failed to get image type: %v\n", err)
+	}
+
 	now := time.Now()
 
+	videoWithMetadata := domain.NewGameVideo(
+		videoID8,
+		values.GameVideoTypeMkv,
+		now,
+	)
+	videoWithMetadata.SetMetadata(domain.NewGameVideoMetadata(
+		3*time.Second,
+		640,
+		360,
+		values.GameVideoCodecVP9,
+		option.NewOption(values.GameVideoAudioCodecOpus),
+	))
+	videoWithMetadata.SetPosterType(values.GameImageTypePng)
+
 	testCases := []test{
 		{
 			description: "特に問題ないので問題なし",
@@ -431,6 +497,29 @@ func TestGetGameVideo(t *testing.T) {
 				GameID: gameID3,
 			},
 		},
+		{
+			description: "メタデータとポスター画像があっても問題なし",
+			videoID:     videoID8,
+			lockType:    repository.LockTypeNone,
+			videos: []schema.GameVideoTable2{
+				{
+					ID:                uuid.UUID(videoID8),
+					GameID:            uuid.UUID(gameID6),
+					VideoTypeID:       videoTypeMap[schema.GameVideoTypeMkv],
+					DurationMs:        sql.NullInt64{Int64: 3000, Valid: true},
+					Width:             sql.NullInt32{Int32: 640, Valid: true},
+					Height:            sql.NullInt32{Int32: 360, Valid: true},
+					VideoCodec:        sql.NullString{String: schema.GameVideoCodecVP9, Valid: true},
+					AudioCodec:        sql.NullString{String: schema.GameVideoAudioCodecOpus, Valid: true},
+					PosterImageTypeID: sql.NullInt32{Int32: int32(imageTypePng.ID), Valid: true},
+					CreatedAt:         now,
+				},
+			},
+			expectVideo: repository.GameVideoInfo{
+				GameVideo: videoWithMetadata,
+				GameID:    gameID6,
+			},
+		},
 		{
 			description: "動画が存在しないのでRecordNotFound",
 			videoID:     videoID5,
@@ -490,6 +579,8 @@ func TestGetGameVideo(t *testing.T) {
 			assert.Equal(t, testCase.expectVideo.GetType(), video.GetType())
 			assert.WithinDuration(t, testCase.expectVideo.GetCreatedAt(), video.GetCreatedAt(), time.Second)
 			assert.Equal(t, testCase.expectVideo.GameID, video.GameID)
+			assert.Equal(t, testCase.expectVideo.GetMetadata(), video.GetMetadata())
+			assert.Equal(t, testCase.expectVideo.GetPosterType(), video.GetPosterType())
 		})
 	}
 }
@@ -729,3 +820,186 @@ func TestGetGameVideos(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateGameVideoPosterType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	db, err := testDB.getDB(ctx)
+	if err != nil {
+		t.Fatalf("failed to get db: %+v\n", err)
+	}
+
+	gameVideoRepository := NewGameVideoV2(testDB)
+
+	type test struct {
+		description      string
+		videoID          values.GameVideoID
+		posterType       values.GameImageType
+		videos           []schema.GameVideoTable2
+		expectPosterType option.Option[string]
+		isErr            bool
+		err              error
+	}
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+
+	videoID1 := values.NewGameVideoID()
+	videoID2 := values.NewGameVideoID()
+	videoID3 := values.NewGameVideoID()
+	videoID4 := values.NewGameVideoID()
+
+	var videoTypes []*schema.GameVideoTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&videoTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get video type table: %+v\n", err)
+	}
+
+	videoTypeMap := make(map[string]int, len(videoTypes))
+	for _, videoType := range videoTypes {
+		videoTypeMap[videoType.Name] = videoType.ID
+	}
+
+	var imageTypes []*schema.GameImageTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Find(&imageTypes).Error
+	if err != nil {
+		t.Fatalf("failed to get image type table: %+v\n", err)
+	}
+
+	imageTypeMap := make(map[string]int, len(imageTypes))
+	imageTypeNameMap := make(map[int]string, len(imageTypes))
+	for _, imageType := range imageTypes {
+		imageTypeMap[imageType.Name] = imageType.ID
+		imageTypeNameMap[imageType.ID] = imageType.Name
+	}
+
+	var gameVisibilityPublic schema.GameVisibilityTypeTable
+	err = db.
+		Session(&gorm.Session{}).
+		Where(&schema.GameVisibilityTypeTable{Name: schema.GameVisibilityTypePublic}).
+		Find(&gameVisibilityPublic).Error
+	if err != nil {
+		t.Fatalf("failed to get game visibility: %v\n", err)
+	}
+	gameVisibilityTypeIDPublic := gameVisibilityPublic.ID
+
+	now := time.Now()
+
+	testCases := []test{
+		{
+			description: "特に問題ないので更新される",
+			videoID:     videoID1,
+			posterType:  values.GameImageTypePng,
+			videos: []schema.GameVideoTable2{
+				{
+					ID:          uuid.UUID(videoID1),
+					GameID:      uuid.UUID(gameID1),
+					VideoTypeID: videoTypeMap[schema.GameVideoTypeMp4],
+					CreatedAt:   now,
+				},
+			},
+			expectPosterType: option.NewOption(schema.GameImageTypePng),
+		},
+		{
+			description: "既にポスター画像があっても更新される",
+			videoID:     videoID2,
+			posterType:  values.GameImageTypeWebp,
+			videos: []schema.GameVideoTable2{
+				{
+					ID:                uuid.UUID(videoID2),
+					GameID:            uuid.UUID(gameID2),
+					VideoTypeID:       videoTypeMap[schema.GameVideoTypeMp4],
+					PosterImageTypeID: sql.NullInt32{Int32: int32(imageTypeMap[schema.GameImageTypeJpeg]), Valid: true},
+					CreatedAt:         now,
+				},
+			},
+			expectPosterType: option.NewOption(schema.GameImageTypeWebp),
+		},
+		{
+			description: "種類が変わらないのでErrNoRecordUpdated",
+			videoID:     videoID3,
+			posterType:  values.GameImageTypeJpeg,
+			videos: []schema.GameVideoTable2{
+				{
+					ID:                uuid.UUID(videoID3),
+					GameID:            uuid.UUID(gameID3),
+					VideoTypeID:       videoTypeMap[schema.GameVideoTypeMp4],
+					PosterImageTypeID: sql.NullInt32{Int32: int32(imageTypeMap[schema.GameImageTypeJpeg]), Valid: true},
+					CreatedAt:         now,
+				},
+			},
+			expectPosterType: option.NewOption(schema.GameImageTypeJpeg),
+			isErr:            true,
+			err:              repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "動画が存在しないのでErrNoRecordUpdated",
+			videoID:     videoID4,
+			posterType:  values.GameImageTypePng,
+			videos:      []schema.GameVideoTable2{},
+			isErr:       true,
+			err:         repository.ErrNoRecordUpdated,
+		},
+		{
+			description: "想定外の画像の種類なのでエラー",
+			videoID:     videoID4,
+			posterType:  100,
+			videos:      []schema.GameVideoTable2{},
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			for _, video := range testCase.videos {
+				err := db.Create(&schema.GameTable2{
+					ID:               video.GameID,
+					Name:             "test",
+					Description:      "test",
+					CreatedAt:        now,
+					GameVideo2s:      []schema.GameVideoTable2{video},
+					VisibilityTypeID: gameVisibilityTypeIDPublic,
+				}).Error
+				if err != nil {
+					t.Fatalf("failed to create game table: %+v\n", err)
+				}
+			}
+
+			err := gameVideoRepository.UpdateGameVideoPosterType(ctx, testCase.videoID, testCase.posterType)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			expectPosterType, ok := testCase.expectPosterType.Value()
+			if !ok {
+				return
+			}
+
+			var video schema.GameVideoTable2
+			err = db.
+				Session(&gorm.Session{}).
+				Where("id = ?", uuid.UUID(testCase.videoID)).
+				Take(&video).Error
+			if err != nil {
+				t.Fatalf("failed to get video: %+v\n", err)
+			}
+
+			assert.True(t, video.PosterImageTypeID.Valid)
+			assert.Equal(t, expectPosterType, imageTypeNameMap[int(video.PosterImageTypeID.Int32)])
+		})
+	}
+}
@@ -21,6 +21,7 @@ type MetricsCollectorV2 struct {
 	gameVideoGauge   *prometheus.GaugeVec
 	gameFileGauge    *prometheus.GaugeVec
 	seatGauge        *prometheus.GaugeVec
+	gameStorageGauge *prometheus.GaugeVec
 }
 
 func (mc *MetricsCollectorV2) Metrics(p *gormPrometheus.Prometheus) []prometheus.Collector {
@@ -86,6 +87,15 @@ func (mc *MetricsCollectorV2) Metrics(p *gormPrometheus.Prometheus) []prometheus
 		}, []string{"status"})
 	}
 
+	if mc.gameStorageGauge == nil {
+		mc.gameStorageGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: mc.Prefix,
+			Subsystem: "game_storage",
+			Name:      "bytes",
+			Help:      "Total size of game files, images and videos in bytes",
+		}, []string{"type"})
+	}
+
 	go func() {
 		for range time.Tick(time.Duration(mc.Interval) * time.Second) {
 			mc.collect(p)
@@ -101,6 +111,7 @@ func (mc *MetricsCollectorV2) Metrics(p *gormPrometheus.Prometheus) []prometheus
 		mc.gameVideoGauge,
 		mc.gameFileGauge,
 		mc.seatGauge,
+		mc.gameStorageGauge,
 	}
 }
 
@@ -136,6 +147,11 @@ func (mc *MetricsCollectorV2) collect(p *gormPrometheus.Prometheus) {
 	if err != nil {
 		p.Logger.Error(ctx, "failed to collect seat metrics", err)
 	}
+
+	err = mc.collectGameStorageMetrics(ctx, p)
+	if err != nil {
+		p.Logger.Error(ctx, "failed to collect game storage metrics", err)
+	}
 }
 
 func (mc *MetricsCollectorV2) collectAccessTokenMetrics(_ context.Context, p *gormPrometheus.Prometheus) error {
@@ -317,3 +333,39 @@ func (mc *MetricsCollectorV2) collectSeatMetrics(_ context.Context, p *gormProme
 
 	return nil
 }
+
+func (mc *MetricsCollectorV2) collectGameStorageMetrics(_ context.Context, p *gormPrometheus.Prometheus) error {
+	tables := []struct {
+		label string
+		model any
+	}{
+		{label: "file", model: &schema.GameFileTable2{}},
+		{label: "image", model: &schema.GameImageTable2{}},
+		{label: "video", model: &schema.GameVideoTable2{}},
+	}
+
+	sizes := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		// 行がないとSUMはNULLになるので、COALESCEで0にする
+		var size int64
+		err := p.DB.
+			Session(&gorm.Session{}).
+			Model(table.model).
+			Select("COALESCE(SUM(size), 0)").
+			Scan(&size).Error
+		if err != nil {
+			return fmt.Errorf("failed to get game %s size: %w", table.label, err)
+		}
+
+		sizes[table.label] = size
+	}
+
+	mc.gameStorageGauge.Reset()
+	for label, size := range sizes {
+		mc.gameStorageGauge.
+			WithLabelValues(label).
+			Set(float64(size))
+	}
+
+	return nil
+}
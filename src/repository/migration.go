@@ -0,0 +1,16 @@
+package repository
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import "context"
+
+type Migration interface {
+	// GetMigrationVersions
+	// DBに適用済みのマイグレーションのうち最新のバージョンと、
+	// アプリケーションに含まれるマイグレーションのうち最新のバージョンを返す。
+	// 適用済みのマイグレーションが無い場合、currentは空文字列。
+	GetMigrationVersions(ctx context.Context) (current string, latest string, err error)
+	// Migrate
+	// 未適用のマイグレーションを適用する。
+	Migrate(ctx context.Context) error
+}
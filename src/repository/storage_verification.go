@@ -0,0 +1,32 @@
+package repository
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+type StorageVerification interface {
+	// SaveStorageVerification
+	// ストレージの検証の結果の保存。
+	SaveStorageVerification(ctx context.Context, verification *domain.StorageVerification) error
+	// UpdateStorageVerification
+	// 検証の状態・進捗・問題の数・終了日時の更新。
+	// 検証の結果が存在しない場合、ErrNoRecordUpdatedを返す。
+	UpdateStorageVerification(ctx context.Context, verification *domain.StorageVerification) error
+	// SaveStorageVerificationIssues
+	// 検証で問題が見つかったオブジェクトの保存。
+	SaveStorageVerificationIssues(ctx context.Context, verificationID values.StorageVerificationID, issues []*domain.StorageVerificationIssue) error
+	// GetLatestStorageVerification
+	// 最後に開始した検証の結果の取得。
+	// 1度も検証していない場合、ErrRecordNotFoundを返す。
+	GetLatestStorageVerification(ctx context.Context) (*domain.StorageVerification, error)
+	// GetStorageVerificationIssues
+	// 検証で問題が見つかったオブジェクトの取得。
+	// limitが0の場合は全て取得する。
+	// 並び順はオブジェクトの種類、IDの昇順。
+	GetStorageVerificationIssues(ctx context.Context, verificationID values.StorageVerificationID, limit int) ([]*domain.StorageVerificationIssue, error)
+}
@@ -42,7 +42,9 @@ type GameV2 interface {
 	// visibilitiesが無いときは、全てのゲームを取得する。
 	// userIDが指定されているときは、そのユーザーが作成したゲームを取得する。
 	// gameGenresが指定されているときは、そのジャンルがすべて含まれるゲームを取得する。
+	// 親ジャンルが指定されたときは、その子孫のジャンルを持つゲームも親ジャンルを持つものとして扱う。
 	// nameが指定されているときは、その名前を含むゲームを取得する。
+	// keywordが指定されているときは、名前・説明の全文検索または作成者名(外部の作成者を含む)の部分一致でゲームを取得する。
 	GetGames(
 		// 必須
 		ctx context.Context,
@@ -54,7 +56,21 @@ type GameV2 interface {
 		userID *values.TraPMemberID,
 		gameGenres []values.GameGenreID,
 		name string,
+		keyword string,
 	) ([]*domain.GameWithGenres, int, error)
+
+	// GetGameFacets
+	// GetGamesと同じ条件に一致するゲームについて、ジャンル・ファイルの種類・公開範囲ごとのゲーム数を取得する。
+	// 条件の意味はGetGamesと同じ。
+	// ゲーム数が0のジャンル・ファイルの種類・公開範囲は含まない。
+	GetGameFacets(
+		ctx context.Context,
+		visibilities []values.GameVisibility,
+		userID *values.TraPMemberID,
+		gameGenres []values.GameGenreID,
+		name string,
+		keyword string,
+	) (*domain.GameFacets, error)
 }
 
 type GamesSortType int
@@ -64,4 +80,8 @@ const (
 	GamesSortTypeCreatedAt GamesSortType = iota
 	// ゲームの最終バージョンの作成日時の降順でソート
 	GamesSortTypeLatestVersion
+	// ゲームのプレイ回数の降順でソート
+	GamesSortTypePlayCount
+	// ゲームへの最新のフィードバックの作成日時の降順でソート
+	GamesSortTypeLatestFeedback
 )
@@ -31,6 +31,25 @@ type GameFileV2 interface {
 	// 既にストレージに保存済みのファイルのみが取得できる。
 	// ファイルの並び順はCreateAtの降順。
 	GetGameFilesWithoutTypes(ctx context.Context, fileIDs []values.GameFileID, lockType LockType) ([]*GameFileInfo, error)
+	// SaveGameFileBlob
+	// ハッシュ値に対応するゲームファイルの実体を記録する。
+	// 実体が記録されていなかった場合のみtrueを返し、呼び出し側でストレージに実体を保存する。
+	// ゲームファイルは削除されないので、実体の参照数は数えず、実体も削除しない。
+	// 同じハッシュ値で同時に呼ばれた場合、後の呼び出しは先のトランザクションの終了まで待つ。
+	SaveGameFileBlob(ctx context.Context, hash values.GameFileHash) (bool, error)
+	// GetGameFilesByScanStatus
+	// 検査の状態が一致するゲームファイルのメタデータ一覧の取得。
+	// ファイルの並び順はCreateAtの昇順。
+	GetGameFilesByScanStatus(ctx context.Context, scanStatus values.GameFileScanStatus) ([]*GameFileInfo, error)
+	// UpdateGameFileScanStatus
+	// ゲームファイルの検査の状態の更新。
+	// 検査に失敗した回数は0に戻す。
+	// ゲームファイルが存在しない場合、または変更が起きなかった場合は、ErrNoRecordUpdatedを返す。
+	UpdateGameFileScanStatus(ctx context.Context, gameFileID values.GameFileID, scanStatus values.GameFileScanStatus) error
+	// IncrementGameFileScanAttempts
+	// ゲームファイルの検査に失敗した回数を1増やし、増やした後の回数を返す。
+	// ゲームファイルが存在しない場合、ErrNoRecordUpdatedを返す。
+	IncrementGameFileScanAttempts(ctx context.Context, gameFileID values.GameFileID) (int, error)
 }
 
 type GameFileInfo struct {
@@ -26,6 +26,19 @@ type GameImageV2 interface {
 	// 既にストレージに保存済みの画像のみが取得できる。
 	// 画像の並び順はCreateAtの降順。
 	GetGameImages(ctx context.Context, gameID values.GameID, lockType LockType) ([]*domain.GameImage, error)
+	// GetGameImagesWithPendingVariants
+	// 派生画像の生成が済んでいないゲーム画像のメタデータ一覧の取得。
+	// 画像の並び順はCreateAtの昇順。
+	GetGameImagesWithPendingVariants(ctx context.Context) ([]*GameImageInfo, error)
+	// SaveGameImageVariants
+	// ゲーム画像の派生画像のメタデータを保存し、派生画像の生成を済みにする。
+	// ストレージに保存済みの派生画像のみが見えるように、ストレージへの保存後に呼ぶ。
+	// 生成する派生画像が無い場合もvariantsを空にして呼び、再度生成しないようにする。
+	SaveGameImageVariants(ctx context.Context, gameImageID values.GameImageID, variants []*domain.GameImageVariant) error
+	// GetGameImageVariants
+	// ゲーム画像の派生画像のメタデータ一覧の取得。
+	// 並び順はサイズの昇順。
+	GetGameImageVariants(ctx context.Context, gameImageID values.GameImageID, lockType LockType) ([]*domain.GameImageVariant, error)
 }
 
 type GameImageInfo struct {
@@ -0,0 +1,57 @@
+package repository
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// GameStorageV2
+// ゲームごとのストレージの使用量・上限の取得と、上限の設定。
+type GameStorageV2 interface {
+	// GetGameStorageUsage
+	// ゲームのファイル・画像・動画の容量の合計を種類ごとに取得する。
+	GetGameStorageUsage(ctx context.Context, gameID values.GameID) (*domain.GameStorageUsage, error)
+	// GetGameStorageQuota
+	// 管理者がゲームに設定したストレージの上限を取得する。
+	// 設定されていない場合、ErrRecordNotFoundを返す。
+	GetGameStorageQuota(ctx context.Context, gameID values.GameID, lockType LockType) (values.GameStorageSize, error)
+	// SaveGameStorageQuota
+	// ゲームのストレージの上限を設定する。
+	// 既に設定されている場合は上書きする。
+	SaveGameStorageQuota(ctx context.Context, gameID values.GameID, quota values.GameStorageSize) error
+	// DeleteGameStorageQuota
+	// ゲームに設定されたストレージの上限を削除する。
+	// 設定されていない場合、ErrNoRecordDeletedを返す。
+	DeleteGameStorageQuota(ctx context.Context, gameID values.GameID) error
+	// GetGameStorageObjectsWithoutSize
+	// 容量が記録されていない(0の)ゲームファイル・画像・動画を取得する。
+	// 容量の記録の導入前に保存されたものの容量を、ストレージから読み込んで記録するために使う。
+	GetGameStorageObjectsWithoutSize(ctx context.Context) ([]*GameStorageObject, error)
+	// UpdateGameStorageObjectSize
+	// 容量が記録されていないゲームファイル・画像・動画の容量を記録する。
+	// 既に容量が記録されている場合は更新しない。
+	UpdateGameStorageObjectSize(ctx context.Context, kind GameStorageObjectKind, id uuid.UUID, size values.GameStorageSize) error
+}
+
+// GameStorageObjectKind
+// ゲームのストレージの使用量に含まれるオブジェクトの種類。
+type GameStorageObjectKind int8
+
+const (
+	GameStorageObjectKindFile GameStorageObjectKind = iota + 1
+	GameStorageObjectKindImage
+	GameStorageObjectKindVideo
+)
+
+type GameStorageObject struct {
+	Kind GameStorageObjectKind
+	ID   uuid.UUID
+	// Hash
+	// ゲームファイルのみ設定される。
+	Hash values.GameFileHash
+}
@@ -26,6 +26,11 @@ type GameVideoV2 interface {
 	// 既にストレージに保存済みの動画のみが取得できる。
 	// 動画の並び順はCreateAtの降順。
 	GetGameVideos(ctx context.Context, gameID values.GameID, lockType LockType) ([]*domain.GameVideo, error)
+	// UpdateGameVideoPosterType
+	// ゲーム動画のポスター画像の形式の更新。
+	// ストレージにポスター画像を保存した後に呼ぶ。
+	// ゲーム動画が存在しない場合、または形式が変わらない場合、ErrNoRecordUpdatedを返す。
+	UpdateGameVideoPosterType(ctx context.Context, gameVideoID values.GameVideoID, posterType values.GameImageType) error
 }
 
 type GameVideoInfo struct {
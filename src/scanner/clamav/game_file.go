@@ -0,0 +1,137 @@
+package clamav
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/scanner"
+)
+
+// chunkSize
+// INSTREAMで1度に送るデータの大きさ。
+// メモリ使用量を抑えるため、ファイルを分割して送る。
+const chunkSize = 64 * 1024
+
+// GameFile
+// clamdのINSTREAMコマンドで、ファイルの内容をUNIXドメインソケット経由で送って検査する。
+// 仕様は [clamdのドキュメント] を参照。
+//
+// [clamdのドキュメント]: https://docs.clamav.net/manual/Usage/Scanning.html#clamd
+type GameFile struct {
+	socketPath string
+}
+
+func NewGameFile(conf config.ScannerClamAV) (*GameFile, error) {
+	socketPath, err := conf.Socket()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get socket path: %w", err)
+	}
+
+	return &GameFile{
+		socketPath: socketPath,
+	}, nil
+}
+
+func (gf *GameFile) ScanGameFile(ctx context.Context, reader io.ReaderAt, size int64) (*scanner.Result, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", gf.socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	// 大きいファイルの検査には時間がかかるので、ctxのキャンセル時に通信を打ち切る
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	// zから始まるコマンドでは、コマンドとレスポンスがNULL文字で区切られる
+	_, err = io.WriteString(conn, "zINSTREAM\x00")
+	if err != nil {
+		return nil, fmt.Errorf("failed to send command: %w", err)
+	}
+
+	err = gf.sendStream(conn, io.NewSectionReader(reader, 0, size))
+	if err != nil {
+		// StreamMaxLengthを超えた場合など、clamdがエラーを返して先に接続を閉じていることがあるので、
+		// 原因が分かるようにレスポンスも読んでおく
+		_, resErr := gf.readResult(conn)
+		return nil, fmt.Errorf("failed to send stream: %w", errors.Join(err, resErr))
+	}
+
+	result, err := gf.readResult(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// sendStream
+// 4byteのビッグエンディアンの長さとデータの組を繰り返し送り、最後に長さ0のチャンクを送る。
+func (*GameFile) sendStream(w io.Writer, r io.Reader) error {
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := r.Read(buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+
+			_, writeErr := w.Write(buf[:4+n])
+			if writeErr != nil {
+				return fmt.Errorf("failed to write chunk: %w", writeErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+	}
+
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	if err != nil {
+		return fmt.Errorf("failed to write terminator: %w", err)
+	}
+
+	return nil
+}
+
+// readResult
+// clamdのレスポンスを読み、検査の結果に変換する。
+// レスポンスは以下のいずれかの形式。
+//   - stream: OK
+//   - stream: <ウイルス名> FOUND
+//   - <エラーメッセージ> ERROR
+func (*GameFile) readResult(r io.Reader) (*scanner.Result, error) {
+	res, err := bufio.NewReader(r).ReadString(0)
+	if err != nil && !(errors.Is(err, io.EOF) && len(res) != 0) {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	res = strings.TrimSpace(strings.TrimSuffix(res, "\x00"))
+
+	switch {
+	case res == "stream: OK":
+		return &scanner.Result{Clean: true}, nil
+	case strings.HasSuffix(res, " FOUND"):
+		signature := strings.TrimSuffix(strings.TrimPrefix(res, "stream: "), " FOUND")
+
+		return &scanner.Result{
+			Clean:  false,
+			Reason: fmt.Sprintf("malware found: %s", signature),
+		}, nil
+	case strings.HasSuffix(res, " ERROR"):
+		return nil, fmt.Errorf("clamd returned error: %s", strings.TrimSuffix(res, " ERROR"))
+	}
+
+	return nil, fmt.Errorf("unexpected response: %s", res)
+}
@@ -0,0 +1,178 @@
+package clamav
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// startFakeClamd
+// INSTREAMコマンドを受け取り、受け取ったデータをreceivedに書き込んでresponseを返すclamdを起動する。
+func startFakeClamd(t *testing.T, response string, received *bytes.Buffer) string {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "clamd.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		command, err := r.ReadString(0)
+		if err != nil || command != "zINSTREAM\x00" {
+			_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+			return
+		}
+
+		for {
+			var length uint32
+			err := binary.Read(r, binary.BigEndian, &length)
+			if err != nil {
+				return
+			}
+			if length == 0 {
+				break
+			}
+
+			_, err = io.CopyN(received, r, int64(length))
+			if err != nil {
+				return
+			}
+		}
+
+		_, _ = io.WriteString(conn, response+"\x00")
+	}()
+
+	return socketPath
+}
+
+func TestScanGameFile(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description  string
+		noServer     bool
+		content      []byte
+		response     string
+		expectClean  bool
+		expectReason string
+		isErr        bool
+	}
+
+	testCases := []test{
+		{
+			description: "問題がないので問題なし",
+			content:     []byte("test"),
+			response:    "stream: OK",
+			expectClean: true,
+		},
+		{
+			description: "複数のチャンクに分かれても問題なし",
+			content:     bytes.Repeat([]byte("a"), chunkSize*2+1),
+			response:    "stream: OK",
+			expectClean: true,
+		},
+		{
+			description: "空のファイルでも問題なし",
+			content:     []byte{},
+			response:    "stream: OK",
+			expectClean: true,
+		},
+		{
+			description:  "マルウェアが見つかったので問題あり",
+			content:      []byte("test"),
+			response:     "stream: Win.Test.EICAR_HDB-1 FOUND",
+			expectReason: "malware found: Win.Test.EICAR_HDB-1",
+		},
+		{
+			description: "clamdがエラーを返したのでエラー",
+			content:     []byte("test"),
+			response:    "INSTREAM size limit exceeded. ERROR",
+			isErr:       true,
+		},
+		{
+			description: "不明なレスポンスなのでエラー",
+			content:     []byte("test"),
+			response:    "unknown",
+			isErr:       true,
+		},
+		{
+			description: "clamdに接続できないのでエラー",
+			noServer:    true,
+			content:     []byte("test"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			received := bytes.NewBuffer(nil)
+
+			var socketPath string
+			if testCase.noServer {
+				socketPath = filepath.Join(t.TempDir(), "clamd.sock")
+			} else {
+				socketPath = startFakeClamd(t, testCase.response, received)
+			}
+
+			mockConf := mock.NewMockScannerClamAV(ctrl)
+			mockConf.
+				EXPECT().
+				Socket().
+				Return(socketPath, nil)
+
+			gameFile, err := NewGameFile(mockConf)
+			require.NoError(t, err)
+
+			result, err := gameFile.ScanGameFile(context.Background(), bytes.NewReader(testCase.content), int64(len(testCase.content)))
+
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectClean, result.Clean)
+			assert.Equal(t, testCase.expectReason, result.Reason)
+			assert.Equal(t, string(testCase.content), received.String())
+		})
+	}
+}
+
+func TestNewGameFile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	mockConf := mock.NewMockScannerClamAV(ctrl)
+	mockConf.
+		EXPECT().
+		Socket().
+		Return("", errors.New("error"))
+
+	_, err := NewGameFile(mockConf)
+	assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package scanner
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+	"io"
+)
+
+type GameFile interface {
+	// ScanGameFile
+	// ゲームファイル(zipファイル)の内容を検査する。
+	// 問題が見つかった場合は、エラーを返さずにResult.Cleanをfalseにする。
+	// 検査自体ができなかった場合のみエラーを返す。
+	ScanGameFile(ctx context.Context, reader io.ReaderAt, size int64) (*Result, error)
+}
+
+type Result struct {
+	Clean bool
+	// Reason
+	// 問題が見つかった場合の理由。
+	// 問題が見つからなかった場合は空文字列。
+	Reason string
+}
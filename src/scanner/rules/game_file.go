@@ -0,0 +1,125 @@
+package rules
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/traPtitech/trap-collection-server/src/scanner"
+)
+
+var (
+	// deniedExtensions
+	// ゲームの実行に不要で、悪用されやすいスクリプトやインストーラーの拡張子。
+	deniedExtensions = map[string]struct{}{
+		".bat":  {},
+		".cmd":  {},
+		".ps1":  {},
+		".psm1": {},
+		".vbs":  {},
+		".vbe":  {},
+		".wsf":  {},
+		".wsh":  {},
+		".scr":  {},
+		".pif":  {},
+		".msi":  {},
+		".reg":  {},
+		".lnk":  {},
+		".hta":  {},
+	}
+	// deniedFileNames
+	// 置かれているだけで実行されうるファイル名。
+	deniedFileNames = map[string]struct{}{
+		"autorun.inf": {},
+	}
+	// executableExtensions
+	// 二重拡張子の判定に使う、実行されうるファイルの拡張子。
+	executableExtensions = map[string]struct{}{
+		".exe": {},
+		".com": {},
+		".jar": {},
+	}
+	// disguiseExtensions
+	// 実行ファイルを実行ファイル以外に見せかけるのに使われやすい拡張子。
+	disguiseExtensions = map[string]struct{}{
+		".txt":  {},
+		".pdf":  {},
+		".doc":  {},
+		".docx": {},
+		".jpg":  {},
+		".jpeg": {},
+		".png":  {},
+		".gif":  {},
+		".mp3":  {},
+		".mp4":  {},
+		".zip":  {},
+	}
+)
+
+// GameFile
+// zipファイル内のファイル名のみから、スクリプトやインストーラー、拡張子を偽装した実行ファイルを検出する。
+// ファイルの内容は見ないので、ClamAVなどの外部のスキャナーが使えない環境での最低限の検査として使う。
+type GameFile struct{}
+
+func NewGameFile() *GameFile {
+	return &GameFile{}
+}
+
+func (gf *GameFile) ScanGameFile(_ context.Context, reader io.ReaderAt, size int64) (*scanner.Result, error) {
+	zr, err := zip.NewReader(reader, size)
+	if errors.Is(err, zip.ErrFormat) {
+		return &scanner.Result{
+			Clean:  false,
+			Reason: "not a zip file",
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip file: %w", err)
+	}
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+
+		reason, ok := gf.checkFileName(zf.Name)
+		if !ok {
+			return &scanner.Result{
+				Clean:  false,
+				Reason: fmt.Sprintf("%s: %s", reason, zf.Name),
+			}, nil
+		}
+	}
+
+	return &scanner.Result{Clean: true}, nil
+}
+
+// checkFileName
+// 問題のないファイル名であればtrueを返す。
+// 問題がある場合は、その理由を第1返り値で返す。
+func (*GameFile) checkFileName(name string) (string, bool) {
+	// Windowsで作成されたzipファイルでは、区切り文字が\になっていることがある
+	base := strings.ToLower(path.Base(strings.ReplaceAll(name, `\`, "/")))
+
+	if _, ok := deniedFileNames[base]; ok {
+		return "denied file name", false
+	}
+
+	ext := path.Ext(base)
+	if _, ok := deniedExtensions[ext]; ok {
+		return "denied extension", false
+	}
+
+	if _, ok := executableExtensions[ext]; ok {
+		innerExt := path.Ext(strings.TrimSuffix(base, ext))
+		if _, ok := disguiseExtensions[innerExt]; ok {
+			return "disguised executable", false
+		}
+	}
+
+	return "", true
+}
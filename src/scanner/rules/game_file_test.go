@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanGameFile(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description string
+		notZip      bool
+		fileNames   []string
+		expectClean bool
+		reason      string
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないので問題なし",
+			fileNames:   []string{"game/", "game/game.exe", "game/data.dll", "game/readme.txt"},
+			expectClean: true,
+		},
+		{
+			description: "jarファイルでも問題なし",
+			fileNames:   []string{"game.jar"},
+			expectClean: true,
+		},
+		{
+			description: "macOSのアプリケーションでも問題なし",
+			fileNames:   []string{"game.app/", "game.app/Contents/", "game.app/Contents/MacOS/", "game.app/Contents/MacOS/game", "game.app/Contents/Info.plist"},
+			expectClean: true,
+		},
+		{
+			description: "空のzipファイルでも問題なし",
+			fileNames:   []string{},
+			expectClean: true,
+		},
+		{
+			description: "バッチファイルがあるので問題あり",
+			fileNames:   []string{"game.exe", "setup.bat"},
+			reason:      "denied extension: setup.bat",
+		},
+		{
+			description: "PowerShellのスクリプトがあるので問題あり",
+			fileNames:   []string{"game.exe", "tools/install.PS1"},
+			reason:      "denied extension: tools/install.PS1",
+		},
+		{
+			description: "ショートカットがあるので問題あり",
+			fileNames:   []string{"game.exe", "game.lnk"},
+			reason:      "denied extension: game.lnk",
+		},
+		{
+			description: "autorun.infがあるので問題あり",
+			fileNames:   []string{"game.exe", "AUTORUN.INF"},
+			reason:      "denied file name: AUTORUN.INF",
+		},
+		{
+			description: "区切り文字が\\でも問題あり",
+			fileNames:   []string{`game\setup.vbs`},
+			reason:      `denied extension: game\setup.vbs`,
+		},
+		{
+			description: "拡張子を偽装した実行ファイルがあるので問題あり",
+			fileNames:   []string{"game.exe", "readme.txt.exe"},
+			reason:      "disguised executable: readme.txt.exe",
+		},
+		{
+			description: "実行ファイル以外の二重拡張子は問題なし",
+			fileNames:   []string{"game.exe", "data.tar.gz", "game.v1.exe"},
+			expectClean: true,
+		},
+		{
+			description: "ディレクトリ名は見ないので問題なし",
+			fileNames:   []string{"setup.bat/", "setup.bat/game.exe"},
+			expectClean: true,
+		},
+		{
+			description: "zipファイルでないので問題あり",
+			notZip:      true,
+			reason:      "not a zip file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			buf := bytes.NewBuffer(nil)
+			if testCase.notZip {
+				buf.WriteString("not zip")
+			} else {
+				zw := zip.NewWriter(buf)
+				for _, fileName := range testCase.fileNames {
+					_, err := zw.Create(fileName)
+					require.NoError(t, err)
+				}
+				require.NoError(t, zw.Close())
+			}
+
+			gameFile := NewGameFile()
+
+			result, err := gameFile.ScanGameFile(context.Background(), bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expectClean, result.Clean)
+			assert.Equal(t, testCase.reason, result.Reason)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+type AuditLog interface {
+	// GetAuditLogs
+	// 監査ログを新しい順に取得する。
+	// limitが0のときはすべての監査ログを取得する。
+	// 返り値のintはlimitとoffsetをかけないときの監査ログの数。
+	// limitが負のとき、ErrInvalidLimitを返す。
+	// limitが0でoffsetが0でないとき、ErrOffsetWithoutLimitを返す。
+	// sinceがuntilより後のとき、ErrInvalidAuditLogPeriodを返す。
+	GetAuditLogs(ctx context.Context, limit int, offset int, params *GetAuditLogsParams) (int, []*domain.AuditLog, error)
+}
+
+// GetAuditLogsParams
+// 監査ログの絞り込み条件。
+// 値が設定されていない条件では絞り込みを行わない。
+type GetAuditLogsParams struct {
+	ActorID    option.Option[values.TraPMemberID]
+	Action     option.Option[values.AuditLogAction]
+	TargetType option.Option[values.AuditLogTargetType]
+	TargetID   option.Option[uuid.UUID]
+	Since      option.Option[time.Time]
+	Until      option.Option[time.Time]
+}
@@ -44,6 +44,7 @@ type Edition interface {
 	// ゲームが重複している場合はErrDuplicateGameを返す。
 	UpdateEdition(
 		ctx context.Context,
+		session *domain.OIDCSession,
 		editionID values.EditionID,
 		name values.EditionName,
 		questionnaireURL OptionQuestionnaireURL,
@@ -51,13 +52,14 @@ type Edition interface {
 	// DeleteEdition
 	// エディションの削除。
 	// エディションが存在しない場合はErrInvalidEditionIDを返す。
-	DeleteEdition(ctx context.Context, editionID values.EditionID) error
+	DeleteEdition(ctx context.Context, session *domain.OIDCSession, editionID values.EditionID) error
 	// UpdateEditionGameVersions
 	// エディションに含まれるゲームバージョンの更新。
 	// エディションが存在しない場合はErrInvalidEditionIDを返す。
 	// ゲームバージョンが存在しない場合はErrInvalidGameVersionIDを返す。
 	UpdateEditionGameVersions(
 		ctx context.Context,
+		session *domain.OIDCSession,
 		editionID values.EditionID,
 		gameVersionIDs []values.GameVersionID,
 	) ([]*GameVersionWithGame, error)
@@ -24,12 +24,12 @@ type EditionAuth interface {
 	// 指定したプロダクトキーを有効化します。
 	// 存在しないプロダクトキーの場合、ErrInvalidProductKeyを返します。
 	// 既に有効なプロダクトキーの場合、ErrKeyAlreadyActivatedを返します。
-	ActivateProductKey(ctx context.Context, productKey values.LauncherUserID) (*domain.LauncherUser, error)
+	ActivateProductKey(ctx context.Context, session *domain.OIDCSession, productKey values.LauncherUserID) (*domain.LauncherUser, error)
 	// RevokeProductKey
 	// 指定したプロダクトキーを無効化します。
 	// 存在しないプロダクトキーの場合、ErrInvalidProductKeyを返します。
 	// 既に無効なプロダクトキーの場合、ErrKeyAlreadyRevokedを返します。
-	RevokeProductKey(ctx context.Context, productKey values.LauncherUserID) (*domain.LauncherUser, error)
+	RevokeProductKey(ctx context.Context, session *domain.OIDCSession, productKey values.LauncherUserID) (*domain.LauncherUser, error)
 	// AuthorizeEdition
 	// プロダクトキーから、エディション情報へのアクセストークンを発行します。
 	// 存在しないプロダクトキーの場合、ErrInvalidProductKeyを返します。
@@ -66,7 +66,15 @@ type EditionAuth interface {
 	// アクセストークンが存在しない、もしくは無効な場合、ErrInvalidAccessTokenを返します。
 	// アクセストークンが期限切れの場合、ErrExpiredAccessTokenを返します。
 	// ファイル情報にアクセスできない、もしくはファイルが存在しない場合、ErrForbiddenを返します。
+	// ファイルが未検査、もしくは検査で問題が見つかっている場合、ErrGameFileNotCleanを返します。
 	EditionFileAuth(ctx context.Context, accessToken values.LauncherSessionAccessToken, fileID values.GameFileID) (*domain.LauncherUser, *domain.Edition, error)
+	// EditionGameVersionAuth
+	// エディション情報へのアクセストークンを検証し、
+	// ゲームバージョンにアクセスできるかどうかチェックします。
+	// アクセストークンが存在しない、もしくは無効な場合、ErrInvalidAccessTokenを返します。
+	// アクセストークンが期限切れの場合、ErrExpiredAccessTokenを返します。
+	// ゲームバージョンがエディションに含まれない、もしくはゲームバージョンが存在しない場合、ErrForbiddenを返します。
+	EditionGameVersionAuth(ctx context.Context, accessToken values.LauncherSessionAccessToken, gameVersionID values.GameVersionID) (*domain.LauncherUser, *domain.Edition, error)
 }
 
 type GetProductKeysParams struct {
@@ -0,0 +1,38 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+type EditionBundle interface {
+	// CreateEditionBundle
+	// エディションのオフライン配布用バンドルの生成を予約する。
+	// 生成はBuildEditionBundlesでバックグラウンドで行われる。
+	// エディションが存在しない場合はErrInvalidEditionIDを返す。
+	// 署名鍵が設定されていない場合はErrEditionBundleUnavailableを返す。
+	CreateEditionBundle(ctx context.Context, editionID values.EditionID) (*domain.EditionBundle, error)
+	// GetEditionBundles
+	// エディションのバンドル一覧の取得。
+	// エディションが存在しない場合はErrInvalidEditionIDを返す。
+	GetEditionBundles(ctx context.Context, editionID values.EditionID) ([]*domain.EditionBundle, error)
+	// GetEditionBundle
+	// バンドルの取得。
+	// バンドルが存在しない、またはエディションに紐づいていない場合はErrInvalidEditionBundleIDを返す。
+	GetEditionBundle(ctx context.Context, editionID values.EditionID, bundleID values.EditionBundleID) (*domain.EditionBundle, error)
+	// GetEditionBundleURL
+	// バンドルの一時URLの取得。
+	// バンドルが存在しない、またはエディションに紐づいていない場合はErrInvalidEditionBundleIDを返す。
+	// バンドルの生成が終わっていない場合はErrEditionBundleNotCompletedを返す。
+	GetEditionBundleURL(ctx context.Context, editionID values.EditionID, bundleID values.EditionBundleID) (values.EditionBundleTmpURL, error)
+	// BuildEditionBundles
+	// 生成待ちのバンドルを生成する。
+	// 個々のバンドルの生成に失敗しても処理は続け、失敗したバンドルは生成失敗の状態にする。
+	// 複数のサーバーで同時に呼んでも、1つのバンドルは1つのサーバーだけが生成する。
+	// 生成中のまま生存の報告が途絶えたバンドルは、生成中にサーバーが停止したものとして生成失敗の状態にする。
+	BuildEditionBundles(ctx context.Context) error
+}
@@ -21,6 +21,7 @@ var (
 	ErrInvalidGameFileID                 = errors.New("invalid game file id")
 	ErrInvalidUserID                     = errors.New("invalid user id")
 	ErrInvalidGameFileType               = errors.New("invalid game file type")
+	ErrInvalidGameFileScanStatus         = errors.New("invalid game file scan status")
 	ErrInvalidKeyNum                     = errors.New("invalid key num")
 	ErrInvalidProductKey                 = errors.New("invalid product key")
 	ErrInvalidAccessToken                = errors.New("invalid access token")
@@ -30,9 +31,11 @@ var (
 	ErrExpiredAccessToken                = errors.New("expired access token")
 	ErrForbidden                         = errors.New("forbidden")
 	ErrInvalidFormat                     = errors.New("invalid format")
+	ErrUnsupportedGameVideoCodec         = errors.New("unsupported game video codec")
 	ErrNoGame                            = errors.New("no game")
 	ErrNoGameImage                       = errors.New("no game image")
 	ErrNoGameVideo                       = errors.New("no game video")
+	ErrNoGameVideoPoster                 = errors.New("no game video poster")
 	ErrNoGameVersion                     = errors.New("no game version")
 	ErrNoGameFile                        = errors.New("no game file")
 	ErrNoGameURL                         = errors.New("no game url")
@@ -57,6 +60,7 @@ var (
 	ErrInvalidPlayLogID                  = errors.New("invalid play log id")
 	ErrInvalidEndTime                    = errors.New("invalid end time")
 	ErrInvalidPlayLogEditionGamePair     = errors.New("invalid play log edition and game pair")
+	ErrTooManyPlayLogRecords             = errors.New("too many play log records")
 	ErrInvalidTimeRange                  = errors.New("invalid time range")
 	ErrTimePeriodTooLong                 = errors.New("time period too long")
 	ErrDuplicateCustomJobDisplayName     = errors.New("duplicate custom job display name")
@@ -65,4 +69,9 @@ var (
 	ErrInvalidGameCreatorJobID           = errors.New("invalid game creator job id")
 	ErrInvalidGameCreatorID              = errors.New("invalid game creator id")
 	ErrInvalidGameCreatorGamePair        = errors.New("invalid game creator and game pair")
+	ErrInvalidGameCreatorOrder           = errors.New("invalid game creator order")
+	ErrStorageMigrationFailed            = errors.New("storage migration failed")
+	ErrStorageVerificationIssuesFound    = errors.New("storage verification issues found")
+	ErrNoStorageVerification             = errors.New("no storage verification")
+	ErrStorageSizeBackfillFailed         = errors.New("storage size backfill failed")
 )
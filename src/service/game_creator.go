@@ -37,7 +37,34 @@ type GameCreator interface {
 	// 存在しない job ID が含まれる場合、ErrInvalidGameCreatorJobIDを返す。
 	// すでに存在するカスタムジョブ名が新しいカスタムジョブとして含まれる場合、ErrDuplicateCustomJobDisplayName を返す。
 	// 同一ユーザーに同じjob idが複数含まれる場合は、ErrDuplicateGameCreatorJobIDを返す。
+	// 新しく追加されたクリエイターは、既存のクリエイターの後ろに表示される。
+	// ジョブは Jobs、NewCustomJobNames の順番で表示される。
 	EditGameCreators(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, inputs []*EditGameCreatorJobInput) error
+	// CreateExternalGameCreator
+	// traPのメンバーでないクリエイターをゲームに追加する。
+	// クリエイターは既存のクリエイターの後ろに表示される。
+	// URLが無い場合、externalURLにはnilを渡す。
+	// 該当するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	CreateExternalGameCreator(ctx context.Context, gameID values.GameID, externalName values.GameCreatorExternalName, externalURL values.GameCreatorExternalURL) (*domain.GameCreator, error)
+	// EditGameCreatorJobs
+	// クリエイターのジョブを jobIDs で置き換える。jobIDs の順番がジョブの表示順になる。
+	// traPのメンバーのクリエイター、外部のクリエイターのどちらにも使える。
+	// 該当するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// 該当するクリエイターが存在しない場合、ErrInvalidGameCreatorIDを返す。
+	// クリエイターとゲームが紐づいていない場合は、ErrInvalidGameCreatorGamePairを返す。
+	// 存在しない job ID が含まれる場合、ErrInvalidGameCreatorJobIDを返す。
+	// 同じ job ID が複数含まれる場合、ErrDuplicateGameCreatorJobIDを返す。
+	EditGameCreatorJobs(ctx context.Context, gameID values.GameID, creatorID values.GameCreatorID, jobIDs []values.GameCreatorJobID) (*domain.GameCreatorWithJobs, error)
+	// UpdateGameCreatorsOrder
+	// ゲームのクリエイターの表示順を creatorIDs の順番に変更する。
+	// 該当するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// creatorIDs がゲームのクリエイター全員をちょうど1回ずつ含まない場合、ErrInvalidGameCreatorOrderを返す。
+	UpdateGameCreatorsOrder(ctx context.Context, gameID values.GameID, creatorIDs []values.GameCreatorID) ([]*domain.GameCreatorWithJobs, error)
+	// GetGameCredits
+	// ゲームのスタッフロールを、ジョブごとにまとめて取得する。
+	// ジョブは、クリエイターの表示順、クリエイター内でのジョブの表示順で最初に現れた順に並ぶ。
+	// 該当するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	GetGameCredits(ctx context.Context, gameID values.GameID) ([]*domain.GameCredit, error)
 }
 
 type EditGameCreatorJobInput struct {
@@ -19,16 +19,41 @@ type GameGenre interface {
 	// ゲームが持つジャンルを修正する。
 	// ゲームが存在しない場合は、ErrNoGameを返す。
 	// ジャンル名に重複がある場合は、ErrDuplicateGameGenreを返す。
+	// ジャンルの別名が含まれている場合は、別名が指すジャンルとして扱う。
 	// 存在しないジャンル名が含まれている場合は、ジャンルを作成する。
 	UpdateGameGenres(ctx context.Context, gameID values.GameID, gameGenreNames []values.GameGenreName) error
 	// ゲームジャンルの情報を編集する。
 	// ゲームジャンルが存在しない場合は、ErrNoGameGenreを返す。
 	// ジャンル名に重複がある場合は、ErrDuplicateGameGenreNameを返す。
 	// ジャンル名が変更されなかった場合は、ErrNoGameGenreUpdatedを返す。
+	// ジャンル名が他のジャンルの別名と重複する場合も、ErrDuplicateGameGenreNameを返す。
 	UpdateGameGenre(ctx context.Context, gameGenreID values.GameGenreID, gameGenreName values.GameGenreName) (*GameGenreInfo, error)
+	// MergeGameGenres
+	// sourceのゲームジャンルをtargetのゲームジャンルに統合し、sourceを削除する。
+	// sourceのジャンルを持つゲーム、sourceの別名、sourceの子ジャンルはtargetに付け替え、sourceの名前はtargetの別名にする。
+	// どちらかのゲームジャンルが存在しない場合は、ErrNoGameGenreを返す。
+	// sourceとtargetが同じ場合は、ErrCannotMergeSameGameGenreを返す。
+	MergeGameGenres(ctx context.Context, session *domain.OIDCSession, sourceID values.GameGenreID, targetID values.GameGenreID) (*GameGenreInfo, error)
+	// UpdateGameGenreParent
+	// ゲームジャンルの親ジャンルを変更する。parentIDがnilのときは親ジャンル無しにする。
+	// ゲームジャンルか親ジャンルが存在しない場合は、ErrNoGameGenreを返す。
+	// 親ジャンルが自身か子孫のジャンルの場合は、ErrInvalidGameGenreParentを返す。
+	// 親ジャンルが変わらない場合は、ErrNoGameGenreUpdatedを返す。
+	UpdateGameGenreParent(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, parentID *values.GameGenreID) (*GameGenreInfo, error)
+	// AddGameGenreAlias
+	// ゲームジャンルに別名を追加する。
+	// ゲームジャンルが存在しない場合は、ErrNoGameGenreを返す。
+	// 別名がジャンル名か他の別名と重複する場合は、ErrDuplicateGameGenreNameを返す。
+	AddGameGenreAlias(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, name values.GameGenreName) (*domain.GameGenreAlias, error)
+	// DeleteGameGenreAlias
+	// ゲームジャンルの別名を削除する。
+	// 別名が存在しないか、指定したゲームジャンルの別名でない場合は、ErrNoGameGenreAliasを返す。
+	DeleteGameGenreAlias(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, aliasID values.GameGenreAliasID) error
 }
 
 type GameGenreInfo struct {
 	domain.GameGenre
-	Num int // そのジャンルを持つゲームの数
+	Num      int                      // そのジャンルを持つゲームの数
+	ParentID *values.GameGenreID      // 親ジャンルのID。親ジャンルが無いときはnil
+	Aliases  []*domain.GameGenreAlias // そのジャンルの別名
 }
@@ -0,0 +1,85 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+	"time"
+)
+
+// DependencyName
+// サーバーが依存するサービスの名前
+type DependencyName string
+
+const (
+	DependencyDatabase DependencyName = "database"
+	// DependencyMigration DBのスキーマのマイグレーション
+	DependencyMigration DependencyName = "migration"
+	DependencyStorage   DependencyName = "storage"
+	DependencyCache     DependencyName = "cache"
+)
+
+// DependencyStatus
+// サーバーが依存するサービスの状態。
+type DependencyStatus struct {
+	Name DependencyName
+	// Err 利用できない場合の原因。利用できる場合はnil。
+	Err error
+}
+
+// BuildInfo
+// サーバーのビルドの情報。
+type BuildInfo struct {
+	// Version モジュールのバージョン。
+	Version string
+	// Revision ビルド元のgitのコミットのハッシュ。取得できない場合は空文字列。
+	Revision  string
+	GoVersion string
+}
+
+// MigrationVersions
+// DBのスキーマのマイグレーションのバージョン。
+type MigrationVersions struct {
+	// Current DBに適用済みの最新のバージョン
+	Current string
+	// Latest サーバーに含まれる最新のバージョン
+	Latest string
+}
+
+// JobStatus
+// 定期実行ジョブの最後の実行結果。
+type JobStatus struct {
+	Name           string
+	LastStartedAt  time.Time
+	LastFinishedAt time.Time
+	// LastErr 最後の実行が失敗した場合の原因。成功した場合はnil。
+	LastErr error
+}
+
+// ServerStatus
+// 管理者向けのサーバーの状態。
+type ServerStatus struct {
+	Build BuildInfo
+	// Migration 取得に失敗した場合はnil。
+	// 失敗の原因はDependenciesのDependencyMigrationに含まれる。
+	Migration      *MigrationVersions
+	FeatureV2      bool
+	FeatureV1Write bool
+	Dependencies   []*DependencyStatus
+	// Jobs 起動後に1度も実行されていないジョブは含まない。
+	// 並び順はNameの昇順。
+	Jobs []*JobStatus
+}
+
+type Status interface {
+	// CheckReadiness
+	// リクエストを処理できる状態かを、依存するサービスごとに確認する。
+	CheckReadiness(ctx context.Context) []*DependencyStatus
+	// GetServerStatus
+	// バージョン・機能フラグ・依存するサービス・定期実行ジョブの状態を返す。
+	GetServerStatus(ctx context.Context) *ServerStatus
+	// RecordJobRun
+	// 定期実行ジョブの実行結果を記録する。
+	// errは実行が成功した場合はnil。
+	RecordJobRun(name string, startedAt time.Time, finishedAt time.Time, err error)
+}
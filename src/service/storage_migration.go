@@ -0,0 +1,33 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+)
+
+// StorageMigrationProgress
+// ストレージの移行の進捗。
+type StorageMigrationProgress struct {
+	// Total 移行対象のオブジェクトの数
+	Total int
+	// Done 処理済みのオブジェクトの数
+	Done int
+	// Copied コピーしたオブジェクトの数
+	Copied int
+	// Skipped 移行先に既に存在したため、コピーしなかったオブジェクトの数
+	Skipped int
+	// Failed 移行に失敗したオブジェクトの数
+	Failed int
+}
+
+type StorageMigration interface {
+	// MigrateStorage
+	// 移行元のストレージにある全てのゲームファイル・ゲーム画像・ゲーム動画を移行先のストレージにコピーする。
+	// 移行先に既に存在するオブジェクトはコピーしないため、中断しても再実行すれば続きから移行できる。
+	// verifyExistingがtrueのとき、移行先に既に存在するゲームファイルもハッシュ値を検証し、一致しなければコピーし直す。
+	// ゲームファイルはコピー後に移行先から読み込み、ハッシュ値を検証する。
+	// 個々のオブジェクトの移行に失敗しても処理は続け、失敗があった場合はErrStorageMigrationFailedを返す。
+	// onProgressは各オブジェクトの処理後に呼ばれる。nilでもよい。
+	MigrateStorage(ctx context.Context, verifyExisting bool, onProgress func(progress StorageMigrationProgress)) (StorageMigrationProgress, error)
+}
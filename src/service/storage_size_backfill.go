@@ -0,0 +1,26 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+)
+
+// StorageSizeBackfillResult
+// 容量の記録の結果。
+type StorageSizeBackfillResult struct {
+	// Total 容量が記録されていなかったオブジェクトの数
+	Total int
+	// Updated 容量を記録したオブジェクトの数
+	Updated int
+	// Failed 容量の記録に失敗したオブジェクトの数
+	Failed int
+}
+
+type StorageSizeBackfill interface {
+	// BackfillStorageSizes
+	// 容量が記録されていないゲームファイル・ゲーム画像・ゲーム動画の容量をストレージから読み込み、記録する。
+	// 容量の記録の導入前に保存されたものの容量を、ストレージの使用量に含めるために使う。
+	// 個々のオブジェクトで失敗しても処理は続け、失敗があった場合はErrStorageSizeBackfillFailedを返す。
+	BackfillStorageSizes(ctx context.Context) (StorageSizeBackfillResult, error)
+}
@@ -0,0 +1,23 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+)
+
+type StorageVerification interface {
+	// VerifyStorage
+	// DBに記録されている全てのゲームファイル・ゲーム画像・ゲーム動画がストレージに存在するかを検証する。
+	// ゲームファイルはストレージから読み込み、ハッシュ値も検証する。
+	// 検証の進捗と見つかった問題はDBに記録する。
+	// 個々のオブジェクトで問題が見つかっても処理は続け、問題があった場合はErrStorageVerificationIssuesFoundを返す。
+	VerifyStorage(ctx context.Context) (*domain.StorageVerification, error)
+	// GetLatestStorageVerification
+	// 最新の検証の結果と、見つかった問題を最大issueLimit件取得する。
+	// issueLimitが0の場合は全て取得する。
+	// 検証が1度も行われていない場合はErrNoStorageVerificationを返す。
+	GetLatestStorageVerification(ctx context.Context, issueLimit int) (*domain.StorageVerification, []*domain.StorageVerificationIssue, error)
+}
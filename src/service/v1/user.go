@@ -4,11 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/traPtitech/trap-collection-server/src/auth"
 	"github.com/traPtitech/trap-collection-server/src/cache"
 	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -60,7 +61,7 @@ func (uu *UserUtils) getMe(ctx context.Context, session *domain.OIDCSession) (*s
 	user, err := uu.userCache.GetMe(ctx, session.GetAccessToken())
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗してもauthからとって来れれば良いので、returnはしない
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(ctx, "failed to get user info", slog.Any("error", err))
 	}
 	// cacheから取り出した場合はそれを返す
 	if err == nil {
@@ -75,7 +76,7 @@ func (uu *UserUtils) getMe(ctx context.Context, session *domain.OIDCSession) (*s
 	err = uu.userCache.SetMe(ctx, session, user)
 	if err != nil {
 		// cacheの設定に失敗してもreturnはしない
-		log.Printf("error: failed to set user info: %v\n", err)
+		logger.Error(ctx, "failed to set user info", slog.Any("error", err))
 	}
 
 	return user, nil
@@ -85,7 +86,7 @@ func (uu *UserUtils) getAllActiveUser(ctx context.Context, session *domain.OIDCS
 	users, err := uu.userCache.GetAllActiveUsers(ctx)
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗してもauthからとって来れれば良いので、returnはしない
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(ctx, "failed to get user info", slog.Any("error", err))
 	}
 	// cacheから取り出した場合はそれを返す
 	if err == nil {
@@ -101,7 +102,7 @@ func (uu *UserUtils) getAllActiveUser(ctx context.Context, session *domain.OIDCS
 	err = uu.userCache.SetAllActiveUsers(ctx, users)
 	if err != nil {
 		// cacheの設定に失敗してもreturnはしない
-		log.Printf("error: failed to set user info: %v\n", err)
+		logger.Error(ctx, "failed to set user info", slog.Any("error", err))
 	}
 
 	users = filteringUsers(users, includeBot)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -15,21 +16,27 @@ type AdminAuth struct {
 	db                  repository.DB
 	adminAuthRepository repository.AdminAuthV2
 	user                *User
+	auditLog            *AuditLog
 }
 
 func NewAdminAuth(
 	db repository.DB,
 	adminAuthRepository repository.AdminAuthV2,
 	user *User,
+	auditLog *AuditLog,
 ) *AdminAuth {
 	return &AdminAuth{
 		db:                  db,
 		adminAuthRepository: adminAuthRepository,
 		user:                user,
+		auditLog:            auditLog,
 	}
 }
 
 func (aa *AdminAuth) AddAdmin(ctx context.Context, session *domain.OIDCSession, userID values.TraPMemberID) ([]*service.UserInfo, error) {
+	ctx, span := tracer.Start(ctx, "AdminAuth.AddAdmin")
+	defer span.End()
+
 	var adminInfos []*service.UserInfo
 	err := aa.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		activeUsers, err := aa.user.getActiveUsers(ctx, session)
@@ -61,6 +68,20 @@ func (aa *AdminAuth) AddAdmin(ctx context.Context, session *domain.OIDCSession,
 			return fmt.Errorf("failed to add admin: %w", err)
 		}
 
+		myInfo, err := aa.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = aa.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionAddAdmin, values.AuditLogTargetTypeUser, uuid.UUID(userID),
+			nil, newAuditLogUserState(newAdminInfo),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		adminInfos = make([]*service.UserInfo, 0, len(adminIDs)+1)
 		for _, adminID := range adminIDs {
 			activeAdmin, ok := activeUsersMap[adminID]
@@ -80,6 +101,9 @@ func (aa *AdminAuth) AddAdmin(ctx context.Context, session *domain.OIDCSession,
 }
 
 func (aa *AdminAuth) GetAdmins(ctx context.Context, session *domain.OIDCSession) ([]*service.UserInfo, error) {
+	ctx, span := tracer.Start(ctx, "AdminAuth.GetAdmins")
+	defer span.End()
+
 	activeUsers, err := aa.user.getActiveUsers(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get active users: %w", err)
@@ -105,6 +129,9 @@ func (aa *AdminAuth) GetAdmins(ctx context.Context, session *domain.OIDCSession)
 }
 
 func (aa *AdminAuth) DeleteAdmin(ctx context.Context, session *domain.OIDCSession, userID values.TraPMemberID) ([]*service.UserInfo, error) {
+	ctx, span := tracer.Start(ctx, "AdminAuth.DeleteAdmin")
+	defer span.End()
+
 	myInfo, err := aa.user.getMe(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get me: %w", err)
@@ -122,16 +149,33 @@ func (aa *AdminAuth) DeleteAdmin(ctx context.Context, session *domain.OIDCSessio
 	for _, activeUser := range activeUsers {
 		activeUsersMap[activeUser.GetID()] = activeUser
 	}
-	if _, ok := activeUsersMap[userID]; !ok {
+	deletedAdminInfo, ok := activeUsersMap[userID]
+	if !ok {
 		return nil, service.ErrInvalidUserID
 	}
 
-	err = aa.adminAuthRepository.DeleteAdmin(ctx, userID)
-	if errors.Is(err, repository.ErrNoRecordDeleted) {
-		return nil, service.ErrNotAdmin
-	}
+	err = aa.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		err := aa.adminAuthRepository.DeleteAdmin(ctx, userID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) {
+			return service.ErrNotAdmin
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete admin: %w", err)
+		}
+
+		err = aa.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionDeleteAdmin, values.AuditLogTargetTypeUser, uuid.UUID(userID),
+			newAuditLogUserState(deletedAdminInfo), nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete admin: %w", err)
+		return nil, fmt.Errorf("failed in transaction: %w", err)
 	}
 
 	adminIDs, err := aa.adminAuthRepository.GetAdmins(ctx)
@@ -149,6 +193,9 @@ func (aa *AdminAuth) DeleteAdmin(ctx context.Context, session *domain.OIDCSessio
 }
 
 func (aa *AdminAuth) AdminAuthorize(ctx context.Context, session *domain.OIDCSession) error {
+	ctx, span := tracer.Start(ctx, "AdminAuth.AdminAuthorize")
+	defer span.End()
+
 	if session.IsExpired() {
 		return service.ErrOIDCSessionExpired
 	}
@@ -169,3 +216,74 @@ func (aa *AdminAuth) AdminAuthorize(ctx context.Context, session *domain.OIDCSes
 	}
 	return service.ErrForbidden
 }
+
+func (aa *AdminAuth) AddAdminByOperator(ctx context.Context, userID values.TraPMemberID) error {
+	ctx, span := tracer.Start(ctx, "AdminAuth.AddAdminByOperator")
+	defer span.End()
+
+	err := aa.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		adminIDs, err := aa.adminAuthRepository.GetAdmins(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get admins: %w", err)
+		}
+
+		for _, adminID := range adminIDs {
+			if adminID == userID { //ユーザーがすでにadmin
+				return service.ErrNoAdminsUpdated
+			}
+		}
+
+		err = aa.adminAuthRepository.AddAdmin(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to add admin: %w", err)
+		}
+
+		// traQのユーザー名は取得できないので、IDのみ記録する
+		err = aa.auditLog.record(
+			ctx, operatorActor,
+			values.AuditLogActionAddAdmin, values.AuditLogTargetTypeUser, uuid.UUID(userID),
+			nil, &auditLogUserState{ID: uuid.UUID(userID)},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (aa *AdminAuth) DeleteAdminByOperator(ctx context.Context, userID values.TraPMemberID) error {
+	ctx, span := tracer.Start(ctx, "AdminAuth.DeleteAdminByOperator")
+	defer span.End()
+
+	err := aa.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		err := aa.adminAuthRepository.DeleteAdmin(ctx, userID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) {
+			return service.ErrNotAdmin
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete admin: %w", err)
+		}
+
+		err = aa.auditLog.record(
+			ctx, operatorActor,
+			values.AuditLogActionDeleteAdmin, values.AuditLogTargetTypeUser, uuid.UUID(userID),
+			&auditLogUserState{ID: uuid.UUID(userID)}, nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return nil
+}
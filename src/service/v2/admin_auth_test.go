@@ -34,21 +34,26 @@ func TestAddAdmin(t *testing.T) {
 
 	user := NewUser(mockUserAuth, mockUserCache)
 
-	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user)
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
 
 	type test struct {
-		description       string
-		authSession       *domain.OIDCSession
-		getActiveUsersErr error
-		userID            values.TraPMemberID
-		executeGetAdmins  bool
-		GetAdminsErr      error
-		beforeAdmins      []values.TraPMemberID
-		executeAddAdmin   bool
-		AddAdminErr       error
-		expectedAdmins    []*service.UserInfo
-		isErr             bool
-		err               error
+		description           string
+		authSession           *domain.OIDCSession
+		getActiveUsersErr     error
+		userID                values.TraPMemberID
+		executeGetAdmins      bool
+		GetAdminsErr          error
+		beforeAdmins          []values.TraPMemberID
+		executeAddAdmin       bool
+		AddAdminErr           error
+		executeCreateAuditLog bool
+		CreateAuditLogErr     error
+		expectedAdmins        []*service.UserInfo
+		isErr                 bool
+		err                   error
 	}
 
 	userID1 := values.NewTrapMemberID(uuid.New())
@@ -69,11 +74,12 @@ func TestAddAdmin(t *testing.T) {
 				"access token",
 				time.Now().Add(time.Hour),
 			),
-			userID:           userID1,
-			executeGetAdmins: true,
-			beforeAdmins:     []values.TraPMemberID{userID2},
-			executeAddAdmin:  true,
-			expectedAdmins:   []*service.UserInfo{userInfo2, userInfo1},
+			userID:                userID1,
+			executeGetAdmins:      true,
+			beforeAdmins:          []values.TraPMemberID{userID2},
+			executeAddAdmin:       true,
+			expectedAdmins:        []*service.UserInfo{userInfo2, userInfo1},
+			executeCreateAuditLog: true,
 		},
 		{
 			description: "GetAdminsの結果に凍結済みユーザーがいてもエラー無し",
@@ -81,11 +87,12 @@ func TestAddAdmin(t *testing.T) {
 				"access token",
 				time.Now().Add(time.Hour),
 			),
-			userID:           userID1,
-			executeGetAdmins: true,
-			beforeAdmins:     []values.TraPMemberID{userID2, userID4},
-			executeAddAdmin:  true,
-			expectedAdmins:   []*service.UserInfo{userInfo2, userInfo1},
+			userID:                userID1,
+			executeGetAdmins:      true,
+			beforeAdmins:          []values.TraPMemberID{userID2, userID4},
+			executeAddAdmin:       true,
+			expectedAdmins:        []*service.UserInfo{userInfo2, userInfo1},
+			executeCreateAuditLog: true,
 		},
 		{
 			description: "全ユーザーの取得に失敗したのでエラー",
@@ -142,6 +149,20 @@ func TestAddAdmin(t *testing.T) {
 			AddAdminErr:      errors.New("test"),
 			isErr:            true,
 		},
+		{
+			description: "CreateAuditLogがエラーなのでエラー",
+			authSession: domain.NewOIDCSession(
+				"access token",
+				time.Now().Add(time.Hour),
+			),
+			userID:                userID1,
+			executeGetAdmins:      true,
+			beforeAdmins:          []values.TraPMemberID{userID2},
+			executeAddAdmin:       true,
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("test"),
+			isErr:                 true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -176,6 +197,17 @@ func TestAddAdmin(t *testing.T) {
 					Return(testCase.AddAdminErr)
 			}
 
+			if testCase.executeCreateAuditLog {
+				mockUserCache.
+					EXPECT().
+					GetMe(gomock.Any(), gomock.Any()).
+					Return(userInfo2, nil)
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Return(testCase.CreateAuditLogErr)
+			}
+
 			adminInfos, err := adminAuthService.AddAdmin(ctx, testCase.authSession, testCase.userID)
 
 			if testCase.isErr {
@@ -216,7 +248,10 @@ func TestGetAdmins(t *testing.T) {
 
 	user := NewUser(mockUserAuth, mockUserCache)
 
-	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user)
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
 
 	type test struct {
 		description        string
@@ -337,7 +372,10 @@ func TestDeleteAdmin(t *testing.T) {
 
 	user := NewUser(mockUserAuth, mockUserCache)
 
-	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user)
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
 
 	type test struct {
 		description           string
@@ -349,6 +387,8 @@ func TestDeleteAdmin(t *testing.T) {
 		userID                values.TraPMemberID
 		executeDeleteAdmin    bool
 		DeleteAdminsErr       error
+		executeCreateAuditLog bool
+		CreateAuditLogErr     error
 		executeGetAdmins      bool
 		afterAdminIDs         []values.TraPMemberID
 		GetAdminsErr          error
@@ -381,6 +421,7 @@ func TestDeleteAdmin(t *testing.T) {
 			executeGetAdmins:      true,
 			afterAdminIDs:         []values.TraPMemberID{userID1},
 			expectedAdminInfos:    []*service.UserInfo{userInfo1},
+			executeCreateAuditLog: true,
 		},
 		{
 			description: "getMeがエラーなのでエラー",
@@ -466,6 +507,21 @@ func TestDeleteAdmin(t *testing.T) {
 			executeGetAdmins:      true,
 			GetAdminsErr:          errors.New("test"),
 			isErr:                 true,
+			executeCreateAuditLog: true,
+		},
+		{
+			description: "CreateAuditLogがエラーなのでエラー",
+			authSession: domain.NewOIDCSession(
+				"access token",
+				time.Now().Add(time.Hour),
+			),
+			myInfo:                userInfo1,
+			executeGetActiveUsers: true,
+			userID:                userID2,
+			executeDeleteAdmin:    true,
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("test"),
+			isErr:                 true,
 		},
 	}
 
@@ -508,14 +564,26 @@ func TestDeleteAdmin(t *testing.T) {
 			if testCase.executeDeleteAdmin {
 				mockAdminAuthRepository.
 					EXPECT().
-					DeleteAdmin(ctx, testCase.userID).
+					DeleteAdmin(gomock.Any(), testCase.userID).
 					Return(testCase.DeleteAdminsErr)
 			}
 
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, auditLog *domain.AuditLog) error {
+						assert.Equal(t, values.AuditLogActionDeleteAdmin, auditLog.GetAction())
+						assert.Equal(t, testCase.myInfo.GetID(), auditLog.GetActorID())
+						assert.Equal(t, uuid.UUID(testCase.userID), auditLog.GetTargetID())
+						return testCase.CreateAuditLogErr
+					})
+			}
+
 			if testCase.executeGetAdmins {
 				mockAdminAuthRepository.
 					EXPECT().
-					GetAdmins(ctx).
+					GetAdmins(gomock.Any()).
 					Return(testCase.afterAdminIDs, testCase.GetAdminsErr)
 			}
 
@@ -559,7 +627,10 @@ func TestAdminAuthorize(t *testing.T) {
 
 	user := NewUser(mockUserAuth, mockUserCache)
 
-	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user)
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
 
 	type test struct {
 		description      string
@@ -684,3 +755,221 @@ func TestAdminAuthorize(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAdminByOperator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockAdminAuthRepository := mockRepository.NewMockAdminAuthV2(ctrl)
+
+	mockUserCache := mockCache.NewMockUser(ctrl)
+	mockUserAuth := mockAuth.NewMockUser(ctrl)
+
+	user := NewUser(mockUserAuth, mockUserCache)
+
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
+
+	type test struct {
+		description           string
+		userID                values.TraPMemberID
+		GetAdminsErr          error
+		beforeAdmins          []values.TraPMemberID
+		executeAddAdmin       bool
+		AddAdminErr           error
+		executeCreateAuditLog bool
+		CreateAuditLogErr     error
+		isErr                 bool
+		err                   error
+	}
+
+	userID1 := values.NewTrapMemberID(uuid.New())
+	userID2 := values.NewTrapMemberID(uuid.New())
+
+	testCases := []test{
+		{
+			description:           "特に問題ないのでエラー無し",
+			userID:                userID1,
+			beforeAdmins:          []values.TraPMemberID{userID2},
+			executeAddAdmin:       true,
+			executeCreateAuditLog: true,
+		},
+		{
+			description:           "adminがいなくてもエラー無し",
+			userID:                userID1,
+			beforeAdmins:          []values.TraPMemberID{},
+			executeAddAdmin:       true,
+			executeCreateAuditLog: true,
+		},
+		{
+			description:  "既にadminなのでErrNoAdminsUpdated",
+			userID:       userID1,
+			beforeAdmins: []values.TraPMemberID{userID1, userID2},
+			isErr:        true,
+			err:          service.ErrNoAdminsUpdated,
+		},
+		{
+			description:  "GetAdminsがエラーなのでエラー",
+			userID:       userID1,
+			GetAdminsErr: errors.New("test"),
+			isErr:        true,
+		},
+		{
+			description:     "AddAdminがエラーなのでエラー",
+			userID:          userID1,
+			beforeAdmins:    []values.TraPMemberID{userID2},
+			executeAddAdmin: true,
+			AddAdminErr:     errors.New("test"),
+			isErr:           true,
+		},
+		{
+			description:           "CreateAuditLogがエラーなのでエラー",
+			userID:                userID1,
+			beforeAdmins:          []values.TraPMemberID{userID2},
+			executeAddAdmin:       true,
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("test"),
+			isErr:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAdminAuthRepository.
+				EXPECT().
+				GetAdmins(gomock.Any()).
+				Return(testCase.beforeAdmins, testCase.GetAdminsErr)
+
+			if testCase.executeAddAdmin {
+				mockAdminAuthRepository.
+					EXPECT().
+					AddAdmin(gomock.Any(), testCase.userID).
+					Return(testCase.AddAdminErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, auditLog *domain.AuditLog) error {
+						assert.Equal(t, values.AuditLogActionAddAdmin, auditLog.GetAction())
+						assert.Equal(t, values.NewTrapMemberID(uuid.Nil), auditLog.GetActorID())
+						assert.Equal(t, uuid.UUID(testCase.userID), auditLog.GetTargetID())
+						return testCase.CreateAuditLogErr
+					})
+			}
+
+			err := adminAuthService.AddAdminByOperator(ctx, testCase.userID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteAdminByOperator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockAdminAuthRepository := mockRepository.NewMockAdminAuthV2(ctrl)
+
+	mockUserCache := mockCache.NewMockUser(ctrl)
+	mockUserAuth := mockAuth.NewMockUser(ctrl)
+
+	user := NewUser(mockUserAuth, mockUserCache)
+
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	adminAuthService := NewAdminAuth(mockDB, mockAdminAuthRepository, user, auditLog)
+
+	type test struct {
+		description           string
+		userID                values.TraPMemberID
+		DeleteAdminErr        error
+		executeCreateAuditLog bool
+		CreateAuditLogErr     error
+		isErr                 bool
+		err                   error
+	}
+
+	userID := values.NewTrapMemberID(uuid.New())
+
+	testCases := []test{
+		{
+			description:           "特に問題ないのでエラー無し",
+			userID:                userID,
+			executeCreateAuditLog: true,
+		},
+		{
+			description:    "DeleteAdminがErrNoRecordDeletedなのでErrNotAdmin",
+			userID:         userID,
+			DeleteAdminErr: repository.ErrNoRecordDeleted,
+			isErr:          true,
+			err:            service.ErrNotAdmin,
+		},
+		{
+			description:    "DeleteAdminがエラーなのでエラー",
+			userID:         userID,
+			DeleteAdminErr: errors.New("test"),
+			isErr:          true,
+		},
+		{
+			description:           "CreateAuditLogがエラーなのでエラー",
+			userID:                userID,
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("test"),
+			isErr:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAdminAuthRepository.
+				EXPECT().
+				DeleteAdmin(gomock.Any(), testCase.userID).
+				Return(testCase.DeleteAdminErr)
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, auditLog *domain.AuditLog) error {
+						assert.Equal(t, values.AuditLogActionDeleteAdmin, auditLog.GetAction())
+						assert.Equal(t, values.NewTrapMemberID(uuid.Nil), auditLog.GetActorID())
+						assert.Equal(t, uuid.UUID(testCase.userID), auditLog.GetTargetID())
+						return testCase.CreateAuditLogErr
+					})
+			}
+
+			err := adminAuthService.DeleteAdminByOperator(ctx, testCase.userID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+var _ service.AuditLog = &AuditLog{}
+
+// AuditLog
+// 監査ログの取得と、各serviceからの監査ログの記録を行う。
+type AuditLog struct {
+	auditLogRepository repository.AuditLog
+}
+
+func NewAuditLog(auditLogRepository repository.AuditLog) *AuditLog {
+	return &AuditLog{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (a *AuditLog) GetAuditLogs(ctx context.Context, limit int, offset int, params *service.GetAuditLogsParams) (int, []*domain.AuditLog, error) {
+	ctx, span := tracer.Start(ctx, "AuditLog.GetAuditLogs")
+	defer span.End()
+
+	if limit < 0 {
+		return 0, nil, service.ErrInvalidLimit
+	}
+	if limit == 0 && offset != 0 {
+		return 0, nil, service.ErrOffsetWithoutLimit
+	}
+
+	since, sinceOk := params.Since.Value()
+	until, untilOk := params.Until.Value()
+	if sinceOk && untilOk && since.After(until) {
+		return 0, nil, service.ErrInvalidAuditLogPeriod
+	}
+
+	auditLogs, num, err := a.auditLogRepository.GetAuditLogs(ctx, limit, offset, repository.GetAuditLogsParams{
+		ActorID:    params.ActorID,
+		Action:     params.Action,
+		TargetType: params.TargetType,
+		TargetID:   params.TargetID,
+		Since:      params.Since,
+		Until:      params.Until,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+
+	return num, auditLogs, nil
+}
+
+// operatorActor
+// CLIからの操作の監査ログに記録する操作者。
+// traQのユーザーではないので、IDはuuid.Nilにする。
+var operatorActor = service.NewUserInfo(
+	values.NewTrapMemberID(uuid.Nil),
+	values.NewTrapMemberName("operator"),
+	values.TrapMemberStatusActive,
+	false,
+)
+
+// record
+// 監査ログを記録する。
+// before、afterはJSONに変換して保存する。nilの場合は保存しない。
+// 操作と同じトランザクション内で呼ぶことで、操作が失敗したときに監査ログだけが残らないようにする。
+func (a *AuditLog) record(
+	ctx context.Context,
+	actor *service.UserInfo,
+	action values.AuditLogAction,
+	targetType values.AuditLogTargetType,
+	targetID uuid.UUID,
+	before any,
+	after any,
+) error {
+	beforeJSON, err := marshalAuditLogState(before)
+	if err != nil {
+		return fmt.Errorf("failed to marshal before state: %w", err)
+	}
+
+	afterJSON, err := marshalAuditLogState(after)
+	if err != nil {
+		return fmt.Errorf("failed to marshal after state: %w", err)
+	}
+
+	auditLog := domain.NewAuditLog(
+		values.NewAuditLogID(),
+		actor.GetID(),
+		actor.GetName(),
+		action,
+		targetType,
+		targetID,
+		beforeJSON,
+		afterJSON,
+		time.Now(),
+	)
+
+	err = a.auditLogRepository.CreateAuditLog(ctx, auditLog)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	return nil
+}
+
+func marshalAuditLogState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(state)
+}
+
+// 以下は監査ログのbefore、afterとして保存する操作対象の状態。
+// 後から読んでわかるように、列挙型は文字列にして保存する。
+
+type auditLogUserState struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func newAuditLogUserState(user *service.UserInfo) *auditLogUserState {
+	return &auditLogUserState{
+		ID:   uuid.UUID(user.GetID()),
+		Name: string(user.GetName()),
+	}
+}
+
+type auditLogGameManagementRoleState struct {
+	UserID uuid.UUID `json:"userId"`
+	Role   string    `json:"role"`
+}
+
+func newAuditLogGameManagementRoleState(userID values.TraPMemberID, role values.GameManagementRole) *auditLogGameManagementRoleState {
+	var roleName string
+	switch role {
+	case values.GameManagementRoleAdministrator:
+		roleName = "owner"
+	case values.GameManagementRoleCollaborator:
+		roleName = "maintainer"
+	default:
+		roleName = fmt.Sprintf("unknown(%d)", role)
+	}
+
+	return &auditLogGameManagementRoleState{
+		UserID: uuid.UUID(userID),
+		Role:   roleName,
+	}
+}
+
+// auditLogGameManagementRolesState
+// 複数のユーザーのroleを変更する操作の状態。
+// 監査ログの状態はオブジェクトとして返すので、配列をそのまま保存しない。
+type auditLogGameManagementRolesState struct {
+	Roles []*auditLogGameManagementRoleState `json:"roles"`
+}
+
+func newAuditLogGameManagementRolesState(roles ...*auditLogGameManagementRoleState) *auditLogGameManagementRolesState {
+	return &auditLogGameManagementRolesState{
+		Roles: roles,
+	}
+}
+
+type auditLogGameState struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+func newAuditLogGameState(game *domain.Game) *auditLogGameState {
+	var visibility string
+	switch game.GetVisibility() {
+	case values.GameVisibilityTypePublic:
+		visibility = "public"
+	case values.GameVisibilityTypeLimited:
+		visibility = "limited"
+	case values.GameVisibilityTypePrivate:
+		visibility = "private"
+	default:
+		visibility = fmt.Sprintf("unknown(%d)", game.GetVisibility())
+	}
+
+	return &auditLogGameState{
+		Name:        string(game.GetName()),
+		Description: string(game.GetDescription()),
+		Visibility:  visibility,
+	}
+}
+
+type auditLogEditionState struct {
+	Name             string  `json:"name"`
+	QuestionnaireURL *string `json:"questionnaireUrl,omitempty"`
+}
+
+func newAuditLogEditionState(edition *domain.Edition) *auditLogEditionState {
+	state := &auditLogEditionState{
+		Name: string(edition.GetName()),
+	}
+
+	questionnaireURL, err := edition.GetQuestionnaireURL()
+	if err == nil {
+		urlStr := (*url.URL)(questionnaireURL).String()
+		state.QuestionnaireURL = &urlStr
+	}
+
+	return state
+}
+
+type auditLogEditionGameVersionsState struct {
+	GameVersionIDs []uuid.UUID `json:"gameVersionIds"`
+}
+
+func newAuditLogEditionGameVersionsState(gameVersionIDs []values.GameVersionID) *auditLogEditionGameVersionsState {
+	ids := make([]uuid.UUID, 0, len(gameVersionIDs))
+	for _, gameVersionID := range gameVersionIDs {
+		ids = append(ids, uuid.UUID(gameVersionID))
+	}
+
+	return &auditLogEditionGameVersionsState{
+		GameVersionIDs: ids,
+	}
+}
+
+type auditLogProductKeyState struct {
+	Status string `json:"status"`
+}
+
+func newAuditLogProductKeyState(status values.LauncherUserStatus) *auditLogProductKeyState {
+	var statusName string
+	switch status {
+	case values.LauncherUserStatusActive:
+		statusName = "active"
+	case values.LauncherUserStatusInactive:
+		statusName = "inactive"
+	default:
+		statusName = fmt.Sprintf("unknown(%d)", status)
+	}
+
+	return &auditLogProductKeyState{
+		Status: statusName,
+	}
+}
+
+type auditLogGameGenreState struct {
+	ID       uuid.UUID  `json:"id"`
+	Name     string     `json:"name"`
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
+	Aliases  []string   `json:"aliases,omitempty"`
+}
+
+func newAuditLogGameGenreState(genre *service.GameGenreInfo) *auditLogGameGenreState {
+	state := &auditLogGameGenreState{
+		ID:   uuid.UUID(genre.GetID()),
+		Name: string(genre.GetName()),
+	}
+
+	if genre.ParentID != nil {
+		parentID := uuid.UUID(*genre.ParentID)
+		state.ParentID = &parentID
+	}
+
+	for _, alias := range genre.Aliases {
+		state.Aliases = append(state.Aliases, string(alias.GetName()))
+	}
+
+	return state
+}
+
+type auditLogGameGenreMergeState struct {
+	Source *auditLogGameGenreState `json:"source"`
+	Target *auditLogGameGenreState `json:"target"`
+	// GameIDs
+	// 統合によってsourceからtargetに付け替えられたゲームのID。
+	GameIDs []uuid.UUID `json:"gameIds"`
+}
+
+func newAuditLogGameGenreMergeState(source *service.GameGenreInfo, target *service.GameGenreInfo, games []*domain.Game) *auditLogGameGenreMergeState {
+	gameIDs := make([]uuid.UUID, 0, len(games))
+	for _, game := range games {
+		gameIDs = append(gameIDs, uuid.UUID(game.GetID()))
+	}
+
+	return &auditLogGameGenreMergeState{
+		Source:  newAuditLogGameGenreState(source),
+		Target:  newAuditLogGameGenreState(target),
+		GameIDs: gameIDs,
+	}
+}
+
+type auditLogGameGenreParentState struct {
+	ParentID *uuid.UUID `json:"parentId"`
+}
+
+func newAuditLogGameGenreParentState(parentID *values.GameGenreID) *auditLogGameGenreParentState {
+	state := &auditLogGameGenreParentState{}
+	if parentID != nil {
+		id := uuid.UUID(*parentID)
+		state.ParentID = &id
+	}
+
+	return state
+}
+
+type auditLogGameGenreAliasState struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func newAuditLogGameGenreAliasState(alias *domain.GameGenreAlias) *auditLogGameGenreAliasState {
+	return &auditLogGameGenreAliasState{
+		ID:   uuid.UUID(alias.GetID()),
+		Name: string(alias.GetName()),
+	}
+}
+
+type auditLogGameStorageQuotaState struct {
+	Quota int64 `json:"quota"`
+}
+
+// newAuditLogGameStorageQuotaState
+// 上限が設定されていない場合はnilを返し、監査ログに状態を保存しない。
+func newAuditLogGameStorageQuotaState(quota option.Option[values.GameStorageSize]) any {
+	value, ok := quota.Value()
+	if !ok {
+		// *auditLogGameStorageQuotaStateのnilだとmarshalAuditLogStateでnilと判定されないので、型なしのnilを返す
+		return nil
+	}
+
+	return &auditLogGameStorageQuotaState{
+		Quota: int64(value),
+	}
+}
+
+type auditLogGameFileScanStatusState struct {
+	GameFileID uuid.UUID `json:"gameFileId"`
+	ScanStatus string    `json:"scanStatus"`
+}
+
+func newAuditLogGameFileScanStatusState(fileID values.GameFileID, scanStatus values.GameFileScanStatus) *auditLogGameFileScanStatusState {
+	var scanStatusName string
+	switch scanStatus {
+	case values.GameFileScanStatusPending:
+		scanStatusName = "pending"
+	case values.GameFileScanStatusClean:
+		scanStatusName = "clean"
+	case values.GameFileScanStatusRejected:
+		scanStatusName = "rejected"
+	case values.GameFileScanStatusFailed:
+		scanStatusName = "failed"
+	default:
+		scanStatusName = fmt.Sprintf("unknown(%d)", scanStatus)
+	}
+
+	return &auditLogGameFileScanStatusState{
+		GameFileID: uuid.UUID(fileID),
+		ScanStatus: scanStatusName,
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
@@ -20,6 +22,8 @@ type Edition struct {
 	gameRepository        repository.GameV2
 	gameVersionRepository repository.GameVersionV2
 	gameFileRepository    repository.GameFileV2
+	user                  *User
+	auditLog              *AuditLog
 }
 
 func NewEdition(
@@ -28,6 +32,8 @@ func NewEdition(
 	gameRepository repository.GameV2,
 	gameVersionRepository repository.GameVersionV2,
 	gameFileRepository repository.GameFileV2,
+	user *User,
+	auditLog *AuditLog,
 ) *Edition {
 	return &Edition{
 		db:                    db,
@@ -35,6 +41,8 @@ func NewEdition(
 		gameRepository:        gameRepository,
 		gameVersionRepository: gameVersionRepository,
 		gameFileRepository:    gameFileRepository,
+		user:                  user,
+		auditLog:              auditLog,
 	}
 }
 
@@ -44,6 +52,9 @@ func (edition *Edition) CreateEdition(
 	questionnaireURL option.Option[values.EditionQuestionnaireURL],
 	gameVersionIDs []values.GameVersionID,
 ) (*domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "Edition.CreateEdition")
+	defer span.End()
+
 	gameVersionMap := make(map[values.GameVersionID]struct{}, len(gameVersionIDs))
 	for _, gameVersionID := range gameVersionIDs {
 		if _, ok := gameVersionMap[gameVersionID]; ok {
@@ -99,6 +110,9 @@ func (edition *Edition) CreateEdition(
 }
 
 func (edition *Edition) GetEditions(ctx context.Context) ([]*domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "Edition.GetEditions")
+	defer span.End()
+
 	editions, err := edition.editionRepository.GetEditions(ctx, repository.LockTypeNone)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get editions: %w", err)
@@ -108,6 +122,9 @@ func (edition *Edition) GetEditions(ctx context.Context) ([]*domain.Edition, err
 }
 
 func (edition *Edition) GetEdition(ctx context.Context, editionID values.EditionID) (*domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "Edition.GetEdition")
+	defer span.End()
+
 	editionValue, err := edition.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidEditionID
@@ -121,10 +138,14 @@ func (edition *Edition) GetEdition(ctx context.Context, editionID values.Edition
 
 func (edition *Edition) UpdateEdition(
 	ctx context.Context,
+	session *domain.OIDCSession,
 	editionID values.EditionID,
 	name values.EditionName,
 	questionnaireURL option.Option[values.EditionQuestionnaireURL],
 ) (*domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "Edition.UpdateEdition")
+	defer span.End()
+
 	var editionValue *domain.Edition
 	err := edition.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		var err error
@@ -136,6 +157,8 @@ func (edition *Edition) UpdateEdition(
 			return fmt.Errorf("failed to get edition: %w", err)
 		}
 
+		before := newAuditLogEditionState(editionValue)
+
 		editionValue.SetName(name)
 
 		if url, ok := questionnaireURL.Value(); ok {
@@ -149,6 +172,20 @@ func (edition *Edition) UpdateEdition(
 			return fmt.Errorf("failed to save edition: %w", err)
 		}
 
+		myInfo, err := edition.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = edition.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionUpdateEdition, values.AuditLogTargetTypeEdition, uuid.UUID(editionID),
+			before, newAuditLogEditionState(editionValue),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -158,13 +195,45 @@ func (edition *Edition) UpdateEdition(
 	return editionValue, nil
 }
 
-func (edition *Edition) DeleteEdition(ctx context.Context, editionID values.EditionID) error {
-	err := edition.editionRepository.DeleteEdition(ctx, editionID)
-	if errors.Is(err, repository.ErrNoRecordDeleted) {
-		return service.ErrInvalidEditionID
-	}
+func (edition *Edition) DeleteEdition(ctx context.Context, session *domain.OIDCSession, editionID values.EditionID) error {
+	ctx, span := tracer.Start(ctx, "Edition.DeleteEdition")
+	defer span.End()
+
+	err := edition.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		editionValue, err := edition.editionRepository.GetEdition(ctx, editionID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidEditionID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get edition: %w", err)
+		}
+
+		err = edition.editionRepository.DeleteEdition(ctx, editionID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) {
+			return service.ErrInvalidEditionID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete edition: %w", err)
+		}
+
+		myInfo, err := edition.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = edition.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionDeleteEdition, values.AuditLogTargetTypeEdition, uuid.UUID(editionID),
+			newAuditLogEditionState(editionValue), nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete edition: %w", err)
+		return fmt.Errorf("failed in transaction: %w", err)
 	}
 
 	return nil
@@ -172,9 +241,13 @@ func (edition *Edition) DeleteEdition(ctx context.Context, editionID values.Edit
 
 func (edition *Edition) UpdateEditionGameVersions(
 	ctx context.Context,
+	session *domain.OIDCSession,
 	editionID values.EditionID,
 	gameVersionIDs []values.GameVersionID,
 ) ([]*service.GameVersionWithGame, error) {
+	ctx, span := tracer.Start(ctx, "Edition.UpdateEditionGameVersions")
+	defer span.End()
+
 	gameVersionMap := make(map[values.GameVersionID]struct{}, len(gameVersionIDs))
 	for _, gameVersionID := range gameVersionIDs {
 		if _, ok := gameVersionMap[gameVersionID]; ok {
@@ -250,34 +323,34 @@ func (edition *Edition) UpdateEditionGameVersions(
 			for _, id := range gameVersion.FileIDs {
 				file, ok := fileMap[id]
 				if !ok {
-					log.Printf("error: game file not found(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "game file not found", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				switch file.GetFileType() {
 				case values.GameFileTypeWindows:
 					if _, ok := assets.Windows.Value(); ok {
-						log.Printf("error: duplicate file type windows(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+						logger.Error(ctx, "duplicate file type windows", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 						continue
 					}
 
 					assets.Windows = option.NewOption(file.GetID())
 				case values.GameFileTypeMac:
 					if _, ok := assets.Mac.Value(); ok {
-						log.Printf("error: duplicate file type mac(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+						logger.Error(ctx, "duplicate file type mac", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 						continue
 					}
 
 					assets.Mac = option.NewOption(file.GetID())
 				case values.GameFileTypeJar:
 					if _, ok := assets.Jar.Value(); ok {
-						log.Printf("error: duplicate file type jar(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+						logger.Error(ctx, "duplicate file type jar", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 						continue
 					}
 
 					assets.Jar = option.NewOption(file.GetID())
 				default:
-					log.Printf("error: invalid game file type: game_id=%s, game_version_id=%s, game_file_id=%s, file_type=%d\n", gameVersion.GameID, gameVersion.GetID(), id, file.GetFileType())
+					logger.Error(ctx, "invalid game file type", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)), slog.Any("file_type", file.GetFileType()))
 					continue
 				}
 			}
@@ -293,11 +366,35 @@ func (edition *Edition) UpdateEditionGameVersions(
 			})
 		}
 
+		oldGameVersions, err := edition.editionRepository.GetEditionGameVersions(ctx, editionID, repository.LockTypeNone)
+		if err != nil {
+			return fmt.Errorf("failed to get edition game versions: %w", err)
+		}
+
+		oldGameVersionIDs := make([]values.GameVersionID, 0, len(oldGameVersions))
+		for _, oldGameVersion := range oldGameVersions {
+			oldGameVersionIDs = append(oldGameVersionIDs, oldGameVersion.GetID())
+		}
+
 		err = edition.editionRepository.UpdateEditionGameVersions(ctx, editionID, gameVersionIDs)
 		if err != nil {
 			return fmt.Errorf("failed to update edition game versions: %w", err)
 		}
 
+		myInfo, err := edition.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = edition.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionUpdateEditionGameVersions, values.AuditLogTargetTypeEdition, uuid.UUID(editionID),
+			newAuditLogEditionGameVersionsState(oldGameVersionIDs), newAuditLogEditionGameVersionsState(gameVersionIDs),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -308,6 +405,9 @@ func (edition *Edition) UpdateEditionGameVersions(
 }
 
 func (edition *Edition) GetEditionGameVersions(ctx context.Context, editionID values.EditionID) ([]*service.GameVersionWithGame, error) {
+	ctx, span := tracer.Start(ctx, "Edition.GetEditionGameVersions")
+	defer span.End()
+
 	_, err := edition.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidEditionID
@@ -361,34 +461,34 @@ func (edition *Edition) GetEditionGameVersions(ctx context.Context, editionID va
 		for _, id := range gameVersion.FileIDs {
 			file, ok := fileMap[id]
 			if !ok {
-				log.Printf("error: game file not found(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+				logger.Error(ctx, "game file not found", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 				continue
 			}
 
 			switch file.GetFileType() {
 			case values.GameFileTypeWindows:
 				if _, ok := assets.Windows.Value(); ok {
-					log.Printf("error: duplicate file type windows(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type windows", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Windows = option.NewOption(file.GetID())
 			case values.GameFileTypeMac:
 				if _, ok := assets.Mac.Value(); ok {
-					log.Printf("error: duplicate file type mac(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type mac", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Mac = option.NewOption(file.GetID())
 			case values.GameFileTypeJar:
 				if _, ok := assets.Jar.Value(); ok {
-					log.Printf("error: duplicate file type jar(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameVersion.GameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type jar", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Jar = option.NewOption(file.GetID())
 			default:
-				log.Printf("error: invalid game file type: game_id=%s, game_version_id=%s, game_file_id=%s, file_type=%d\n", gameVersion.GameID, gameVersion.GetID(), id, file.GetFileType())
+				logger.Error(ctx, "invalid game file type", slog.Any("game_id", uuid.UUID(gameVersion.GameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)), slog.Any("file_type", file.GetFileType()))
 				continue
 			}
 		}
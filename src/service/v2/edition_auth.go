@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -21,6 +23,9 @@ type EditionAuth struct {
 	editionRepository     repository.Edition
 	productKeyRepository  repository.ProductKey
 	accessTokenRepository repository.AccessToken
+	gameFileRepository    repository.GameFileV2
+	user                  *User
+	auditLog              *AuditLog
 }
 
 func NewEditionAuth(
@@ -28,16 +33,25 @@ func NewEditionAuth(
 	editionRepository repository.Edition,
 	productKeyRepository repository.ProductKey,
 	accessTokenRepository repository.AccessToken,
+	gameFileRepository repository.GameFileV2,
+	user *User,
+	auditLog *AuditLog,
 ) *EditionAuth {
 	return &EditionAuth{
 		db:                    db,
 		editionRepository:     editionRepository,
 		productKeyRepository:  productKeyRepository,
 		accessTokenRepository: accessTokenRepository,
+		gameFileRepository:    gameFileRepository,
+		user:                  user,
+		auditLog:              auditLog,
 	}
 }
 
 func (editionAuth *EditionAuth) GenerateProductKey(ctx context.Context, editionID values.EditionID, num uint) ([]*domain.LauncherUser, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.GenerateProductKey")
+	defer span.End()
+
 	if num == 0 {
 		return nil, service.ErrInvalidKeyNum
 	}
@@ -75,6 +89,9 @@ func (editionAuth *EditionAuth) GenerateProductKey(ctx context.Context, editionI
 }
 
 func (editionAuth *EditionAuth) GetProductKeys(ctx context.Context, editionID values.EditionID, params service.GetProductKeysParams) ([]*domain.LauncherUser, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.GetProductKeys")
+	defer span.End()
+
 	_, err := editionAuth.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidLauncherVersion
@@ -102,53 +119,112 @@ func (editionAuth *EditionAuth) GetProductKeys(ctx context.Context, editionID va
 	return productKeys, nil
 }
 
-func (editionAuth *EditionAuth) ActivateProductKey(ctx context.Context, productKeyID values.LauncherUserID) (*domain.LauncherUser, error) {
-	productKey, err := editionAuth.productKeyRepository.GetProductKey(ctx, productKeyID, repository.LockTypeNone)
-	if errors.Is(err, repository.ErrRecordNotFound) {
-		return nil, service.ErrInvalidProductKey
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to get launcher user: %w", err)
-	}
+func (editionAuth *EditionAuth) ActivateProductKey(ctx context.Context, session *domain.OIDCSession, productKeyID values.LauncherUserID) (*domain.LauncherUser, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.ActivateProductKey")
+	defer span.End()
 
-	if productKey.GetStatus() == values.LauncherUserStatusActive {
-		return nil, service.ErrKeyAlreadyActivated
-	}
+	var productKey *domain.LauncherUser
+	err := editionAuth.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		var err error
+		productKey, err = editionAuth.productKeyRepository.GetProductKey(ctx, productKeyID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidProductKey
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get launcher user: %w", err)
+		}
+
+		if productKey.GetStatus() == values.LauncherUserStatusActive {
+			return service.ErrKeyAlreadyActivated
+		}
 
-	productKey.SetStatus(values.LauncherUserStatusActive)
+		before := newAuditLogProductKeyState(productKey.GetStatus())
 
-	err = editionAuth.productKeyRepository.UpdateProductKey(ctx, productKey)
+		productKey.SetStatus(values.LauncherUserStatusActive)
+
+		err = editionAuth.productKeyRepository.UpdateProductKey(ctx, productKey)
+		if err != nil {
+			return fmt.Errorf("failed to update launcher user: %w", err)
+		}
+
+		myInfo, err := editionAuth.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = editionAuth.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionActivateProductKey, values.AuditLogTargetTypeProductKey, uuid.UUID(productKeyID),
+			before, newAuditLogProductKeyState(productKey.GetStatus()),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete launcher user: %w", err)
+		return nil, fmt.Errorf("failed in transaction: %w", err)
 	}
 
 	return productKey, nil
 }
 
-func (editionAuth *EditionAuth) RevokeProductKey(ctx context.Context, productKeyID values.LauncherUserID) (*domain.LauncherUser, error) {
-	productKey, err := editionAuth.productKeyRepository.GetProductKey(ctx, productKeyID, repository.LockTypeNone)
-	if errors.Is(err, repository.ErrRecordNotFound) {
-		return nil, service.ErrInvalidProductKey
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to get launcher user: %w", err)
-	}
+func (editionAuth *EditionAuth) RevokeProductKey(ctx context.Context, session *domain.OIDCSession, productKeyID values.LauncherUserID) (*domain.LauncherUser, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.RevokeProductKey")
+	defer span.End()
 
-	if productKey.GetStatus() == values.LauncherUserStatusInactive {
-		return nil, service.ErrKeyAlreadyRevoked
-	}
+	var productKey *domain.LauncherUser
+	err := editionAuth.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		var err error
+		productKey, err = editionAuth.productKeyRepository.GetProductKey(ctx, productKeyID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidProductKey
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get launcher user: %w", err)
+		}
+
+		if productKey.GetStatus() == values.LauncherUserStatusInactive {
+			return service.ErrKeyAlreadyRevoked
+		}
+
+		before := newAuditLogProductKeyState(productKey.GetStatus())
+
+		productKey.SetStatus(values.LauncherUserStatusInactive)
+
+		err = editionAuth.productKeyRepository.UpdateProductKey(ctx, productKey)
+		if err != nil {
+			return fmt.Errorf("failed to update launcher user: %w", err)
+		}
+
+		myInfo, err := editionAuth.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
 
-	productKey.SetStatus(values.LauncherUserStatusInactive)
+		err = editionAuth.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionRevokeProductKey, values.AuditLogTargetTypeProductKey, uuid.UUID(productKeyID),
+			before, newAuditLogProductKeyState(productKey.GetStatus()),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
 
-	err = editionAuth.productKeyRepository.UpdateProductKey(ctx, productKey)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete launcher user: %w", err)
+		return nil, fmt.Errorf("failed in transaction: %w", err)
 	}
 
 	return productKey, nil
 }
 
 func (editionAuth *EditionAuth) AuthorizeEdition(ctx context.Context, key values.LauncherUserProductKey) (*domain.LauncherSession, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.AuthorizeEdition")
+	defer span.End()
+
 	productKey, err := editionAuth.productKeyRepository.GetProductKeyByKey(ctx, key)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidProductKey
@@ -185,6 +261,9 @@ func getExpiresAt() time.Time {
 }
 
 func (editionAuth *EditionAuth) EditionAuth(ctx context.Context, token values.LauncherSessionAccessToken) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionAuth")
+	defer span.End()
+
 	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, token, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidAccessToken
@@ -205,6 +284,9 @@ func (editionAuth *EditionAuth) EditionAuth(ctx context.Context, token values.La
 }
 
 func (editionAuth *EditionAuth) EditionGameAuth(ctx context.Context, token values.LauncherSessionAccessToken, gameID values.GameID) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionGameAuth")
+	defer span.End()
+
 	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, token, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidAccessToken
@@ -233,6 +315,9 @@ func (editionAuth *EditionAuth) EditionGameAuth(ctx context.Context, token value
 }
 
 func (editionAuth *EditionAuth) EditionImageAuth(ctx context.Context, token values.LauncherSessionAccessToken, imageID values.GameImageID) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionImageAuth")
+	defer span.End()
+
 	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, token, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidAccessToken
@@ -261,6 +346,9 @@ func (editionAuth *EditionAuth) EditionImageAuth(ctx context.Context, token valu
 }
 
 func (editionAuth *EditionAuth) EditionVideoAuth(ctx context.Context, token values.LauncherSessionAccessToken, videoID values.GameVideoID) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionVideoAuth")
+	defer span.End()
+
 	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, token, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidAccessToken
@@ -289,6 +377,9 @@ func (editionAuth *EditionAuth) EditionVideoAuth(ctx context.Context, token valu
 }
 
 func (editionAuth *EditionAuth) EditionFileAuth(ctx context.Context, accessToken values.LauncherSessionAccessToken, fileID values.GameFileID) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionFileAuth")
+	defer span.End()
+
 	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, accessToken, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidAccessToken
@@ -313,5 +404,53 @@ func (editionAuth *EditionAuth) EditionFileAuth(ctx context.Context, accessToken
 		return nil, nil, fmt.Errorf("failed to get launcher version and user and session: %w", err)
 	}
 
+	// ゲームバージョンへの紐づけ後に検査で問題が見つかったファイルや、
+	// 検査の導入前に紐づけられた未検査のファイルは配布しない
+	file, err := editionAuth.gameFileRepository.GetGameFile(ctx, fileID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, nil, service.ErrForbidden
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get game file: %w", err)
+	}
+
+	if file.GetScanStatus() != values.GameFileScanStatusClean {
+		return nil, nil, service.ErrGameFileNotClean
+	}
+
+	return accessTokenInfo.ProductKey, accessTokenInfo.Edition, nil
+}
+
+func (editionAuth *EditionAuth) EditionGameVersionAuth(ctx context.Context, accessToken values.LauncherSessionAccessToken, gameVersionID values.GameVersionID) (*domain.LauncherUser, *domain.Edition, error) {
+	ctx, span := tracer.Start(ctx, "EditionAuth.EditionGameVersionAuth")
+	defer span.End()
+
+	accessTokenInfo, err := editionAuth.accessTokenRepository.GetAccessTokenInfo(ctx, accessToken, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, nil, service.ErrInvalidAccessToken
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get launcher version and user and session: %w", err)
+	}
+
+	if accessTokenInfo.ProductKey.GetStatus() == values.LauncherUserStatusInactive {
+		return nil, nil, service.ErrInvalidAccessToken
+	}
+
+	if accessTokenInfo.AccessToken.IsExpired() {
+		return nil, nil, service.ErrExpiredAccessToken
+	}
+
+	gameVersions, err := editionAuth.editionRepository.GetEditionGameVersions(ctx, accessTokenInfo.Edition.GetID(), repository.LockTypeNone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get edition game versions: %w", err)
+	}
+
+	if !slices.ContainsFunc(gameVersions, func(gameVersion *repository.GameVersionInfoWithGameID) bool {
+		return gameVersion.GetID() == gameVersionID
+	}) {
+		return nil, nil, service.ErrForbidden
+	}
+
 	return accessTokenInfo.ProductKey, accessTokenInfo.Edition, nil
 }
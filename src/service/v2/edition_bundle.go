@@ -0,0 +1,710 @@
+package v2
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ service.EditionBundle = &EditionBundle{}
+
+const (
+	editionBundleManifestName          = "manifest.json"
+	editionBundleManifestSignatureName = "manifest.json.sig"
+	editionBundleChecksumsName         = "SHA256SUMS"
+	// editionBundleTmpURLExpires
+	// バンドルは大きくなりやすいので、ダウンロードに時間がかかっても切れないように長めにしておく。
+	editionBundleTmpURLExpires = time.Hour
+	// editionBundleHeartbeatInterval
+	// 生成中のバンドルについて、生存を報告する間隔。
+	editionBundleHeartbeatInterval = 30 * time.Second
+	// editionBundleStaleTimeout
+	// 生存の報告がこの時間途絶えた生成中のバンドルは、生成中にサーバーが停止したものとして扱う。
+	// サーバー間の時刻のずれや、DBの一時的な障害で報告が遅れても誤判定しないよう、報告の間隔より十分長くしておく。
+	editionBundleStaleTimeout = 5 * time.Minute
+)
+
+// errEditionBundleLost
+// 生成中のバンドルが、他のサーバーによって失敗扱いにされたことを表す。
+var errEditionBundleLost = errors.New("edition bundle is no longer owned by this server")
+
+type EditionBundle struct {
+	editionRepository       repository.Edition
+	editionBundleRepository repository.EditionBundle
+	gameImageRepository     repository.GameImageV2
+	gameVideoRepository     repository.GameVideoV2
+	gameFileRepository      repository.GameFileV2
+	gameImageStorage        storage.GameImage
+	gameVideoStorage        storage.GameVideo
+	gameFileStorage         storage.GameFile
+	editionBundleStorage    storage.EditionBundle
+	// signingKey
+	// マニフェストの署名に使う秘密鍵。
+	// 設定されていない場合はnilになり、バンドルを生成できない。
+	signingKey ed25519.PrivateKey
+	// owner
+	// このサーバーを表すID。
+	// 複数のサーバーで動かしたときに、どのサーバーがバンドルを生成しているかを区別するために使う。
+	owner uuid.UUID
+}
+
+func NewEditionBundle(
+	conf config.ServiceV2,
+	editionRepository repository.Edition,
+	editionBundleRepository repository.EditionBundle,
+	gameImageRepository repository.GameImageV2,
+	gameVideoRepository repository.GameVideoV2,
+	gameFileRepository repository.GameFileV2,
+	gameImageStorage storage.GameImage,
+	gameVideoStorage storage.GameVideo,
+	gameFileStorage storage.GameFile,
+	editionBundleStorage storage.EditionBundle,
+) (*EditionBundle, error) {
+	signingKey, ok, err := conf.EditionBundleSigningKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundle signing key: %w", err)
+	}
+	if !ok {
+		slog.Warn("edition bundle signing key is not set. edition bundles are unavailable.")
+		signingKey = nil
+	}
+
+	return &EditionBundle{
+		editionRepository:       editionRepository,
+		editionBundleRepository: editionBundleRepository,
+		gameImageRepository:     gameImageRepository,
+		gameVideoRepository:     gameVideoRepository,
+		gameFileRepository:      gameFileRepository,
+		gameImageStorage:        gameImageStorage,
+		gameVideoStorage:        gameVideoStorage,
+		gameFileStorage:         gameFileStorage,
+		editionBundleStorage:    editionBundleStorage,
+		signingKey:              signingKey,
+		owner:                   uuid.New(),
+	}, nil
+}
+
+func (editionBundle *EditionBundle) CreateEditionBundle(ctx context.Context, editionID values.EditionID) (*domain.EditionBundle, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.CreateEditionBundle")
+	defer span.End()
+
+	if editionBundle.signingKey == nil {
+		return nil, service.ErrEditionBundleUnavailable
+	}
+
+	_, err := editionBundle.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidEditionID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition: %w", err)
+	}
+
+	bundle := domain.NewEditionBundle(
+		values.NewEditionBundleID(),
+		editionID,
+		values.EditionBundleStatusPending,
+		time.Now(),
+	)
+
+	err = editionBundle.editionBundleRepository.SaveEditionBundle(ctx, bundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save edition bundle: %w", err)
+	}
+
+	return bundle, nil
+}
+
+func (editionBundle *EditionBundle) GetEditionBundles(ctx context.Context, editionID values.EditionID) ([]*domain.EditionBundle, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.GetEditionBundles")
+	defer span.End()
+
+	_, err := editionBundle.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidEditionID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition: %w", err)
+	}
+
+	bundles, err := editionBundle.editionBundleRepository.GetEditionBundles(ctx, editionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundles: %w", err)
+	}
+
+	return bundles, nil
+}
+
+func (editionBundle *EditionBundle) GetEditionBundle(ctx context.Context, editionID values.EditionID, bundleID values.EditionBundleID) (*domain.EditionBundle, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.GetEditionBundle")
+	defer span.End()
+
+	bundle, err := editionBundle.editionBundleRepository.GetEditionBundle(ctx, bundleID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidEditionBundleID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundle: %w", err)
+	}
+
+	if bundle.GetEditionID() != editionID {
+		return nil, service.ErrInvalidEditionBundleID
+	}
+
+	return bundle, nil
+}
+
+func (editionBundle *EditionBundle) GetEditionBundleURL(ctx context.Context, editionID values.EditionID, bundleID values.EditionBundleID) (values.EditionBundleTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.GetEditionBundleURL")
+	defer span.End()
+
+	bundle, err := editionBundle.GetEditionBundle(ctx, editionID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if bundle.GetStatus() != values.EditionBundleStatusCompleted {
+		return nil, service.ErrEditionBundleNotCompleted
+	}
+
+	tmpURL, err := editionBundle.editionBundleStorage.GetTempURL(ctx, bundle, editionBundleTmpURLExpires)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition bundle temp url: %w", err)
+	}
+
+	return tmpURL, nil
+}
+
+func (editionBundle *EditionBundle) BuildEditionBundles(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "EditionBundle.BuildEditionBundles")
+	defer span.End()
+
+	// 複数のサーバーで動かしている場合、生成中のバンドルは他のサーバーが生成している可能性がある。
+	// そのため、生存の報告が途絶えたものだけを、生成中にサーバーが停止したものとして失敗扱いにする。
+	staleCount, err := editionBundle.editionBundleRepository.FailStaleEditionBundles(ctx, time.Now().Add(-editionBundleStaleTimeout))
+	if err != nil {
+		return fmt.Errorf("failed to fail stale edition bundles: %w", err)
+	}
+	if staleCount != 0 {
+		logger.Warn(ctx, "edition bundle builds were interrupted", slog.Int("count", staleCount))
+	}
+
+	bundles, err := editionBundle.editionBundleRepository.GetEditionBundlesByStatus(ctx, values.EditionBundleStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to get pending edition bundles: %w", err)
+	}
+
+	for _, bundle := range bundles {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context done: %w", err)
+		}
+
+		// 取得してから生成を始めるまでに他のサーバーが生成を始めている可能性があるので、
+		// 生成を始められたバンドルのみ生成する
+		err := editionBundle.editionBundleRepository.ClaimEditionBundle(ctx, bundle.GetID(), editionBundle.owner, time.Now())
+		if errors.Is(err, repository.ErrNoRecordUpdated) {
+			continue
+		}
+		if err != nil {
+			logger.Error(ctx, "failed to claim edition bundle", slog.Any("edition_bundle_id", uuid.UUID(bundle.GetID())), slog.Any("error", err))
+			continue
+		}
+		bundle.SetStatus(values.EditionBundleStatusBuilding)
+
+		if editionBundle.signingKey == nil {
+			logger.Error(ctx, "edition bundle signing key is not set", slog.Any("edition_bundle_id", uuid.UUID(bundle.GetID())))
+			editionBundle.markEditionBundleFailed(ctx, bundle)
+			continue
+		}
+
+		err = editionBundle.buildEditionBundle(ctx, bundle)
+		if errors.Is(err, errEditionBundleLost) {
+			// 既に他のサーバーによって失敗扱いにされているので、状態は更新しない
+			logger.Warn(ctx, "edition bundle build was taken over", slog.Any("edition_bundle_id", uuid.UUID(bundle.GetID())))
+			continue
+		}
+		if err != nil {
+			logger.Error(ctx, "failed to build edition bundle", slog.Any("edition_bundle_id", uuid.UUID(bundle.GetID())), slog.Any("error", err))
+			editionBundle.markEditionBundleFailed(ctx, bundle)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (editionBundle *EditionBundle) markEditionBundleFailed(ctx context.Context, bundle *domain.EditionBundle) {
+	bundle.SetStatus(values.EditionBundleStatusFailed)
+	err := editionBundle.updateEditionBundle(ctx, bundle)
+	if err != nil {
+		logger.Error(ctx, "failed to update edition bundle status", slog.Any("edition_bundle_id", uuid.UUID(bundle.GetID())), slog.Any("error", err))
+	}
+}
+
+// updateEditionBundle
+// このサーバーが生成中のバンドルを更新する。
+// 他のサーバーによって失敗扱いにされている場合、errEditionBundleLostを返す。
+func (editionBundle *EditionBundle) updateEditionBundle(ctx context.Context, bundle *domain.EditionBundle) error {
+	err := editionBundle.editionBundleRepository.UpdateOwnedEditionBundle(ctx, bundle, editionBundle.owner, time.Now())
+	if errors.Is(err, repository.ErrNoRecordUpdated) {
+		return errEditionBundleLost
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update edition bundle: %w", err)
+	}
+
+	return nil
+}
+
+// keepEditionBundleAlive
+// ctxが終わるまで、生成中のバンドルについて定期的に生存を報告する。
+// 他のサーバーによって失敗扱いにされていた場合は、errEditionBundleLostでcancelを呼んで生成をやめさせる。
+func (editionBundle *EditionBundle) keepEditionBundleAlive(ctx context.Context, cancel context.CancelCauseFunc, bundleID values.EditionBundleID) {
+	ticker := time.NewTicker(editionBundleHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := editionBundle.editionBundleRepository.HeartbeatEditionBundle(ctx, bundleID, editionBundle.owner, time.Now())
+		if errors.Is(err, repository.ErrNoRecordUpdated) {
+			cancel(errEditionBundleLost)
+			return
+		}
+		if err != nil {
+			// 一時的な失敗であれば次の報告で回復するので、生成は続ける
+			logger.Error(ctx, "failed to update edition bundle heartbeat", slog.Any("edition_bundle_id", uuid.UUID(bundleID)), slog.Any("error", err))
+		}
+	}
+}
+
+// editionBundleManifest
+// バンドルに含めるマニフェスト。
+// editionは/editions/infoのレスポンスと同じ形式にしてある。
+type editionBundleManifest struct {
+	Edition   editionBundleManifestEdition `json:"edition"`
+	Games     []*editionBundleManifestGame `json:"games"`
+	CreatedAt time.Time                    `json:"createdAt"`
+}
+
+type editionBundleManifestEdition struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	Questionnaire *string   `json:"questionnaire,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type editionBundleManifestGame struct {
+	ID      uuid.UUID                        `json:"id"`
+	Version editionBundleManifestGameVersion `json:"version"`
+	Image   *editionBundleManifestAsset      `json:"image"`
+	Video   *editionBundleManifestAsset      `json:"video"`
+	Files   []*editionBundleManifestGameFile `json:"files"`
+}
+
+type editionBundleManifestGameVersion struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	URL         *string   `json:"url,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type editionBundleManifestAsset struct {
+	ID     uuid.UUID `json:"id"`
+	Type   string    `json:"type"`
+	Path   string    `json:"path"`
+	Size   int64     `json:"size"`
+	SHA256 string    `json:"sha256"`
+}
+
+type editionBundleManifestGameFile struct {
+	editionBundleManifestAsset
+	EntryPoint string `json:"entryPoint"`
+	// MD5 ランチャーがダウンロードしたファイルの確認に使うハッシュ値と同じもの
+	MD5 string `json:"md5"`
+}
+
+// editionBundleSignature
+// manifest.jsonの署名。
+// 公開鍵も含めておき、ランチャー側で信頼している公開鍵と一致するか確認してもらう。
+type editionBundleSignature struct {
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature"`
+}
+
+// editionBundleItem
+// バンドルに書き込む画像・動画・ファイル。
+// 書き込み後にassetのサイズとハッシュ値を埋める。
+type editionBundleItem struct {
+	asset *editionBundleManifestAsset
+	load  func(ctx context.Context, writer io.Writer) error
+}
+
+func (editionBundle *EditionBundle) buildEditionBundle(ctx context.Context, bundle *domain.EditionBundle) (err error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		editionBundle.keepEditionBundleAlive(ctx, cancel, bundle.GetID())
+	})
+	defer func() {
+		// 生存の報告で失敗扱いにされていたことが分かった場合、途中で起きたエラーよりもそちらを優先して返す
+		if err != nil && errors.Is(context.Cause(ctx), errEditionBundleLost) {
+			err = errEditionBundleLost
+		}
+		cancel(nil)
+		wg.Wait()
+	}()
+
+	manifest, items, err := editionBundle.collectEditionBundleItems(ctx, bundle.GetEditionID())
+	if err != nil {
+		return fmt.Errorf("failed to collect edition bundle items: %w", err)
+	}
+	manifest.CreatedAt = bundle.GetCreatedAt()
+
+	bundle.SetProgress(0, len(items))
+	err = editionBundle.updateEditionBundle(ctx, bundle)
+	if err != nil {
+		return fmt.Errorf("failed to update edition bundle progress: %w", err)
+	}
+
+	f, err := os.CreateTemp("", "edition_bundle")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = editionBundle.writeEditionBundle(ctx, f, bundle, manifest, items)
+	if err != nil {
+		return fmt.Errorf("failed to write edition bundle: %w", err)
+	}
+
+	fInfo, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to get file info: %w", err)
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("failed to seek temp file: %w", err)
+	}
+
+	err = editionBundle.editionBundleStorage.SaveEditionBundle(ctx, f, bundle.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to save edition bundle: %w", err)
+	}
+
+	bundle.SetSize(values.GameStorageSize(fInfo.Size()))
+	bundle.SetStatus(values.EditionBundleStatusCompleted)
+	err = editionBundle.updateEditionBundle(ctx, bundle)
+	if err != nil {
+		return fmt.Errorf("failed to update edition bundle: %w", err)
+	}
+
+	return nil
+}
+
+// collectEditionBundleItems
+// エディションに含まれるゲームバージョンの画像・動画・ファイルを集め、マニフェストの雛形を作る。
+// ファイルはランチャーと同じく、検査を通過したもののみ含める。
+func (editionBundle *EditionBundle) collectEditionBundleItems(ctx context.Context, editionID values.EditionID) (*editionBundleManifest, []*editionBundleItem, error) {
+	edition, err := editionBundle.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get edition: %w", err)
+	}
+
+	var questionnaire *string
+	questionnaireURL, err := edition.GetQuestionnaireURL()
+	if err != nil && !errors.Is(err, domain.ErrNoQuestionnaire) {
+		return nil, nil, fmt.Errorf("failed to get questionnaire url: %w", err)
+	}
+	if err == nil {
+		v := (*url.URL)(questionnaireURL).String()
+		questionnaire = &v
+	}
+
+	gameVersions, err := editionBundle.editionRepository.GetEditionGameVersions(ctx, editionID, repository.LockTypeNone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get edition game versions: %w", err)
+	}
+
+	manifest := &editionBundleManifest{
+		Edition: editionBundleManifestEdition{
+			ID:            uuid.UUID(edition.GetID()),
+			Name:          string(edition.GetName()),
+			Questionnaire: questionnaire,
+			CreatedAt:     edition.GetCreatedAt(),
+		},
+		Games: make([]*editionBundleManifestGame, 0, len(gameVersions)),
+	}
+	items := make([]*editionBundleItem, 0, len(gameVersions)*2)
+	for _, gameVersion := range gameVersions {
+		gameDir := fmt.Sprintf("games/%s", uuid.UUID(gameVersion.GameID))
+
+		var versionURL *string
+		if urlLink, ok := gameVersion.URL.Value(); ok {
+			v := (*url.URL)(urlLink).String()
+			versionURL = &v
+		}
+
+		image, err := editionBundle.gameImageRepository.GetGameImage(ctx, gameVersion.ImageID, repository.LockTypeNone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get game image: %w", err)
+		}
+
+		imageType, ext := editionBundleImageType(image.GetType())
+		imageAsset := &editionBundleManifestAsset{
+			ID:   uuid.UUID(image.GetID()),
+			Type: imageType,
+			Path: fmt.Sprintf("%s/images/%s%s", gameDir, uuid.UUID(image.GetID()), ext),
+		}
+		imageID := image.GetID()
+		items = append(items, &editionBundleItem{
+			asset: imageAsset,
+			load: func(ctx context.Context, writer io.Writer) error {
+				return editionBundle.gameImageStorage.LoadGameImage(ctx, writer, imageID)
+			},
+		})
+
+		video, err := editionBundle.gameVideoRepository.GetGameVideo(ctx, gameVersion.VideoID, repository.LockTypeNone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get game video: %w", err)
+		}
+
+		videoType, ext := editionBundleVideoType(video.GetType())
+		videoAsset := &editionBundleManifestAsset{
+			ID:   uuid.UUID(video.GetID()),
+			Type: videoType,
+			Path: fmt.Sprintf("%s/videos/%s%s", gameDir, uuid.UUID(video.GetID()), ext),
+		}
+		videoID := video.GetID()
+		items = append(items, &editionBundleItem{
+			asset: videoAsset,
+			load: func(ctx context.Context, writer io.Writer) error {
+				return editionBundle.gameVideoStorage.LoadGameVideo(ctx, writer, videoID)
+			},
+		})
+
+		var fileInfos []*repository.GameFileInfo
+		if len(gameVersion.FileIDs) != 0 {
+			fileInfos, err = editionBundle.gameFileRepository.GetGameFilesWithoutTypes(ctx, gameVersion.FileIDs, repository.LockTypeNone)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get game files: %w", err)
+			}
+		}
+
+		files := make([]*editionBundleManifestGameFile, 0, len(fileInfos))
+		for _, fileInfo := range fileInfos {
+			if fileInfo.GetScanStatus() != values.GameFileScanStatusClean {
+				continue
+			}
+
+			file := &editionBundleManifestGameFile{
+				editionBundleManifestAsset: editionBundleManifestAsset{
+					ID:   uuid.UUID(fileInfo.GetID()),
+					Type: editionBundleFileType(fileInfo.GetFileType()),
+					Path: fmt.Sprintf("%s/files/%s.zip", gameDir, uuid.UUID(fileInfo.GetID())),
+				},
+				EntryPoint: string(fileInfo.GetEntryPoint()),
+				MD5:        fileInfo.GetHash().String(),
+			}
+			files = append(files, file)
+
+			gameFile := fileInfo.GameFile
+			items = append(items, &editionBundleItem{
+				asset: &file.editionBundleManifestAsset,
+				load: func(ctx context.Context, writer io.Writer) error {
+					return editionBundle.gameFileStorage.LoadGameFile(ctx, writer, gameFile)
+				},
+			})
+		}
+
+		manifest.Games = append(manifest.Games, &editionBundleManifestGame{
+			ID: uuid.UUID(gameVersion.GameID),
+			Version: editionBundleManifestGameVersion{
+				ID:          uuid.UUID(gameVersion.GetID()),
+				Name:        string(gameVersion.GetName()),
+				Description: string(gameVersion.GetDescription()),
+				URL:         versionURL,
+				CreatedAt:   gameVersion.GetCreatedAt(),
+			},
+			Image: imageAsset,
+			Video: videoAsset,
+			Files: files,
+		})
+	}
+
+	return manifest, items, nil
+}
+
+// writeEditionBundle
+// 画像・動画・ファイル、マニフェストとその署名、チェックサムの一覧をzipに書き込む。
+// 書き込むたびに進捗を更新する。
+func (editionBundle *EditionBundle) writeEditionBundle(
+	ctx context.Context,
+	writer io.Writer,
+	bundle *domain.EditionBundle,
+	manifest *editionBundleManifest,
+	items []*editionBundleItem,
+) error {
+	zipWriter := zip.NewWriter(writer)
+
+	checksums := &strings.Builder{}
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context done: %w", err)
+		}
+
+		// 画像・動画・ゲームファイルは既に圧縮されているので、圧縮せずに格納する
+		w, err := zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     item.asset.Path,
+			Method:   zip.Store,
+			Modified: manifest.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry(%s): %w", item.asset.Path, err)
+		}
+
+		hash := sha256.New()
+		counter := &editionBundleCountWriter{}
+		err = item.load(ctx, io.MultiWriter(w, hash, counter))
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %w", item.asset.Path, err)
+		}
+
+		item.asset.Size = counter.size
+		item.asset.SHA256 = hex.EncodeToString(hash.Sum(nil))
+		fmt.Fprintf(checksums, "%s  %s\n", item.asset.SHA256, item.asset.Path)
+
+		bundle.SetProgress(i+1, len(items))
+		err = editionBundle.updateEditionBundle(ctx, bundle)
+		if err != nil {
+			return fmt.Errorf("failed to update edition bundle progress: %w", err)
+		}
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	signatureJSON, err := json.MarshalIndent(editionBundleSignature{
+		Algorithm: "ed25519",
+		PublicKey: base64.StdEncoding.EncodeToString(editionBundle.signingKey.Public().(ed25519.PublicKey)),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(editionBundle.signingKey, manifestJSON)),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal signature: %w", err)
+	}
+
+	manifestHash := sha256.Sum256(manifestJSON)
+	fmt.Fprintf(checksums, "%s  %s\n", hex.EncodeToString(manifestHash[:]), editionBundleManifestName)
+
+	for _, entry := range []struct {
+		name    string
+		content []byte
+	}{
+		{name: editionBundleManifestName, content: manifestJSON},
+		{name: editionBundleManifestSignatureName, content: signatureJSON},
+		{name: editionBundleChecksumsName, content: []byte(checksums.String())},
+	} {
+		w, err := zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: manifest.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry(%s): %w", entry.name, err)
+		}
+
+		_, err = w.Write(entry.content)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", entry.name, err)
+		}
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close zip writer: %w", err)
+	}
+
+	return nil
+}
+
+type editionBundleCountWriter struct {
+	size int64
+}
+
+func (w *editionBundleCountWriter) Write(p []byte) (int, error) {
+	w.size += int64(len(p))
+	return len(p), nil
+}
+
+func editionBundleImageType(imageType values.GameImageType) (string, string) {
+	switch imageType {
+	case values.GameImageTypeJpeg:
+		return "jpeg", ".jpg"
+	case values.GameImageTypePng:
+		return "png", ".png"
+	case values.GameImageTypeGif:
+		return "gif", ".gif"
+	case values.GameImageTypeWebp:
+		return "webp", ".webp"
+	default:
+		return "", ""
+	}
+}
+
+func editionBundleVideoType(videoType values.GameVideoType) (string, string) {
+	switch videoType {
+	case values.GameVideoTypeMp4:
+		return "mp4", ".mp4"
+	case values.GameVideoTypeM4v:
+		return "m4v", ".m4v"
+	case values.GameVideoTypeMkv:
+		return "mkv", ".mkv"
+	default:
+		return "", ""
+	}
+}
+
+func editionBundleFileType(fileType values.GameFileType) string {
+	switch fileType {
+	case values.GameFileTypeJar:
+		return "jar"
+	case values.GameFileTypeWindows:
+		return "win32"
+	case values.GameFileTypeMac:
+		return "darwin"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,736 @@
+package v2
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+var testEditionBundleSigningKey = ed25519.NewKeyFromSeed(bytes.Repeat([]byte{0x01}, ed25519.SeedSize))
+
+func newEditionBundleConfig(ctrl *gomock.Controller, hasSigningKey bool) *mockConfig.MockServiceV2 {
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
+	if hasSigningKey {
+		mockConf.
+			EXPECT().
+			EditionBundleSigningKey().
+			Return(testEditionBundleSigningKey, true, nil)
+	} else {
+		mockConf.
+			EXPECT().
+			EditionBundleSigningKey().
+			Return(nil, false, nil)
+	}
+
+	return mockConf
+}
+
+type editionBundleMocks struct {
+	editionRepository       *mockRepository.MockEdition
+	editionBundleRepository *mockRepository.MockEditionBundle
+	gameImageRepository     *mockRepository.MockGameImageV2
+	gameVideoRepository     *mockRepository.MockGameVideoV2
+	gameFileRepository      *mockRepository.MockGameFileV2
+	gameImageStorage        *mockStorage.GameImage
+	gameVideoStorage        *mockStorage.GameVideo
+	gameFileStorage         *mockStorage.GameFile
+	editionBundleStorage    *mockStorage.EditionBundle
+}
+
+func newEditionBundleService(t *testing.T, ctrl *gomock.Controller, hasSigningKey bool, buf *bytes.Buffer) (*EditionBundle, *editionBundleMocks) {
+	t.Helper()
+
+	mocks := &editionBundleMocks{
+		editionRepository:       mockRepository.NewMockEdition(ctrl),
+		editionBundleRepository: mockRepository.NewMockEditionBundle(ctrl),
+		gameImageRepository:     mockRepository.NewMockGameImageV2(ctrl),
+		gameVideoRepository:     mockRepository.NewMockGameVideoV2(ctrl),
+		gameFileRepository:      mockRepository.NewMockGameFileV2(ctrl),
+		gameImageStorage:        mockStorage.NewGameImage(ctrl, nil),
+		gameVideoStorage:        mockStorage.NewGameVideo(ctrl, nil),
+		gameFileStorage:         mockStorage.NewGameFile(ctrl, nil),
+		editionBundleStorage:    mockStorage.NewEditionBundle(ctrl, buf),
+	}
+
+	editionBundleService, err := NewEditionBundle(
+		newEditionBundleConfig(ctrl, hasSigningKey),
+		mocks.editionRepository,
+		mocks.editionBundleRepository,
+		mocks.gameImageRepository,
+		mocks.gameVideoRepository,
+		mocks.gameFileRepository,
+		mocks.gameImageStorage,
+		mocks.gameVideoStorage,
+		mocks.gameFileStorage,
+		mocks.editionBundleStorage,
+	)
+	require.NoError(t, err)
+
+	return editionBundleService, mocks
+}
+
+func TestCreateEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description       string
+		hasSigningKey     bool
+		executeGetEdition bool
+		getEditionErr     error
+		executeSave       bool
+		saveErr           error
+		isErr             bool
+		err               error
+	}
+
+	testCases := []test{
+		{
+			description:       "特に問題ないのでエラー無し",
+			hasSigningKey:     true,
+			executeGetEdition: true,
+			executeSave:       true,
+		},
+		{
+			description:   "署名鍵が設定されていないのでErrEditionBundleUnavailable",
+			hasSigningKey: false,
+			isErr:         true,
+			err:           service.ErrEditionBundleUnavailable,
+		},
+		{
+			description:       "エディションが存在しないのでErrInvalidEditionID",
+			hasSigningKey:     true,
+			executeGetEdition: true,
+			getEditionErr:     repository.ErrRecordNotFound,
+			isErr:             true,
+			err:               service.ErrInvalidEditionID,
+		},
+		{
+			description:       "GetEditionがエラーなのでエラー",
+			hasSigningKey:     true,
+			executeGetEdition: true,
+			getEditionErr:     errors.New("error"),
+			isErr:             true,
+		},
+		{
+			description:       "SaveEditionBundleがエラーなのでエラー",
+			hasSigningKey:     true,
+			executeGetEdition: true,
+			executeSave:       true,
+			saveErr:           errors.New("error"),
+			isErr:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			editionBundleService, mocks := newEditionBundleService(t, ctrl, testCase.hasSigningKey, nil)
+
+			editionID := values.NewEditionID()
+
+			if testCase.executeGetEdition {
+				mocks.editionRepository.
+					EXPECT().
+					GetEdition(gomock.Any(), editionID, repository.LockTypeNone).
+					Return(domain.NewEditionWithoutQuestionnaire(editionID, values.NewEditionName("test"), time.Now()), testCase.getEditionErr)
+			}
+
+			if testCase.executeSave {
+				mocks.editionBundleRepository.
+					EXPECT().
+					SaveEditionBundle(gomock.Any(), gomock.Any()).
+					Return(testCase.saveErr)
+			}
+
+			bundle, err := editionBundleService.CreateEditionBundle(ctx, editionID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, editionID, bundle.GetEditionID())
+			assert.Equal(t, values.EditionBundleStatusPending, bundle.GetStatus())
+			assert.WithinDuration(t, time.Now(), bundle.GetCreatedAt(), time.Second)
+		})
+	}
+}
+
+func TestGetEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description       string
+		getEditionErr     error
+		executeGetBundles bool
+		bundles           []*domain.EditionBundle
+		getBundlesErr     error
+		isErr             bool
+		err               error
+	}
+
+	editionID := values.NewEditionID()
+	bundle := domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusCompleted, time.Now())
+
+	testCases := []test{
+		{
+			description:       "特に問題ないのでエラー無し",
+			executeGetBundles: true,
+			bundles:           []*domain.EditionBundle{bundle},
+		},
+		{
+			description:       "バンドルが存在しなくてもエラー無し",
+			executeGetBundles: true,
+			bundles:           []*domain.EditionBundle{},
+		},
+		{
+			description:   "エディションが存在しないのでErrInvalidEditionID",
+			getEditionErr: repository.ErrRecordNotFound,
+			isErr:         true,
+			err:           service.ErrInvalidEditionID,
+		},
+		{
+			description:   "GetEditionがエラーなのでエラー",
+			getEditionErr: errors.New("error"),
+			isErr:         true,
+		},
+		{
+			description:       "GetEditionBundlesがエラーなのでエラー",
+			executeGetBundles: true,
+			getBundlesErr:     errors.New("error"),
+			isErr:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			editionBundleService, mocks := newEditionBundleService(t, ctrl, true, nil)
+
+			mocks.editionRepository.
+				EXPECT().
+				GetEdition(gomock.Any(), editionID, repository.LockTypeNone).
+				Return(domain.NewEditionWithoutQuestionnaire(editionID, values.NewEditionName("test"), time.Now()), testCase.getEditionErr)
+
+			if testCase.executeGetBundles {
+				mocks.editionBundleRepository.
+					EXPECT().
+					GetEditionBundles(gomock.Any(), editionID).
+					Return(testCase.bundles, testCase.getBundlesErr)
+			}
+
+			bundles, err := editionBundleService.GetEditionBundles(ctx, editionID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.bundles, bundles)
+		})
+	}
+}
+
+func TestGetEditionBundleURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description       string
+		bundle            *domain.EditionBundle
+		getBundleErr      error
+		executeGetTempURL bool
+		getTempURLErr     error
+		isErr             bool
+		err               error
+	}
+
+	editionID := values.NewEditionID()
+	urlLink, err := url.Parse("https://example.com/bundle")
+	require.NoError(t, err)
+
+	testCases := []test{
+		{
+			description:       "特に問題ないのでエラー無し",
+			bundle:            domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusCompleted, time.Now()),
+			executeGetTempURL: true,
+		},
+		{
+			description:  "バンドルが存在しないのでErrInvalidEditionBundleID",
+			bundle:       domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusCompleted, time.Now()),
+			getBundleErr: repository.ErrRecordNotFound,
+			isErr:        true,
+			err:          service.ErrInvalidEditionBundleID,
+		},
+		{
+			description: "別のエディションのバンドルなのでErrInvalidEditionBundleID",
+			bundle:      domain.NewEditionBundle(values.NewEditionBundleID(), values.NewEditionID(), values.EditionBundleStatusCompleted, time.Now()),
+			isErr:       true,
+			err:         service.ErrInvalidEditionBundleID,
+		},
+		{
+			description: "生成待ちなのでErrEditionBundleNotCompleted",
+			bundle:      domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusPending, time.Now()),
+			isErr:       true,
+			err:         service.ErrEditionBundleNotCompleted,
+		},
+		{
+			description: "生成中なのでErrEditionBundleNotCompleted",
+			bundle:      domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusBuilding, time.Now()),
+			isErr:       true,
+			err:         service.ErrEditionBundleNotCompleted,
+		},
+		{
+			description: "生成に失敗したのでErrEditionBundleNotCompleted",
+			bundle:      domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusFailed, time.Now()),
+			isErr:       true,
+			err:         service.ErrEditionBundleNotCompleted,
+		},
+		{
+			description:  "GetEditionBundleがエラーなのでエラー",
+			bundle:       domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusCompleted, time.Now()),
+			getBundleErr: errors.New("error"),
+			isErr:        true,
+		},
+		{
+			description:       "GetTempURLがエラーなのでエラー",
+			bundle:            domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusCompleted, time.Now()),
+			executeGetTempURL: true,
+			getTempURLErr:     storage.ErrNotFound,
+			isErr:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			editionBundleService, mocks := newEditionBundleService(t, ctrl, true, nil)
+
+			mocks.editionBundleRepository.
+				EXPECT().
+				GetEditionBundle(gomock.Any(), testCase.bundle.GetID(), repository.LockTypeNone).
+				Return(testCase.bundle, testCase.getBundleErr)
+
+			if testCase.executeGetTempURL {
+				mocks.editionBundleStorage.
+					EXPECT().
+					GetTempURL(gomock.Any(), testCase.bundle, editionBundleTmpURLExpires).
+					Return(values.NewEditionBundleTmpURL(urlLink), testCase.getTempURLErr)
+			}
+
+			tmpURL, err := editionBundleService.GetEditionBundleURL(ctx, editionID, testCase.bundle.GetID())
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, urlLink, (*url.URL)(tmpURL))
+		})
+	}
+}
+
+func TestBuildEditionBundles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description       string
+		hasSigningKey     bool
+		staleCount        int
+		failStaleErr      error
+		executeGetPending bool
+		getPendingErr     error
+		pending           bool
+		claimErr          error
+		executeBuild      bool
+		// updateErr
+		// UpdateOwnedEditionBundleが返すエラー。
+		// nilでないときは、最初の更新で生成をやめるので、ストレージからの読み込みは行わない
+		updateErr      error
+		fileScanStatus values.GameFileScanStatus
+		loadErr        error
+		saveErr        error
+		isErr          bool
+		wantStatus     values.EditionBundleStatus
+	}
+
+	testCases := []test{
+		{
+			description:       "特に問題ないのでバンドルが生成される",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			executeBuild:      true,
+			fileScanStatus:    values.GameFileScanStatusClean,
+			wantStatus:        values.EditionBundleStatusCompleted,
+		},
+		{
+			description:       "検査を通過していないファイルは含めない",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			executeBuild:      true,
+			fileScanStatus:    values.GameFileScanStatusRejected,
+			wantStatus:        values.EditionBundleStatusCompleted,
+		},
+		{
+			description:       "生成待ちのバンドルが無くてもエラー無し",
+			hasSigningKey:     true,
+			executeGetPending: true,
+		},
+		{
+			description:       "生存の報告が途絶えたバンドルがあってもエラー無し",
+			hasSigningKey:     true,
+			staleCount:        1,
+			executeGetPending: true,
+		},
+		{
+			description:       "他のサーバーが先に生成を始めたので生成しない",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			claimErr:          repository.ErrNoRecordUpdated,
+			wantStatus:        values.EditionBundleStatusPending,
+		},
+		{
+			description:       "生成を始められなかったので生成しない",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			claimErr:          errors.New("error"),
+			wantStatus:        values.EditionBundleStatusPending,
+		},
+		{
+			description:       "他のサーバーに失敗扱いにされたので状態を更新しない",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			executeBuild:      true,
+			fileScanStatus:    values.GameFileScanStatusClean,
+			updateErr:         repository.ErrNoRecordUpdated,
+			wantStatus:        values.EditionBundleStatusBuilding,
+		},
+		{
+			description:       "署名鍵が設定されていないので失敗扱いにする",
+			hasSigningKey:     false,
+			executeGetPending: true,
+			pending:           true,
+			wantStatus:        values.EditionBundleStatusFailed,
+		},
+		{
+			description:       "読み込みに失敗したので失敗扱いにする",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			executeBuild:      true,
+			fileScanStatus:    values.GameFileScanStatusClean,
+			loadErr:           storage.ErrNotFound,
+			wantStatus:        values.EditionBundleStatusFailed,
+		},
+		{
+			description:       "保存に失敗したので失敗扱いにする",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			pending:           true,
+			executeBuild:      true,
+			fileScanStatus:    values.GameFileScanStatusClean,
+			saveErr:           errors.New("error"),
+			wantStatus:        values.EditionBundleStatusFailed,
+		},
+		{
+			description:   "生存の報告が途絶えたバンドルの更新に失敗したのでエラー",
+			hasSigningKey: true,
+			failStaleErr:  errors.New("error"),
+			isErr:         true,
+		},
+		{
+			description:       "生成待ちのバンドルの取得に失敗したのでエラー",
+			hasSigningKey:     true,
+			executeGetPending: true,
+			getPendingErr:     errors.New("error"),
+			isErr:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			buf := bytes.NewBuffer(nil)
+			editionBundleService, mocks := newEditionBundleService(t, ctrl, testCase.hasSigningKey, buf)
+
+			mocks.editionBundleRepository.
+				EXPECT().
+				FailStaleEditionBundles(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, staleBefore time.Time) (int, error) {
+					assert.WithinDuration(t, time.Now().Add(-editionBundleStaleTimeout), staleBefore, time.Second)
+					return testCase.staleCount, testCase.failStaleErr
+				})
+
+			var updatedStatuses []values.EditionBundleStatus
+			mocks.editionBundleRepository.
+				EXPECT().
+				UpdateOwnedEditionBundle(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, bundle *domain.EditionBundle, _ uuid.UUID, _ time.Time) error {
+					updatedStatuses = append(updatedStatuses, bundle.GetStatus())
+					return testCase.updateErr
+				}).
+				AnyTimes()
+
+			editionID := values.NewEditionID()
+			bundle := domain.NewEditionBundle(values.NewEditionBundleID(), editionID, values.EditionBundleStatusPending, time.Now())
+
+			var pending []*domain.EditionBundle
+			if testCase.pending {
+				pending = []*domain.EditionBundle{bundle}
+			}
+			if testCase.executeGetPending {
+				mocks.editionBundleRepository.
+					EXPECT().
+					GetEditionBundlesByStatus(gomock.Any(), values.EditionBundleStatusPending).
+					Return(pending, testCase.getPendingErr)
+			}
+			if testCase.pending {
+				mocks.editionBundleRepository.
+					EXPECT().
+					ClaimEditionBundle(gomock.Any(), bundle.GetID(), gomock.Any(), gomock.Any()).
+					Return(testCase.claimErr)
+			}
+
+			questionnaireURL, err := url.Parse("https://example.com/questionnaire")
+			require.NoError(t, err)
+			edition := domain.NewEditionWithQuestionnaire(editionID, values.NewEditionName("test"), values.NewEditionQuestionnaireURL(questionnaireURL), time.Now())
+
+			gameID := values.NewGameID()
+			image := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+			video := domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now())
+			fileContent := []byte("game file")
+			fileHash, err := values.NewGameFileHash(bytes.NewReader(fileContent))
+			require.NoError(t, err)
+			file := domain.NewGameFile(values.NewGameFileID(), values.GameFileTypeJar, values.NewGameFileEntryPoint("main.jar"), fileHash, time.Now())
+			file.SetScanStatus(testCase.fileScanStatus)
+			gameVersion := &repository.GameVersionInfoWithGameID{
+				GameVersion: domain.NewGameVersion(values.NewGameVersionID(), values.NewGameVersionName("v1.0.0"), values.NewGameVersionDescription("description"), time.Now()),
+				GameID:      gameID,
+				ImageID:     image.GetID(),
+				VideoID:     video.GetID(),
+				URL:         option.Option[values.GameURLLink]{},
+				FileIDs:     []values.GameFileID{file.GetID()},
+			}
+
+			if testCase.executeBuild {
+				mocks.editionRepository.
+					EXPECT().
+					GetEdition(gomock.Any(), editionID, repository.LockTypeNone).
+					Return(edition, nil)
+				mocks.editionRepository.
+					EXPECT().
+					GetEditionGameVersions(gomock.Any(), editionID, repository.LockTypeNone).
+					Return([]*repository.GameVersionInfoWithGameID{gameVersion}, nil)
+				mocks.gameImageRepository.
+					EXPECT().
+					GetGameImage(gomock.Any(), image.GetID(), repository.LockTypeNone).
+					Return(&repository.GameImageInfo{GameImage: image, GameID: gameID}, nil)
+				mocks.gameVideoRepository.
+					EXPECT().
+					GetGameVideo(gomock.Any(), video.GetID(), repository.LockTypeNone).
+					Return(&repository.GameVideoInfo{GameVideo: video, GameID: gameID}, nil)
+				mocks.gameFileRepository.
+					EXPECT().
+					GetGameFilesWithoutTypes(gomock.Any(), []values.GameFileID{file.GetID()}, repository.LockTypeNone).
+					Return([]*repository.GameFileInfo{{GameFile: file, GameID: gameID}}, nil)
+			}
+
+			if testCase.executeBuild && testCase.updateErr == nil {
+				mocks.gameImageStorage.
+					EXPECT().
+					LoadGameImage(gomock.Any(), gomock.Any(), image.GetID()).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameImageID) error {
+						_, err := writer.Write([]byte("image"))
+						require.NoError(t, err)
+						return testCase.loadErr
+					})
+				if testCase.loadErr == nil {
+					mocks.gameVideoStorage.
+						EXPECT().
+						LoadGameVideo(gomock.Any(), gomock.Any(), video.GetID()).
+						DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameVideoID) error {
+							_, err := writer.Write([]byte("video"))
+							require.NoError(t, err)
+							return nil
+						})
+					if testCase.fileScanStatus == values.GameFileScanStatusClean {
+						mocks.gameFileStorage.
+							EXPECT().
+							LoadGameFile(gomock.Any(), gomock.Any(), file).
+							DoAndReturn(func(_ context.Context, writer io.Writer, _ *domain.GameFile) error {
+								_, err := writer.Write(fileContent)
+								require.NoError(t, err)
+								return nil
+							})
+					}
+					mocks.editionBundleStorage.
+						EXPECT().
+						SaveEditionBundle(gomock.Any(), bundle.GetID()).
+						Return(testCase.saveErr)
+				}
+			}
+
+			err = editionBundleService.BuildEditionBundles(ctx)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			if !testCase.pending {
+				return
+			}
+
+			assert.Equal(t, testCase.wantStatus, bundle.GetStatus())
+			if testCase.updateErr != nil {
+				// 他のサーバーに失敗扱いにされているので、失敗扱いにする更新は行わない
+				assert.Equal(t, []values.EditionBundleStatus{values.EditionBundleStatusBuilding}, updatedStatuses)
+			}
+			if testCase.wantStatus != values.EditionBundleStatusCompleted {
+				return
+			}
+
+			assert.Equal(t, bundle.GetTotalItems(), bundle.GetProcessedItems())
+			assert.Equal(t, values.GameStorageSize(buf.Len()), bundle.GetSize())
+			assert.Equal(t, values.EditionBundleStatusCompleted, updatedStatuses[len(updatedStatuses)-1])
+
+			zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			require.NoError(t, err)
+
+			contents := make(map[string][]byte, len(zipReader.File))
+			for _, zipFile := range zipReader.File {
+				r, err := zipFile.Open()
+				require.NoError(t, err)
+				content, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.NoError(t, r.Close())
+				contents[zipFile.Name] = content
+			}
+
+			gameDir := fmt.Sprintf("games/%s", uuid.UUID(gameID))
+			wantItems := map[string][]byte{
+				fmt.Sprintf("%s/images/%s.png", gameDir, uuid.UUID(image.GetID())): []byte("image"),
+				fmt.Sprintf("%s/videos/%s.mp4", gameDir, uuid.UUID(video.GetID())): []byte("video"),
+			}
+			if testCase.fileScanStatus == values.GameFileScanStatusClean {
+				wantItems[fmt.Sprintf("%s/files/%s.zip", gameDir, uuid.UUID(file.GetID()))] = fileContent
+			}
+			assert.Equal(t, len(wantItems), bundle.GetTotalItems())
+			assert.Len(t, contents, len(wantItems)+3)
+			for name, content := range wantItems {
+				assert.Equal(t, content, contents[name], name)
+			}
+
+			manifestJSON := contents[editionBundleManifestName]
+			var manifest editionBundleManifest
+			require.NoError(t, json.Unmarshal(manifestJSON, &manifest))
+			assert.Equal(t, uuid.UUID(editionID), manifest.Edition.ID)
+			assert.Equal(t, "test", manifest.Edition.Name)
+			if assert.NotNil(t, manifest.Edition.Questionnaire) {
+				assert.Equal(t, questionnaireURL.String(), *manifest.Edition.Questionnaire)
+			}
+			require.Len(t, manifest.Games, 1)
+			assert.Equal(t, uuid.UUID(gameID), manifest.Games[0].ID)
+			assert.Equal(t, uuid.UUID(gameVersion.GetID()), manifest.Games[0].Version.ID)
+			assert.Equal(t, "png", manifest.Games[0].Image.Type)
+			assert.Equal(t, "mp4", manifest.Games[0].Video.Type)
+			if testCase.fileScanStatus == values.GameFileScanStatusClean {
+				require.Len(t, manifest.Games[0].Files, 1)
+				assert.Equal(t, "jar", manifest.Games[0].Files[0].Type)
+				assert.Equal(t, "main.jar", manifest.Games[0].Files[0].EntryPoint)
+				assert.Equal(t, fileHash.String(), manifest.Games[0].Files[0].MD5)
+				fileSHA256 := sha256.Sum256(fileContent)
+				assert.Equal(t, hex.EncodeToString(fileSHA256[:]), manifest.Games[0].Files[0].SHA256)
+			} else {
+				assert.Empty(t, manifest.Games[0].Files)
+			}
+
+			var signature editionBundleSignature
+			require.NoError(t, json.Unmarshal(contents[editionBundleManifestSignatureName], &signature))
+			assert.Equal(t, "ed25519", signature.Algorithm)
+			publicKey, err := base64.StdEncoding.DecodeString(signature.PublicKey)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(testEditionBundleSigningKey.Public().(ed25519.PublicKey)), publicKey)
+			sig, err := base64.StdEncoding.DecodeString(signature.Signature)
+			require.NoError(t, err)
+			assert.True(t, ed25519.Verify(publicKey, manifestJSON, sig))
+
+			manifestSHA256 := sha256.Sum256(manifestJSON)
+			assert.Contains(t, string(contents[editionBundleChecksumsName]), fmt.Sprintf("%s  %s\n", hex.EncodeToString(manifestSHA256[:]), editionBundleManifestName))
+			for name, content := range wantItems {
+				itemSHA256 := sha256.Sum256(content)
+				assert.Contains(t, string(contents[editionBundleChecksumsName]), fmt.Sprintf("%s  %s\n", hex.EncodeToString(itemSHA256[:]), name))
+			}
+		})
+	}
+}
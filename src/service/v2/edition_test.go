@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockAuth "github.com/traPtitech/trap-collection-server/src/auth/mock"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -214,6 +216,9 @@ func TestCreateEdition(t *testing.T) {
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
 			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
 
 			editionService := NewEdition(
 				mockDB,
@@ -221,24 +226,26 @@ func TestCreateEdition(t *testing.T) {
 				mockGameRepository,
 				mockGameVersionRepository,
 				mockGameFileRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
 			)
 
 			if testCase.mockInfo.executeGetGameVersionsByIDs {
 				mockGameVersionRepository.
 					EXPECT().
-					GetGameVersionsByIDs(ctx, testCase.args.gameVersionIDs, repository.LockTypeRecord).
+					GetGameVersionsByIDs(gomock.Any(), testCase.args.gameVersionIDs, repository.LockTypeRecord).
 					Return(testCase.mockInfo.gameVersions, testCase.mockInfo.errGetGameVersionsByIDs)
 			}
 			if testCase.mockInfo.executeSaveEdition {
 				mockEditionRepository.
 					EXPECT().
-					SaveEdition(ctx, gomock.Any()). // newEditionについてはmockできない
+					SaveEdition(gomock.Any(), gomock.Any()). // newEditionについてはmockできない
 					Return(testCase.mockInfo.errSaveEdition)
 			}
 			if testCase.mockInfo.executeUpdateEditionGameVersions {
 				mockEditionRepository.
 					EXPECT().
-					UpdateEditionGameVersions(ctx, gomock.Any(), testCase.args.gameVersionIDs).
+					UpdateEditionGameVersions(gomock.Any(), gomock.Any(), testCase.args.gameVersionIDs).
 					Return(testCase.mockInfo.errUpdateEditionGameVersions)
 			}
 
@@ -338,6 +345,9 @@ func TestGetEditions(t *testing.T) {
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
 			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
 
 			editionService := NewEdition(
 				mockDB,
@@ -345,11 +355,13 @@ func TestGetEditions(t *testing.T) {
 				mockGameRepository,
 				mockGameVersionRepository,
 				mockGameFileRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
 			)
 
 			mockEditionRepository.
 				EXPECT().
-				GetEditions(ctx, repository.LockTypeNone).
+				GetEditions(gomock.Any(), repository.LockTypeNone).
 				Return(testCase.mockInfo.editions, testCase.mockInfo.errGetEditions)
 
 			gotEditions, err := editionService.GetEditions(ctx)
@@ -471,6 +483,9 @@ func TestGetEdition(t *testing.T) {
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
 			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
 
 			editionService := NewEdition(
 				mockDB,
@@ -478,11 +493,13 @@ func TestGetEdition(t *testing.T) {
 				mockGameRepository,
 				mockGameVersionRepository,
 				mockGameFileRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
 			)
 
 			mockEditionRepository.
 				EXPECT().
-				GetEdition(ctx, testCase.args.editionID, repository.LockTypeNone).
+				GetEdition(gomock.Any(), testCase.args.editionID, repository.LockTypeNone).
 				Return(testCase.mockInfo.edition, testCase.mockInfo.errGetEdition)
 
 			got, err := editionService.GetEdition(ctx, testCase.args.editionID)
@@ -528,10 +545,12 @@ func TestUpdateEdition(t *testing.T) {
 		edition        *domain.Edition
 		updatedEdition *domain.Edition
 
-		executeUpdateEdition bool
+		executeUpdateEdition  bool
+		executeCreateAuditLog bool
 
-		errGetEdition    error
-		errUpdateEdition error
+		errGetEdition     error
+		errUpdateEdition  error
+		errCreateAuditLog error
 	}
 
 	type test struct {
@@ -543,6 +562,11 @@ func TestUpdateEdition(t *testing.T) {
 		err             error
 	}
 
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+
 	newName := values.NewEditionName("v2.0.0")
 	newURL, err := url.Parse("https://example.com/new")
 	if err != nil {
@@ -587,6 +611,11 @@ func TestUpdateEdition(t *testing.T) {
 	updatedEdition9.SetName(newName)
 	updatedEdition9.SetQuestionnaireURL(newURL)
 
+	editionID10, edition10 := generateEdition(t, true)
+	updatedEdition10 := copyEdition(t, edition10)
+	updatedEdition10.SetName(newName)
+	updatedEdition10.SetQuestionnaireURL(newURL)
+
 	testCases := []test{
 		{
 			description: "特に問題ないのでエラーなし",
@@ -599,7 +628,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition1,
 				updatedEdition: updatedEdition1,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition1,
 		},
@@ -614,7 +644,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition2,
 				updatedEdition: updatedEdition2,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition2,
 		},
@@ -629,7 +660,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition3,
 				updatedEdition: updatedEdition3,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition3,
 		},
@@ -644,7 +676,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition4,
 				updatedEdition: updatedEdition4,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition4,
 		},
@@ -659,7 +692,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition5,
 				updatedEdition: updatedEdition5,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition5,
 		},
@@ -674,7 +708,8 @@ func TestUpdateEdition(t *testing.T) {
 				edition:        edition6,
 				updatedEdition: updatedEdition6,
 
-				executeUpdateEdition: true,
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
 			},
 			expectedEdition: updatedEdition6,
 		},
@@ -724,6 +759,24 @@ func TestUpdateEdition(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			description: "CreateAuditLogでエラーなのでエラー",
+			args: args{
+				editionID:        editionID10,
+				name:             newName,
+				questionnaireURL: newOptionalURL,
+			},
+			mockInfo: mockInfo{
+				edition:        edition10,
+				updatedEdition: updatedEdition10,
+
+				executeUpdateEdition:  true,
+				executeCreateAuditLog: true,
+
+				errCreateAuditLog: errors.New("error"),
+			},
+			isErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -736,6 +789,9 @@ func TestUpdateEdition(t *testing.T) {
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
 			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
 
 			editionService := NewEdition(
 				mockDB,
@@ -743,20 +799,33 @@ func TestUpdateEdition(t *testing.T) {
 				mockGameRepository,
 				mockGameVersionRepository,
 				mockGameFileRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
 			)
 
 			mockEditionRepository.
 				EXPECT().
-				GetEdition(ctx, testCase.args.editionID, repository.LockTypeRecord).
+				GetEdition(gomock.Any(), testCase.args.editionID, repository.LockTypeRecord).
 				Return(testCase.mockInfo.edition, testCase.mockInfo.errGetEdition)
 
 			if testCase.mockInfo.executeUpdateEdition {
 				mockEditionRepository.EXPECT().
-					UpdateEdition(ctx, testCase.mockInfo.updatedEdition).
+					UpdateEdition(gomock.Any(), testCase.mockInfo.updatedEdition).
 					Return(testCase.mockInfo.errUpdateEdition)
 			}
 
-			got, err := editionService.UpdateEdition(ctx, testCase.args.editionID, testCase.args.name, testCase.args.questionnaireURL)
+			if testCase.mockInfo.executeCreateAuditLog {
+				mockUserCache.
+					EXPECT().
+					GetMe(gomock.Any(), gomock.Any()).
+					Return(&service.UserInfo{}, nil)
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Return(testCase.mockInfo.errCreateAuditLog)
+			}
+
+			got, err := editionService.UpdateEdition(ctx, authSession, testCase.args.editionID, testCase.args.name, testCase.args.questionnaireURL)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
@@ -20,6 +22,7 @@ type Game struct {
 	gameManagementRole  repository.GameManagementRole
 	gameGenreRepository repository.GameGenre
 	user                *User
+	auditLog            *AuditLog
 }
 
 func NewGame(
@@ -28,6 +31,7 @@ func NewGame(
 	gameManagementRole repository.GameManagementRole,
 	gameGenreRepository repository.GameGenre,
 	user *User,
+	auditLog *AuditLog,
 ) *Game {
 	return &Game{
 		db:                  db,
@@ -35,10 +39,14 @@ func NewGame(
 		gameManagementRole:  gameManagementRole,
 		gameGenreRepository: gameGenreRepository,
 		user:                user,
+		auditLog:            auditLog,
 	}
 }
 
 func (g *Game) CreateGame(ctx context.Context, session *domain.OIDCSession, name values.GameName, description values.GameDescription, visibility values.GameVisibility, owners []values.TraPMemberName, maintainers []values.TraPMemberName, gameGenreNames []values.GameGenreName) (*service.GameInfoV2, error) {
+	ctx, span := tracer.Start(ctx, "Game.CreateGame")
+	defer span.End()
+
 	user, err := g.user.getMe(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -71,7 +79,7 @@ func (g *Game) CreateGame(ctx context.Context, session *domain.OIDCSession, name
 		ownersMap := make(map[values.TraPMemberName]struct{}, len(owners))
 		for _, owner := range owners {
 			if _, ok := activeUsersMap[owner]; !ok { //ownerが存在するか確かめる
-				fmt.Printf("User '%s' is not an active user.", owner)
+				logger.Warn(ctx, "owner is not an active user", slog.Any("game_id", uuid.UUID(game.GetID())), slog.String("user_name", string(owner)))
 				continue
 			}
 			if _, ok := ownersMap[owner]; !ok { //owners内の重複を除く。ここでユーザーとownersの重複も除かれる
@@ -101,7 +109,7 @@ func (g *Game) CreateGame(ctx context.Context, session *domain.OIDCSession, name
 			}
 
 			if _, ok := activeUsersMap[maintainer]; !ok { //maintainerが存在するか確認
-				fmt.Printf("User '%s' is not an active user.", maintainer)
+				logger.Warn(ctx, "maintainer is not an active user", slog.Any("game_id", uuid.UUID(game.GetID())), slog.String("user_name", string(maintainer)))
 				continue
 			}
 			if _, ok := maintainersMap[maintainer]; !ok {
@@ -147,7 +155,7 @@ func (g *Game) CreateGame(ctx context.Context, session *domain.OIDCSession, name
 		slices.Sort[[]values.GameGenreName](gameGenreNames)
 		uniqueGameGenreNames := slices.Compact[[]values.GameGenreName, values.GameGenreName](gameGenreNames)
 		if len(uniqueGameGenreNames) != len(gameGenreNames) {
-			log.Println("duplicate game genre")
+			logger.Info(ctx, "duplicate game genre")
 			return service.ErrDuplicateGameGenre
 		}
 
@@ -216,6 +224,9 @@ func (g *Game) CreateGame(ctx context.Context, session *domain.OIDCSession, name
 }
 
 func (g *Game) GetGame(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) (*service.GameInfoV2, error) {
+	ctx, span := tracer.Start(ctx, "Game.GetGame")
+	defer span.End()
+
 	game, err := g.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrNoGame
@@ -267,7 +278,7 @@ func (g *Game) GetGame(ctx context.Context, session *domain.OIDCSession, gameID
 					maintainersInfo = append(maintainersInfo, maintainerInfo)
 				}
 			default:
-				fmt.Println("invalid administrator role")
+				logger.Error(ctx, "invalid administrator role", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("user_id", uuid.UUID(administrator.UserID)), slog.Any("role", administrator.Role))
 			}
 		}
 	}
@@ -289,7 +300,10 @@ func (g *Game) GetGame(ctx context.Context, session *domain.OIDCSession, gameID
 
 func (g *Game) GetGames(
 	ctx context.Context, limit int, offset int, sort service.GamesSortType,
-	visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string) (int, []*domain.GameWithGenres, error) {
+	visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (int, []*domain.GameWithGenres, error) {
+	ctx, span := tracer.Start(ctx, "Game.GetGames")
+	defer span.End()
+
 	if limit < 0 {
 		return 0, nil, service.ErrInvalidLimit
 	}
@@ -297,17 +311,12 @@ func (g *Game) GetGames(
 		return 0, nil, service.ErrOffsetWithoutLimit
 	}
 
-	var sortType repository.GamesSortType
-	switch sort {
-	case service.GamesSortTypeCreatedAt:
-		sortType = repository.GamesSortTypeCreatedAt
-	case service.GamesSortTypeLatestVersion:
-		sortType = repository.GamesSortTypeLatestVersion
-	default:
-		return 0, nil, service.ErrInvalidGamesSortType
+	sortType, err := convertGamesSortType(sort)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	gamesWithGenres, gameNumber, err := g.gameRepository.GetGames(ctx, limit, offset, sortType, visibilities, nil, gameGenreIDs, gameName)
+	gamesWithGenres, gameNumber, err := g.gameRepository.GetGames(ctx, limit, offset, sortType, visibilities, nil, gameGenreIDs, gameName, keyword)
 	if err != nil {
 		return 0, nil, fmt.Errorf("failed to get games: %w", err)
 	}
@@ -320,7 +329,10 @@ func (g *Game) GetGames(
 
 func (g *Game) GetMyGames(
 	ctx context.Context, session *domain.OIDCSession, limit int, offset int, sort service.GamesSortType,
-	visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string) (int, []*domain.GameWithGenres, error) {
+	visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (int, []*domain.GameWithGenres, error) {
+	ctx, span := tracer.Start(ctx, "Game.GetMyGames")
+	defer span.End()
+
 	if limit < 0 {
 		return 0, nil, service.ErrInvalidLimit
 	}
@@ -333,17 +345,12 @@ func (g *Game) GetMyGames(
 	}
 	userID := user.GetID()
 
-	var sortType repository.GamesSortType
-	switch sort {
-	case service.GamesSortTypeCreatedAt:
-		sortType = repository.GamesSortTypeCreatedAt
-	case service.GamesSortTypeLatestVersion:
-		sortType = repository.GamesSortTypeLatestVersion
-	default:
-		return 0, nil, service.ErrInvalidGamesSortType
+	sortType, err := convertGamesSortType(sort)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	myGamesWithGenres, gameNumber, err := g.gameRepository.GetGames(ctx, limit, offset, sortType, visibilities, &userID, gameGenreIDs, gameName)
+	myGamesWithGenres, gameNumber, err := g.gameRepository.GetGames(ctx, limit, offset, sortType, visibilities, &userID, gameGenreIDs, gameName, keyword)
 	if err != nil {
 		return 0, nil, fmt.Errorf("failed to get game IDs: %w", err)
 	}
@@ -355,7 +362,49 @@ func (g *Game) GetMyGames(
 	return gameNumber, myGamesWithGenres, nil
 }
 
-func (g *Game) UpdateGame(ctx context.Context, gameID values.GameID, name values.GameName, description values.GameDescription, visibility *values.GameVisibility) (*domain.Game, error) {
+func (g *Game) GetGameFacets(
+	ctx context.Context, session *domain.OIDCSession,
+	visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (*domain.GameFacets, error) {
+	ctx, span := tracer.Start(ctx, "Game.GetGameFacets")
+	defer span.End()
+
+	var userID *values.TraPMemberID
+	if session != nil {
+		user, err := g.user.getMe(ctx, session)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		id := user.GetID()
+		userID = &id
+	}
+
+	facets, err := g.gameRepository.GetGameFacets(ctx, visibilities, userID, gameGenreIDs, gameName, keyword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game facets: %w", err)
+	}
+
+	return facets, nil
+}
+
+func convertGamesSortType(sort service.GamesSortType) (repository.GamesSortType, error) {
+	switch sort {
+	case service.GamesSortTypeCreatedAt:
+		return repository.GamesSortTypeCreatedAt, nil
+	case service.GamesSortTypeLatestVersion:
+		return repository.GamesSortTypeLatestVersion, nil
+	case service.GamesSortTypePlayCount:
+		return repository.GamesSortTypePlayCount, nil
+	case service.GamesSortTypeLatestFeedback:
+		return repository.GamesSortTypeLatestFeedback, nil
+	default:
+		return 0, service.ErrInvalidGamesSortType
+	}
+}
+
+func (g *Game) UpdateGame(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, name values.GameName, description values.GameDescription, visibility *values.GameVisibility) (*domain.Game, error) {
+	ctx, span := tracer.Start(ctx, "Game.UpdateGame")
+	defer span.End()
+
 	var game, newGame *domain.Game
 	err := g.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		var err error
@@ -387,6 +436,20 @@ func (g *Game) UpdateGame(ctx context.Context, gameID values.GameID, name values
 			return fmt.Errorf("failed to save game: %w", err)
 		}
 
+		myInfo, err := g.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = g.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionUpdateGame, values.AuditLogTargetTypeGame, uuid.UUID(gameID),
+			newAuditLogGameState(game), newAuditLogGameState(newGame),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -397,6 +460,9 @@ func (g *Game) UpdateGame(ctx context.Context, gameID values.GameID, name values
 }
 
 func (g *Game) DeleteGame(ctx context.Context, gameID values.GameID) error { //V1と変わらない
+	ctx, span := tracer.Start(ctx, "Game.DeleteGame")
+	defer span.End()
+
 	err := g.gameRepository.RemoveGame(ctx, gameID)
 	if errors.Is(err, repository.ErrNoRecordDeleted) {
 		return service.ErrNoGame
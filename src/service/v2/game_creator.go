@@ -1,10 +1,12 @@
 package v2
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"time"
 
 	"github.com/traPtitech/trap-collection-server/src/domain"
@@ -30,6 +32,9 @@ func NewGameCreator(gameCreatorRepo repository.GameCreator, gameRepository repos
 }
 
 func (gc *GameCreator) GetGameCreators(ctx context.Context, gameID values.GameID) ([]*domain.GameCreatorWithJobs, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.GetGameCreators")
+	defer span.End()
+
 	_, err := gc.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -47,6 +52,9 @@ func (gc *GameCreator) GetGameCreators(ctx context.Context, gameID values.GameID
 }
 
 func (gc *GameCreator) GetGameCreatorJobs(ctx context.Context, gameID values.GameID) ([]*domain.GameCreatorJob, []*domain.GameCreatorCustomJob, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.GetGameCreatorJobs")
+	defer span.End()
+
 	_, err := gc.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, nil, service.ErrInvalidGameID
@@ -69,6 +77,9 @@ func (gc *GameCreator) GetGameCreatorJobs(ctx context.Context, gameID values.Gam
 }
 
 func (gc *GameCreator) CreateGameCustomJob(ctx context.Context, gameID values.GameID, displayName values.GameCreatorJobDisplayName) (*domain.GameCreatorCustomJob, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.CreateGameCustomJob")
+	defer span.End()
+
 	var customJob *domain.GameCreatorCustomJob
 	err := gc.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		_, err := gc.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
@@ -110,6 +121,9 @@ func (gc *GameCreator) CreateGameCustomJob(ctx context.Context, gameID values.Ga
 }
 
 func (gc *GameCreator) DeleteGameCreator(ctx context.Context, gameID values.GameID, creatorID values.GameCreatorID) error {
+	ctx, span := tracer.Start(ctx, "GameCreator.DeleteGameCreator")
+	defer span.End()
+
 	err := gc.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		creator, err := gc.gameCreatorRepo.GetGameCreatorByID(ctx, creatorID)
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -151,6 +165,9 @@ func (gc *GameCreator) DeleteGameCreator(ctx context.Context, gameID values.Game
 }
 
 func (gc *GameCreator) EditGameCreators(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, inputs []*service.EditGameCreatorJobInput) error {
+	ctx, span := tracer.Start(ctx, "GameCreator.EditGameCreators")
+	defer span.End()
+
 	err := gc.validateGameExists(ctx, gameID)
 	if err != nil {
 		return err
@@ -284,6 +301,7 @@ func (gc *GameCreator) validateEditGameCreatorsInput(
 type editGameCreatorsInputData struct {
 	creatorUserIDs         []values.TraPMemberID
 	existingUserCreatorMap map[values.TraPMemberID]*domain.GameCreator
+	nextDisplayOrder       int
 }
 
 func (gc *GameCreator) loadEditGameCreatorsInputData(
@@ -306,12 +324,34 @@ func (gc *GameCreator) loadEditGameCreatorsInputData(
 		existingUserCreatorMap[creator.GetUserID()] = creator
 	}
 
+	nextDisplayOrder, err := gc.getNextGameCreatorDisplayOrder(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &editGameCreatorsInputData{
 		creatorUserIDs:         creatorUserIDs,
 		existingUserCreatorMap: existingUserCreatorMap,
+		nextDisplayOrder:       nextDisplayOrder,
 	}, nil
 }
 
+// getNextGameCreatorDisplayOrder
+// 新しく追加するクリエイターを既存のクリエイターの後ろに表示するための表示順を返す。
+func (gc *GameCreator) getNextGameCreatorDisplayOrder(ctx context.Context, gameID values.GameID) (int, error) {
+	creators, err := gc.gameCreatorRepo.GetGameCreatorsByGameID(ctx, gameID)
+	if err != nil {
+		return 0, fmt.Errorf("get game creators by game id: %w", err)
+	}
+
+	nextDisplayOrder := 0
+	for _, creator := range creators {
+		nextDisplayOrder = max(nextDisplayOrder, creator.GetGameCreator().GetDisplayOrder()+1)
+	}
+
+	return nextDisplayOrder, nil
+}
+
 func (gc *GameCreator) applyEditGameCreators(
 	ctx context.Context,
 	gameID values.GameID,
@@ -338,6 +378,7 @@ func (gc *GameCreator) applyEditGameCreators(
 	for _, userID := range inputData.creatorUserIDs {
 		if _, ok := inputData.existingUserCreatorMap[userID]; !ok {
 			newCreator := domain.NewGameCreator(values.NewGameCreatorID(), userID, gameID, validatedInput.usersMap[userID].GetName(), time.Now())
+			newCreator.SetDisplayOrder(inputData.nextDisplayOrder + len(newCreators))
 			newCreators = append(newCreators, newCreator)
 		}
 	}
@@ -370,6 +411,21 @@ func (gc *GameCreator) applyEditGameCreators(
 		return fmt.Errorf("upsert game creator custom jobs relations: %w", err)
 	}
 
+	for _, input := range inputs {
+		creator := userCreatorMap[input.UserID]
+
+		jobIDs := make([]values.GameCreatorJobID, 0, len(input.Jobs)+len(input.NewCustomJobNames))
+		jobIDs = append(jobIDs, input.Jobs...)
+		for _, newCustomJobName := range input.NewCustomJobNames {
+			jobIDs = append(jobIDs, newCustomJobsMap[newCustomJobName])
+		}
+
+		err = gc.gameCreatorRepo.UpdateGameCreatorJobDisplayOrders(ctx, creator.GetID(), jobIDs)
+		if err != nil {
+			return fmt.Errorf("update game creator job display orders: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -434,3 +490,243 @@ func buildCustomJobRelations(
 
 	return customRelations, nil
 }
+
+func (gc *GameCreator) CreateExternalGameCreator(ctx context.Context, gameID values.GameID, externalName values.GameCreatorExternalName, externalURL values.GameCreatorExternalURL) (*domain.GameCreator, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.CreateExternalGameCreator")
+	defer span.End()
+
+	var creator *domain.GameCreator
+	err := gc.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gc.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameID
+		}
+		if err != nil {
+			return fmt.Errorf("get game: %w", err)
+		}
+
+		nextDisplayOrder, err := gc.getNextGameCreatorDisplayOrder(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		creator = domain.NewExternalGameCreator(values.NewGameCreatorID(), gameID, externalName, externalURL, time.Now())
+		creator.SetDisplayOrder(nextDisplayOrder)
+
+		err = gc.gameCreatorRepo.CreateGameCreators(ctx, []*domain.GameCreator{creator})
+		if err != nil {
+			return fmt.Errorf("create game creators: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+
+	return creator, nil
+}
+
+func (gc *GameCreator) EditGameCreatorJobs(ctx context.Context, gameID values.GameID, creatorID values.GameCreatorID, jobIDs []values.GameCreatorJobID) (*domain.GameCreatorWithJobs, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.EditGameCreatorJobs")
+	defer span.End()
+
+	jobIDsMap := make(map[values.GameCreatorJobID]struct{}, len(jobIDs))
+	for _, jobID := range jobIDs {
+		if _, ok := jobIDsMap[jobID]; ok {
+			return nil, service.ErrDuplicateGameCreatorJobID
+		}
+		jobIDsMap[jobID] = struct{}{}
+	}
+
+	// ゲームが存在しない場合は ErrInvalidGameID が返る
+	presetJobs, customJobs, err := gc.GetGameCreatorJobs(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	presetJobsMap := make(map[values.GameCreatorJobID]*domain.GameCreatorJob, len(presetJobs))
+	for _, job := range presetJobs {
+		presetJobsMap[job.GetID()] = job
+	}
+	customJobsMap := make(map[values.GameCreatorJobID]*domain.GameCreatorCustomJob, len(customJobs))
+	for _, job := range customJobs {
+		customJobsMap[job.GetID()] = job
+	}
+
+	creatorPresetJobs := make([]*domain.GameCreatorJob, 0, len(jobIDs))
+	creatorCustomJobs := make([]*domain.GameCreatorCustomJob, 0, len(jobIDs))
+	jobDisplayOrders := make(map[values.GameCreatorJobID]int, len(jobIDs))
+	for i, jobID := range jobIDs {
+		if job, ok := presetJobsMap[jobID]; ok {
+			creatorPresetJobs = append(creatorPresetJobs, job)
+		} else if job, ok := customJobsMap[jobID]; ok {
+			creatorCustomJobs = append(creatorCustomJobs, job)
+		} else {
+			return nil, service.ErrInvalidGameCreatorJobID
+		}
+		jobDisplayOrders[jobID] = i
+	}
+
+	var creator *domain.GameCreator
+	err = gc.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		creator, err = gc.gameCreatorRepo.GetGameCreatorByID(ctx, creatorID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameCreatorID
+		}
+		if err != nil {
+			return fmt.Errorf("get game creator by id: %w", err)
+		}
+
+		if creator.GetGameID() != gameID {
+			return service.ErrInvalidGameCreatorGamePair
+		}
+
+		err = gc.gameCreatorRepo.DeleteGameCreatorPresetJobs(ctx, creatorID)
+		if err != nil {
+			return fmt.Errorf("delete game creator preset jobs: %w", err)
+		}
+		err = gc.gameCreatorRepo.DeleteGameCreatorCustomJobs(ctx, creatorID)
+		if err != nil {
+			return fmt.Errorf("delete game creator custom jobs: %w", err)
+		}
+
+		presetJobIDs := make([]values.GameCreatorJobID, 0, len(creatorPresetJobs))
+		for _, job := range creatorPresetJobs {
+			presetJobIDs = append(presetJobIDs, job.GetID())
+		}
+		err = gc.gameCreatorRepo.UpsertGameCreatorPresetJobsRelations(ctx, map[values.GameCreatorID][]values.GameCreatorJobID{
+			creatorID: presetJobIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("upsert game creator preset jobs relations: %w", err)
+		}
+
+		customJobIDs := make([]values.GameCreatorJobID, 0, len(creatorCustomJobs))
+		for _, job := range creatorCustomJobs {
+			customJobIDs = append(customJobIDs, job.GetID())
+		}
+		err = gc.gameCreatorRepo.UpsertGameCreatorCustomJobsRelations(ctx, map[values.GameCreatorID][]values.GameCreatorJobID{
+			creatorID: customJobIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("upsert game creator custom jobs relations: %w", err)
+		}
+
+		err = gc.gameCreatorRepo.UpdateGameCreatorJobDisplayOrders(ctx, creatorID, jobIDs)
+		if err != nil {
+			return fmt.Errorf("update game creator job display orders: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+
+	creatorWithJobs := domain.NewGameCreatorWithJobs(creator, creatorPresetJobs, creatorCustomJobs)
+	creatorWithJobs.SetJobDisplayOrders(jobDisplayOrders)
+
+	return creatorWithJobs, nil
+}
+
+func (gc *GameCreator) UpdateGameCreatorsOrder(ctx context.Context, gameID values.GameID, creatorIDs []values.GameCreatorID) ([]*domain.GameCreatorWithJobs, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.UpdateGameCreatorsOrder")
+	defer span.End()
+
+	var orderedCreators []*domain.GameCreatorWithJobs
+	err := gc.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gc.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameID
+		}
+		if err != nil {
+			return fmt.Errorf("get game: %w", err)
+		}
+
+		creators, err := gc.gameCreatorRepo.GetGameCreatorsByGameID(ctx, gameID)
+		if err != nil {
+			return fmt.Errorf("get game creators by game id: %w", err)
+		}
+
+		// 並び替えなので、既存のクリエイター全員をちょうど1回ずつ含む必要がある
+		if len(creatorIDs) != len(creators) {
+			return service.ErrInvalidGameCreatorOrder
+		}
+		creatorsMap := make(map[values.GameCreatorID]*domain.GameCreatorWithJobs, len(creators))
+		for _, creator := range creators {
+			creatorsMap[creator.GetGameCreator().GetID()] = creator
+		}
+		orderedCreators = make([]*domain.GameCreatorWithJobs, 0, len(creatorIDs))
+		for i, creatorID := range creatorIDs {
+			creator, ok := creatorsMap[creatorID]
+			if !ok {
+				return service.ErrInvalidGameCreatorOrder
+			}
+			delete(creatorsMap, creatorID)
+
+			creator.GetGameCreator().SetDisplayOrder(i)
+			orderedCreators = append(orderedCreators, creator)
+		}
+
+		err = gc.gameCreatorRepo.UpdateGameCreatorDisplayOrders(ctx, gameID, creatorIDs)
+		if err != nil {
+			return fmt.Errorf("update game creator display orders: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+
+	return orderedCreators, nil
+}
+
+func (gc *GameCreator) GetGameCredits(ctx context.Context, gameID values.GameID) ([]*domain.GameCredit, error) {
+	ctx, span := tracer.Start(ctx, "GameCreator.GetGameCredits")
+	defer span.End()
+
+	creators, err := gc.GetGameCreators(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildGameCredits(creators), nil
+}
+
+// buildGameCredits
+// 表示順に並んだクリエイターから、ジョブごとのスタッフロールを作る。
+func buildGameCredits(creators []*domain.GameCreatorWithJobs) []*domain.GameCredit {
+	type creditJob struct {
+		id          values.GameCreatorJobID
+		displayName values.GameCreatorJobDisplayName
+		isCustomJob bool
+	}
+
+	credits := make([]*domain.GameCredit, 0)
+	creditsMap := make(map[values.GameCreatorJobID]*domain.GameCredit)
+	for _, creator := range creators {
+		jobs := make([]creditJob, 0, len(creator.GetJobs())+len(creator.GetCustomJobs()))
+		for _, job := range creator.GetJobs() {
+			jobs = append(jobs, creditJob{id: job.GetID(), displayName: job.GetDisplayName(), isCustomJob: false})
+		}
+		for _, job := range creator.GetCustomJobs() {
+			jobs = append(jobs, creditJob{id: job.GetID(), displayName: job.GetDisplayName(), isCustomJob: true})
+		}
+		slices.SortStableFunc(jobs, func(a, b creditJob) int {
+			return cmp.Compare(creator.GetJobDisplayOrder(a.id), creator.GetJobDisplayOrder(b.id))
+		})
+
+		for _, job := range jobs {
+			credit, ok := creditsMap[job.id]
+			if !ok {
+				credit = domain.NewGameCredit(job.id, job.displayName, job.isCustomJob, make([]*domain.GameCreator, 0, 1))
+				creditsMap[job.id] = credit
+				credits = append(credits, credit)
+			}
+			credit.AddCreator(creator.GetGameCreator())
+		}
+	}
+
+	return credits
+}
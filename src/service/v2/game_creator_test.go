@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"net/url"
 	"testing"
 	"time"
 
@@ -488,6 +489,8 @@ func TestEditGameCreators(t *testing.T) {
 		executeGetCreatorsByUserIDs         bool
 		existingCreators                    []*domain.GameCreator
 		getCreatorsByUserIDsErr             error
+		executeGetCreatorsByGameID          bool
+		getCreatorsByGameIDErr              error
 		executeCreateGameCreatorCustomJobs  bool
 		createGameCreatorCustomJobsErr      error
 		executeCreateGameCreators           bool
@@ -496,6 +499,8 @@ func TestEditGameCreators(t *testing.T) {
 		upsertPresetJobsRelationsErr        error
 		executeUpsertCustomJobsRelations    bool
 		upsertCustomJobsRelationsErr        error
+		executeUpdateJobDisplayOrders       bool
+		updateJobDisplayOrdersErr           error
 		wantErr                             error
 	}{
 		"ゲームが存在しない場合ErrInvalidGameID": {
@@ -612,6 +617,22 @@ func TestEditGameCreators(t *testing.T) {
 			getCreatorsByUserIDsErr:             assert.AnError,
 			wantErr:                             assert.AnError,
 		},
+		"creatorの表示順の取得でエラーなのでエラー": {
+			gameID:                              gameID,
+			inputs:                              []*service.EditGameCreatorJobInput{{UserID: user1.GetID()}},
+			executeGetGameCreatorPresetJobs:     true,
+			presetJobs:                          []*domain.GameCreatorJob{presetJob1, presetJob2},
+			executeGetAllUsers:                  true,
+			cacheGetAllUsersErr:                 cache.ErrCacheMiss,
+			authUsers:                           []*service.UserInfo{user1},
+			executeGetGameCreatorCustomJobsByID: true,
+			existingCustomJobs:                  []*domain.GameCreatorCustomJob{},
+			executeGetCreatorsByUserIDs:         true,
+			existingCreators:                    []*domain.GameCreator{},
+			executeGetCreatorsByGameID:          true,
+			getCreatorsByGameIDErr:              assert.AnError,
+			wantErr:                             assert.AnError,
+		},
 		"create custom jobsでエラーなのでエラー": {
 			gameID: gameID,
 			inputs: []*service.EditGameCreatorJobInput{{
@@ -627,6 +648,7 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			createGameCreatorCustomJobsErr:      assert.AnError,
 			wantErr:                             assert.AnError,
@@ -646,6 +668,7 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			executeCreateGameCreators:           true,
 			createGameCreatorsErr:               assert.AnError,
@@ -666,6 +689,7 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{existingCustomJob},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{existingCreator},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			executeCreateGameCreators:           true,
 			executeUpsertPresetJobsRelations:    true,
@@ -688,6 +712,7 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{existingCustomJob},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{existingCreator},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			executeCreateGameCreators:           true,
 			executeUpsertPresetJobsRelations:    true,
@@ -695,6 +720,30 @@ func TestEditGameCreators(t *testing.T) {
 			upsertCustomJobsRelationsErr:        assert.AnError,
 			wantErr:                             assert.AnError,
 		},
+		"job の表示順の更新でエラーなのでエラー": {
+			gameID: gameID,
+			inputs: []*service.EditGameCreatorJobInput{{
+				UserID: user1.GetID(),
+				Jobs:   []values.GameCreatorJobID{presetJob1.GetID(), existingCustomJob.GetID()},
+			}},
+			executeGetGameCreatorPresetJobs:     true,
+			presetJobs:                          []*domain.GameCreatorJob{presetJob1, presetJob2},
+			executeGetAllUsers:                  true,
+			cacheGetAllUsersErr:                 cache.ErrCacheMiss,
+			authUsers:                           []*service.UserInfo{user1},
+			executeGetGameCreatorCustomJobsByID: true,
+			existingCustomJobs:                  []*domain.GameCreatorCustomJob{existingCustomJob},
+			executeGetCreatorsByUserIDs:         true,
+			existingCreators:                    []*domain.GameCreator{existingCreator},
+			executeGetCreatorsByGameID:          true,
+			executeCreateGameCreatorCustomJobs:  true,
+			executeCreateGameCreators:           true,
+			executeUpsertPresetJobsRelations:    true,
+			executeUpsertCustomJobsRelations:    true,
+			executeUpdateJobDisplayOrders:       true,
+			updateJobDisplayOrdersErr:           assert.AnError,
+			wantErr:                             assert.AnError,
+		},
 		"user cache hitの正常系": {
 			gameID: gameID,
 			inputs: []*service.EditGameCreatorJobInput{
@@ -717,10 +766,12 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{existingCustomJob},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{existingCreator},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			executeCreateGameCreators:           true,
 			executeUpsertPresetJobsRelations:    true,
 			executeUpsertCustomJobsRelations:    true,
+			executeUpdateJobDisplayOrders:       true,
 			wantErr:                             nil,
 		},
 		"user cache missの正常系": {
@@ -739,10 +790,12 @@ func TestEditGameCreators(t *testing.T) {
 			existingCustomJobs:                  []*domain.GameCreatorCustomJob{existingCustomJob},
 			executeGetCreatorsByUserIDs:         true,
 			existingCreators:                    []*domain.GameCreator{existingCreator},
+			executeGetCreatorsByGameID:          true,
 			executeCreateGameCreatorCustomJobs:  true,
 			executeCreateGameCreators:           true,
 			executeUpsertPresetJobsRelations:    true,
 			executeUpsertCustomJobsRelations:    true,
+			executeUpdateJobDisplayOrders:       true,
 			wantErr:                             nil,
 		},
 	}
@@ -809,6 +862,11 @@ func TestEditGameCreators(t *testing.T) {
 					GetGameCreatorsByUserIDs(gomock.Any(), testCase.gameID, userIDs).
 					Return(testCase.existingCreators, testCase.getCreatorsByUserIDsErr)
 			}
+			if testCase.executeGetCreatorsByGameID {
+				mockGameCreatorRepo.EXPECT().
+					GetGameCreatorsByGameID(gomock.Any(), testCase.gameID).
+					Return([]*domain.GameCreatorWithJobs{domain.NewGameCreatorWithJobs(existingCreator, nil, nil)}, testCase.getCreatorsByGameIDErr)
+			}
 			if testCase.executeCreateGameCreatorCustomJobs {
 				mockGameCreatorRepo.EXPECT().
 					CreateGameCreatorCustomJobs(gomock.Any(), gomock.Any()).
@@ -830,6 +888,19 @@ func TestEditGameCreators(t *testing.T) {
 					Return(testCase.upsertCustomJobsRelationsErr)
 			}
 
+			if testCase.executeUpdateJobDisplayOrders {
+				if testCase.updateJobDisplayOrdersErr != nil {
+					mockGameCreatorRepo.EXPECT().
+						UpdateGameCreatorJobDisplayOrders(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(testCase.updateJobDisplayOrdersErr)
+				} else {
+					mockGameCreatorRepo.EXPECT().
+						UpdateGameCreatorJobDisplayOrders(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil).
+						Times(len(testCase.inputs))
+				}
+			}
+
 			err := gc.EditGameCreators(t.Context(), sess, testCase.gameID, testCase.inputs)
 
 			if testCase.wantErr != nil {
@@ -841,3 +912,494 @@ func TestEditGameCreators(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateExternalGameCreator(t *testing.T) {
+	t.Parallel()
+
+	gameID := values.NewGameID()
+	externalName := values.NewGameCreatorExternalName("外部の作曲者")
+	externalURL := values.NewGameCreatorExternalURL(&url.URL{Scheme: "https", Host: "example.com"})
+
+	existingCreator := domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(uuid.New()), gameID, values.NewTrapMemberName("name"), time.Now())
+	existingCreator.SetDisplayOrder(3)
+
+	testCases := map[string]struct {
+		externalURL           values.GameCreatorExternalURL
+		getGameErr            error
+		executeGetCreators    bool
+		creators              []*domain.GameCreatorWithJobs
+		getCreatorsErr        error
+		executeCreateCreators bool
+		createCreatorsErr     error
+		expectedDisplayOrder  int
+		err                   error
+	}{
+		"特に問題ないのでエラー無し": {
+			externalURL:           externalURL,
+			executeGetCreators:    true,
+			creators:              []*domain.GameCreatorWithJobs{domain.NewGameCreatorWithJobs(existingCreator, nil, nil)},
+			executeCreateCreators: true,
+			expectedDisplayOrder:  4,
+		},
+		"URLが無くてもエラー無し": {
+			executeGetCreators:    true,
+			creators:              []*domain.GameCreatorWithJobs{},
+			executeCreateCreators: true,
+			expectedDisplayOrder:  0,
+		},
+		"ゲームが存在しないのでErrInvalidGameID": {
+			getGameErr: repository.ErrRecordNotFound,
+			err:        service.ErrInvalidGameID,
+		},
+		"GetGameがエラーなのでエラー": {
+			getGameErr: assert.AnError,
+			err:        assert.AnError,
+		},
+		"GetGameCreatorsByGameIDがエラーなのでエラー": {
+			executeGetCreators: true,
+			getCreatorsErr:     assert.AnError,
+			err:                assert.AnError,
+		},
+		"CreateGameCreatorsがエラーなのでエラー": {
+			executeGetCreators:    true,
+			creators:              []*domain.GameCreatorWithJobs{},
+			executeCreateCreators: true,
+			createCreatorsErr:     assert.AnError,
+			err:                   assert.AnError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			gameCreatorRepo := mock.NewMockGameCreator(ctrl)
+			gameRepository := mock.NewMockGameV2(ctrl)
+			db := mock.NewMockDB(ctrl)
+			gc := NewGameCreator(gameCreatorRepo, gameRepository, db, nil)
+
+			gameRepository.EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+				Return(nil, testCase.getGameErr)
+			if testCase.executeGetCreators {
+				gameCreatorRepo.EXPECT().
+					GetGameCreatorsByGameID(gomock.Any(), gameID).
+					Return(testCase.creators, testCase.getCreatorsErr)
+			}
+			if testCase.executeCreateCreators {
+				gameCreatorRepo.EXPECT().
+					CreateGameCreators(gomock.Any(), gomock.Any()).
+					Return(testCase.createCreatorsErr)
+			}
+
+			creator, err := gc.CreateExternalGameCreator(t.Context(), gameID, externalName, testCase.externalURL)
+
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, creator.IsExternal())
+			assert.Equal(t, gameID, creator.GetGameID())
+			assert.Equal(t, externalName, creator.GetExternalName())
+			assert.Equal(t, testCase.externalURL, creator.GetExternalURL())
+			assert.Equal(t, testCase.expectedDisplayOrder, creator.GetDisplayOrder())
+		})
+	}
+}
+
+func TestEditGameCreatorJobs(t *testing.T) {
+	t.Parallel()
+
+	gameID := values.NewGameID()
+	creatorID := values.NewGameCreatorID()
+	creator := domain.NewExternalGameCreator(creatorID, gameID, values.NewGameCreatorExternalName("外部の声優"), nil, time.Now())
+	creatorOfAnotherGame := domain.NewExternalGameCreator(creatorID, values.NewGameID(), values.NewGameCreatorExternalName("外部の声優"), nil, time.Now())
+
+	presetJob := domain.NewGameCreatorJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Sound"), time.Now())
+	customJob := domain.NewGameCreatorCustomJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Voice"), gameID, time.Now())
+
+	testCases := map[string]struct {
+		jobIDs                 []values.GameCreatorJobID
+		executeGetGame         bool
+		getGameErr             error
+		executeGetJobs         bool
+		getPresetJobsErr       error
+		executeGetCreator      bool
+		creator                *domain.GameCreator
+		getCreatorErr          error
+		executeEdit            bool
+		deletePresetJobsErr    error
+		updateDisplayOrdersErr error
+		expectedPresetJobs     []*domain.GameCreatorJob
+		expectedCustomJobs     []*domain.GameCreatorCustomJob
+		err                    error
+	}{
+		"特に問題ないのでエラー無し": {
+			jobIDs:             []values.GameCreatorJobID{customJob.GetID(), presetJob.GetID()},
+			executeGetGame:     true,
+			executeGetJobs:     true,
+			executeGetCreator:  true,
+			creator:            creator,
+			executeEdit:        true,
+			expectedPresetJobs: []*domain.GameCreatorJob{presetJob},
+			expectedCustomJobs: []*domain.GameCreatorCustomJob{customJob},
+		},
+		"jobを空にできる": {
+			jobIDs:             []values.GameCreatorJobID{},
+			executeGetGame:     true,
+			executeGetJobs:     true,
+			executeGetCreator:  true,
+			creator:            creator,
+			executeEdit:        true,
+			expectedPresetJobs: []*domain.GameCreatorJob{},
+			expectedCustomJobs: []*domain.GameCreatorCustomJob{},
+		},
+		"同じjob idが含まれるのでErrDuplicateGameCreatorJobID": {
+			jobIDs: []values.GameCreatorJobID{presetJob.GetID(), presetJob.GetID()},
+			err:    service.ErrDuplicateGameCreatorJobID,
+		},
+		"ゲームが存在しないのでErrInvalidGameID": {
+			jobIDs:         []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame: true,
+			getGameErr:     repository.ErrRecordNotFound,
+			err:            service.ErrInvalidGameID,
+		},
+		"jobの取得でエラーなのでエラー": {
+			jobIDs:           []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame:   true,
+			executeGetJobs:   true,
+			getPresetJobsErr: assert.AnError,
+			err:              assert.AnError,
+		},
+		"存在しないjob idが含まれるのでErrInvalidGameCreatorJobID": {
+			jobIDs:         []values.GameCreatorJobID{values.NewGameCreatorJobID()},
+			executeGetGame: true,
+			executeGetJobs: true,
+			err:            service.ErrInvalidGameCreatorJobID,
+		},
+		"creatorが存在しないのでErrInvalidGameCreatorID": {
+			jobIDs:            []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame:    true,
+			executeGetJobs:    true,
+			executeGetCreator: true,
+			getCreatorErr:     repository.ErrRecordNotFound,
+			err:               service.ErrInvalidGameCreatorID,
+		},
+		"creatorが別のゲームのものなのでErrInvalidGameCreatorGamePair": {
+			jobIDs:            []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame:    true,
+			executeGetJobs:    true,
+			executeGetCreator: true,
+			creator:           creatorOfAnotherGame,
+			err:               service.ErrInvalidGameCreatorGamePair,
+		},
+		"DeleteGameCreatorPresetJobsがエラーなのでエラー": {
+			jobIDs:              []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame:      true,
+			executeGetJobs:      true,
+			executeGetCreator:   true,
+			creator:             creator,
+			executeEdit:         true,
+			deletePresetJobsErr: assert.AnError,
+			err:                 assert.AnError,
+		},
+		"UpdateGameCreatorJobDisplayOrdersがエラーなのでエラー": {
+			jobIDs:                 []values.GameCreatorJobID{presetJob.GetID()},
+			executeGetGame:         true,
+			executeGetJobs:         true,
+			executeGetCreator:      true,
+			creator:                creator,
+			executeEdit:            true,
+			updateDisplayOrdersErr: assert.AnError,
+			err:                    assert.AnError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			gameCreatorRepo := mock.NewMockGameCreator(ctrl)
+			gameRepository := mock.NewMockGameV2(ctrl)
+			db := mock.NewMockDB(ctrl)
+			gc := NewGameCreator(gameCreatorRepo, gameRepository, db, nil)
+
+			if testCase.executeGetGame {
+				gameRepository.EXPECT().
+					GetGame(gomock.Any(), gameID, repository.LockTypeNone).
+					Return(nil, testCase.getGameErr)
+			}
+			if testCase.executeGetJobs {
+				gameCreatorRepo.EXPECT().
+					GetGameCreatorPresetJobs(gomock.Any()).
+					Return([]*domain.GameCreatorJob{presetJob}, testCase.getPresetJobsErr)
+				if testCase.getPresetJobsErr == nil {
+					gameCreatorRepo.EXPECT().
+						GetGameCreatorCustomJobsByGameID(gomock.Any(), gameID).
+						Return([]*domain.GameCreatorCustomJob{customJob}, nil)
+				}
+			}
+			if testCase.executeGetCreator {
+				gameCreatorRepo.EXPECT().
+					GetGameCreatorByID(gomock.Any(), creatorID).
+					Return(testCase.creator, testCase.getCreatorErr)
+			}
+			if testCase.executeEdit {
+				gameCreatorRepo.EXPECT().
+					DeleteGameCreatorPresetJobs(gomock.Any(), creatorID).
+					Return(testCase.deletePresetJobsErr)
+				if testCase.deletePresetJobsErr == nil {
+					gameCreatorRepo.EXPECT().
+						DeleteGameCreatorCustomJobs(gomock.Any(), creatorID).
+						Return(nil)
+					gameCreatorRepo.EXPECT().
+						UpsertGameCreatorPresetJobsRelations(gomock.Any(), gomock.Any()).
+						Return(nil)
+					gameCreatorRepo.EXPECT().
+						UpsertGameCreatorCustomJobsRelations(gomock.Any(), gomock.Any()).
+						Return(nil)
+					gameCreatorRepo.EXPECT().
+						UpdateGameCreatorJobDisplayOrders(gomock.Any(), creatorID, testCase.jobIDs).
+						Return(testCase.updateDisplayOrdersErr)
+				}
+			}
+
+			creatorWithJobs, err := gc.EditGameCreatorJobs(t.Context(), gameID, creatorID, testCase.jobIDs)
+
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.creator, creatorWithJobs.GetGameCreator())
+			assert.Equal(t, testCase.expectedPresetJobs, creatorWithJobs.GetJobs())
+			assert.Equal(t, testCase.expectedCustomJobs, creatorWithJobs.GetCustomJobs())
+			for i, jobID := range testCase.jobIDs {
+				assert.Equal(t, i, creatorWithJobs.GetJobDisplayOrder(jobID))
+			}
+		})
+	}
+}
+
+func TestUpdateGameCreatorsOrder(t *testing.T) {
+	t.Parallel()
+
+	gameID := values.NewGameID()
+	newCreators := func() (*domain.GameCreatorWithJobs, *domain.GameCreatorWithJobs) {
+		creator1 := domain.NewGameCreatorWithJobs(
+			domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(uuid.New()), gameID, values.NewTrapMemberName("name"), time.Now()),
+			nil, nil,
+		)
+		creator2 := domain.NewGameCreatorWithJobs(
+			domain.NewExternalGameCreator(values.NewGameCreatorID(), gameID, values.NewGameCreatorExternalName("外部の作曲者"), nil, time.Now()),
+			nil, nil,
+		)
+		creator2.GetGameCreator().SetDisplayOrder(1)
+		return creator1, creator2
+	}
+
+	testCases := map[string]struct {
+		order               func(creator1, creator2 *domain.GameCreatorWithJobs) []values.GameCreatorID
+		getGameErr          error
+		executeGetCreators  bool
+		getCreatorsErr      error
+		executeUpdateOrders bool
+		updateOrdersErr     error
+		isReversed          bool
+		err                 error
+	}{
+		"特に問題ないのでエラー無し": {
+			order: func(creator1, creator2 *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{creator2.GetGameCreator().GetID(), creator1.GetGameCreator().GetID()}
+			},
+			executeGetCreators:  true,
+			executeUpdateOrders: true,
+			isReversed:          true,
+		},
+		"ゲームが存在しないのでErrInvalidGameID": {
+			order: func(_, _ *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{}
+			},
+			getGameErr: repository.ErrRecordNotFound,
+			err:        service.ErrInvalidGameID,
+		},
+		"GetGameCreatorsByGameIDがエラーなのでエラー": {
+			order: func(_, _ *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{}
+			},
+			executeGetCreators: true,
+			getCreatorsErr:     assert.AnError,
+			err:                assert.AnError,
+		},
+		"クリエイターが足りないのでErrInvalidGameCreatorOrder": {
+			order: func(creator1, _ *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{creator1.GetGameCreator().GetID()}
+			},
+			executeGetCreators: true,
+			err:                service.ErrInvalidGameCreatorOrder,
+		},
+		"同じクリエイターが含まれるのでErrInvalidGameCreatorOrder": {
+			order: func(creator1, _ *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{creator1.GetGameCreator().GetID(), creator1.GetGameCreator().GetID()}
+			},
+			executeGetCreators: true,
+			err:                service.ErrInvalidGameCreatorOrder,
+		},
+		"存在しないクリエイターが含まれるのでErrInvalidGameCreatorOrder": {
+			order: func(creator1, _ *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{creator1.GetGameCreator().GetID(), values.NewGameCreatorID()}
+			},
+			executeGetCreators: true,
+			err:                service.ErrInvalidGameCreatorOrder,
+		},
+		"UpdateGameCreatorDisplayOrdersがエラーなのでエラー": {
+			order: func(creator1, creator2 *domain.GameCreatorWithJobs) []values.GameCreatorID {
+				return []values.GameCreatorID{creator1.GetGameCreator().GetID(), creator2.GetGameCreator().GetID()}
+			},
+			executeGetCreators:  true,
+			executeUpdateOrders: true,
+			updateOrdersErr:     assert.AnError,
+			err:                 assert.AnError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			gameCreatorRepo := mock.NewMockGameCreator(ctrl)
+			gameRepository := mock.NewMockGameV2(ctrl)
+			db := mock.NewMockDB(ctrl)
+			gc := NewGameCreator(gameCreatorRepo, gameRepository, db, nil)
+
+			creator1, creator2 := newCreators()
+			creatorIDs := testCase.order(creator1, creator2)
+
+			gameRepository.EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+				Return(nil, testCase.getGameErr)
+			if testCase.executeGetCreators {
+				gameCreatorRepo.EXPECT().
+					GetGameCreatorsByGameID(gomock.Any(), gameID).
+					Return([]*domain.GameCreatorWithJobs{creator1, creator2}, testCase.getCreatorsErr)
+			}
+			if testCase.executeUpdateOrders {
+				gameCreatorRepo.EXPECT().
+					UpdateGameCreatorDisplayOrders(gomock.Any(), gameID, creatorIDs).
+					Return(testCase.updateOrdersErr)
+			}
+
+			creators, err := gc.UpdateGameCreatorsOrder(t.Context(), gameID, creatorIDs)
+
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if testCase.isReversed {
+				assert.Equal(t, []*domain.GameCreatorWithJobs{creator2, creator1}, creators)
+			}
+			for i, creator := range creators {
+				assert.Equal(t, i, creator.GetGameCreator().GetDisplayOrder())
+			}
+		})
+	}
+}
+
+func TestGetGameCredits(t *testing.T) {
+	t.Parallel()
+
+	gameID := values.NewGameID()
+	programmer := domain.NewGameCreatorJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Programmer"), time.Now())
+	sound := domain.NewGameCreatorJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Sound"), time.Now())
+	voice := domain.NewGameCreatorCustomJob(values.NewGameCreatorJobID(), values.NewGameCreatorJobDisplayName("Voice"), gameID, time.Now())
+
+	member := domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(uuid.New()), gameID, values.NewTrapMemberName("member"), time.Now())
+	memberWithJobs := domain.NewGameCreatorWithJobs(member, []*domain.GameCreatorJob{programmer, sound}, nil)
+	memberWithJobs.SetJobDisplayOrders(map[values.GameCreatorJobID]int{sound.GetID(): 0, programmer.GetID(): 1})
+
+	external := domain.NewExternalGameCreator(values.NewGameCreatorID(), gameID, values.NewGameCreatorExternalName("外部の声優"), nil, time.Now())
+	externalWithJobs := domain.NewGameCreatorWithJobs(external, []*domain.GameCreatorJob{sound}, []*domain.GameCreatorCustomJob{voice})
+	externalWithJobs.SetJobDisplayOrders(map[values.GameCreatorJobID]int{voice.GetID(): 0, sound.GetID(): 1})
+
+	noJobCreator := domain.NewGameCreatorWithJobs(
+		domain.NewGameCreator(values.NewGameCreatorID(), values.NewTrapMemberID(uuid.New()), gameID, values.NewTrapMemberName("nojob"), time.Now()),
+		nil, nil,
+	)
+
+	testCases := map[string]struct {
+		getGameErr         error
+		executeGetCreators bool
+		creators           []*domain.GameCreatorWithJobs
+		getCreatorsErr     error
+		expected           []*domain.GameCredit
+		err                error
+	}{
+		"ジョブごとにまとめられる": {
+			executeGetCreators: true,
+			creators:           []*domain.GameCreatorWithJobs{memberWithJobs, externalWithJobs, noJobCreator},
+			expected: []*domain.GameCredit{
+				domain.NewGameCredit(sound.GetID(), sound.GetDisplayName(), false, []*domain.GameCreator{member, external}),
+				domain.NewGameCredit(programmer.GetID(), programmer.GetDisplayName(), false, []*domain.GameCreator{member}),
+				domain.NewGameCredit(voice.GetID(), voice.GetDisplayName(), true, []*domain.GameCreator{external}),
+			},
+		},
+		"クリエイターがいなければ空": {
+			executeGetCreators: true,
+			creators:           []*domain.GameCreatorWithJobs{},
+			expected:           []*domain.GameCredit{},
+		},
+		"ゲームが存在しないのでErrInvalidGameID": {
+			getGameErr: repository.ErrRecordNotFound,
+			err:        service.ErrInvalidGameID,
+		},
+		"GetGameCreatorsByGameIDがエラーなのでエラー": {
+			executeGetCreators: true,
+			getCreatorsErr:     assert.AnError,
+			err:                assert.AnError,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			gameCreatorRepo := mock.NewMockGameCreator(ctrl)
+			gameRepository := mock.NewMockGameV2(ctrl)
+			gc := NewGameCreator(gameCreatorRepo, gameRepository, nil, nil)
+
+			gameRepository.EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeNone).
+				Return(nil, testCase.getGameErr)
+			if testCase.executeGetCreators {
+				gameCreatorRepo.EXPECT().
+					GetGameCreatorsByGameID(gomock.Any(), gameID).
+					Return(testCase.creators, testCase.getCreatorsErr)
+			}
+
+			credits, err := gc.GetGameCredits(t.Context(), gameID)
+
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, credits, len(testCase.expected))
+			for i, expected := range testCase.expected {
+				assert.Equal(t, expected.GetJobID(), credits[i].GetJobID())
+				assert.Equal(t, expected.GetJobDisplayName(), credits[i].GetJobDisplayName())
+				assert.Equal(t, expected.IsCustomJob(), credits[i].IsCustomJob())
+				assert.Equal(t, expected.GetCreators(), credits[i].GetCreators())
+			}
+		})
+	}
+}
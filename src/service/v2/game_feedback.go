@@ -26,6 +26,9 @@ func NewGameFeedback(
 }
 
 func (g *GameFeedback) GetFeedbackConfig(ctx context.Context, gameID values.GameID) (bool, error) {
+	ctx, span := tracer.Start(ctx, "GameFeedback.GetFeedbackConfig")
+	defer span.End()
+
 	_, err := g.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return false, service.ErrInvalidGame
@@ -2,10 +2,15 @@ package v2
 
 import (
 	"archive/zip"
+	"bufio"
+	"compress/flate"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"log/slog"
+	"math"
 	"net/url"
 	"os"
 	"path"
@@ -13,12 +18,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/scanner"
 	"github.com/traPtitech/trap-collection-server/src/service"
 	"github.com/traPtitech/trap-collection-server/src/storage"
-	"golang.org/x/sync/errgroup"
 )
 
 type GameFile struct {
@@ -26,39 +34,82 @@ type GameFile struct {
 	gameRepository     repository.GameV2
 	gameFileRepository repository.GameFileV2
 	gameFileStorage    storage.GameFile
+	gameFileScanner    scanner.GameFile
+	gameStorage        *GameStorage
+	user               *User
+	auditLog           *AuditLog
+	// maxUncompressedSize
+	// zipファイルの展開後の合計サイズの上限。
+	maxUncompressedSize uint64
+	// maxEntries
+	// zipファイルのエントリー数の上限。
+	maxEntries int
+	// maxCompressionRatio
+	// zipファイルの各エントリーの圧縮率の上限。
+	maxCompressionRatio float64
 }
 
 func NewGameFile(
+	conf config.ServiceV2,
 	db repository.DB,
 	gameRepository repository.GameV2,
 	gameFileRepository repository.GameFileV2,
 	gameFileStorage storage.GameFile,
-) *GameFile {
-	return &GameFile{
-		db:                 db,
-		gameRepository:     gameRepository,
-		gameFileRepository: gameFileRepository,
-		gameFileStorage:    gameFileStorage,
+	gameFileScanner scanner.GameFile,
+	gameStorage *GameStorage,
+	user *User,
+	auditLog *AuditLog,
+) (*GameFile, error) {
+	maxUncompressedSize, err := conf.GameFileMaxUncompressedSize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max uncompressed size: %w", err)
 	}
+
+	maxEntries, err := conf.GameFileMaxEntries()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max entries: %w", err)
+	}
+
+	maxCompressionRatio, err := conf.GameFileMaxCompressionRatio()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get max compression ratio: %w", err)
+	}
+
+	return &GameFile{
+		db:                  db,
+		gameRepository:      gameRepository,
+		gameFileRepository:  gameFileRepository,
+		gameFileStorage:     gameFileStorage,
+		gameFileScanner:     gameFileScanner,
+		gameStorage:         gameStorage,
+		user:                user,
+		auditLog:            auditLog,
+		maxUncompressedSize: uint64(maxUncompressedSize),
+		maxEntries:          maxEntries,
+		maxCompressionRatio: maxCompressionRatio,
+	}, nil
 }
 
-func (*GameFile) checkZip(_ context.Context, reader io.Reader) (zr *zip.Reader, ok bool, err error) {
+// saveTempFile
+// ハッシュ値を計算しながら、readerの内容を一時ファイルに書き出す。
+// 一時ファイルの削除は呼び出し側で行う。
+func (*GameFile) saveTempFile(reader io.Reader) (*os.File, values.GameFileHash, error) {
 	f, err := os.CreateTemp("", "game_file")
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to create temp file: %w", err)
+		return nil, nil, fmt.Errorf("failed to create temp file: %w", err)
 	}
-	defer func() {
-		err = os.Remove(f.Name())
-	}()
-	defer func() {
-		err = f.Close()
-	}()
 
-	_, err = io.Copy(f, reader)
+	hash, err := values.NewGameFileHash(io.TeeReader(reader, f))
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to copy file: %w", err)
+		f.Close()
+		os.Remove(f.Name())
+		return nil, nil, fmt.Errorf("failed to copy file: %w", err)
 	}
 
+	return f, hash, nil
+}
+
+func (*GameFile) checkZip(_ context.Context, f *os.File) (zr *zip.Reader, ok bool, err error) {
 	fInfo, err := f.Stat()
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to get file info: %w", err)
@@ -74,6 +125,182 @@ func (*GameFile) checkZip(_ context.Context, reader io.Reader) (zr *zip.Reader,
 	return zr, true, nil
 }
 
+// minCompressionRatioCheckSize
+// 圧縮率を確認するエントリーの、展開後のサイズの下限。
+// 小さいファイルは圧縮率が高くなりやすく、展開しても問題にならないので確認しない。
+const minCompressionRatioCheckSize = 1 << 20
+
+// checkZipEntries
+// ランチャーがzipファイルを安全に展開できるよう、全てのエントリーを確認する。
+// ヘッダーに記録されたサイズは偽装できるので、各エントリーを実際に展開してサイズと圧縮率を確認する。
+func (gameFile *GameFile) checkZipEntries(_ context.Context, zr *zip.Reader) error {
+	if len(zr.File) > gameFile.maxEntries {
+		return service.ErrZipTooManyEntries
+	}
+
+	var (
+		totalSize   uint64
+		names       = make(map[string]struct{}, len(zr.File))
+		entryErrors []*service.ZipEntryError
+	)
+	for _, zf := range zr.File {
+		// totalSizeは常にmaxUncompressedSize以下なので、引き算でオーバーフローしない
+		size, err := readZipEntrySize(zf, gameFile.maxUncompressedSize-totalSize)
+		if err != nil {
+			return err
+		}
+		totalSize += size.uncompressed
+
+		reason, ok := gameFile.checkZipEntry(zf, size, names)
+		if !ok {
+			entryErrors = append(entryErrors, &service.ZipEntryError{
+				Name:   zf.Name,
+				Reason: reason,
+			})
+		}
+	}
+
+	if len(entryErrors) != 0 {
+		return &service.InvalidZipEntriesError{
+			Entries: entryErrors,
+		}
+	}
+
+	return nil
+}
+
+// checkZipEntry
+// 問題のないエントリーであればtrueを返す。
+// 問題がある場合は、その理由を第1返り値で返す。
+// namesには確認済みのエントリーの名前が入っており、問題のないエントリーの名前を追加する。
+func (gameFile *GameFile) checkZipEntry(zf *zip.File, size zipEntrySize, names map[string]struct{}) (service.ZipEntryErrorReason, bool) {
+	// Windowsで作成されたzipファイルでは、区切り文字が\になっていることがある
+	name := strings.ReplaceAll(zf.Name, `\`, "/")
+
+	if strings.HasPrefix(name, "/") || hasWindowsDriveLetter(name) {
+		return service.ZipEntryErrorReasonAbsolutePath, false
+	}
+
+	if slices.Contains(strings.Split(name, "/"), "..") {
+		return service.ZipEntryErrorReasonPathTraversal, false
+	}
+
+	if zf.Mode()&fs.ModeSymlink != 0 {
+		return service.ZipEntryErrorReasonSymlink, false
+	}
+
+	// ディレクトリのエントリーは末尾に/が付くので、取り除いてから比較する
+	cleanName := path.Clean(name)
+	if _, ok := names[cleanName]; ok {
+		return service.ZipEntryErrorReasonDuplicateName, false
+	}
+	names[cleanName] = struct{}{}
+
+	if size.uncompressed >= minCompressionRatioCheckSize &&
+		(size.compressed == 0 || float64(size.uncompressed)/float64(size.compressed) > gameFile.maxCompressionRatio) {
+		return service.ZipEntryErrorReasonCompressionRatio, false
+	}
+
+	return 0, true
+}
+
+// zipEntrySize
+// エントリーを実際に展開して得たサイズ。
+type zipEntrySize struct {
+	// compressed
+	// 展開のために読み込んだ圧縮後のデータのサイズ。
+	compressed uint64
+	// uncompressed
+	// 展開後のデータのサイズ。
+	uncompressed uint64
+}
+
+// readZipEntrySize
+// エントリーを展開しながら読み捨て、実際のサイズを返す。
+// 展開後のサイズがlimitを超えた時点で展開をやめ、ErrZipTooLargeを返す。
+// 展開できないエントリーの場合、ErrNotZipFileを返す。
+func readZipEntrySize(zf *zip.File, limit uint64) (zipEntrySize, error) {
+	raw, err := zf.OpenRaw()
+	if errors.Is(err, zip.ErrFormat) {
+		return zipEntrySize{}, service.ErrNotZipFile
+	}
+	if err != nil {
+		return zipEntrySize{}, fmt.Errorf("failed to open zip entry: %w", err)
+	}
+
+	// 圧縮後のサイズもヘッダーの値ではなく、展開に実際に使った分を数える
+	counter := &zipEntryCountReader{r: bufio.NewReader(raw)}
+
+	var r io.ReadCloser
+	switch zf.Method {
+	case zip.Store:
+		r = io.NopCloser(counter)
+	case zip.Deflate:
+		r = flate.NewReader(counter)
+	default:
+		// ランチャーが展開できない圧縮方式は受け付けない
+		return zipEntrySize{}, service.ErrNotZipFile
+	}
+	defer r.Close()
+
+	readLimit := int64(math.MaxInt64)
+	if limit < math.MaxInt64 {
+		readLimit = int64(limit) + 1
+	}
+
+	n, err := io.CopyN(io.Discard, r, readLimit)
+	var corruptErr flate.CorruptInputError
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &corruptErr) {
+		return zipEntrySize{}, service.ErrNotZipFile
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return zipEntrySize{}, fmt.Errorf("failed to read zip entry: %w", err)
+	}
+
+	if uint64(n) > limit {
+		return zipEntrySize{}, service.ErrZipTooLarge
+	}
+
+	return zipEntrySize{
+		compressed:   counter.n,
+		uncompressed: uint64(n),
+	}, nil
+}
+
+// zipEntryCountReader
+// 読み込んだバイト数を数えるio.Reader。
+// flate.NewReaderはio.ByteReaderを実装していれば先読みしないので、
+// 展開に使った圧縮後のデータのサイズを正確に数えられる。
+type zipEntryCountReader struct {
+	r *bufio.Reader
+	n uint64
+}
+
+func (r *zipEntryCountReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += uint64(n)
+	return n, err
+}
+
+func (r *zipEntryCountReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.n++
+	}
+	return b, err
+}
+
+// hasWindowsDriveLetter
+// C:のようなドライブレターから始まるパスであればtrueを返す。
+func hasWindowsDriveLetter(name string) bool {
+	if len(name) < 2 || name[1] != ':' {
+		return false
+	}
+
+	c := name[0]
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
 func zipFileContains(zr *zip.Reader, filePath string, isDir bool) bool {
 	return slices.ContainsFunc(zr.File, func(zf *zip.File) bool {
 		if isDir {
@@ -134,6 +361,8 @@ func (*GameFile) checkMacOSAppEntryPointValid(_ context.Context, zr *zip.Reader,
 }
 
 func (gameFile *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, gameID values.GameID, fileType values.GameFileType, entryPoint values.GameFileEntryPoint) (*domain.GameFile, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.SaveGameFile")
+	defer span.End()
 
 	var file *domain.GameFile
 	err := gameFile.db.Transaction(ctx, nil, func(ctx context.Context) error {
@@ -145,92 +374,82 @@ func (gameFile *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, ga
 			return fmt.Errorf("failed to get game: %w", err)
 		}
 
-		fileID := values.NewGameFileID()
-
-		eg, ctx := errgroup.WithContext(ctx)
-		hashPr, hashPw := io.Pipe()
-		filePr, filePw := io.Pipe()
-		entryPointPr, entryPointPw := io.Pipe()
+		quotaReader, err := gameFile.gameStorage.newQuotaReader(ctx, gameID, reader)
+		if err != nil {
+			return fmt.Errorf("failed to create quota reader: %w", err)
+		}
 
-		eg.Go(func() error {
-			defer hashPr.Close()
+		// 同じ内容のファイルを重複して保存しないよう、ハッシュ値が分かってからストレージに保存する。
+		// そのため、一度一時ファイルに書き出す。
+		f, hash, err := gameFile.saveTempFile(quotaReader)
+		if err != nil {
+			return fmt.Errorf("failed to save temp file: %w", err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
 
-			hash, err := values.NewGameFileHash(hashPr)
-			if err != nil {
-				return fmt.Errorf("failed to get hash: %w", err)
-			}
+		zr, ok, err := gameFile.checkZip(ctx, f)
+		if err != nil {
+			return fmt.Errorf("failed to check zip: %w", err)
+		}
+		if !ok {
+			return service.ErrNotZipFile
+		}
 
-			file = domain.NewGameFile(
-				fileID,
-				fileType,
-				entryPoint,
-				hash,
-				time.Now(),
-			)
+		err = gameFile.checkZipEntries(ctx, zr)
+		if err != nil {
+			return err
+		}
 
-			err = gameFile.gameFileRepository.SaveGameFile(ctx, gameID, file)
+		// これらのどれか一つで成功した場合(trueが返ってきた場合)、有効なエントリーポイントとして扱う
+		checkers := []func(context.Context, *zip.Reader, values.GameFileEntryPoint) (bool, error){
+			gameFile.checkEntryPointExist,
+			gameFile.checkMacOSAppEntryPointValid,
+		}
+		ok = false
+		for _, checker := range checkers {
+			ok, err = checker(ctx, zr, entryPoint)
 			if err != nil {
-				return fmt.Errorf("failed to save game file: %w", err)
+				return fmt.Errorf("failed to check entry point: %w", err)
 			}
-
-			return nil
-		})
-
-		eg.Go(func() error {
-			defer filePr.Close()
-
-			err = gameFile.gameFileStorage.SaveGameFile(ctx, filePr, fileID)
-			if err != nil {
-				return fmt.Errorf("failed to save game file: %w", err)
+			if ok {
+				break
 			}
+		}
+		if !ok {
+			return service.ErrInvalidEntryPoint
+		}
 
-			return nil
-		})
+		file = domain.NewGameFile(
+			values.NewGameFileID(),
+			fileType,
+			entryPoint,
+			hash,
+			time.Now(),
+		)
+		file.SetSize(quotaReader.Size())
 
-		eg.Go(func() error {
-			defer entryPointPr.Close()
+		created, err := gameFile.gameFileRepository.SaveGameFileBlob(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to save game file blob: %w", err)
+		}
 
-			zr, ok, err := gameFile.checkZip(ctx, entryPointPr)
+		// 同じ内容のファイルが既に保存されている場合は、ストレージには保存しない
+		if created {
+			_, err = f.Seek(0, io.SeekStart)
 			if err != nil {
-				return fmt.Errorf("failed to check zip: %w", err)
-			}
-			if !ok {
-				return service.ErrNotZipFile
-			}
-
-			// これらのどれか一つで成功した場合(trueが返ってきた場合)、有効なエントリーポイントとして扱う
-			checkers := []func(context.Context, *zip.Reader, values.GameFileEntryPoint) (bool, error){
-				gameFile.checkEntryPointExist,
-				gameFile.checkMacOSAppEntryPointValid,
+				return fmt.Errorf("failed to seek temp file: %w", err)
 			}
-			for _, checker := range checkers {
-				ok, err = checker(ctx, zr, entryPoint)
-				if err != nil {
-					return fmt.Errorf("failed to check entry point: %w", err)
-				}
-				if ok {
-					return nil
-				}
-			}
-
-			return service.ErrInvalidEntryPoint
-		})
-
-		eg.Go(func() error {
-			defer hashPw.Close()
-			defer filePw.Close()
-			defer entryPointPw.Close()
 
-			mw := io.MultiWriter(hashPw, filePw, entryPointPw)
-			_, err = io.Copy(mw, reader)
-			if err != nil {
-				return fmt.Errorf("failed to copy file: %w", err)
+			err = gameFile.gameFileStorage.SaveGameFile(ctx, f, hash)
+			// 失敗したトランザクションで、ストレージにのみ保存されていることがある。
+			// 同じハッシュ値なら内容も同じなので、そのまま使う。
+			if err != nil && !errors.Is(err, storage.ErrAlreadyExists) {
+				return fmt.Errorf("failed to save game file: %w", err)
 			}
+		}
 
-			return nil
-		})
-
-		err = eg.Wait()
+		err = gameFile.gameFileRepository.SaveGameFile(ctx, gameID, file)
 		if err != nil {
 			return fmt.Errorf("failed to save game file: %w", err)
 		}
@@ -245,6 +464,9 @@ func (gameFile *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, ga
 }
 
 func (gameFile *GameFile) GetGameFile(ctx context.Context, gameID values.GameID, fileID values.GameFileID) (values.GameFileTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetGameFile")
+	defer span.End()
+
 	_, err := gameFile.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -285,6 +507,9 @@ func (gameFile *GameFile) GetGameFile(ctx context.Context, gameID values.GameID,
 }
 
 func (gameFile *GameFile) GetGameFiles(ctx context.Context, gameID values.GameID) ([]*domain.GameFile, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetGameFiles")
+	defer span.End()
+
 	_, err := gameFile.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -302,6 +527,9 @@ func (gameFile *GameFile) GetGameFiles(ctx context.Context, gameID values.GameID
 }
 
 func (gameFile *GameFile) GetGameFileMeta(ctx context.Context, gameID values.GameID, fileID values.GameFileID) (*domain.GameFile, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetGameFileMeta")
+	defer span.End()
+
 	_, err := gameFile.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -327,3 +555,190 @@ func (gameFile *GameFile) GetGameFileMeta(ctx context.Context, gameID values.Gam
 
 	return file.GameFile, nil
 }
+
+func (gameFile *GameFile) ScanGameFiles(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "GameFile.ScanGameFiles")
+	defer span.End()
+
+	files, err := gameFile.gameFileRepository.GetGameFilesByScanStatus(ctx, values.GameFileScanStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to get pending game files: %w", err)
+	}
+
+	// 同じ内容のファイルはストレージ上の実体を共有しているので、1度だけ検査する
+	scanStatusMap := make(map[string]values.GameFileScanStatus, len(files))
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context done: %w", err)
+		}
+
+		scanStatus, ok := scanStatusMap[file.GetHash().String()]
+		if !ok {
+			scanStatus, err = gameFile.scanGameFile(ctx, file.GameFile)
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					// キャンセルによる失敗は検査の失敗として数えない
+					return fmt.Errorf("context done: %w", ctxErr)
+				}
+
+				logger.Error(ctx, "failed to scan game file", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.Any("error", err))
+				gameFile.recordScanFailure(ctx, file.GameFile, err)
+				continue
+			}
+
+			scanStatusMap[file.GetHash().String()] = scanStatus
+		}
+
+		err = gameFile.gameFileRepository.UpdateGameFileScanStatus(ctx, file.GetID(), scanStatus)
+		if err != nil {
+			logger.Error(ctx, "failed to update game file scan status", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.Any("error", err))
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (gameFile *GameFile) UpdateGameFileScanStatus(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, fileID values.GameFileID, scanStatus values.GameFileScanStatus) (*domain.GameFile, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.UpdateGameFileScanStatus")
+	defer span.End()
+
+	// 未検査・検査の失敗への変更は、検査をせずに状態を変えるだけになってしまうので受け付けない
+	if scanStatus != values.GameFileScanStatusClean && scanStatus != values.GameFileScanStatusRejected {
+		return nil, service.ErrInvalidGameFileScanStatus
+	}
+
+	return gameFile.updateGameFileScanStatus(ctx, session, gameID, fileID, scanStatus)
+}
+
+func (gameFile *GameFile) RescanGameFile(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, fileID values.GameFileID) (*domain.GameFile, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.RescanGameFile")
+	defer span.End()
+
+	return gameFile.updateGameFileScanStatus(ctx, session, gameID, fileID, values.GameFileScanStatusPending)
+}
+
+// updateGameFileScanStatus
+// adminの操作によってゲームファイルの検査の状態を変更し、監査ログに記録する。
+func (gameFile *GameFile) updateGameFileScanStatus(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, fileID values.GameFileID, scanStatus values.GameFileScanStatus) (*domain.GameFile, error) {
+	myInfo, err := gameFile.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var file *domain.GameFile
+	err = gameFile.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameFile.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		fileInfo, err := gameFile.gameFileRepository.GetGameFile(ctx, fileID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameFileID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game file: %w", err)
+		}
+
+		if fileInfo.GameID != gameID {
+			return service.ErrInvalidGameFileID
+		}
+		file = fileInfo.GameFile
+
+		before := file.GetScanStatus()
+
+		err = gameFile.gameFileRepository.UpdateGameFileScanStatus(ctx, fileID, scanStatus)
+		// 検査の状態が既に同じ場合は、変更がなくても成功として扱う
+		if err != nil && !errors.Is(err, repository.ErrNoRecordUpdated) {
+			return fmt.Errorf("failed to update game file scan status: %w", err)
+		}
+		file.SetScanStatus(scanStatus)
+
+		err = gameFile.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionUpdateGameFileScanStatus,
+			values.AuditLogTargetTypeGame,
+			uuid.UUID(gameID),
+			newAuditLogGameFileScanStatusState(fileID, before),
+			newAuditLogGameFileScanStatusState(fileID, scanStatus),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return file, nil
+}
+
+// maxGameFileScanAttempts
+// ゲームファイルの検査に失敗できる回数。
+// 定期実行のジョブは1分ごとなので、約10分失敗し続けたファイルは検査をやめる。
+const maxGameFileScanAttempts = 10
+
+// recordScanFailure
+// 検査に失敗したことを記録し、失敗し続けているファイルは検査の失敗として検査をやめる。
+// ストレージにファイルが存在しない場合は何度検査しても失敗するので、すぐに検査をやめる。
+func (gameFile *GameFile) recordScanFailure(ctx context.Context, file *domain.GameFile, scanErr error) {
+	if !errors.Is(scanErr, storage.ErrNotFound) {
+		attempts, err := gameFile.gameFileRepository.IncrementGameFileScanAttempts(ctx, file.GetID())
+		if err != nil {
+			logger.Error(ctx, "failed to increment game file scan attempts", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.Any("error", err))
+			return
+		}
+
+		if attempts < maxGameFileScanAttempts {
+			return
+		}
+	}
+
+	logger.Warn(ctx, "game file scan failed permanently", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.Any("error", scanErr))
+
+	err := gameFile.gameFileRepository.UpdateGameFileScanStatus(ctx, file.GetID(), values.GameFileScanStatusFailed)
+	if err != nil {
+		logger.Error(ctx, "failed to update game file scan status", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.Any("error", err))
+	}
+}
+
+// scanGameFile
+// ストレージからファイルを一時ファイルに読み込んで検査する。
+// zipファイルの検査にはランダムアクセスが必要なので、一度一時ファイルに書き出す。
+func (gameFile *GameFile) scanGameFile(ctx context.Context, file *domain.GameFile) (values.GameFileScanStatus, error) {
+	f, err := os.CreateTemp("", "game_file_scan")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = gameFile.gameFileStorage.LoadGameFile(ctx, f, file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load game file: %w", err)
+	}
+
+	fInfo, err := f.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get file info: %w", err)
+	}
+
+	result, err := gameFile.gameFileScanner.ScanGameFile(ctx, f, fInfo.Size())
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan game file: %w", err)
+	}
+
+	if !result.Clean {
+		logger.Warn(ctx, "game file rejected by scanner", slog.Any("game_file_id", uuid.UUID(file.GetID())), slog.String("reason", result.Reason))
+		return values.GameFileScanStatusRejected, nil
+	}
+
+	return values.GameFileScanStatusClean, nil
+}
@@ -3,22 +3,34 @@ package v2
 import (
 	"archive/zip"
 	"bytes"
+	"compress/flate"
 	"context"
 	"errors"
 	"io"
+	"io/fs"
 	"net/url"
+	"os"
 	"path"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockAuth "github.com/traPtitech/trap-collection-server/src/auth/mock"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/scanner"
+	mockScanner "github.com/traPtitech/trap-collection-server/src/scanner/mock"
 	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
 	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
 	"github.com/traPtitech/trap-collection-server/testdata"
 	"go.uber.org/mock/gomock"
@@ -62,7 +74,14 @@ func Test_checkZip(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			_, ok, err := gameFile.checkZip(context.Background(), testCase.readerFunc(t))
+			f, _, err := gameFile.saveTempFile(testCase.readerFunc(t))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				f.Close()
+				os.Remove(f.Name())
+			})
+
+			_, ok, err := gameFile.checkZip(context.Background(), f)
 			if testCase.isErr {
 				if testCase.err != nil {
 					assert.ErrorIs(t, err, testCase.err)
@@ -78,6 +97,292 @@ func Test_checkZip(t *testing.T) {
 	}
 }
 
+// newGameFileConfig
+// ゲームファイルのzipの上限として、デフォルト値を返す設定のmockを作る。
+func newGameFileConfig(ctrl *gomock.Controller) *mockConfig.MockServiceV2 {
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
+	mockConf.
+		EXPECT().
+		GameFileMaxUncompressedSize().
+		Return(int64(10<<30), nil)
+	mockConf.
+		EXPECT().
+		GameFileMaxEntries().
+		Return(100000, nil)
+	mockConf.
+		EXPECT().
+		GameFileMaxCompressionRatio().
+		Return(float64(100), nil)
+
+	return mockConf
+}
+
+type rawZipEntrySize struct {
+	compressed   uint64
+	uncompressed uint64
+}
+
+func deflateForTest(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	buf := bytes.NewBuffer(nil)
+	fw, err := flate.NewWriter(buf, flate.BestCompression)
+	require.NoError(t, err)
+	_, err = fw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+
+	return buf.Bytes()
+}
+
+func Test_checkZipEntries(t *testing.T) {
+	t.Parallel()
+
+	type zipEntry struct {
+		name    string
+		content []byte
+		mode    fs.FileMode
+		store   bool
+		// raw
+		// nilでないとき、contentを圧縮せずにそのまま書き込み、ヘッダーのサイズをrawの値にする
+		raw *rawZipEntrySize
+	}
+
+	testCases := map[string]struct {
+		entries []zipEntry
+		isErr   bool
+		err     error
+		// invalidEntries
+		// InvalidZipEntriesErrorが返るときの、不正なエントリーの一覧
+		invalidEntries []*service.ZipEntryError
+	}{
+		"特に問題ないのでエラーなし": {
+			entries: []zipEntry{
+				{name: "game/game.exe", content: []byte("game")},
+				{name: "game/data/", mode: fs.ModeDir},
+				{name: "game/data/a.txt", content: []byte("a")},
+			},
+		},
+		"小さいファイルは圧縮率が高くてもエラーなし": {
+			entries: []zipEntry{
+				{name: "a.txt", content: bytes.Repeat([]byte{0}, 1<<10)},
+			},
+		},
+		"圧縮されていない大きいファイルはエラーなし": {
+			entries: []zipEntry{
+				{name: "a.bin", content: bytes.Repeat([]byte{0}, 2<<20), store: true},
+			},
+		},
+		"エントリー数が上限を超えるのでErrZipTooManyEntries": {
+			entries: []zipEntry{
+				{name: "a.txt"},
+				{name: "b.txt"},
+				{name: "c.txt"},
+				{name: "d.txt"},
+			},
+			isErr: true,
+			err:   service.ErrZipTooManyEntries,
+		},
+		"展開後のサイズが上限を超えるのでErrZipTooLarge": {
+			entries: []zipEntry{
+				{name: "a.bin", content: bytes.Repeat([]byte{1}, 2<<20), store: true},
+				{name: "b.bin", content: bytes.Repeat([]byte{1}, 2<<20), store: true},
+			},
+			isErr: true,
+			err:   service.ErrZipTooLarge,
+		},
+		"絶対パスなのでエラー": {
+			entries: []zipEntry{
+				{name: "/etc/passwd"},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "/etc/passwd", Reason: service.ZipEntryErrorReasonAbsolutePath},
+			},
+		},
+		"ドライブレターから始まるのでエラー": {
+			entries: []zipEntry{
+				{name: `C:\Windows\a.dll`},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: `C:\Windows\a.dll`, Reason: service.ZipEntryErrorReasonAbsolutePath},
+			},
+		},
+		"..を含むのでエラー": {
+			entries: []zipEntry{
+				{name: "game/../../a.txt"},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "game/../../a.txt", Reason: service.ZipEntryErrorReasonPathTraversal},
+			},
+		},
+		"区切り文字が\\でも..を含むのでエラー": {
+			entries: []zipEntry{
+				{name: `..\a.txt`},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: `..\a.txt`, Reason: service.ZipEntryErrorReasonPathTraversal},
+			},
+		},
+		"名前に..を含むだけならエラーなし": {
+			entries: []zipEntry{
+				{name: "game/a..txt"},
+			},
+		},
+		"シンボリックリンクなのでエラー": {
+			entries: []zipEntry{
+				{name: "game/link", content: []byte("/etc/passwd"), mode: fs.ModeSymlink},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "game/link", Reason: service.ZipEntryErrorReasonSymlink},
+			},
+		},
+		"同じ名前のエントリーがあるのでエラー": {
+			entries: []zipEntry{
+				{name: "game/a.txt"},
+				{name: "game/a.txt"},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "game/a.txt", Reason: service.ZipEntryErrorReasonDuplicateName},
+			},
+		},
+		"圧縮率が上限を超えるのでエラー": {
+			entries: []zipEntry{
+				{name: "bomb.bin", content: bytes.Repeat([]byte{0}, 2<<20)},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "bomb.bin", Reason: service.ZipEntryErrorReasonCompressionRatio},
+			},
+		},
+		"ヘッダーの展開後のサイズを偽装していてもErrZipTooLarge": {
+			entries: []zipEntry{
+				{
+					name:    "bomb.bin",
+					content: deflateForTest(t, bytes.Repeat([]byte{0}, 4<<20)),
+					raw:     &rawZipEntrySize{uncompressed: 1},
+				},
+			},
+			isErr: true,
+			err:   service.ErrZipTooLarge,
+		},
+		"ヘッダーの圧縮後のサイズを偽装していても圧縮率でエラー": {
+			entries: []zipEntry{
+				{
+					name:    "bomb.bin",
+					content: deflateForTest(t, bytes.Repeat([]byte{0}, 2<<20)),
+					raw:     &rawZipEntrySize{compressed: 2 << 20, uncompressed: 2 << 20},
+				},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "bomb.bin", Reason: service.ZipEntryErrorReasonCompressionRatio},
+			},
+		},
+		"展開できないエントリーなのでErrNotZipFile": {
+			entries: []zipEntry{
+				{
+					name:    "a.bin",
+					content: []byte{0xff, 0xff, 0xff, 0xff},
+					raw:     &rawZipEntrySize{uncompressed: 4},
+				},
+			},
+			isErr: true,
+			err:   service.ErrNotZipFile,
+		},
+		"不正なエントリーが複数あると全て返す": {
+			entries: []zipEntry{
+				{name: "game/game.exe"},
+				{name: "../a.txt"},
+				{name: "game/link", mode: fs.ModeSymlink},
+			},
+			isErr: true,
+			err:   service.ErrInvalidZipEntries,
+			invalidEntries: []*service.ZipEntryError{
+				{Name: "../a.txt", Reason: service.ZipEntryErrorReasonPathTraversal},
+				{Name: "game/link", Reason: service.ZipEntryErrorReasonSymlink},
+			},
+		},
+	}
+
+	gameFile := &GameFile{
+		maxUncompressedSize: 3 << 20,
+		maxEntries:          3,
+		maxCompressionRatio: 100,
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := bytes.NewBuffer(nil)
+			zw := zip.NewWriter(buf)
+			for _, entry := range testCase.entries {
+				header := &zip.FileHeader{
+					Name:   entry.name,
+					Method: zip.Deflate,
+				}
+				if entry.store {
+					header.Method = zip.Store
+				}
+				header.SetMode(entry.mode | 0o644)
+
+				if entry.raw != nil {
+					header.CompressedSize64 = uint64(len(entry.content))
+					if entry.raw.compressed != 0 {
+						header.CompressedSize64 = entry.raw.compressed
+					}
+					header.UncompressedSize64 = entry.raw.uncompressed
+
+					w, err := zw.CreateRaw(header)
+					require.NoError(t, err)
+					_, err = w.Write(entry.content)
+					require.NoError(t, err)
+					continue
+				}
+
+				w, err := zw.CreateHeader(header)
+				require.NoError(t, err)
+				_, err = w.Write(entry.content)
+				require.NoError(t, err)
+			}
+			require.NoError(t, zw.Close())
+
+			zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			require.NoError(t, err)
+
+			err = gameFile.checkZipEntries(context.Background(), zr)
+			if testCase.isErr {
+				if testCase.err != nil {
+					assert.ErrorIs(t, err, testCase.err)
+				} else {
+					assert.Error(t, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testCase.invalidEntries != nil {
+				var invalidEntriesErr *service.InvalidZipEntriesError
+				require.ErrorAs(t, err, &invalidEntriesErr)
+				assert.Equal(t, testCase.invalidEntries, invalidEntriesErr.Entries)
+			}
+		})
+	}
+}
+
 func Test_checkEntryPointExist(t *testing.T) {
 	t.Parallel()
 
@@ -227,6 +532,15 @@ func TestSaveGameFile(t *testing.T) {
 	mockDB := mockRepository.NewMockDB(ctrl)
 	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+	mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
+	mockConf.
+		EXPECT().
+		DefaultGameStorageQuota().
+		Return(int64(0), false, nil)
+	gameStorage, err := NewGameStorage(mockConf, mockDB, mockGameRepository, mockGameStorageRepository, nil, nil)
+	require.NoError(t, err)
 
 	type test struct {
 		description                   string
@@ -235,10 +549,15 @@ func TestSaveGameFile(t *testing.T) {
 		fileType                      values.GameFileType
 		entryPoint                    values.GameFileEntryPoint
 		GetGameErr                    error
-		executeRepositorySaveGameFile bool
-		repositorySaveGameFileErr     error
+		storageUsage                  values.GameStorageSize
+		storageQuota                  option.Option[values.GameStorageSize]
+		executeSaveBlob               bool
+		blobCreated                   bool
+		saveBlobErr                   error
 		executeStorageSaveGameFile    bool
 		storageSaveGameFileErr        error
+		executeRepositorySaveGameFile bool
+		repositorySaveGameFileErr     error
 		hash                          values.GameFileHash
 		isErr                         bool
 		err                           error
@@ -258,6 +577,9 @@ func TestSaveGameFile(t *testing.T) {
 		return r
 	}
 	testdataZipHash := values.NewGameFileHashFromBytes([]byte{0x02, 0x4d, 0xc4, 0x46, 0xbe, 0x7a, 0xb9, 0x1e, 0x64, 0xb9, 0x50, 0x10, 0x2a, 0x94, 0xb7, 0xbd})
+	testdataZip, err := testdata.FS.ReadFile("a.zip")
+	require.NoError(t, err)
+	testdataZipSize := values.GameStorageSize(len(testdataZip))
 
 	testCases := []test{
 		{
@@ -266,8 +588,10 @@ func TestSaveGameFile(t *testing.T) {
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeJar,
 			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
-			executeRepositorySaveGameFile: true,
+			executeSaveBlob:               true,
+			blobCreated:                   true,
 			executeStorageSaveGameFile:    true,
+			executeRepositorySaveGameFile: true,
 			hash:                          testdataZipHash,
 		},
 		{
@@ -295,20 +619,58 @@ func TestSaveGameFile(t *testing.T) {
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeJar,
 			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
+			executeSaveBlob:               true,
+			blobCreated:                   true,
+			executeStorageSaveGameFile:    true,
 			executeRepositorySaveGameFile: true,
 			repositorySaveGameFileErr:     errors.New("error"),
 			isErr:                         true,
 		},
 		{
-			description:                   "storageのSaveGameFileがエラーなのでエラー",
+			description:                "storageのSaveGameFileがエラーなのでエラー",
+			readerFunc:                 testdataZipReaderFunc,
+			gameID:                     gameID,
+			fileType:                   values.GameFileTypeJar,
+			entryPoint:                 values.NewGameFileEntryPoint("a/b/file"),
+			executeSaveBlob:            true,
+			blobCreated:                true,
+			executeStorageSaveGameFile: true,
+			storageSaveGameFileErr:     errors.New("error"),
+			isErr:                      true,
+		},
+		{
+			description:                   "同じ内容のファイルが既に存在するのでストレージには保存しない",
 			readerFunc:                    testdataZipReaderFunc,
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeJar,
 			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
+			executeSaveBlob:               true,
+			blobCreated:                   false,
 			executeRepositorySaveGameFile: true,
+			hash:                          testdataZipHash,
+		},
+		{
+			description:                   "ストレージにのみ実体が存在してもエラーなし",
+			readerFunc:                    testdataZipReaderFunc,
+			gameID:                        gameID,
+			fileType:                      values.GameFileTypeJar,
+			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
+			executeSaveBlob:               true,
+			blobCreated:                   true,
 			executeStorageSaveGameFile:    true,
-			storageSaveGameFileErr:        errors.New("error"),
-			isErr:                         true,
+			storageSaveGameFileErr:        storage.ErrAlreadyExists,
+			executeRepositorySaveGameFile: true,
+			hash:                          testdataZipHash,
+		},
+		{
+			description:     "SaveGameFileBlobがエラーなのでエラー",
+			readerFunc:      testdataZipReaderFunc,
+			gameID:          gameID,
+			fileType:        values.GameFileTypeJar,
+			entryPoint:      values.NewGameFileEntryPoint("a/b/file"),
+			executeSaveBlob: true,
+			saveBlobErr:     errors.New("error"),
+			isErr:           true,
 		},
 		{
 			description:                   "fileTypeがwindowsでもエラーなし",
@@ -316,8 +678,10 @@ func TestSaveGameFile(t *testing.T) {
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeWindows,
 			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
-			executeRepositorySaveGameFile: true,
+			executeSaveBlob:               true,
+			blobCreated:                   true,
 			executeStorageSaveGameFile:    true,
+			executeRepositorySaveGameFile: true,
 			hash:                          testdataZipHash,
 		},
 		{
@@ -326,42 +690,84 @@ func TestSaveGameFile(t *testing.T) {
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeMac,
 			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
-			executeRepositorySaveGameFile: true,
+			executeSaveBlob:               true,
+			blobCreated:                   true,
 			executeStorageSaveGameFile:    true,
+			executeRepositorySaveGameFile: true,
 			hash:                          testdataZipHash,
 		},
 		{
-			description:                   "entryPointが空なので、ErrInvalidEntryPoint",
+			description:                   "ストレージの上限以内なのでエラーなし",
 			readerFunc:                    testdataZipReaderFunc,
 			gameID:                        gameID,
 			fileType:                      values.GameFileTypeJar,
-			entryPoint:                    values.NewGameFileEntryPoint(""),
-			executeRepositorySaveGameFile: true,
+			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
+			storageUsage:                  100,
+			storageQuota:                  option.NewOption(100 + testdataZipSize),
+			executeSaveBlob:               true,
+			blobCreated:                   true,
 			executeStorageSaveGameFile:    true,
-			isErr:                         true,
-			err:                           service.ErrInvalidEntryPoint,
+			executeRepositorySaveGameFile: true,
+			hash:                          testdataZipHash,
 		},
 		{
-			description:                   "無効なentryPointなので、ErrInvalidEntryPoint",
-			readerFunc:                    testdataZipReaderFunc,
-			gameID:                        gameID,
-			fileType:                      values.GameFileTypeJar,
-			entryPoint:                    values.NewGameFileEntryPoint("a/b/not_exist"),
-			executeRepositorySaveGameFile: true,
-			executeStorageSaveGameFile:    true,
-			isErr:                         true,
-			err:                           service.ErrInvalidEntryPoint,
+			description:  "ストレージの上限を超えるのでErrGameStorageQuotaExceeded",
+			readerFunc:   testdataZipReaderFunc,
+			gameID:       gameID,
+			fileType:     values.GameFileTypeJar,
+			entryPoint:   values.NewGameFileEntryPoint("a/b/file"),
+			storageUsage: 100,
+			storageQuota: option.NewOption(100 + testdataZipSize - 1),
+			isErr:        true,
+			err:          service.ErrGameStorageQuotaExceeded,
 		},
 		{
-			description:                   "zipではないので、ErrNotZipFile",
-			readerFunc:                    func(t *testing.T) io.Reader { t.Helper(); return strings.NewReader("test") },
-			gameID:                        gameID,
-			fileType:                      values.GameFileTypeJar,
-			entryPoint:                    values.NewGameFileEntryPoint("a/b/file"),
-			executeRepositorySaveGameFile: true,
-			executeStorageSaveGameFile:    true,
-			isErr:                         true,
-			err:                           service.ErrNotZipFile,
+			description: "entryPointが空なので、ErrInvalidEntryPoint",
+			readerFunc:  testdataZipReaderFunc,
+			gameID:      gameID,
+			fileType:    values.GameFileTypeJar,
+			entryPoint:  values.NewGameFileEntryPoint(""),
+			isErr:       true,
+			err:         service.ErrInvalidEntryPoint,
+		},
+		{
+			description: "無効なentryPointなので、ErrInvalidEntryPoint",
+			readerFunc:  testdataZipReaderFunc,
+			gameID:      gameID,
+			fileType:    values.GameFileTypeJar,
+			entryPoint:  values.NewGameFileEntryPoint("a/b/not_exist"),
+			isErr:       true,
+			err:         service.ErrInvalidEntryPoint,
+		},
+		{
+			description: "zipではないので、ErrNotZipFile",
+			readerFunc:  func(t *testing.T) io.Reader { t.Helper(); return strings.NewReader("test") },
+			gameID:      gameID,
+			fileType:    values.GameFileTypeJar,
+			entryPoint:  values.NewGameFileEntryPoint("a/b/file"),
+			isErr:       true,
+			err:         service.ErrNotZipFile,
+		},
+		{
+			description: "zipに不正なエントリーがあるので、ErrInvalidZipEntries",
+			readerFunc: func(t *testing.T) io.Reader {
+				t.Helper()
+
+				buf := bytes.NewBuffer(nil)
+				zw := zip.NewWriter(buf)
+				_, err := zw.Create("a/b/file")
+				require.NoError(t, err)
+				_, err = zw.Create("../file")
+				require.NoError(t, err)
+				require.NoError(t, zw.Close())
+
+				return buf
+			},
+			gameID:     gameID,
+			fileType:   values.GameFileTypeJar,
+			entryPoint: values.NewGameFileEntryPoint("a/b/file"),
+			isErr:      true,
+			err:        service.ErrInvalidZipEntries,
 		},
 	}
 
@@ -370,31 +776,66 @@ func TestSaveGameFile(t *testing.T) {
 			buf := bytes.NewBuffer(nil)
 			mockGameFileStorage := mockStorage.NewGameFile(ctrl, buf)
 
-			gameFileService := NewGameFile(
+			gameFileService, err := NewGameFile(
+				newGameFileConfig(ctrl),
 				mockDB,
 				mockGameRepository,
 				mockGameFileRepository,
 				mockGameFileStorage,
+				nil,
+				gameStorage,
+				nil,
+				nil,
 			)
+			require.NoError(t, err)
 
 			mockGameRepository.
 				EXPECT().
 				GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
 				Return(nil, testCase.GetGameErr)
 
-			if testCase.executeRepositorySaveGameFile {
+			if testCase.GetGameErr == nil {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), gameID).
+					Return(domain.NewGameStorageUsage(testCase.storageUsage, 0, 0), nil)
+
+				if quota, ok := testCase.storageQuota.Value(); ok {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeNone).
+						Return(quota, nil)
+				} else {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeNone).
+						Return(values.GameStorageSize(0), repository.ErrRecordNotFound)
+				}
+			}
+
+			if testCase.executeSaveBlob {
 				mockGameFileRepository.
 					EXPECT().
-					SaveGameFile(gomock.Any(), testCase.gameID, gomock.Any()).
-					Return(testCase.repositorySaveGameFileErr)
+					SaveGameFileBlob(gomock.Any(), testdataZipHash).
+					Return(testCase.blobCreated, testCase.saveBlobErr)
+			}
+
+			if testCase.executeStorageSaveGameFile {
 				mockGameFileStorage.
 					EXPECT().
-					SaveGameFile(gomock.Any(), gomock.Any()).
+					SaveGameFile(gomock.Any(), testdataZipHash).
 					Return(testCase.storageSaveGameFileErr)
 			}
 
+			if testCase.executeRepositorySaveGameFile {
+				mockGameFileRepository.
+					EXPECT().
+					SaveGameFile(gomock.Any(), testCase.gameID, gomock.Any()).
+					Return(testCase.repositorySaveGameFileErr)
+			}
+
 			var expectBytes []byte
-			if testCase.readerFunc != nil {
+			if testCase.executeStorageSaveGameFile {
 				var err error
 				expectBytes, err = io.ReadAll(testCase.readerFunc(t))
 				require.NoError(t, err)
@@ -418,6 +859,7 @@ func TestSaveGameFile(t *testing.T) {
 			assert.Equal(t, testCase.fileType, gameFile.GetFileType())
 			assert.Equal(t, testCase.entryPoint, gameFile.GetEntryPoint())
 			assert.Equal(t, testCase.hash, gameFile.GetHash())
+			assert.Equal(t, testdataZipSize, gameFile.GetSize())
 			assert.WithinDuration(t, time.Now(), gameFile.GetCreatedAt(), time.Second)
 
 			assert.Equal(t, expectBytes, buf.Bytes())
@@ -438,12 +880,18 @@ func TestGetGameFile(t *testing.T) {
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
-	gameFileService := NewGameFile(
+	gameFileService, err := NewGameFile(
+		newGameFileConfig(ctrl),
 		mockDB,
 		mockGameRepository,
 		mockGameFileRepository,
 		mockGameFileStorage,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
+	require.NoError(t, err)
 
 	type test struct {
 		description        string
@@ -628,20 +1076,20 @@ func TestGetGameFile(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameFile {
 				mockGameFileRepository.
 					EXPECT().
-					GetGameFile(ctx, testCase.gameFileID, repository.LockTypeRecord).
+					GetGameFile(gomock.Any(), testCase.gameFileID, repository.LockTypeRecord).
 					Return(testCase.file, testCase.getGameFileErr)
 			}
 
 			if testCase.executeGetTempURL {
 				mockGameFileStorage.
 					EXPECT().
-					GetTempURL(ctx, testCase.file.GameFile, time.Minute).
+					GetTempURL(gomock.Any(), testCase.file.GameFile, time.Minute).
 					Return(testCase.fileURL, testCase.getTempURLErr)
 			}
 
@@ -678,12 +1126,18 @@ func TestGetGameFiles(t *testing.T) {
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
-	gameFileService := NewGameFile(
+	gameFileService, err := NewGameFile(
+		newGameFileConfig(ctrl),
 		mockDB,
 		mockGameRepository,
 		mockGameFileRepository,
 		mockGameFileStorage,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
+	require.NoError(t, err)
 
 	type test struct {
 		description         string
@@ -848,12 +1302,18 @@ func TestGetGameFileMeta(t *testing.T) {
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
-	gameFileService := NewGameFile(
+	gameFileService, err := NewGameFile(
+		newGameFileConfig(ctrl),
 		mockDB,
 		mockGameRepository,
 		mockGameFileRepository,
 		mockGameFileStorage,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
+	require.NoError(t, err)
 
 	type test struct {
 		description        string
@@ -973,13 +1433,13 @@ func TestGetGameFileMeta(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameFile {
 				mockGameFileRepository.
 					EXPECT().
-					GetGameFile(ctx, testCase.gameFileID, repository.LockTypeNone).
+					GetGameFile(gomock.Any(), testCase.gameFileID, repository.LockTypeNone).
 					Return(testCase.file, testCase.getGameFileErr)
 			}
 
@@ -1006,3 +1466,618 @@ func TestGetGameFileMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestScanGameFiles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type scanResult struct {
+		result *scanner.Result
+		err    error
+	}
+
+	type test struct {
+		description     string
+		files           []*repository.GameFileInfo
+		getGameFilesErr error
+		loadErr         error
+		scanResults     []scanResult
+		updateStatuses  []values.GameFileScanStatus
+		updateErrs      []error
+		// executeIncrement trueのとき、1つ目のファイルの検査の失敗回数を増やす
+		executeIncrement bool
+		attempts         int
+		incrementErr     error
+		// markFailed trueのとき、1つ目のファイルを検査の失敗にする
+		markFailed bool
+		isErr      bool
+		err        error
+	}
+
+	newFile := func(hash []byte) *repository.GameFileInfo {
+		return &repository.GameFileInfo{
+			GameFile: domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("/path/to/file"),
+				values.NewGameFileHashFromBytes(hash),
+				time.Now(),
+			),
+			GameID: values.NewGameID(),
+		}
+	}
+
+	hash1 := []byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}
+	hash2 := []byte{0x0a, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}
+
+	testCases := []test{
+		{
+			description:    "特に問題ないのでcleanになる",
+			files:          []*repository.GameFileInfo{newFile(hash1)},
+			scanResults:    []scanResult{{result: &scanner.Result{Clean: true}}},
+			updateStatuses: []values.GameFileScanStatus{values.GameFileScanStatusClean},
+		},
+		{
+			description:    "検査で問題ありとされたのでrejectedになる",
+			files:          []*repository.GameFileInfo{newFile(hash1)},
+			scanResults:    []scanResult{{result: &scanner.Result{Clean: false, Reason: "denied extension: setup.bat"}}},
+			updateStatuses: []values.GameFileScanStatus{values.GameFileScanStatusRejected},
+		},
+		{
+			description: "未検査のファイルがなくてもエラー無し",
+			files:       []*repository.GameFileInfo{},
+		},
+		{
+			description: "同じハッシュのファイルは1度だけ検査する",
+			files:       []*repository.GameFileInfo{newFile(hash1), newFile(hash1)},
+			scanResults: []scanResult{{result: &scanner.Result{Clean: true}}},
+			updateStatuses: []values.GameFileScanStatus{
+				values.GameFileScanStatusClean,
+				values.GameFileScanStatusClean,
+			},
+		},
+		{
+			description: "異なるハッシュのファイルはそれぞれ検査する",
+			files:       []*repository.GameFileInfo{newFile(hash1), newFile(hash2)},
+			scanResults: []scanResult{
+				{result: &scanner.Result{Clean: true}},
+				{result: &scanner.Result{Clean: false, Reason: "not a zip file"}},
+			},
+			updateStatuses: []values.GameFileScanStatus{
+				values.GameFileScanStatusClean,
+				values.GameFileScanStatusRejected,
+			},
+		},
+		{
+			description:     "GetGameFilesByScanStatusがエラーなのでエラー",
+			getGameFilesErr: errors.New("error"),
+			isErr:           true,
+		},
+		{
+			description: "LoadGameFileがErrNotFoundなのですぐにfailedになる",
+			files:       []*repository.GameFileInfo{newFile(hash1)},
+			loadErr:     storage.ErrNotFound,
+			markFailed:  true,
+		},
+		{
+			description:      "LoadGameFileがエラーでも失敗回数が上限未満なので更新せずにエラー無し",
+			files:            []*repository.GameFileInfo{newFile(hash1)},
+			loadErr:          errors.New("error"),
+			executeIncrement: true,
+			attempts:         1,
+		},
+		{
+			description:      "ScanGameFileがエラーでも失敗回数が上限未満なので更新せずにエラー無し",
+			files:            []*repository.GameFileInfo{newFile(hash1)},
+			scanResults:      []scanResult{{err: errors.New("error")}},
+			executeIncrement: true,
+			attempts:         maxGameFileScanAttempts - 1,
+		},
+		{
+			description:      "ScanGameFileの失敗回数が上限に達したのでfailedになる",
+			files:            []*repository.GameFileInfo{newFile(hash1)},
+			scanResults:      []scanResult{{err: errors.New("error")}},
+			executeIncrement: true,
+			attempts:         maxGameFileScanAttempts,
+			markFailed:       true,
+		},
+		{
+			description:      "IncrementGameFileScanAttemptsがエラーでも更新せずにエラー無し",
+			files:            []*repository.GameFileInfo{newFile(hash1)},
+			scanResults:      []scanResult{{err: errors.New("error")}},
+			executeIncrement: true,
+			incrementErr:     errors.New("error"),
+		},
+		{
+			description: "UpdateGameFileScanStatusがエラーでも続けるのでエラー無し",
+			files:       []*repository.GameFileInfo{newFile(hash1), newFile(hash2)},
+			scanResults: []scanResult{
+				{result: &scanner.Result{Clean: true}},
+				{result: &scanner.Result{Clean: true}},
+			},
+			updateStatuses: []values.GameFileScanStatus{
+				values.GameFileScanStatusClean,
+				values.GameFileScanStatusClean,
+			},
+			updateErrs: []error{errors.New("error"), nil},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
+			mockGameFileScanner := mockScanner.NewMockGameFile(ctrl)
+
+			gameFileService, err := NewGameFile(
+				newGameFileConfig(ctrl),
+				mockDB,
+				mockGameRepository,
+				mockGameFileRepository,
+				mockGameFileStorage,
+				mockGameFileScanner,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+
+			mockGameFileRepository.
+				EXPECT().
+				GetGameFilesByScanStatus(gomock.Any(), values.GameFileScanStatusPending).
+				Return(testCase.files, testCase.getGameFilesErr)
+
+			loadCount := len(testCase.scanResults)
+			if testCase.loadErr != nil {
+				loadCount = 1
+			}
+			if loadCount > 0 {
+				mockGameFileStorage.
+					EXPECT().
+					LoadGameFile(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ *domain.GameFile) error {
+						if testCase.loadErr != nil {
+							return testCase.loadErr
+						}
+
+						_, err := io.WriteString(writer, "test")
+						return err
+					}).
+					Times(loadCount)
+			}
+
+			for _, scanResult := range testCase.scanResults {
+				mockGameFileScanner.
+					EXPECT().
+					ScanGameFile(gomock.Any(), gomock.Any(), int64(len("test"))).
+					Return(scanResult.result, scanResult.err)
+			}
+
+			for i, status := range testCase.updateStatuses {
+				var updateErr error
+				if i < len(testCase.updateErrs) {
+					updateErr = testCase.updateErrs[i]
+				}
+
+				mockGameFileRepository.
+					EXPECT().
+					UpdateGameFileScanStatus(gomock.Any(), testCase.files[i].GetID(), status).
+					Return(updateErr)
+			}
+
+			if testCase.executeIncrement {
+				mockGameFileRepository.
+					EXPECT().
+					IncrementGameFileScanAttempts(gomock.Any(), testCase.files[0].GetID()).
+					Return(testCase.attempts, testCase.incrementErr)
+			}
+
+			if testCase.markFailed {
+				mockGameFileRepository.
+					EXPECT().
+					UpdateGameFileScanStatus(gomock.Any(), testCase.files[0].GetID(), values.GameFileScanStatusFailed).
+					Return(nil)
+			}
+
+			err = gameFileService.ScanGameFiles(ctx)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateGameFileScanStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	type test struct {
+		description                     string
+		scanStatus                      values.GameFileScanStatus
+		executeGetMe                    bool
+		GetMeErr                        error
+		executeGetGame                  bool
+		GetGameErr                      error
+		executeGetGameFile              bool
+		fileGameIDMismatch              bool
+		GetGameFileErr                  error
+		executeUpdateGameFileScanStatus bool
+		UpdateGameFileScanStatusErr     error
+		executeCreateAuditLog           bool
+		CreateAuditLogErr               error
+		isErr                           bool
+		err                             error
+	}
+
+	testCases := []test{
+		{
+			description:                     "cleanにしてもエラー無し",
+			scanStatus:                      values.GameFileScanStatusClean,
+			executeGetMe:                    true,
+			executeGetGame:                  true,
+			executeGetGameFile:              true,
+			executeUpdateGameFileScanStatus: true,
+			executeCreateAuditLog:           true,
+		},
+		{
+			description:                     "rejectedにしてもエラー無し",
+			scanStatus:                      values.GameFileScanStatusRejected,
+			executeGetMe:                    true,
+			executeGetGame:                  true,
+			executeGetGameFile:              true,
+			executeUpdateGameFileScanStatus: true,
+			executeCreateAuditLog:           true,
+		},
+		{
+			description: "pendingなのでErrInvalidGameFileScanStatus",
+			scanStatus:  values.GameFileScanStatusPending,
+			isErr:       true,
+			err:         service.ErrInvalidGameFileScanStatus,
+		},
+		{
+			description: "failedなのでErrInvalidGameFileScanStatus",
+			scanStatus:  values.GameFileScanStatusFailed,
+			isErr:       true,
+			err:         service.ErrInvalidGameFileScanStatus,
+		},
+		{
+			description:  "GetMeがエラーなのでエラー",
+			scanStatus:   values.GameFileScanStatusClean,
+			executeGetMe: true,
+			GetMeErr:     errors.New("error"),
+			isErr:        true,
+		},
+		{
+			description:    "ゲームが存在しないのでErrInvalidGameID",
+			scanStatus:     values.GameFileScanStatusClean,
+			executeGetMe:   true,
+			executeGetGame: true,
+			GetGameErr:     repository.ErrRecordNotFound,
+			isErr:          true,
+			err:            service.ErrInvalidGameID,
+		},
+		{
+			description:    "GetGameがエラーなのでエラー",
+			scanStatus:     values.GameFileScanStatusClean,
+			executeGetMe:   true,
+			executeGetGame: true,
+			GetGameErr:     errors.New("error"),
+			isErr:          true,
+		},
+		{
+			description:        "ファイルが存在しないのでErrInvalidGameFileID",
+			scanStatus:         values.GameFileScanStatusClean,
+			executeGetMe:       true,
+			executeGetGame:     true,
+			executeGetGameFile: true,
+			GetGameFileErr:     repository.ErrRecordNotFound,
+			isErr:              true,
+			err:                service.ErrInvalidGameFileID,
+		},
+		{
+			description:        "ファイルが別のゲームのものなのでErrInvalidGameFileID",
+			scanStatus:         values.GameFileScanStatusClean,
+			executeGetMe:       true,
+			executeGetGame:     true,
+			executeGetGameFile: true,
+			fileGameIDMismatch: true,
+			isErr:              true,
+			err:                service.ErrInvalidGameFileID,
+		},
+		{
+			description:        "GetGameFileがエラーなのでエラー",
+			scanStatus:         values.GameFileScanStatusClean,
+			executeGetMe:       true,
+			executeGetGame:     true,
+			executeGetGameFile: true,
+			GetGameFileErr:     errors.New("error"),
+			isErr:              true,
+		},
+		{
+			description:                     "検査の状態が変わらなくてもエラー無し",
+			scanStatus:                      values.GameFileScanStatusClean,
+			executeGetMe:                    true,
+			executeGetGame:                  true,
+			executeGetGameFile:              true,
+			executeUpdateGameFileScanStatus: true,
+			UpdateGameFileScanStatusErr:     repository.ErrNoRecordUpdated,
+			executeCreateAuditLog:           true,
+		},
+		{
+			description:                     "UpdateGameFileScanStatusがエラーなのでエラー",
+			scanStatus:                      values.GameFileScanStatusClean,
+			executeGetMe:                    true,
+			executeGetGame:                  true,
+			executeGetGameFile:              true,
+			executeUpdateGameFileScanStatus: true,
+			UpdateGameFileScanStatusErr:     errors.New("error"),
+			isErr:                           true,
+		},
+		{
+			description:                     "CreateAuditLogがエラーなのでエラー",
+			scanStatus:                      values.GameFileScanStatusClean,
+			executeGetMe:                    true,
+			executeGetGame:                  true,
+			executeGetGameFile:              true,
+			executeUpdateGameFileScanStatus: true,
+			executeCreateAuditLog:           true,
+			CreateAuditLogErr:               errors.New("error"),
+			isErr:                           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameFileService, err := NewGameFile(
+				newGameFileConfig(ctrl),
+				mockDB,
+				mockGameRepository,
+				mockGameFileRepository,
+				mockStorage.NewGameFile(ctrl, nil),
+				mockScanner.NewMockGameFile(ctrl),
+				nil,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+			require.NoError(t, err)
+
+			gameID := values.NewGameID()
+			fileID := values.NewGameFileID()
+
+			if testCase.executeGetMe {
+				if testCase.GetMeErr == nil {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), authSession.GetAccessToken()).
+						Return(myInfo, nil)
+				} else {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), authSession.GetAccessToken()).
+						Return(nil, cache.ErrCacheMiss)
+					mockUserAuth.
+						EXPECT().
+						GetMe(gomock.Any(), authSession).
+						Return(nil, testCase.GetMeErr)
+				}
+			}
+
+			if testCase.executeGetGame {
+				mockGameRepository.
+					EXPECT().
+					GetGame(gomock.Any(), gameID, repository.LockTypeNone).
+					Return(nil, testCase.GetGameErr)
+			}
+
+			if testCase.executeGetGameFile {
+				fileGameID := gameID
+				if testCase.fileGameIDMismatch {
+					fileGameID = values.NewGameID()
+				}
+
+				var fileInfo *repository.GameFileInfo
+				if testCase.GetGameFileErr == nil {
+					fileInfo = &repository.GameFileInfo{
+						GameFile: domain.NewGameFile(
+							fileID,
+							values.GameFileTypeJar,
+							values.NewGameFileEntryPoint("/path/to/file"),
+							values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+							time.Now(),
+						),
+						GameID: fileGameID,
+					}
+				}
+
+				mockGameFileRepository.
+					EXPECT().
+					GetGameFile(gomock.Any(), fileID, repository.LockTypeRecord).
+					Return(fileInfo, testCase.GetGameFileErr)
+			}
+
+			if testCase.executeUpdateGameFileScanStatus {
+				mockGameFileRepository.
+					EXPECT().
+					UpdateGameFileScanStatus(gomock.Any(), fileID, testCase.scanStatus).
+					Return(testCase.UpdateGameFileScanStatusErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionUpdateGameFileScanStatus &&
+							auditLog.GetTargetType() == values.AuditLogTargetTypeGame &&
+							auditLog.GetTargetID() == uuid.UUID(gameID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			file, err := gameFileService.UpdateGameFileScanStatus(ctx, authSession, gameID, fileID, testCase.scanStatus)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, fileID, file.GetID())
+			assert.Equal(t, testCase.scanStatus, file.GetScanStatus())
+		})
+	}
+}
+
+func TestRescanGameFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	type test struct {
+		description                 string
+		UpdateGameFileScanStatusErr error
+		CreateAuditLogErr           error
+		executeCreateAuditLog       bool
+		isErr                       bool
+	}
+
+	testCases := []test{
+		{
+			description:           "特に問題ないのでpendingになる",
+			executeCreateAuditLog: true,
+		},
+		{
+			description:                 "UpdateGameFileScanStatusがエラーなのでエラー",
+			UpdateGameFileScanStatusErr: errors.New("error"),
+			isErr:                       true,
+		},
+		{
+			description:           "CreateAuditLogがエラーなのでエラー",
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("error"),
+			isErr:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameFileService, err := NewGameFile(
+				newGameFileConfig(ctrl),
+				mockDB,
+				mockGameRepository,
+				mockGameFileRepository,
+				mockStorage.NewGameFile(ctrl, nil),
+				mockScanner.NewMockGameFile(ctrl),
+				nil,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+			require.NoError(t, err)
+
+			gameID := values.NewGameID()
+			fileID := values.NewGameFileID()
+
+			file := domain.NewGameFile(
+				fileID,
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("/path/to/file"),
+				values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+				time.Now(),
+			)
+			file.SetScanStatus(values.GameFileScanStatusFailed)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeNone).
+				Return(nil, nil)
+			mockGameFileRepository.
+				EXPECT().
+				GetGameFile(gomock.Any(), fileID, repository.LockTypeRecord).
+				Return(&repository.GameFileInfo{GameFile: file, GameID: gameID}, nil)
+			mockGameFileRepository.
+				EXPECT().
+				UpdateGameFileScanStatus(gomock.Any(), fileID, values.GameFileScanStatusPending).
+				Return(testCase.UpdateGameFileScanStatusErr)
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionUpdateGameFileScanStatus &&
+							auditLog.GetTargetType() == values.AuditLogTargetTypeGame &&
+							auditLog.GetTargetID() == uuid.UUID(gameID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			resFile, err := gameFileService.RescanGameFile(ctx, authSession, gameID, fileID)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, values.GameFileScanStatusPending, resFile.GetScanStatus())
+		})
+	}
+}
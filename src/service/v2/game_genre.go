@@ -7,6 +7,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -16,18 +17,25 @@ import (
 type GameGenre struct {
 	db                  repository.DB
 	gameGenreRepository repository.GameGenre
+	user                *User
+	auditLog            *AuditLog
 }
 
-func NewGameGenre(db repository.DB, gameGenreRepository repository.GameGenre) *GameGenre {
+func NewGameGenre(db repository.DB, gameGenreRepository repository.GameGenre, user *User, auditLog *AuditLog) *GameGenre {
 	return &GameGenre{
 		db:                  db,
 		gameGenreRepository: gameGenreRepository,
+		user:                user,
+		auditLog:            auditLog,
 	}
 }
 
 var _ service.GameGenre = &GameGenre{}
 
 func (gameGenre *GameGenre) GetGameGenres(ctx context.Context, isLoginUser bool) ([]*service.GameGenreInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameGenre.GetGameGenres")
+	defer span.End()
+
 	var visibilities []values.GameVisibility
 	if !isLoginUser {
 		visibilities = []values.GameVisibility{values.GameVisibilityTypePublic, values.GameVisibilityTypeLimited}
@@ -41,17 +49,37 @@ func (gameGenre *GameGenre) GetGameGenres(ctx context.Context, isLoginUser bool)
 		return nil, err
 	}
 
+	aliases, err := gameGenre.gameGenreRepository.GetGameGenreAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre aliases: %w", err)
+	}
+
+	aliasesMap := make(map[values.GameGenreID][]*domain.GameGenreAlias, len(gameInfosRepo))
+	for _, alias := range aliases {
+		aliasesMap[alias.GetGenreID()] = append(aliasesMap[alias.GetGenreID()], alias)
+	}
+
 	gameInfos := make([]*service.GameGenreInfo, 0, len(gameInfosRepo))
 	for i := range gameInfosRepo {
+		genreAliases, ok := aliasesMap[gameInfosRepo[i].GetID()]
+		if !ok {
+			genreAliases = []*domain.GameGenreAlias{}
+		}
+
 		gameInfos = append(gameInfos, &service.GameGenreInfo{
 			GameGenre: gameInfosRepo[i].GameGenre,
 			Num:       gameInfosRepo[i].Num,
+			ParentID:  gameInfosRepo[i].ParentID,
+			Aliases:   genreAliases,
 		})
 	}
 	return gameInfos, nil
 }
 
 func (gameGenre *GameGenre) DeleteGameGenre(ctx context.Context, gameGenreID values.GameGenreID) error {
+	ctx, span := tracer.Start(ctx, "GameGenre.DeleteGameGenre")
+	defer span.End()
+
 	err := gameGenre.gameGenreRepository.RemoveGameGenre(ctx, gameGenreID)
 	if errors.Is(err, repository.ErrNoRecordDeleted) {
 		return service.ErrNoGameGenre
@@ -64,27 +92,48 @@ func (gameGenre *GameGenre) DeleteGameGenre(ctx context.Context, gameGenreID val
 }
 
 func (gameGenre *GameGenre) UpdateGameGenres(ctx context.Context, gameID values.GameID, gameGenreNames []values.GameGenreName) error {
+	ctx, span := tracer.Start(ctx, "GameGenre.UpdateGameGenres")
+	defer span.End()
+
 	// 重複するジャンルがあったらエラー
 	if len(slices.Compact[[]values.GameGenreName](gameGenreNames)) != len(gameGenreNames) {
 		return service.ErrDuplicateGameGenre
 	}
 
 	err := gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
-		existingGenres, err := gameGenre.gameGenreRepository.GetGameGenresWithNames(ctx, gameGenreNames)
+		// 別名は別名が指すジャンルとして扱う
+		aliases, err := gameGenre.gameGenreRepository.GetGameGenreAliasesWithNames(ctx, gameGenreNames)
+		if err != nil {
+			return fmt.Errorf("failed to get game genre aliases: %w", err)
+		}
+
+		aliasesMap := make(map[values.GameGenreName]values.GameGenreID, len(aliases))
+		for _, alias := range aliases {
+			aliasesMap[alias.GetName()] = alias.GetGenreID()
+		}
+
+		genreNames := make([]values.GameGenreName, 0, len(gameGenreNames))
+		for _, name := range gameGenreNames {
+			if _, ok := aliasesMap[name]; !ok {
+				genreNames = append(genreNames, name)
+			}
+		}
+
+		existingGenres, err := gameGenre.gameGenreRepository.GetGameGenresWithNames(ctx, genreNames)
 		if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
 			return fmt.Errorf("failed to get game genres: %w", err)
 		}
 
-		newGameGenres := make([]*domain.GameGenre, 0, len(gameGenreNames))
-		if len(existingGenres) != len(gameGenreNames) {
+		newGameGenres := make([]*domain.GameGenre, 0, len(genreNames))
+		if len(existingGenres) != len(genreNames) {
 			existingGenresMap := make(map[values.GameGenreName]struct{}, len(existingGenres))
 			for i := range existingGenres {
 				existingGenresMap[existingGenres[i].GetName()] = struct{}{}
 			}
 
-			for i := range gameGenreNames {
-				if _, ok := existingGenresMap[gameGenreNames[i]]; !ok {
-					newGameGenres = append(newGameGenres, domain.NewGameGenre(values.NewGameGenreID(), gameGenreNames[i], time.Now()))
+			for i := range genreNames {
+				if _, ok := existingGenresMap[genreNames[i]]; !ok {
+					newGameGenres = append(newGameGenres, domain.NewGameGenre(values.NewGameGenreID(), genreNames[i], time.Now()))
 				}
 			}
 
@@ -97,13 +146,20 @@ func (gameGenre *GameGenre) UpdateGameGenres(ctx context.Context, gameID values.
 			}
 		}
 
-		gameGenreIDs := make([]values.GameGenreID, 0, len(existingGenres)+len(newGameGenres))
+		gameGenreIDs := make([]values.GameGenreID, 0, len(gameGenreNames))
 		for i := range existingGenres {
 			gameGenreIDs = append(gameGenreIDs, existingGenres[i].GetID())
 		}
 		for i := range newGameGenres {
 			gameGenreIDs = append(gameGenreIDs, newGameGenres[i].GetID())
 		}
+		for _, name := range gameGenreNames {
+			genreID, ok := aliasesMap[name]
+			// 別名と元のジャンル名が同時に指定された場合などに重複して登録しないようにする
+			if ok && !slices.Contains(gameGenreIDs, genreID) {
+				gameGenreIDs = append(gameGenreIDs, genreID)
+			}
+		}
 
 		err = gameGenre.gameGenreRepository.RegisterGenresToGame(ctx, gameID, gameGenreIDs)
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -122,6 +178,9 @@ func (gameGenre *GameGenre) UpdateGameGenres(ctx context.Context, gameID values.
 }
 
 func (gameGenre *GameGenre) UpdateGameGenre(ctx context.Context, gameGenreID values.GameGenreID, gameGenreName values.GameGenreName) (*service.GameGenreInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameGenre.UpdateGameGenre")
+	defer span.End()
+
 	var genreInfo *service.GameGenreInfo
 	err := gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		genre, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, gameGenreID)
@@ -136,6 +195,14 @@ func (gameGenre *GameGenre) UpdateGameGenre(ctx context.Context, gameGenreID val
 			return service.ErrNoGameGenreUpdated
 		}
 
+		aliases, err := gameGenre.gameGenreRepository.GetGameGenreAliasesWithNames(ctx, []values.GameGenreName{gameGenreName})
+		if err != nil {
+			return fmt.Errorf("failed to get game genre aliases: %w", err)
+		}
+		if len(aliases) != 0 {
+			return service.ErrDuplicateGameGenreName
+		}
+
 		newGameGenre := domain.NewGameGenre(genre.GetID(), gameGenreName, genre.GetCreatedAt())
 		err = gameGenre.gameGenreRepository.UpdateGameGenre(ctx, newGameGenre)
 		if errors.Is(err, repository.ErrNoRecordUpdated) { // 起きないはず
@@ -148,14 +215,116 @@ func (gameGenre *GameGenre) UpdateGameGenre(ctx context.Context, gameGenreID val
 			return fmt.Errorf("failed to update game genre: %w", err)
 		}
 
-		games, err := gameGenre.gameGenreRepository.GetGamesByGenreID(ctx, gameGenreID)
+		genreInfo, err = gameGenre.getGameGenreInfo(ctx, newGameGenre)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genreInfo, nil
+}
+
+func (gameGenre *GameGenre) MergeGameGenres(ctx context.Context, session *domain.OIDCSession, sourceID values.GameGenreID, targetID values.GameGenreID) (*service.GameGenreInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameGenre.MergeGameGenres")
+	defer span.End()
+
+	if sourceID == targetID {
+		return nil, service.ErrCannotMergeSameGameGenre
+	}
+
+	myInfo, err := gameGenre.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var genreInfo *service.GameGenreInfo
+	err = gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		source, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, sourceID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGameGenre
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get source game genre: %w", err)
+		}
+
+		target, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, targetID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGameGenre
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get target game genre: %w", err)
+		}
+
+		sourceInfo, err := gameGenre.getGameGenreInfo(ctx, source)
+		if err != nil {
+			return err
+		}
+
+		targetInfo, err := gameGenre.getGameGenreInfo(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		sourceGames, err := gameGenre.gameGenreRepository.GetGamesByGenreID(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("failed to get games by genre id: %w", err)
+		}
+
+		parentIDs, err := gameGenre.gameGenreRepository.GetGameGenreParentIDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get game genre parent ids: %w", err)
+		}
+
+		// targetがsourceの子孫の場合、sourceの子をtargetに付け替えると循環するので、
+		// 先にtargetをsourceの親の下に移しておく
+		if isDescendantGameGenre(parentIDs, targetID, sourceID) {
+			err = gameGenre.gameGenreRepository.UpdateGameGenreParent(ctx, targetID, sourceInfo.ParentID)
+			if err != nil {
+				return fmt.Errorf("failed to update game genre parent: %w", err)
+			}
+		}
+
+		err = gameGenre.gameGenreRepository.MergeGameGenre(ctx, sourceID, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to merge game genre: %w", err)
+		}
+
+		err = gameGenre.gameGenreRepository.RemoveGameGenre(ctx, sourceID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) { // 起きないはず
+			return service.ErrNoGameGenre
+		}
 		if err != nil {
-			return fmt.Errorf("get games by genre id error: %w", err)
+			return fmt.Errorf("failed to remove game genre: %w", err)
 		}
 
-		genreInfo = &service.GameGenreInfo{
-			GameGenre: *newGameGenre,
-			Num:       len(games),
+		// 統合前のジャンル名でも同じジャンルとして扱えるように、sourceの名前をtargetの別名にする
+		alias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), targetID, source.GetName(), time.Now())
+		err = gameGenre.gameGenreRepository.SaveGameGenreAlias(ctx, alias)
+		if err != nil {
+			return fmt.Errorf("failed to save game genre alias: %w", err)
+		}
+
+		genreInfo, err = gameGenre.getGameGenreInfo(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		err = gameGenre.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionMergeGameGenres,
+			values.AuditLogTargetTypeGameGenre,
+			uuid.UUID(targetID),
+			newAuditLogGameGenreMergeState(sourceInfo, targetInfo, sourceGames),
+			newAuditLogGameGenreState(genreInfo),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
 		}
 
 		return nil
@@ -166,3 +335,263 @@ func (gameGenre *GameGenre) UpdateGameGenre(ctx context.Context, gameGenreID val
 
 	return genreInfo, nil
 }
+
+func (gameGenre *GameGenre) UpdateGameGenreParent(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, parentID *values.GameGenreID) (*service.GameGenreInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameGenre.UpdateGameGenreParent")
+	defer span.End()
+
+	if parentID != nil && *parentID == gameGenreID {
+		return nil, service.ErrInvalidGameGenreParent
+	}
+
+	myInfo, err := gameGenre.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var genreInfo *service.GameGenreInfo
+	err = gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		genre, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, gameGenreID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGameGenre
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game genre: %w", err)
+		}
+
+		if parentID != nil {
+			_, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, *parentID)
+			if errors.Is(err, repository.ErrRecordNotFound) {
+				return service.ErrNoGameGenre
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get parent game genre: %w", err)
+			}
+		}
+
+		parentIDs, err := gameGenre.gameGenreRepository.GetGameGenreParentIDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get game genre parent ids: %w", err)
+		}
+
+		var currentParentID *values.GameGenreID
+		if id, ok := parentIDs[gameGenreID]; ok {
+			currentParentID = &id
+		}
+
+		if (currentParentID == nil && parentID == nil) ||
+			(currentParentID != nil && parentID != nil && *currentParentID == *parentID) {
+			return service.ErrNoGameGenreUpdated
+		}
+
+		// 子孫を親にすると循環してしまう
+		if parentID != nil && isDescendantGameGenre(parentIDs, *parentID, gameGenreID) {
+			return service.ErrInvalidGameGenreParent
+		}
+
+		err = gameGenre.gameGenreRepository.UpdateGameGenreParent(ctx, gameGenreID, parentID)
+		if errors.Is(err, repository.ErrNoRecordUpdated) {
+			return service.ErrNoGameGenreUpdated
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update game genre parent: %w", err)
+		}
+
+		genreInfo, err = gameGenre.getGameGenreInfo(ctx, genre)
+		if err != nil {
+			return err
+		}
+
+		err = gameGenre.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionUpdateGameGenreParent,
+			values.AuditLogTargetTypeGameGenre,
+			uuid.UUID(gameGenreID),
+			newAuditLogGameGenreParentState(currentParentID),
+			newAuditLogGameGenreParentState(parentID),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genreInfo, nil
+}
+
+func (gameGenre *GameGenre) AddGameGenreAlias(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, name values.GameGenreName) (*domain.GameGenreAlias, error) {
+	ctx, span := tracer.Start(ctx, "GameGenre.AddGameGenreAlias")
+	defer span.End()
+
+	myInfo, err := gameGenre.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var alias *domain.GameGenreAlias
+	err = gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameGenre.gameGenreRepository.GetGameGenre(ctx, gameGenreID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGameGenre
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game genre: %w", err)
+		}
+
+		// 別名はジャンル名とも重複してはいけない
+		_, err = gameGenre.gameGenreRepository.GetGameGenresWithNames(ctx, []values.GameGenreName{name})
+		if err == nil {
+			return service.ErrDuplicateGameGenreName
+		}
+		if !errors.Is(err, repository.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get game genres: %w", err)
+		}
+
+		alias = domain.NewGameGenreAlias(values.NewGameGenreAliasID(), gameGenreID, name, time.Now())
+		err = gameGenre.gameGenreRepository.SaveGameGenreAlias(ctx, alias)
+		if errors.Is(err, repository.ErrDuplicatedUniqueKey) {
+			return service.ErrDuplicateGameGenreName
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save game genre alias: %w", err)
+		}
+
+		err = gameGenre.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionAddGameGenreAlias,
+			values.AuditLogTargetTypeGameGenre,
+			uuid.UUID(gameGenreID),
+			nil,
+			newAuditLogGameGenreAliasState(alias),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+func (gameGenre *GameGenre) DeleteGameGenreAlias(ctx context.Context, session *domain.OIDCSession, gameGenreID values.GameGenreID, aliasID values.GameGenreAliasID) error {
+	ctx, span := tracer.Start(ctx, "GameGenre.DeleteGameGenreAlias")
+	defer span.End()
+
+	myInfo, err := gameGenre.user.getMe(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	err = gameGenre.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		alias, err := gameGenre.gameGenreRepository.GetGameGenreAlias(ctx, aliasID)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGameGenreAlias
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game genre alias: %w", err)
+		}
+
+		if alias.GetGenreID() != gameGenreID {
+			return service.ErrNoGameGenreAlias
+		}
+
+		err = gameGenre.gameGenreRepository.RemoveGameGenreAlias(ctx, aliasID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) {
+			return service.ErrNoGameGenreAlias
+		}
+		if err != nil {
+			return fmt.Errorf("failed to remove game genre alias: %w", err)
+		}
+
+		err = gameGenre.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionDeleteGameGenreAlias,
+			values.AuditLogTargetTypeGameGenre,
+			uuid.UUID(gameGenreID),
+			newAuditLogGameGenreAliasState(alias),
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getGameGenreInfo
+// ゲームの数、親ジャンル、別名を含めたゲームジャンルの情報を取得する。
+func (gameGenre *GameGenre) getGameGenreInfo(ctx context.Context, genre *domain.GameGenre) (*service.GameGenreInfo, error) {
+	games, err := gameGenre.gameGenreRepository.GetGamesByGenreID(ctx, genre.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("get games by genre id error: %w", err)
+	}
+
+	parentIDs, err := gameGenre.gameGenreRepository.GetGameGenreParentIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre parent ids: %w", err)
+	}
+
+	var parentID *values.GameGenreID
+	if id, ok := parentIDs[genre.GetID()]; ok {
+		parentID = &id
+	}
+
+	allAliases, err := gameGenre.gameGenreRepository.GetGameGenreAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game genre aliases: %w", err)
+	}
+
+	aliases := make([]*domain.GameGenreAlias, 0, len(allAliases))
+	for _, alias := range allAliases {
+		if alias.GetGenreID() == genre.GetID() {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	return &service.GameGenreInfo{
+		GameGenre: *genre,
+		Num:       len(games),
+		ParentID:  parentID,
+		Aliases:   aliases,
+	}, nil
+}
+
+// isDescendantGameGenre
+// genreIDのジャンルがancestorIDのジャンルの子孫かどうかを、親ジャンルのIDのmapを辿って判定する。
+func isDescendantGameGenre(parentIDs map[values.GameGenreID]values.GameGenreID, genreID values.GameGenreID, ancestorID values.GameGenreID) bool {
+	visited := map[values.GameGenreID]struct{}{}
+	for {
+		parentID, ok := parentIDs[genreID]
+		if !ok {
+			return false
+		}
+		if parentID == ancestorID {
+			return true
+		}
+
+		// 念のため循環している場合に無限ループにならないようにする
+		if _, ok := visited[parentID]; ok {
+			return false
+		}
+		visited[parentID] = struct{}{}
+
+		genreID = parentID
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	mockAuth "github.com/traPtitech/trap-collection-server/src/auth/mock"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -26,44 +29,71 @@ func TestGetGameGenres(t *testing.T) {
 	mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
 	mockDB := mockRepository.NewMockDB(ctrl)
 
-	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository)
+	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository, nil, nil)
 
 	type test struct {
-		isLoginUser      bool
-		gameInfosRepo    []*repository.GameGenreInfo
-		GetGameGenresErr error
-		gameInfos        []*service.GameGenreInfo
-		isErr            bool
-		expectedErr      error
+		isLoginUser                bool
+		gameInfosRepo              []*repository.GameGenreInfo
+		GetGameGenresErr           error
+		executeGetGameGenreAliases bool
+		aliases                    []*domain.GameGenreAlias
+		GetGameGenreAliasesErr     error
+		gameInfos                  []*service.GameGenreInfo
+		isErr                      bool
+		expectedErr                error
 	}
 
 	gameGenre1 := domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now())
 	gameGenre2 := domain.NewGameGenre(values.NewGameGenreID(), "2D", time.Now())
+	gameGenreID1 := gameGenre1.GetID()
+	alias1 := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), gameGenre1.GetID(), "3DCG", time.Now())
+	alias2 := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), gameGenre1.GetID(), "three-d", time.Now())
 
 	testCases := map[string]test{
 		"特に問題ないのでエラー無し": {
-			gameInfosRepo: []*repository.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
-			gameInfos:     []*service.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
+			gameInfosRepo:              []*repository.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
+			executeGetGameGenreAliases: true,
+			gameInfos:                  []*service.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
 		},
 		"ログインしていてもエラー無し": {
-			isLoginUser:   true,
-			gameInfosRepo: []*repository.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
-			gameInfos:     []*service.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
+			isLoginUser:                true,
+			gameInfosRepo:              []*repository.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
+			executeGetGameGenreAliases: true,
+			gameInfos:                  []*service.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
 		},
 		"複数でもエラー無し": {
 			gameInfosRepo: []*repository.GameGenreInfo{
 				{GameGenre: *gameGenre1, Num: 1},
 				{GameGenre: *gameGenre2, Num: 3},
 			},
+			executeGetGameGenreAliases: true,
 			gameInfos: []*service.GameGenreInfo{
 				{GameGenre: *gameGenre1, Num: 1},
 				{GameGenre: *gameGenre2, Num: 3},
 			},
 		},
+		"親ジャンルと別名があってもエラー無し": {
+			gameInfosRepo: []*repository.GameGenreInfo{
+				{GameGenre: *gameGenre1, Num: 1},
+				{GameGenre: *gameGenre2, Num: 3, ParentID: &gameGenreID1},
+			},
+			executeGetGameGenreAliases: true,
+			aliases:                    []*domain.GameGenreAlias{alias1, alias2},
+			gameInfos: []*service.GameGenreInfo{
+				{GameGenre: *gameGenre1, Num: 1, Aliases: []*domain.GameGenreAlias{alias1, alias2}},
+				{GameGenre: *gameGenre2, Num: 3, ParentID: &gameGenreID1},
+			},
+		},
 		"GetGameGenresがエラーなのでエラー": {
 			GetGameGenresErr: errors.New("test"),
 			isErr:            true,
 		},
+		"GetGameGenreAliasesがエラーなのでエラー": {
+			gameInfosRepo:              []*repository.GameGenreInfo{{GameGenre: *gameGenre1, Num: 1}},
+			executeGetGameGenreAliases: true,
+			GetGameGenreAliasesErr:     errors.New("test"),
+			isErr:                      true,
+		},
 	}
 
 	visibilitiesAll := []values.GameVisibility{values.GameVisibilityTypePublic, values.GameVisibilityTypeLimited, values.GameVisibilityTypePrivate}
@@ -82,6 +112,13 @@ func TestGetGameGenres(t *testing.T) {
 				GetGameGenres(gomock.Any(), gomock.InAnyOrder(argVisibilities)).
 				Return(testCase.gameInfosRepo, testCase.GetGameGenresErr)
 
+			if testCase.executeGetGameGenreAliases {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreAliases(gomock.Any()).
+					Return(testCase.aliases, testCase.GetGameGenreAliasesErr)
+			}
+
 			gameInfos, err := gameGenreService.GetGameGenres(ctx, testCase.isLoginUser)
 
 			if testCase.isErr {
@@ -104,6 +141,11 @@ func TestGetGameGenres(t *testing.T) {
 				assert.Equal(t, testCase.gameInfos[i].GetName(), gameInfos[i].GetName())
 				assert.Equal(t, testCase.gameInfos[i].Num, gameInfos[i].Num)
 				assert.WithinDuration(t, testCase.gameInfos[i].GetCreatedAt(), gameInfos[i].GetCreatedAt(), time.Second)
+				assert.Equal(t, testCase.gameInfos[i].ParentID, gameInfos[i].ParentID)
+				assert.Len(t, gameInfos[i].Aliases, len(testCase.gameInfos[i].Aliases))
+				for j := range gameInfos[i].Aliases {
+					assert.Equal(t, testCase.gameInfos[i].Aliases[j], gameInfos[i].Aliases[j])
+				}
 			}
 
 		})
@@ -121,7 +163,7 @@ func TestDeleteGameGenre(t *testing.T) {
 	mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
 	mockDB := mockRepository.NewMockDB(ctrl)
 
-	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository)
+	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository, nil, nil)
 
 	type test struct {
 		ID                 values.GameGenreID
@@ -153,7 +195,7 @@ func TestDeleteGameGenre(t *testing.T) {
 		t.Run(description, func(t *testing.T) {
 			mockGameGenreRepository.
 				EXPECT().
-				RemoveGameGenre(ctx, testCase.ID).
+				RemoveGameGenre(gomock.Any(), testCase.ID).
 				Return(testCase.RemoveGameGenreErr)
 
 			err := gameGenreService.DeleteGameGenre(ctx, genreID)
@@ -183,20 +225,26 @@ func TestUpdateGameGenres(t *testing.T) {
 	mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
 	mockDB := mockRepository.NewMockDB(ctrl)
 
-	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository)
+	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository, nil, nil)
 
 	type test struct {
-		gameID                        values.GameID
-		gameGenreNames                []values.GameGenreName
-		executeGetGameGenresWithNames bool
-		GetGameGenresWithNamesResult  []*domain.GameGenre
-		GetGameGenresWithNamesErr     error
-		executeSaveGameGenres         bool
-		SaveGameGenresErr             error
-		executeRegisterGenresToGame   bool
-		RegisterGenresToGameErr       error
-		isErr                         bool
-		expectedErr                   error
+		gameID                              values.GameID
+		gameGenreNames                      []values.GameGenreName
+		executeGetGameGenreAliasesWithNames bool
+		aliases                             []*domain.GameGenreAlias
+		GetGameGenreAliasesWithNamesErr     error
+		executeGetGameGenresWithNames       bool
+		GetGameGenresWithNamesResult        []*domain.GameGenre
+		GetGameGenresWithNamesErr           error
+		executeSaveGameGenres               bool
+		SaveGameGenresErr                   error
+		executeRegisterGenresToGame         bool
+		// registeredGenreNum
+		// ゲームに登録されるジャンルの数。0のときはgameGenreNamesの数。
+		registeredGenreNum      int
+		RegisterGenresToGameErr error
+		isErr                   bool
+		expectedErr             error
 	}
 
 	gameGenreName1 := values.GameGenreName("3D")
@@ -205,14 +253,18 @@ func TestUpdateGameGenres(t *testing.T) {
 	gameGenre1 := domain.NewGameGenre(values.NewGameGenreID(), gameGenreName1, time.Now())
 	gameGenre2 := domain.NewGameGenre(values.NewGameGenreID(), gameGenreName2, time.Now())
 
+	aliasName1 := values.GameGenreName("3DCG")
+	alias1 := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), gameGenre1.GetID(), aliasName1, time.Now())
+
 	testCases := map[string]test{
 		"特に問題ないのでエラー無し": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1},
-			executeSaveGameGenres:         true,
-			executeRegisterGenresToGame:   true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeSaveGameGenres:               true,
+			executeRegisterGenresToGame:         true,
 		},
 		"ジャンル名が重複しているのでエラー": {
 			gameID:         values.NewGameID(),
@@ -221,91 +273,150 @@ func TestUpdateGameGenres(t *testing.T) {
 			expectedErr:    service.ErrDuplicateGameGenre,
 		},
 		"GetGameGenresWithNamesがErrRecordNotFoundでもエラー無し": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{},
-			GetGameGenresWithNamesErr:     repository.ErrRecordNotFound,
-			executeSaveGameGenres:         true,
-			executeRegisterGenresToGame:   true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{},
+			GetGameGenresWithNamesErr:           repository.ErrRecordNotFound,
+			executeSaveGameGenres:               true,
+			executeRegisterGenresToGame:         true,
 		},
 		"GetGameGenresWithNamesがErrRecordNotFound以外のエラーなのでエラー": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{},
-			GetGameGenresWithNamesErr:     errors.New("test"),
-			isErr:                         true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{},
+			GetGameGenresWithNamesErr:           errors.New("test"),
+			isErr:                               true,
 		},
 		"全てが既存のジャンルでもエラー無し": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1, gameGenre2},
-			executeRegisterGenresToGame:   true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1, gameGenre2},
+			executeRegisterGenresToGame:         true,
 		},
 		"SaveGameGenresがErrDuplicatedUniqueKeyなのでエラー": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1},
-			executeSaveGameGenres:         true,
-			SaveGameGenresErr:             repository.ErrDuplicatedUniqueKey,
-			isErr:                         true,
-			expectedErr:                   service.ErrDuplicateGameGenre,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeSaveGameGenres:               true,
+			SaveGameGenresErr:                   repository.ErrDuplicatedUniqueKey,
+			isErr:                               true,
+			expectedErr:                         service.ErrDuplicateGameGenre,
 		},
 		"SaveGameGenresが他のエラーなのでエラー": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1},
-			executeSaveGameGenres:         true,
-			SaveGameGenresErr:             errors.New("test"),
-			isErr:                         true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeSaveGameGenres:               true,
+			SaveGameGenresErr:                   errors.New("test"),
+			isErr:                               true,
+		},
+		"別名は別名が指すジャンルとして扱うのでエラー無し": {
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{aliasName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			aliases:                             []*domain.GameGenreAlias{alias1},
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre2},
+			executeRegisterGenresToGame:         true,
+		},
+		"別名と元のジャンル名が両方あっても1つとして登録するのでエラー無し": {
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, aliasName1},
+			executeGetGameGenreAliasesWithNames: true,
+			aliases:                             []*domain.GameGenreAlias{alias1},
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeRegisterGenresToGame:         true,
+			registeredGenreNum:                  1,
+		},
+		"GetGameGenreAliasesWithNamesがエラーなのでエラー": {
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			GetGameGenreAliasesWithNamesErr:     errors.New("test"),
+			isErr:                               true,
 		},
 		"RegisterGenresToGameがErrRecordNotFoundなのでエラー": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1},
-			executeSaveGameGenres:         true,
-			executeRegisterGenresToGame:   true,
-			RegisterGenresToGameErr:       repository.ErrRecordNotFound,
-			isErr:                         true,
-			expectedErr:                   service.ErrNoGame,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeSaveGameGenres:               true,
+			executeRegisterGenresToGame:         true,
+			RegisterGenresToGameErr:             repository.ErrRecordNotFound,
+			isErr:                               true,
+			expectedErr:                         service.ErrNoGame,
 		},
 		"RegisterGenresToGameが他のエラーなのでエラー": {
-			gameID:                        values.NewGameID(),
-			gameGenreNames:                []values.GameGenreName{gameGenreName1, gameGenreName2},
-			executeGetGameGenresWithNames: true,
-			GetGameGenresWithNamesResult:  []*domain.GameGenre{gameGenre1},
-			executeSaveGameGenres:         true,
-			executeRegisterGenresToGame:   true,
-			RegisterGenresToGameErr:       errors.New("test"),
-			isErr:                         true,
+			gameID:                              values.NewGameID(),
+			gameGenreNames:                      []values.GameGenreName{gameGenreName1, gameGenreName2},
+			executeGetGameGenreAliasesWithNames: true,
+			executeGetGameGenresWithNames:       true,
+			GetGameGenresWithNamesResult:        []*domain.GameGenre{gameGenre1},
+			executeSaveGameGenres:               true,
+			executeRegisterGenresToGame:         true,
+			RegisterGenresToGameErr:             errors.New("test"),
+			isErr:                               true,
 		},
 	}
 
 	for description, testCase := range testCases {
 		t.Run(description, func(t *testing.T) {
+			if testCase.executeGetGameGenreAliasesWithNames {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreAliasesWithNames(gomock.Any(), testCase.gameGenreNames).
+					Return(testCase.aliases, testCase.GetGameGenreAliasesWithNamesErr)
+			}
+
+			// 別名でないジャンル名だけがジャンルとして検索される
+			genreNames := make([]values.GameGenreName, 0, len(testCase.gameGenreNames))
+			for _, name := range testCase.gameGenreNames {
+				isAlias := false
+				for _, alias := range testCase.aliases {
+					if alias.GetName() == name {
+						isAlias = true
+					}
+				}
+				if !isAlias {
+					genreNames = append(genreNames, name)
+				}
+			}
+
 			if testCase.executeGetGameGenresWithNames {
 				mockGameGenreRepository.
 					EXPECT().
-					GetGameGenresWithNames(ctx, testCase.gameGenreNames).
+					GetGameGenresWithNames(gomock.Any(), genreNames).
 					Return(testCase.GetGameGenresWithNamesResult, testCase.GetGameGenresWithNamesErr)
 			}
 
 			if testCase.executeSaveGameGenres {
 				mockGameGenreRepository.
 					EXPECT().
-					SaveGameGenres(ctx, gomock.Len(len(testCase.gameGenreNames)-len(testCase.GetGameGenresWithNamesResult))).
+					SaveGameGenres(gomock.Any(), gomock.Len(len(genreNames)-len(testCase.GetGameGenresWithNamesResult))).
 					Return(testCase.SaveGameGenresErr)
 			}
 
 			if testCase.executeRegisterGenresToGame {
+				registeredGenreNum := len(testCase.gameGenreNames)
+				if testCase.registeredGenreNum != 0 {
+					registeredGenreNum = testCase.registeredGenreNum
+				}
+
 				mockGameGenreRepository.
 					EXPECT().
-					RegisterGenresToGame(ctx, testCase.gameID, gomock.Len(len(testCase.gameGenreNames))).
+					RegisterGenresToGame(gomock.Any(), testCase.gameID, gomock.Len(registeredGenreNum)).
 					Return(testCase.RegisterGenresToGameErr)
 			}
 
@@ -336,31 +447,46 @@ func TestUpdateGameGenre(t *testing.T) {
 	mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
 	mockDB := mockRepository.NewMockDB(ctrl)
 
-	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository)
+	gameGenreService := NewGameGenre(mockDB, mockGameGenreRepository, nil, nil)
 
 	gameGenreID := values.NewGameGenreID()
+	parentGameGenreID := values.NewGameGenreID()
+	alias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), gameGenreID, "3DCG", time.Now())
+	otherAlias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), parentGameGenreID, "2DCG", time.Now())
 
 	testCases := map[string]struct {
-		gameGenre              *domain.GameGenre
-		newGameGenreName       values.GameGenreName
-		GetGameGenreErr        error
-		executeUpdateGameGenre bool
-		UpdateGameGenreErr     error
-		executeGetGenreGames   bool
-		games                  []*domain.Game
-		GetGenreGamesErr       error
-		genreInfo              *service.GameGenreInfo
-		isError                bool
-		wantErr                error
+		gameGenre                           *domain.GameGenre
+		newGameGenreName                    values.GameGenreName
+		GetGameGenreErr                     error
+		executeGetGameGenreAliasesWithNames bool
+		aliasesWithName                     []*domain.GameGenreAlias
+		GetGameGenreAliasesWithNamesErr     error
+		executeUpdateGameGenre              bool
+		UpdateGameGenreErr                  error
+		executeGetGenreGames                bool
+		games                               []*domain.Game
+		GetGenreGamesErr                    error
+		executeGetGameGenreParentIDs        bool
+		parentIDs                           map[values.GameGenreID]values.GameGenreID
+		GetGameGenreParentIDsErr            error
+		executeGetGameGenreAliases          bool
+		aliases                             []*domain.GameGenreAlias
+		GetGameGenreAliasesErr              error
+		genreInfo                           *service.GameGenreInfo
+		isError                             bool
+		wantErr                             error
 	}{
 		"特に問題ないのでエラー無し": {
-			gameGenre:              domain.NewGameGenre(gameGenreID, "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			executeGetGenreGames:   true,
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
 			games: []*domain.Game{
 				domain.NewGame(values.NewGameID(), "game", "description", values.GameVisibilityTypePublic, time.Now()),
 			},
+			executeGetGameGenreParentIDs: true,
+			executeGetGameGenreAliases:   true,
 			genreInfo: &service.GameGenreInfo{
 				GameGenre: *domain.NewGameGenre(gameGenreID, "2D", time.Now()),
 				Num:       1,
@@ -386,60 +512,123 @@ func TestUpdateGameGenre(t *testing.T) {
 			wantErr:          service.ErrNoGameGenreUpdated,
 		},
 		"UpdateGameGenreがErrDuplicatedUniqueKeyなのでErrDuplicateGameGenre": {
-			gameGenre:              domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			UpdateGameGenreErr:     repository.ErrDuplicatedUniqueKey,
-			isError:                true,
-			wantErr:                service.ErrDuplicateGameGenreName,
+			gameGenre:                           domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			UpdateGameGenreErr:                  repository.ErrDuplicatedUniqueKey,
+			isError:                             true,
+			wantErr:                             service.ErrDuplicateGameGenreName,
 		},
 		"UpdateGameGenreがErrNoRecordUpdatedなのでErrNoGameGenreUpdated": {
-			gameGenre:              domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
-			newGameGenreName:       "2D", // 本来はこの値が異なるからErrNoRecordUpdatedにはならない
-			executeUpdateGameGenre: true,
-			UpdateGameGenreErr:     repository.ErrNoRecordUpdated,
-			isError:                true,
-			wantErr:                service.ErrNoGameGenreUpdated,
+			gameGenre:                           domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
+			newGameGenreName:                    "2D", // 本来はこの値が異なるからErrNoRecordUpdatedにはならない
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			UpdateGameGenreErr:                  repository.ErrNoRecordUpdated,
+			isError:                             true,
+			wantErr:                             service.ErrNoGameGenreUpdated,
 		},
 		"UpdateGameGenreがエラーなのでエラー": {
-			gameGenre:              domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			UpdateGameGenreErr:     errors.New("test"),
-			isError:                true,
+			gameGenre:                           domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			UpdateGameGenreErr:                  errors.New("test"),
+			isError:                             true,
 		},
 		"GetGenreGamesがnilなので0件でエラー無し": {
-			gameGenre:              domain.NewGameGenre(gameGenreID, "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			executeGetGenreGames:   true,
-			games:                  nil,
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
+			games:                               nil,
+			executeGetGameGenreParentIDs:        true,
+			executeGetGameGenreAliases:          true,
 			genreInfo: &service.GameGenreInfo{
 				GameGenre: *domain.NewGameGenre(gameGenreID, "2D", time.Now()),
 				Num:       0,
 			},
 		},
 		"GetGenreGamesが複数でもエラー無し": {
-			gameGenre:              domain.NewGameGenre(gameGenreID, "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			executeGetGenreGames:   true,
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
 			games: []*domain.Game{
 				domain.NewGame(values.NewGameID(), "game1", "description1", values.GameVisibilityTypePublic, time.Now()),
 				domain.NewGame(values.NewGameID(), "game2", "description2", values.GameVisibilityTypePublic, time.Now()),
 			},
+			executeGetGameGenreParentIDs: true,
+			executeGetGameGenreAliases:   true,
 			genreInfo: &service.GameGenreInfo{
 				GameGenre: *domain.NewGameGenre(gameGenreID, "2D", time.Now()),
 				Num:       2,
 			},
 		},
+		"ジャンル名が他のジャンルの別名と重複するのでErrDuplicateGameGenreName": {
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2DCG",
+			executeGetGameGenreAliasesWithNames: true,
+			aliasesWithName:                     []*domain.GameGenreAlias{otherAlias},
+			isError:                             true,
+			wantErr:                             service.ErrDuplicateGameGenreName,
+		},
+		"GetGameGenreAliasesWithNamesがエラーなのでエラー": {
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			GetGameGenreAliasesWithNamesErr:     errors.New("test"),
+			isError:                             true,
+		},
+		"親ジャンルと別名があってもエラー無し": {
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
+			executeGetGameGenreParentIDs:        true,
+			parentIDs:                           map[values.GameGenreID]values.GameGenreID{gameGenreID: parentGameGenreID},
+			executeGetGameGenreAliases:          true,
+			aliases:                             []*domain.GameGenreAlias{alias, otherAlias},
+			genreInfo: &service.GameGenreInfo{
+				GameGenre: *domain.NewGameGenre(gameGenreID, "2D", time.Now()),
+				Num:       0,
+				ParentID:  &parentGameGenreID,
+				Aliases:   []*domain.GameGenreAlias{alias},
+			},
+		},
+		"GetGameGenreParentIDsがエラーなのでエラー": {
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
+			executeGetGameGenreParentIDs:        true,
+			GetGameGenreParentIDsErr:            errors.New("test"),
+			isError:                             true,
+		},
+		"GetGameGenreAliasesがエラーなのでエラー": {
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
+			executeGetGameGenreParentIDs:        true,
+			executeGetGameGenreAliases:          true,
+			GetGameGenreAliasesErr:              errors.New("test"),
+			isError:                             true,
+		},
 		"GetGenreGamesがエラーなのでエラー": {
-			gameGenre:              domain.NewGameGenre(gameGenreID, "3D", time.Now()),
-			newGameGenreName:       "2D",
-			executeUpdateGameGenre: true,
-			executeGetGenreGames:   true,
-			GetGenreGamesErr:       errors.New("test"),
-			isError:                true,
+			gameGenre:                           domain.NewGameGenre(gameGenreID, "3D", time.Now()),
+			newGameGenreName:                    "2D",
+			executeGetGameGenreAliasesWithNames: true,
+			executeUpdateGameGenre:              true,
+			executeGetGenreGames:                true,
+			GetGenreGamesErr:                    errors.New("test"),
+			isError:                             true,
 		},
 	}
 
@@ -450,6 +639,13 @@ func TestUpdateGameGenre(t *testing.T) {
 				GetGameGenre(gomock.Any(), testCase.gameGenre.GetID()).
 				Return(testCase.gameGenre, testCase.GetGameGenreErr)
 
+			if testCase.executeGetGameGenreAliasesWithNames {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreAliasesWithNames(gomock.Any(), []values.GameGenreName{testCase.newGameGenreName}).
+					Return(testCase.aliasesWithName, testCase.GetGameGenreAliasesWithNamesErr)
+			}
+
 			if testCase.executeUpdateGameGenre {
 				mockGameGenreRepository.
 					EXPECT().
@@ -464,6 +660,20 @@ func TestUpdateGameGenre(t *testing.T) {
 					Return(testCase.games, testCase.GetGenreGamesErr)
 			}
 
+			if testCase.executeGetGameGenreParentIDs {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreParentIDs(gomock.Any()).
+					Return(testCase.parentIDs, testCase.GetGameGenreParentIDsErr)
+			}
+
+			if testCase.executeGetGameGenreAliases {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreAliases(gomock.Any()).
+					Return(testCase.aliases, testCase.GetGameGenreAliasesErr)
+			}
+
 			genreInfo, err := gameGenreService.UpdateGameGenre(ctx, testCase.gameGenre.GetID(), testCase.newGameGenreName)
 
 			if testCase.isError {
@@ -484,6 +694,798 @@ func TestUpdateGameGenre(t *testing.T) {
 			assert.Equal(t, testCase.genreInfo.GetName(), genreInfo.GetName())
 			assert.WithinDuration(t, testCase.genreInfo.GetCreatedAt(), genreInfo.GetCreatedAt(), time.Second)
 			assert.Equal(t, testCase.genreInfo.Num, genreInfo.Num)
+			assert.Equal(t, testCase.genreInfo.ParentID, genreInfo.ParentID)
+			assert.Len(t, genreInfo.Aliases, len(testCase.genreInfo.Aliases))
+			for i := range genreInfo.Aliases {
+				assert.Equal(t, testCase.genreInfo.Aliases[i], genreInfo.Aliases[i])
+			}
+		})
+	}
+}
+
+func TestMergeGameGenres(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	source := domain.NewGameGenre(values.NewGameGenreID(), "3DCG", time.Now())
+	target := domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now())
+	grandParentID := values.NewGameGenreID()
+	middleID := values.NewGameGenreID()
+
+	type test struct {
+		description                  string
+		sourceID                     values.GameGenreID
+		targetID                     values.GameGenreID
+		executeGetGameGenres         bool
+		GetSourceErr                 error
+		GetTargetErr                 error
+		executeMerge                 bool
+		parentIDs                    map[values.GameGenreID]values.GameGenreID
+		executeUpdateGameGenreParent bool
+		newTargetParentID            *values.GameGenreID
+		MergeGameGenreErr            error
+		executeRemoveGameGenre       bool
+		RemoveGameGenreErr           error
+		executeSaveGameGenreAlias    bool
+		SaveGameGenreAliasErr        error
+		executeCreateAuditLog        bool
+		CreateAuditLogErr            error
+		isErr                        bool
+		err                          error
+	}
+
+	testCases := []test{
+		{
+			description:               "特に問題ないのでエラー無し",
+			sourceID:                  source.GetID(),
+			targetID:                  target.GetID(),
+			executeGetGameGenres:      true,
+			executeMerge:              true,
+			parentIDs:                 map[values.GameGenreID]values.GameGenreID{},
+			executeRemoveGameGenre:    true,
+			executeSaveGameGenreAlias: true,
+			executeCreateAuditLog:     true,
+		},
+		{
+			description: "sourceとtargetが同じなのでErrCannotMergeSameGameGenre",
+			sourceID:    source.GetID(),
+			targetID:    source.GetID(),
+			isErr:       true,
+			err:         service.ErrCannotMergeSameGameGenre,
+		},
+		{
+			description:          "sourceが存在しないのでErrNoGameGenre",
+			sourceID:             source.GetID(),
+			targetID:             target.GetID(),
+			executeGetGameGenres: true,
+			GetSourceErr:         repository.ErrRecordNotFound,
+			isErr:                true,
+			err:                  service.ErrNoGameGenre,
+		},
+		{
+			description:          "targetが存在しないのでErrNoGameGenre",
+			sourceID:             source.GetID(),
+			targetID:             target.GetID(),
+			executeGetGameGenres: true,
+			GetTargetErr:         repository.ErrRecordNotFound,
+			isErr:                true,
+			err:                  service.ErrNoGameGenre,
+		},
+		{
+			description:          "targetがsourceの子孫なのでtargetをsourceの親の下に移す",
+			sourceID:             source.GetID(),
+			targetID:             target.GetID(),
+			executeGetGameGenres: true,
+			executeMerge:         true,
+			parentIDs: map[values.GameGenreID]values.GameGenreID{
+				source.GetID(): grandParentID,
+				middleID:       source.GetID(),
+				target.GetID(): middleID,
+			},
+			executeUpdateGameGenreParent: true,
+			newTargetParentID:            &grandParentID,
+			executeRemoveGameGenre:       true,
+			executeSaveGameGenreAlias:    true,
+			executeCreateAuditLog:        true,
+		},
+		{
+			description:          "MergeGameGenreがエラーなのでエラー",
+			sourceID:             source.GetID(),
+			targetID:             target.GetID(),
+			executeGetGameGenres: true,
+			executeMerge:         true,
+			parentIDs:            map[values.GameGenreID]values.GameGenreID{},
+			MergeGameGenreErr:    errors.New("error"),
+			isErr:                true,
+		},
+		{
+			description:            "RemoveGameGenreがエラーなのでエラー",
+			sourceID:               source.GetID(),
+			targetID:               target.GetID(),
+			executeGetGameGenres:   true,
+			executeMerge:           true,
+			parentIDs:              map[values.GameGenreID]values.GameGenreID{},
+			executeRemoveGameGenre: true,
+			RemoveGameGenreErr:     errors.New("error"),
+			isErr:                  true,
+		},
+		{
+			description:               "SaveGameGenreAliasがエラーなのでエラー",
+			sourceID:                  source.GetID(),
+			targetID:                  target.GetID(),
+			executeGetGameGenres:      true,
+			executeMerge:              true,
+			parentIDs:                 map[values.GameGenreID]values.GameGenreID{},
+			executeRemoveGameGenre:    true,
+			executeSaveGameGenreAlias: true,
+			SaveGameGenreAliasErr:     errors.New("error"),
+			isErr:                     true,
+		},
+		{
+			description:               "CreateAuditLogがエラーなのでエラー",
+			sourceID:                  source.GetID(),
+			targetID:                  target.GetID(),
+			executeGetGameGenres:      true,
+			executeMerge:              true,
+			parentIDs:                 map[values.GameGenreID]values.GameGenreID{},
+			executeRemoveGameGenre:    true,
+			executeSaveGameGenreAlias: true,
+			executeCreateAuditLog:     true,
+			CreateAuditLogErr:         errors.New("error"),
+			isErr:                     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameGenreService := NewGameGenre(
+				mockDB,
+				mockGameGenreRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			if testCase.sourceID != testCase.targetID {
+				mockUserCache.
+					EXPECT().
+					GetMe(gomock.Any(), authSession.GetAccessToken()).
+					Return(myInfo, nil)
+			}
+
+			if testCase.executeGetGameGenres {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenre(gomock.Any(), testCase.sourceID).
+					Return(source, testCase.GetSourceErr)
+				if testCase.GetSourceErr == nil {
+					mockGameGenreRepository.
+						EXPECT().
+						GetGameGenre(gomock.Any(), testCase.targetID).
+						Return(target, testCase.GetTargetErr)
+				}
+			}
+
+			if testCase.executeMerge {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGamesByGenreID(gomock.Any(), gomock.Any()).
+					Return([]*domain.Game{}, nil).
+					AnyTimes()
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreParentIDs(gomock.Any()).
+					Return(testCase.parentIDs, nil).
+					AnyTimes()
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreAliases(gomock.Any()).
+					Return([]*domain.GameGenreAlias{}, nil).
+					AnyTimes()
+
+				mockGameGenreRepository.
+					EXPECT().
+					MergeGameGenre(gomock.Any(), testCase.sourceID, testCase.targetID).
+					Return(testCase.MergeGameGenreErr)
+			}
+
+			if testCase.executeUpdateGameGenreParent {
+				mockGameGenreRepository.
+					EXPECT().
+					UpdateGameGenreParent(gomock.Any(), testCase.targetID, testCase.newTargetParentID).
+					Return(nil)
+			}
+
+			if testCase.executeRemoveGameGenre {
+				mockGameGenreRepository.
+					EXPECT().
+					RemoveGameGenre(gomock.Any(), testCase.sourceID).
+					Return(testCase.RemoveGameGenreErr)
+			}
+
+			if testCase.executeSaveGameGenreAlias {
+				mockGameGenreRepository.
+					EXPECT().
+					SaveGameGenreAlias(gomock.Any(), gomock.Cond(func(alias *domain.GameGenreAlias) bool {
+						return alias.GetGenreID() == testCase.targetID && alias.GetName() == source.GetName()
+					})).
+					Return(testCase.SaveGameGenreAliasErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionMergeGameGenres &&
+							auditLog.GetTargetType() == values.AuditLogTargetTypeGameGenre &&
+							auditLog.GetTargetID() == uuid.UUID(testCase.targetID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			genreInfo, err := gameGenreService.MergeGameGenres(ctx, authSession, testCase.sourceID, testCase.targetID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, target.GetID(), genreInfo.GetID())
+			assert.Equal(t, target.GetName(), genreInfo.GetName())
+		})
+	}
+}
+
+func TestUpdateGameGenreParent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	genre := domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now())
+	parent := domain.NewGameGenre(values.NewGameGenreID(), "CG", time.Now())
+	parentID := parent.GetID()
+	genreID := genre.GetID()
+	otherID := values.NewGameGenreID()
+
+	type test struct {
+		description                  string
+		parentID                     *values.GameGenreID
+		executeGetMe                 bool
+		GetGameGenreErr              error
+		executeGetParent             bool
+		GetParentErr                 error
+		executeGetGameGenreParentIDs bool
+		parentIDs                    map[values.GameGenreID]values.GameGenreID
+		executeUpdateGameGenreParent bool
+		UpdateGameGenreParentErr     error
+		executeCreateAuditLog        bool
+		CreateAuditLogErr            error
+		isErr                        bool
+		err                          error
+	}
+
+	testCases := []test{
+		{
+			description:                  "特に問題ないのでエラー無し",
+			parentID:                     &parentID,
+			executeGetMe:                 true,
+			executeGetParent:             true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{},
+			executeUpdateGameGenreParent: true,
+			executeCreateAuditLog:        true,
+		},
+		{
+			description:                  "親ジャンル無しにしてもエラー無し",
+			executeGetMe:                 true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{genreID: parentID},
+			executeUpdateGameGenreParent: true,
+			executeCreateAuditLog:        true,
+		},
+		{
+			description: "自身を親にするのでErrInvalidGameGenreParent",
+			parentID:    &genreID,
+			isErr:       true,
+			err:         service.ErrInvalidGameGenreParent,
+		},
+		{
+			description:     "ジャンルが存在しないのでErrNoGameGenre",
+			parentID:        &parentID,
+			executeGetMe:    true,
+			GetGameGenreErr: repository.ErrRecordNotFound,
+			isErr:           true,
+			err:             service.ErrNoGameGenre,
+		},
+		{
+			description:      "親ジャンルが存在しないのでErrNoGameGenre",
+			parentID:         &parentID,
+			executeGetMe:     true,
+			executeGetParent: true,
+			GetParentErr:     repository.ErrRecordNotFound,
+			isErr:            true,
+			err:              service.ErrNoGameGenre,
+		},
+		{
+			description:                  "親ジャンルが変わらないのでErrNoGameGenreUpdated",
+			parentID:                     &parentID,
+			executeGetMe:                 true,
+			executeGetParent:             true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{genreID: parentID},
+			isErr:                        true,
+			err:                          service.ErrNoGameGenreUpdated,
+		},
+		{
+			description:                  "親ジャンル無しのままなのでErrNoGameGenreUpdated",
+			executeGetMe:                 true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{},
+			isErr:                        true,
+			err:                          service.ErrNoGameGenreUpdated,
+		},
+		{
+			description:                  "子孫を親にすると循環するのでErrInvalidGameGenreParent",
+			parentID:                     &parentID,
+			executeGetMe:                 true,
+			executeGetParent:             true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs: map[values.GameGenreID]values.GameGenreID{
+				otherID:  genreID,
+				parentID: otherID,
+			},
+			isErr: true,
+			err:   service.ErrInvalidGameGenreParent,
+		},
+		{
+			description:                  "UpdateGameGenreParentがエラーなのでエラー",
+			parentID:                     &parentID,
+			executeGetMe:                 true,
+			executeGetParent:             true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{},
+			executeUpdateGameGenreParent: true,
+			UpdateGameGenreParentErr:     errors.New("error"),
+			isErr:                        true,
+		},
+		{
+			description:                  "CreateAuditLogがエラーなのでエラー",
+			parentID:                     &parentID,
+			executeGetMe:                 true,
+			executeGetParent:             true,
+			executeGetGameGenreParentIDs: true,
+			parentIDs:                    map[values.GameGenreID]values.GameGenreID{},
+			executeUpdateGameGenreParent: true,
+			executeCreateAuditLog:        true,
+			CreateAuditLogErr:            errors.New("error"),
+			isErr:                        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameGenreService := NewGameGenre(
+				mockDB,
+				mockGameGenreRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			if testCase.executeGetMe {
+				mockUserCache.
+					EXPECT().
+					GetMe(gomock.Any(), authSession.GetAccessToken()).
+					Return(myInfo, nil)
+
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenre(gomock.Any(), genreID).
+					Return(genre, testCase.GetGameGenreErr)
+			}
+
+			if testCase.executeGetParent {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenre(gomock.Any(), parentID).
+					Return(parent, testCase.GetParentErr)
+			}
+
+			if testCase.executeGetGameGenreParentIDs {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenreParentIDs(gomock.Any()).
+					Return(testCase.parentIDs, nil)
+			}
+
+			if testCase.executeUpdateGameGenreParent {
+				mockGameGenreRepository.
+					EXPECT().
+					UpdateGameGenreParent(gomock.Any(), genreID, testCase.parentID).
+					Return(testCase.UpdateGameGenreParentErr)
+
+				if testCase.UpdateGameGenreParentErr == nil {
+					newParentIDs := map[values.GameGenreID]values.GameGenreID{}
+					if testCase.parentID != nil {
+						newParentIDs[genreID] = *testCase.parentID
+					}
+
+					mockGameGenreRepository.
+						EXPECT().
+						GetGamesByGenreID(gomock.Any(), genreID).
+						Return([]*domain.Game{}, nil)
+					mockGameGenreRepository.
+						EXPECT().
+						GetGameGenreParentIDs(gomock.Any()).
+						Return(newParentIDs, nil)
+					mockGameGenreRepository.
+						EXPECT().
+						GetGameGenreAliases(gomock.Any()).
+						Return([]*domain.GameGenreAlias{}, nil)
+				}
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionUpdateGameGenreParent &&
+							auditLog.GetTargetID() == uuid.UUID(genreID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			genreInfo, err := gameGenreService.UpdateGameGenreParent(ctx, authSession, genreID, testCase.parentID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, genreID, genreInfo.GetID())
+			assert.Equal(t, testCase.parentID, genreInfo.ParentID)
+		})
+	}
+}
+
+func TestAddGameGenreAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	genre := domain.NewGameGenre(values.NewGameGenreID(), "3D", time.Now())
+	aliasName := values.GameGenreName("3DCG")
+
+	type test struct {
+		description                   string
+		GetGameGenreErr               error
+		executeGetGameGenresWithNames bool
+		GetGameGenresWithNamesErr     error
+		executeSaveGameGenreAlias     bool
+		SaveGameGenreAliasErr         error
+		executeCreateAuditLog         bool
+		CreateAuditLogErr             error
+		isErr                         bool
+		err                           error
+	}
+
+	testCases := []test{
+		{
+			description:                   "特に問題ないのでエラー無し",
+			executeGetGameGenresWithNames: true,
+			GetGameGenresWithNamesErr:     repository.ErrRecordNotFound,
+			executeSaveGameGenreAlias:     true,
+			executeCreateAuditLog:         true,
+		},
+		{
+			description:     "ジャンルが存在しないのでErrNoGameGenre",
+			GetGameGenreErr: repository.ErrRecordNotFound,
+			isErr:           true,
+			err:             service.ErrNoGameGenre,
+		},
+		{
+			description:                   "別名がジャンル名と重複するのでErrDuplicateGameGenreName",
+			executeGetGameGenresWithNames: true,
+			isErr:                         true,
+			err:                           service.ErrDuplicateGameGenreName,
+		},
+		{
+			description:                   "GetGameGenresWithNamesがエラーなのでエラー",
+			executeGetGameGenresWithNames: true,
+			GetGameGenresWithNamesErr:     errors.New("error"),
+			isErr:                         true,
+		},
+		{
+			description:                   "別名が他の別名と重複するのでErrDuplicateGameGenreName",
+			executeGetGameGenresWithNames: true,
+			GetGameGenresWithNamesErr:     repository.ErrRecordNotFound,
+			executeSaveGameGenreAlias:     true,
+			SaveGameGenreAliasErr:         repository.ErrDuplicatedUniqueKey,
+			isErr:                         true,
+			err:                           service.ErrDuplicateGameGenreName,
+		},
+		{
+			description:                   "SaveGameGenreAliasがエラーなのでエラー",
+			executeGetGameGenresWithNames: true,
+			GetGameGenresWithNamesErr:     repository.ErrRecordNotFound,
+			executeSaveGameGenreAlias:     true,
+			SaveGameGenreAliasErr:         errors.New("error"),
+			isErr:                         true,
+		},
+		{
+			description:                   "CreateAuditLogがエラーなのでエラー",
+			executeGetGameGenresWithNames: true,
+			GetGameGenresWithNamesErr:     repository.ErrRecordNotFound,
+			executeSaveGameGenreAlias:     true,
+			executeCreateAuditLog:         true,
+			CreateAuditLogErr:             errors.New("error"),
+			isErr:                         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameGenreService := NewGameGenre(
+				mockDB,
+				mockGameGenreRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+
+			mockGameGenreRepository.
+				EXPECT().
+				GetGameGenre(gomock.Any(), genre.GetID()).
+				Return(genre, testCase.GetGameGenreErr)
+
+			if testCase.executeGetGameGenresWithNames {
+				mockGameGenreRepository.
+					EXPECT().
+					GetGameGenresWithNames(gomock.Any(), []values.GameGenreName{aliasName}).
+					Return([]*domain.GameGenre{}, testCase.GetGameGenresWithNamesErr)
+			}
+
+			if testCase.executeSaveGameGenreAlias {
+				mockGameGenreRepository.
+					EXPECT().
+					SaveGameGenreAlias(gomock.Any(), gomock.Any()).
+					Return(testCase.SaveGameGenreAliasErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionAddGameGenreAlias &&
+							auditLog.GetTargetID() == uuid.UUID(genre.GetID())
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			alias, err := gameGenreService.AddGameGenreAlias(ctx, authSession, genre.GetID(), aliasName)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, genre.GetID(), alias.GetGenreID())
+			assert.Equal(t, aliasName, alias.GetName())
+			assert.WithinDuration(t, time.Now(), alias.GetCreatedAt(), time.Second)
+		})
+	}
+}
+
+func TestDeleteGameGenreAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	genreID := values.NewGameGenreID()
+	alias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), genreID, "3DCG", time.Now())
+	otherGenreAlias := domain.NewGameGenreAlias(values.NewGameGenreAliasID(), values.NewGameGenreID(), "2DCG", time.Now())
+
+	type test struct {
+		description                 string
+		alias                       *domain.GameGenreAlias
+		GetGameGenreAliasErr        error
+		executeRemoveGameGenreAlias bool
+		RemoveGameGenreAliasErr     error
+		executeCreateAuditLog       bool
+		CreateAuditLogErr           error
+		isErr                       bool
+		err                         error
+	}
+
+	testCases := []test{
+		{
+			description:                 "特に問題ないのでエラー無し",
+			alias:                       alias,
+			executeRemoveGameGenreAlias: true,
+			executeCreateAuditLog:       true,
+		},
+		{
+			description:          "別名が存在しないのでErrNoGameGenreAlias",
+			alias:                alias,
+			GetGameGenreAliasErr: repository.ErrRecordNotFound,
+			isErr:                true,
+			err:                  service.ErrNoGameGenreAlias,
+		},
+		{
+			description:          "GetGameGenreAliasがエラーなのでエラー",
+			alias:                alias,
+			GetGameGenreAliasErr: errors.New("error"),
+			isErr:                true,
+		},
+		{
+			description: "他のジャンルの別名なのでErrNoGameGenreAlias",
+			alias:       otherGenreAlias,
+			isErr:       true,
+			err:         service.ErrNoGameGenreAlias,
+		},
+		{
+			description:                 "RemoveGameGenreAliasがErrNoRecordDeletedなのでErrNoGameGenreAlias",
+			alias:                       alias,
+			executeRemoveGameGenreAlias: true,
+			RemoveGameGenreAliasErr:     repository.ErrNoRecordDeleted,
+			isErr:                       true,
+			err:                         service.ErrNoGameGenreAlias,
+		},
+		{
+			description:                 "RemoveGameGenreAliasがエラーなのでエラー",
+			alias:                       alias,
+			executeRemoveGameGenreAlias: true,
+			RemoveGameGenreAliasErr:     errors.New("error"),
+			isErr:                       true,
+		},
+		{
+			description:                 "CreateAuditLogがエラーなのでエラー",
+			alias:                       alias,
+			executeRemoveGameGenreAlias: true,
+			executeCreateAuditLog:       true,
+			CreateAuditLogErr:           errors.New("error"),
+			isErr:                       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameGenreService := NewGameGenre(
+				mockDB,
+				mockGameGenreRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+
+			mockGameGenreRepository.
+				EXPECT().
+				GetGameGenreAlias(gomock.Any(), testCase.alias.GetID()).
+				Return(testCase.alias, testCase.GetGameGenreAliasErr)
+
+			if testCase.executeRemoveGameGenreAlias {
+				mockGameGenreRepository.
+					EXPECT().
+					RemoveGameGenreAlias(gomock.Any(), testCase.alias.GetID()).
+					Return(testCase.RemoveGameGenreAliasErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionDeleteGameGenreAlias &&
+							auditLog.GetTargetID() == uuid.UUID(genreID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			err := gameGenreService.DeleteGameGenreAlias(ctx, authSession, genreID, testCase.alias.GetID())
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
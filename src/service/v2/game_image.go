@@ -1,17 +1,21 @@
 package v2
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/url"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/h2non/filetype"
 	"github.com/h2non/filetype/matchers"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/service"
 	"github.com/traPtitech/trap-collection-server/src/storage"
@@ -25,6 +29,7 @@ type GameImage struct {
 	gameRepository      repository.GameV2
 	gameImageRepository repository.GameImageV2
 	gameImageStorage    storage.GameImage
+	gameStorage         *GameStorage
 }
 
 func NewGameImage(
@@ -32,16 +37,21 @@ func NewGameImage(
 	gameRepository repository.GameV2,
 	gameImageRepository repository.GameImageV2,
 	gameImageStorage storage.GameImage,
+	gameStorage *GameStorage,
 ) *GameImage {
 	return &GameImage{
 		db:                  db,
 		gameRepository:      gameRepository,
 		gameImageRepository: gameImageRepository,
 		gameImageStorage:    gameImageStorage,
+		gameStorage:         gameStorage,
 	}
 }
 
 func (gameImage *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, gameID values.GameID) (*domain.GameImage, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.SaveGameImage")
+	defer span.End()
+
 	var image *domain.GameImage
 	err := gameImage.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		_, err := gameImage.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
@@ -52,14 +62,23 @@ func (gameImage *GameImage) SaveGameImage(ctx context.Context, reader io.Reader,
 			return fmt.Errorf("failed to get game: %w", err)
 		}
 
+		quotaReader, err := gameImage.gameStorage.newQuotaReader(ctx, gameID, reader)
+		if err != nil {
+			return fmt.Errorf("failed to create quota reader: %w", err)
+		}
+
 		imageID := values.NewGameImageID()
 
-		eg, ctx := errgroup.WithContext(ctx)
+		// eg.Wait後もトランザクションを使うので、ctxを上書きしない
+		eg, egCtx := errgroup.WithContext(ctx)
 		fileTypePr, fileTypePw := io.Pipe()
 		filePr, filePw := io.Pipe()
 
-		eg.Go(func() error {
-			defer fileTypePr.Close()
+		eg.Go(func() (err error) {
+			// 判定に失敗した場合、コピー・保存側でも同じエラーで失敗するようにする
+			defer func() {
+				fileTypePr.CloseWithError(err)
+			}()
 
 			fType, err := filetype.MatchReader(fileTypePr)
 			if err != nil {
@@ -89,18 +108,13 @@ func (gameImage *GameImage) SaveGameImage(ctx context.Context, reader io.Reader,
 				time.Now(),
 			)
 
-			err = gameImage.gameImageRepository.SaveGameImage(ctx, gameID, image)
-			if err != nil {
-				return fmt.Errorf("failed to save game image: %w", err)
-			}
-
 			return nil
 		})
 
 		eg.Go(func() error {
 			defer filePr.Close()
 
-			err = gameImage.gameImageStorage.SaveGameImage(ctx, filePr, imageID)
+			err := gameImage.gameImageStorage.SaveGameImage(egCtx, filePr, imageID)
 			if err != nil {
 				return fmt.Errorf("failed to save game image file: %w", err)
 			}
@@ -109,11 +123,11 @@ func (gameImage *GameImage) SaveGameImage(ctx context.Context, reader io.Reader,
 		})
 
 		eg.Go(func() error {
-			defer filePw.Close()
-			defer fileTypePw.Close()
-
 			mw := io.MultiWriter(fileTypePw, filePw)
-			_, err = io.Copy(mw, reader)
+			_, err := io.Copy(mw, quotaReader)
+			// 途中で失敗した場合に、途中までの内容がストレージに保存されないようにエラーを伝える
+			fileTypePw.CloseWithError(err)
+			filePw.CloseWithError(err)
 			if err != nil {
 				return fmt.Errorf("failed to copy image: %w", err)
 			}
@@ -126,16 +140,30 @@ func (gameImage *GameImage) SaveGameImage(ctx context.Context, reader io.Reader,
 			return fmt.Errorf("failed to save game image: %w", err)
 		}
 
+		// 容量は全て読み込むまで分からないので、ストレージへの保存後にメタデータを保存する
+		image.SetSize(quotaReader.Size())
+
+		err = gameImage.gameImageRepository.SaveGameImage(ctx, gameID, image)
+		if err != nil {
+			return fmt.Errorf("failed to save game image: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed in transaction: %w", err)
 	}
 
+	// 大きな画像のデコードは多くのメモリを使うので、リクエスト中には派生画像を生成しない。
+	// 派生画像はBackfillGameImageVariantsで1枚ずつ生成される。
+
 	return image, nil
 }
 
 func (gameImage *GameImage) GetGameImages(ctx context.Context, gameID values.GameID) ([]*domain.GameImage, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetGameImages")
+	defer span.End()
+
 	_, err := gameImage.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -152,7 +180,10 @@ func (gameImage *GameImage) GetGameImages(ctx context.Context, gameID values.Gam
 	return images, nil
 }
 
-func (gameImage *GameImage) GetGameImage(ctx context.Context, gameID values.GameID, imageID values.GameImageID) (values.GameImageTmpURL, error) {
+func (gameImage *GameImage) GetGameImage(ctx context.Context, gameID values.GameID, imageID values.GameImageID, size values.GameImageSize, webp bool) (values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetGameImage")
+	defer span.End()
+
 	_, err := gameImage.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -178,6 +209,21 @@ func (gameImage *GameImage) GetGameImage(ctx context.Context, gameID values.Game
 			return service.ErrInvalidGameImageID
 		}
 
+		variants, err := gameImage.gameImageRepository.GetGameImageVariants(ctx, imageID, repository.LockTypeRecord)
+		if err != nil {
+			return fmt.Errorf("failed to get game image variants: %w", err)
+		}
+
+		variant := selectGameImageVariant(variants, size, webp)
+		if variant != nil {
+			url, err = gameImage.gameImageStorage.GetVariantTempURL(ctx, variant, time.Minute)
+			if err != nil {
+				return fmt.Errorf("failed to get game image variant: %w", err)
+			}
+
+			return nil
+		}
+
 		url, err = gameImage.gameImageStorage.GetTempURL(ctx, image.GameImage, time.Minute)
 		if err != nil {
 			return fmt.Errorf("failed to get game image: %w", err)
@@ -192,29 +238,101 @@ func (gameImage *GameImage) GetGameImage(ctx context.Context, gameID values.Game
 	return url, nil
 }
 
-func (gameImage *GameImage) GetGameImageMeta(ctx context.Context, gameID values.GameID, imageID values.GameImageID) (*domain.GameImage, error) {
+func (gameImage *GameImage) GetGameImageMeta(ctx context.Context, gameID values.GameID, imageID values.GameImageID, size values.GameImageSize, webp bool) (*domain.GameImage, *domain.GameImageVariant, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetGameImageMeta")
+	defer span.End()
+
 	_, err := gameImage.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
-		return nil, service.ErrInvalidGameID
+		return nil, nil, service.ErrInvalidGameID
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game: %w", err)
+		return nil, nil, fmt.Errorf("failed to get game: %w", err)
 	}
 
 	image, err := gameImage.gameImageRepository.GetGameImage(ctx, imageID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
-		return nil, service.ErrInvalidGameImageID
+		return nil, nil, service.ErrInvalidGameImageID
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game image file: %w", err)
+		return nil, nil, fmt.Errorf("failed to get game image file: %w", err)
 	}
 
 	if image.GameID != gameID {
 		// gameIdに対応したゲームにゲーム画像が紐づいていない場合も、
 		// 念の為閲覧権限がないゲームに紐づいた画像IDを知ることができないようにするため、
 		// 画像が存在しない場合と同じErrInvalidGameImageIDを返す
-		return nil, service.ErrInvalidGameImageID
+		return nil, nil, service.ErrInvalidGameImageID
+	}
+
+	variants, err := gameImage.gameImageRepository.GetGameImageVariants(ctx, imageID, repository.LockTypeNone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get game image variants: %w", err)
+	}
+
+	return image.GameImage, selectGameImageVariant(variants, size, webp), nil
+}
+
+func (gameImage *GameImage) BackfillGameImageVariants(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "GameImage.BackfillGameImageVariants")
+	defer span.End()
+
+	images, err := gameImage.gameImageRepository.GetGameImagesWithPendingVariants(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get game images with pending variants: %w", err)
+	}
+
+	for _, image := range images {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("context done: %w", err)
+		}
+
+		imageBuf := bytes.NewBuffer(nil)
+		err := gameImage.gameImageStorage.LoadGameImage(ctx, imageBuf, image.GetID())
+		if err != nil {
+			logger.Error(ctx, "failed to load game image", slog.Any("game_image_id", uuid.UUID(image.GetID())), slog.Any("error", err))
+			continue
+		}
+
+		err = gameImage.createGameImageVariants(ctx, image.GameImage, imageBuf.Bytes())
+		if err != nil {
+			logger.Error(ctx, "failed to create game image variants", slog.Any("game_image_id", uuid.UUID(image.GetID())), slog.Any("error", err))
+			continue
+		}
+	}
+
+	return nil
+}
+
+// createGameImageVariants
+// 派生画像を生成してストレージに保存し、そのメタデータを保存する。
+// ストレージに保存済みの派生画像のみが見えるように、メタデータはストレージへの保存後に保存する。
+func (gameImage *GameImage) createGameImageVariants(ctx context.Context, image *domain.GameImage, data []byte) error {
+	encodedVariants, err := generateGameImageVariants(data, image.GetType(), time.Now())
+	if err != nil {
+		// 画像が大きすぎる、デコードできないなどは何度試しても失敗するので、
+		// 派生画像無しで生成済みとし、BackfillGameImageVariantsで再度処理しないようにする。
+		logger.Warn(ctx, "failed to generate game image variants", slog.Any("game_image_id", uuid.UUID(image.GetID())), slog.Any("error", err))
+		encodedVariants = nil
+	}
+
+	variants := make([]*domain.GameImageVariant, 0, len(encodedVariants))
+	for _, encodedVariant := range encodedVariants {
+		err := gameImage.gameImageStorage.SaveGameImageVariant(ctx, bytes.NewReader(encodedVariant.data), encodedVariant.variant.GetID())
+		if err != nil {
+			return fmt.Errorf("failed to save game image variant: %w", err)
+		}
+
+		variants = append(variants, encodedVariant.variant)
+	}
+
+	// 派生画像のメタデータと生成済みの記録を同時に保存する
+	err = gameImage.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		return gameImage.gameImageRepository.SaveGameImageVariants(ctx, image.GetID(), variants)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save game image variants: %w", err)
 	}
 
-	return image.GameImage, nil
+	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"net/url"
 	"strings"
@@ -12,6 +14,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -33,6 +38,15 @@ func TestSaveGameImage(t *testing.T) {
 	mockDB := mockRepository.NewMockDB(ctrl)
 	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 	mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+	mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
+	mockConf.
+		EXPECT().
+		DefaultGameStorageQuota().
+		Return(int64(0), false, nil)
+	gameStorage, err := NewGameStorage(mockConf, mockDB, mockGameRepository, mockGameStorageRepository, nil, nil)
+	require.NoError(t, err)
 
 	type test struct {
 		description                    string
@@ -40,6 +54,7 @@ func TestSaveGameImage(t *testing.T) {
 		isValidFile                    bool
 		imageType                      values.GameImageType
 		GetGameErr                     error
+		storageQuota                   option.Option[values.GameStorageSize]
 		executeRepositorySaveGameImage bool
 		RepositorySaveGameImageErr     error
 		executeStorageSaveGameImage    bool
@@ -109,14 +124,32 @@ func TestSaveGameImage(t *testing.T) {
 			isErr:                          true,
 		},
 		{
-			description:                    "storage.SaveGameImageがエラーなのでエラー",
+			description:                 "storage.SaveGameImageがエラーなのでエラー",
+			gameID:                      values.NewGameID(),
+			isValidFile:                 true,
+			imageType:                   values.GameImageTypeJpeg,
+			executeStorageSaveGameImage: true,
+			StorageSaveGameImageErr:     errors.New("error"),
+			isErr:                       true,
+		},
+		{
+			description:                    "ストレージの上限以内なのでエラーなし",
 			gameID:                         values.NewGameID(),
 			isValidFile:                    true,
 			imageType:                      values.GameImageTypeJpeg,
+			storageQuota:                   option.NewOption[values.GameStorageSize](1 << 30),
 			executeRepositorySaveGameImage: true,
 			executeStorageSaveGameImage:    true,
-			StorageSaveGameImageErr:        errors.New("error"),
-			isErr:                          true,
+		},
+		{
+			description:                 "ストレージの上限を超えるのでErrGameStorageQuotaExceeded",
+			gameID:                      values.NewGameID(),
+			isValidFile:                 true,
+			imageType:                   values.GameImageTypeJpeg,
+			storageQuota:                option.NewOption[values.GameStorageSize](10),
+			executeStorageSaveGameImage: true,
+			isErr:                       true,
+			err:                         service.ErrGameStorageQuotaExceeded,
 		},
 	}
 
@@ -131,6 +164,7 @@ func TestSaveGameImage(t *testing.T) {
 				mockGameRepository,
 				mockGameImageRepository,
 				mockGameImageStorage,
+				gameStorage,
 			)
 
 			var file io.Reader
@@ -178,6 +212,25 @@ func TestSaveGameImage(t *testing.T) {
 				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeRecord).
 				Return(nil, testCase.GetGameErr)
 
+			if testCase.GetGameErr == nil {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), testCase.gameID).
+					Return(domain.NewGameStorageUsage(0, 0, 0), nil)
+
+				if quota, ok := testCase.storageQuota.Value(); ok {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+						Return(quota, nil)
+				} else {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+						Return(values.GameStorageSize(0), repository.ErrRecordNotFound)
+				}
+			}
+
 			if testCase.executeRepositorySaveGameImage {
 				mockGameImageRepository.
 					EXPECT().
@@ -192,6 +245,7 @@ func TestSaveGameImage(t *testing.T) {
 					Return(testCase.StorageSaveGameImageErr)
 			}
 
+			callTime := time.Now()
 			image, err := gameImageService.SaveGameImage(ctx, file, testCase.gameID)
 
 			if testCase.isErr {
@@ -208,7 +262,8 @@ func TestSaveGameImage(t *testing.T) {
 			}
 
 			assert.Equal(t, testCase.imageType, image.GetType())
-			assert.WithinDuration(t, time.Now(), image.GetCreatedAt(), time.Second)
+			assert.Equal(t, values.GameStorageSize(len(expectBytes)), image.GetSize())
+			assert.WithinDuration(t, callTime, image.GetCreatedAt(), time.Second)
 
 			assert.Equal(t, expectBytes, buf.Bytes())
 		})
@@ -233,6 +288,7 @@ func TestGetGameImages(t *testing.T) {
 		mockGameRepository,
 		mockGameImageRepository,
 		mockGameImageStorage,
+		nil,
 	)
 
 	type test struct {
@@ -378,21 +434,30 @@ func TestGetGameImage(t *testing.T) {
 		mockGameRepository,
 		mockGameImageRepository,
 		mockGameImageStorage,
+		nil,
 	)
 
 	type test struct {
-		description         string
-		gameID              values.GameID
-		gameImageID         values.GameImageID
-		getGameErr          error
-		executeGetGameImage bool
-		image               *repository.GameImageInfo
-		getGameImageErr     error
-		executeGetTempURL   bool
-		imageURL            values.GameImageTmpURL
-		getTempURLErr       error
-		isErr               bool
-		err                 error
+		description              string
+		gameID                   values.GameID
+		gameImageID              values.GameImageID
+		getGameErr               error
+		size                     values.GameImageSize
+		webp                     bool
+		executeGetGameImage      bool
+		image                    *repository.GameImageInfo
+		getGameImageErr          error
+		executeGetVariants       bool
+		variants                 []*domain.GameImageVariant
+		getVariantsErr           error
+		executeGetVariantTempURL bool
+		variant                  *domain.GameImageVariant
+		getVariantTempURLErr     error
+		executeGetTempURL        bool
+		imageURL                 values.GameImageTmpURL
+		getTempURLErr            error
+		isErr                    bool
+		err                      error
 	}
 
 	urlLink, err := url.Parse("https://example.com")
@@ -404,6 +469,16 @@ func TestGetGameImage(t *testing.T) {
 	gameID2 := values.NewGameID()
 	gameID3 := values.NewGameID()
 	gameID4 := values.NewGameID()
+	gameID5 := values.NewGameID()
+	gameID6 := values.NewGameID()
+	gameID7 := values.NewGameID()
+	gameID8 := values.NewGameID()
+	gameID9 := values.NewGameID()
+
+	smallJpegVariant := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypeJpeg, 320, 180, time.Now())
+	smallWebpVariant := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypeWebp, 320, 180, time.Now())
+	originalWebpVariant := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeOriginal, values.GameImageTypeWebp, 1920, 1080, time.Now())
+	variants := []*domain.GameImageVariant{originalWebpVariant, smallJpegVariant, smallWebpVariant}
 
 	testCases := []test{
 		{
@@ -418,8 +493,9 @@ func TestGetGameImage(t *testing.T) {
 				),
 				GameID: gameID1,
 			},
-			executeGetTempURL: true,
-			imageURL:          values.NewGameImageTmpURL(urlLink),
+			executeGetVariants: true,
+			executeGetTempURL:  true,
+			imageURL:           values.NewGameImageTmpURL(urlLink),
 		},
 		{
 			description: "GetGameがErrRecordNotFoundなのでErrInvalidGameID",
@@ -446,7 +522,8 @@ func TestGetGameImage(t *testing.T) {
 				),
 				GameID: gameID2,
 			},
-			executeGetTempURL: true,
+			executeGetVariants: true,
+			executeGetTempURL:  true,
 		},
 		{
 			description:         "画像がgifでもエラーなし",
@@ -460,7 +537,8 @@ func TestGetGameImage(t *testing.T) {
 				),
 				GameID: gameID3,
 			},
-			executeGetTempURL: true,
+			executeGetVariants: true,
+			executeGetTempURL:  true,
 		},
 		{
 			description:         "GetGameImageがErrRecordNotFoundなのでErrInvalidGameImageID",
@@ -504,10 +582,124 @@ func TestGetGameImage(t *testing.T) {
 				),
 				GameID: gameID4,
 			},
-			executeGetTempURL: true,
-			imageURL:          values.NewGameImageTmpURL(urlLink),
-			getTempURLErr:     errors.New("error"),
-			isErr:             true,
+			executeGetVariants: true,
+			executeGetTempURL:  true,
+			imageURL:           values.NewGameImageTmpURL(urlLink),
+			getTempURLErr:      errors.New("error"),
+			isErr:              true,
+		},
+		{
+			description:         "サムネイルが存在するのでサムネイルのURL",
+			gameID:              gameID5,
+			size:                values.GameImageSizeSmall,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypeJpeg,
+					time.Now(),
+				),
+				GameID: gameID5,
+			},
+			executeGetVariants:       true,
+			variants:                 variants,
+			executeGetVariantTempURL: true,
+			variant:                  smallJpegVariant,
+			imageURL:                 values.NewGameImageTmpURL(urlLink),
+		},
+		{
+			description:         "WebPのサムネイルが存在するのでWebPのURL",
+			gameID:              gameID6,
+			size:                values.GameImageSizeSmall,
+			webp:                true,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypeJpeg,
+					time.Now(),
+				),
+				GameID: gameID6,
+			},
+			executeGetVariants:       true,
+			variants:                 variants,
+			executeGetVariantTempURL: true,
+			variant:                  smallWebpVariant,
+			imageURL:                 values.NewGameImageTmpURL(urlLink),
+		},
+		{
+			description:         "元のサイズのWebPが存在するのでWebPのURL",
+			gameID:              gameID7,
+			webp:                true,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypePng,
+					time.Now(),
+				),
+				GameID: gameID7,
+			},
+			executeGetVariants:       true,
+			variants:                 variants,
+			executeGetVariantTempURL: true,
+			variant:                  originalWebpVariant,
+			imageURL:                 values.NewGameImageTmpURL(urlLink),
+		},
+		{
+			description:         "対応するサムネイルが存在しないので元の画像のURL",
+			gameID:              gameID8,
+			size:                values.GameImageSizeLarge,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypeJpeg,
+					time.Now(),
+				),
+				GameID: gameID8,
+			},
+			executeGetVariants: true,
+			variants:           variants,
+			executeGetTempURL:  true,
+			imageURL:           values.NewGameImageTmpURL(urlLink),
+		},
+		{
+			description:         "GetGameImageVariantsがエラーなのでエラー",
+			gameID:              gameID9,
+			size:                values.GameImageSizeSmall,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypeJpeg,
+					time.Now(),
+				),
+				GameID: gameID9,
+			},
+			executeGetVariants: true,
+			getVariantsErr:     errors.New("error"),
+			isErr:              true,
+		},
+		{
+			description:         "GetVariantTempURLがエラーなのでエラー",
+			gameID:              gameID5,
+			size:                values.GameImageSizeSmall,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypeJpeg,
+					time.Now(),
+				),
+				GameID: gameID5,
+			},
+			executeGetVariants:       true,
+			variants:                 variants,
+			executeGetVariantTempURL: true,
+			variant:                  smallJpegVariant,
+			getVariantTempURLErr:     errors.New("error"),
+			isErr:                    true,
 		},
 	}
 
@@ -515,24 +707,38 @@ func TestGetGameImage(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameImage {
 				mockGameImageRepository.
 					EXPECT().
-					GetGameImage(ctx, testCase.gameImageID, repository.LockTypeRecord).
+					GetGameImage(gomock.Any(), testCase.gameImageID, repository.LockTypeRecord).
 					Return(testCase.image, testCase.getGameImageErr)
 			}
 
+			if testCase.executeGetVariants {
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImageVariants(gomock.Any(), testCase.gameImageID, repository.LockTypeRecord).
+					Return(testCase.variants, testCase.getVariantsErr)
+			}
+
+			if testCase.executeGetVariantTempURL {
+				mockGameImageStorage.
+					EXPECT().
+					GetVariantTempURL(gomock.Any(), testCase.variant, time.Minute).
+					Return(testCase.imageURL, testCase.getVariantTempURLErr)
+			}
+
 			if testCase.executeGetTempURL {
 				mockGameImageStorage.
 					EXPECT().
-					GetTempURL(ctx, testCase.image.GameImage, time.Minute).
+					GetTempURL(gomock.Any(), testCase.image.GameImage, time.Minute).
 					Return(testCase.imageURL, testCase.getTempURLErr)
 			}
 
-			tmpURL, err := gameImageService.GetGameImage(ctx, testCase.gameID, testCase.gameImageID)
+			tmpURL, err := gameImageService.GetGameImage(ctx, testCase.gameID, testCase.gameImageID, testCase.size, testCase.webp)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -570,6 +776,7 @@ func TestGetGameImageMeta(t *testing.T) {
 		mockGameRepository,
 		mockGameImageRepository,
 		mockGameImageStorage,
+		nil,
 	)
 
 	type test struct {
@@ -577,9 +784,15 @@ func TestGetGameImageMeta(t *testing.T) {
 		gameID              values.GameID
 		gameImageID         values.GameImageID
 		getGameErr          error
+		size                values.GameImageSize
+		webp                bool
 		executeGetGameImage bool
 		image               *repository.GameImageInfo
 		getGameImageErr     error
+		executeGetVariants  bool
+		variants            []*domain.GameImageVariant
+		getVariantsErr      error
+		variant             *domain.GameImageVariant
 		isErr               bool
 		err                 error
 	}
@@ -587,6 +800,11 @@ func TestGetGameImageMeta(t *testing.T) {
 	gameID1 := values.NewGameID()
 	gameID2 := values.NewGameID()
 	gameID3 := values.NewGameID()
+	gameID4 := values.NewGameID()
+	gameID5 := values.NewGameID()
+	gameID6 := values.NewGameID()
+
+	smallWebpVariant := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypeWebp, 320, 180, time.Now())
 
 	testCases := []test{
 		{
@@ -601,6 +819,7 @@ func TestGetGameImageMeta(t *testing.T) {
 				),
 				GameID: gameID1,
 			},
+			executeGetVariants: true,
 		},
 		{
 			description: "GetGameがErrRecordNotFoundなのでErrInvalidGameID",
@@ -627,6 +846,7 @@ func TestGetGameImageMeta(t *testing.T) {
 				),
 				GameID: gameID2,
 			},
+			executeGetVariants: true,
 		},
 		{
 			description:         "画像がgifでもエラーなし",
@@ -640,6 +860,7 @@ func TestGetGameImageMeta(t *testing.T) {
 				),
 				GameID: gameID3,
 			},
+			executeGetVariants: true,
 		},
 		{
 			description:         "GetGameImageがErrRecordNotFoundなのでErrInvalidGameImageID",
@@ -671,23 +892,80 @@ func TestGetGameImageMeta(t *testing.T) {
 			getGameImageErr:     errors.New("error"),
 			isErr:               true,
 		},
+		{
+			description:         "派生画像が存在するので派生画像のメタデータも返す",
+			gameID:              gameID4,
+			size:                values.GameImageSizeSmall,
+			webp:                true,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypePng,
+					time.Now(),
+				),
+				GameID: gameID4,
+			},
+			executeGetVariants: true,
+			variants:           []*domain.GameImageVariant{smallWebpVariant},
+			variant:            smallWebpVariant,
+		},
+		{
+			description:         "派生画像が存在しないので派生画像のメタデータはnil",
+			gameID:              gameID5,
+			size:                values.GameImageSizeLarge,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypePng,
+					time.Now(),
+				),
+				GameID: gameID5,
+			},
+			executeGetVariants: true,
+			variants:           []*domain.GameImageVariant{smallWebpVariant},
+		},
+		{
+			description:         "GetGameImageVariantsがエラーなのでエラー",
+			gameID:              gameID6,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					values.NewGameImageID(),
+					values.GameImageTypePng,
+					time.Now(),
+				),
+				GameID: gameID6,
+			},
+			executeGetVariants: true,
+			getVariantsErr:     errors.New("error"),
+			isErr:              true,
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameImage {
 				mockGameImageRepository.
 					EXPECT().
-					GetGameImage(ctx, testCase.gameImageID, repository.LockTypeNone).
+					GetGameImage(gomock.Any(), testCase.gameImageID, repository.LockTypeNone).
 					Return(testCase.image, testCase.getGameImageErr)
 			}
 
-			gameImage, err := gameImageService.GetGameImageMeta(ctx, testCase.gameID, testCase.gameImageID)
+			if testCase.executeGetVariants {
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImageVariants(gomock.Any(), testCase.gameImageID, repository.LockTypeNone).
+					Return(testCase.variants, testCase.getVariantsErr)
+			}
+
+			gameImage, variant, err := gameImageService.GetGameImageMeta(ctx, testCase.gameID, testCase.gameImageID, testCase.size, testCase.webp)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -705,6 +983,158 @@ func TestGetGameImageMeta(t *testing.T) {
 			assert.Equal(t, testCase.image.GetID(), gameImage.GetID())
 			assert.Equal(t, testCase.image.GetType(), gameImage.GetType())
 			assert.Equal(t, testCase.image.GetCreatedAt(), gameImage.GetCreatedAt())
+			assert.Equal(t, testCase.variant, variant)
+		})
+	}
+}
+
+func TestBackfillGameImageVariants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	imgBuf := bytes.NewBuffer(nil)
+	err := png.Encode(imgBuf, image.NewRGBA(image.Rect(0, 0, 400, 200)))
+	if err != nil {
+		t.Fatalf("failed to encode image: %v", err)
+	}
+	validImage := imgBuf.Bytes()
+
+	validVariants, err := generateGameImageVariants(validImage, values.GameImageTypePng, time.Now())
+	if err != nil {
+		t.Fatalf("failed to generate variants: %v", err)
+	}
+
+	type imageTest struct {
+		loadErr     error
+		data        []byte
+		executeSave bool
+		// 派生画像を生成できず、派生画像無しで保存する
+		executeSaveEmpty bool
+		saveVariantsErr  error
+	}
+
+	type test struct {
+		description                      string
+		getGameImagesWithPendingVariants error
+		images                           []imageTest
+		isErr                            bool
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			images: []imageTest{
+				{data: validImage, executeSave: true},
+			},
+		},
+		{
+			description: "画像がなくてもエラーなし",
+			images:      []imageTest{},
+		},
+		{
+			description:                      "GetGameImagesWithPendingVariantsがエラーなのでエラー",
+			getGameImagesWithPendingVariants: errors.New("error"),
+			isErr:                            true,
+		},
+		{
+			description: "LoadGameImageがエラーでも他の画像は処理される",
+			images: []imageTest{
+				{loadErr: errors.New("error")},
+				{data: validImage, executeSave: true},
+			},
+		},
+		{
+			description: "画像が不正でも他の画像は処理される",
+			images: []imageTest{
+				{data: []byte("invalid file"), executeSaveEmpty: true},
+				{data: validImage, executeSave: true},
+			},
+		},
+		{
+			description: "SaveGameImageVariantsがエラーでも他の画像は処理される",
+			images: []imageTest{
+				{data: validImage, executeSave: true, saveVariantsErr: errors.New("error")},
+				{data: validImage, executeSave: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+			mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+
+			gameImageService := NewGameImage(
+				mockDB,
+				mockGameRepository,
+				mockGameImageRepository,
+				mockGameImageStorage,
+				nil,
+			)
+
+			images := make([]*repository.GameImageInfo, 0, len(testCase.images))
+			saveCount := 0
+			for _, imageTest := range testCase.images {
+				gameImage := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+				images = append(images, &repository.GameImageInfo{
+					GameImage: gameImage,
+					GameID:    values.NewGameID(),
+				})
+
+				mockGameImageStorage.
+					EXPECT().
+					LoadGameImage(gomock.Any(), gomock.Any(), gameImage.GetID()).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameImageID) error {
+						if imageTest.loadErr != nil {
+							return imageTest.loadErr
+						}
+
+						_, err := writer.Write(imageTest.data)
+						return err
+					})
+
+				if imageTest.executeSave {
+					saveCount++
+					mockGameImageRepository.
+						EXPECT().
+						SaveGameImageVariants(gomock.Any(), gameImage.GetID(), gomock.Len(len(validVariants))).
+						Return(imageTest.saveVariantsErr)
+				}
+				if imageTest.executeSaveEmpty {
+					mockGameImageRepository.
+						EXPECT().
+						SaveGameImageVariants(gomock.Any(), gameImage.GetID(), gomock.Len(0)).
+						Return(imageTest.saveVariantsErr)
+				}
+			}
+
+			if saveCount > 0 {
+				mockGameImageStorage.
+					EXPECT().
+					SaveGameImageVariant(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(saveCount * len(validVariants))
+			}
+
+			mockGameImageRepository.
+				EXPECT().
+				GetGameImagesWithPendingVariants(gomock.Any()).
+				Return(images, testCase.getGameImagesWithPendingVariants)
+
+			err := gameImageService.BackfillGameImageVariants(ctx)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
 		})
 	}
 }
@@ -0,0 +1,178 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // image.Decodeでgifを扱うため
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"time"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"golang.org/x/image/draw"
+)
+
+// gameImageVariantWidths
+// サムネイルとして生成する画像のサイズと幅。
+// 元の画像の幅以上のサイズのサムネイルは生成しない。
+var gameImageVariantWidths = []struct {
+	size  values.GameImageSize
+	width int
+}{
+	{size: values.GameImageSizeSmall, width: 320},
+	{size: values.GameImageSizeMedium, width: 640},
+	{size: values.GameImageSizeLarge, width: 1280},
+}
+
+// maxGameImageVariantSourcePixels
+// 派生画像の生成時にデコードする画像の最大ピクセル数。
+// 巨大な画像のデコードでメモリを使い果たさないように制限する。
+const maxGameImageVariantSourcePixels = 50_000_000
+
+const gameImageVariantJpegQuality = 85
+
+var errGameImageTooLarge = errors.New("game image too large")
+
+type encodedGameImageVariant struct {
+	variant *domain.GameImageVariant
+	data    []byte
+}
+
+// generateGameImageVariants
+// 画像のバイナリからサムネイル・WebPの派生画像を生成する。
+// サムネイルは元の画像と同じ形式(gifの場合はアニメーションが失われるためpng)で生成する。
+// WebPはpure Goで扱える可逆圧縮のみのため、同じサイズの画像よりも小さくなった場合のみ派生画像とする。
+func generateGameImageVariants(data []byte, imageType values.GameImageType, now time.Time) ([]*encodedGameImageVariant, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("invalid image size: %dx%d", config.Width, config.Height)
+	}
+	if config.Width*config.Height > maxGameImageVariantSourcePixels {
+		return nil, errGameImageTooLarge
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	// jpegのYCbCrなどのままだと縮小が遅いため、先にRGBAに変換しておく
+	src := image.NewRGBA(decoded.Bounds())
+	draw.Draw(src, src.Bounds(), decoded, decoded.Bounds().Min, draw.Src)
+
+	thumbnailType := imageType
+	if thumbnailType == values.GameImageTypeGif {
+		thumbnailType = values.GameImageTypePng
+	}
+
+	variants := make([]*encodedGameImageVariant, 0, len(gameImageVariantWidths)*2+1)
+
+	// jpegは非可逆圧縮のため、可逆圧縮のWebPで小さくなることはほぼないので生成しない
+	if imageType != values.GameImageTypeJpeg {
+		webpVariant, err := encodeGameImageVariant(src, values.GameImageSizeOriginal, values.GameImageTypeWebp, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode original webp: %w", err)
+		}
+
+		if len(webpVariant.data) < len(data) {
+			variants = append(variants, webpVariant)
+		}
+	}
+
+	// 大きいサイズから順に、直前に縮小した画像をさらに縮小することで処理を軽くする
+	var scaleSrc image.Image = src
+	for _, variantWidth := range slices.Backward(gameImageVariantWidths) {
+		if variantWidth.width >= config.Width {
+			continue
+		}
+
+		height := max(config.Height*variantWidth.width/config.Width, 1)
+		dst := image.NewRGBA(image.Rect(0, 0, variantWidth.width, height))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), scaleSrc, scaleSrc.Bounds(), draw.Src, nil)
+		scaleSrc = dst
+
+		thumbnailVariant, err := encodeGameImageVariant(dst, variantWidth.size, thumbnailType, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+		}
+		variants = append(variants, thumbnailVariant)
+
+		webpVariant, err := encodeGameImageVariant(dst, variantWidth.size, values.GameImageTypeWebp, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode thumbnail webp: %w", err)
+		}
+
+		if len(webpVariant.data) < len(thumbnailVariant.data) {
+			variants = append(variants, webpVariant)
+		}
+	}
+
+	return variants, nil
+}
+
+func encodeGameImageVariant(img image.Image, size values.GameImageSize, imageType values.GameImageType, now time.Time) (*encodedGameImageVariant, error) {
+	buf := bytes.NewBuffer(nil)
+
+	var err error
+	switch imageType {
+	case values.GameImageTypeJpeg:
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: gameImageVariantJpegQuality})
+	case values.GameImageTypePng:
+		err = png.Encode(buf, img)
+	case values.GameImageTypeWebp:
+		err = nativewebp.Encode(buf, img, nil)
+	default:
+		return nil, fmt.Errorf("unsupported variant image type: %d", imageType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	bounds := img.Bounds()
+
+	return &encodedGameImageVariant{
+		variant: domain.NewGameImageVariant(
+			values.NewGameImageVariantID(),
+			size,
+			imageType,
+			bounds.Dx(),
+			bounds.Dy(),
+			now,
+		),
+		data: buf.Bytes(),
+	}, nil
+}
+
+// selectGameImageVariant
+// 要求されたサイズ・形式に対応する派生画像を選ぶ。
+// WebPが要求されたがWebPの派生画像が存在しない場合は、同じサイズのサムネイルを選ぶ。
+// 対応する派生画像が存在しない場合はnilを返し、元の画像を使う。
+func selectGameImageVariant(variants []*domain.GameImageVariant, size values.GameImageSize, webp bool) *domain.GameImageVariant {
+	if webp {
+		for _, variant := range variants {
+			if variant.GetSize() == size && variant.GetType() == values.GameImageTypeWebp {
+				return variant
+			}
+		}
+	}
+
+	if size == values.GameImageSizeOriginal {
+		return nil
+	}
+
+	for _, variant := range variants {
+		if variant.GetSize() == size && variant.GetType() != values.GameImageTypeWebp {
+			return variant
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	_ "golang.org/x/image/webp" // 生成されたWebPをデコードするため
+)
+
+func TestGenerateGameImageVariants(t *testing.T) {
+	t.Parallel()
+
+	newImage := func(width, height int) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		for x := range width {
+			for y := range height {
+				img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: uint8(x + y), A: 0xff})
+			}
+		}
+
+		return img
+	}
+
+	encode := func(t *testing.T, img image.Image, imageType values.GameImageType) []byte {
+		t.Helper()
+
+		buf := bytes.NewBuffer(nil)
+		var err error
+		switch imageType {
+		case values.GameImageTypeJpeg:
+			err = jpeg.Encode(buf, img, nil)
+		case values.GameImageTypePng:
+			err = png.Encode(buf, img)
+		case values.GameImageTypeGif:
+			err = gif.Encode(buf, img, nil)
+		default:
+			t.Fatalf("invalid image type: %v", imageType)
+		}
+		if err != nil {
+			t.Fatalf("failed to encode image: %v", err)
+		}
+
+		return buf.Bytes()
+	}
+
+	type thumbnail struct {
+		size   values.GameImageSize
+		width  int
+		height int
+	}
+
+	type test struct {
+		description   string
+		data          func(t *testing.T) []byte
+		imageType     values.GameImageType
+		thumbnailType values.GameImageType
+		thumbnails    []thumbnail
+		isErr         bool
+		err           error
+	}
+
+	testCases := []test{
+		{
+			description: "jpegなのでjpegのサムネイルが生成される",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(1000, 500), values.GameImageTypeJpeg)
+			},
+			imageType:     values.GameImageTypeJpeg,
+			thumbnailType: values.GameImageTypeJpeg,
+			thumbnails: []thumbnail{
+				{size: values.GameImageSizeMedium, width: 640, height: 320},
+				{size: values.GameImageSizeSmall, width: 320, height: 160},
+			},
+		},
+		{
+			description: "pngなのでpngのサムネイルが生成される",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(1000, 500), values.GameImageTypePng)
+			},
+			imageType:     values.GameImageTypePng,
+			thumbnailType: values.GameImageTypePng,
+			thumbnails: []thumbnail{
+				{size: values.GameImageSizeMedium, width: 640, height: 320},
+				{size: values.GameImageSizeSmall, width: 320, height: 160},
+			},
+		},
+		{
+			description: "gifなのでpngのサムネイルが生成される",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(1000, 500), values.GameImageTypeGif)
+			},
+			imageType:     values.GameImageTypeGif,
+			thumbnailType: values.GameImageTypePng,
+			thumbnails: []thumbnail{
+				{size: values.GameImageSizeMedium, width: 640, height: 320},
+				{size: values.GameImageSizeSmall, width: 320, height: 160},
+			},
+		},
+		{
+			description: "幅が大きいのでlargeも生成される",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(1600, 100), values.GameImageTypeJpeg)
+			},
+			imageType:     values.GameImageTypeJpeg,
+			thumbnailType: values.GameImageTypeJpeg,
+			thumbnails: []thumbnail{
+				{size: values.GameImageSizeLarge, width: 1280, height: 80},
+				{size: values.GameImageSizeMedium, width: 640, height: 40},
+				{size: values.GameImageSizeSmall, width: 320, height: 20},
+			},
+		},
+		{
+			description: "幅が小さいのでサムネイルは生成されない",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(320, 100), values.GameImageTypeJpeg)
+			},
+			imageType:     values.GameImageTypeJpeg,
+			thumbnailType: values.GameImageTypeJpeg,
+			thumbnails:    []thumbnail{},
+		},
+		{
+			description: "縦長でも高さは1px以上",
+			data: func(t *testing.T) []byte {
+				return encode(t, newImage(700, 1), values.GameImageTypePng)
+			},
+			imageType:     values.GameImageTypePng,
+			thumbnailType: values.GameImageTypePng,
+			thumbnails: []thumbnail{
+				{size: values.GameImageSizeMedium, width: 640, height: 1},
+				{size: values.GameImageSizeSmall, width: 320, height: 1},
+			},
+		},
+		{
+			description: "画像でないのでエラー",
+			data: func(*testing.T) []byte {
+				return []byte("invalid file")
+			},
+			imageType: values.GameImageTypeJpeg,
+			isErr:     true,
+		},
+		{
+			description: "画像が大きすぎるのでエラー",
+			data: func(t *testing.T) []byte {
+				// ヘッダーのみで判定されるので、画素数が多いだけの画像を使う
+				return encode(t, image.NewGray(image.Rect(0, 0, 10000, 5001)), values.GameImageTypePng)
+			},
+			imageType: values.GameImageTypePng,
+			isErr:     true,
+			err:       errGameImageTooLarge,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			data := testCase.data(t)
+			now := time.Now()
+
+			variants, err := generateGameImageVariants(data, testCase.imageType, now)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			actualThumbnails := make([]thumbnail, 0, len(testCase.thumbnails))
+			for _, variant := range variants {
+				assert.WithinDuration(t, now, variant.variant.GetCreatedAt(), 0)
+
+				config, format, err := image.DecodeConfig(bytes.NewReader(variant.data))
+				if err != nil {
+					t.Fatalf("failed to decode variant: %v", err)
+				}
+				assert.Equal(t, variant.variant.GetWidth(), config.Width)
+				assert.Equal(t, variant.variant.GetHeight(), config.Height)
+
+				if variant.variant.GetType() == values.GameImageTypeWebp {
+					assert.Equal(t, "webp", format)
+					continue
+				}
+
+				assert.Equal(t, testCase.thumbnailType, variant.variant.GetType())
+				actualThumbnails = append(actualThumbnails, thumbnail{
+					size:   variant.variant.GetSize(),
+					width:  variant.variant.GetWidth(),
+					height: variant.variant.GetHeight(),
+				})
+			}
+
+			assert.Equal(t, testCase.thumbnails, actualThumbnails)
+		})
+	}
+}
+
+func TestSelectGameImageVariant(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	originalWebp := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeOriginal, values.GameImageTypeWebp, 1000, 500, now)
+	smallPng := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypePng, 320, 160, now)
+	smallWebp := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypeWebp, 320, 160, now)
+	mediumPng := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeMedium, values.GameImageTypePng, 640, 320, now)
+
+	variants := []*domain.GameImageVariant{originalWebp, smallPng, smallWebp, mediumPng}
+
+	type test struct {
+		description string
+		variants    []*domain.GameImageVariant
+		size        values.GameImageSize
+		webp        bool
+		expect      *domain.GameImageVariant
+	}
+
+	testCases := []test{
+		{
+			description: "元のサイズなのでnil",
+			variants:    variants,
+			size:        values.GameImageSizeOriginal,
+		},
+		{
+			description: "元のサイズのWebP",
+			variants:    variants,
+			size:        values.GameImageSizeOriginal,
+			webp:        true,
+			expect:      originalWebp,
+		},
+		{
+			description: "サムネイル",
+			variants:    variants,
+			size:        values.GameImageSizeSmall,
+			expect:      smallPng,
+		},
+		{
+			description: "サムネイルのWebP",
+			variants:    variants,
+			size:        values.GameImageSizeSmall,
+			webp:        true,
+			expect:      smallWebp,
+		},
+		{
+			description: "WebPがないので同じサイズのサムネイル",
+			variants:    variants,
+			size:        values.GameImageSizeMedium,
+			webp:        true,
+			expect:      mediumPng,
+		},
+		{
+			description: "対応するサイズがないのでnil",
+			variants:    variants,
+			size:        values.GameImageSizeLarge,
+			webp:        true,
+		},
+		{
+			description: "派生画像がないのでnil",
+			variants:    []*domain.GameImageVariant{},
+			size:        values.GameImageSizeSmall,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual := selectGameImageVariant(testCase.variants, testCase.size, testCase.webp)
+
+			assert.Equal(t, testCase.expect, actual)
+		})
+	}
+}
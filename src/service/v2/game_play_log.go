@@ -12,12 +12,23 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
+const (
+	// longPlayLogThreshold
+	// これより長いプレイログは、ランチャーの終了し忘れなどによるものとして扱う。
+	longPlayLogThreshold = 3 * time.Hour
+	// maxPlayLogRecords 1度にアップロードできるプレイログの数の上限
+	maxPlayLogRecords = 1000
+	// playLogClockSkew ランチャーとサーバーの時計のずれとして許容する時間
+	playLogClockSkew = time.Minute
+)
+
 type GamePlayLog struct {
 	db                    repository.DB
 	gamePlayLogRepository repository.GamePlayLogV2
 	editionRepository     repository.Edition
 	gameRepository        repository.GameV2
 	gameVersionRepository repository.GameVersionV2
+	accessTokenRepository repository.AccessToken
 }
 
 func NewGamePlayLog(
@@ -26,6 +37,7 @@ func NewGamePlayLog(
 	editionRepository repository.Edition,
 	gameRepository repository.GameV2,
 	gameVersionRepository repository.GameVersionV2,
+	accessTokenRepository repository.AccessToken,
 ) *GamePlayLog {
 	return &GamePlayLog{
 		db:                    db,
@@ -33,10 +45,14 @@ func NewGamePlayLog(
 		editionRepository:     editionRepository,
 		gameRepository:        gameRepository,
 		gameVersionRepository: gameVersionRepository,
+		accessTokenRepository: accessTokenRepository,
 	}
 }
 
 func (g *GamePlayLog) CreatePlayLog(ctx context.Context, editionID values.EditionID, gameID values.GameID, gameVersionID values.GameVersionID, startTime time.Time) (*domain.GamePlayLog, error) {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.CreatePlayLog")
+	defer span.End()
+
 	_, err := g.editionRepository.GetEdition(ctx, editionID, repository.LockTypeNone)
 	if err != nil {
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -82,6 +98,9 @@ func (g *GamePlayLog) CreatePlayLog(ctx context.Context, editionID values.Editio
 }
 
 func (g *GamePlayLog) UpdatePlayLogEndTime(ctx context.Context, editionID values.EditionID, gameID values.GameID, playLogID values.GamePlayLogID, endTime time.Time) error {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.UpdatePlayLogEndTime")
+	defer span.End()
+
 	return g.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		playLog, err := g.gamePlayLogRepository.GetGamePlayLog(ctx, playLogID)
 		if err != nil {
@@ -108,7 +127,123 @@ func (g *GamePlayLog) UpdatePlayLogEndTime(ctx context.Context, editionID values
 	})
 }
 
+func (g *GamePlayLog) CreatePlayLogs(
+	ctx context.Context,
+	accessToken values.LauncherSessionAccessToken,
+	editionID values.EditionID,
+	records []*service.GamePlayLogRecord,
+) ([]*service.GamePlayLogRecordResult, error) {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.CreatePlayLogs")
+	defer span.End()
+
+	if len(records) > maxPlayLogRecords {
+		return nil, service.ErrTooManyPlayLogRecords
+	}
+
+	accessTokenInfo, err := g.accessTokenRepository.GetAccessTokenInfo(ctx, accessToken, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidAccessToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting access token info: %w", err)
+	}
+
+	if accessTokenInfo.Edition.GetID() != editionID {
+		return nil, service.ErrForbidden
+	}
+
+	gameVersions, err := g.editionRepository.GetEditionGameVersions(ctx, editionID, repository.LockTypeNone)
+	if err != nil {
+		return nil, fmt.Errorf("getting edition game versions: %w", err)
+	}
+
+	gameVersionGameMap := make(map[values.GameVersionID]values.GameID, len(gameVersions))
+	for _, gameVersion := range gameVersions {
+		gameVersionGameMap[gameVersion.GetID()] = gameVersion.GameID
+	}
+
+	// アクセストークンの発行前や、現在より後に行われたプレイは記録できないはずなので、不正な記録として扱う
+	issuedAt := accessTokenInfo.AccessToken.GetExpiresAt().Add(-expiresIn * time.Second)
+	now := time.Now()
+
+	results := make([]*service.GamePlayLogRecordResult, 0, len(records))
+	for _, record := range records {
+		result := &service.GamePlayLogRecordResult{
+			ID: record.ID,
+		}
+		results = append(results, result)
+
+		reason := checkGamePlayLogRecord(record, gameVersionGameMap, issuedAt, now)
+		if reason != "" {
+			result.Status = service.GamePlayLogRecordStatusInvalid
+			result.Reason = reason
+			continue
+		}
+
+		endTime := record.EndTime
+		playLog := domain.NewGamePlayLog(
+			record.ID,
+			editionID,
+			record.GameID,
+			record.GameVersionID,
+			record.StartTime,
+			&endTime,
+			now,
+			now,
+		)
+
+		// 再送された記録は、IDの重複で検出する
+		err := g.gamePlayLogRepository.CreateGamePlayLog(ctx, playLog)
+		if errors.Is(err, repository.ErrDuplicatedUniqueKey) {
+			result.Status = service.GamePlayLogRecordStatusDuplicated
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("creating game play log: %w", err)
+		}
+
+		result.Status = service.GamePlayLogRecordStatusCreated
+	}
+
+	return results, nil
+}
+
+// checkGamePlayLogRecord
+// 記録が不正な場合、その理由を返す。問題がなければ空文字列を返す。
+func checkGamePlayLogRecord(
+	record *service.GamePlayLogRecord,
+	gameVersionGameMap map[values.GameVersionID]values.GameID,
+	issuedAt time.Time,
+	now time.Time,
+) string {
+	gameID, ok := gameVersionGameMap[record.GameVersionID]
+	if !ok || gameID != record.GameID {
+		return "game version is not included in edition"
+	}
+
+	if record.EndTime.Before(record.StartTime) {
+		return "end time is before start time"
+	}
+
+	if record.StartTime.Before(issuedAt.Add(-playLogClockSkew)) {
+		return "start time is before access token was issued"
+	}
+
+	if record.EndTime.After(now.Add(playLogClockSkew)) {
+		return "end time is in the future"
+	}
+
+	if record.EndTime.Sub(record.StartTime) > longPlayLogThreshold {
+		return "play time is too long"
+	}
+
+	return ""
+}
+
 func (g *GamePlayLog) GetGamePlayStats(ctx context.Context, gameID values.GameID, gameVersionID *values.GameVersionID, start, end time.Time) (*domain.GamePlayStats, error) {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.GetGamePlayStats")
+	defer span.End()
+
 	if end.Before(start) {
 		return nil, service.ErrInvalidTimeRange
 	}
@@ -145,6 +280,9 @@ func (g *GamePlayLog) GetGamePlayStats(ctx context.Context, gameID values.GameID
 }
 
 func (g *GamePlayLog) GetEditionPlayStats(ctx context.Context, editionID values.EditionID, start, end time.Time) (*domain.EditionPlayStats, error) {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.GetEditionPlayStats")
+	defer span.End()
+
 	if end.Before(start) {
 		return nil, service.ErrInvalidTimeRange
 	}
@@ -171,6 +309,9 @@ func (g *GamePlayLog) GetEditionPlayStats(ctx context.Context, editionID values.
 }
 
 func (g *GamePlayLog) DeleteGamePlayLog(ctx context.Context, editionID values.EditionID, gameID values.GameID, playLogID values.GamePlayLogID) error {
+	ctx, span := tracer.Start(ctx, "GamePlayLog.DeleteGamePlayLog")
+	defer span.End()
+
 	err := g.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		playLog, err := g.gamePlayLogRepository.GetGamePlayLog(ctx, playLogID)
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -203,9 +344,10 @@ func (g *GamePlayLog) DeleteGamePlayLog(ctx context.Context, editionID values.Ed
 }
 
 func (g *GamePlayLog) DeleteLongLogs(ctx context.Context) error {
-	const threshold = 3 * time.Hour
+	ctx, span := tracer.Start(ctx, "GamePlayLog.DeleteLongLogs")
+	defer span.End()
 
-	err := g.gamePlayLogRepository.DeleteLongLogs(ctx, threshold)
+	err := g.gamePlayLogRepository.DeleteLongLogs(ctx, longPlayLogThreshold)
 	if err != nil {
 		return fmt.Errorf("delete long logs: %w", err)
 	}
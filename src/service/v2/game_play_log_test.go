@@ -200,6 +200,7 @@ func TestCreatePlayLog(t *testing.T) {
 			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
 
 			gamePlayLogService := NewGamePlayLog(
 				mockDB,
@@ -207,33 +208,34 @@ func TestCreatePlayLog(t *testing.T) {
 				mockEditionRepository,
 				mockGameRepository,
 				mockGameVersionRepository,
+				mockAccessTokenRepository,
 			)
 
 			if testCase.executeGetEdition {
 				mockEditionRepository.
 					EXPECT().
-					GetEdition(ctx, testCase.editionID, repository.LockTypeNone).
+					GetEdition(gomock.Any(), testCase.editionID, repository.LockTypeNone).
 					Return(testCase.getEditionResult, testCase.getEditionErr)
 			}
 
 			if testCase.executeGetGame {
 				mockGameRepository.
 					EXPECT().
-					GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+					GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 					Return(testCase.getGameResult, testCase.getGameErr)
 			}
 
 			if testCase.executeGetGameVersionByID {
 				mockGameVersionRepository.
 					EXPECT().
-					GetGameVersionByID(ctx, testCase.gameVersionID, repository.LockTypeNone).
+					GetGameVersionByID(gomock.Any(), testCase.gameVersionID, repository.LockTypeNone).
 					Return(testCase.getGameVersionByIDResult, testCase.getGameVersionByIDErr)
 			}
 
 			if testCase.executeCreateGamePlayLog {
 				mockGamePlayLogRepository.
 					EXPECT().
-					CreateGamePlayLog(ctx, gomock.Cond(func(playLog *domain.GamePlayLog) bool {
+					CreateGamePlayLog(gomock.Any(), gomock.Cond(func(playLog *domain.GamePlayLog) bool {
 						if playLog.GetEditionID() != testCase.editionID {
 							t.Errorf("EditionID: expected %v, got %v", testCase.editionID, playLog.GetEditionID())
 							return false
@@ -429,6 +431,7 @@ func TestUpdatePlayLogEndTime(t *testing.T) {
 			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
 
 			gamePlayLogService := NewGamePlayLog(
 				mockDB,
@@ -436,19 +439,20 @@ func TestUpdatePlayLogEndTime(t *testing.T) {
 				mockEditionRepository,
 				mockGameRepository,
 				mockGameVersionRepository,
+				mockAccessTokenRepository,
 			)
 
 			if testCase.executeGetGamePlayLog {
 				mockGamePlayLogRepository.
 					EXPECT().
-					GetGamePlayLog(ctx, testCase.playLogID).
+					GetGamePlayLog(gomock.Any(), testCase.playLogID).
 					Return(testCase.getGamePlayLogResult, testCase.getGamePlayLogErr)
 			}
 
 			if testCase.executeUpdateGamePlayLogEndTime {
 				mockGamePlayLogRepository.
 					EXPECT().
-					UpdateGamePlayLogEndTime(ctx, testCase.playLogID, testCase.endTime).
+					UpdateGamePlayLogEndTime(gomock.Any(), testCase.playLogID, testCase.endTime).
 					Return(testCase.updateGamePlayLogEndTimeErr)
 			}
 
@@ -473,6 +477,266 @@ func TestUpdatePlayLogEndTime(t *testing.T) {
 	}
 }
 
+func TestCreatePlayLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description            string
+		records                []*service.GamePlayLogRecord
+		getAccessTokenInfoErr  error
+		accessTokenEditionID   *values.EditionID
+		executeGetGameVersions bool
+		getGameVersionsErr     error
+		createPlayLogIDs       []values.GamePlayLogID
+		createPlayLogErrs      []error
+		expectedStatuses       []service.GamePlayLogRecordStatus
+		isErr                  bool
+		err                    error
+	}
+
+	now := time.Now()
+	accessToken, err := values.NewLauncherSessionAccessToken()
+	if err != nil {
+		t.Fatalf("failed to create access token: %v", err)
+	}
+	productKey, err := values.NewLauncherUserProductKey()
+	if err != nil {
+		t.Fatalf("failed to create product key: %v", err)
+	}
+	// 1時間前に発行されたアクセストークン
+	expiresAt := now.Add(-time.Hour).Add(expiresIn * time.Second)
+	editionID := values.NewEditionID()
+	gameID := values.NewGameID()
+	gameVersionID := values.NewGameVersionID()
+
+	newRecord := func(gameID values.GameID, gameVersionID values.GameVersionID, startTime, endTime time.Time) *service.GamePlayLogRecord {
+		return &service.GamePlayLogRecord{
+			ID:            values.NewGamePlayLogID(),
+			GameID:        gameID,
+			GameVersionID: gameVersionID,
+			StartTime:     startTime,
+			EndTime:       endTime,
+		}
+	}
+
+	validRecord1 := newRecord(gameID, gameVersionID, now.Add(-30*time.Minute), now.Add(-20*time.Minute))
+	validRecord2 := newRecord(gameID, gameVersionID, now.Add(-10*time.Minute), now.Add(-5*time.Minute))
+	tooManyRecords := make([]*service.GamePlayLogRecord, 0, maxPlayLogRecords+1)
+	for range maxPlayLogRecords + 1 {
+		tooManyRecords = append(tooManyRecords, validRecord1)
+	}
+	otherEditionID := values.NewEditionID()
+
+	testCases := []test{
+		{
+			description:            "正常にプレイログが作成される",
+			records:                []*service.GamePlayLogRecord{validRecord1, validRecord2},
+			executeGetGameVersions: true,
+			createPlayLogIDs:       []values.GamePlayLogID{validRecord1.ID, validRecord2.ID},
+			createPlayLogErrs:      []error{nil, nil},
+			expectedStatuses: []service.GamePlayLogRecordStatus{
+				service.GamePlayLogRecordStatusCreated,
+				service.GamePlayLogRecordStatusCreated,
+			},
+		},
+		{
+			description:            "記録が空でもエラー無し",
+			records:                []*service.GamePlayLogRecord{},
+			executeGetGameVersions: true,
+			expectedStatuses:       []service.GamePlayLogRecordStatus{},
+		},
+		{
+			description:            "再送された記録は重複として扱う",
+			records:                []*service.GamePlayLogRecord{validRecord1, validRecord2},
+			executeGetGameVersions: true,
+			createPlayLogIDs:       []values.GamePlayLogID{validRecord1.ID, validRecord2.ID},
+			createPlayLogErrs:      []error{repository.ErrDuplicatedUniqueKey, nil},
+			expectedStatuses: []service.GamePlayLogRecordStatus{
+				service.GamePlayLogRecordStatusDuplicated,
+				service.GamePlayLogRecordStatusCreated,
+			},
+		},
+		{
+			description: "不正な記録は作成しない",
+			records: []*service.GamePlayLogRecord{
+				// エディションに含まれないゲームバージョン
+				newRecord(gameID, values.NewGameVersionID(), now.Add(-30*time.Minute), now.Add(-20*time.Minute)),
+				// ゲームとゲームバージョンの組が正しくない
+				newRecord(values.NewGameID(), gameVersionID, now.Add(-30*time.Minute), now.Add(-20*time.Minute)),
+				// 終了時刻が開始時刻より前
+				newRecord(gameID, gameVersionID, now.Add(-20*time.Minute), now.Add(-30*time.Minute)),
+				// アクセストークンの発行前
+				newRecord(gameID, gameVersionID, now.Add(-2*time.Hour), now.Add(-20*time.Minute)),
+				// 終了時刻が未来
+				newRecord(gameID, gameVersionID, now.Add(-20*time.Minute), now.Add(time.Hour)),
+				validRecord1,
+			},
+			executeGetGameVersions: true,
+			createPlayLogIDs:       []values.GamePlayLogID{validRecord1.ID},
+			createPlayLogErrs:      []error{nil},
+			expectedStatuses: []service.GamePlayLogRecordStatus{
+				service.GamePlayLogRecordStatusInvalid,
+				service.GamePlayLogRecordStatusInvalid,
+				service.GamePlayLogRecordStatusInvalid,
+				service.GamePlayLogRecordStatusInvalid,
+				service.GamePlayLogRecordStatusInvalid,
+				service.GamePlayLogRecordStatusCreated,
+			},
+		},
+		{
+			description: "記録が多すぎるのでErrTooManyPlayLogRecords",
+			records:     tooManyRecords,
+			isErr:       true,
+			err:         service.ErrTooManyPlayLogRecords,
+		},
+		{
+			description:           "アクセストークンが存在しないのでErrInvalidAccessToken",
+			records:               []*service.GamePlayLogRecord{validRecord1},
+			getAccessTokenInfoErr: repository.ErrRecordNotFound,
+			isErr:                 true,
+			err:                   service.ErrInvalidAccessToken,
+		},
+		{
+			description:           "GetAccessTokenInfoがエラーなのでエラー",
+			records:               []*service.GamePlayLogRecord{validRecord1},
+			getAccessTokenInfoErr: assert.AnError,
+			isErr:                 true,
+			err:                   assert.AnError,
+		},
+		{
+			description:          "アクセストークンのエディションが異なるのでErrForbidden",
+			records:              []*service.GamePlayLogRecord{validRecord1},
+			accessTokenEditionID: &otherEditionID,
+			isErr:                true,
+			err:                  service.ErrForbidden,
+		},
+		{
+			description:            "GetEditionGameVersionsがエラーなのでエラー",
+			records:                []*service.GamePlayLogRecord{validRecord1},
+			executeGetGameVersions: true,
+			getGameVersionsErr:     assert.AnError,
+			isErr:                  true,
+			err:                    assert.AnError,
+		},
+		{
+			description:            "CreateGamePlayLogがエラーなのでエラー",
+			records:                []*service.GamePlayLogRecord{validRecord1},
+			executeGetGameVersions: true,
+			createPlayLogIDs:       []values.GamePlayLogID{validRecord1.ID},
+			createPlayLogErrs:      []error{assert.AnError},
+			isErr:                  true,
+			err:                    assert.AnError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGamePlayLogRepository := mockRepository.NewMockGamePlayLogV2(ctrl)
+			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
+
+			gamePlayLogService := NewGamePlayLog(
+				mockDB,
+				mockGamePlayLogRepository,
+				mockEditionRepository,
+				mockGameRepository,
+				mockGameVersionRepository,
+				mockAccessTokenRepository,
+			)
+
+			if len(testCase.records) <= maxPlayLogRecords {
+				accessTokenEditionID := editionID
+				if testCase.accessTokenEditionID != nil {
+					accessTokenEditionID = *testCase.accessTokenEditionID
+				}
+
+				mockAccessTokenRepository.
+					EXPECT().
+					GetAccessTokenInfo(gomock.Any(), accessToken, repository.LockTypeNone).
+					Return(&repository.AccessTokenInfo{
+						AccessToken: domain.NewLauncherSession(values.NewLauncherSessionID(), accessToken, expiresAt),
+						ProductKey:  domain.NewLauncherUser(values.NewLauncherUserID(), productKey),
+						Edition:     domain.NewEditionWithoutQuestionnaire(accessTokenEditionID, values.NewEditionName("test"), now),
+					}, testCase.getAccessTokenInfoErr)
+			}
+
+			if testCase.executeGetGameVersions {
+				mockEditionRepository.
+					EXPECT().
+					GetEditionGameVersions(gomock.Any(), editionID, repository.LockTypeNone).
+					Return([]*repository.GameVersionInfoWithGameID{
+						{
+							GameVersion: domain.NewGameVersion(
+								gameVersionID,
+								values.NewGameVersionName("v1.0.0"),
+								values.NewGameVersionDescription("description"),
+								now,
+							),
+							GameID: gameID,
+						},
+					}, testCase.getGameVersionsErr)
+			}
+
+			var createdPlayLogs []*domain.GamePlayLog
+			for i, playLogID := range testCase.createPlayLogIDs {
+				mockGamePlayLogRepository.
+					EXPECT().
+					CreateGamePlayLog(gomock.Any(), gomock.Cond(func(playLog *domain.GamePlayLog) bool {
+						return playLog.GetID() == playLogID
+					})).
+					DoAndReturn(func(_ context.Context, playLog *domain.GamePlayLog) error {
+						createdPlayLogs = append(createdPlayLogs, playLog)
+						return testCase.createPlayLogErrs[i]
+					})
+			}
+
+			results, err := gamePlayLogService.CreatePlayLogs(ctx, accessToken, editionID, testCase.records)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Len(t, results, len(testCase.records))
+			for i, result := range results {
+				assert.Equal(t, testCase.records[i].ID, result.ID)
+				assert.Equal(t, testCase.expectedStatuses[i], result.Status)
+				if result.Status == service.GamePlayLogRecordStatusInvalid {
+					assert.NotEmpty(t, result.Reason)
+				} else {
+					assert.Empty(t, result.Reason)
+				}
+			}
+
+			for _, playLog := range createdPlayLogs {
+				assert.Equal(t, editionID, playLog.GetEditionID())
+				assert.Equal(t, gameID, playLog.GetGameID())
+				assert.Equal(t, gameVersionID, playLog.GetGameVersionID())
+				if assert.NotNil(t, playLog.GetEndTime()) {
+					assert.False(t, playLog.GetEndTime().Before(playLog.GetStartTime()))
+				}
+			}
+		})
+	}
+}
+
 func TestGetGamePlayStats(t *testing.T) {
 	t.Parallel()
 
@@ -686,6 +950,7 @@ func TestGetGamePlayStats(t *testing.T) {
 			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
 
 			gamePlayLogService := NewGamePlayLog(
 				mockDB,
@@ -693,26 +958,27 @@ func TestGetGamePlayStats(t *testing.T) {
 				mockEditionRepository,
 				mockGameRepository,
 				mockGameVersionRepository,
+				mockAccessTokenRepository,
 			)
 
 			if testCase.executeGetGame {
 				mockGameRepository.
 					EXPECT().
-					GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+					GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 					Return(testCase.getGameResult, testCase.getGameErr)
 			}
 
 			if testCase.executeGetGameVersionByID {
 				mockGameVersionRepository.
 					EXPECT().
-					GetGameVersionByID(ctx, *testCase.gameVersionID, repository.LockTypeNone).
+					GetGameVersionByID(gomock.Any(), *testCase.gameVersionID, repository.LockTypeNone).
 					Return(testCase.getGameVersionByIDResult, testCase.getGameVersionByIDErr)
 			}
 
 			if testCase.executeGetGamePlayStats {
 				mockGamePlayLogRepository.
 					EXPECT().
-					GetGamePlayStats(ctx, testCase.gameID, testCase.gameVersionID, testCase.start, testCase.end).
+					GetGamePlayStats(gomock.Any(), testCase.gameID, testCase.gameVersionID, testCase.start, testCase.end).
 					Return(testCase.getGamePlayStatsResult, testCase.getGamePlayStatsErr)
 			}
 
@@ -918,6 +1184,7 @@ func TestGetEditionPlayStats(t *testing.T) {
 			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
 
 			gamePlayLogService := NewGamePlayLog(
 				mockDB,
@@ -925,19 +1192,20 @@ func TestGetEditionPlayStats(t *testing.T) {
 				mockEditionRepository,
 				mockGameRepository,
 				mockGameVersionRepository,
+				mockAccessTokenRepository,
 			)
 
 			if testCase.executeGetEdition {
 				mockEditionRepository.
 					EXPECT().
-					GetEdition(ctx, testCase.editionID, repository.LockTypeNone).
+					GetEdition(gomock.Any(), testCase.editionID, repository.LockTypeNone).
 					Return(testCase.getEditionResult, testCase.getEditionErr)
 			}
 
 			if testCase.executeGetEditionPlayStats {
 				mockGamePlayLogRepository.
 					EXPECT().
-					GetEditionPlayStats(ctx, testCase.editionID, testCase.start, testCase.end).
+					GetEditionPlayStats(gomock.Any(), testCase.editionID, testCase.start, testCase.end).
 					Return(testCase.getEditionPlayStatsResult, testCase.getEditionPlayStatsErr)
 			}
 
@@ -1054,6 +1322,7 @@ func TestDeleteGamePlayLog(t *testing.T) {
 			mockEditionRepository := mockRepository.NewMockEdition(ctrl)
 			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 			mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+			mockAccessTokenRepository := mockRepository.NewMockAccessToken(ctrl)
 
 			playLog := NewGamePlayLog(
 				mockDB,
@@ -1061,6 +1330,7 @@ func TestDeleteGamePlayLog(t *testing.T) {
 				mockEditionRepository,
 				mockGameRepository,
 				mockGameVersionRepository,
+				mockAccessTokenRepository,
 			)
 
 			mockGamePlayLogRepository.
@@ -1124,11 +1394,12 @@ func TestDeleteLongLogs(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 
 			mockGamePlayLogRepository.
 				EXPECT().
-				DeleteLongLogs(ctx, 3*time.Hour).
+				DeleteLongLogs(gomock.Any(), 3*time.Hour).
 				Return(testCase.deleteLongLogsErr)
 
 			err := gamePlayLogService.DeleteLongLogs(ctx)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
@@ -15,24 +17,37 @@ type GameRole struct {
 	db                           repository.DB
 	gameRepository               repository.GameV2
 	gameManagementRoleRepository repository.GameManagementRole
+	gameRoleInvitationRepository repository.GameRoleInvitation
 	user                         *User
+	auditLog                     *AuditLog
 }
 
 func NewGameRole(
 	db repository.DB,
 	gameRepository repository.GameV2,
 	gameManagementRoleRepository repository.GameManagementRole,
+	gameRoleInvitationRepository repository.GameRoleInvitation,
 	userUtils *User,
+	auditLog *AuditLog,
 ) *GameRole {
 	return &GameRole{
 		db:                           db,
 		gameRepository:               gameRepository,
 		gameManagementRoleRepository: gameManagementRoleRepository,
+		gameRoleInvitationRepository: gameRoleInvitationRepository,
 		user:                         userUtils,
+		auditLog:                     auditLog,
 	}
 }
 
+// gameRoleInvitationExpiration
+// ゲームの管理権限への招待の有効期限。
+const gameRoleInvitationExpiration = 7 * 24 * time.Hour
+
 func (gameRole *GameRole) EditGameManagementRole(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID, newRole values.GameManagementRole) error {
+	ctx, span := tracer.Start(ctx, "GameRole.EditGameManagementRole")
+	defer span.End()
+
 	err := gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		_, err := gameRole.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -68,6 +83,7 @@ func (gameRole *GameRole) EditGameManagementRole(ctx context.Context, session *d
 			}
 		}
 
+		var before any
 		if role, ok := gameManagersMap[userID]; ok {
 			if role != newRole { //既にあるroleと違うので、Update
 				if role == values.GameManagementRoleAdministrator && ownersNumber == 1 { //ownersが一人の場合にそのownerをmaintainerに変えるのを止める。
@@ -80,15 +96,28 @@ func (gameRole *GameRole) EditGameManagementRole(ctx context.Context, session *d
 				if err != nil {
 					return fmt.Errorf("error: failed to update game management role: %w", err)
 				}
+				before = newAuditLogGameManagementRoleState(userID, role)
 			} else { //既にあるroleと同じなので、エラー
 				return service.ErrNoGameManagementRoleUpdated
 			}
-		} else { //roleを持っていなかったので、追加する。
-			err = gameRole.gameManagementRoleRepository.AddGameManagementRoles(ctx, gameID, []values.TraPMemberID{userID}, newRole)
-			if err != nil {
-				return fmt.Errorf("error: failed to add game management role: %w", err)
-			}
+		} else { //roleを持っていないユーザーは、本人の承諾無しに追加しないよう招待を使う
+			return service.ErrInvalidRole
+		}
+
+		myInfo, err := gameRole.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
 		}
+
+		err = gameRole.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionEditGameManagementRole, values.AuditLogTargetTypeGame, uuid.UUID(gameID),
+			before, newAuditLogGameManagementRoleState(userID, newRole),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -97,7 +126,10 @@ func (gameRole *GameRole) EditGameManagementRole(ctx context.Context, session *d
 	return nil
 }
 
-func (gameRole *GameRole) RemoveGameManagementRole(ctx context.Context, gameID values.GameID, userID values.TraPMemberID) error {
+func (gameRole *GameRole) RemoveGameManagementRole(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.RemoveGameManagementRole")
+	defer span.End()
+
 	err := gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		_, err := gameRole.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
 		if errors.Is(err, repository.ErrRecordNotFound) {
@@ -135,6 +167,20 @@ func (gameRole *GameRole) RemoveGameManagementRole(ctx context.Context, gameID v
 			return fmt.Errorf("failed to remove game management role: %w", err)
 		}
 
+		myInfo, err := gameRole.user.getMe(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get me: %w", err)
+		}
+
+		err = gameRole.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionRemoveGameManagementRole, values.AuditLogTargetTypeGame, uuid.UUID(gameID),
+			newAuditLogGameManagementRoleState(userID, managersMap[userID]), nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -145,6 +191,9 @@ func (gameRole *GameRole) RemoveGameManagementRole(ctx context.Context, gameID v
 }
 
 func (gameRole *GameRole) UpdateGameAuth(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.UpdateGameAuth")
+	defer span.End()
+
 	myInfo, err := gameRole.user.getMe(ctx, session)
 	if err != nil {
 		return fmt.Errorf("failed to get me: %w", err)
@@ -174,6 +223,9 @@ func (gameRole *GameRole) UpdateGameAuth(ctx context.Context, session *domain.OI
 }
 
 func (gameRole *GameRole) UpdateGameManagementRoleAuth(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.UpdateGameManagementRoleAuth")
+	defer span.End()
+
 	myInfo, err := gameRole.user.getMe(ctx, session)
 	if err != nil {
 		return fmt.Errorf("failed to get me: %w", err)
@@ -201,3 +253,369 @@ func (gameRole *GameRole) UpdateGameManagementRoleAuth(ctx context.Context, sess
 
 	return nil
 }
+
+func (gameRole *GameRole) InviteGameManagementRole(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID, role values.GameManagementRole) (*domain.GameRoleInvitation, error) {
+	ctx, span := tracer.Start(ctx, "GameRole.InviteGameManagementRole")
+	defer span.End()
+
+	myInfo, err := gameRole.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get me: %w", err)
+	}
+
+	var invitation *domain.GameRoleInvitation
+	err = gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameRole.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGame
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		activeUsers, err := gameRole.user.getActiveUsers(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get active users: %w", err)
+		}
+		isActiveUser := false
+		for _, activeUser := range activeUsers {
+			if activeUser.GetID() == userID {
+				isActiveUser = true
+				break
+			}
+		}
+		if !isActiveUser {
+			return service.ErrInvalidUserID
+		}
+
+		currentRole, err := gameRole.gameManagementRoleRepository.GetGameManagementRole(ctx, gameID, userID, repository.LockTypeNone)
+		if err != nil && !errors.Is(err, repository.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get game management role: %w", err)
+		}
+		if err == nil && currentRole == role {
+			return service.ErrNoGameManagementRoleUpdated
+		}
+
+		pendingInvitations, err := gameRole.gameRoleInvitationRepository.GetPendingGameRoleInvitationsByGameID(ctx, gameID, repository.LockTypeRecord)
+		if err != nil {
+			return fmt.Errorf("failed to get pending game role invitations: %w", err)
+		}
+		for _, pendingInvitation := range pendingInvitations {
+			if pendingInvitation.GetInviteeID() == userID && !pendingInvitation.IsExpired() {
+				return service.ErrDuplicateGameRoleInvitation
+			}
+		}
+
+		now := time.Now()
+		invitation = domain.NewGameRoleInvitation(
+			values.NewGameRoleInvitationID(),
+			myInfo.GetID(),
+			userID,
+			role,
+			values.GameRoleInvitationStatusPending,
+			now,
+			now.Add(gameRoleInvitationExpiration),
+		)
+
+		err = gameRole.gameRoleInvitationRepository.SaveGameRoleInvitation(ctx, gameID, invitation)
+		if err != nil {
+			return fmt.Errorf("failed to save game role invitation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return invitation, nil
+}
+
+func (gameRole *GameRole) GetMyGameRoleInvitations(ctx context.Context, session *domain.OIDCSession) ([]*service.GameRoleInvitationInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameRole.GetMyGameRoleInvitations")
+	defer span.End()
+
+	myInfo, err := gameRole.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get me: %w", err)
+	}
+
+	invitations, err := gameRole.gameRoleInvitationRepository.GetPendingGameRoleInvitationsByInviteeID(ctx, myInfo.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending game role invitations: %w", err)
+	}
+
+	validInvitations := make([]*repository.GameRoleInvitationInfo, 0, len(invitations))
+	gameIDs := make([]values.GameID, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.IsExpired() {
+			continue
+		}
+
+		validInvitations = append(validInvitations, invitation)
+		gameIDs = append(gameIDs, invitation.GameID)
+	}
+
+	if len(validInvitations) == 0 {
+		return []*service.GameRoleInvitationInfo{}, nil
+	}
+
+	games, err := gameRole.gameRepository.GetGamesByIDs(ctx, gameIDs, repository.LockTypeNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	gameMap := make(map[values.GameID]*domain.Game, len(games))
+	for _, game := range games {
+		gameMap[game.GetID()] = game
+	}
+
+	invitationInfos := make([]*service.GameRoleInvitationInfo, 0, len(validInvitations))
+	for _, invitation := range validInvitations {
+		game, ok := gameMap[invitation.GameID]
+		if !ok {
+			// 招待の取得後にゲームが削除された場合
+			continue
+		}
+
+		invitationInfos = append(invitationInfos, &service.GameRoleInvitationInfo{
+			Invitation: invitation.GameRoleInvitation,
+			Game:       game,
+		})
+	}
+
+	return invitationInfos, nil
+}
+
+func (gameRole *GameRole) AcceptGameRoleInvitation(ctx context.Context, session *domain.OIDCSession, invitationID values.GameRoleInvitationID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.AcceptGameRoleInvitation")
+	defer span.End()
+
+	myInfo, err := gameRole.user.getMe(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to get me: %w", err)
+	}
+
+	err = gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		invitation, err := gameRole.getMyPendingGameRoleInvitation(ctx, myInfo.GetID(), invitationID)
+		if err != nil {
+			return err
+		}
+		if invitation.IsExpired() {
+			return service.ErrGameRoleInvitationExpired
+		}
+
+		_, err = gameRole.gameRepository.GetGame(ctx, invitation.GameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGame
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		managers, err := gameRole.gameManagementRoleRepository.GetGameManagersByGameID(ctx, invitation.GameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game managers by gameID: %w", err)
+		}
+		managersMap := make(map[values.TraPMemberID]values.GameManagementRole, len(managers))
+		ownersNumber := 0
+		for _, manager := range managers {
+			managersMap[manager.UserID] = manager.Role
+			if manager.Role == values.GameManagementRoleAdministrator {
+				ownersNumber++
+			}
+		}
+
+		newRole := invitation.GetRole()
+		var before any
+		currentRole, ok := managersMap[myInfo.GetID()]
+		switch {
+		case ok && currentRole == newRole:
+			// 既に同じroleを持っているので、招待を承諾済みにするだけ
+		case ok:
+			if currentRole == values.GameManagementRoleAdministrator && ownersNumber == 1 {
+				return service.ErrCannotEditOwners
+			}
+			err = gameRole.gameManagementRoleRepository.UpdateGameManagementRole(ctx, invitation.GameID, myInfo.GetID(), newRole)
+			if err != nil {
+				return fmt.Errorf("failed to update game management role: %w", err)
+			}
+			before = newAuditLogGameManagementRoleState(myInfo.GetID(), currentRole)
+		default:
+			err = gameRole.gameManagementRoleRepository.AddGameManagementRoles(ctx, invitation.GameID, []values.TraPMemberID{myInfo.GetID()}, newRole)
+			if err != nil {
+				return fmt.Errorf("failed to add game management role: %w", err)
+			}
+		}
+
+		err = gameRole.gameRoleInvitationRepository.UpdateGameRoleInvitationStatus(ctx, invitationID, values.GameRoleInvitationStatusAccepted)
+		if err != nil {
+			return fmt.Errorf("failed to update game role invitation status: %w", err)
+		}
+
+		if ok && currentRole == newRole {
+			return nil
+		}
+
+		err = gameRole.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionEditGameManagementRole, values.AuditLogTargetTypeGame, uuid.UUID(invitation.GameID),
+			before, newAuditLogGameManagementRoleState(myInfo.GetID(), newRole),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (gameRole *GameRole) DeclineGameRoleInvitation(ctx context.Context, session *domain.OIDCSession, invitationID values.GameRoleInvitationID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.DeclineGameRoleInvitation")
+	defer span.End()
+
+	myInfo, err := gameRole.user.getMe(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to get me: %w", err)
+	}
+
+	err = gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameRole.getMyPendingGameRoleInvitation(ctx, myInfo.GetID(), invitationID)
+		if err != nil {
+			return err
+		}
+
+		err = gameRole.gameRoleInvitationRepository.UpdateGameRoleInvitationStatus(ctx, invitationID, values.GameRoleInvitationStatusDeclined)
+		if err != nil {
+			return fmt.Errorf("failed to update game role invitation status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return nil
+}
+
+// getMyPendingGameRoleInvitation
+// ログイン中のユーザーへの応答待ちの招待をロックをかけて取得する。
+// トランザクション内で呼ぶ必要がある。
+func (gameRole *GameRole) getMyPendingGameRoleInvitation(ctx context.Context, myID values.TraPMemberID, invitationID values.GameRoleInvitationID) (*repository.GameRoleInvitationInfo, error) {
+	invitation, err := gameRole.gameRoleInvitationRepository.GetGameRoleInvitation(ctx, invitationID, repository.LockTypeRecord)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameRoleInvitationID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game role invitation: %w", err)
+	}
+
+	// 他のユーザーへの招待の存在を知られないように、存在しない場合と同じエラーを返す
+	if invitation.GetInviteeID() != myID {
+		return nil, service.ErrInvalidGameRoleInvitationID
+	}
+
+	if invitation.GetStatus() != values.GameRoleInvitationStatusPending {
+		return nil, service.ErrGameRoleInvitationAlreadyAnswered
+	}
+
+	return invitation, nil
+}
+
+func (gameRole *GameRole) TransferGameOwnership(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID) error {
+	ctx, span := tracer.Start(ctx, "GameRole.TransferGameOwnership")
+	defer span.End()
+
+	myInfo, err := gameRole.user.getMe(ctx, session)
+	if err != nil {
+		return fmt.Errorf("failed to get me: %w", err)
+	}
+
+	err = gameRole.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameRole.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrNoGame
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		activeUsers, err := gameRole.user.getActiveUsers(ctx, session)
+		if err != nil {
+			return fmt.Errorf("failed to get active users: %w", err)
+		}
+		isActiveUser := false
+		for _, activeUser := range activeUsers {
+			if activeUser.GetID() == userID {
+				isActiveUser = true
+				break
+			}
+		}
+		if !isActiveUser {
+			return service.ErrInvalidUserID
+		}
+
+		managers, err := gameRole.gameManagementRoleRepository.GetGameManagersByGameID(ctx, gameID)
+		if err != nil {
+			return fmt.Errorf("failed to get game managers by gameID: %w", err)
+		}
+		managersMap := make(map[values.TraPMemberID]values.GameManagementRole, len(managers))
+		for _, manager := range managers {
+			managersMap[manager.UserID] = manager.Role
+		}
+
+		if myRole, ok := managersMap[myInfo.GetID()]; !ok || myRole != values.GameManagementRoleAdministrator {
+			return service.ErrForbidden
+		}
+
+		before := newAuditLogGameManagementRolesState(
+			newAuditLogGameManagementRoleState(myInfo.GetID(), values.GameManagementRoleAdministrator),
+		)
+		currentRole, ok := managersMap[userID]
+		switch {
+		case ok && currentRole == values.GameManagementRoleAdministrator:
+			// 自分自身への譲渡もここに含まれる
+			return service.ErrNoGameManagementRoleUpdated
+		case !ok:
+			// 本人の承諾無しにownerにしないよう、譲渡先は既に管理者である必要がある
+			return service.ErrInvalidRole
+		}
+
+		err = gameRole.gameManagementRoleRepository.UpdateGameManagementRole(ctx, gameID, userID, values.GameManagementRoleAdministrator)
+		if err != nil {
+			return fmt.Errorf("failed to update game management role: %w", err)
+		}
+		before.Roles = append(before.Roles, newAuditLogGameManagementRoleState(userID, currentRole))
+
+		err = gameRole.gameManagementRoleRepository.UpdateGameManagementRole(ctx, gameID, myInfo.GetID(), values.GameManagementRoleCollaborator)
+		if err != nil {
+			return fmt.Errorf("failed to update game management role: %w", err)
+		}
+
+		err = gameRole.auditLog.record(
+			ctx, myInfo,
+			values.AuditLogActionTransferGameOwnership, values.AuditLogTargetTypeGame, uuid.UUID(gameID),
+			before,
+			newAuditLogGameManagementRolesState(
+				newAuditLogGameManagementRoleState(myInfo.GetID(), values.GameManagementRoleCollaborator),
+				newAuditLogGameManagementRoleState(userID, values.GameManagementRoleAdministrator),
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return nil
+}
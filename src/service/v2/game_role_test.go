@@ -0,0 +1,648 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	mockAuth "github.com/traPtitech/trap-collection-server/src/auth/mock"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEditGameManagementRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+
+	myID := values.NewTrapMemberID(uuid.New())
+	myInfo := service.NewUserInfo(myID, "me", values.TrapMemberStatusActive, false)
+	userID := values.NewTrapMemberID(uuid.New())
+	userInfo := service.NewUserInfo(userID, "user", values.TrapMemberStatusActive, false)
+	inactiveUserID := values.NewTrapMemberID(uuid.New())
+	gameID := values.NewGameID()
+	game := domain.NewGame(gameID, "game", "description", values.GameVisibilityTypeLimited, time.Now())
+
+	type test struct {
+		description                     string
+		userID                          values.TraPMemberID
+		role                            values.GameManagementRole
+		GetGameErr                      error
+		executeGetGameManagers          bool
+		managers                        []*repository.UserIDAndManagementRole
+		executeUpdateGameManagementRole bool
+		executeCreateAuditLog           bool
+		isErr                           bool
+		err                             error
+	}
+
+	testCases := []test{
+		{
+			description:            "maintainerをownerに変更するのでエラー無し",
+			userID:                 userID,
+			role:                   values.GameManagementRoleAdministrator,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+				{UserID: userID, Role: values.GameManagementRoleCollaborator},
+			},
+			executeUpdateGameManagementRole: true,
+			executeCreateAuditLog:           true,
+		},
+		{
+			description:            "roleを持っていないユーザーなのでErrInvalidRole",
+			userID:                 userID,
+			role:                   values.GameManagementRoleCollaborator,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+			},
+			isErr: true,
+			err:   service.ErrInvalidRole,
+		},
+		{
+			description:            "既に同じroleなのでErrNoGameManagementRoleUpdated",
+			userID:                 userID,
+			role:                   values.GameManagementRoleCollaborator,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+				{UserID: userID, Role: values.GameManagementRoleCollaborator},
+			},
+			isErr: true,
+			err:   service.ErrNoGameManagementRoleUpdated,
+		},
+		{
+			description:            "唯一のownerをmaintainerにするのでErrCannotEditOwners",
+			userID:                 myID,
+			role:                   values.GameManagementRoleCollaborator,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+			},
+			isErr: true,
+			err:   service.ErrCannotEditOwners,
+		},
+		{
+			description: "ゲームが存在しないのでErrNoGame",
+			userID:      userID,
+			role:        values.GameManagementRoleCollaborator,
+			GetGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrNoGame,
+		},
+		{
+			description: "アクティブでないユーザーなのでErrInvalidUserID",
+			userID:      inactiveUserID,
+			role:        values.GameManagementRoleCollaborator,
+			isErr:       true,
+			err:         service.ErrInvalidUserID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameManagementRoleRepository := mockRepository.NewMockGameManagementRole(ctrl)
+			mockGameRoleInvitationRepository := mockRepository.NewMockGameRoleInvitation(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameRoleService := NewGameRole(
+				mockDB,
+				mockGameRepository,
+				mockGameManagementRoleRepository,
+				mockGameRoleInvitationRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil).
+				AnyTimes()
+			mockUserCache.
+				EXPECT().
+				GetActiveUsers(gomock.Any()).
+				Return([]*service.UserInfo{myInfo, userInfo}, nil).
+				AnyTimes()
+
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+				Return(game, testCase.GetGameErr)
+
+			if testCase.executeGetGameManagers {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					GetGameManagersByGameID(gomock.Any(), gameID).
+					Return(testCase.managers, nil)
+			}
+
+			if testCase.executeUpdateGameManagementRole {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					UpdateGameManagementRole(gomock.Any(), gameID, testCase.userID, testCase.role).
+					Return(nil)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
+
+			err := gameRoleService.EditGameManagementRole(ctx, authSession, gameID, testCase.userID, testCase.role)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAcceptGameRoleInvitation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+
+	myID := values.NewTrapMemberID(uuid.New())
+	myInfo := service.NewUserInfo(myID, "me", values.TrapMemberStatusActive, false)
+	ownerID := values.NewTrapMemberID(uuid.New())
+	gameID := values.NewGameID()
+	game := domain.NewGame(gameID, "game", "description", values.GameVisibilityTypeLimited, time.Now())
+
+	newInvitation := func(inviteeID values.TraPMemberID, role values.GameManagementRole, status values.GameRoleInvitationStatus, expiresAt time.Time) *repository.GameRoleInvitationInfo {
+		return &repository.GameRoleInvitationInfo{
+			GameRoleInvitation: domain.NewGameRoleInvitation(
+				values.NewGameRoleInvitationID(),
+				ownerID,
+				inviteeID,
+				role,
+				status,
+				time.Now(),
+				expiresAt,
+			),
+			GameID: gameID,
+		}
+	}
+
+	type test struct {
+		description                     string
+		invitation                      *repository.GameRoleInvitationInfo
+		GetGameRoleInvitationErr        error
+		executeGetGame                  bool
+		GetGameErr                      error
+		executeGetGameManagers          bool
+		managers                        []*repository.UserIDAndManagementRole
+		executeAddGameManagementRoles   bool
+		executeUpdateGameManagementRole bool
+		executeUpdateStatus             bool
+		UpdateStatusErr                 error
+		executeCreateAuditLog           bool
+		CreateAuditLogErr               error
+		isErr                           bool
+		err                             error
+	}
+
+	testCases := []test{
+		{
+			description:                   "特に問題ないのでエラー無し",
+			invitation:                    newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:                true,
+			executeGetGameManagers:        true,
+			managers:                      []*repository.UserIDAndManagementRole{{UserID: ownerID, Role: values.GameManagementRoleAdministrator}},
+			executeAddGameManagementRoles: true,
+			executeUpdateStatus:           true,
+			executeCreateAuditLog:         true,
+		},
+		{
+			description:            "既にmaintainerの場合ownerに変更される",
+			invitation:             newInvitation(myID, values.GameManagementRoleAdministrator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:         true,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: ownerID, Role: values.GameManagementRoleAdministrator},
+				{UserID: myID, Role: values.GameManagementRoleCollaborator},
+			},
+			executeUpdateGameManagementRole: true,
+			executeUpdateStatus:             true,
+			executeCreateAuditLog:           true,
+		},
+		{
+			description:            "既に同じroleを持っている場合は承諾済みにするだけ",
+			invitation:             newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:         true,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: ownerID, Role: values.GameManagementRoleAdministrator},
+				{UserID: myID, Role: values.GameManagementRoleCollaborator},
+			},
+			executeUpdateStatus: true,
+		},
+		{
+			description:            "唯一のownerがmaintainerになってしまうのでエラー",
+			invitation:             newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:         true,
+			executeGetGameManagers: true,
+			managers:               []*repository.UserIDAndManagementRole{{UserID: myID, Role: values.GameManagementRoleAdministrator}},
+			isErr:                  true,
+			err:                    service.ErrCannotEditOwners,
+		},
+		{
+			description:              "招待が存在しないのでErrInvalidGameRoleInvitationID",
+			GetGameRoleInvitationErr: repository.ErrRecordNotFound,
+			isErr:                    true,
+			err:                      service.ErrInvalidGameRoleInvitationID,
+		},
+		{
+			description:              "GetGameRoleInvitationがエラーなのでエラー",
+			GetGameRoleInvitationErr: errors.New("error"),
+			isErr:                    true,
+		},
+		{
+			description: "他のユーザーへの招待なのでErrInvalidGameRoleInvitationID",
+			invitation:  newInvitation(ownerID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			isErr:       true,
+			err:         service.ErrInvalidGameRoleInvitationID,
+		},
+		{
+			description: "承諾済みなのでErrGameRoleInvitationAlreadyAnswered",
+			invitation:  newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusAccepted, time.Now().Add(time.Hour)),
+			isErr:       true,
+			err:         service.ErrGameRoleInvitationAlreadyAnswered,
+		},
+		{
+			description: "辞退済みなのでErrGameRoleInvitationAlreadyAnswered",
+			invitation:  newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusDeclined, time.Now().Add(time.Hour)),
+			isErr:       true,
+			err:         service.ErrGameRoleInvitationAlreadyAnswered,
+		},
+		{
+			description: "期限切れなのでErrGameRoleInvitationExpired",
+			invitation:  newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(-time.Hour)),
+			isErr:       true,
+			err:         service.ErrGameRoleInvitationExpired,
+		},
+		{
+			description:    "ゲームが存在しないのでErrNoGame",
+			invitation:     newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame: true,
+			GetGameErr:     repository.ErrRecordNotFound,
+			isErr:          true,
+			err:            service.ErrNoGame,
+		},
+		{
+			description:                   "UpdateGameRoleInvitationStatusがエラーなのでエラー",
+			invitation:                    newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:                true,
+			executeGetGameManagers:        true,
+			managers:                      []*repository.UserIDAndManagementRole{{UserID: ownerID, Role: values.GameManagementRoleAdministrator}},
+			executeAddGameManagementRoles: true,
+			executeUpdateStatus:           true,
+			UpdateStatusErr:               errors.New("error"),
+			isErr:                         true,
+		},
+		{
+			description:                   "CreateAuditLogがエラーなのでエラー",
+			invitation:                    newInvitation(myID, values.GameManagementRoleCollaborator, values.GameRoleInvitationStatusPending, time.Now().Add(time.Hour)),
+			executeGetGame:                true,
+			executeGetGameManagers:        true,
+			managers:                      []*repository.UserIDAndManagementRole{{UserID: ownerID, Role: values.GameManagementRoleAdministrator}},
+			executeAddGameManagementRoles: true,
+			executeUpdateStatus:           true,
+			executeCreateAuditLog:         true,
+			CreateAuditLogErr:             errors.New("error"),
+			isErr:                         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameManagementRoleRepository := mockRepository.NewMockGameManagementRole(ctrl)
+			mockGameRoleInvitationRepository := mockRepository.NewMockGameRoleInvitation(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameRoleService := NewGameRole(
+				mockDB,
+				mockGameRepository,
+				mockGameManagementRoleRepository,
+				mockGameRoleInvitationRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+
+			var invitationID values.GameRoleInvitationID
+			if testCase.invitation != nil {
+				invitationID = testCase.invitation.GetID()
+			} else {
+				invitationID = values.NewGameRoleInvitationID()
+			}
+
+			mockGameRoleInvitationRepository.
+				EXPECT().
+				GetGameRoleInvitation(gomock.Any(), invitationID, repository.LockTypeRecord).
+				Return(testCase.invitation, testCase.GetGameRoleInvitationErr)
+
+			if testCase.executeGetGame {
+				mockGameRepository.
+					EXPECT().
+					GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+					Return(game, testCase.GetGameErr)
+			}
+
+			if testCase.executeGetGameManagers {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					GetGameManagersByGameID(gomock.Any(), gameID).
+					Return(testCase.managers, nil)
+			}
+
+			if testCase.executeAddGameManagementRoles {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					AddGameManagementRoles(gomock.Any(), gameID, []values.TraPMemberID{myID}, testCase.invitation.GetRole()).
+					Return(nil)
+			}
+
+			if testCase.executeUpdateGameManagementRole {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					UpdateGameManagementRole(gomock.Any(), gameID, myID, testCase.invitation.GetRole()).
+					Return(nil)
+			}
+
+			if testCase.executeUpdateStatus {
+				mockGameRoleInvitationRepository.
+					EXPECT().
+					UpdateGameRoleInvitationStatus(gomock.Any(), invitationID, values.GameRoleInvitationStatusAccepted).
+					Return(testCase.UpdateStatusErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			err := gameRoleService.AcceptGameRoleInvitation(ctx, authSession, invitationID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTransferGameOwnership(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+
+	myID := values.NewTrapMemberID(uuid.New())
+	myInfo := service.NewUserInfo(myID, "me", values.TrapMemberStatusActive, false)
+	userID := values.NewTrapMemberID(uuid.New())
+	userInfo := service.NewUserInfo(userID, "user", values.TrapMemberStatusActive, false)
+	inactiveUserID := values.NewTrapMemberID(uuid.New())
+	gameID := values.NewGameID()
+	game := domain.NewGame(gameID, "game", "description", values.GameVisibilityTypeLimited, time.Now())
+
+	type test struct {
+		description            string
+		userID                 values.TraPMemberID
+		GetGameErr             error
+		executeGetGameManagers bool
+		managers               []*repository.UserIDAndManagementRole
+		executeUpdateNewOwner  bool
+		executeUpdateOldOwner  bool
+		UpdateOldOwnerErr      error
+		executeCreateAuditLog  bool
+		isErr                  bool
+		err                    error
+	}
+
+	testCases := []test{
+		{
+			description:            "maintainerへの譲渡なのでエラー無し",
+			userID:                 userID,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+				{UserID: userID, Role: values.GameManagementRoleCollaborator},
+			},
+			executeUpdateNewOwner: true,
+			executeUpdateOldOwner: true,
+			executeCreateAuditLog: true,
+		},
+		{
+			description:            "roleを持っていないユーザーへの譲渡なのでErrInvalidRole",
+			userID:                 userID,
+			executeGetGameManagers: true,
+			managers:               []*repository.UserIDAndManagementRole{{UserID: myID, Role: values.GameManagementRoleAdministrator}},
+			isErr:                  true,
+			err:                    service.ErrInvalidRole,
+		},
+		{
+			description: "ゲームが存在しないのでErrNoGame",
+			userID:      userID,
+			GetGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrNoGame,
+		},
+		{
+			description: "アクティブでないユーザーなのでErrInvalidUserID",
+			userID:      inactiveUserID,
+			isErr:       true,
+			err:         service.ErrInvalidUserID,
+		},
+		{
+			description:            "ownerでないのでErrForbidden",
+			userID:                 userID,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleCollaborator},
+			},
+			isErr: true,
+			err:   service.ErrForbidden,
+		},
+		{
+			description:            "既にownerなのでErrNoGameManagementRoleUpdated",
+			userID:                 userID,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+				{UserID: userID, Role: values.GameManagementRoleAdministrator},
+			},
+			isErr: true,
+			err:   service.ErrNoGameManagementRoleUpdated,
+		},
+		{
+			description:            "自分自身への譲渡なのでErrNoGameManagementRoleUpdated",
+			userID:                 myID,
+			executeGetGameManagers: true,
+			managers:               []*repository.UserIDAndManagementRole{{UserID: myID, Role: values.GameManagementRoleAdministrator}},
+			isErr:                  true,
+			err:                    service.ErrNoGameManagementRoleUpdated,
+		},
+		{
+			description:            "元のownerの変更に失敗したのでエラー",
+			userID:                 userID,
+			executeGetGameManagers: true,
+			managers: []*repository.UserIDAndManagementRole{
+				{UserID: myID, Role: values.GameManagementRoleAdministrator},
+				{UserID: userID, Role: values.GameManagementRoleCollaborator},
+			},
+			executeUpdateNewOwner: true,
+			executeUpdateOldOwner: true,
+			UpdateOldOwnerErr:     errors.New("error"),
+			isErr:                 true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameManagementRoleRepository := mockRepository.NewMockGameManagementRole(ctrl)
+			mockGameRoleInvitationRepository := mockRepository.NewMockGameRoleInvitation(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			gameRoleService := NewGameRole(
+				mockDB,
+				mockGameRepository,
+				mockGameManagementRoleRepository,
+				mockGameRoleInvitationRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+			mockUserCache.
+				EXPECT().
+				GetActiveUsers(gomock.Any()).
+				Return([]*service.UserInfo{myInfo, userInfo}, nil).
+				AnyTimes()
+
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+				Return(game, testCase.GetGameErr)
+
+			if testCase.executeGetGameManagers {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					GetGameManagersByGameID(gomock.Any(), gameID).
+					Return(testCase.managers, nil)
+			}
+
+			if testCase.executeUpdateNewOwner {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					UpdateGameManagementRole(gomock.Any(), gameID, testCase.userID, values.GameManagementRoleAdministrator).
+					Return(nil)
+			}
+
+			if testCase.executeUpdateOldOwner {
+				mockGameManagementRoleRepository.
+					EXPECT().
+					UpdateGameManagementRole(gomock.Any(), gameID, myID, values.GameManagementRoleCollaborator).
+					Return(testCase.UpdateOldOwnerErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, auditLog *domain.AuditLog) error {
+						// 監査ログの取得時にオブジェクトとして読み込めるようにする
+						var before, after map[string]any
+						assert.NoError(t, json.Unmarshal(auditLog.GetBefore(), &before))
+						assert.NoError(t, json.Unmarshal(auditLog.GetAfter(), &after))
+						assert.Len(t, before["roles"], len(testCase.managers))
+						assert.Len(t, after["roles"], 2)
+
+						return nil
+					})
+			}
+
+			err := gameRoleService.TransferGameOwnership(ctx, authSession, gameID, testCase.userID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+)
+
+var _ service.GameStorageV2 = &GameStorage{}
+
+// GameStorage
+// ゲームごとのストレージの使用量・上限の管理と、アップロード時の上限の確認を行う。
+type GameStorage struct {
+	db                    repository.DB
+	gameRepository        repository.GameV2
+	gameStorageRepository repository.GameStorageV2
+	user                  *User
+	auditLog              *AuditLog
+	// defaultQuota
+	// 管理者が上限を設定していないゲームに適用する上限。上限なしの場合はinvalid。
+	defaultQuota option.Option[values.GameStorageSize]
+}
+
+func NewGameStorage(
+	conf config.ServiceV2,
+	db repository.DB,
+	gameRepository repository.GameV2,
+	gameStorageRepository repository.GameStorageV2,
+	user *User,
+	auditLog *AuditLog,
+) (*GameStorage, error) {
+	quota, ok, err := conf.DefaultGameStorageQuota()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default game storage quota: %w", err)
+	}
+
+	var defaultQuota option.Option[values.GameStorageSize]
+	if ok {
+		defaultQuota = option.NewOption(values.GameStorageSize(quota))
+	}
+
+	return &GameStorage{
+		db:                    db,
+		gameRepository:        gameRepository,
+		gameStorageRepository: gameStorageRepository,
+		user:                  user,
+		auditLog:              auditLog,
+		defaultQuota:          defaultQuota,
+	}, nil
+}
+
+func (gameStorage *GameStorage) GetGameStorage(ctx context.Context, gameID values.GameID) (*service.GameStorageInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameStorage.GetGameStorage")
+	defer span.End()
+
+	_, err := gameStorage.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	return gameStorage.getGameStorageInfo(ctx, gameID)
+}
+
+func (gameStorage *GameStorage) UpdateGameStorageQuota(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, quota values.GameStorageSize) (*service.GameStorageInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameStorage.UpdateGameStorageQuota")
+	defer span.End()
+
+	if quota < 0 {
+		return nil, service.ErrInvalidGameStorageQuota
+	}
+
+	myInfo, err := gameStorage.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var info *service.GameStorageInfo
+	err = gameStorage.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameStorage.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		before, err := gameStorage.getOverriddenQuota(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = gameStorage.gameStorageRepository.SaveGameStorageQuota(ctx, gameID, quota)
+		if err != nil {
+			return fmt.Errorf("failed to save game storage quota: %w", err)
+		}
+
+		err = gameStorage.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionUpdateGameStorageQuota,
+			values.AuditLogTargetTypeGame,
+			uuid.UUID(gameID),
+			newAuditLogGameStorageQuotaState(before),
+			newAuditLogGameStorageQuotaState(option.NewOption(quota)),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		info, err = gameStorage.getGameStorageInfo(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return info, nil
+}
+
+func (gameStorage *GameStorage) DeleteGameStorageQuota(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) (*service.GameStorageInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameStorage.DeleteGameStorageQuota")
+	defer span.End()
+
+	myInfo, err := gameStorage.user.getMe(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+
+	var info *service.GameStorageInfo
+	err = gameStorage.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		_, err := gameStorage.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game: %w", err)
+		}
+
+		before, err := gameStorage.getOverriddenQuota(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		err = gameStorage.gameStorageRepository.DeleteGameStorageQuota(ctx, gameID)
+		if errors.Is(err, repository.ErrNoRecordDeleted) {
+			return service.ErrNoGameStorageQuota
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete game storage quota: %w", err)
+		}
+
+		err = gameStorage.auditLog.record(
+			ctx,
+			myInfo,
+			values.AuditLogActionDeleteGameStorageQuota,
+			values.AuditLogTargetTypeGame,
+			uuid.UUID(gameID),
+			newAuditLogGameStorageQuotaState(before),
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record audit log: %w", err)
+		}
+
+		info, err = gameStorage.getGameStorageInfo(ctx, gameID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return info, nil
+}
+
+// getOverriddenQuota
+// 管理者がゲームに設定した上限を取得する。設定されていない場合はinvalid。
+func (gameStorage *GameStorage) getOverriddenQuota(ctx context.Context, gameID values.GameID) (option.Option[values.GameStorageSize], error) {
+	quota, err := gameStorage.gameStorageRepository.GetGameStorageQuota(ctx, gameID, repository.LockTypeRecord)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return option.Option[values.GameStorageSize]{}, nil
+	}
+	if err != nil {
+		return option.Option[values.GameStorageSize]{}, fmt.Errorf("failed to get game storage quota: %w", err)
+	}
+
+	return option.NewOption(quota), nil
+}
+
+func (gameStorage *GameStorage) getGameStorageInfo(ctx context.Context, gameID values.GameID) (*service.GameStorageInfo, error) {
+	usage, err := gameStorage.gameStorageRepository.GetGameStorageUsage(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game storage usage: %w", err)
+	}
+
+	quota, err := gameStorage.gameStorageRepository.GetGameStorageQuota(ctx, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return &service.GameStorageInfo{
+			Usage: usage,
+			Quota: gameStorage.defaultQuota,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game storage quota: %w", err)
+	}
+
+	return &service.GameStorageInfo{
+		Usage:             usage,
+		Quota:             option.NewOption(quota),
+		IsQuotaOverridden: true,
+	}, nil
+}
+
+// newQuotaReader
+// ゲームのストレージの残り容量を超えて読み込むとErrGameStorageQuotaExceededを返すreaderを作る。
+// 同時にアップロードされたファイルの容量も正しく数えるため、
+// ゲームの行ロックを取ったトランザクション内で呼び、保存が終わるまでロックを保持すること。
+func (gameStorage *GameStorage) newQuotaReader(ctx context.Context, gameID values.GameID, reader io.Reader) (*gameStorageQuotaReader, error) {
+	info, err := gameStorage.getGameStorageInfo(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining option.Option[values.GameStorageSize]
+	if quota, ok := info.Quota.Value(); ok {
+		remaining = option.NewOption(quota - info.Usage.GetTotal())
+	}
+
+	return &gameStorageQuotaReader{
+		reader:    reader,
+		remaining: remaining,
+	}, nil
+}
+
+// gameStorageQuotaReader
+// 読み込んだ容量を数え、残り容量を超えた時点でErrGameStorageQuotaExceededを返すreader。
+// 上限を超えるファイルを最後まで読み込まずに済むよう、読み込みながら確認する。
+type gameStorageQuotaReader struct {
+	reader io.Reader
+	// remaining
+	// 残り容量。上限がない場合はinvalid。
+	remaining option.Option[values.GameStorageSize]
+	size      values.GameStorageSize
+}
+
+func (r *gameStorageQuotaReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += values.GameStorageSize(n)
+
+	if remaining, ok := r.remaining.Value(); ok && r.size > remaining {
+		return n, service.ErrGameStorageQuotaExceeded
+	}
+
+	return n, err
+}
+
+// Size
+// これまでに読み込んだ容量。
+func (r *gameStorageQuotaReader) Size() values.GameStorageSize {
+	return r.size
+}
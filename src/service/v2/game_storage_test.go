@@ -0,0 +1,694 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockAuth "github.com/traPtitech/trap-collection-server/src/auth/mock"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetGameStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type test struct {
+		description                string
+		defaultQuota               option.Option[values.GameStorageSize]
+		GetGameErr                 error
+		executeGetGameStorageUsage bool
+		usage                      *domain.GameStorageUsage
+		GetGameStorageUsageErr     error
+		executeGetGameStorageQuota bool
+		quota                      values.GameStorageSize
+		GetGameStorageQuotaErr     error
+		expected                   *service.GameStorageInfo
+		isErr                      bool
+		err                        error
+	}
+
+	usage := domain.NewGameStorageUsage(100, 200, 300)
+
+	testCases := []test{
+		{
+			description:                "特に問題ないのでエラー無し",
+			executeGetGameStorageUsage: true,
+			usage:                      usage,
+			executeGetGameStorageQuota: true,
+			quota:                      1000,
+			expected: &service.GameStorageInfo{
+				Usage:             usage,
+				Quota:             option.NewOption[values.GameStorageSize](1000),
+				IsQuotaOverridden: true,
+			},
+		},
+		{
+			description:                "上限が設定されていないのでデフォルトの上限",
+			defaultQuota:               option.NewOption[values.GameStorageSize](2000),
+			executeGetGameStorageUsage: true,
+			usage:                      usage,
+			executeGetGameStorageQuota: true,
+			GetGameStorageQuotaErr:     repository.ErrRecordNotFound,
+			expected: &service.GameStorageInfo{
+				Usage: usage,
+				Quota: option.NewOption[values.GameStorageSize](2000),
+			},
+		},
+		{
+			description:                "上限もデフォルトの上限もないので上限なし",
+			executeGetGameStorageUsage: true,
+			usage:                      usage,
+			executeGetGameStorageQuota: true,
+			GetGameStorageQuotaErr:     repository.ErrRecordNotFound,
+			expected: &service.GameStorageInfo{
+				Usage: usage,
+			},
+		},
+		{
+			description: "ゲームが存在しないのでErrInvalidGameID",
+			GetGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrInvalidGameID,
+		},
+		{
+			description: "GetGameがエラーなのでエラー",
+			GetGameErr:  errors.New("error"),
+			isErr:       true,
+		},
+		{
+			description:                "GetGameStorageUsageがエラーなのでエラー",
+			executeGetGameStorageUsage: true,
+			GetGameStorageUsageErr:     errors.New("error"),
+			isErr:                      true,
+		},
+		{
+			description:                "GetGameStorageQuotaがエラーなのでエラー",
+			executeGetGameStorageUsage: true,
+			usage:                      usage,
+			executeGetGameStorageQuota: true,
+			GetGameStorageQuotaErr:     errors.New("error"),
+			isErr:                      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockConf := mockConfig.NewMockServiceV2(ctrl)
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			defaultQuota, ok := testCase.defaultQuota.Value()
+			mockConf.
+				EXPECT().
+				DefaultGameStorageQuota().
+				Return(int64(defaultQuota), ok, nil)
+
+			gameStorageService, err := NewGameStorage(
+				mockConf,
+				mockDB,
+				mockGameRepository,
+				mockGameStorageRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+			require.NoError(t, err)
+
+			gameID := values.NewGameID()
+
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), gameID, repository.LockTypeNone).
+				Return(nil, testCase.GetGameErr)
+
+			if testCase.executeGetGameStorageUsage {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), gameID).
+					Return(testCase.usage, testCase.GetGameStorageUsageErr)
+			}
+
+			if testCase.executeGetGameStorageQuota {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeNone).
+					Return(testCase.quota, testCase.GetGameStorageQuotaErr)
+			}
+
+			info, err := gameStorageService.GetGameStorage(ctx, gameID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expected, info)
+		})
+	}
+}
+
+func TestUpdateGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	usage := domain.NewGameStorageUsage(100, 200, 300)
+
+	type test struct {
+		description                 string
+		quota                       values.GameStorageSize
+		executeGetMe                bool
+		GetMeErr                    error
+		executeGetGame              bool
+		GetGameErr                  error
+		executeGetOverriddenQuota   bool
+		overriddenQuota             option.Option[values.GameStorageSize]
+		GetOverriddenQuotaErr       error
+		executeSaveGameStorageQuota bool
+		SaveGameStorageQuotaErr     error
+		executeCreateAuditLog       bool
+		CreateAuditLogErr           error
+		isErr                       bool
+		err                         error
+	}
+
+	testCases := []test{
+		{
+			description:                 "特に問題ないのでエラー無し",
+			quota:                       1000,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			executeSaveGameStorageQuota: true,
+			executeCreateAuditLog:       true,
+		},
+		{
+			description:                 "既に上限が設定されていてもエラー無し",
+			quota:                       1000,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			overriddenQuota:             option.NewOption[values.GameStorageSize](500),
+			executeSaveGameStorageQuota: true,
+			executeCreateAuditLog:       true,
+		},
+		{
+			description:                 "使用量より小さい上限でもエラー無し",
+			quota:                       10,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			executeSaveGameStorageQuota: true,
+			executeCreateAuditLog:       true,
+		},
+		{
+			description:                 "上限が0でもエラー無し",
+			quota:                       0,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			executeSaveGameStorageQuota: true,
+			executeCreateAuditLog:       true,
+		},
+		{
+			description: "上限が負なのでErrInvalidGameStorageQuota",
+			quota:       -1,
+			isErr:       true,
+			err:         service.ErrInvalidGameStorageQuota,
+		},
+		{
+			description:  "GetMeがエラーなのでエラー",
+			quota:        1000,
+			executeGetMe: true,
+			GetMeErr:     errors.New("error"),
+			isErr:        true,
+		},
+		{
+			description:    "ゲームが存在しないのでErrInvalidGameID",
+			quota:          1000,
+			executeGetMe:   true,
+			executeGetGame: true,
+			GetGameErr:     repository.ErrRecordNotFound,
+			isErr:          true,
+			err:            service.ErrInvalidGameID,
+		},
+		{
+			description:    "GetGameがエラーなのでエラー",
+			quota:          1000,
+			executeGetMe:   true,
+			executeGetGame: true,
+			GetGameErr:     errors.New("error"),
+			isErr:          true,
+		},
+		{
+			description:               "GetGameStorageQuotaがエラーなのでエラー",
+			quota:                     1000,
+			executeGetMe:              true,
+			executeGetGame:            true,
+			executeGetOverriddenQuota: true,
+			GetOverriddenQuotaErr:     errors.New("error"),
+			isErr:                     true,
+		},
+		{
+			description:                 "SaveGameStorageQuotaがエラーなのでエラー",
+			quota:                       1000,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			executeSaveGameStorageQuota: true,
+			SaveGameStorageQuotaErr:     errors.New("error"),
+			isErr:                       true,
+		},
+		{
+			description:                 "CreateAuditLogがエラーなのでエラー",
+			quota:                       1000,
+			executeGetMe:                true,
+			executeGetGame:              true,
+			executeGetOverriddenQuota:   true,
+			executeSaveGameStorageQuota: true,
+			executeCreateAuditLog:       true,
+			CreateAuditLogErr:           errors.New("error"),
+			isErr:                       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockConf := mockConfig.NewMockServiceV2(ctrl)
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			mockConf.
+				EXPECT().
+				DefaultGameStorageQuota().
+				Return(int64(0), false, nil)
+
+			gameStorageService, err := NewGameStorage(
+				mockConf,
+				mockDB,
+				mockGameRepository,
+				mockGameStorageRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+			require.NoError(t, err)
+
+			gameID := values.NewGameID()
+
+			if testCase.executeGetMe {
+				if testCase.GetMeErr == nil {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), authSession.GetAccessToken()).
+						Return(myInfo, nil)
+				} else {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), authSession.GetAccessToken()).
+						Return(nil, cache.ErrCacheMiss)
+					mockUserAuth.
+						EXPECT().
+						GetMe(gomock.Any(), authSession).
+						Return(nil, testCase.GetMeErr)
+				}
+			}
+
+			if testCase.executeGetGame {
+				mockGameRepository.
+					EXPECT().
+					GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+					Return(nil, testCase.GetGameErr)
+			}
+
+			if testCase.executeGetOverriddenQuota {
+				quota, ok := testCase.overriddenQuota.Value()
+				getOverriddenQuotaErr := testCase.GetOverriddenQuotaErr
+				if !ok && getOverriddenQuotaErr == nil {
+					getOverriddenQuotaErr = repository.ErrRecordNotFound
+				}
+
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeRecord).
+					Return(quota, getOverriddenQuotaErr)
+			}
+
+			if testCase.executeSaveGameStorageQuota {
+				mockGameStorageRepository.
+					EXPECT().
+					SaveGameStorageQuota(gomock.Any(), gameID, testCase.quota).
+					Return(testCase.SaveGameStorageQuotaErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionUpdateGameStorageQuota &&
+							auditLog.GetTargetType() == values.AuditLogTargetTypeGame &&
+							auditLog.GetTargetID() == uuid.UUID(gameID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			if !testCase.isErr {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), gameID).
+					Return(usage, nil)
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeNone).
+					Return(testCase.quota, nil)
+			}
+
+			info, err := gameStorageService.UpdateGameStorageQuota(ctx, authSession, gameID, testCase.quota)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, usage, info.Usage)
+			assert.Equal(t, option.NewOption(testCase.quota), info.Quota)
+			assert.True(t, info.IsQuotaOverridden)
+		})
+	}
+}
+
+func TestDeleteGameStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+	myInfo := service.NewUserInfo(values.NewTrapMemberID(uuid.New()), "me", values.TrapMemberStatusActive, false)
+
+	usage := domain.NewGameStorageUsage(100, 200, 300)
+
+	type test struct {
+		description                   string
+		defaultQuota                  option.Option[values.GameStorageSize]
+		executeGetGame                bool
+		GetGameErr                    error
+		executeGetOverriddenQuota     bool
+		overriddenQuota               option.Option[values.GameStorageSize]
+		executeDeleteGameStorageQuota bool
+		DeleteGameStorageQuotaErr     error
+		executeCreateAuditLog         bool
+		CreateAuditLogErr             error
+		isErr                         bool
+		err                           error
+	}
+
+	testCases := []test{
+		{
+			description:                   "特に問題ないのでエラー無し",
+			executeGetGame:                true,
+			executeGetOverriddenQuota:     true,
+			overriddenQuota:               option.NewOption[values.GameStorageSize](1000),
+			executeDeleteGameStorageQuota: true,
+			executeCreateAuditLog:         true,
+		},
+		{
+			description:                   "デフォルトの上限があってもエラー無し",
+			defaultQuota:                  option.NewOption[values.GameStorageSize](2000),
+			executeGetGame:                true,
+			executeGetOverriddenQuota:     true,
+			overriddenQuota:               option.NewOption[values.GameStorageSize](1000),
+			executeDeleteGameStorageQuota: true,
+			executeCreateAuditLog:         true,
+		},
+		{
+			description:    "ゲームが存在しないのでErrInvalidGameID",
+			executeGetGame: true,
+			GetGameErr:     repository.ErrRecordNotFound,
+			isErr:          true,
+			err:            service.ErrInvalidGameID,
+		},
+		{
+			description:                   "上限が設定されていないのでErrNoGameStorageQuota",
+			executeGetGame:                true,
+			executeGetOverriddenQuota:     true,
+			executeDeleteGameStorageQuota: true,
+			DeleteGameStorageQuotaErr:     repository.ErrNoRecordDeleted,
+			isErr:                         true,
+			err:                           service.ErrNoGameStorageQuota,
+		},
+		{
+			description:                   "DeleteGameStorageQuotaがエラーなのでエラー",
+			executeGetGame:                true,
+			executeGetOverriddenQuota:     true,
+			overriddenQuota:               option.NewOption[values.GameStorageSize](1000),
+			executeDeleteGameStorageQuota: true,
+			DeleteGameStorageQuotaErr:     errors.New("error"),
+			isErr:                         true,
+		},
+		{
+			description:                   "CreateAuditLogがエラーなのでエラー",
+			executeGetGame:                true,
+			executeGetOverriddenQuota:     true,
+			overriddenQuota:               option.NewOption[values.GameStorageSize](1000),
+			executeDeleteGameStorageQuota: true,
+			executeCreateAuditLog:         true,
+			CreateAuditLogErr:             errors.New("error"),
+			isErr:                         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockConf := mockConfig.NewMockServiceV2(ctrl)
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+			mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+			mockUserAuth := mockAuth.NewMockUser(ctrl)
+			mockUserCache := mockCache.NewMockUser(ctrl)
+
+			defaultQuota, ok := testCase.defaultQuota.Value()
+			mockConf.
+				EXPECT().
+				DefaultGameStorageQuota().
+				Return(int64(defaultQuota), ok, nil)
+
+			gameStorageService, err := NewGameStorage(
+				mockConf,
+				mockDB,
+				mockGameRepository,
+				mockGameStorageRepository,
+				NewUser(mockUserAuth, mockUserCache),
+				NewAuditLog(mockAuditLogRepository),
+			)
+			require.NoError(t, err)
+
+			gameID := values.NewGameID()
+
+			mockUserCache.
+				EXPECT().
+				GetMe(gomock.Any(), authSession.GetAccessToken()).
+				Return(myInfo, nil)
+
+			if testCase.executeGetGame {
+				mockGameRepository.
+					EXPECT().
+					GetGame(gomock.Any(), gameID, repository.LockTypeRecord).
+					Return(nil, testCase.GetGameErr)
+			}
+
+			if testCase.executeGetOverriddenQuota {
+				var getOverriddenQuotaErr error
+				quota, ok := testCase.overriddenQuota.Value()
+				if !ok {
+					getOverriddenQuotaErr = repository.ErrRecordNotFound
+				}
+
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeRecord).
+					Return(quota, getOverriddenQuotaErr)
+			}
+
+			if testCase.executeDeleteGameStorageQuota {
+				mockGameStorageRepository.
+					EXPECT().
+					DeleteGameStorageQuota(gomock.Any(), gameID).
+					Return(testCase.DeleteGameStorageQuotaErr)
+			}
+
+			if testCase.executeCreateAuditLog {
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Cond(func(auditLog *domain.AuditLog) bool {
+						return auditLog.GetAction() == values.AuditLogActionDeleteGameStorageQuota &&
+							auditLog.GetTargetType() == values.AuditLogTargetTypeGame &&
+							auditLog.GetTargetID() == uuid.UUID(gameID)
+					})).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			if !testCase.isErr {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), gameID).
+					Return(usage, nil)
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageQuota(gomock.Any(), gameID, repository.LockTypeNone).
+					Return(values.GameStorageSize(0), repository.ErrRecordNotFound)
+			}
+
+			info, err := gameStorageService.DeleteGameStorageQuota(ctx, authSession, gameID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, usage, info.Usage)
+			assert.Equal(t, testCase.defaultQuota, info.Quota)
+			assert.False(t, info.IsQuotaOverridden)
+		})
+	}
+}
+
+func TestGameStorageQuotaReader(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description string
+		content     string
+		remaining   option.Option[values.GameStorageSize]
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "上限がないのでエラー無し",
+			content:     "0123456789",
+		},
+		{
+			description: "残り容量以内なのでエラー無し",
+			content:     "0123456789",
+			remaining:   option.NewOption[values.GameStorageSize](20),
+		},
+		{
+			description: "残り容量ちょうどなのでエラー無し",
+			content:     "0123456789",
+			remaining:   option.NewOption[values.GameStorageSize](10),
+		},
+		{
+			description: "残り容量を超えるのでErrGameStorageQuotaExceeded",
+			content:     "0123456789",
+			remaining:   option.NewOption[values.GameStorageSize](9),
+			isErr:       true,
+			err:         service.ErrGameStorageQuotaExceeded,
+		},
+		{
+			description: "既に上限を超えているのでErrGameStorageQuotaExceeded",
+			content:     "0123456789",
+			remaining:   option.NewOption[values.GameStorageSize](-10),
+			isErr:       true,
+			err:         service.ErrGameStorageQuotaExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			reader := &gameStorageQuotaReader{
+				reader:    strings.NewReader(testCase.content),
+				remaining: testCase.remaining,
+			}
+
+			b, err := io.ReadAll(reader)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.content, string(b))
+			assert.Equal(t, values.GameStorageSize(len(testCase.content)), reader.Size())
+		})
+	}
+}
@@ -37,12 +37,16 @@ func TestCreateGame(t *testing.T) {
 
 	user := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		user,
+		auditLog,
 	)
 
 	type test struct {
@@ -822,7 +826,7 @@ func TestCreateGame(t *testing.T) {
 			if testCase.executeGetGameGenresWithNames {
 				mockGameGenreRepository.
 					EXPECT().
-					GetGameGenresWithNames(ctx, gomock.Any()).
+					GetGameGenresWithNames(gomock.Any(), gomock.Any()).
 					Return(testCase.GetGameGenresWithNamesReturn, testCase.GetGameGenresWithNamesErr)
 			}
 
@@ -836,7 +840,7 @@ func TestCreateGame(t *testing.T) {
 			if testCase.executeRegisterGenresToGame {
 				mockGameGenreRepository.
 					EXPECT().
-					RegisterGenresToGame(ctx, gomock.Any(), gomock.Any()).
+					RegisterGenresToGame(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(testCase.RegisterGenresToGameErr)
 			}
 
@@ -900,12 +904,16 @@ func TestGetGame(t *testing.T) {
 
 	userUtils := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		userUtils,
+		auditLog,
 	)
 
 	type test struct {
@@ -1101,13 +1109,13 @@ func TestGetGame(t *testing.T) {
 			if testCase.executeGetGameManagersByGameID {
 				mockGameManagementRoleRepository.
 					EXPECT().
-					GetGameManagersByGameID(ctx, testCase.gameID).
+					GetGameManagersByGameID(gomock.Any(), testCase.gameID).
 					Return(testCase.administrators, testCase.GetGameManagersByGameIDErr)
 			}
 			if testCase.executeGetGenresByGameID {
 				mockGameGenreRepository.
 					EXPECT().
-					GetGenresByGameID(ctx, testCase.gameID).
+					GetGenresByGameID(gomock.Any(), testCase.gameID).
 					Return(testCase.genres, testCase.GetGenresByGameIDErr)
 			}
 
@@ -1182,12 +1190,16 @@ func TestGetGames(t *testing.T) {
 
 	userUtils := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		userUtils,
+		auditLog,
 	)
 
 	type test struct {
@@ -1198,6 +1210,7 @@ func TestGetGames(t *testing.T) {
 		visibilities    []values.GameVisibility
 		gameGenreIDs    []values.GameGenreID
 		gameName        string
+		keyword         string
 		gamesNumber     int
 		executeGetGames bool
 		gamesWithGenres []*domain.GameWithGenres
@@ -1340,6 +1353,40 @@ func TestGetGames(t *testing.T) {
 			gamesNumber:     2,
 			executeGetGames: true,
 		},
+		{
+			description: "sortがplayCountでもエラー無し",
+			gamesWithGenres: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1, gameGenre2}),
+			},
+			limit:           1,
+			offset:          0,
+			sort:            service.GamesSortTypePlayCount,
+			gamesNumber:     2,
+			executeGetGames: true,
+		},
+		{
+			description: "sortがlatestFeedbackでもエラー無し",
+			gamesWithGenres: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1, gameGenre2}),
+			},
+			limit:           1,
+			offset:          0,
+			sort:            service.GamesSortTypeLatestFeedback,
+			gamesNumber:     2,
+			executeGetGames: true,
+		},
+		{
+			description: "キーワードの指定があってもエラー無し",
+			gamesWithGenres: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1, gameGenre2}),
+			},
+			limit:           1,
+			offset:          0,
+			keyword:         "description",
+			sort:            service.GamesSortTypeCreatedAt,
+			gamesNumber:     2,
+			executeGetGames: true,
+		},
 		{
 			description: "limitが負なのでエラー",
 			limit:       -1,
@@ -1376,11 +1423,11 @@ func TestGetGames(t *testing.T) {
 			if testCase.executeGetGames {
 				mockGameRepository.
 					EXPECT().
-					GetGames(gomock.Any(), testCase.limit, testCase.offset, gomock.Any(), testCase.visibilities, gomock.Nil(), testCase.gameGenreIDs, testCase.gameName).
+					GetGames(gomock.Any(), testCase.limit, testCase.offset, gomock.Any(), testCase.visibilities, gomock.Nil(), testCase.gameGenreIDs, testCase.gameName, testCase.keyword).
 					Return(testCase.gamesWithGenres, testCase.gamesNumber, testCase.GetGamesErr)
 			}
 
-			n, gamesWithGenres, err := gameService.GetGames(ctx, testCase.limit, testCase.offset, testCase.sort, testCase.visibilities, testCase.gameGenreIDs, testCase.gameName)
+			n, gamesWithGenres, err := gameService.GetGames(ctx, testCase.limit, testCase.offset, testCase.sort, testCase.visibilities, testCase.gameGenreIDs, testCase.gameName, testCase.keyword)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -1433,12 +1480,16 @@ func TestGetMyGames(t *testing.T) {
 
 	userUtils := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		userUtils,
+		auditLog,
 	)
 
 	type test struct {
@@ -1454,6 +1505,7 @@ func TestGetMyGames(t *testing.T) {
 		visibilities          []values.GameVisibility
 		gameGenreIDs          []values.GameGenreID
 		gameName              string
+		keyword               string
 		gameNumber            int
 		games                 []*domain.GameWithGenres
 		GetGamesErr           error
@@ -1645,6 +1697,23 @@ func TestGetMyGames(t *testing.T) {
 			sort:       service.GamesSortTypeLatestVersion,
 			gameNumber: 1,
 		},
+		{
+			description: "キーワードの指定があってもエラー無し",
+			authSession: domain.NewOIDCSession(
+				"access token",
+				time.Now().Add(time.Hour),
+			),
+			user:                  user,
+			executeGetGamesByUser: true,
+			games: []*domain.GameWithGenres{
+				domain.NewGameWithGenres(game1, []*domain.GameGenre{gameGenre1}),
+			},
+			limit:      1,
+			offset:     0,
+			keyword:    "description",
+			sort:       service.GamesSortTypeCreatedAt,
+			gameNumber: 1,
+		},
 		{
 			description: "limitが負なのでエラー",
 			authSession: domain.NewOIDCSession(
@@ -1720,11 +1789,11 @@ func TestGetMyGames(t *testing.T) {
 				userID := testCase.user.GetID()
 				mockGameRepository.
 					EXPECT().
-					GetGames(gomock.Any(), testCase.limit, testCase.offset, gomock.Any(), testCase.visibilities, &userID, testCase.gameGenreIDs, testCase.gameName).
+					GetGames(gomock.Any(), testCase.limit, testCase.offset, gomock.Any(), testCase.visibilities, &userID, testCase.gameGenreIDs, testCase.gameName, testCase.keyword).
 					Return(testCase.games, testCase.gameNumber, testCase.GetGamesByUserErr)
 			}
 
-			n, games, err := gameService.GetMyGames(ctx, testCase.authSession, testCase.limit, testCase.offset, testCase.sort, testCase.visibilities, testCase.gameGenreIDs, testCase.gameName)
+			n, games, err := gameService.GetMyGames(ctx, testCase.authSession, testCase.limit, testCase.offset, testCase.sort, testCase.visibilities, testCase.gameGenreIDs, testCase.gameName, testCase.keyword)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -1760,6 +1829,160 @@ func TestGetMyGames(t *testing.T) {
 	}
 }
 
+func TestGetGameFacets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameManagementRoleRepository := mockRepository.NewMockGameManagementRole(ctrl)
+	mockGameGenreRepository := mockRepository.NewMockGameGenre(ctrl)
+
+	mockUserCache := mockCache.NewMockUser(ctrl)
+	mockUserAuth := mockAuth.NewMockUser(ctrl)
+
+	userUtils := NewUser(mockUserAuth, mockUserCache)
+
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
+	gameService := NewGame(
+		mockDB,
+		mockGameRepository,
+		mockGameManagementRoleRepository,
+		mockGameGenreRepository,
+		userUtils,
+		auditLog,
+	)
+
+	type test struct {
+		description          string
+		authSession          *domain.OIDCSession
+		isGetMeErr           bool
+		visibilities         []values.GameVisibility
+		gameGenreIDs         []values.GameGenreID
+		gameName             string
+		keyword              string
+		executeGetGameFacets bool
+		facets               *domain.GameFacets
+		GetGameFacetsErr     error
+		isErr                bool
+		err                  error
+	}
+
+	user := service.NewUserInfo(
+		values.NewTrapMemberID(uuid.New()),
+		"ikura-hamu",
+		values.TrapMemberStatusActive,
+		false,
+	)
+
+	gameGenre := domain.NewGameGenre(values.NewGameGenreID(), values.NewGameGenreName("game genre name"), time.Now())
+
+	facets := domain.NewGameFacets(
+		[]*domain.GameGenreFacet{domain.NewGameGenreFacet(gameGenre, 2)},
+		[]*domain.GameFileTypeFacet{domain.NewGameFileTypeFacet(values.GameFileTypeJar, 1)},
+		[]*domain.GameVisibilityFacet{domain.NewGameVisibilityFacet(values.GameVisibilityTypePublic, 2)},
+	)
+
+	testCases := []test{
+		{
+			description:          "sessionがnilなので全てのゲームについて数える",
+			executeGetGameFacets: true,
+			facets:               facets,
+		},
+		{
+			description: "sessionがあるので自分のゲームについて数える",
+			authSession: domain.NewOIDCSession(
+				"access token",
+				time.Now().Add(time.Hour),
+			),
+			executeGetGameFacets: true,
+			facets:               facets,
+		},
+		{
+			description:          "条件があってもエラー無し",
+			visibilities:         []values.GameVisibility{values.GameVisibilityTypePublic},
+			gameGenreIDs:         []values.GameGenreID{gameGenre.GetID()},
+			gameName:             "game name",
+			keyword:              "description",
+			executeGetGameFacets: true,
+			facets:               facets,
+		},
+		{
+			description: "getMeがエラーなのでエラー",
+			authSession: domain.NewOIDCSession(
+				"access token",
+				time.Now().Add(time.Hour),
+			),
+			isGetMeErr: true,
+			isErr:      true,
+		},
+		{
+			description:          "GetGameFacetsがエラーなのでエラー",
+			executeGetGameFacets: true,
+			GetGameFacetsErr:     errors.New("error"),
+			isErr:                true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.authSession != nil {
+				if testCase.isGetMeErr {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), testCase.authSession.GetAccessToken()).
+						Return(nil, cache.ErrCacheMiss)
+					mockUserAuth.
+						EXPECT().
+						GetMe(gomock.Any(), testCase.authSession).
+						Return(nil, errors.New("error"))
+				} else {
+					mockUserCache.
+						EXPECT().
+						GetMe(gomock.Any(), testCase.authSession.GetAccessToken()).
+						Return(user, nil)
+				}
+			}
+
+			if testCase.executeGetGameFacets {
+				var userIDMatcher gomock.Matcher = gomock.Nil()
+				if testCase.authSession != nil {
+					userID := user.GetID()
+					userIDMatcher = gomock.Eq(&userID)
+				}
+
+				mockGameRepository.
+					EXPECT().
+					GetGameFacets(gomock.Any(), testCase.visibilities, userIDMatcher, testCase.gameGenreIDs, testCase.gameName, testCase.keyword).
+					Return(testCase.facets, testCase.GetGameFacetsErr)
+			}
+
+			facets, err := gameService.GetGameFacets(ctx, testCase.authSession, testCase.visibilities, testCase.gameGenreIDs, testCase.gameName, testCase.keyword)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.facets, facets)
+		})
+	}
+}
+
 func TestUpdateGame(t *testing.T) {
 	t.Parallel()
 
@@ -1778,29 +2001,40 @@ func TestUpdateGame(t *testing.T) {
 
 	userUtils := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameVersionService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		userUtils,
+		auditLog,
 	)
 
 	type test struct {
-		description       string
-		gameID            values.GameID
-		name              values.GameName
-		gameDescription   values.GameDescription
-		visibility        *values.GameVisibility
-		currentGame       *domain.Game
-		GetGameErr        error
-		newGame           *domain.Game
-		executeUpdateGame bool
-		UpdateGameErr     error
-		isErr             bool
-		err               error
+		description           string
+		gameID                values.GameID
+		name                  values.GameName
+		gameDescription       values.GameDescription
+		visibility            *values.GameVisibility
+		currentGame           *domain.Game
+		GetGameErr            error
+		newGame               *domain.Game
+		executeUpdateGame     bool
+		UpdateGameErr         error
+		executeCreateAuditLog bool
+		CreateAuditLogErr     error
+		isErr                 bool
+		err                   error
 	}
 
+	authSession := domain.NewOIDCSession(
+		"access token",
+		time.Now().Add(time.Hour),
+	)
+
 	gameID := values.NewGameID()
 	var (
 		visibilityPublic  = values.GameVisibilityTypePublic
@@ -1831,7 +2065,8 @@ func TestUpdateGame(t *testing.T) {
 				values.GameVisibilityTypePublic,
 				now,
 			),
-			executeUpdateGame: true,
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
 		},
 		{
 			description:     "nameの変更なしでもエラーなし",
@@ -1853,7 +2088,8 @@ func TestUpdateGame(t *testing.T) {
 				values.GameVisibilityTypePublic,
 				now,
 			),
-			executeUpdateGame: true,
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
 		},
 		{
 			description:     "descriptionの変更なしでもエラーなし",
@@ -1875,7 +2111,8 @@ func TestUpdateGame(t *testing.T) {
 				values.GameVisibilityTypePublic,
 				now,
 			),
-			executeUpdateGame: true,
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
 		},
 		{
 			description:     "visibilityの変更なしでもエラーなし",
@@ -1897,7 +2134,8 @@ func TestUpdateGame(t *testing.T) {
 				values.GameVisibilityTypeLimited,
 				now,
 			),
-			executeUpdateGame: true,
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
 		},
 		{
 			description:     "visibilityの変更なし(nil)でもエラーなし",
@@ -1919,7 +2157,8 @@ func TestUpdateGame(t *testing.T) {
 				values.GameVisibilityTypeLimited,
 				now,
 			),
-			executeUpdateGame: true,
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
 		},
 		{
 			description:     "変更なしでも問題なし",
@@ -2004,6 +2243,30 @@ func TestUpdateGame(t *testing.T) {
 			UpdateGameErr:     errors.New("error"),
 			isErr:             true,
 		},
+		{
+			description:     "CreateAuditLogがエラーなのでエラー",
+			gameID:          gameID,
+			name:            values.GameName("after"),
+			gameDescription: values.GameDescription("after"),
+			currentGame: domain.NewGame(
+				gameID,
+				values.GameName("before"),
+				values.GameDescription("before"),
+				values.GameVisibilityTypeLimited,
+				now,
+			),
+			newGame: domain.NewGame(
+				gameID,
+				values.GameName("after"),
+				values.GameDescription("after"),
+				values.GameVisibilityTypeLimited,
+				now,
+			),
+			executeUpdateGame:     true,
+			executeCreateAuditLog: true,
+			CreateAuditLogErr:     errors.New("error"),
+			isErr:                 true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2020,7 +2283,18 @@ func TestUpdateGame(t *testing.T) {
 					Return(testCase.UpdateGameErr)
 			}
 
-			game, err := gameVersionService.UpdateGame(ctx, testCase.gameID, testCase.name, testCase.gameDescription, testCase.visibility)
+			if testCase.executeCreateAuditLog {
+				mockUserCache.
+					EXPECT().
+					GetMe(gomock.Any(), gomock.Any()).
+					Return(&service.UserInfo{}, nil)
+				mockAuditLogRepository.
+					EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Return(testCase.CreateAuditLogErr)
+			}
+
+			game, err := gameVersionService.UpdateGame(ctx, authSession, testCase.gameID, testCase.name, testCase.gameDescription, testCase.visibility)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -2062,12 +2336,16 @@ func TestDeleteGame(t *testing.T) {
 
 	userUtils := NewUser(mockUserAuth, mockUserCache)
 
+	mockAuditLogRepository := mockRepository.NewMockAuditLog(ctrl)
+	auditLog := NewAuditLog(mockAuditLogRepository)
+
 	gameVersionService := NewGame(
 		mockDB,
 		mockGameRepository,
 		mockGameManagementRoleRepository,
 		mockGameGenreRepository,
 		userUtils,
+		auditLog,
 	)
 
 	type test struct {
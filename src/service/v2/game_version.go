@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"golang.org/x/sync/errgroup"
 )
 
 var _ service.GameVersionV2 = &GameVersion{}
@@ -23,6 +27,10 @@ type GameVersion struct {
 	gameVideoRepository   repository.GameVideoV2
 	gameFileRepository    repository.GameFileV2
 	gameVersionRepository repository.GameVersionV2
+	editionRepository     repository.Edition
+	gameImageStorage      storage.GameImage
+	gameVideoStorage      storage.GameVideo
+	gameFileStorage       storage.GameFile
 }
 
 func NewGameVersion(
@@ -32,6 +40,10 @@ func NewGameVersion(
 	gameVideoRepository repository.GameVideoV2,
 	gameFileRepository repository.GameFileV2,
 	gameVersionRepository repository.GameVersionV2,
+	editionRepository repository.Edition,
+	gameImageStorage storage.GameImage,
+	gameVideoStorage storage.GameVideo,
+	gameFileStorage storage.GameFile,
 ) *GameVersion {
 	return &GameVersion{
 		db:                    db,
@@ -40,6 +52,10 @@ func NewGameVersion(
 		gameVideoRepository:   gameVideoRepository,
 		gameFileRepository:    gameFileRepository,
 		gameVersionRepository: gameVersionRepository,
+		editionRepository:     editionRepository,
+		gameImageStorage:      gameImageStorage,
+		gameVideoStorage:      gameVideoStorage,
+		gameFileStorage:       gameFileStorage,
 	}
 }
 
@@ -52,6 +68,9 @@ func (gameVersion *GameVersion) CreateGameVersion(
 	videoID values.GameVideoID,
 	assets *service.Assets,
 ) (*service.GameVersionInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameVersion.CreateGameVersion")
+	defer span.End()
+
 	fileIDs := make([]values.GameFileID, 0, 3)
 	// fileの種類確認用のmap
 	fileTypeMap := make(map[values.GameFileID]values.GameFileType, 3)
@@ -148,6 +167,11 @@ func (gameVersion *GameVersion) CreateGameVersion(
 				if gameFile.GetFileType() != fileType {
 					return service.ErrInvalidGameFileType
 				}
+
+				// 検査を通過していないファイルは、エディションを通して配布されないようにする
+				if gameFile.GetScanStatus() != values.GameFileScanStatusClean {
+					return service.ErrGameFileNotClean
+				}
 			}
 		}
 
@@ -175,6 +199,9 @@ func (gameVersion *GameVersion) CreateGameVersion(
 }
 
 func (gameVersion *GameVersion) GetGameVersions(ctx context.Context, gameID values.GameID, params *service.GetGameVersionsParams) (uint, []*service.GameVersionInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameVersion.GetGameVersions")
+	defer span.End()
+
 	var (
 		limit  uint
 		offset uint
@@ -230,34 +257,34 @@ func (gameVersion *GameVersion) GetGameVersions(ctx context.Context, gameID valu
 		for _, id := range gameVersion.FileIDs {
 			gameFile, ok := gameFileMap[id]
 			if !ok {
-				log.Printf("error: game file not found(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, gameVersion.GetID(), id)
+				logger.Error(ctx, "game file not found", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 				continue
 			}
 
 			switch gameFile.GetFileType() {
 			case values.GameFileTypeWindows:
 				if _, ok := assets.Windows.Value(); ok {
-					log.Printf("error: duplicate file type windows(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type windows", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Windows = option.NewOption(gameFile.GetID())
 			case values.GameFileTypeMac:
 				if _, ok := assets.Mac.Value(); ok {
-					log.Printf("error: duplicate file type mac(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type mac", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Mac = option.NewOption(gameFile.GetID())
 			case values.GameFileTypeJar:
 				if _, ok := assets.Jar.Value(); ok {
-					log.Printf("error: duplicate file type jar(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, gameVersion.GetID(), id)
+					logger.Error(ctx, "duplicate file type jar", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 					continue
 				}
 
 				assets.Jar = option.NewOption(gameFile.GetID())
 			default:
-				log.Printf("invalid game file type: game_id=%s, game_version_id=%s, game_file_id=%s, file_type=%d\n", gameID, gameVersion.GetID(), id, gameFile.GetFileType())
+				logger.Error(ctx, "invalid game file type", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(gameVersion.GetID())), slog.Any("game_file_id", uuid.UUID(id)), slog.Any("file_type", gameFile.GetFileType()))
 				continue
 			}
 		}
@@ -274,6 +301,9 @@ func (gameVersion *GameVersion) GetGameVersions(ctx context.Context, gameID valu
 }
 
 func (gameVersion *GameVersion) GetLatestGameVersion(ctx context.Context, gameID values.GameID) (*service.GameVersionInfo, error) {
+	ctx, span := tracer.Start(ctx, "GameVersion.GetLatestGameVersion")
+	defer span.End()
+
 	_, err := gameVersion.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -308,34 +338,34 @@ func (gameVersion *GameVersion) GetLatestGameVersion(ctx context.Context, gameID
 	for _, id := range version.FileIDs {
 		gameFile, ok := gameFileMap[id]
 		if !ok {
-			log.Printf("error: game file not found(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, version.GetID(), id)
+			logger.Error(ctx, "game file not found", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(version.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 			continue
 		}
 
 		switch gameFile.GetFileType() {
 		case values.GameFileTypeWindows:
 			if _, ok := assets.Windows.Value(); ok {
-				log.Printf("error: duplicate file type windows(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, version.GetID(), id)
+				logger.Error(ctx, "duplicate file type windows", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(version.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 				continue
 			}
 
 			assets.Windows = option.NewOption(gameFile.GetID())
 		case values.GameFileTypeMac:
 			if _, ok := assets.Mac.Value(); ok {
-				log.Printf("error: duplicate file type mac(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, version.GetID(), id)
+				logger.Error(ctx, "duplicate file type mac", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(version.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 				continue
 			}
 
 			assets.Mac = option.NewOption(gameFile.GetID())
 		case values.GameFileTypeJar:
 			if _, ok := assets.Jar.Value(); ok {
-				log.Printf("error: duplicate file type jar(game_id=%s, game_version_id=%s, game_file_id=%s)\n", gameID, version.GetID(), id)
+				logger.Error(ctx, "duplicate file type jar", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(version.GetID())), slog.Any("game_file_id", uuid.UUID(id)))
 				continue
 			}
 
 			assets.Jar = option.NewOption(gameFile.GetID())
 		default:
-			log.Printf("invalid game file type: game_id=%s, game_version_id=%s, game_file_id=%s, file_type=%d\n", gameID, version.GetID(), id, gameFile.GetFileType())
+			logger.Error(ctx, "invalid game file type", slog.Any("game_id", uuid.UUID(gameID)), slog.Any("game_version_id", uuid.UUID(version.GetID())), slog.Any("game_file_id", uuid.UUID(id)), slog.Any("file_type", gameFile.GetFileType()))
 			continue
 		}
 	}
@@ -347,3 +377,177 @@ func (gameVersion *GameVersion) GetLatestGameVersion(ctx context.Context, gameID
 		VideoID:     version.VideoID,
 	}, nil
 }
+
+// assetTmpURLExpires
+// GetGameVersionAssetsで返す一時的なurlの有効期間。
+// 個別のアセットの取得と同じにする。
+const assetTmpURLExpires = time.Minute
+
+func (gameVersion *GameVersion) GetGameVersionAssets(ctx context.Context, gameID values.GameID, gameVersionID values.GameVersionID) (*service.GameVersionAssets, error) {
+	ctx, span := tracer.Start(ctx, "GameVersion.GetGameVersionAssets")
+	defer span.End()
+
+	_, err := gameVersion.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	version, err := gameVersion.gameVersionRepository.GetGameVersionByID(ctx, gameVersionID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameVersionID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game version: %w", err)
+	}
+
+	if version.GameID != gameID {
+		// 他のゲームのゲームバージョンIDを知ることができないよう、存在しない場合と同じエラーを返す
+		return nil, service.ErrInvalidGameVersionID
+	}
+
+	return gameVersion.getGameVersionAssets(ctx, version, false)
+}
+
+func (gameVersion *GameVersion) GetEditionGameVersionAssets(ctx context.Context, editionID values.EditionID, gameID values.GameID, gameVersionID values.GameVersionID) (*service.GameVersionAssets, error) {
+	ctx, span := tracer.Start(ctx, "GameVersion.GetEditionGameVersionAssets")
+	defer span.End()
+
+	// エディションには、ゲームごとに1つのゲームバージョンが含まれる
+	editionGameVersion, err := gameVersion.editionRepository.GetEditionGameVersionByGameID(ctx, editionID, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameVersionID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edition game version: %w", err)
+	}
+
+	if editionGameVersion.GetID() != gameVersionID {
+		return nil, service.ErrInvalidGameVersionID
+	}
+
+	version, err := gameVersion.gameVersionRepository.GetGameVersionByID(ctx, gameVersionID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameVersionID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game version: %w", err)
+	}
+
+	// ランチャーには、検査を通過したファイルのみ配布する
+	return gameVersion.getGameVersionAssets(ctx, version, true)
+}
+
+// getGameVersionAssets
+// ゲームバージョンに紐づくアセットのメタデータを取得し、一時的なurlを種類ごとにまとめて生成する。
+// onlyCleanがtrueの場合、検査を通過していないファイルは含めない。
+func (gameVersion *GameVersion) getGameVersionAssets(ctx context.Context, version *repository.GameVersionInfoWithGameID, onlyClean bool) (*service.GameVersionAssets, error) {
+	image, err := gameVersion.gameImageRepository.GetGameImage(ctx, version.ImageID, repository.LockTypeNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game image: %w", err)
+	}
+
+	video, err := gameVersion.gameVideoRepository.GetGameVideo(ctx, version.VideoID, repository.LockTypeNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game video: %w", err)
+	}
+
+	var files []*domain.GameFile
+	if len(version.FileIDs) != 0 {
+		fileInfos, err := gameVersion.gameFileRepository.GetGameFilesWithoutTypes(ctx, version.FileIDs, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game files: %w", err)
+		}
+
+		files = make([]*domain.GameFile, 0, len(fileInfos))
+		for _, fileInfo := range fileInfos {
+			if onlyClean && fileInfo.GetScanStatus() != values.GameFileScanStatusClean {
+				continue
+			}
+
+			files = append(files, fileInfo.GameFile)
+		}
+	}
+
+	expiresAt := time.Now().Add(assetTmpURLExpires)
+
+	var (
+		imageURLs []values.GameImageTmpURL
+		videoURLs []values.GameVideoTmpURL
+		posterURL service.OptionGameVideoPosterTmpURL
+		fileURLs  []values.GameFileTmpURL
+	)
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var err error
+		imageURLs, err = gameVersion.gameImageStorage.GetTempURLs(egCtx, []*domain.GameImage{image.GameImage}, assetTmpURLExpires)
+		if err != nil {
+			return fmt.Errorf("failed to get game image temp url: %w", err)
+		}
+
+		return nil
+	})
+	eg.Go(func() error {
+		var err error
+		videoURLs, err = gameVersion.gameVideoStorage.GetTempURLs(egCtx, []*domain.GameVideo{video.GameVideo}, assetTmpURLExpires)
+		if err != nil {
+			return fmt.Errorf("failed to get game video temp url: %w", err)
+		}
+
+		return nil
+	})
+	if _, ok := video.GetPosterType().Value(); ok {
+		eg.Go(func() error {
+			url, err := gameVersion.gameVideoStorage.GetPosterTempURL(egCtx, video.GameVideo, assetTmpURLExpires)
+			if err != nil {
+				return fmt.Errorf("failed to get game video poster temp url: %w", err)
+			}
+
+			posterURL = option.NewOption(url)
+
+			return nil
+		})
+	}
+	if len(files) != 0 {
+		eg.Go(func() error {
+			var err error
+			fileURLs, err = gameVersion.gameFileStorage.GetTempURLs(egCtx, files, assetTmpURLExpires)
+			if err != nil {
+				return fmt.Errorf("failed to get game file temp urls: %w", err)
+			}
+
+			return nil
+		})
+	}
+
+	err = eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	fileAssets := make([]*service.GameFileAsset, 0, len(files))
+	for i, file := range files {
+		fileAssets = append(fileAssets, &service.GameFileAsset{
+			GameFile: file,
+			TmpURL:   fileURLs[i],
+		})
+	}
+
+	return &service.GameVersionAssets{
+		GameVersion: version.GameVersion,
+		Image: &service.GameImageAsset{
+			GameImage: image.GameImage,
+			TmpURL:    imageURLs[0],
+		},
+		Video: &service.GameVideoAsset{
+			GameVideo:    video.GameVideo,
+			TmpURL:       videoURLs[0],
+			PosterTmpURL: posterURL,
+		},
+		Files:     fileAssets,
+		URL:       version.URL,
+		ExpiresAt: expiresAt,
+	}, nil
+}
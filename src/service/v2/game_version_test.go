@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
@@ -14,6 +15,7 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
 	"github.com/traPtitech/trap-collection-server/src/service"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
 	"go.uber.org/mock/gomock"
 )
 
@@ -31,6 +33,10 @@ func TestCreateGameVersion(t *testing.T) {
 	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+	mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+	mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
 	gameVersionService := NewGameVersion(
 		mockDB,
@@ -39,8 +45,23 @@ func TestCreateGameVersion(t *testing.T) {
 		mockGameVideoRepository,
 		mockGameFileRepository,
 		mockGameVersionRepository,
+		mockEditionRepository,
+		mockGameImageStorage,
+		mockGameVideoStorage,
+		mockGameFileStorage,
 	)
 
+	// 検査を通過したゲームファイル
+	cleanGameFile := func(file *domain.GameFile) *domain.GameFile {
+		file.SetScanStatus(values.GameFileScanStatusClean)
+		return file
+	}
+	// 検査で問題が見つかったゲームファイル
+	rejectedGameFile := func(file *domain.GameFile) *domain.GameFile {
+		file.SetScanStatus(values.GameFileScanStatusRejected)
+		return file
+	}
+
 	type test struct {
 		description              string
 		gameID                   values.GameID
@@ -94,6 +115,8 @@ func TestCreateGameVersion(t *testing.T) {
 	gameID18 := values.NewGameID()
 	gameID19 := values.NewGameID()
 	gameID20 := values.NewGameID()
+	gameID21 := values.NewGameID()
+	gameID22 := values.NewGameID()
 
 	imageID1 := values.NewGameImageID()
 	imageID2 := values.NewGameImageID()
@@ -116,6 +139,8 @@ func TestCreateGameVersion(t *testing.T) {
 	imageID19 := values.NewGameImageID()
 	imageID20 := values.NewGameImageID()
 	imageID21 := values.NewGameImageID()
+	imageID22 := values.NewGameImageID()
+	imageID23 := values.NewGameImageID()
 
 	videoID1 := values.NewGameVideoID()
 	videoID2 := values.NewGameVideoID()
@@ -138,6 +163,8 @@ func TestCreateGameVersion(t *testing.T) {
 	videoID19 := values.NewGameVideoID()
 	videoID20 := values.NewGameVideoID()
 	videoID21 := values.NewGameVideoID()
+	videoID22 := values.NewGameVideoID()
+	videoID23 := values.NewGameVideoID()
 
 	fileID1 := values.NewGameFileID()
 	fileID2 := values.NewGameFileID()
@@ -149,6 +176,8 @@ func TestCreateGameVersion(t *testing.T) {
 	fileID8 := values.NewGameFileID()
 	fileID9 := values.NewGameFileID()
 	fileID10 := values.NewGameFileID()
+	fileID11 := values.NewGameFileID()
+	fileID12 := values.NewGameFileID()
 
 	now := time.Now()
 
@@ -217,13 +246,13 @@ func TestCreateGameVersion(t *testing.T) {
 			executeGetGameFile: true,
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID1,
 						values.GameFileTypeWindows,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID2,
 				},
 			},
@@ -262,13 +291,13 @@ func TestCreateGameVersion(t *testing.T) {
 			executeGetGameFile: true,
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID2,
 						values.GameFileTypeMac,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID3,
 				},
 			},
@@ -307,13 +336,13 @@ func TestCreateGameVersion(t *testing.T) {
 			executeGetGameFile: true,
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID3,
 						values.GameFileTypeJar,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID4,
 				},
 			},
@@ -597,19 +626,111 @@ func TestCreateGameVersion(t *testing.T) {
 			fileIDs:            []values.GameFileID{fileID6},
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID6,
 						values.GameFileTypeMac,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID15,
 				},
 			},
 			isErr: true,
 			err:   service.ErrInvalidGameFileType,
 		},
+		{
+			description:        "ファイルが未検査なのでErrGameFileNotClean",
+			gameID:             gameID21,
+			versionName:        values.NewGameVersionName("v1.0.0"),
+			versionDescription: values.NewGameVersionDescription("おいす〜"),
+			imageID:            imageID22,
+			videoID:            videoID22,
+			assets: &service.Assets{
+				Windows: option.NewOption(fileID11),
+			},
+			executeGetGame:      true,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					imageID22,
+					values.GameImageTypeJpeg,
+					now,
+				),
+				GameID: gameID21,
+			},
+			executeGetGameVideo: true,
+			video: &repository.GameVideoInfo{
+				GameVideo: domain.NewGameVideo(
+					videoID22,
+					values.GameVideoTypeMp4,
+					now,
+				),
+				GameID: gameID21,
+			},
+			executeGetGameFile: true,
+			fileIDs:            []values.GameFileID{fileID11},
+			files: []*repository.GameFileInfo{
+				{
+					GameFile: domain.NewGameFile(
+						fileID11,
+						values.GameFileTypeWindows,
+						values.NewGameFileEntryPoint("/path/to/file"),
+						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+						now,
+					),
+					GameID: gameID21,
+				},
+			},
+			isErr: true,
+			err:   service.ErrGameFileNotClean,
+		},
+		{
+			description:        "ファイルが検査で問題ありとされたのでErrGameFileNotClean",
+			gameID:             gameID22,
+			versionName:        values.NewGameVersionName("v1.0.0"),
+			versionDescription: values.NewGameVersionDescription("おいす〜"),
+			imageID:            imageID23,
+			videoID:            videoID23,
+			assets: &service.Assets{
+				Windows: option.NewOption(fileID12),
+			},
+			executeGetGame:      true,
+			executeGetGameImage: true,
+			image: &repository.GameImageInfo{
+				GameImage: domain.NewGameImage(
+					imageID23,
+					values.GameImageTypeJpeg,
+					now,
+				),
+				GameID: gameID22,
+			},
+			executeGetGameVideo: true,
+			video: &repository.GameVideoInfo{
+				GameVideo: domain.NewGameVideo(
+					videoID23,
+					values.GameVideoTypeMp4,
+					now,
+				),
+				GameID: gameID22,
+			},
+			executeGetGameFile: true,
+			fileIDs:            []values.GameFileID{fileID12},
+			files: []*repository.GameFileInfo{
+				{
+					GameFile: rejectedGameFile(domain.NewGameFile(
+						fileID12,
+						values.GameFileTypeWindows,
+						values.NewGameFileEntryPoint("/path/to/file"),
+						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+						now,
+					)),
+					GameID: gameID22,
+				},
+			},
+			isErr: true,
+			err:   service.ErrGameFileNotClean,
+		},
 		{
 			description:        "CreateGameVersionがエラーなのでエラー",
 			gameID:             gameID16,
@@ -678,13 +799,13 @@ func TestCreateGameVersion(t *testing.T) {
 			fileIDs:            []values.GameFileID{fileID7},
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID7,
 						values.GameFileTypeWindows,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID17,
 				},
 			},
@@ -723,23 +844,23 @@ func TestCreateGameVersion(t *testing.T) {
 			executeGetGameFile: true,
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID8,
 						values.GameFileTypeWindows,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID18,
 				},
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID9,
 						values.GameFileTypeMac,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: gameID18,
 				},
 			},
@@ -779,13 +900,13 @@ func TestCreateGameVersion(t *testing.T) {
 			fileIDs:            []values.GameFileID{fileID10},
 			files: []*repository.GameFileInfo{
 				{
-					GameFile: domain.NewGameFile(
+					GameFile: cleanGameFile(domain.NewGameFile(
 						fileID10,
 						values.GameFileTypeWindows,
 						values.NewGameFileEntryPoint("/path/to/file"),
 						values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
 						now,
-					),
+					)),
 					GameID: values.NewGameID(),
 				},
 			},
@@ -928,6 +1049,10 @@ func TestGetGameVersions(t *testing.T) {
 	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+	mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+	mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
 	gameVersionService := NewGameVersion(
 		mockDB,
@@ -936,6 +1061,10 @@ func TestGetGameVersions(t *testing.T) {
 		mockGameVideoRepository,
 		mockGameFileRepository,
 		mockGameVersionRepository,
+		mockEditionRepository,
+		mockGameImageStorage,
+		mockGameVideoStorage,
+		mockGameFileStorage,
 	)
 
 	type test struct {
@@ -1945,6 +2074,10 @@ func TestGetLatestGameVersion(t *testing.T) {
 	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
 	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
 	mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+	mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+	mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
 
 	gameVersionService := NewGameVersion(
 		mockDB,
@@ -1953,6 +2086,10 @@ func TestGetLatestGameVersion(t *testing.T) {
 		mockGameVideoRepository,
 		mockGameFileRepository,
 		mockGameVersionRepository,
+		mockEditionRepository,
+		mockGameImageStorage,
+		mockGameVideoStorage,
+		mockGameFileStorage,
 	)
 
 	type test struct {
@@ -2505,3 +2642,481 @@ func TestGetLatestGameVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGameVersionAssets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+	mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+	mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+	mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
+
+	gameVersionService := NewGameVersion(
+		mockDB,
+		mockGameRepository,
+		mockGameImageRepository,
+		mockGameVideoRepository,
+		mockGameFileRepository,
+		mockGameVersionRepository,
+		mockEditionRepository,
+		mockGameImageStorage,
+		mockGameVideoStorage,
+		mockGameFileStorage,
+	)
+
+	type test struct {
+		description                string
+		gameID                     values.GameID
+		gameVersionID              values.GameVersionID
+		getGameErr                 error
+		executeGetGameVersionByID  bool
+		version                    *repository.GameVersionInfoWithGameID
+		getGameVersionByIDErr      error
+		executeGetAssets           bool
+		image                      *repository.GameImageInfo
+		video                      *repository.GameVideoInfo
+		executeGetGameFiles        bool
+		files                      []*repository.GameFileInfo
+		executeGetPosterTempURL    bool
+		executeGetFileTempURLs     bool
+		getFileTempURLsErr         error
+		expectedFiles              []*domain.GameFile
+		expectedPosterTmpURLExists bool
+		isErr                      bool
+		err                        error
+	}
+
+	now := time.Now()
+
+	gameID := values.NewGameID()
+	versionID := values.NewGameVersionID()
+	imageID := values.NewGameImageID()
+	videoID := values.NewGameVideoID()
+	fileID1 := values.NewGameFileID()
+	fileID2 := values.NewGameFileID()
+
+	image := domain.NewGameImage(imageID, values.GameImageTypePng, now)
+	video := domain.NewGameVideo(videoID, values.GameVideoTypeMp4, now)
+	posterVideo := domain.NewGameVideo(videoID, values.GameVideoTypeMp4, now)
+	posterVideo.SetPosterType(values.GameImageTypeJpeg)
+	file1 := domain.NewGameFile(fileID1, values.GameFileTypeJar, "/path/to/game.jar", values.NewGameFileHashFromBytes([]byte("hash1")), now)
+	file2 := domain.NewGameFile(fileID2, values.GameFileTypeWindows, "/path/to/game.exe", values.NewGameFileHashFromBytes([]byte("hash2")), now)
+
+	newVersion := func(gameID values.GameID, fileIDs ...values.GameFileID) *repository.GameVersionInfoWithGameID {
+		return &repository.GameVersionInfoWithGameID{
+			GameVersion: domain.NewGameVersion(versionID, "v1.0.0", "description", now),
+			GameID:      gameID,
+			ImageID:     imageID,
+			VideoID:     videoID,
+			FileIDs:     fileIDs,
+		}
+	}
+
+	testCases := []test{
+		{
+			description:               "ファイルが無くても問題ないのでエラーなし",
+			gameID:                    gameID,
+			gameVersionID:             versionID,
+			executeGetGameVersionByID: true,
+			version:                   newVersion(gameID),
+			executeGetAssets:          true,
+			image:                     &repository.GameImageInfo{GameImage: image, GameID: gameID},
+			video:                     &repository.GameVideoInfo{GameVideo: video, GameID: gameID},
+			expectedFiles:             []*domain.GameFile{},
+		},
+		{
+			description:                "ファイルとポスター画像があるのでそれらの一時URLも含まれる",
+			gameID:                     gameID,
+			gameVersionID:              versionID,
+			executeGetGameVersionByID:  true,
+			version:                    newVersion(gameID, fileID1, fileID2),
+			executeGetAssets:           true,
+			image:                      &repository.GameImageInfo{GameImage: image, GameID: gameID},
+			video:                      &repository.GameVideoInfo{GameVideo: posterVideo, GameID: gameID},
+			executeGetGameFiles:        true,
+			files:                      []*repository.GameFileInfo{{GameFile: file1, GameID: gameID}, {GameFile: file2, GameID: gameID}},
+			executeGetPosterTempURL:    true,
+			executeGetFileTempURLs:     true,
+			expectedFiles:              []*domain.GameFile{file1, file2},
+			expectedPosterTmpURLExists: true,
+		},
+		{
+			description: "ゲームが存在しないのでErrInvalidGameID",
+			gameID:      gameID,
+			getGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrInvalidGameID,
+		},
+		{
+			description: "GetGameがエラーなのでエラー",
+			gameID:      gameID,
+			getGameErr:  errors.New("error"),
+			isErr:       true,
+		},
+		{
+			description:               "ゲームバージョンが存在しないのでErrInvalidGameVersionID",
+			gameID:                    gameID,
+			gameVersionID:             versionID,
+			executeGetGameVersionByID: true,
+			getGameVersionByIDErr:     repository.ErrRecordNotFound,
+			isErr:                     true,
+			err:                       service.ErrInvalidGameVersionID,
+		},
+		{
+			description:               "他のゲームのゲームバージョンなのでErrInvalidGameVersionID",
+			gameID:                    gameID,
+			gameVersionID:             versionID,
+			executeGetGameVersionByID: true,
+			version:                   newVersion(values.NewGameID()),
+			isErr:                     true,
+			err:                       service.ErrInvalidGameVersionID,
+		},
+		{
+			description:               "ファイルの一時URLの取得に失敗したのでエラー",
+			gameID:                    gameID,
+			gameVersionID:             versionID,
+			executeGetGameVersionByID: true,
+			version:                   newVersion(gameID, fileID1),
+			executeGetAssets:          true,
+			image:                     &repository.GameImageInfo{GameImage: image, GameID: gameID},
+			video:                     &repository.GameVideoInfo{GameVideo: video, GameID: gameID},
+			executeGetGameFiles:       true,
+			files:                     []*repository.GameFileInfo{{GameFile: file1, GameID: gameID}},
+			executeGetFileTempURLs:    true,
+			getFileTempURLsErr:        errors.New("error"),
+			isErr:                     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+				Return(nil, testCase.getGameErr)
+
+			if testCase.executeGetGameVersionByID {
+				mockGameVersionRepository.
+					EXPECT().
+					GetGameVersionByID(gomock.Any(), testCase.gameVersionID, repository.LockTypeNone).
+					Return(testCase.version, testCase.getGameVersionByIDErr)
+			}
+
+			imageURL, err := url.Parse("https://example.com/image")
+			if err != nil {
+				t.Fatalf("failed to parse url: %v", err)
+			}
+			videoURL, err := url.Parse("https://example.com/video")
+			if err != nil {
+				t.Fatalf("failed to parse url: %v", err)
+			}
+			posterURL, err := url.Parse("https://example.com/poster")
+			if err != nil {
+				t.Fatalf("failed to parse url: %v", err)
+			}
+
+			if testCase.executeGetAssets {
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImage(gomock.Any(), imageID, repository.LockTypeNone).
+					Return(testCase.image, nil)
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideo(gomock.Any(), videoID, repository.LockTypeNone).
+					Return(testCase.video, nil)
+
+				mockGameImageStorage.
+					EXPECT().
+					GetTempURLs(gomock.Any(), []*domain.GameImage{testCase.image.GameImage}, time.Minute).
+					Return([]values.GameImageTmpURL{values.NewGameImageTmpURL(imageURL)}, nil)
+				mockGameVideoStorage.
+					EXPECT().
+					GetTempURLs(gomock.Any(), []*domain.GameVideo{testCase.video.GameVideo}, time.Minute).
+					Return([]values.GameVideoTmpURL{values.NewGameVideoTmpURL(videoURL)}, nil)
+			}
+
+			if testCase.executeGetGameFiles {
+				mockGameFileRepository.
+					EXPECT().
+					GetGameFilesWithoutTypes(gomock.Any(), testCase.version.FileIDs, repository.LockTypeNone).
+					Return(testCase.files, nil)
+			}
+
+			if testCase.executeGetPosterTempURL {
+				mockGameVideoStorage.
+					EXPECT().
+					GetPosterTempURL(gomock.Any(), testCase.video.GameVideo, time.Minute).
+					Return(values.GameVideoPosterTmpURL(posterURL), nil)
+			}
+
+			if testCase.executeGetFileTempURLs {
+				var fileURLs []values.GameFileTmpURL
+				if testCase.getFileTempURLsErr == nil {
+					for _, file := range testCase.expectedFiles {
+						fileURL, err := url.Parse("https://example.com/" + uuid.UUID(file.GetID()).String())
+						if err != nil {
+							t.Fatalf("failed to parse url: %v", err)
+						}
+						fileURLs = append(fileURLs, values.NewGameFileTmpURL(fileURL))
+					}
+				}
+
+				var files []*domain.GameFile
+				for _, file := range testCase.files {
+					files = append(files, file.GameFile)
+				}
+
+				mockGameFileStorage.
+					EXPECT().
+					GetTempURLs(gomock.Any(), files, time.Minute).
+					Return(fileURLs, testCase.getFileTempURLsErr)
+			}
+
+			assets, err := gameVersionService.GetGameVersionAssets(ctx, testCase.gameID, testCase.gameVersionID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, testCase.version.GameVersion, assets.GameVersion)
+			assert.Equal(t, testCase.image.GameImage, assets.Image.GameImage)
+			assert.Equal(t, imageURL, (*url.URL)(assets.Image.TmpURL))
+			assert.Equal(t, testCase.video.GameVideo, assets.Video.GameVideo)
+			assert.Equal(t, videoURL, (*url.URL)(assets.Video.TmpURL))
+			if testCase.expectedPosterTmpURLExists {
+				actualPosterURL, ok := assets.Video.PosterTmpURL.Value()
+				if assert.True(t, ok) {
+					assert.Equal(t, posterURL, (*url.URL)(actualPosterURL))
+				}
+			} else {
+				_, ok := assets.Video.PosterTmpURL.Value()
+				assert.False(t, ok)
+			}
+			if assert.Len(t, assets.Files, len(testCase.expectedFiles)) {
+				for i, file := range testCase.expectedFiles {
+					assert.Equal(t, file, assets.Files[i].GameFile)
+					assert.Equal(t, "https://example.com/"+uuid.UUID(file.GetID()).String(), (*url.URL)(assets.Files[i].TmpURL).String())
+				}
+			}
+			assert.WithinDuration(t, time.Now().Add(time.Minute), assets.ExpiresAt, 2*time.Second)
+		})
+	}
+}
+
+func TestGetEditionGameVersionAssets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+	mockGameVersionRepository := mockRepository.NewMockGameVersionV2(ctrl)
+	mockEditionRepository := mockRepository.NewMockEdition(ctrl)
+	mockGameImageStorage := mockStorage.NewGameImage(ctrl, nil)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+	mockGameFileStorage := mockStorage.NewGameFile(ctrl, nil)
+
+	gameVersionService := NewGameVersion(
+		mockDB,
+		mockGameRepository,
+		mockGameImageRepository,
+		mockGameVideoRepository,
+		mockGameFileRepository,
+		mockGameVersionRepository,
+		mockEditionRepository,
+		mockGameImageStorage,
+		mockGameVideoStorage,
+		mockGameFileStorage,
+	)
+
+	type test struct {
+		description                      string
+		gameVersionID                    values.GameVersionID
+		editionGameVersion               *domain.GameVersion
+		getEditionGameVersionByGameIDErr error
+		executeGetAssets                 bool
+		files                            []*repository.GameFileInfo
+		expectedFiles                    []*domain.GameFile
+		isErr                            bool
+		err                              error
+	}
+
+	now := time.Now()
+
+	editionID := values.NewEditionID()
+	gameID := values.NewGameID()
+	versionID := values.NewGameVersionID()
+	imageID := values.NewGameImageID()
+	videoID := values.NewGameVideoID()
+	fileID1 := values.NewGameFileID()
+	fileID2 := values.NewGameFileID()
+	fileID3 := values.NewGameFileID()
+
+	gameVersion := domain.NewGameVersion(versionID, "v1.0.0", "description", now)
+	image := domain.NewGameImage(imageID, values.GameImageTypePng, now)
+	video := domain.NewGameVideo(videoID, values.GameVideoTypeMp4, now)
+
+	cleanFile := domain.NewGameFile(fileID1, values.GameFileTypeJar, "/path/to/game.jar", values.NewGameFileHashFromBytes([]byte("hash1")), now)
+	cleanFile.SetScanStatus(values.GameFileScanStatusClean)
+	pendingFile := domain.NewGameFile(fileID2, values.GameFileTypeWindows, "/path/to/game.exe", values.NewGameFileHashFromBytes([]byte("hash2")), now)
+	rejectedFile := domain.NewGameFile(fileID3, values.GameFileTypeMac, "/path/to/game.app", values.NewGameFileHashFromBytes([]byte("hash3")), now)
+	rejectedFile.SetScanStatus(values.GameFileScanStatusRejected)
+
+	testCases := []test{
+		{
+			description:        "検査を通過したファイルのみ含まれる",
+			gameVersionID:      versionID,
+			editionGameVersion: gameVersion,
+			executeGetAssets:   true,
+			files: []*repository.GameFileInfo{
+				{GameFile: cleanFile, GameID: gameID},
+				{GameFile: pendingFile, GameID: gameID},
+				{GameFile: rejectedFile, GameID: gameID},
+			},
+			expectedFiles: []*domain.GameFile{cleanFile},
+		},
+		{
+			description:        "検査を通過したファイルが無くてもエラーなし",
+			gameVersionID:      versionID,
+			editionGameVersion: gameVersion,
+			executeGetAssets:   true,
+			files: []*repository.GameFileInfo{
+				{GameFile: pendingFile, GameID: gameID},
+			},
+			expectedFiles: []*domain.GameFile{},
+		},
+		{
+			description:                      "エディションにゲームが含まれないのでErrInvalidGameVersionID",
+			gameVersionID:                    versionID,
+			getEditionGameVersionByGameIDErr: repository.ErrRecordNotFound,
+			isErr:                            true,
+			err:                              service.ErrInvalidGameVersionID,
+		},
+		{
+			description:                      "GetEditionGameVersionByGameIDがエラーなのでエラー",
+			gameVersionID:                    versionID,
+			getEditionGameVersionByGameIDErr: errors.New("error"),
+			isErr:                            true,
+		},
+		{
+			description:        "エディションに含まれるゲームバージョンと異なるのでErrInvalidGameVersionID",
+			gameVersionID:      values.NewGameVersionID(),
+			editionGameVersion: gameVersion,
+			isErr:              true,
+			err:                service.ErrInvalidGameVersionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockEditionRepository.
+				EXPECT().
+				GetEditionGameVersionByGameID(gomock.Any(), editionID, gameID, repository.LockTypeNone).
+				Return(testCase.editionGameVersion, testCase.getEditionGameVersionByGameIDErr)
+
+			tmpURL, err := url.Parse("https://example.com")
+			if err != nil {
+				t.Fatalf("failed to parse url: %v", err)
+			}
+
+			if testCase.executeGetAssets {
+				fileIDs := make([]values.GameFileID, 0, len(testCase.files))
+				for _, file := range testCase.files {
+					fileIDs = append(fileIDs, file.GetID())
+				}
+
+				mockGameVersionRepository.
+					EXPECT().
+					GetGameVersionByID(gomock.Any(), testCase.gameVersionID, repository.LockTypeNone).
+					Return(&repository.GameVersionInfoWithGameID{
+						GameVersion: gameVersion,
+						GameID:      gameID,
+						ImageID:     imageID,
+						VideoID:     videoID,
+						FileIDs:     fileIDs,
+					}, nil)
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImage(gomock.Any(), imageID, repository.LockTypeNone).
+					Return(&repository.GameImageInfo{GameImage: image, GameID: gameID}, nil)
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideo(gomock.Any(), videoID, repository.LockTypeNone).
+					Return(&repository.GameVideoInfo{GameVideo: video, GameID: gameID}, nil)
+				mockGameFileRepository.
+					EXPECT().
+					GetGameFilesWithoutTypes(gomock.Any(), fileIDs, repository.LockTypeNone).
+					Return(testCase.files, nil)
+
+				mockGameImageStorage.
+					EXPECT().
+					GetTempURLs(gomock.Any(), []*domain.GameImage{image}, time.Minute).
+					Return([]values.GameImageTmpURL{values.NewGameImageTmpURL(tmpURL)}, nil)
+				mockGameVideoStorage.
+					EXPECT().
+					GetTempURLs(gomock.Any(), []*domain.GameVideo{video}, time.Minute).
+					Return([]values.GameVideoTmpURL{values.NewGameVideoTmpURL(tmpURL)}, nil)
+
+				if len(testCase.expectedFiles) != 0 {
+					fileURLs := make([]values.GameFileTmpURL, 0, len(testCase.expectedFiles))
+					for range testCase.expectedFiles {
+						fileURLs = append(fileURLs, values.NewGameFileTmpURL(tmpURL))
+					}
+
+					mockGameFileStorage.
+						EXPECT().
+						GetTempURLs(gomock.Any(), testCase.expectedFiles, time.Minute).
+						Return(fileURLs, nil)
+				}
+			}
+
+			assets, err := gameVersionService.GetEditionGameVersionAssets(ctx, editionID, gameID, testCase.gameVersionID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, gameVersion, assets.GameVersion)
+			if assert.Len(t, assets.Files, len(testCase.expectedFiles)) {
+				for i, file := range testCase.expectedFiles {
+					assert.Equal(t, file, assets.Files[i].GameFile)
+				}
+			}
+		})
+	}
+}
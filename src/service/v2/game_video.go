@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,11 +21,17 @@ import (
 
 var _ service.GameVideoV2 = &GameVideo{}
 
+// gameVideoHeadSize
+// 動画の種類の判定に使う先頭部分のサイズ。
+// filetypeが判定に使うサイズに合わせている。
+const gameVideoHeadSize = 8192
+
 type GameVideo struct {
 	db                  repository.DB
 	gameRepository      repository.GameV2
 	gameVideoRepository repository.GameVideoV2
 	gameVideoStorage    storage.GameVideo
+	gameStorage         *GameStorage
 }
 
 func NewGameVideo(
@@ -32,16 +39,21 @@ func NewGameVideo(
 	gameRepository repository.GameV2,
 	gameVideoRepository repository.GameVideoV2,
 	gameVideoStorage storage.GameVideo,
+	gameStorage *GameStorage,
 ) *GameVideo {
 	return &GameVideo{
 		db:                  db,
 		gameRepository:      gameRepository,
 		gameVideoRepository: gameVideoRepository,
 		gameVideoStorage:    gameVideoStorage,
+		gameStorage:         gameStorage,
 	}
 }
 
 func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, gameID values.GameID) (*domain.GameVideo, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideo")
+	defer span.End()
+
 	var video *domain.GameVideo
 	err := gameVideo.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeRecord)
@@ -52,23 +64,35 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 			return fmt.Errorf("failed to get game: %w", err)
 		}
 
+		quotaReader, err := gameVideo.gameStorage.newQuotaReader(ctx, gameID, reader)
+		if err != nil {
+			return fmt.Errorf("failed to create quota reader: %w", err)
+		}
+
 		videoID := values.NewGameVideoID()
 
-		eg, ctx := errgroup.WithContext(ctx)
+		// eg.Wait後もトランザクションを使うので、ctxを上書きしない
+		eg, egCtx := errgroup.WithContext(ctx)
 		fileTypePr, fileTypePw := io.Pipe()
 		filePr, filePw := io.Pipe()
 
-		eg.Go(func() error {
-			defer fileTypePr.Close()
-
-			fType, err := filetype.MatchReader(fileTypePr)
-			if err != nil {
-				return fmt.Errorf("failed to get file type: %w", err)
+		eg.Go(func() (err error) {
+			// 判定に失敗した場合、コピー・保存側でも同じエラーで失敗するようにする
+			defer func() {
+				fileTypePr.CloseWithError(err)
+			}()
+
+			// 種類の判定に使った先頭部分も、メタデータの読み取りで使う
+			head := make([]byte, gameVideoHeadSize)
+			n, err := io.ReadFull(fileTypePr, head)
+			if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return fmt.Errorf("failed to read file head: %w", err)
 			}
+			head = head[:n]
 
-			_, err = io.ReadAll(fileTypePr)
+			fType, err := filetype.Match(head)
 			if err != nil {
-				return fmt.Errorf("failed to read file type: %w", err)
+				return fmt.Errorf("failed to get file type: %w", err)
 			}
 
 			var videoType values.GameVideoType
@@ -83,16 +107,23 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 				return service.ErrInvalidFormat
 			}
 
+			metadata, err := parseGameVideoMetadata(io.MultiReader(bytes.NewReader(head), fileTypePr), videoType)
+			if errors.Is(err, errUnsupportedGameVideoCodec) {
+				return fmt.Errorf("%w: %w", service.ErrUnsupportedGameVideoCodec, err)
+			}
+			if errors.Is(err, errInvalidGameVideoContainer) {
+				return fmt.Errorf("%w: %w", service.ErrInvalidFormat, err)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to parse game video metadata: %w", err)
+			}
+
 			video = domain.NewGameVideo(
 				videoID,
 				videoType,
 				time.Now(),
 			)
-
-			err = gameVideo.gameVideoRepository.SaveGameVideo(ctx, gameID, video)
-			if err != nil {
-				return fmt.Errorf("failed to save game video: %w", err)
-			}
+			video.SetMetadata(metadata)
 
 			return nil
 		})
@@ -100,7 +131,7 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 		eg.Go(func() error {
 			defer filePr.Close()
 
-			err = gameVideo.gameVideoStorage.SaveGameVideo(ctx, filePr, videoID)
+			err := gameVideo.gameVideoStorage.SaveGameVideo(egCtx, filePr, videoID)
 			if err != nil {
 				return fmt.Errorf("failed to save game video file: %w", err)
 			}
@@ -109,11 +140,11 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 		})
 
 		eg.Go(func() error {
-			defer filePw.Close()
-			defer fileTypePw.Close()
-
 			mw := io.MultiWriter(fileTypePw, filePw)
-			_, err = io.Copy(mw, reader)
+			_, err := io.Copy(mw, quotaReader)
+			// 途中で失敗した場合に、途中までの内容がストレージに保存されないようにエラーを伝える
+			fileTypePw.CloseWithError(err)
+			filePw.CloseWithError(err)
 			if err != nil {
 				return fmt.Errorf("failed to copy video: %w", err)
 			}
@@ -126,6 +157,14 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 			return fmt.Errorf("failed to save game video: %w", err)
 		}
 
+		// 容量は全て読み込むまで分からないので、ストレージへの保存後にメタデータを保存する
+		video.SetSize(quotaReader.Size())
+
+		err = gameVideo.gameVideoRepository.SaveGameVideo(ctx, gameID, video)
+		if err != nil {
+			return fmt.Errorf("failed to save game video: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -136,6 +175,9 @@ func (gameVideo *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader,
 }
 
 func (gameVideo *GameVideo) GetGameVideos(ctx context.Context, gameID values.GameID) ([]*domain.GameVideo, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetGameVideos")
+	defer span.End()
+
 	_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -153,6 +195,9 @@ func (gameVideo *GameVideo) GetGameVideos(ctx context.Context, gameID values.Gam
 }
 
 func (gameVideo *GameVideo) GetGameVideo(ctx context.Context, gameID values.GameID, videoID values.GameVideoID) (values.GameVideoTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetGameVideo")
+	defer span.End()
+
 	_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -193,6 +238,9 @@ func (gameVideo *GameVideo) GetGameVideo(ctx context.Context, gameID values.Game
 }
 
 func (gameVideo *GameVideo) GetGameVideoMeta(ctx context.Context, gameID values.GameID, videoID values.GameVideoID) (*domain.GameVideo, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetGameVideoMeta")
+	defer span.End()
+
 	_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
 	if errors.Is(err, repository.ErrRecordNotFound) {
 		return nil, service.ErrInvalidGameID
@@ -218,3 +266,122 @@ func (gameVideo *GameVideo) GetGameVideoMeta(ctx context.Context, gameID values.
 
 	return video.GameVideo, nil
 }
+
+func (gameVideo *GameVideo) SaveGameVideoPoster(ctx context.Context, reader io.Reader, gameID values.GameID, videoID values.GameVideoID) (*domain.GameVideo, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideoPoster")
+	defer span.End()
+
+	_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	// 既存のポスター画像を上書きするので、保存前に画像の種類を確認する
+	head := make([]byte, gameVideoHeadSize)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("failed to read file head: %w", err)
+	}
+	head = head[:n]
+
+	fType, err := filetype.Match(head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file type: %w", err)
+	}
+
+	var posterType values.GameImageType
+	switch fType.Extension {
+	case matchers.TypeJpeg.Extension:
+		posterType = values.GameImageTypeJpeg
+	case matchers.TypePng.Extension:
+		posterType = values.GameImageTypePng
+	case matchers.TypeGif.Extension:
+		posterType = values.GameImageTypeGif
+	case matchers.TypeWebp.Extension:
+		posterType = values.GameImageTypeWebp
+	default:
+		return nil, service.ErrInvalidFormat
+	}
+
+	var video *domain.GameVideo
+	err = gameVideo.db.Transaction(ctx, nil, func(ctx context.Context) error {
+		videoInfo, err := gameVideo.gameVideoRepository.GetGameVideo(ctx, videoID, repository.LockTypeRecord)
+		if errors.Is(err, repository.ErrRecordNotFound) {
+			return service.ErrInvalidGameVideoID
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get game video: %w", err)
+		}
+
+		if videoInfo.GameID != gameID {
+			// 念の為閲覧権限がないゲームに紐づいた動画IDを知ることができないようにするため、
+			// 動画が存在しない場合と同じErrInvalidGameVideoIDを返す
+			return service.ErrInvalidGameVideoID
+		}
+
+		err = gameVideo.gameVideoRepository.UpdateGameVideoPosterType(ctx, videoID, posterType)
+		// 同じ種類の画像で上書きする場合はレコードが更新されない
+		if err != nil && !errors.Is(err, repository.ErrNoRecordUpdated) {
+			return fmt.Errorf("failed to update game video poster type: %w", err)
+		}
+
+		err = gameVideo.gameVideoStorage.SaveGameVideoPoster(ctx, io.MultiReader(bytes.NewReader(head), reader), videoID)
+		if err != nil {
+			return fmt.Errorf("failed to save game video poster: %w", err)
+		}
+
+		video = videoInfo.GameVideo
+		video.SetPosterType(posterType)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed in transaction: %w", err)
+	}
+
+	return video, nil
+}
+
+func (gameVideo *GameVideo) GetGameVideoPoster(ctx context.Context, gameID values.GameID, videoID values.GameVideoID) (values.GameVideoPosterTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetGameVideoPoster")
+	defer span.End()
+
+	_, err := gameVideo.gameRepository.GetGame(ctx, gameID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	video, err := gameVideo.gameVideoRepository.GetGameVideo(ctx, videoID, repository.LockTypeNone)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, service.ErrInvalidGameVideoID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game video: %w", err)
+	}
+
+	if video.GameID != gameID {
+		// 念の為閲覧権限がないゲームに紐づいた動画IDを知ることができないようにするため、
+		// 動画が存在しない場合と同じErrInvalidGameVideoIDを返す
+		return nil, service.ErrInvalidGameVideoID
+	}
+
+	if _, ok := video.GetPosterType().Value(); !ok {
+		return nil, service.ErrNoGameVideoPoster
+	}
+
+	url, err := gameVideo.gameVideoStorage.GetPosterTempURL(ctx, video.GameVideo, time.Minute)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, service.ErrNoGameVideoPoster
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game video poster: %w", err)
+	}
+
+	return url, nil
+}
@@ -0,0 +1,946 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// maxGameVideoHeaderSize
+// メモリ上に読み込むコンテナのヘッダー(mp4のmoov、MatroskaのInfo・Tracks)の最大サイズ。
+const maxGameVideoHeaderSize = 64 << 20
+
+var (
+	errInvalidGameVideoContainer = errors.New("invalid game video container")
+	errUnsupportedGameVideoCodec = errors.New("unsupported game video codec")
+)
+
+// gameVideoTrack
+// コンテナから読み取った、変換前のトラックの情報。
+type gameVideoTrack struct {
+	isVideo bool
+	codec   string
+	width   int
+	height  int
+}
+
+// parseGameVideoMetadata
+// 動画のコンテナを読み取り、長さ・解像度・コーデックを取得する。
+// readerは最後まで読み進めるため、mdatやClusterなどの巨大な要素はメモリに載せずに読み捨てる。
+// コンテナが不正な場合はerrInvalidGameVideoContainer、
+// ブラウザで再生できないコーデックが含まれる場合はerrUnsupportedGameVideoCodecを返す。
+func parseGameVideoMetadata(reader io.Reader, videoType values.GameVideoType) (*domain.GameVideoMetadata, error) {
+	var (
+		duration time.Duration
+		tracks   []gameVideoTrack
+		err      error
+	)
+	switch videoType {
+	case values.GameVideoTypeMp4, values.GameVideoTypeM4v:
+		duration, tracks, err = parseMP4(reader)
+	case values.GameVideoTypeMkv:
+		duration, tracks, err = parseMatroska(reader)
+	default:
+		return nil, fmt.Errorf("unknown video type: %d", videoType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Matroskaでは必要な要素を読み終えた時点で返ってくるので、残りを読み捨てる
+	_, err = io.Copy(io.Discard, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discard video: %w", err)
+	}
+
+	var (
+		hasVideo   bool
+		width      int
+		height     int
+		videoCodec values.GameVideoCodec
+		audioCodec option.Option[values.GameVideoAudioCodec]
+	)
+	for _, track := range tracks {
+		if track.isVideo {
+			codec, ok := gameVideoCodecs[track.codec]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", errUnsupportedGameVideoCodec, track.codec)
+			}
+
+			// 映像トラックが複数ある場合は最初のものを使う
+			if !hasVideo {
+				hasVideo = true
+				width = track.width
+				height = track.height
+				videoCodec = codec
+			}
+
+			continue
+		}
+
+		codec, ok := gameVideoAudioCodecs[track.codec]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errUnsupportedGameVideoCodec, track.codec)
+		}
+
+		if _, ok := audioCodec.Value(); !ok {
+			audioCodec = option.NewOption(codec)
+		}
+	}
+
+	if !hasVideo {
+		return nil, fmt.Errorf("%w: no video track", errInvalidGameVideoContainer)
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("%w: invalid resolution %dx%d", errInvalidGameVideoContainer, width, height)
+	}
+
+	return domain.NewGameVideoMetadata(duration, width, height, videoCodec, audioCodec), nil
+}
+
+// コーデック名はmp4ではサンプルエントリのfourcc(mp4aはesdsのObjectTypeIndicationで細分化)、
+// MatroskaではCodecIDを使う。
+var (
+	gameVideoCodecs = map[string]values.GameVideoCodec{
+		"avc1":            values.GameVideoCodecH264,
+		"avc3":            values.GameVideoCodecH264,
+		"vp08":            values.GameVideoCodecVP8,
+		"vp09":            values.GameVideoCodecVP9,
+		"av01":            values.GameVideoCodecAV1,
+		"V_MPEG4/ISO/AVC": values.GameVideoCodecH264,
+		"V_VP8":           values.GameVideoCodecVP8,
+		"V_VP9":           values.GameVideoCodecVP9,
+		"V_AV1":           values.GameVideoCodecAV1,
+	}
+	gameVideoAudioCodecs = map[string]values.GameVideoAudioCodec{
+		"mp4a.aac":  values.GameVideoAudioCodecAAC,
+		"mp4a.mp3":  values.GameVideoAudioCodecMP3,
+		".mp3":      values.GameVideoAudioCodecMP3,
+		"Opus":      values.GameVideoAudioCodecOpus,
+		"fLaC":      values.GameVideoAudioCodecFLAC,
+		"A_AAC":     values.GameVideoAudioCodecAAC,
+		"A_MPEG/L3": values.GameVideoAudioCodecMP3,
+		"A_OPUS":    values.GameVideoAudioCodecOpus,
+		"A_VORBIS":  values.GameVideoAudioCodecVorbis,
+		"A_FLAC":    values.GameVideoAudioCodecFLAC,
+	}
+)
+
+/*
+	mp4(ISO Base Media File Format)
+*/
+
+// parseMP4
+// トップレベルのboxを順に読み、moovのみをメモリに載せて解析する。
+// moovがファイルの末尾にある場合にも対応するため、最後まで読み進める。
+func parseMP4(reader io.Reader) (time.Duration, []gameVideoTrack, error) {
+	var (
+		foundMoov bool
+		duration  time.Duration
+		tracks    []gameVideoTrack
+	)
+	for {
+		boxType, bodySize, err := readMP4BoxHeader(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if boxType != "moov" {
+			if bodySize < 0 {
+				// サイズ0のboxはファイルの末尾まで続く
+				break
+			}
+
+			_, err = io.CopyN(io.Discard, reader, bodySize)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: failed to skip %s box: %w", errInvalidGameVideoContainer, boxType, err)
+			}
+
+			continue
+		}
+
+		if foundMoov {
+			return 0, nil, fmt.Errorf("%w: duplicate moov box", errInvalidGameVideoContainer)
+		}
+		if bodySize < 0 || bodySize > maxGameVideoHeaderSize {
+			return 0, nil, fmt.Errorf("%w: invalid moov box size", errInvalidGameVideoContainer)
+		}
+
+		moov := make([]byte, bodySize)
+		_, err = io.ReadFull(reader, moov)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: failed to read moov box: %w", errInvalidGameVideoContainer, err)
+		}
+
+		duration, tracks, err = parseMP4Moov(moov)
+		if err != nil {
+			return 0, nil, err
+		}
+		foundMoov = true
+	}
+
+	if !foundMoov {
+		return 0, nil, fmt.Errorf("%w: no moov box", errInvalidGameVideoContainer)
+	}
+
+	return duration, tracks, nil
+}
+
+// readMP4BoxHeader
+// boxのヘッダーを読み、boxの種類と中身のサイズを返す。
+// ファイルの末尾まで続くboxの場合、サイズは-1になる。
+// boxの境界でreaderが終わった場合はio.EOFを返す。
+func readMP4BoxHeader(reader io.Reader) (string, int64, error) {
+	var header [8]byte
+	_, err := io.ReadFull(reader, header[:])
+	if errors.Is(err, io.EOF) {
+		return "", 0, io.EOF
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: failed to read box header: %w", errInvalidGameVideoContainer, err)
+	}
+
+	size := uint64(binary.BigEndian.Uint32(header[:4]))
+	boxType := string(header[4:8])
+	headerSize := uint64(8)
+
+	switch size {
+	case 0:
+		return boxType, -1, nil
+	case 1:
+		var largeSize [8]byte
+		_, err := io.ReadFull(reader, largeSize[:])
+		if err != nil {
+			return "", 0, fmt.Errorf("%w: failed to read box large size: %w", errInvalidGameVideoContainer, err)
+		}
+		size = binary.BigEndian.Uint64(largeSize[:])
+		headerSize += 8
+	}
+
+	if size < headerSize || size-headerSize > math.MaxInt64 {
+		return "", 0, fmt.Errorf("%w: invalid %s box size", errInvalidGameVideoContainer, boxType)
+	}
+
+	return boxType, int64(size - headerSize), nil
+}
+
+// mp4Box
+// メモリ上に読み込んだbox。
+type mp4Box struct {
+	boxType string
+	body    []byte
+}
+
+// splitMP4Boxes
+// メモリ上のbox列を分割する。
+func splitMP4Boxes(data []byte) ([]mp4Box, error) {
+	boxes := []mp4Box{}
+	for len(data) > 0 {
+		boxType, bodySize, err := readMP4BoxHeader(bytes.NewReader(data))
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: truncated box header", errInvalidGameVideoContainer)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		headerSize := int64(8)
+		if binary.BigEndian.Uint32(data[:4]) == 1 {
+			headerSize = 16
+		}
+		if bodySize < 0 {
+			bodySize = int64(len(data)) - headerSize
+		}
+		if headerSize+bodySize > int64(len(data)) {
+			return nil, fmt.Errorf("%w: %s box overflows its parent", errInvalidGameVideoContainer, boxType)
+		}
+
+		boxes = append(boxes, mp4Box{
+			boxType: boxType,
+			body:    data[headerSize : headerSize+bodySize],
+		})
+		data = data[headerSize+bodySize:]
+	}
+
+	return boxes, nil
+}
+
+func parseMP4Moov(moov []byte) (time.Duration, []gameVideoTrack, error) {
+	boxes, err := splitMP4Boxes(moov)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		foundMvhd bool
+		duration  time.Duration
+		tracks    []gameVideoTrack
+	)
+	for _, box := range boxes {
+		switch box.boxType {
+		case "mvhd":
+			duration, err = parseMP4Mvhd(box.body)
+			if err != nil {
+				return 0, nil, err
+			}
+			foundMvhd = true
+		case "trak":
+			track, ok, err := parseMP4Trak(box.body)
+			if err != nil {
+				return 0, nil, err
+			}
+			if ok {
+				tracks = append(tracks, track)
+			}
+		}
+	}
+
+	if !foundMvhd {
+		return 0, nil, fmt.Errorf("%w: no mvhd box", errInvalidGameVideoContainer)
+	}
+
+	return duration, tracks, nil
+}
+
+func parseMP4Mvhd(body []byte) (time.Duration, error) {
+	if len(body) < 1 {
+		return 0, fmt.Errorf("%w: mvhd box is too short", errInvalidGameVideoContainer)
+	}
+
+	var timescale, duration uint64
+	switch body[0] {
+	case 0:
+		// version(1), flags(3), creation_time(4), modification_time(4), timescale(4), duration(4)
+		if len(body) < 20 {
+			return 0, fmt.Errorf("%w: mvhd box is too short", errInvalidGameVideoContainer)
+		}
+		timescale = uint64(binary.BigEndian.Uint32(body[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(body[16:20]))
+		if duration == math.MaxUint32 {
+			duration = 0
+		}
+	case 1:
+		// version(1), flags(3), creation_time(8), modification_time(8), timescale(4), duration(8)
+		if len(body) < 32 {
+			return 0, fmt.Errorf("%w: mvhd box is too short", errInvalidGameVideoContainer)
+		}
+		timescale = uint64(binary.BigEndian.Uint32(body[20:24]))
+		duration = binary.BigEndian.Uint64(body[24:32])
+		if duration == math.MaxUint64 {
+			duration = 0
+		}
+	default:
+		return 0, fmt.Errorf("%w: unknown mvhd version %d", errInvalidGameVideoContainer, body[0])
+	}
+
+	if timescale == 0 {
+		return 0, fmt.Errorf("%w: mvhd timescale is 0", errInvalidGameVideoContainer)
+	}
+
+	// fragmented mp4などで長さが不明な場合は0になる
+	seconds := duration / timescale
+	if seconds >= uint64(math.MaxInt64/time.Second) {
+		return 0, fmt.Errorf("%w: mvhd duration is too long", errInvalidGameVideoContainer)
+	}
+	// timescaleは32bitなので、余りに1e9を掛けてもオーバーフローしない
+	nanoseconds := duration % timescale * uint64(time.Second) / timescale
+
+	return time.Duration(seconds)*time.Second + time.Duration(nanoseconds), nil
+}
+
+// parseMP4Trak
+// trakを解析する。映像・音声以外のトラックの場合はfalseを返す。
+func parseMP4Trak(trak []byte) (gameVideoTrack, bool, error) {
+	boxes, err := splitMP4Boxes(trak)
+	if err != nil {
+		return gameVideoTrack{}, false, err
+	}
+
+	var (
+		track       gameVideoTrack
+		handlerType string
+		codec       string
+	)
+	for _, box := range boxes {
+		switch box.boxType {
+		case "tkhd":
+			track.width, track.height, err = parseMP4Tkhd(box.body)
+			if err != nil {
+				return gameVideoTrack{}, false, err
+			}
+		case "mdia":
+			handlerType, codec, err = parseMP4Mdia(box.body)
+			if err != nil {
+				return gameVideoTrack{}, false, err
+			}
+		}
+	}
+
+	switch handlerType {
+	case "vide":
+		track.isVideo = true
+	case "soun":
+		track.isVideo = false
+	default:
+		return gameVideoTrack{}, false, nil
+	}
+
+	if codec == "" {
+		return gameVideoTrack{}, false, fmt.Errorf("%w: no sample entry", errInvalidGameVideoContainer)
+	}
+	track.codec = codec
+
+	return track, true, nil
+}
+
+// parseMP4Tkhd
+// 表示サイズ(16.16の固定小数点数)を取得する。
+func parseMP4Tkhd(body []byte) (int, int, error) {
+	if len(body) < 1 {
+		return 0, 0, fmt.Errorf("%w: tkhd box is too short", errInvalidGameVideoContainer)
+	}
+
+	var offset int
+	switch body[0] {
+	case 0:
+		offset = 76
+	case 1:
+		offset = 88
+	default:
+		return 0, 0, fmt.Errorf("%w: unknown tkhd version %d", errInvalidGameVideoContainer, body[0])
+	}
+	if len(body) < offset+8 {
+		return 0, 0, fmt.Errorf("%w: tkhd box is too short", errInvalidGameVideoContainer)
+	}
+
+	width := int(binary.BigEndian.Uint32(body[offset:offset+4]) >> 16)
+	height := int(binary.BigEndian.Uint32(body[offset+4:offset+8]) >> 16)
+
+	return width, height, nil
+}
+
+// parseMP4Mdia
+// hdlrのhandler_typeと、stsdの最初のサンプルエントリのコーデック名を取得する。
+func parseMP4Mdia(mdia []byte) (string, string, error) {
+	boxes, err := splitMP4Boxes(mdia)
+	if err != nil {
+		return "", "", err
+	}
+
+	var handlerType, codec string
+	for _, box := range boxes {
+		switch box.boxType {
+		case "hdlr":
+			// version(1), flags(3), pre_defined(4), handler_type(4)
+			if len(box.body) < 12 {
+				return "", "", fmt.Errorf("%w: hdlr box is too short", errInvalidGameVideoContainer)
+			}
+			handlerType = string(box.body[8:12])
+		case "minf":
+			minfBoxes, err := splitMP4Boxes(box.body)
+			if err != nil {
+				return "", "", err
+			}
+
+			for _, minfBox := range minfBoxes {
+				if minfBox.boxType != "stbl" {
+					continue
+				}
+
+				stblBoxes, err := splitMP4Boxes(minfBox.body)
+				if err != nil {
+					return "", "", err
+				}
+
+				for _, stblBox := range stblBoxes {
+					if stblBox.boxType != "stsd" {
+						continue
+					}
+
+					codec, err = parseMP4Stsd(stblBox.body)
+					if err != nil {
+						return "", "", err
+					}
+				}
+			}
+		}
+	}
+
+	return handlerType, codec, nil
+}
+
+func parseMP4Stsd(stsd []byte) (string, error) {
+	// version(1), flags(3), entry_count(4)
+	if len(stsd) < 8 {
+		return "", fmt.Errorf("%w: stsd box is too short", errInvalidGameVideoContainer)
+	}
+
+	entries, err := splitMP4Boxes(stsd[8:])
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 0 {
+		return "", fmt.Errorf("%w: no sample entry", errInvalidGameVideoContainer)
+	}
+
+	entry := entries[0]
+	if entry.boxType != "mp4a" {
+		return entry.boxType, nil
+	}
+
+	// mp4aはAACとMP3のどちらもありうるので、esdsのObjectTypeIndicationで判別する
+	// AudioSampleEntryの固定長部分は28byte
+	if len(entry.body) < 28 {
+		return "", fmt.Errorf("%w: mp4a sample entry is too short", errInvalidGameVideoContainer)
+	}
+	children, err := splitMP4Boxes(entry.body[28:])
+	if err != nil {
+		return "", err
+	}
+
+	for _, child := range children {
+		if child.boxType != "esds" {
+			continue
+		}
+
+		objectType, err := parseMP4EsdsObjectType(child.body)
+		if err != nil {
+			return "", err
+		}
+
+		switch objectType {
+		case 0x40, 0x66, 0x67, 0x68:
+			return "mp4a.aac", nil
+		case 0x69, 0x6B:
+			return "mp4a.mp3", nil
+		default:
+			return fmt.Sprintf("mp4a.%02x", objectType), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: no esds box", errInvalidGameVideoContainer)
+}
+
+// parseMP4EsdsObjectType
+// ES_DescriptorのDecoderConfigDescriptorからObjectTypeIndicationを取得する。
+func parseMP4EsdsObjectType(esds []byte) (byte, error) {
+	// version(1), flags(3)
+	if len(esds) < 4 {
+		return 0, fmt.Errorf("%w: esds box is too short", errInvalidGameVideoContainer)
+	}
+	data := esds[4:]
+
+	readDescriptor := func(data []byte) (byte, []byte, error) {
+		if len(data) < 2 {
+			return 0, nil, fmt.Errorf("%w: descriptor is too short", errInvalidGameVideoContainer)
+		}
+		tag := data[0]
+		data = data[1:]
+
+		// サイズは1byteあたり7bitの可変長(最大4byte)
+		var size int
+		for i := 0; ; i++ {
+			if i >= 4 || len(data) == 0 {
+				return 0, nil, fmt.Errorf("%w: invalid descriptor size", errInvalidGameVideoContainer)
+			}
+			b := data[0]
+			data = data[1:]
+			size = size<<7 | int(b&0x7F)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		if size > len(data) {
+			return 0, nil, fmt.Errorf("%w: descriptor overflows esds box", errInvalidGameVideoContainer)
+		}
+
+		return tag, data[:size], nil
+	}
+
+	tag, esDescriptor, err := readDescriptor(data)
+	if err != nil {
+		return 0, err
+	}
+	if tag != 0x03 {
+		return 0, fmt.Errorf("%w: no ES_Descriptor", errInvalidGameVideoContainer)
+	}
+
+	// ES_ID(2), flags(1)
+	if len(esDescriptor) < 3 {
+		return 0, fmt.Errorf("%w: ES_Descriptor is too short", errInvalidGameVideoContainer)
+	}
+	flags := esDescriptor[2]
+	esDescriptor = esDescriptor[3:]
+	if flags&0x80 != 0 {
+		// dependsOn_ES_ID(2)
+		if len(esDescriptor) < 2 {
+			return 0, fmt.Errorf("%w: ES_Descriptor is too short", errInvalidGameVideoContainer)
+		}
+		esDescriptor = esDescriptor[2:]
+	}
+	if flags&0x40 != 0 {
+		// URLlength(1), URLstring(URLlength)
+		if len(esDescriptor) < 1 || len(esDescriptor) < 1+int(esDescriptor[0]) {
+			return 0, fmt.Errorf("%w: ES_Descriptor is too short", errInvalidGameVideoContainer)
+		}
+		esDescriptor = esDescriptor[1+int(esDescriptor[0]):]
+	}
+	if flags&0x20 != 0 {
+		// OCR_ES_Id(2)
+		if len(esDescriptor) < 2 {
+			return 0, fmt.Errorf("%w: ES_Descriptor is too short", errInvalidGameVideoContainer)
+		}
+		esDescriptor = esDescriptor[2:]
+	}
+
+	tag, decoderConfig, err := readDescriptor(esDescriptor)
+	if err != nil {
+		return 0, err
+	}
+	if tag != 0x04 || len(decoderConfig) < 1 {
+		return 0, fmt.Errorf("%w: no DecoderConfigDescriptor", errInvalidGameVideoContainer)
+	}
+
+	return decoderConfig[0], nil
+}
+
+/*
+	Matroska(EBML)
+*/
+
+const (
+	ebmlIDHeader         = 0x1A45DFA3
+	ebmlIDDocType        = 0x4282
+	ebmlIDSegment        = 0x18538067
+	ebmlIDInfo           = 0x1549A966
+	ebmlIDTimestampScale = 0x2AD7B1
+	ebmlIDDuration       = 0x4489
+	ebmlIDTracks         = 0x1654AE6B
+	ebmlIDTrackEntry     = 0xAE
+	ebmlIDTrackType      = 0x83
+	ebmlIDCodecID        = 0x86
+	ebmlIDVideo          = 0xE0
+	ebmlIDPixelWidth     = 0xB0
+	ebmlIDPixelHeight    = 0xBA
+	ebmlIDCluster        = 0x1F43B675
+
+	ebmlTrackTypeVideo = 1
+	ebmlTrackTypeAudio = 2
+
+	// ebmlUnknownSize
+	// サイズが不明な要素(ライブ配信などで使われる)
+	ebmlUnknownSize = -1
+)
+
+// parseMatroska
+// EBMLヘッダーとSegment直下のInfo・Tracksを解析する。
+// InfoとTracksを読み終えた時点で返るので、残りは呼び出し側で読み捨てる。
+func parseMatroska(reader io.Reader) (time.Duration, []gameVideoTrack, error) {
+	id, size, err := readEBMLElementHeader(reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if id != ebmlIDHeader || size == ebmlUnknownSize || size > maxGameVideoHeaderSize {
+		return 0, nil, fmt.Errorf("%w: no EBML header", errInvalidGameVideoContainer)
+	}
+
+	header := make([]byte, size)
+	_, err = io.ReadFull(reader, header)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: failed to read EBML header: %w", errInvalidGameVideoContainer, err)
+	}
+
+	err = walkEBMLElements(header, func(id uint64, body []byte) error {
+		if id == ebmlIDDocType {
+			docType := strings.TrimRight(string(body), "\x00")
+			if docType != "matroska" && docType != "webm" {
+				return fmt.Errorf("%w: unknown doc type %s", errInvalidGameVideoContainer, docType)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	id, segmentSize, err := readEBMLElementHeader(reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if id != ebmlIDSegment {
+		return 0, nil, fmt.Errorf("%w: no Segment", errInvalidGameVideoContainer)
+	}
+
+	var segmentReader io.Reader = reader
+	if segmentSize != ebmlUnknownSize {
+		segmentReader = io.LimitReader(reader, segmentSize)
+	}
+
+	var (
+		foundInfo      bool
+		foundTracks    bool
+		timestampScale uint64 = 1_000_000
+		rawDuration    float64
+		tracks         []gameVideoTrack
+	)
+	for !foundInfo || !foundTracks {
+		id, size, err := readEBMLElementHeader(segmentReader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if id == ebmlIDCluster {
+			// Tracksより前にClusterが来ることはないので、ここまでにTracksがない場合は不正
+			break
+		}
+		if size == ebmlUnknownSize {
+			return 0, nil, fmt.Errorf("%w: unknown size element %x in Segment", errInvalidGameVideoContainer, id)
+		}
+
+		if id != ebmlIDInfo && id != ebmlIDTracks {
+			_, err = io.CopyN(io.Discard, segmentReader, size)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: failed to skip element %x: %w", errInvalidGameVideoContainer, id, err)
+			}
+
+			continue
+		}
+
+		if size > maxGameVideoHeaderSize {
+			return 0, nil, fmt.Errorf("%w: element %x is too large", errInvalidGameVideoContainer, id)
+		}
+		body := make([]byte, size)
+		_, err = io.ReadFull(segmentReader, body)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: failed to read element %x: %w", errInvalidGameVideoContainer, id, err)
+		}
+
+		switch id {
+		case ebmlIDInfo:
+			foundInfo = true
+			err = walkEBMLElements(body, func(id uint64, body []byte) error {
+				switch id {
+				case ebmlIDTimestampScale:
+					timestampScale = parseEBMLUint(body)
+				case ebmlIDDuration:
+					var err error
+					rawDuration, err = parseEBMLFloat(body)
+					if err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		case ebmlIDTracks:
+			foundTracks = true
+			tracks, err = parseMatroskaTracks(body)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if !foundTracks {
+		return 0, nil, fmt.Errorf("%w: no Tracks", errInvalidGameVideoContainer)
+	}
+
+	// Durationはライブ配信などでは存在しないので、その場合は0になる
+	nanoseconds := rawDuration * float64(timestampScale)
+	if nanoseconds < 0 || math.IsNaN(nanoseconds) || nanoseconds >= math.MaxInt64 {
+		return 0, nil, fmt.Errorf("%w: invalid duration", errInvalidGameVideoContainer)
+	}
+
+	return time.Duration(nanoseconds), tracks, nil
+}
+
+func parseMatroskaTracks(body []byte) ([]gameVideoTrack, error) {
+	var tracks []gameVideoTrack
+	err := walkEBMLElements(body, func(id uint64, body []byte) error {
+		if id != ebmlIDTrackEntry {
+			return nil
+		}
+
+		var (
+			trackType uint64
+			track     gameVideoTrack
+		)
+		err := walkEBMLElements(body, func(id uint64, body []byte) error {
+			switch id {
+			case ebmlIDTrackType:
+				trackType = parseEBMLUint(body)
+			case ebmlIDCodecID:
+				track.codec = strings.TrimRight(string(body), "\x00")
+			case ebmlIDVideo:
+				return walkEBMLElements(body, func(id uint64, body []byte) error {
+					switch id {
+					case ebmlIDPixelWidth:
+						track.width = int(min(parseEBMLUint(body), math.MaxInt32))
+					case ebmlIDPixelHeight:
+						track.height = int(min(parseEBMLUint(body), math.MaxInt32))
+					}
+
+					return nil
+				})
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		switch trackType {
+		case ebmlTrackTypeVideo:
+			track.isVideo = true
+		case ebmlTrackTypeAudio:
+			track.isVideo = false
+			// AACはA_AAC/MPEG4/LCのようにプロファイルが付くことがある
+			if strings.HasPrefix(track.codec, "A_AAC") {
+				track.codec = "A_AAC"
+			}
+		default:
+			// 字幕などのトラックは無視する
+			return nil
+		}
+
+		tracks = append(tracks, track)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+// readEBMLVint
+// EBMLの可変長整数を読む。
+// keepMarkerがtrueの場合(要素ID)は先頭のマーカービットを残す。
+func readEBMLVint(reader io.Reader, keepMarker bool) (uint64, int, error) {
+	var first [1]byte
+	_, err := io.ReadFull(reader, first[:])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	length := 1
+	for mask := byte(0x80); first[0]&mask == 0; mask >>= 1 {
+		length++
+		if length > 8 {
+			return 0, 0, fmt.Errorf("%w: invalid vint", errInvalidGameVideoContainer)
+		}
+	}
+
+	value := uint64(first[0])
+	if !keepMarker {
+		value &= uint64(0xFF >> length)
+	}
+
+	rest := make([]byte, length-1)
+	_, err = io.ReadFull(reader, rest)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: failed to read vint: %w", errInvalidGameVideoContainer, err)
+	}
+	for _, b := range rest {
+		value = value<<8 | uint64(b)
+	}
+
+	return value, length, nil
+}
+
+// readEBMLElementHeader
+// 要素のIDとサイズを読む。
+// 要素の境界でreaderが終わった場合はio.EOFを返す。
+func readEBMLElementHeader(reader io.Reader) (uint64, int64, error) {
+	id, _, err := readEBMLVint(reader, true)
+	if errors.Is(err, io.EOF) {
+		return 0, 0, io.EOF
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: failed to read element id: %w", errInvalidGameVideoContainer, err)
+	}
+
+	size, length, err := readEBMLVint(reader, false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: failed to read element size: %w", errInvalidGameVideoContainer, err)
+	}
+
+	// 全てのビットが1のサイズはサイズ不明を表す
+	if size == 1<<(7*length)-1 {
+		return id, ebmlUnknownSize, nil
+	}
+	if size > math.MaxInt64 {
+		return 0, 0, fmt.Errorf("%w: element size is too large", errInvalidGameVideoContainer)
+	}
+
+	return id, int64(size), nil
+}
+
+// walkEBMLElements
+// メモリ上の要素列を順に処理する。
+func walkEBMLElements(data []byte, f func(id uint64, body []byte) error) error {
+	reader := bytes.NewReader(data)
+	for reader.Len() > 0 {
+		id, size, err := readEBMLElementHeader(reader)
+		if err != nil {
+			return err
+		}
+		if size == ebmlUnknownSize || size > int64(reader.Len()) {
+			return fmt.Errorf("%w: element %x overflows its parent", errInvalidGameVideoContainer, id)
+		}
+
+		offset := len(data) - reader.Len()
+		body := data[offset : offset+int(size)]
+		_, err = reader.Seek(size, io.SeekCurrent)
+		if err != nil {
+			return fmt.Errorf("failed to seek: %w", err)
+		}
+
+		err = f(id, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseEBMLUint(body []byte) uint64 {
+	var value uint64
+	for _, b := range body {
+		value = value<<8 | uint64(b)
+	}
+
+	return value
+}
+
+func parseEBMLFloat(body []byte) (float64, error) {
+	switch len(body) {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(body))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(body)), nil
+	default:
+		return 0, fmt.Errorf("%w: invalid float size %d", errInvalidGameVideoContainer, len(body))
+	}
+}
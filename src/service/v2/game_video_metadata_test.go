@@ -0,0 +1,370 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/testdata"
+)
+
+func mp4BoxForTest(boxType string, children ...[]byte) []byte {
+	body := bytes.Join(children, nil)
+
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	box = append(box, boxType...)
+
+	return append(box, body...)
+}
+
+func mp4MvhdForTest(timescale, duration uint32) []byte {
+	body := make([]byte, 100)
+	binary.BigEndian.PutUint32(body[12:16], timescale)
+	binary.BigEndian.PutUint32(body[16:20], duration)
+
+	return mp4BoxForTest("mvhd", body)
+}
+
+func mp4TrakForTest(handlerType string, width, height int, sampleEntry []byte) []byte {
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:80], uint32(width)<<16)
+	binary.BigEndian.PutUint32(tkhd[80:84], uint32(height)<<16)
+
+	hdlr := make([]byte, 24)
+	copy(hdlr[8:12], handlerType)
+
+	stsd := make([]byte, 8)
+	binary.BigEndian.PutUint32(stsd[4:8], 1)
+
+	return mp4BoxForTest("trak",
+		mp4BoxForTest("tkhd", tkhd),
+		mp4BoxForTest("mdia",
+			mp4BoxForTest("hdlr", hdlr),
+			mp4BoxForTest("minf",
+				mp4BoxForTest("stbl",
+					mp4BoxForTest("stsd", stsd, sampleEntry),
+				),
+			),
+		),
+	)
+}
+
+func mp4AudioSampleEntryForTest(objectType byte) []byte {
+	// ES_Descriptor(ES_ID, flags) > DecoderConfigDescriptor(objectTypeIndication, ...)
+	decoderConfig := []byte{0x04, 0x0D, objectType, 0x15, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	esDescriptor := append([]byte{0x03, byte(3 + len(decoderConfig)), 0, 1, 0}, decoderConfig...)
+	esds := mp4BoxForTest("esds", make([]byte, 4), esDescriptor)
+
+	return mp4BoxForTest("mp4a", make([]byte, 28), esds)
+}
+
+func mp4ForTest(moovBeforeMdat bool, moovChildren ...[]byte) []byte {
+	ftyp := mp4BoxForTest("ftyp", []byte("isom"), make([]byte, 4), []byte("isomavc1"))
+	moov := mp4BoxForTest("moov", moovChildren...)
+	mdat := mp4BoxForTest("mdat", bytes.Repeat([]byte{0xFF}, 1024))
+
+	if moovBeforeMdat {
+		return bytes.Join([][]byte{ftyp, moov, mdat}, nil)
+	}
+
+	return bytes.Join([][]byte{ftyp, mdat, moov}, nil)
+}
+
+func ebmlElementForTest(id uint64, children ...[]byte) []byte {
+	body := bytes.Join(children, nil)
+
+	var element []byte
+	for shift := 24; shift >= 0; shift -= 8 {
+		if b := byte(id >> shift); b != 0 || len(element) > 0 {
+			element = append(element, b)
+		}
+	}
+
+	// サイズは常に8byteのvintで書く
+	size := binary.BigEndian.AppendUint64(nil, uint64(len(body)))
+	size[0] = 0x01
+
+	element = append(element, size...)
+
+	return append(element, body...)
+}
+
+func ebmlUintForTest(id uint64, value uint64) []byte {
+	return ebmlElementForTest(id, binary.BigEndian.AppendUint64(nil, value))
+}
+
+func ebmlFloatForTest(id uint64, value float64) []byte {
+	return ebmlElementForTest(id, binary.BigEndian.AppendUint64(nil, math.Float64bits(value)))
+}
+
+func matroskaTrackForTest(trackType uint64, codecID string, width, height uint64) []byte {
+	children := [][]byte{
+		ebmlUintForTest(ebmlIDTrackType, trackType),
+		ebmlElementForTest(ebmlIDCodecID, []byte(codecID)),
+	}
+	if trackType == ebmlTrackTypeVideo {
+		children = append(children, ebmlElementForTest(ebmlIDVideo,
+			ebmlUintForTest(ebmlIDPixelWidth, width),
+			ebmlUintForTest(ebmlIDPixelHeight, height),
+		))
+	}
+
+	return ebmlElementForTest(ebmlIDTrackEntry, children...)
+}
+
+func matroskaForTest(docType string, duration float64, tracks ...[]byte) []byte {
+	header := ebmlElementForTest(ebmlIDHeader, ebmlElementForTest(ebmlIDDocType, []byte(docType)))
+	segment := ebmlElementForTest(ebmlIDSegment,
+		ebmlElementForTest(0x114D9B74, make([]byte, 16)), // SeekHead
+		ebmlElementForTest(ebmlIDInfo,
+			ebmlUintForTest(ebmlIDTimestampScale, 1_000_000),
+			ebmlFloatForTest(ebmlIDDuration, duration),
+		),
+		ebmlElementForTest(ebmlIDTracks, tracks...),
+		ebmlElementForTest(ebmlIDCluster, bytes.Repeat([]byte{0xFF}, 1024)),
+	)
+
+	return append(header, segment...)
+}
+
+func TestParseGameVideoMetadata(t *testing.T) {
+	t.Parallel()
+
+	realMP4, err := testdata.FS.ReadFile("1.mp4")
+	if err != nil {
+		t.Fatalf("failed to read test video: %v", err)
+	}
+
+	type test struct {
+		description string
+		data        []byte
+		videoType   values.GameVideoType
+		duration    time.Duration
+		width       int
+		height      int
+		videoCodec  values.GameVideoCodec
+		audioCodec  option.Option[values.GameVideoAudioCodec]
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "実際のmp4でもエラーなし",
+			data:        realMP4,
+			videoType:   values.GameVideoTypeMp4,
+			duration:    14581233333 * time.Nanosecond,
+			width:       1920,
+			height:      1080,
+			videoCodec:  values.GameVideoCodecH264,
+		},
+		{
+			description: "mp4でmoovが先頭にあってもエラーなし",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(1000, 12500),
+				mp4TrakForTest("vide", 1280, 720, mp4BoxForTest("avc1", make([]byte, 78))),
+				mp4TrakForTest("soun", 0, 0, mp4AudioSampleEntryForTest(0x40)),
+			),
+			videoType:  values.GameVideoTypeMp4,
+			duration:   12500 * time.Millisecond,
+			width:      1280,
+			height:     720,
+			videoCodec: values.GameVideoCodecH264,
+			audioCodec: option.NewOption(values.GameVideoAudioCodecAAC),
+		},
+		{
+			description: "mp4でmoovが末尾にあってもエラーなし",
+			data: mp4ForTest(false,
+				mp4MvhdForTest(1000, 12500),
+				mp4TrakForTest("vide", 1280, 720, mp4BoxForTest("av01", make([]byte, 78))),
+				mp4TrakForTest("soun", 0, 0, mp4BoxForTest("Opus", make([]byte, 28))),
+			),
+			videoType:  values.GameVideoTypeM4v,
+			duration:   12500 * time.Millisecond,
+			width:      1280,
+			height:     720,
+			videoCodec: values.GameVideoCodecAV1,
+			audioCodec: option.NewOption(values.GameVideoAudioCodecOpus),
+		},
+		{
+			description: "mp4でMP3の音声でもエラーなし",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(90000, 180000),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("vp09", make([]byte, 78))),
+				mp4TrakForTest("soun", 0, 0, mp4AudioSampleEntryForTest(0x6B)),
+			),
+			videoType:  values.GameVideoTypeMp4,
+			duration:   2 * time.Second,
+			width:      640,
+			height:     480,
+			videoCodec: values.GameVideoCodecVP9,
+			audioCodec: option.NewOption(values.GameVideoAudioCodecMP3),
+		},
+		{
+			description: "mp4で字幕トラックは無視される",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("text", 0, 0, mp4BoxForTest("tx3g", make([]byte, 8))),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("avc1", make([]byte, 78))),
+			),
+			videoType:  values.GameVideoTypeMp4,
+			duration:   time.Second,
+			width:      640,
+			height:     480,
+			videoCodec: values.GameVideoCodecH264,
+		},
+		{
+			description: "mp4でHEVCなのでエラー",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("hvc1", make([]byte, 78))),
+			),
+			videoType: values.GameVideoTypeMp4,
+			isErr:     true,
+			err:       errUnsupportedGameVideoCodec,
+		},
+		{
+			description: "mp4でAC-3なのでエラー",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("avc1", make([]byte, 78))),
+				mp4TrakForTest("soun", 0, 0, mp4BoxForTest("ac-3", make([]byte, 28))),
+			),
+			videoType: values.GameVideoTypeMp4,
+			isErr:     true,
+			err:       errUnsupportedGameVideoCodec,
+		},
+		{
+			description: "mp4で映像トラックがないのでエラー",
+			data: mp4ForTest(true,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("soun", 0, 0, mp4AudioSampleEntryForTest(0x40)),
+			),
+			videoType: values.GameVideoTypeMp4,
+			isErr:     true,
+			err:       errInvalidGameVideoContainer,
+		},
+		{
+			description: "mp4でmoovがないのでエラー",
+			data:        mp4BoxForTest("ftyp", []byte("isom")),
+			videoType:   values.GameVideoTypeMp4,
+			isErr:       true,
+			err:         errInvalidGameVideoContainer,
+		},
+		{
+			description: "mp4が途中で切れているのでエラー",
+			data: mp4ForTest(false,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("avc1", make([]byte, 78))),
+			)[:100],
+			videoType: values.GameVideoTypeMp4,
+			isErr:     true,
+			err:       errInvalidGameVideoContainer,
+		},
+		{
+			description: "mkvでもエラーなし",
+			data: matroskaForTest("matroska", 12500,
+				matroskaTrackForTest(ebmlTrackTypeVideo, "V_MPEG4/ISO/AVC", 1920, 1080),
+				matroskaTrackForTest(ebmlTrackTypeAudio, "A_AAC/MPEG4/LC", 0, 0),
+			),
+			videoType:  values.GameVideoTypeMkv,
+			duration:   12500 * time.Millisecond,
+			width:      1920,
+			height:     1080,
+			videoCodec: values.GameVideoCodecH264,
+			audioCodec: option.NewOption(values.GameVideoAudioCodecAAC),
+		},
+		{
+			description: "webmでもエラーなし",
+			data: matroskaForTest("webm", 3000,
+				matroskaTrackForTest(ebmlTrackTypeVideo, "V_VP8", 640, 360),
+				matroskaTrackForTest(ebmlTrackTypeAudio, "A_VORBIS", 0, 0),
+			),
+			videoType:  values.GameVideoTypeMkv,
+			duration:   3 * time.Second,
+			width:      640,
+			height:     360,
+			videoCodec: values.GameVideoCodecVP8,
+			audioCodec: option.NewOption(values.GameVideoAudioCodecVorbis),
+		},
+		{
+			description: "mkvでHEVCなのでエラー",
+			data: matroskaForTest("matroska", 1000,
+				matroskaTrackForTest(ebmlTrackTypeVideo, "V_MPEGH/ISO/HEVC", 1920, 1080),
+			),
+			videoType: values.GameVideoTypeMkv,
+			isErr:     true,
+			err:       errUnsupportedGameVideoCodec,
+		},
+		{
+			description: "mkvでDTSなのでエラー",
+			data: matroskaForTest("matroska", 1000,
+				matroskaTrackForTest(ebmlTrackTypeVideo, "V_VP9", 1920, 1080),
+				matroskaTrackForTest(ebmlTrackTypeAudio, "A_DTS", 0, 0),
+			),
+			videoType: values.GameVideoTypeMkv,
+			isErr:     true,
+			err:       errUnsupportedGameVideoCodec,
+		},
+		{
+			description: "DocTypeが不正なのでエラー",
+			data: matroskaForTest("unknown", 1000,
+				matroskaTrackForTest(ebmlTrackTypeVideo, "V_VP9", 1920, 1080),
+			),
+			videoType: values.GameVideoTypeMkv,
+			isErr:     true,
+			err:       errInvalidGameVideoContainer,
+		},
+		{
+			description: "Tracksがないのでエラー",
+			data:        matroskaForTest("matroska", 1000)[:60],
+			videoType:   values.GameVideoTypeMkv,
+			isErr:       true,
+			err:         errInvalidGameVideoContainer,
+		},
+		{
+			description: "mkvでないのでエラー",
+			data:        []byte("invalid file"),
+			videoType:   values.GameVideoTypeMkv,
+			isErr:       true,
+			err:         errInvalidGameVideoContainer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			reader := bytes.NewReader(testCase.data)
+
+			metadata, err := parseGameVideoMetadata(reader, testCase.videoType)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			// ストレージへの保存が止まらないように、最後まで読み進める
+			_, err = reader.ReadByte()
+			assert.ErrorIs(t, err, io.EOF)
+
+			assert.Equal(t, testCase.duration, metadata.GetDuration())
+			assert.Equal(t, testCase.width, metadata.GetWidth())
+			assert.Equal(t, testCase.height, metadata.GetHeight())
+			assert.Equal(t, testCase.videoCodec, metadata.GetVideoCodec())
+			assert.Equal(t, testCase.audioCodec, metadata.GetAudioCodec())
+		})
+	}
+}
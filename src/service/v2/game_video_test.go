@@ -12,11 +12,15 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
 	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
 	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
 	"github.com/traPtitech/trap-collection-server/testdata"
 	"go.uber.org/mock/gomock"
@@ -33,14 +37,26 @@ func TestSaveGameVideo(t *testing.T) {
 	mockDB := mockRepository.NewMockDB(ctrl)
 	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
 	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
+	mockConf.
+		EXPECT().
+		DefaultGameStorageQuota().
+		Return(int64(0), false, nil)
+	gameStorage, err := NewGameStorage(mockConf, mockDB, mockGameRepository, mockGameStorageRepository, nil, nil)
+	require.NoError(t, err)
 
 	type test struct {
 		description                    string
 		gameID                         values.GameID
 		isValidFile                    bool
 		videoFileName                  string
+		videoData                      []byte
 		videoType                      values.GameVideoType
+		metadata                       *domain.GameVideoMetadata
 		GetGameErr                     error
+		storageQuota                   option.Option[values.GameStorageSize]
 		executeRepositorySaveGameVideo bool
 		RepositorySaveGameVideoErr     error
 		executeStorageSaveGameVideo    bool
@@ -51,11 +67,18 @@ func TestSaveGameVideo(t *testing.T) {
 
 	testCases := []test{
 		{
-			description:                    "特に問題ないのでエラーなし",
-			gameID:                         values.NewGameID(),
-			isValidFile:                    true,
-			videoFileName:                  "1.mp4",
-			videoType:                      values.GameVideoTypeMp4,
+			description:   "特に問題ないのでエラーなし",
+			gameID:        values.NewGameID(),
+			isValidFile:   true,
+			videoFileName: "1.mp4",
+			videoType:     values.GameVideoTypeMp4,
+			metadata: domain.NewGameVideoMetadata(
+				14581233333*time.Nanosecond,
+				1920,
+				1080,
+				values.GameVideoCodecH264,
+				option.Option[values.GameVideoAudioCodec]{},
+			),
 			executeRepositorySaveGameVideo: true,
 			executeStorageSaveGameVideo:    true,
 		},
@@ -104,6 +127,29 @@ func TestSaveGameVideo(t *testing.T) {
 			isErr:                       true,
 			err:                         service.ErrInvalidFormat,
 		},
+		{
+			description: "ブラウザで再生できないコーデックなのでErrUnsupportedGameVideoCodec",
+			gameID:      values.NewGameID(),
+			isValidFile: true,
+			videoData: mp4ForTest(true,
+				mp4MvhdForTest(1000, 1000),
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("hvc1", make([]byte, 78))),
+			),
+			executeStorageSaveGameVideo: true,
+			isErr:                       true,
+			err:                         service.ErrUnsupportedGameVideoCodec,
+		},
+		{
+			description: "コンテナが不正なのでErrInvalidFormat",
+			gameID:      values.NewGameID(),
+			isValidFile: true,
+			videoData: mp4ForTest(true,
+				mp4TrakForTest("vide", 640, 480, mp4BoxForTest("avc1", make([]byte, 78))),
+			),
+			executeStorageSaveGameVideo: true,
+			isErr:                       true,
+			err:                         service.ErrInvalidFormat,
+		},
 		{
 			description:                    "repository.SaveGameVideoがエラーなのでエラー",
 			gameID:                         values.NewGameID(),
@@ -116,15 +162,35 @@ func TestSaveGameVideo(t *testing.T) {
 			isErr:                          true,
 		},
 		{
-			description:                    "storage.SaveGameVideoがエラーなのでエラー",
+			description:                 "storage.SaveGameVideoがエラーなのでエラー",
+			gameID:                      values.NewGameID(),
+			isValidFile:                 true,
+			videoFileName:               "1.mp4",
+			videoType:                   values.GameVideoTypeMp4,
+			executeStorageSaveGameVideo: true,
+			StorageSaveGameVideoErr:     errors.New("error"),
+			isErr:                       true,
+		},
+		{
+			description:                    "ストレージの上限以内なのでエラーなし",
 			gameID:                         values.NewGameID(),
 			isValidFile:                    true,
 			videoFileName:                  "1.mp4",
 			videoType:                      values.GameVideoTypeMp4,
+			storageQuota:                   option.NewOption[values.GameStorageSize](1 << 30),
 			executeRepositorySaveGameVideo: true,
 			executeStorageSaveGameVideo:    true,
-			StorageSaveGameVideoErr:        errors.New("error"),
-			isErr:                          true,
+		},
+		{
+			description:                 "ストレージの上限を超えるのでErrGameStorageQuotaExceeded",
+			gameID:                      values.NewGameID(),
+			isValidFile:                 true,
+			videoFileName:               "1.mp4",
+			videoType:                   values.GameVideoTypeMp4,
+			storageQuota:                option.NewOption[values.GameStorageSize](10),
+			executeStorageSaveGameVideo: true,
+			isErr:                       true,
+			err:                         service.ErrGameStorageQuotaExceeded,
 		},
 	}
 
@@ -139,11 +205,15 @@ func TestSaveGameVideo(t *testing.T) {
 				mockGameRepository,
 				mockGameVideoRepository,
 				mockGameVideoStorage,
+				gameStorage,
 			)
 
 			var file io.Reader
 			var expectBytes []byte
-			if testCase.isValidFile {
+			if testCase.videoData != nil {
+				file = bytes.NewReader(testCase.videoData)
+				expectBytes = testCase.videoData
+			} else if testCase.isValidFile {
 				imgBuf := bytes.NewBuffer(nil)
 
 				err := func() error {
@@ -175,6 +245,25 @@ func TestSaveGameVideo(t *testing.T) {
 				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeRecord).
 				Return(nil, testCase.GetGameErr)
 
+			if testCase.GetGameErr == nil {
+				mockGameStorageRepository.
+					EXPECT().
+					GetGameStorageUsage(gomock.Any(), testCase.gameID).
+					Return(domain.NewGameStorageUsage(0, 0, 0), nil)
+
+				if quota, ok := testCase.storageQuota.Value(); ok {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+						Return(quota, nil)
+				} else {
+					mockGameStorageRepository.
+						EXPECT().
+						GetGameStorageQuota(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+						Return(values.GameStorageSize(0), repository.ErrRecordNotFound)
+				}
+			}
+
 			if testCase.executeRepositorySaveGameVideo {
 				mockGameVideoRepository.
 					EXPECT().
@@ -205,7 +294,13 @@ func TestSaveGameVideo(t *testing.T) {
 			}
 
 			assert.Equal(t, testCase.videoType, video.GetType())
+			assert.Equal(t, values.GameStorageSize(len(expectBytes)), video.GetSize())
 			assert.WithinDuration(t, time.Now(), video.GetCreatedAt(), time.Second)
+			if testCase.metadata != nil {
+				metadata, ok := video.GetMetadata().Value()
+				assert.True(t, ok)
+				assert.Equal(t, testCase.metadata, metadata)
+			}
 
 			assert.Equal(t, expectBytes, buf.Bytes())
 		})
@@ -230,6 +325,7 @@ func TestGetGameVideos(t *testing.T) {
 		mockGameRepository,
 		mockGameVideoRepository,
 		mockGameVideoStorage,
+		nil,
 	)
 
 	type test struct {
@@ -358,6 +454,7 @@ func TestGetGameVideo(t *testing.T) {
 		mockGameRepository,
 		mockGameVideoRepository,
 		mockGameVideoStorage,
+		nil,
 	)
 
 	type test struct {
@@ -465,20 +562,20 @@ func TestGetGameVideo(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameVideo {
 				mockGameVideoRepository.
 					EXPECT().
-					GetGameVideo(ctx, testCase.gameVideoID, repository.LockTypeRecord).
+					GetGameVideo(gomock.Any(), testCase.gameVideoID, repository.LockTypeRecord).
 					Return(testCase.video, testCase.getGameVideoErr)
 			}
 
 			if testCase.executeGetTempURL {
 				mockGameVideoStorage.
 					EXPECT().
-					GetTempURL(ctx, testCase.video.GameVideo, time.Minute).
+					GetTempURL(gomock.Any(), testCase.video.GameVideo, time.Minute).
 					Return(testCase.videoURL, testCase.getTempURLErr)
 			}
 
@@ -520,6 +617,7 @@ func TestGetGameVideoMeta(t *testing.T) {
 		mockGameRepository,
 		mockGameVideoRepository,
 		mockGameVideoStorage,
+		nil,
 	)
 
 	type test struct {
@@ -599,13 +697,13 @@ func TestGetGameVideoMeta(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			mockGameRepository.
 				EXPECT().
-				GetGame(ctx, testCase.gameID, repository.LockTypeNone).
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
 				Return(nil, testCase.getGameErr)
 
 			if testCase.executeGetGameVideo {
 				mockGameVideoRepository.
 					EXPECT().
-					GetGameVideo(ctx, testCase.gameVideoID, repository.LockTypeNone).
+					GetGameVideo(gomock.Any(), testCase.gameVideoID, repository.LockTypeNone).
 					Return(testCase.video, testCase.getGameVideoErr)
 			}
 
@@ -630,3 +728,419 @@ func TestGetGameVideoMeta(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+
+	readTestFile := func(t *testing.T, name string) []byte {
+		t.Helper()
+
+		data, err := testdata.FS.ReadFile(name)
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+
+		return data
+	}
+
+	type test struct {
+		description                string
+		gameID                     values.GameID
+		poster                     []byte
+		posterType                 values.GameImageType
+		getGameErr                 error
+		executeGetGameVideo        bool
+		video                      *repository.GameVideoInfo
+		getGameVideoErr            error
+		executeUpdatePosterType    bool
+		updatePosterTypeErr        error
+		executeSaveGameVideoPoster bool
+		saveGameVideoPosterErr     error
+		isErr                      bool
+		err                        error
+	}
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+	gameID4 := values.NewGameID()
+	gameID5 := values.NewGameID()
+
+	newVideoInfo := func(gameID values.GameID) *repository.GameVideoInfo {
+		return &repository.GameVideoInfo{
+			GameVideo: domain.NewGameVideo(
+				values.NewGameVideoID(),
+				values.GameVideoTypeMp4,
+				time.Now(),
+			),
+			GameID: gameID,
+		}
+	}
+
+	testCases := []test{
+		{
+			description:                "特に問題ないのでエラーなし",
+			gameID:                     gameID1,
+			poster:                     readTestFile(t, "1.png"),
+			posterType:                 values.GameImageTypePng,
+			executeGetGameVideo:        true,
+			video:                      newVideoInfo(gameID1),
+			executeUpdatePosterType:    true,
+			executeSaveGameVideoPoster: true,
+		},
+		{
+			description:                "jpegでもエラーなし",
+			gameID:                     gameID2,
+			poster:                     readTestFile(t, "1.jpg"),
+			posterType:                 values.GameImageTypeJpeg,
+			executeGetGameVideo:        true,
+			video:                      newVideoInfo(gameID2),
+			executeUpdatePosterType:    true,
+			executeSaveGameVideoPoster: true,
+		},
+		{
+			description:                "同じ種類のポスター画像で上書きしてもエラーなし",
+			gameID:                     gameID3,
+			poster:                     readTestFile(t, "1.gif"),
+			posterType:                 values.GameImageTypeGif,
+			executeGetGameVideo:        true,
+			video:                      newVideoInfo(gameID3),
+			executeUpdatePosterType:    true,
+			updatePosterTypeErr:        repository.ErrNoRecordUpdated,
+			executeSaveGameVideoPoster: true,
+		},
+		{
+			description: "GetGameがErrRecordNotFoundなのでErrInvalidGameID",
+			gameID:      values.NewGameID(),
+			poster:      readTestFile(t, "1.png"),
+			getGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrInvalidGameID,
+		},
+		{
+			description: "GetGameがエラーなのでエラー",
+			gameID:      values.NewGameID(),
+			poster:      readTestFile(t, "1.png"),
+			getGameErr:  errors.New("error"),
+			isErr:       true,
+		},
+		{
+			description: "画像でないのでErrInvalidFormat",
+			gameID:      values.NewGameID(),
+			poster:      []byte("invalid file"),
+			isErr:       true,
+			err:         service.ErrInvalidFormat,
+		},
+		{
+			description:         "GetGameVideoがErrRecordNotFoundなのでErrInvalidGameVideoID",
+			gameID:              values.NewGameID(),
+			poster:              readTestFile(t, "1.png"),
+			executeGetGameVideo: true,
+			getGameVideoErr:     repository.ErrRecordNotFound,
+			isErr:               true,
+			err:                 service.ErrInvalidGameVideoID,
+		},
+		{
+			description:         "ゲーム動画に紐づくゲームIDが違うのでErrInvalidGameVideoID",
+			gameID:              values.NewGameID(),
+			poster:              readTestFile(t, "1.png"),
+			executeGetGameVideo: true,
+			video:               newVideoInfo(values.NewGameID()),
+			isErr:               true,
+			err:                 service.ErrInvalidGameVideoID,
+		},
+		{
+			description:         "GetGameVideoがエラーなのでエラー",
+			gameID:              values.NewGameID(),
+			poster:              readTestFile(t, "1.png"),
+			executeGetGameVideo: true,
+			getGameVideoErr:     errors.New("error"),
+			isErr:               true,
+		},
+		{
+			description:             "UpdateGameVideoPosterTypeがエラーなのでエラー",
+			gameID:                  gameID4,
+			poster:                  readTestFile(t, "1.png"),
+			posterType:              values.GameImageTypePng,
+			executeGetGameVideo:     true,
+			video:                   newVideoInfo(gameID4),
+			executeUpdatePosterType: true,
+			updatePosterTypeErr:     errors.New("error"),
+			isErr:                   true,
+		},
+		{
+			description:                "storage.SaveGameVideoPosterがエラーなのでエラー",
+			gameID:                     gameID5,
+			poster:                     readTestFile(t, "1.png"),
+			posterType:                 values.GameImageTypePng,
+			executeGetGameVideo:        true,
+			video:                      newVideoInfo(gameID5),
+			executeUpdatePosterType:    true,
+			executeSaveGameVideoPoster: true,
+			saveGameVideoPosterErr:     errors.New("error"),
+			isErr:                      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, buf)
+
+			gameVideoService := NewGameVideo(
+				mockDB,
+				mockGameRepository,
+				mockGameVideoRepository,
+				mockGameVideoStorage,
+				nil,
+			)
+
+			var videoID values.GameVideoID
+			if testCase.video != nil {
+				videoID = testCase.video.GetID()
+			} else {
+				videoID = values.NewGameVideoID()
+			}
+
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+				Return(nil, testCase.getGameErr)
+
+			if testCase.executeGetGameVideo {
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideo(gomock.Any(), videoID, repository.LockTypeRecord).
+					Return(testCase.video, testCase.getGameVideoErr)
+			}
+
+			if testCase.executeUpdatePosterType {
+				mockGameVideoRepository.
+					EXPECT().
+					UpdateGameVideoPosterType(gomock.Any(), videoID, testCase.posterType).
+					Return(testCase.updatePosterTypeErr)
+			}
+
+			if testCase.executeSaveGameVideoPoster {
+				mockGameVideoStorage.
+					EXPECT().
+					SaveGameVideoPoster(gomock.Any(), videoID).
+					Return(testCase.saveGameVideoPosterErr)
+			}
+
+			video, err := gameVideoService.SaveGameVideoPoster(ctx, bytes.NewReader(testCase.poster), testCase.gameID, videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, videoID, video.GetID())
+			posterType, ok := video.GetPosterType().Value()
+			assert.True(t, ok)
+			assert.Equal(t, testCase.posterType, posterType)
+
+			assert.Equal(t, testCase.poster, buf.Bytes())
+		})
+	}
+}
+
+func TestGetGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockGameVideoStorage := mockStorage.NewGameVideo(ctrl, nil)
+
+	gameVideoService := NewGameVideo(
+		mockDB,
+		mockGameRepository,
+		mockGameVideoRepository,
+		mockGameVideoStorage,
+		nil,
+	)
+
+	type test struct {
+		description             string
+		gameID                  values.GameID
+		getGameErr              error
+		executeGetGameVideo     bool
+		video                   *repository.GameVideoInfo
+		getGameVideoErr         error
+		executeGetPosterTempURL bool
+		posterURL               values.GameVideoPosterTmpURL
+		getPosterTempURLErr     error
+		isErr                   bool
+		err                     error
+	}
+
+	urlLink, err := url.Parse("https://example.com")
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	gameID1 := values.NewGameID()
+	gameID2 := values.NewGameID()
+	gameID3 := values.NewGameID()
+	gameID4 := values.NewGameID()
+
+	newVideoInfo := func(gameID values.GameID, hasPoster bool) *repository.GameVideoInfo {
+		video := domain.NewGameVideo(
+			values.NewGameVideoID(),
+			values.GameVideoTypeMp4,
+			time.Now(),
+		)
+		if hasPoster {
+			video.SetPosterType(values.GameImageTypePng)
+		}
+
+		return &repository.GameVideoInfo{
+			GameVideo: video,
+			GameID:    gameID,
+		}
+	}
+
+	testCases := []test{
+		{
+			description:             "特に問題ないのでエラーなし",
+			gameID:                  gameID1,
+			executeGetGameVideo:     true,
+			video:                   newVideoInfo(gameID1, true),
+			executeGetPosterTempURL: true,
+			posterURL:               values.NewGameVideoPosterTmpURL(urlLink),
+		},
+		{
+			description: "GetGameがErrRecordNotFoundなのでErrInvalidGameID",
+			gameID:      values.NewGameID(),
+			getGameErr:  repository.ErrRecordNotFound,
+			isErr:       true,
+			err:         service.ErrInvalidGameID,
+		},
+		{
+			description: "GetGameがエラーなのでエラー",
+			gameID:      values.NewGameID(),
+			getGameErr:  errors.New("error"),
+			isErr:       true,
+		},
+		{
+			description:         "GetGameVideoがErrRecordNotFoundなのでErrInvalidGameVideoID",
+			gameID:              values.NewGameID(),
+			executeGetGameVideo: true,
+			getGameVideoErr:     repository.ErrRecordNotFound,
+			isErr:               true,
+			err:                 service.ErrInvalidGameVideoID,
+		},
+		{
+			description:         "ゲーム動画に紐づくゲームIDが違うのでErrInvalidGameVideoID",
+			gameID:              values.NewGameID(),
+			executeGetGameVideo: true,
+			video:               newVideoInfo(values.NewGameID(), true),
+			isErr:               true,
+			err:                 service.ErrInvalidGameVideoID,
+		},
+		{
+			description:         "GetGameVideoがエラーなのでエラー",
+			gameID:              values.NewGameID(),
+			executeGetGameVideo: true,
+			getGameVideoErr:     errors.New("error"),
+			isErr:               true,
+		},
+		{
+			description:         "ポスター画像がないのでErrNoGameVideoPoster",
+			gameID:              gameID2,
+			executeGetGameVideo: true,
+			video:               newVideoInfo(gameID2, false),
+			isErr:               true,
+			err:                 service.ErrNoGameVideoPoster,
+		},
+		{
+			description:             "ストレージにポスター画像がないのでErrNoGameVideoPoster",
+			gameID:                  gameID3,
+			executeGetGameVideo:     true,
+			video:                   newVideoInfo(gameID3, true),
+			executeGetPosterTempURL: true,
+			getPosterTempURLErr:     storage.ErrNotFound,
+			isErr:                   true,
+			err:                     service.ErrNoGameVideoPoster,
+		},
+		{
+			description:             "GetPosterTempURLがエラーなのでエラー",
+			gameID:                  gameID4,
+			executeGetGameVideo:     true,
+			video:                   newVideoInfo(gameID4, true),
+			executeGetPosterTempURL: true,
+			getPosterTempURLErr:     errors.New("error"),
+			isErr:                   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			var videoID values.GameVideoID
+			if testCase.video != nil {
+				videoID = testCase.video.GetID()
+			} else {
+				videoID = values.NewGameVideoID()
+			}
+
+			mockGameRepository.
+				EXPECT().
+				GetGame(gomock.Any(), testCase.gameID, repository.LockTypeNone).
+				Return(nil, testCase.getGameErr)
+
+			if testCase.executeGetGameVideo {
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideo(gomock.Any(), videoID, repository.LockTypeNone).
+					Return(testCase.video, testCase.getGameVideoErr)
+			}
+
+			if testCase.executeGetPosterTempURL {
+				mockGameVideoStorage.
+					EXPECT().
+					GetPosterTempURL(gomock.Any(), testCase.video.GameVideo, time.Minute).
+					Return(testCase.posterURL, testCase.getPosterTempURLErr)
+			}
+
+			tmpURL, err := gameVideoService.GetGameVideoPoster(ctx, testCase.gameID, videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.posterURL, tmpURL)
+		})
+	}
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/traPtitech/trap-collection-server/src/auth"
 	"github.com/traPtitech/trap-collection-server/src/cache"
 	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
 
@@ -39,7 +40,10 @@ func NewOIDC(conf config.ServiceV2, user *User, oidc auth.OIDC) (*OIDC, error) {
 	}, nil
 }
 
-func (o *OIDC) GenerateAuthState(_ context.Context) (*domain.OIDCClient, *domain.OIDCAuthState, error) {
+func (o *OIDC) GenerateAuthState(ctx context.Context) (*domain.OIDCClient, *domain.OIDCAuthState, error) {
+	ctx, span := tracer.Start(ctx, "OIDC.GenerateAuthState")
+	defer span.End()
+
 	codeChallengeMethod := values.OIDCCodeChallengeMethodSha256
 	codeChallenge, err := values.NewOIDCCodeVerifier()
 	if err != nil {
@@ -52,6 +56,9 @@ func (o *OIDC) GenerateAuthState(_ context.Context) (*domain.OIDCClient, *domain
 }
 
 func (o *OIDC) Callback(ctx context.Context, authState *domain.OIDCAuthState, code values.OIDCAuthorizationCode) (*domain.OIDCSession, error) {
+	ctx, span := tracer.Start(ctx, "OIDC.Callback")
+	defer span.End()
+
 	session, err := o.oidcAuth.GetOIDCSession(ctx, o.client, code, authState)
 	if errors.Is(err, auth.ErrInvalidCredentials) {
 		return nil, service.ErrInvalidAuthStateOrCode
@@ -64,6 +71,9 @@ func (o *OIDC) Callback(ctx context.Context, authState *domain.OIDCAuthState, co
 }
 
 func (o *OIDC) Logout(ctx context.Context, session *domain.OIDCSession) error {
+	ctx, span := tracer.Start(ctx, "OIDC.Logout")
+	defer span.End()
+
 	err := o.oidcAuth.RevokeOIDCSession(ctx, session)
 	if err != nil {
 		return fmt.Errorf("failed to revoke OIDC session: %w", err)
@@ -72,7 +82,10 @@ func (o *OIDC) Logout(ctx context.Context, session *domain.OIDCSession) error {
 	return nil
 }
 
-func (o *OIDC) Authenticate(_ context.Context, session *domain.OIDCSession) error {
+func (o *OIDC) Authenticate(ctx context.Context, session *domain.OIDCSession) error {
+	ctx, span := tracer.Start(ctx, "OIDC.Authenticate")
+	defer span.End()
+
 	// traQで凍結された場合の反映が遅れるのは許容しているので、sessionの有効期限確認のみ
 	if session.IsExpired() {
 		return service.ErrOIDCSessionExpired
@@ -82,10 +95,16 @@ func (o *OIDC) Authenticate(_ context.Context, session *domain.OIDCSession) erro
 }
 
 func (o *OIDC) GetMe(ctx context.Context, session *domain.OIDCSession) (*service.UserInfo, error) {
+	ctx, span := tracer.Start(ctx, "OIDC.GetMe")
+	defer span.End()
+
 	return o.user.getMe(ctx, session)
 }
 
 func (o *OIDC) GetActiveUsers(ctx context.Context, session *domain.OIDCSession, includeBot bool) ([]*service.UserInfo, error) {
+	ctx, span := tracer.Start(ctx, "OIDC.GetActiveUsers")
+	defer span.End()
+
 	users, err := o.user.getActiveUsers(ctx, session)
 	if err != nil {
 		return nil, err
@@ -124,7 +143,7 @@ func (uu *User) getMe(ctx context.Context, session *domain.OIDCSession) (*servic
 	user, err := uu.userCache.GetMe(ctx, session.GetAccessToken())
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗してもauthからとって来れれば良いので、returnはしない
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(ctx, "failed to get user info", slog.Any("error", err))
 	}
 	// cacheから取り出した場合はそれを返す
 	if err == nil {
@@ -139,7 +158,7 @@ func (uu *User) getMe(ctx context.Context, session *domain.OIDCSession) (*servic
 	err = uu.userCache.SetMe(ctx, session, user)
 	if err != nil {
 		// cacheの設定に失敗してもreturnはしない
-		log.Printf("error: failed to set user info: %v\n", err)
+		logger.Error(ctx, "failed to set user info", slog.Any("error", err))
 	}
 
 	return user, nil
@@ -151,7 +170,7 @@ func (uu *User) getActiveUsers(ctx context.Context, session *domain.OIDCSession)
 	users, err := uu.userCache.GetActiveUsers(ctx)
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗してもauthからとって来れれば良いので、returnはしない
-		log.Printf("error: failed to get user info: %v\n", err)
+		logger.Error(ctx, "failed to get user info", slog.Any("error", err))
 	}
 	// cacheから取り出した場合はそれを返す
 	if err == nil {
@@ -166,7 +185,7 @@ func (uu *User) getActiveUsers(ctx context.Context, session *domain.OIDCSession)
 	err = uu.userCache.SetActiveUsers(ctx, users)
 	if err != nil {
 		// cacheの設定に失敗してもreturnはしない
-		log.Printf("error: failed to set user info: %v\n", err)
+		logger.Error(ctx, "failed to set user info", slog.Any("error", err))
 	}
 
 	return users, nil
@@ -176,7 +195,7 @@ func (uu *User) getAllUsers(ctx context.Context, session *domain.OIDCSession) ([
 	users, err := uu.userCache.GetAllUsers(ctx)
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗してもauthからとって来れれば良いので、returnはしない
-		log.Printf("failed to get users from cache: %v\n", err)
+		logger.Error(ctx, "failed to get users from cache", slog.Any("error", err))
 	}
 	// cacheから取り出した場合はそれを返す
 	if err == nil {
@@ -191,7 +210,7 @@ func (uu *User) getAllUsers(ctx context.Context, session *domain.OIDCSession) ([
 	err = uu.userCache.SetAllUsers(ctx, users)
 	if err != nil {
 		// cacheの設定に失敗してもreturnはしない
-		log.Printf("error: failed to set user info: %v\n", err)
+		logger.Error(ctx, "failed to set user info", slog.Any("error", err))
 	}
 
 	return users, nil
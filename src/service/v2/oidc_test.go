@@ -29,7 +29,7 @@ func TestGenerateAuthState(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -60,7 +60,7 @@ func TestCallback(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -117,7 +117,7 @@ func TestCallback(t *testing.T) {
 			}
 			mockOIDCAuth.
 				EXPECT().
-				GetOIDCSession(ctx, oidcService.client, code, authState).
+				GetOIDCSession(gomock.Any(), oidcService.client, code, authState).
 				Return(session, testCase.GetOIDCSessionErr)
 
 			actualSession, err := oidcService.Callback(ctx, authState, code)
@@ -150,7 +150,7 @@ func TestLogout(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -190,7 +190,7 @@ func TestLogout(t *testing.T) {
 			)
 			mockOIDCAuth.
 				EXPECT().
-				RevokeOIDCSession(ctx, session).
+				RevokeOIDCSession(gomock.Any(), session).
 				Return(testCase.RevokeOIDCSessionErr)
 
 			err := oidcService.Logout(ctx, session)
@@ -218,7 +218,7 @@ func TestAuthenticate(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -290,7 +290,7 @@ func TestGetMe(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -370,17 +370,17 @@ func TestGetMe(t *testing.T) {
 
 			mockUserCache.
 				EXPECT().
-				GetMe(ctx, session.GetAccessToken()).
+				GetMe(gomock.Any(), session.GetAccessToken()).
 				Return(testCase.cacheUser, testCase.cacheGetMeErr)
 			if testCase.executeAuthGetMe {
 				mockUserAuth.
 					EXPECT().
-					GetMe(ctx, session).
+					GetMe(gomock.Any(), session).
 					Return(testCase.authUser, testCase.authGetMeErr)
 				if testCase.authGetMeErr == nil {
 					mockUserCache.
 						EXPECT().
-						SetMe(ctx, session, testCase.authUser).
+						SetMe(gomock.Any(), session, testCase.authUser).
 						Return(testCase.cacheSetMeErr)
 				}
 			}
@@ -415,7 +415,7 @@ func TestGetActiveUsers(t *testing.T) {
 
 	mockOIDCAuth := mockAuth.NewMockOIDC(ctrl)
 
-	mockConf := mockConfig.NewMockServiceV1(ctrl)
+	mockConf := mockConfig.NewMockServiceV2(ctrl)
 	mockConf.
 		EXPECT().
 		ClientID().
@@ -521,17 +521,17 @@ func TestGetActiveUsers(t *testing.T) {
 
 			mockUserCache.
 				EXPECT().
-				GetActiveUsers(ctx).
+				GetActiveUsers(gomock.Any()).
 				Return(testCase.cacheUsers, testCase.cacheGetAllActiveUsersErr)
 			if testCase.executeAuthGetAllActiveUsers {
 				mockUserAuth.
 					EXPECT().
-					GetActiveUsers(ctx, session).
+					GetActiveUsers(gomock.Any(), session).
 					Return(testCase.authUsers, testCase.authGetAllActiveUsersErr)
 				if testCase.authGetAllActiveUsersErr == nil {
 					mockUserCache.
 						EXPECT().
-						SetActiveUsers(ctx, testCase.authUsers).
+						SetActiveUsers(gomock.Any(), testCase.authUsers).
 						Return(testCase.cacheSetAllActiveUsersErr)
 				}
 			}
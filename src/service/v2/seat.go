@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/traPtitech/trap-collection-server/src/cache"
 	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/service"
 )
@@ -45,10 +46,13 @@ var (
 )
 
 func (s *Seat) GetSeats(ctx context.Context) ([]*domain.Seat, error) {
+	ctx, span := tracer.Start(ctx, "Seat.GetSeats")
+	defer span.End()
+
 	seats, err := s.seatCache.GetActiveSeats(ctx)
 	if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
 		// cacheからの取り出しに失敗しても、dbから取り出せば良いのでエラーは無視する
-		log.Printf("error: failed to get seats from cache: %v\n", err)
+		logger.Error(ctx, "failed to get seats from cache", slog.Any("error", err))
 	}
 	if err == nil {
 		return seats, nil
@@ -62,18 +66,24 @@ func (s *Seat) GetSeats(ctx context.Context) ([]*domain.Seat, error) {
 	err = s.seatCache.SetActiveSeats(ctx, seats)
 	if err != nil {
 		// cacheの設定に失敗しても致命傷ではないのでエラーを返さない
-		log.Printf("error: failed to set seats to cache: %v\n", err)
+		logger.Error(ctx, "failed to set seats to cache", slog.Any("error", err))
 	}
 
 	return seats, nil
 }
 
 func (s *Seat) UpdateSeatStatus(ctx context.Context, seatID values.SeatID, status values.SeatStatus) (*domain.Seat, error) {
+	ctx, span := tracer.Start(ctx, "Seat.UpdateSeatStatus")
+	defer span.End()
+
 	if status == values.SeatStatusNone {
 		return nil, service.ErrInvalidSeatStatus
 	}
 
-	var seat *domain.Seat
+	var (
+		seat    *domain.Seat
+		updated bool
+	)
 	err := s.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		var err error
 		seat, err = s.seatRepository.GetSeat(ctx, seatID, repository.LockTypeRecord)
@@ -105,6 +115,7 @@ func (s *Seat) UpdateSeatStatus(ctx context.Context, seatID values.SeatID, statu
 		if err != nil {
 			return fmt.Errorf("failed to update seats status: %w", err)
 		}
+		updated = true
 
 		return nil
 	})
@@ -112,10 +123,22 @@ func (s *Seat) UpdateSeatStatus(ctx context.Context, seatID values.SeatID, statu
 		return nil, fmt.Errorf("failed to update seat status: %w", err)
 	}
 
+	// 他のレプリカが古い座席の状態を返さないよう、コミット後にキャッシュを削除する
+	if updated {
+		err = s.seatCache.DeleteActiveSeats(ctx)
+		if err != nil {
+			// キャッシュはTTLで消えるので、削除に失敗してもエラーを返さない
+			logger.Error(ctx, "failed to delete seats from cache", slog.Any("error", err))
+		}
+	}
+
 	return seat, nil
 }
 
 func (s *Seat) UpdateSeatNum(ctx context.Context, num uint) ([]*domain.Seat, error) {
+	ctx, span := tracer.Start(ctx, "Seat.UpdateSeatNum")
+	defer span.End()
+
 	var activeSeats []*domain.Seat
 	err := s.db.Transaction(ctx, nil, func(ctx context.Context) error {
 		seats, err := s.seatRepository.GetSeats(ctx, repository.LockTypeNone)
@@ -195,7 +218,7 @@ func (s *Seat) UpdateSeatNum(ctx context.Context, num uint) ([]*domain.Seat, err
 	err = s.seatCache.SetActiveSeats(ctx, activeSeats)
 	if err != nil {
 		// cacheの設定に失敗しても致命傷ではないのでエラーを返さない
-		log.Printf("error: failed to set seats to cache: %v", err)
+		logger.Error(ctx, "failed to set seats to cache", slog.Any("error", err))
 	}
 
 	return activeSeats, nil
@@ -0,0 +1,180 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ service.Status = &Status{}
+
+type Status struct {
+	appConf             config.App
+	db                  repository.DB
+	migrationRepository repository.Migration
+	storageHealth       storage.Health
+	cacheHealth         cache.Health
+	buildInfo           service.BuildInfo
+
+	jobsLocker sync.RWMutex
+	jobs       map[string]*service.JobStatus
+}
+
+func NewStatus(
+	appConf config.App,
+	db repository.DB,
+	migrationRepository repository.Migration,
+	storageHealth storage.Health,
+	cacheHealth cache.Health,
+) *Status {
+	return &Status{
+		appConf:             appConf,
+		db:                  db,
+		migrationRepository: migrationRepository,
+		storageHealth:       storageHealth,
+		cacheHealth:         cacheHealth,
+		buildInfo:           readBuildInfo(),
+		jobs:                map[string]*service.JobStatus{},
+	}
+}
+
+// readBuildInfo
+// go buildで埋め込まれたビルドの情報を読み込む
+func readBuildInfo() service.BuildInfo {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return service.BuildInfo{}
+	}
+
+	buildInfo := service.BuildInfo{
+		Version:   info.Main.Version,
+		GoVersion: info.GoVersion,
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			buildInfo.Revision = setting.Value
+		}
+	}
+
+	return buildInfo
+}
+
+func (s *Status) CheckReadiness(ctx context.Context) []*service.DependencyStatus {
+	ctx, span := tracer.Start(ctx, "Status.CheckReadiness")
+	defer span.End()
+
+	_, migrationErr := s.checkMigration(ctx)
+
+	return s.checkDependencies(ctx, migrationErr)
+}
+
+func (s *Status) GetServerStatus(ctx context.Context) *service.ServerStatus {
+	ctx, span := tracer.Start(ctx, "Status.GetServerStatus")
+	defer span.End()
+
+	migration, migrationErr := s.checkMigration(ctx)
+
+	s.jobsLocker.RLock()
+	jobs := make([]*service.JobStatus, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		// 記録の更新の影響を受けないようにコピーする
+		copiedJob := *job
+		jobs = append(jobs, &copiedJob)
+	}
+	s.jobsLocker.RUnlock()
+
+	slices.SortFunc(jobs, func(a, b *service.JobStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return &service.ServerStatus{
+		Build:          s.buildInfo,
+		Migration:      migration,
+		FeatureV2:      s.appConf.FeatureV2(),
+		FeatureV1Write: s.appConf.FeatureV1Write(),
+		Dependencies:   s.checkDependencies(ctx, migrationErr),
+		Jobs:           jobs,
+	}
+}
+
+func (s *Status) RecordJobRun(name string, startedAt time.Time, finishedAt time.Time, err error) {
+	s.jobsLocker.Lock()
+	defer s.jobsLocker.Unlock()
+
+	s.jobs[name] = &service.JobStatus{
+		Name:           name,
+		LastStartedAt:  startedAt,
+		LastFinishedAt: finishedAt,
+		LastErr:        err,
+	}
+}
+
+// checkDependencies
+// マイグレーションの確認はサーバーの状態の取得でバージョンも使うので、結果を受け取る
+func (s *Status) checkDependencies(ctx context.Context, migrationErr error) []*service.DependencyStatus {
+	return []*service.DependencyStatus{
+		{
+			Name: service.DependencyDatabase,
+			Err:  s.checkDatabase(ctx),
+		},
+		{
+			Name: service.DependencyMigration,
+			Err:  migrationErr,
+		},
+		{
+			Name: service.DependencyStorage,
+			Err:  s.storageHealth.Ping(ctx),
+		},
+		{
+			Name: service.DependencyCache,
+			Err:  s.cacheHealth.Ping(ctx),
+		},
+	}
+}
+
+func (s *Status) checkDatabase(ctx context.Context) error {
+	sqlDB, err := s.db.Get()
+	if err != nil {
+		return fmt.Errorf("failed to get db: %w", err)
+	}
+
+	err = sqlDB.PingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ping db: %w", err)
+	}
+
+	return nil
+}
+
+// checkMigration
+// サーバーに含まれる全てのマイグレーションがDBに適用されているかを確認する。
+// ローリングアップデート中は新しいサーバーのマイグレーションが適用されていることがあるので、
+// DBのバージョンの方が新しい場合は問題としない。
+func (s *Status) checkMigration(ctx context.Context) (*service.MigrationVersions, error) {
+	current, latest, err := s.migrationRepository.GetMigrationVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration versions: %w", err)
+	}
+
+	versions := &service.MigrationVersions{
+		Current: current,
+		Latest:  latest,
+	}
+
+	// バージョンは同じ桁数のタイムスタンプなので、文字列の比較で新旧を比べられる
+	if current < latest {
+		return versions, fmt.Errorf("migration is not applied (current: %s, latest: %s)", current, latest)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,253 @@
+package v2
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mockCache "github.com/traPtitech/trap-collection-server/src/cache/mock"
+	mockConfig "github.com/traPtitech/trap-collection-server/src/config/mock"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+// pingConnector
+// 接続時にerrを返すことで、sql.DBのPingContextの結果を差し替えるためのdriver.Connector
+type pingConnector struct {
+	err error
+}
+
+func (c *pingConnector) Connect(context.Context) (driver.Conn, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &pingConn{}, nil
+}
+
+func (c *pingConnector) Driver() driver.Driver {
+	return nil
+}
+
+type pingConn struct{}
+
+func (*pingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (*pingConn) Close() error {
+	return nil
+}
+
+func (*pingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestCheckReadiness(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		getDBErr         error
+		pingErr          error
+		currentMigration string
+		latestMigration  string
+		getMigrationErr  error
+		storageErr       error
+		cacheErr         error
+		expectErrs       map[service.DependencyName]bool
+	}
+
+	testCases := []test{
+		{
+			description:      "全て正常なのでエラーなし",
+			currentMigration: "20250101000000",
+			latestMigration:  "20250101000000",
+			expectErrs:       map[service.DependencyName]bool{},
+		},
+		{
+			description:      "DBの取得に失敗したのでdatabaseがエラー",
+			getDBErr:         errors.New("error"),
+			currentMigration: "20250101000000",
+			latestMigration:  "20250101000000",
+			expectErrs:       map[service.DependencyName]bool{service.DependencyDatabase: true},
+		},
+		{
+			description:      "DBに接続できないのでdatabaseがエラー",
+			pingErr:          errors.New("error"),
+			currentMigration: "20250101000000",
+			latestMigration:  "20250101000000",
+			expectErrs:       map[service.DependencyName]bool{service.DependencyDatabase: true},
+		},
+		{
+			description:      "マイグレーションが適用されていないのでmigrationがエラー",
+			currentMigration: "20250101000000",
+			latestMigration:  "20250201000000",
+			expectErrs:       map[service.DependencyName]bool{service.DependencyMigration: true},
+		},
+		{
+			description:      "DBの方が新しいマイグレーションが適用されていてもエラーなし",
+			currentMigration: "20250201000000",
+			latestMigration:  "20250101000000",
+			expectErrs:       map[service.DependencyName]bool{},
+		},
+		{
+			description:     "マイグレーションのバージョンの取得に失敗したのでmigrationがエラー",
+			getMigrationErr: errors.New("error"),
+			expectErrs:      map[service.DependencyName]bool{service.DependencyMigration: true},
+		},
+		{
+			description:      "ストレージに接続できないのでstorageがエラー",
+			currentMigration: "20250101000000",
+			latestMigration:  "20250101000000",
+			storageErr:       errors.New("error"),
+			expectErrs:       map[service.DependencyName]bool{service.DependencyStorage: true},
+		},
+		{
+			description:      "キャッシュに接続できないのでcacheがエラー",
+			currentMigration: "20250101000000",
+			latestMigration:  "20250101000000",
+			cacheErr:         errors.New("error"),
+			expectErrs:       map[service.DependencyName]bool{service.DependencyCache: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockAppConf := mockConfig.NewMockApp(ctrl)
+			mockDB := mockRepository.NewMockDB(ctrl)
+			mockMigrationRepository := mockRepository.NewMockMigration(ctrl)
+			mockStorageHealth := mockStorage.NewHealth(ctrl)
+			mockCacheHealth := mockCache.NewMockHealth(ctrl)
+
+			statusService := NewStatus(mockAppConf, mockDB, mockMigrationRepository, mockStorageHealth, mockCacheHealth)
+
+			var sqlDB *sql.DB
+			if testCase.getDBErr == nil {
+				sqlDB = sql.OpenDB(&pingConnector{err: testCase.pingErr})
+				t.Cleanup(func() {
+					_ = sqlDB.Close()
+				})
+			}
+
+			mockDB.
+				EXPECT().
+				Get().
+				Return(sqlDB, testCase.getDBErr)
+			mockMigrationRepository.
+				EXPECT().
+				GetMigrationVersions(gomock.Any()).
+				Return(testCase.currentMigration, testCase.latestMigration, testCase.getMigrationErr)
+			mockStorageHealth.
+				EXPECT().
+				Ping(gomock.Any()).
+				Return(testCase.storageErr)
+			mockCacheHealth.
+				EXPECT().
+				Ping(gomock.Any()).
+				Return(testCase.cacheErr)
+
+			dependencies := statusService.CheckReadiness(context.Background())
+
+			assert.Len(t, dependencies, 4)
+			for _, dependency := range dependencies {
+				if testCase.expectErrs[dependency.Name] {
+					assert.Error(t, dependency.Err, dependency.Name)
+				} else {
+					assert.NoError(t, dependency.Err, dependency.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetServerStatus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockAppConf := mockConfig.NewMockApp(ctrl)
+	mockDB := mockRepository.NewMockDB(ctrl)
+	mockMigrationRepository := mockRepository.NewMockMigration(ctrl)
+	mockStorageHealth := mockStorage.NewHealth(ctrl)
+	mockCacheHealth := mockCache.NewMockHealth(ctrl)
+
+	statusService := NewStatus(mockAppConf, mockDB, mockMigrationRepository, mockStorageHealth, mockCacheHealth)
+
+	sqlDB := sql.OpenDB(&pingConnector{})
+	t.Cleanup(func() {
+		_ = sqlDB.Close()
+	})
+
+	mockAppConf.
+		EXPECT().
+		FeatureV2().
+		Return(true)
+	mockAppConf.
+		EXPECT().
+		FeatureV1Write().
+		Return(false)
+	mockDB.
+		EXPECT().
+		Get().
+		Return(sqlDB, nil)
+	mockMigrationRepository.
+		EXPECT().
+		GetMigrationVersions(gomock.Any()).
+		Return("20250101000000", "20250201000000", nil)
+	mockStorageHealth.
+		EXPECT().
+		Ping(gomock.Any()).
+		Return(nil)
+	mockCacheHealth.
+		EXPECT().
+		Ping(gomock.Any()).
+		Return(nil)
+
+	now := time.Now()
+	jobErr := errors.New("job error")
+	statusService.RecordJobRun("b", now.Add(-2*time.Hour), now.Add(-time.Hour), nil)
+	statusService.RecordJobRun("a", now.Add(-time.Hour), now.Add(-time.Minute), nil)
+	// 同じジョブの記録は最後の実行結果で上書きされる
+	statusService.RecordJobRun("b", now.Add(-time.Minute), now, jobErr)
+
+	status := statusService.GetServerStatus(context.Background())
+
+	assert.True(t, status.FeatureV2)
+	assert.False(t, status.FeatureV1Write)
+	assert.NotEmpty(t, status.Build.GoVersion)
+	assert.Equal(t, &service.MigrationVersions{
+		Current: "20250101000000",
+		Latest:  "20250201000000",
+	}, status.Migration)
+
+	for _, dependency := range status.Dependencies {
+		if dependency.Name == service.DependencyMigration {
+			assert.Error(t, dependency.Err)
+		} else {
+			assert.NoError(t, dependency.Err, dependency.Name)
+		}
+	}
+
+	assert.Equal(t, []*service.JobStatus{
+		{
+			Name:           "a",
+			LastStartedAt:  now.Add(-time.Hour),
+			LastFinishedAt: now.Add(-time.Minute),
+		},
+		{
+			Name:           "b",
+			LastStartedAt:  now.Add(-time.Minute),
+			LastFinishedAt: now,
+			LastErr:        jobErr,
+		},
+	}, status.Jobs)
+}
@@ -0,0 +1,290 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"golang.org/x/sync/errgroup"
+)
+
+var _ service.StorageMigration = &StorageMigration{}
+
+var (
+	errStorageMigrationSourceNotFound = errors.New("object not found in source storage")
+	errStorageMigrationHashMismatch   = errors.New("hash mismatch")
+)
+
+type StorageMigration struct {
+	gameRepository      repository.GameV2
+	gameFileRepository  repository.GameFileV2
+	gameImageRepository repository.GameImageV2
+	gameVideoRepository repository.GameVideoV2
+	source              storage.Objects
+	target              storage.Objects
+}
+
+// NewStorageMigration
+// sourceが移行元、targetが移行先のストレージ。
+func NewStorageMigration(
+	gameRepository repository.GameV2,
+	gameFileRepository repository.GameFileV2,
+	gameImageRepository repository.GameImageV2,
+	gameVideoRepository repository.GameVideoV2,
+	source storage.Objects,
+	target storage.Objects,
+) *StorageMigration {
+	return &StorageMigration{
+		gameRepository:      gameRepository,
+		gameFileRepository:  gameFileRepository,
+		gameImageRepository: gameImageRepository,
+		gameVideoRepository: gameVideoRepository,
+		source:              source,
+		target:              target,
+	}
+}
+
+type storageMigrationObject struct {
+	kind storage.ObjectKind
+	id   string
+	// hash ゲームファイルのみ設定される
+	hash values.GameFileHash
+}
+
+func (sm *StorageMigration) MigrateStorage(ctx context.Context, verifyExisting bool, onProgress func(progress service.StorageMigrationProgress)) (service.StorageMigrationProgress, error) {
+	ctx, span := tracer.Start(ctx, "StorageMigration.MigrateStorage")
+	defer span.End()
+
+	objects, err := sm.listObjects(ctx)
+	if err != nil {
+		return service.StorageMigrationProgress{}, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	progress := service.StorageMigrationProgress{
+		Total: len(objects),
+	}
+	for _, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return progress, fmt.Errorf("context done: %w", err)
+		}
+
+		copied, err := sm.migrateObject(ctx, object, verifyExisting)
+		switch {
+		case err != nil:
+			logger.Error(ctx, "failed to migrate object", slog.Any("kind", object.kind), slog.String("id", object.id), slog.Any("error", err))
+			progress.Failed++
+		case copied:
+			progress.Copied++
+		default:
+			progress.Skipped++
+		}
+		progress.Done++
+
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+
+	if progress.Failed > 0 {
+		return progress, fmt.Errorf("%d objects failed: %w", progress.Failed, service.ErrStorageMigrationFailed)
+	}
+
+	return progress, nil
+}
+
+// listObjects
+// データベースに記録されている、移行対象の全てのオブジェクトを取得する。
+func (sm *StorageMigration) listObjects(ctx context.Context) ([]*storageMigrationObject, error) {
+	games, _, err := sm.gameRepository.GetGames(ctx, 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	objects := []*storageMigrationObject{}
+	for _, game := range games {
+		gameID := game.GetGame().GetID()
+
+		files, err := sm.gameFileRepository.GetGameFiles(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game files: %w", err)
+		}
+		for _, file := range files {
+			objects = append(objects, &storageMigrationObject{
+				kind: storage.ObjectKindGameFile,
+				id:   uuid.UUID(file.GetID()).String(),
+				hash: file.GetHash(),
+			})
+		}
+
+		images, err := sm.gameImageRepository.GetGameImages(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game images: %w", err)
+		}
+		for _, image := range images {
+			objects = append(objects, &storageMigrationObject{
+				kind: storage.ObjectKindGameImage,
+				id:   uuid.UUID(image.GetID()).String(),
+			})
+
+			variants, err := sm.gameImageRepository.GetGameImageVariants(ctx, image.GetID(), repository.LockTypeNone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get game image variants: %w", err)
+			}
+			for _, variant := range variants {
+				objects = append(objects, &storageMigrationObject{
+					kind: storage.ObjectKindGameImageVariant,
+					id:   uuid.UUID(variant.GetID()).String(),
+				})
+			}
+		}
+
+		videos, err := sm.gameVideoRepository.GetGameVideos(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game videos: %w", err)
+		}
+		for _, video := range videos {
+			objects = append(objects, &storageMigrationObject{
+				kind: storage.ObjectKindGameVideo,
+				id:   uuid.UUID(video.GetID()).String(),
+			})
+
+			if _, ok := video.GetPosterType().Value(); ok {
+				objects = append(objects, &storageMigrationObject{
+					kind: storage.ObjectKindGameVideoPoster,
+					id:   uuid.UUID(video.GetID()).String(),
+				})
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// migrateObject
+// オブジェクトを移行先にコピーする。
+// 移行先に既に存在し、コピーしなかった場合はfalseを返す。
+func (sm *StorageMigration) migrateObject(ctx context.Context, object *storageMigrationObject, verifyExisting bool) (bool, error) {
+	if object.kind == storage.ObjectKindGameFile {
+		var err error
+		object, err = sm.resolveGameFileObject(ctx, object)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve game file object: %w", err)
+		}
+	}
+
+	exists, err := sm.target.ExistsObject(ctx, object.kind, object.id)
+	if err != nil {
+		return false, fmt.Errorf("failed to check target object: %w", err)
+	}
+	if exists {
+		if !verifyExisting || object.hash == nil {
+			return false, nil
+		}
+
+		hash, err := sm.hashObject(ctx, sm.target, object)
+		if err != nil {
+			return false, fmt.Errorf("failed to hash target object: %w", err)
+		}
+		if bytes.Equal(hash, object.hash) {
+			return false, nil
+		}
+
+		logger.Warn(ctx, "hash mismatch in target storage, copy again", slog.Any("kind", object.kind), slog.String("id", object.id))
+	}
+
+	exists, err = sm.source.ExistsObject(ctx, object.kind, object.id)
+	if err != nil {
+		return false, fmt.Errorf("failed to check source object: %w", err)
+	}
+	if !exists {
+		return false, errStorageMigrationSourceNotFound
+	}
+
+	err = sm.copyObject(ctx, object)
+	if err != nil {
+		return false, fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	if object.hash != nil {
+		// 転送中に壊れていないか、移行先から読み直して確認する
+		hash, err := sm.hashObject(ctx, sm.target, object)
+		if err != nil {
+			return false, fmt.Errorf("failed to hash copied object: %w", err)
+		}
+		if !bytes.Equal(hash, object.hash) {
+			return false, fmt.Errorf("expected %s, actual %s: %w", object.hash, hash, errStorageMigrationHashMismatch)
+		}
+	}
+
+	return true, nil
+}
+
+// resolveGameFileObject
+// ゲームファイルは、移行元にハッシュ値をキーとした実体があればそれを、
+// なければ重複排除の導入前のファイルIDをキーとしたオブジェクトを移行する。
+// 同じ実体を参照するゲームファイルは、2つ目以降は移行先に存在するのでコピーされない。
+func (sm *StorageMigration) resolveGameFileObject(ctx context.Context, object *storageMigrationObject) (*storageMigrationObject, error) {
+	blobObject := &storageMigrationObject{
+		kind: storage.ObjectKindGameFileBlob,
+		id:   object.hash.String(),
+		hash: object.hash,
+	}
+
+	exists, err := sm.source.ExistsObject(ctx, blobObject.kind, blobObject.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check source object: %w", err)
+	}
+	if exists {
+		return blobObject, nil
+	}
+
+	return object, nil
+}
+
+func (sm *StorageMigration) copyObject(ctx context.Context, object *storageMigrationObject) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	pr, pw := io.Pipe()
+
+	eg.Go(func() error {
+		err := sm.source.LoadObject(ctx, object.kind, object.id, pw)
+		if err != nil {
+			pw.CloseWithError(err)
+			return fmt.Errorf("failed to load object: %w", err)
+		}
+
+		return pw.Close()
+	})
+
+	eg.Go(func() error {
+		defer pr.Close()
+
+		err := sm.target.SaveObject(ctx, object.kind, object.id, pr)
+		if err != nil {
+			return fmt.Errorf("failed to save object: %w", err)
+		}
+
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+func (sm *StorageMigration) hashObject(ctx context.Context, objects storage.Objects, object *storageMigrationObject) (values.GameFileHash, error) {
+	h := md5.New()
+	err := objects.LoadObject(ctx, object.kind, object.id, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load object: %w", err)
+	}
+
+	return values.NewGameFileHashFromBytes(h.Sum(nil)), nil
+}
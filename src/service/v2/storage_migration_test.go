@@ -0,0 +1,352 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMigrateStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	content := "file content"
+	contentHash := md5.Sum([]byte(content))
+
+	type test struct {
+		description         string
+		verifyExisting      bool
+		getGamesErr         error
+		sourceBlobExists    bool
+		targetExists        bool
+		executeSourceExists bool
+		sourceExists        bool
+		executeCopy         bool
+		saveErr             error
+		// targetContents 移行先からLoadObjectで読み込まれる内容(呼ばれる順)
+		targetContents []string
+		expect         service.StorageMigrationProgress
+		isErr          bool
+		err            error
+	}
+
+	testCases := []test{
+		{
+			description:         "移行先に存在しないのでコピーする",
+			executeSourceExists: true,
+			sourceExists:        true,
+			executeCopy:         true,
+			targetContents:      []string{content},
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Copied: 1},
+		},
+		{
+			description:         "移行元にハッシュ値をキーとした実体が存在するのでそれをコピーする",
+			sourceBlobExists:    true,
+			executeSourceExists: true,
+			sourceExists:        true,
+			executeCopy:         true,
+			targetContents:      []string{content},
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Copied: 1},
+		},
+		{
+			description:  "移行先に存在するのでコピーしない",
+			targetExists: true,
+			expect:       service.StorageMigrationProgress{Total: 1, Done: 1, Skipped: 1},
+		},
+		{
+			description:    "verifyExistingでハッシュ値が一致するのでコピーしない",
+			verifyExisting: true,
+			targetExists:   true,
+			targetContents: []string{content},
+			expect:         service.StorageMigrationProgress{Total: 1, Done: 1, Skipped: 1},
+		},
+		{
+			description:         "verifyExistingでハッシュ値が一致しないのでコピーし直す",
+			verifyExisting:      true,
+			targetExists:        true,
+			executeSourceExists: true,
+			sourceExists:        true,
+			executeCopy:         true,
+			targetContents:      []string{"broken", content},
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Copied: 1},
+		},
+		{
+			description:         "移行元に存在しないので失敗",
+			executeSourceExists: true,
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Failed: 1},
+			isErr:               true,
+			err:                 service.ErrStorageMigrationFailed,
+		},
+		{
+			description:         "SaveObjectがエラーなので失敗",
+			executeSourceExists: true,
+			sourceExists:        true,
+			executeCopy:         true,
+			saveErr:             errors.New("error"),
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Failed: 1},
+			isErr:               true,
+			err:                 service.ErrStorageMigrationFailed,
+		},
+		{
+			description:         "コピー後のハッシュ値が一致しないので失敗",
+			executeSourceExists: true,
+			sourceExists:        true,
+			executeCopy:         true,
+			targetContents:      []string{"broken"},
+			expect:              service.StorageMigrationProgress{Total: 1, Done: 1, Failed: 1},
+			isErr:               true,
+			err:                 service.ErrStorageMigrationFailed,
+		},
+		{
+			description: "GetGamesがエラーなのでエラー",
+			getGamesErr: errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+			mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+			targetBuf := bytes.NewBuffer(nil)
+			mockSource := mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil))
+			mockTarget := mockStorage.NewObjects(ctrl, targetBuf)
+
+			storageMigrationService := NewStorageMigration(
+				mockGameRepository,
+				mockGameFileRepository,
+				mockGameImageRepository,
+				mockGameVideoRepository,
+				mockSource,
+				mockTarget,
+			)
+
+			game := domain.NewGame(values.NewGameID(), "test", "test", values.GameVisibilityTypePublic, time.Now())
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				values.NewGameFileHashFromBytes(contentHash[:]),
+				time.Now(),
+			)
+			objectKind := storage.ObjectKindGameFile
+			objectID := uuid.UUID(file.GetID()).String()
+			if testCase.sourceBlobExists {
+				objectKind = storage.ObjectKindGameFileBlob
+				objectID = file.GetHash().String()
+			}
+
+			if testCase.getGamesErr != nil {
+				mockGameRepository.
+					EXPECT().
+					GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+					Return(nil, 0, testCase.getGamesErr)
+			} else {
+				mockGameRepository.
+					EXPECT().
+					GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+					Return([]*domain.GameWithGenres{domain.NewGameWithGenres(game, nil)}, 1, nil)
+				mockGameFileRepository.
+					EXPECT().
+					GetGameFiles(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameFile{file}, nil)
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImages(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameImage{}, nil)
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideos(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameVideo{}, nil)
+
+				mockSource.
+					EXPECT().
+					ExistsObject(gomock.Any(), storage.ObjectKindGameFileBlob, file.GetHash().String()).
+					Return(testCase.sourceBlobExists, nil)
+				mockTarget.
+					EXPECT().
+					ExistsObject(gomock.Any(), objectKind, objectID).
+					Return(testCase.targetExists, nil)
+			}
+
+			if testCase.executeSourceExists {
+				mockSource.
+					EXPECT().
+					ExistsObject(gomock.Any(), objectKind, objectID).
+					Return(testCase.sourceExists, nil)
+			}
+
+			if testCase.executeCopy {
+				mockSource.
+					EXPECT().
+					LoadObject(gomock.Any(), objectKind, objectID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ storage.ObjectKind, _ string, writer io.Writer) error {
+						_, err := io.WriteString(writer, content)
+						return err
+					})
+				mockTarget.
+					EXPECT().
+					SaveObject(gomock.Any(), objectKind, objectID).
+					Return(testCase.saveErr)
+			}
+
+			targetContents := testCase.targetContents
+			if len(targetContents) > 0 {
+				mockTarget.
+					EXPECT().
+					LoadObject(gomock.Any(), objectKind, objectID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ storage.ObjectKind, _ string, writer io.Writer) error {
+						targetContent := targetContents[0]
+						targetContents = targetContents[1:]
+
+						_, err := io.WriteString(writer, targetContent)
+						return err
+					}).
+					Times(len(targetContents))
+			}
+
+			progresses := []service.StorageMigrationProgress{}
+			progress, err := storageMigrationService.MigrateStorage(ctx, testCase.verifyExisting, func(progress service.StorageMigrationProgress) {
+				progresses = append(progresses, progress)
+			})
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testCase.getGamesErr != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, progress)
+			assert.Equal(t, []service.StorageMigrationProgress{testCase.expect}, progresses)
+
+			if testCase.executeCopy {
+				assert.Equal(t, content, targetBuf.String())
+			}
+		})
+	}
+}
+
+func TestMigrateStorageObjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+	mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockSource := mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil))
+	mockTarget := mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil))
+
+	storageMigrationService := NewStorageMigration(
+		mockGameRepository,
+		mockGameFileRepository,
+		mockGameImageRepository,
+		mockGameVideoRepository,
+		mockSource,
+		mockTarget,
+	)
+
+	game := domain.NewGame(values.NewGameID(), "test", "test", values.GameVisibilityTypePublic, time.Now())
+	file := domain.NewGameFile(
+		values.NewGameFileID(),
+		values.GameFileTypeJar,
+		values.NewGameFileEntryPoint("main.jar"),
+		values.NewGameFileHashFromBytes([]byte("hash")),
+		time.Now(),
+	)
+	image := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+	variant := domain.NewGameImageVariant(values.NewGameImageVariantID(), values.GameImageSizeSmall, values.GameImageTypePng, 320, 160, time.Now())
+	video := domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now())
+	videoWithPoster := domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now())
+	videoWithPoster.SetPosterType(values.GameImageTypePng)
+
+	mockGameRepository.
+		EXPECT().
+		GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+		Return([]*domain.GameWithGenres{domain.NewGameWithGenres(game, nil)}, 1, nil)
+	mockGameFileRepository.
+		EXPECT().
+		GetGameFiles(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameFile{file}, nil)
+	mockGameImageRepository.
+		EXPECT().
+		GetGameImages(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameImage{image}, nil)
+	mockGameImageRepository.
+		EXPECT().
+		GetGameImageVariants(gomock.Any(), image.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameImageVariant{variant}, nil)
+	mockGameVideoRepository.
+		EXPECT().
+		GetGameVideos(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameVideo{video, videoWithPoster}, nil)
+
+	type object struct {
+		kind storage.ObjectKind
+		id   string
+	}
+	expectObjects := []object{
+		{kind: storage.ObjectKindGameFile, id: uuid.UUID(file.GetID()).String()},
+		{kind: storage.ObjectKindGameImage, id: uuid.UUID(image.GetID()).String()},
+		{kind: storage.ObjectKindGameImageVariant, id: uuid.UUID(variant.GetID()).String()},
+		{kind: storage.ObjectKindGameVideo, id: uuid.UUID(video.GetID()).String()},
+		{kind: storage.ObjectKindGameVideo, id: uuid.UUID(videoWithPoster.GetID()).String()},
+		{kind: storage.ObjectKindGameVideoPoster, id: uuid.UUID(videoWithPoster.GetID()).String()},
+	}
+
+	mockSource.
+		EXPECT().
+		ExistsObject(gomock.Any(), storage.ObjectKindGameFileBlob, file.GetHash().String()).
+		Return(false, nil)
+
+	actualObjects := []object{}
+	mockTarget.
+		EXPECT().
+		ExistsObject(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, kind storage.ObjectKind, id string) (bool, error) {
+			actualObjects = append(actualObjects, object{kind: kind, id: id})
+			return true, nil
+		}).
+		Times(len(expectObjects))
+
+	progress, err := storageMigrationService.MigrateStorage(ctx, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, service.StorageMigrationProgress{
+		Total:   len(expectObjects),
+		Done:    len(expectObjects),
+		Skipped: len(expectObjects),
+	}, progress)
+	assert.Equal(t, expectObjects, actualObjects)
+}
@@ -0,0 +1,112 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ service.StorageSizeBackfill = &StorageSizeBackfill{}
+
+type StorageSizeBackfill struct {
+	gameStorageRepository repository.GameStorageV2
+	objects               storage.Objects
+}
+
+func NewStorageSizeBackfill(
+	gameStorageRepository repository.GameStorageV2,
+	objects storage.Objects,
+) *StorageSizeBackfill {
+	return &StorageSizeBackfill{
+		gameStorageRepository: gameStorageRepository,
+		objects:               objects,
+	}
+}
+
+func (sb *StorageSizeBackfill) BackfillStorageSizes(ctx context.Context) (service.StorageSizeBackfillResult, error) {
+	ctx, span := tracer.Start(ctx, "StorageSizeBackfill.BackfillStorageSizes")
+	defer span.End()
+
+	objects, err := sb.gameStorageRepository.GetGameStorageObjectsWithoutSize(ctx)
+	if err != nil {
+		return service.StorageSizeBackfillResult{}, fmt.Errorf("failed to get game storage objects without size: %w", err)
+	}
+
+	result := service.StorageSizeBackfillResult{
+		Total: len(objects),
+	}
+	for _, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return result, fmt.Errorf("context done: %w", err)
+		}
+
+		size, err := sb.loadObjectSize(ctx, object)
+		if err == nil {
+			err = sb.gameStorageRepository.UpdateGameStorageObjectSize(ctx, object.Kind, object.ID, size)
+		}
+		if err != nil {
+			logger.Error(ctx, "failed to backfill storage size", slog.Any("kind", object.Kind), slog.String("id", object.ID.String()), slog.Any("error", err))
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	if result.Failed > 0 {
+		return result, fmt.Errorf("%d objects failed: %w", result.Failed, service.ErrStorageSizeBackfillFailed)
+	}
+
+	return result, nil
+}
+
+// loadObjectSize
+// オブジェクトをストレージから読み込み、その容量を返す。
+func (sb *StorageSizeBackfill) loadObjectSize(ctx context.Context, object *repository.GameStorageObject) (values.GameStorageSize, error) {
+	var (
+		storageKind storage.ObjectKind
+		storageID   = object.ID.String()
+	)
+	switch object.Kind {
+	case repository.GameStorageObjectKindFile:
+		// ゲームファイルは、ハッシュ値をキーとした実体があればそれを、
+		// なければ重複排除の導入前のファイルIDをキーとしたオブジェクトを読み込む。
+		storageKind = storage.ObjectKindGameFile
+		exists, err := sb.objects.ExistsObject(ctx, storage.ObjectKindGameFileBlob, object.Hash.String())
+		if err != nil {
+			return 0, fmt.Errorf("failed to check game file blob: %w", err)
+		}
+		if exists {
+			storageKind, storageID = storage.ObjectKindGameFileBlob, object.Hash.String()
+		}
+	case repository.GameStorageObjectKindImage:
+		storageKind = storage.ObjectKindGameImage
+	case repository.GameStorageObjectKindVideo:
+		storageKind = storage.ObjectKindGameVideo
+	default:
+		return 0, fmt.Errorf("invalid game storage object kind: %d", object.Kind)
+	}
+
+	writer := &storageSizeCountWriter{}
+	err := sb.objects.LoadObject(ctx, storageKind, storageID, writer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load object: %w", err)
+	}
+
+	return values.GameStorageSize(writer.size), nil
+}
+
+type storageSizeCountWriter struct {
+	size int64
+}
+
+func (w *storageSizeCountWriter) Write(p []byte) (int, error) {
+	w.size += int64(len(p))
+	return len(p), nil
+}
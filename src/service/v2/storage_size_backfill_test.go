@@ -0,0 +1,221 @@
+package v2
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBackfillStorageSizes(t *testing.T) {
+	t.Parallel()
+
+	content := "file content"
+	contentHash := md5.Sum([]byte(content))
+	hash := values.NewGameFileHashFromBytes(contentHash[:])
+
+	type test struct {
+		description   string
+		object        *repository.GameStorageObject
+		getObjectsErr error
+		executeExists bool
+		blobExists    bool
+		existsErr     error
+		executeLoad   bool
+		storageKind   storage.ObjectKind
+		storageID     string
+		loadErr       error
+		executeUpdate bool
+		updateErr     error
+		expect        service.StorageSizeBackfillResult
+		isErr         bool
+		err           error
+	}
+
+	fileID := uuid.New()
+	imageID := uuid.New()
+	videoID := uuid.New()
+
+	testCases := []test{
+		{
+			description: "ゲームファイルの容量を記録できる",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindFile,
+				ID:   fileID,
+				Hash: hash,
+			},
+			executeExists: true,
+			executeLoad:   true,
+			storageKind:   storage.ObjectKindGameFile,
+			storageID:     fileID.String(),
+			executeUpdate: true,
+			expect:        service.StorageSizeBackfillResult{Total: 1, Updated: 1},
+		},
+		{
+			description: "ハッシュ値をキーとした実体の容量を記録する",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindFile,
+				ID:   fileID,
+				Hash: hash,
+			},
+			executeExists: true,
+			blobExists:    true,
+			executeLoad:   true,
+			storageKind:   storage.ObjectKindGameFileBlob,
+			storageID:     hash.String(),
+			executeUpdate: true,
+			expect:        service.StorageSizeBackfillResult{Total: 1, Updated: 1},
+		},
+		{
+			description: "ゲーム画像の容量を記録できる",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindImage,
+				ID:   imageID,
+			},
+			executeLoad:   true,
+			storageKind:   storage.ObjectKindGameImage,
+			storageID:     imageID.String(),
+			executeUpdate: true,
+			expect:        service.StorageSizeBackfillResult{Total: 1, Updated: 1},
+		},
+		{
+			description: "ゲーム動画の容量を記録できる",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindVideo,
+				ID:   videoID,
+			},
+			executeLoad:   true,
+			storageKind:   storage.ObjectKindGameVideo,
+			storageID:     videoID.String(),
+			executeUpdate: true,
+			expect:        service.StorageSizeBackfillResult{Total: 1, Updated: 1},
+		},
+		{
+			description: "容量が記録されていないものがなくてもエラーなし",
+			expect:      service.StorageSizeBackfillResult{},
+		},
+		{
+			description:   "GetGameStorageObjectsWithoutSizeがエラーなのでエラー",
+			getObjectsErr: errors.New("error"),
+			isErr:         true,
+		},
+		{
+			description: "ExistsObjectがエラーなのでErrStorageSizeBackfillFailed",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindFile,
+				ID:   fileID,
+				Hash: hash,
+			},
+			executeExists: true,
+			existsErr:     errors.New("error"),
+			expect:        service.StorageSizeBackfillResult{Total: 1, Failed: 1},
+			isErr:         true,
+			err:           service.ErrStorageSizeBackfillFailed,
+		},
+		{
+			description: "LoadObjectがエラーなのでErrStorageSizeBackfillFailed",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindImage,
+				ID:   imageID,
+			},
+			executeLoad: true,
+			storageKind: storage.ObjectKindGameImage,
+			storageID:   imageID.String(),
+			loadErr:     storage.ErrNotFound,
+			expect:      service.StorageSizeBackfillResult{Total: 1, Failed: 1},
+			isErr:       true,
+			err:         service.ErrStorageSizeBackfillFailed,
+		},
+		{
+			description: "UpdateGameStorageObjectSizeがエラーなのでErrStorageSizeBackfillFailed",
+			object: &repository.GameStorageObject{
+				Kind: repository.GameStorageObjectKindVideo,
+				ID:   videoID,
+			},
+			executeLoad:   true,
+			storageKind:   storage.ObjectKindGameVideo,
+			storageID:     videoID.String(),
+			executeUpdate: true,
+			updateErr:     errors.New("error"),
+			expect:        service.StorageSizeBackfillResult{Total: 1, Failed: 1},
+			isErr:         true,
+			err:           service.ErrStorageSizeBackfillFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockGameStorageRepository := mockRepository.NewMockGameStorageV2(ctrl)
+			mockObjects := mockStorage.NewObjects(ctrl, nil)
+
+			storageSizeBackfill := NewStorageSizeBackfill(mockGameStorageRepository, mockObjects)
+
+			var objects []*repository.GameStorageObject
+			if testCase.object != nil {
+				objects = []*repository.GameStorageObject{testCase.object}
+			}
+
+			mockGameStorageRepository.
+				EXPECT().
+				GetGameStorageObjectsWithoutSize(gomock.Any()).
+				Return(objects, testCase.getObjectsErr)
+
+			if testCase.executeExists {
+				mockObjects.
+					EXPECT().
+					ExistsObject(gomock.Any(), storage.ObjectKindGameFileBlob, hash.String()).
+					Return(testCase.blobExists, testCase.existsErr)
+			}
+
+			if testCase.executeLoad {
+				mockObjects.
+					EXPECT().
+					LoadObject(gomock.Any(), testCase.storageKind, testCase.storageID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ storage.ObjectKind, _ string, writer io.Writer) error {
+						if testCase.loadErr != nil {
+							return testCase.loadErr
+						}
+
+						_, err := io.WriteString(writer, content)
+						return err
+					})
+			}
+
+			if testCase.executeUpdate {
+				mockGameStorageRepository.
+					EXPECT().
+					UpdateGameStorageObjectSize(gomock.Any(), testCase.object.Kind, testCase.object.ID, values.GameStorageSize(len(content))).
+					Return(testCase.updateErr)
+			}
+
+			result, err := storageSizeBackfill.BackfillStorageSizes(context.Background())
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.expect, result)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ service.StorageVerification = &StorageVerification{}
+
+type StorageVerification struct {
+	gameRepository                repository.GameV2
+	gameFileRepository            repository.GameFileV2
+	gameImageRepository           repository.GameImageV2
+	gameVideoRepository           repository.GameVideoV2
+	storageVerificationRepository repository.StorageVerification
+	objects                       storage.Objects
+}
+
+func NewStorageVerification(
+	gameRepository repository.GameV2,
+	gameFileRepository repository.GameFileV2,
+	gameImageRepository repository.GameImageV2,
+	gameVideoRepository repository.GameVideoV2,
+	storageVerificationRepository repository.StorageVerification,
+	objects storage.Objects,
+) *StorageVerification {
+	return &StorageVerification{
+		gameRepository:                gameRepository,
+		gameFileRepository:            gameFileRepository,
+		gameImageRepository:           gameImageRepository,
+		gameVideoRepository:           gameVideoRepository,
+		storageVerificationRepository: storageVerificationRepository,
+		objects:                       objects,
+	}
+}
+
+var (
+	storageVerificationObjectsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "service_trap_collection",
+		Subsystem: "storage_verification",
+		Name:      "objects",
+		Help:      "The number of objects by result in the last completed storage verification",
+	}, []string{"result"})
+	storageVerificationLastFinishedGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "service_trap_collection",
+		Subsystem: "storage_verification",
+		Name:      "last_finished_timestamp_seconds",
+		Help:      "The unix time when the last storage verification completed",
+	})
+)
+
+// storageVerificationIssueBatchSize
+// 見つかった問題をDBに保存する単位。
+// 途中で止まっても、それまでに見つかった問題を確認できるようにする。
+const storageVerificationIssueBatchSize = 100
+
+type storageVerificationObject struct {
+	kind        values.StorageVerificationObjectKind
+	storageKind storage.ObjectKind
+	id          uuid.UUID
+	gameID      values.GameID
+	// hash ゲームファイルのみ設定される
+	hash values.GameFileHash
+}
+
+func (sv *StorageVerification) VerifyStorage(ctx context.Context) (*domain.StorageVerification, error) {
+	ctx, span := tracer.Start(ctx, "StorageVerification.VerifyStorage")
+	defer span.End()
+
+	verification := domain.NewStorageVerification(
+		values.NewStorageVerificationID(),
+		values.StorageVerificationStatusRunning,
+		time.Now(),
+	)
+	err := sv.storageVerificationRepository.SaveStorageVerification(ctx, verification)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save storage verification: %w", err)
+	}
+
+	objects, err := sv.listObjects(ctx)
+	if err != nil {
+		sv.abort(ctx, verification, nil)
+		return verification, fmt.Errorf("failed to list objects: %w", err)
+	}
+	verification.SetProgress(0, len(objects))
+
+	issues := make([]*domain.StorageVerificationIssue, 0, storageVerificationIssueBatchSize)
+	for i, object := range objects {
+		if err := ctx.Err(); err != nil {
+			sv.abort(ctx, verification, issues)
+			return verification, fmt.Errorf("context done: %w", err)
+		}
+
+		issue := sv.verifyObject(ctx, object)
+		if err := ctx.Err(); err != nil {
+			// キャンセルによる読み込みの失敗を問題として記録しないようにする
+			sv.abort(ctx, verification, issues)
+			return verification, fmt.Errorf("context done: %w", err)
+		}
+		if issue != nil {
+			logger.Warn(ctx, "storage verification issue found",
+				slog.Any("kind", object.kind),
+				slog.String("id", object.id.String()),
+				slog.Any("issueType", issue.GetIssueType()),
+				slog.String("detail", issue.GetDetail()),
+			)
+			verification.AddIssue(issue.GetIssueType())
+			issues = append(issues, issue)
+		}
+		verification.SetProgress(i+1, len(objects))
+
+		if len(issues) >= storageVerificationIssueBatchSize {
+			err := sv.saveProgress(ctx, verification, issues)
+			if err != nil {
+				sv.abort(ctx, verification, nil)
+				return verification, err
+			}
+			issues = issues[:0]
+		}
+	}
+
+	verification.Finish(values.StorageVerificationStatusCompleted, time.Now())
+	err = sv.saveProgress(ctx, verification, issues)
+	if err != nil {
+		sv.abort(ctx, verification, nil)
+		return verification, err
+	}
+
+	sv.recordMetrics(verification)
+
+	if verification.GetTotalIssues() > 0 {
+		return verification, fmt.Errorf("%d objects have issues: %w", verification.GetTotalIssues(), service.ErrStorageVerificationIssuesFound)
+	}
+
+	return verification, nil
+}
+
+func (sv *StorageVerification) GetLatestStorageVerification(ctx context.Context, issueLimit int) (*domain.StorageVerification, []*domain.StorageVerificationIssue, error) {
+	ctx, span := tracer.Start(ctx, "StorageVerification.GetLatestStorageVerification")
+	defer span.End()
+
+	if issueLimit < 0 {
+		return nil, nil, service.ErrInvalidLimit
+	}
+
+	verification, err := sv.storageVerificationRepository.GetLatestStorageVerification(ctx)
+	if errors.Is(err, repository.ErrRecordNotFound) {
+		return nil, nil, service.ErrNoStorageVerification
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest storage verification: %w", err)
+	}
+
+	issues, err := sv.storageVerificationRepository.GetStorageVerificationIssues(ctx, verification.GetID(), issueLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get storage verification issues: %w", err)
+	}
+
+	return verification, issues, nil
+}
+
+// saveProgress
+// 見つかった問題と検証の進捗をDBに保存する。
+func (sv *StorageVerification) saveProgress(ctx context.Context, verification *domain.StorageVerification, issues []*domain.StorageVerificationIssue) error {
+	err := sv.storageVerificationRepository.SaveStorageVerificationIssues(ctx, verification.GetID(), issues)
+	if err != nil {
+		return fmt.Errorf("failed to save storage verification issues: %w", err)
+	}
+
+	err = sv.storageVerificationRepository.UpdateStorageVerification(ctx, verification)
+	if err != nil {
+		return fmt.Errorf("failed to update storage verification: %w", err)
+	}
+
+	return nil
+}
+
+// abort
+// 検証を失敗として記録する。
+// キャンセルされた場合も記録できるよう、ctxのキャンセルは引き継がない。
+func (sv *StorageVerification) abort(ctx context.Context, verification *domain.StorageVerification, issues []*domain.StorageVerificationIssue) {
+	ctx = context.WithoutCancel(ctx)
+
+	verification.Finish(values.StorageVerificationStatusFailed, time.Now())
+	err := sv.saveProgress(ctx, verification, issues)
+	if err != nil {
+		logger.Error(ctx, "failed to record storage verification failure", slog.Any("error", err))
+	}
+}
+
+func (sv *StorageVerification) recordMetrics(verification *domain.StorageVerification) {
+	missing := verification.GetIssueCount(values.StorageVerificationIssueTypeMissing)
+	hashMismatch := verification.GetIssueCount(values.StorageVerificationIssueTypeHashMismatch)
+	checkFailed := verification.GetIssueCount(values.StorageVerificationIssueTypeCheckFailed)
+
+	storageVerificationObjectsGauge.WithLabelValues("ok").Set(float64(verification.GetCheckedObjects() - verification.GetTotalIssues()))
+	storageVerificationObjectsGauge.WithLabelValues("missing").Set(float64(missing))
+	storageVerificationObjectsGauge.WithLabelValues("hash_mismatch").Set(float64(hashMismatch))
+	storageVerificationObjectsGauge.WithLabelValues("check_failed").Set(float64(checkFailed))
+
+	if finishedAt, ok := verification.GetFinishedAt().Value(); ok {
+		storageVerificationLastFinishedGauge.Set(float64(finishedAt.Unix()))
+	}
+}
+
+// listObjects
+// データベースに記録されている、検証対象の全てのオブジェクトを取得する。
+func (sv *StorageVerification) listObjects(ctx context.Context) ([]*storageVerificationObject, error) {
+	games, _, err := sv.gameRepository.GetGames(ctx, 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get games: %w", err)
+	}
+
+	objects := []*storageVerificationObject{}
+	for _, game := range games {
+		gameID := game.GetGame().GetID()
+
+		files, err := sv.gameFileRepository.GetGameFiles(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game files: %w", err)
+		}
+		for _, file := range files {
+			objects = append(objects, &storageVerificationObject{
+				kind:        values.StorageVerificationObjectKindGameFile,
+				storageKind: storage.ObjectKindGameFile,
+				id:          uuid.UUID(file.GetID()),
+				gameID:      gameID,
+				hash:        file.GetHash(),
+			})
+		}
+
+		images, err := sv.gameImageRepository.GetGameImages(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game images: %w", err)
+		}
+		for _, image := range images {
+			objects = append(objects, &storageVerificationObject{
+				kind:        values.StorageVerificationObjectKindGameImage,
+				storageKind: storage.ObjectKindGameImage,
+				id:          uuid.UUID(image.GetID()),
+				gameID:      gameID,
+			})
+
+			variants, err := sv.gameImageRepository.GetGameImageVariants(ctx, image.GetID(), repository.LockTypeNone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get game image variants: %w", err)
+			}
+			for _, variant := range variants {
+				objects = append(objects, &storageVerificationObject{
+					kind:        values.StorageVerificationObjectKindGameImageVariant,
+					storageKind: storage.ObjectKindGameImageVariant,
+					id:          uuid.UUID(variant.GetID()),
+					gameID:      gameID,
+				})
+			}
+		}
+
+		videos, err := sv.gameVideoRepository.GetGameVideos(ctx, gameID, repository.LockTypeNone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game videos: %w", err)
+		}
+		for _, video := range videos {
+			objects = append(objects, &storageVerificationObject{
+				kind:        values.StorageVerificationObjectKindGameVideo,
+				storageKind: storage.ObjectKindGameVideo,
+				id:          uuid.UUID(video.GetID()),
+				gameID:      gameID,
+			})
+
+			if _, ok := video.GetPosterType().Value(); ok {
+				objects = append(objects, &storageVerificationObject{
+					kind:        values.StorageVerificationObjectKindGameVideoPoster,
+					storageKind: storage.ObjectKindGameVideoPoster,
+					id:          uuid.UUID(video.GetID()),
+					gameID:      gameID,
+				})
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// verifyObject
+// オブジェクトを検証し、問題があればその内容を返す。
+// 問題がない場合はnilを返す。
+func (sv *StorageVerification) verifyObject(ctx context.Context, object *storageVerificationObject) *domain.StorageVerificationIssue {
+	newIssue := func(issueType values.StorageVerificationIssueType, detail string) *domain.StorageVerificationIssue {
+		return domain.NewStorageVerificationIssue(object.kind, object.id, object.gameID, issueType, detail)
+	}
+
+	storageKind, storageID := object.storageKind, object.id.String()
+	if object.hash != nil {
+		// ゲームファイルは、ハッシュ値をキーとした実体があればそれを、
+		// なければ重複排除の導入前のファイルIDをキーとしたオブジェクトを検証する。
+		exists, err := sv.objects.ExistsObject(ctx, storage.ObjectKindGameFileBlob, object.hash.String())
+		if err != nil {
+			return newIssue(values.StorageVerificationIssueTypeCheckFailed, err.Error())
+		}
+		if exists {
+			storageKind, storageID = storage.ObjectKindGameFileBlob, object.hash.String()
+		}
+	}
+
+	exists, err := sv.objects.ExistsObject(ctx, storageKind, storageID)
+	if err != nil {
+		return newIssue(values.StorageVerificationIssueTypeCheckFailed, err.Error())
+	}
+	if !exists {
+		return newIssue(values.StorageVerificationIssueTypeMissing, "")
+	}
+
+	if object.hash == nil {
+		return nil
+	}
+
+	h := md5.New()
+	err = sv.objects.LoadObject(ctx, storageKind, storageID, h)
+	if err != nil {
+		return newIssue(values.StorageVerificationIssueTypeCheckFailed, err.Error())
+	}
+
+	hash := values.NewGameFileHashFromBytes(h.Sum(nil))
+	if !bytes.Equal(hash, object.hash) {
+		return newIssue(
+			values.StorageVerificationIssueTypeHashMismatch,
+			fmt.Sprintf("expected %s, actual %s", object.hash, hash),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,523 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	mockRepository "github.com/traPtitech/trap-collection-server/src/repository/mock"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	mockStorage "github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyStorage(t *testing.T) {
+	t.Parallel()
+
+	content := "file content"
+	contentHash := md5.Sum([]byte(content))
+
+	type test struct {
+		description   string
+		saveErr       error
+		getGamesErr   error
+		blobExists    bool
+		existsErr     error
+		executeExists bool
+		exists        bool
+		executeLoad   bool
+		loadContent   string
+		loadErr       error
+		imageExists   bool
+		// expectIssueTypes 記録される問題の種類(ゲームファイル、ゲーム画像の順)
+		expectIssueTypes []values.StorageVerificationIssueType
+		expectStatus     values.StorageVerificationStatus
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description:   "問題が無いのでエラーなし",
+			executeExists: true,
+			exists:        true,
+			executeLoad:   true,
+			loadContent:   content,
+			imageExists:   true,
+			expectStatus:  values.StorageVerificationStatusCompleted,
+		},
+		{
+			description:   "ハッシュ値をキーとした実体を検証する",
+			blobExists:    true,
+			executeExists: true,
+			exists:        true,
+			executeLoad:   true,
+			loadContent:   content,
+			imageExists:   true,
+			expectStatus:  values.StorageVerificationStatusCompleted,
+		},
+		{
+			description:   "ゲームファイルが存在しないのでErrStorageVerificationIssuesFound",
+			executeExists: true,
+			imageExists:   true,
+			expectIssueTypes: []values.StorageVerificationIssueType{
+				values.StorageVerificationIssueTypeMissing,
+			},
+			expectStatus: values.StorageVerificationStatusCompleted,
+			isErr:        true,
+			err:          service.ErrStorageVerificationIssuesFound,
+		},
+		{
+			description:   "ハッシュ値が一致しないのでErrStorageVerificationIssuesFound",
+			executeExists: true,
+			exists:        true,
+			executeLoad:   true,
+			loadContent:   "broken",
+			imageExists:   true,
+			expectIssueTypes: []values.StorageVerificationIssueType{
+				values.StorageVerificationIssueTypeHashMismatch,
+			},
+			expectStatus: values.StorageVerificationStatusCompleted,
+			isErr:        true,
+			err:          service.ErrStorageVerificationIssuesFound,
+		},
+		{
+			description:   "読み込みに失敗したのでErrStorageVerificationIssuesFound",
+			executeExists: true,
+			exists:        true,
+			executeLoad:   true,
+			loadErr:       errors.New("error"),
+			imageExists:   true,
+			expectIssueTypes: []values.StorageVerificationIssueType{
+				values.StorageVerificationIssueTypeCheckFailed,
+			},
+			expectStatus: values.StorageVerificationStatusCompleted,
+			isErr:        true,
+			err:          service.ErrStorageVerificationIssuesFound,
+		},
+		{
+			description:  "存在の確認に失敗したのでErrStorageVerificationIssuesFound",
+			existsErr:    errors.New("error"),
+			imageExists:  true,
+			expectStatus: values.StorageVerificationStatusCompleted,
+			expectIssueTypes: []values.StorageVerificationIssueType{
+				values.StorageVerificationIssueTypeCheckFailed,
+			},
+			isErr: true,
+			err:   service.ErrStorageVerificationIssuesFound,
+		},
+		{
+			description:   "ゲーム画像が存在しないのでErrStorageVerificationIssuesFound",
+			executeExists: true,
+			exists:        true,
+			executeLoad:   true,
+			loadContent:   content,
+			expectIssueTypes: []values.StorageVerificationIssueType{
+				values.StorageVerificationIssueTypeMissing,
+			},
+			expectStatus: values.StorageVerificationStatusCompleted,
+			isErr:        true,
+			err:          service.ErrStorageVerificationIssuesFound,
+		},
+		{
+			description:  "GetGamesがエラーなので失敗として記録する",
+			getGamesErr:  errors.New("error"),
+			expectStatus: values.StorageVerificationStatusFailed,
+			isErr:        true,
+		},
+		{
+			description: "SaveStorageVerificationがエラーなのでエラー",
+			saveErr:     errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+			mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+			mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+			mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+			mockStorageVerificationRepository := mockRepository.NewMockStorageVerification(ctrl)
+			mockObjects := mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil))
+
+			storageVerificationService := NewStorageVerification(
+				mockGameRepository,
+				mockGameFileRepository,
+				mockGameImageRepository,
+				mockGameVideoRepository,
+				mockStorageVerificationRepository,
+				mockObjects,
+			)
+
+			game := domain.NewGame(values.NewGameID(), "test", "test", values.GameVisibilityTypePublic, time.Now())
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				values.NewGameFileHashFromBytes(contentHash[:]),
+				time.Now(),
+			)
+			image := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+
+			objectKind := storage.ObjectKindGameFile
+			objectID := uuid.UUID(file.GetID()).String()
+			if testCase.blobExists {
+				objectKind = storage.ObjectKindGameFileBlob
+				objectID = file.GetHash().String()
+			}
+
+			mockStorageVerificationRepository.
+				EXPECT().
+				SaveStorageVerification(gomock.Any(), gomock.Any()).
+				Return(testCase.saveErr)
+			if testCase.saveErr != nil {
+				_, err := storageVerificationService.VerifyStorage(t.Context())
+				assert.Error(t, err)
+				return
+			}
+
+			if testCase.getGamesErr != nil {
+				mockGameRepository.
+					EXPECT().
+					GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+					Return(nil, 0, testCase.getGamesErr)
+			} else {
+				mockGameRepository.
+					EXPECT().
+					GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+					Return([]*domain.GameWithGenres{domain.NewGameWithGenres(game, nil)}, 1, nil)
+				mockGameFileRepository.
+					EXPECT().
+					GetGameFiles(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameFile{file}, nil)
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImages(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameImage{image}, nil)
+				mockGameImageRepository.
+					EXPECT().
+					GetGameImageVariants(gomock.Any(), image.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameImageVariant{}, nil)
+				mockGameVideoRepository.
+					EXPECT().
+					GetGameVideos(gomock.Any(), game.GetID(), repository.LockTypeNone).
+					Return([]*domain.GameVideo{}, nil)
+
+				mockObjects.
+					EXPECT().
+					ExistsObject(gomock.Any(), storage.ObjectKindGameFileBlob, file.GetHash().String()).
+					Return(testCase.blobExists, testCase.existsErr)
+				mockObjects.
+					EXPECT().
+					ExistsObject(gomock.Any(), storage.ObjectKindGameImage, uuid.UUID(image.GetID()).String()).
+					Return(testCase.imageExists, nil)
+			}
+
+			if testCase.executeExists {
+				mockObjects.
+					EXPECT().
+					ExistsObject(gomock.Any(), objectKind, objectID).
+					Return(testCase.exists, nil)
+			}
+
+			if testCase.executeLoad {
+				mockObjects.
+					EXPECT().
+					LoadObject(gomock.Any(), objectKind, objectID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ storage.ObjectKind, _ string, writer io.Writer) error {
+						if testCase.loadErr != nil {
+							return testCase.loadErr
+						}
+
+						_, err := io.WriteString(writer, testCase.loadContent)
+						return err
+					})
+			}
+
+			var savedIssues []*domain.StorageVerificationIssue
+			mockStorageVerificationRepository.
+				EXPECT().
+				SaveStorageVerificationIssues(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ values.StorageVerificationID, issues []*domain.StorageVerificationIssue) error {
+					savedIssues = append(savedIssues, issues...)
+					return nil
+				})
+			var updatedVerification *domain.StorageVerification
+			mockStorageVerificationRepository.
+				EXPECT().
+				UpdateStorageVerification(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, verification *domain.StorageVerification) error {
+					updatedVerification = verification
+					return nil
+				})
+
+			verification, err := storageVerificationService.VerifyStorage(t.Context())
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if !assert.NotNil(t, verification) {
+				return
+			}
+			assert.Equal(t, verification, updatedVerification)
+			assert.Equal(t, testCase.expectStatus, verification.GetStatus())
+			_, finished := verification.GetFinishedAt().Value()
+			assert.True(t, finished)
+
+			if testCase.getGamesErr != nil {
+				assert.Zero(t, verification.GetTotalObjects())
+				assert.Empty(t, savedIssues)
+				return
+			}
+
+			assert.Equal(t, 2, verification.GetTotalObjects())
+			assert.Equal(t, 2, verification.GetCheckedObjects())
+			assert.Equal(t, len(testCase.expectIssueTypes), verification.GetTotalIssues())
+
+			actualIssueTypes := make([]values.StorageVerificationIssueType, 0, len(savedIssues))
+			for _, issue := range savedIssues {
+				assert.Equal(t, game.GetID(), issue.GetGameID())
+				actualIssueTypes = append(actualIssueTypes, issue.GetIssueType())
+			}
+			if len(testCase.expectIssueTypes) == 0 {
+				assert.Empty(t, actualIssueTypes)
+			} else {
+				assert.Equal(t, testCase.expectIssueTypes, actualIssueTypes)
+			}
+		})
+	}
+}
+
+func TestVerifyStorageCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	mockGameRepository := mockRepository.NewMockGameV2(ctrl)
+	mockGameFileRepository := mockRepository.NewMockGameFileV2(ctrl)
+	mockGameImageRepository := mockRepository.NewMockGameImageV2(ctrl)
+	mockGameVideoRepository := mockRepository.NewMockGameVideoV2(ctrl)
+	mockStorageVerificationRepository := mockRepository.NewMockStorageVerification(ctrl)
+	mockObjects := mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil))
+
+	storageVerificationService := NewStorageVerification(
+		mockGameRepository,
+		mockGameFileRepository,
+		mockGameImageRepository,
+		mockGameVideoRepository,
+		mockStorageVerificationRepository,
+		mockObjects,
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	game := domain.NewGame(values.NewGameID(), "test", "test", values.GameVisibilityTypePublic, time.Now())
+	image1 := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+	image2 := domain.NewGameImage(values.NewGameImageID(), values.GameImageTypePng, time.Now())
+
+	mockStorageVerificationRepository.
+		EXPECT().
+		SaveStorageVerification(gomock.Any(), gomock.Any()).
+		Return(nil)
+	mockGameRepository.
+		EXPECT().
+		GetGames(gomock.Any(), 0, 0, repository.GamesSortTypeCreatedAt, nil, nil, nil, "", "").
+		Return([]*domain.GameWithGenres{domain.NewGameWithGenres(game, nil)}, 1, nil)
+	mockGameFileRepository.
+		EXPECT().
+		GetGameFiles(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameFile{}, nil)
+	mockGameImageRepository.
+		EXPECT().
+		GetGameImages(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameImage{image1, image2}, nil)
+	mockGameImageRepository.
+		EXPECT().
+		GetGameImageVariants(gomock.Any(), gomock.Any(), repository.LockTypeNone).
+		Return([]*domain.GameImageVariant{}, nil).
+		Times(2)
+	mockGameVideoRepository.
+		EXPECT().
+		GetGameVideos(gomock.Any(), game.GetID(), repository.LockTypeNone).
+		Return([]*domain.GameVideo{}, nil)
+
+	// 1つ目の検証中にキャンセルされる
+	mockObjects.
+		EXPECT().
+		ExistsObject(gomock.Any(), storage.ObjectKindGameImage, uuid.UUID(image1.GetID()).String()).
+		DoAndReturn(func(_ context.Context, _ storage.ObjectKind, _ string) (bool, error) {
+			cancel()
+			return false, context.Canceled
+		})
+
+	mockStorageVerificationRepository.
+		EXPECT().
+		SaveStorageVerificationIssues(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ values.StorageVerificationID, issues []*domain.StorageVerificationIssue) error {
+			assert.NoError(t, ctx.Err())
+			assert.Empty(t, issues)
+			return nil
+		})
+	mockStorageVerificationRepository.
+		EXPECT().
+		UpdateStorageVerification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *domain.StorageVerification) error {
+			assert.NoError(t, ctx.Err())
+			return nil
+		})
+
+	verification, err := storageVerificationService.VerifyStorage(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	if !assert.NotNil(t, verification) {
+		return
+	}
+	assert.Equal(t, values.StorageVerificationStatusFailed, verification.GetStatus())
+	assert.Equal(t, 2, verification.GetTotalObjects())
+	assert.Zero(t, verification.GetCheckedObjects())
+	assert.Zero(t, verification.GetTotalIssues())
+}
+
+func TestGetLatestStorageVerification(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		issueLimit       int
+		executeGetLatest bool
+		getLatestErr     error
+		executeGetIssues bool
+		getIssuesErr     error
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description:      "特に問題ないのでエラーなし",
+			issueLimit:       100,
+			executeGetLatest: true,
+			executeGetIssues: true,
+		},
+		{
+			description:      "issueLimitが0でも全て取得できる",
+			executeGetLatest: true,
+			executeGetIssues: true,
+		},
+		{
+			description: "issueLimitが負なのでErrInvalidLimit",
+			issueLimit:  -1,
+			isErr:       true,
+			err:         service.ErrInvalidLimit,
+		},
+		{
+			description:      "検証が行われていないのでErrNoStorageVerification",
+			issueLimit:       100,
+			executeGetLatest: true,
+			getLatestErr:     repository.ErrRecordNotFound,
+			isErr:            true,
+			err:              service.ErrNoStorageVerification,
+		},
+		{
+			description:      "GetLatestStorageVerificationがエラーなのでエラー",
+			issueLimit:       100,
+			executeGetLatest: true,
+			getLatestErr:     errors.New("error"),
+			isErr:            true,
+		},
+		{
+			description:      "GetStorageVerificationIssuesがエラーなのでエラー",
+			issueLimit:       100,
+			executeGetLatest: true,
+			executeGetIssues: true,
+			getIssuesErr:     errors.New("error"),
+			isErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			mockStorageVerificationRepository := mockRepository.NewMockStorageVerification(ctrl)
+
+			storageVerificationService := NewStorageVerification(
+				mockRepository.NewMockGameV2(ctrl),
+				mockRepository.NewMockGameFileV2(ctrl),
+				mockRepository.NewMockGameImageV2(ctrl),
+				mockRepository.NewMockGameVideoV2(ctrl),
+				mockStorageVerificationRepository,
+				mockStorage.NewObjects(ctrl, bytes.NewBuffer(nil)),
+			)
+
+			verification := domain.NewStorageVerification(
+				values.NewStorageVerificationID(),
+				values.StorageVerificationStatusCompleted,
+				time.Now(),
+			)
+			issues := []*domain.StorageVerificationIssue{
+				domain.NewStorageVerificationIssue(
+					values.StorageVerificationObjectKindGameImage,
+					uuid.New(),
+					values.NewGameID(),
+					values.StorageVerificationIssueTypeMissing,
+					"",
+				),
+			}
+
+			if testCase.executeGetLatest {
+				mockStorageVerificationRepository.
+					EXPECT().
+					GetLatestStorageVerification(gomock.Any()).
+					Return(verification, testCase.getLatestErr)
+			}
+			if testCase.executeGetIssues {
+				mockStorageVerificationRepository.
+					EXPECT().
+					GetStorageVerificationIssues(gomock.Any(), verification.GetID(), testCase.issueLimit).
+					Return(issues, testCase.getIssuesErr)
+			}
+
+			actualVerification, actualIssues, err := storageVerificationService.GetLatestStorageVerification(t.Context(), testCase.issueLimit)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil || testCase.isErr {
+				return
+			}
+
+			assert.Equal(t, verification, actualVerification)
+			assert.Equal(t, issues, actualIssues)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package v2
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/traPtitech/trap-collection-server/src/service/v2")
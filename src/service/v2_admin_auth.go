@@ -29,4 +29,13 @@ type AdminAuthV2 interface {
 	//adminでなければErrForbiddenを返し、adminならnilを返す。
 	//sessionが切れている場合はErrOIDCSessionExpiredを返す。
 	AdminAuthorize(ctx context.Context, session *domain.OIDCSession) error
+	//AddAdminByOperator
+	//CLIからadminを追加する。
+	//traQのセッションがないため、ユーザーの存在は確認しない。
+	//既にユーザーがadminのとき、ErrNoAdminsUpdatedを返す。
+	AddAdminByOperator(ctx context.Context, userID values.TraPMemberID) error
+	//DeleteAdminByOperator
+	//CLIからadminを削除する。
+	//ユーザーがadminでないとき、ErrNotAdminを返す。
+	DeleteAdminByOperator(ctx context.Context, userID values.TraPMemberID) error
 }
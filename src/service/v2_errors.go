@@ -12,4 +12,22 @@ var (
 	ErrNoAdminsUpdated                    = errors.New("no admins updated")
 	ErrNotAdmin                           = errors.New("not admin")
 	ErrCannotDeleteMeFromAdmins           = errors.New("cannot delete myself from admins")
+	ErrInvalidAuditLogPeriod              = errors.New("invalid audit log period")
+	ErrInvalidGameRoleInvitationID        = errors.New("invalid game role invitation id")
+	ErrGameRoleInvitationExpired          = errors.New("game role invitation expired")
+	ErrGameRoleInvitationAlreadyAnswered  = errors.New("game role invitation already answered")
+	ErrDuplicateGameRoleInvitation        = errors.New("duplicate game role invitation")
+	ErrCannotMergeSameGameGenre           = errors.New("cannot merge game genre into itself")
+	ErrInvalidGameGenreParent             = errors.New("invalid game genre parent")
+	ErrNoGameGenreAlias                   = errors.New("no game genre alias")
+	ErrGameStorageQuotaExceeded           = errors.New("game storage quota exceeded")
+	ErrInvalidGameStorageQuota            = errors.New("invalid game storage quota")
+	ErrNoGameStorageQuota                 = errors.New("no game storage quota")
+	ErrGameFileNotClean                   = errors.New("game file is not clean")
+	ErrZipTooManyEntries                  = errors.New("too many entries in zip file")
+	ErrZipTooLarge                        = errors.New("uncompressed size of zip file is too large")
+	ErrInvalidZipEntries                  = errors.New("invalid entries in zip file")
+	ErrInvalidEditionBundleID             = errors.New("invalid edition bundle id")
+	ErrEditionBundleNotCompleted          = errors.New("edition bundle is not completed")
+	ErrEditionBundleUnavailable           = errors.New("edition bundle is unavailable")
 )
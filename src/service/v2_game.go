@@ -30,28 +30,37 @@ type GameV2 interface {
 	// visibilitiesは取得するゲームの公開範囲を指定。空配列の場合は全ての公開範囲のゲームを取得。
 	// gameGenreIDsは取得するゲームのジャンルを指定。空配列の場合は全てのジャンルのゲームを取得。
 	// gameNameはゲーム名の部分一致検索。空文字の場合は全てのゲームを取得。
+	// keywordはゲーム名・説明・作成者名からの検索。空文字の場合は全てのゲームを取得。
 	// 返り値のintは制限をかけない場合のゲーム数
 	// sortTypeがおかしいときErrInvalidGamesSortType
 	GetGames(
 		ctx context.Context, limit int, offset int, sort GamesSortType,
-		visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string) (int, []*domain.GameWithGenres, error)
+		visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (int, []*domain.GameWithGenres, error)
 
 	// GetMyGames
 	// ログイン中のユーザーが作ったゲームを制限をかけて取得。limitは取得上限、offsetは取得開始位置。limitが0のときは全て取得。
 	// visibilitiesは取得するゲームの公開範囲を指定。空配列の場合は全ての公開範囲のゲームを取得。
 	// gameGenreIDsは取得するゲームのジャンルを指定。空配列の場合は全てのジャンルのゲームを取得。
 	// gameNameはゲーム名の部分一致検索。空文字の場合は全てのゲームを取得。
+	// keywordはゲーム名・説明・作成者名からの検索。空文字の場合は全てのゲームを取得。
 	// 返り値のintは制限をかけない場合のゲーム数
 	// sortTypeがおかしいときErrInvalidGamesSortType
 	GetMyGames(
 		ctx context.Context, session *domain.OIDCSession, limit int, offset int, sort GamesSortType,
-		visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string) (int, []*domain.GameWithGenres, error)
+		visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (int, []*domain.GameWithGenres, error)
+
+	// GetGameFacets
+	// GetGames、GetMyGamesと同じ条件に一致するゲームの、ジャンル・ファイルの種類・公開範囲ごとの数を取得する。
+	// sessionがnilの場合は全てのゲーム、そうでない場合はログイン中のユーザーが作ったゲームについて数える。
+	GetGameFacets(
+		ctx context.Context, session *domain.OIDCSession,
+		visibilities []values.GameVisibility, gameGenreIDs []values.GameGenreID, gameName string, keyword string) (*domain.GameFacets, error)
 
 	// UpdateGame
 	// ゲームのidを指定して情報（名前、説明）を修正する。
 	// idが一致するゲームが存在しなかった場合、ErrNoGameを返す。
 	// visibilityが変わらないときはnilを指定する。
-	UpdateGame(ctx context.Context, gameID values.GameID, name values.GameName, description values.GameDescription, visibility *values.GameVisibility) (*domain.Game, error)
+	UpdateGame(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, name values.GameName, description values.GameDescription, visibility *values.GameVisibility) (*domain.Game, error)
 
 	// DeleteGame
 	// ゲームのidを指定してゲームを削除する。
@@ -73,4 +82,6 @@ type GamesSortType int
 const (
 	GamesSortTypeCreatedAt GamesSortType = iota
 	GamesSortTypeLatestVersion
+	GamesSortTypePlayCount
+	GamesSortTypeLatestFeedback
 )
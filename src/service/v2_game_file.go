@@ -4,6 +4,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/traPtitech/trap-collection-server/src/domain"
@@ -14,8 +15,13 @@ type GameFileV2 interface {
 	// SaveGameFile
 	// ゲームファイルの保存。
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
-	// ファイルがzipファイルでないとき、ErrNotZipFileを返す。
+	// ファイルがzipファイルでないとき、もしくは展開できないエントリーを含むとき、ErrNotZipFileを返す。
 	// ファイルがzipファイルであっても、エントリーポイントが存在しない場合、ErrInvalidEntryPointを返す。
+	// zipファイルのエントリー数が上限を超える場合、ErrZipTooManyEntriesを返す。
+	// zipファイルの展開後の合計サイズが上限を超える場合、ErrZipTooLargeを返す。
+	// 不正なエントリーが含まれる場合、それらの一覧を持つ*InvalidZipEntriesErrorを返す。
+	// 保存するとゲームのストレージの上限を超える場合、ErrGameStorageQuotaExceededを返す。
+	// 保存したファイルは未検査の状態になり、ScanGameFilesで検査されるまでゲームバージョンに紐づけられない。
 	SaveGameFile(ctx context.Context, reader io.Reader, gameID values.GameID, fileType values.GameFileType, entryPoint values.GameFileEntryPoint) (*domain.GameFile, error)
 	// GetGameFile
 	// ゲームファイル一覧の取得。
@@ -32,4 +38,69 @@ type GameFileV2 interface {
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
 	// ゲームファイルIDに対応するゲームファイルが存在しない場合、ErrInvalidGameFileIDを返す。
 	GetGameFileMeta(ctx context.Context, gameID values.GameID, fileID values.GameFileID) (*domain.GameFile, error)
+	// ScanGameFiles
+	// 未検査のゲームファイルの内容を検査し、検査の結果を記録する。
+	// 検査に失敗したファイルは未検査のまま残し、次回の実行で再度検査する。
+	// 失敗し続けたファイルやストレージに存在しないファイルは検査の失敗として記録し、RescanGameFileが呼ばれるまで検査しない。
+	ScanGameFiles(ctx context.Context) error
+	// UpdateGameFileScanStatus
+	// adminによるゲームファイルの検査の状態の変更。
+	// 誤検知されたファイルを配信できるようにする場合などに使う。
+	// 検査の状態にはcleanかrejectedのみ指定でき、それ以外の場合はErrInvalidGameFileScanStatusを返す。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// ゲームファイルIDに対応するゲームファイルが存在しない、
+	// もしくは存在しても紐づくゲームのゲームIDが異なる場合、ErrInvalidGameFileIDを返す。
+	UpdateGameFileScanStatus(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, fileID values.GameFileID, scanStatus values.GameFileScanStatus) (*domain.GameFile, error)
+	// RescanGameFile
+	// adminによるゲームファイルの再検査の指示。
+	// ゲームファイルを未検査に戻し、ScanGameFilesで再度検査されるようにする。
+	// 検査を通過したファイルも、再度検査を通過するまで配信できなくなる。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// ゲームファイルIDに対応するゲームファイルが存在しない、
+	// もしくは存在しても紐づくゲームのゲームIDが異なる場合、ErrInvalidGameFileIDを返す。
+	RescanGameFile(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, fileID values.GameFileID) (*domain.GameFile, error)
+}
+
+// ZipEntryErrorReason
+// zipファイルのエントリーが不正である理由。
+type ZipEntryErrorReason int
+
+const (
+	// ZipEntryErrorReasonAbsolutePath
+	// 絶対パスである。
+	ZipEntryErrorReasonAbsolutePath ZipEntryErrorReason = iota
+	// ZipEntryErrorReasonPathTraversal
+	// ..を含み、展開先のディレクトリの外を指しうる。
+	ZipEntryErrorReasonPathTraversal
+	// ZipEntryErrorReasonSymlink
+	// シンボリックリンクである。
+	ZipEntryErrorReasonSymlink
+	// ZipEntryErrorReasonDuplicateName
+	// 同じ名前のエントリーが既に存在する。
+	ZipEntryErrorReasonDuplicateName
+	// ZipEntryErrorReasonCompressionRatio
+	// 圧縮率が上限を超えている。
+	ZipEntryErrorReasonCompressionRatio
+)
+
+type ZipEntryError struct {
+	// Name
+	// zipファイル内でのエントリーの名前。
+	Name   string
+	Reason ZipEntryErrorReason
+}
+
+// InvalidZipEntriesError
+// zipファイルに含まれる不正なエントリーの一覧を持つエラー。
+// errors.Is(err, ErrInvalidZipEntries)で判定できる。
+type InvalidZipEntriesError struct {
+	Entries []*ZipEntryError
+}
+
+func (e *InvalidZipEntriesError) Error() string {
+	return fmt.Sprintf("%s: %d entries", ErrInvalidZipEntries, len(e.Entries))
+}
+
+func (*InvalidZipEntriesError) Unwrap() error {
+	return ErrInvalidZipEntries
 }
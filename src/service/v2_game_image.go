@@ -14,6 +14,8 @@ type GameImageV2 interface {
 	// SaveGameImage
 	// ゲーム画像の保存。
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// 保存するとゲームのストレージの上限を超える場合、ErrGameStorageQuotaExceededを返す。
+	// 派生画像は生成せず、BackfillGameImageVariantsで後から生成する。
 	SaveGameImage(ctx context.Context, reader io.Reader, gameID values.GameID) (*domain.GameImage, error)
 	// GetGameImage
 	// ゲーム画像一覧の取得。
@@ -21,13 +23,21 @@ type GameImageV2 interface {
 	GetGameImages(ctx context.Context, gameID values.GameID) ([]*domain.GameImage, error)
 	// GetGameImage
 	// ゲーム画像の一時的(1分間)に有効なurlを返す。
+	// sizeに対応するサムネイル、webpがtrueの場合はWebPの派生画像のurlを返す。
+	// 対応する派生画像が存在しない場合は元の画像のurlを返す。
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
 	// ゲーム画像IDに対応するゲーム画像が存在しない、
 	// もしくは存在しても紐づくゲームのゲームIDが異なる場合、ErrInvalidGameImageIDを返す。
-	GetGameImage(ctx context.Context, gameID values.GameID, imageID values.GameImageID) (values.GameImageTmpURL, error)
+	GetGameImage(ctx context.Context, gameID values.GameID, imageID values.GameImageID, size values.GameImageSize, webp bool) (values.GameImageTmpURL, error)
 	// GetGameImageMeta
 	// ゲーム画像のメタデータの取得。
+	// GetGameImageと同様に派生画像を選び、派生画像が存在する場合はそのメタデータも返す。
+	// 派生画像が存在しない場合、派生画像のメタデータはnilになる。
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
 	// ゲーム画像IDに対応するゲーム画像が存在しない場合、ErrInvalidGameImageIDを返す。
-	GetGameImageMeta(ctx context.Context, gameID values.GameID, imageID values.GameImageID) (*domain.GameImage, error)
+	GetGameImageMeta(ctx context.Context, gameID values.GameID, imageID values.GameImageID, size values.GameImageSize, webp bool) (*domain.GameImage, *domain.GameImageVariant, error)
+	// BackfillGameImageVariants
+	// 派生画像の生成が済んでいないゲーム画像の派生画像を、ストレージから読み込んで1枚ずつ生成する。
+	// 個々の画像の生成に失敗しても処理は続け、失敗した画像は次回の実行で再度生成を試みる。
+	BackfillGameImageVariants(ctx context.Context) error
 }
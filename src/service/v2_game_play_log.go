@@ -23,6 +23,20 @@ type GamePlayLogV2 interface {
 	// 終了時刻が開始時刻より前の場合、ErrInvalidEndTimeを返す。
 	// プレイログがeditionIDとgameIDのペアに対応しない場合、ErrInvalidPlayLogEditionGamePairを返す。
 	UpdatePlayLogEndTime(ctx context.Context, editionID values.EditionID, gameID values.GameID, playLogID values.GamePlayLogID, endTime time.Time) error
+	// CreatePlayLogs
+	// ネットワークに接続できない間にランチャーで記録された、終了済みのプレイログをまとめて作成する。
+	// 記録ごとの結果を、recordsと同じ順番で返す。
+	// 既に同じIDのプレイログが存在する記録は再送されたものとして扱い、作成しない。
+	// 時刻がアクセストークンの有効期間外の記録や、エディションに含まれないゲームバージョンの記録は作成しない。
+	// アクセストークンが存在しない場合、ErrInvalidAccessTokenを返す。
+	// アクセストークンがエディションに対応しない場合、ErrForbiddenを返す。
+	// 記録の数が多すぎる場合、ErrTooManyPlayLogRecordsを返す。
+	CreatePlayLogs(
+		ctx context.Context,
+		accessToken values.LauncherSessionAccessToken,
+		editionID values.EditionID,
+		records []*GamePlayLogRecord,
+	) ([]*GamePlayLogRecordResult, error)
 	// GetGamePlayStats
 	// 指定されたゲームと期間のプレイ統計を取得する。
 	// gameVersionIDがnilの場合、そのゲームのすべてのバージョンの統計を取得する。
@@ -43,3 +57,32 @@ type GamePlayLogV2 interface {
 	// ※これはCronで定期実行されています。
 	DeleteLongLogs(ctx context.Context) error
 }
+
+// GamePlayLogRecord
+// ランチャーでまとめてアップロードされる、終了済みのプレイログ。
+type GamePlayLogRecord struct {
+	// ID ランチャーが生成したID。再送されたときの重複の排除に使う。
+	ID            values.GamePlayLogID
+	GameID        values.GameID
+	GameVersionID values.GameVersionID
+	StartTime     time.Time
+	EndTime       time.Time
+}
+
+type GamePlayLogRecordStatus int
+
+const (
+	// GamePlayLogRecordStatusCreated プレイログを作成した
+	GamePlayLogRecordStatusCreated GamePlayLogRecordStatus = iota
+	// GamePlayLogRecordStatusDuplicated 既に同じIDのプレイログが存在した
+	GamePlayLogRecordStatusDuplicated
+	// GamePlayLogRecordStatusInvalid 記録の内容が不正なため、作成しなかった
+	GamePlayLogRecordStatusInvalid
+)
+
+type GamePlayLogRecordResult struct {
+	ID     values.GamePlayLogID
+	Status GamePlayLogRecordStatus
+	// Reason StatusがGamePlayLogRecordStatusInvalidのときのみ設定される
+	Reason string
+}
@@ -11,8 +11,8 @@ import (
 
 type GameRoleV2 interface {
 	//EditGameManagementRole
-	//指定されたユーザーを指定されたゲームの管理者に指定したり変更したりする。
-	//管理者でない場合は、roleが追加される。
+	//指定されたゲームの管理者のroleを変更する。
+	//管理者でない場合は、ErrInvalidRoleを返す。管理者でないユーザーはInviteGameManagementRoleで招待する。
 	//既に管理者の場合は、指定されたroleが現状と異なれば変更し、現状と同じ場合はErrNoGameManagementRoleUpdatedを返す。
 	//そのユーザーのroleを変えるとowners(administraitors)がいなくなってしまう場合は、ErrCannotEditOwnersを返す。
 	//ゲームIDに当てはまるゲームが存在しないとき、ErrNoGameを返す。
@@ -23,7 +23,7 @@ type GameRoleV2 interface {
 	//そのゲームのroleを持っていない場合は、ErrInvalidRoleを返す。ユーザーが存在しない場合もErrInvalidRoleになる。
 	//そのユーザーを消すとowners(administraitors)がいなくなってしまう場合は、ErrCannotDeleteOwnerを返す。
 	//ゲームIDに当てはまるゲームが存在しないとき、ErrNoGameを返す。
-	RemoveGameManagementRole(ctx context.Context, gameID values.GameID, userID values.TraPMemberID) error
+	RemoveGameManagementRole(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID) error
 	//UpdateGameAuth
 	//ログイン中のユーザーがゲームの情報を更新する権限を持っているか、
 	//すなわちowners(administraitors)とmaintainers(collaborators)のどちらかであるかを調べる。
@@ -36,6 +36,46 @@ type GameRoleV2 interface {
 	//権限を持っていない場合、ErrForbiddenを返す。持っている場合はnilを返す。
 	//ゲームIDに当てはまるゲームが存在しないとき、ErrNoGameを返す。
 	UpdateGameManagementRoleAuth(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) error
+	//InviteGameManagementRole
+	//指定されたユーザーを指定されたゲームの管理者に招待する。
+	//招待されたユーザーが承諾するまでroleは付与されない。
+	//既に指定されたroleを持っている場合は、ErrNoGameManagementRoleUpdatedを返す。
+	//同じユーザーへの応答待ちの招待が既にある場合は、ErrDuplicateGameRoleInvitationを返す。
+	//ゲームIDに当てはまるゲームが存在しないとき、ErrNoGameを返す。
+	//ユーザーが存在しない、またはアクティブではない場合、ErrInvalidUserIDを返す。
+	InviteGameManagementRole(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID, role values.GameManagementRole) (*domain.GameRoleInvitation, error)
+	//GetMyGameRoleInvitations
+	//ログイン中のユーザーへの応答待ちの招待を新しい順に取得する。
+	//有効期限切れの招待は含まない。
+	GetMyGameRoleInvitations(ctx context.Context, session *domain.OIDCSession) ([]*GameRoleInvitationInfo, error)
+	//AcceptGameRoleInvitation
+	//ログイン中のユーザーへの招待を承諾し、招待されたroleを付与する。
+	//招待が存在しない、またはログイン中のユーザーへの招待でない場合、ErrInvalidGameRoleInvitationIDを返す。
+	//招待に既に応答済みの場合、ErrGameRoleInvitationAlreadyAnsweredを返す。
+	//招待の有効期限が切れている場合、ErrGameRoleInvitationExpiredを返す。
+	//既に同じroleを持っている場合は、roleを変更せずに招待を承諾済みにする。
+	//承諾によってowners(administraitors)がいなくなってしまう場合は、ErrCannotEditOwnersを返す。
+	AcceptGameRoleInvitation(ctx context.Context, session *domain.OIDCSession, invitationID values.GameRoleInvitationID) error
+	//DeclineGameRoleInvitation
+	//ログイン中のユーザーへの招待を辞退する。
+	//招待が存在しない、またはログイン中のユーザーへの招待でない場合、ErrInvalidGameRoleInvitationIDを返す。
+	//招待に既に応答済みの場合、ErrGameRoleInvitationAlreadyAnsweredを返す。
+	//有効期限が切れた招待も辞退できる。
+	DeclineGameRoleInvitation(ctx context.Context, session *domain.OIDCSession, invitationID values.GameRoleInvitationID) error
+	//TransferGameOwnership
+	//ログイン中のユーザー(owner)から指定されたユーザーにゲームのownerを譲渡する。
+	//指定されたユーザーがownerになり、ログイン中のユーザーはmaintainerになる。
+	//指定されたユーザーが既にownerの場合は、ErrNoGameManagementRoleUpdatedを返す。
+	//指定されたユーザーがそのゲームの管理者でない場合は、ErrInvalidRoleを返す。
+	//ログイン中のユーザーがownerでない場合、ErrForbiddenを返す。
+	//ゲームIDに当てはまるゲームが存在しないとき、ErrNoGameを返す。
+	//ユーザーが存在しない、またはアクティブではない場合、ErrInvalidUserIDを返す。
+	TransferGameOwnership(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, userID values.TraPMemberID) error
+}
+
+type GameRoleInvitationInfo struct {
+	Invitation *domain.GameRoleInvitation
+	Game       *domain.Game
 }
 
 type GameManagerV2 struct {
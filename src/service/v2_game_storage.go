@@ -0,0 +1,39 @@
+package service
+
+//go:generate go tool mockgen -source=$GOFILE -destination=mock/${GOFILE} -package=mock
+
+import (
+	"context"
+
+	"github.com/traPtitech/trap-collection-server/pkg/option"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+type GameStorageV2 interface {
+	// GetGameStorage
+	// ゲームのストレージの使用量と上限の取得。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	GetGameStorage(ctx context.Context, gameID values.GameID) (*GameStorageInfo, error)
+	// UpdateGameStorageQuota
+	// 管理者によるゲームごとのストレージの上限の設定。
+	// デフォルトの上限より優先される。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// 上限が負の場合、ErrInvalidGameStorageQuotaを返す。
+	UpdateGameStorageQuota(ctx context.Context, session *domain.OIDCSession, gameID values.GameID, quota values.GameStorageSize) (*GameStorageInfo, error)
+	// DeleteGameStorageQuota
+	// 管理者が設定したストレージの上限を削除し、デフォルトの上限に戻す。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// 上限が設定されていない場合、ErrNoGameStorageQuotaを返す。
+	DeleteGameStorageQuota(ctx context.Context, session *domain.OIDCSession, gameID values.GameID) (*GameStorageInfo, error)
+}
+
+type GameStorageInfo struct {
+	Usage *domain.GameStorageUsage
+	// Quota
+	// 適用されている上限。上限がない場合はinvalid。
+	Quota option.Option[values.GameStorageSize]
+	// IsQuotaOverridden
+	// 管理者がゲームごとに設定した上限が適用されているか。
+	IsQuotaOverridden bool
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/traPtitech/trap-collection-server/pkg/option"
 	"github.com/traPtitech/trap-collection-server/src/domain"
@@ -15,8 +16,9 @@ import (
 // ref: https://github.com/golang/mock/pull/640
 // TODO: mockgenのv1.7.0がリリースされ次第削除する
 type (
-	OptionFileID  = option.Option[values.GameFileID]
-	OptionURLLink = option.Option[values.GameURLLink]
+	OptionFileID                = option.Option[values.GameFileID]
+	OptionURLLink               = option.Option[values.GameURLLink]
+	OptionGameVideoPosterTmpURL = option.Option[values.GameVideoPosterTmpURL]
 )
 
 // GameVersionV2
@@ -28,6 +30,7 @@ type GameVersionV2 interface {
 	// 画像、動画、ファイルでそれぞれのIDに対応するものが存在しない場合、
 	// ErrInvalidImageID、ErrInvalidVideoID、ErrInvalidFileIDを返す。
 	// fileの種類が誤っている場合、ErrInvalidFileTypeを返す。
+	// fileが未検査、もしくは検査で問題が見つかっている場合、ErrGameFileNotCleanを返す。
 	// url、fileのいずれも空の場合、ErrNoAssetを返す。
 	// gameIDとnameが同一の組み合わせが既に存在する場合、ErrDuplicateGameVersionを返す。
 	CreateGameVersion(
@@ -50,6 +53,18 @@ type GameVersionV2 interface {
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
 	// ゲームにバージョンが存在しない場合、ErrNoGameVersionを返す。
 	GetLatestGameVersion(ctx context.Context, gameID values.GameID) (*GameVersionInfo, error)
+	// GetGameVersionAssets
+	// ゲームバージョンの画像、動画、ファイルのメタデータと、一時的(1分間)に有効なurlをまとめて取得する。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// ゲームバージョンIDに対応するゲームバージョンが存在しない、
+	// もしくは存在しても紐づくゲームのゲームIDが異なる場合、ErrInvalidGameVersionIDを返す。
+	GetGameVersionAssets(ctx context.Context, gameID values.GameID, gameVersionID values.GameVersionID) (*GameVersionAssets, error)
+	// GetEditionGameVersionAssets
+	// エディションに含まれるゲームバージョンについて、GetGameVersionAssetsと同様にまとめて取得する。
+	// 未検査、もしくは検査で問題が見つかっているファイルは含めない。
+	// ゲームバージョンがエディションに含まれない、
+	// もしくは紐づくゲームのゲームIDが異なる場合、ErrInvalidGameVersionIDを返す。
+	GetEditionGameVersionAssets(ctx context.Context, editionID values.EditionID, gameID values.GameID, gameVersionID values.GameVersionID) (*GameVersionAssets, error)
 }
 
 // GetGameVersionsParams
@@ -72,3 +87,36 @@ type GameVersionInfo struct {
 	ImageID values.GameImageID
 	VideoID values.GameVideoID
 }
+
+// GameVersionAssets
+// ゲームバージョンに紐づく全てのアセットと、その一時的に有効なurl。
+type GameVersionAssets struct {
+	*domain.GameVersion
+	Image *GameImageAsset
+	Video *GameVideoAsset
+	// Files
+	// ゲームバージョンに紐づくゲームファイル。
+	Files []*GameFileAsset
+	URL   OptionURLLink
+	// ExpiresAt
+	// 一時的に有効なurlの有効期限。
+	ExpiresAt time.Time
+}
+
+type GameImageAsset struct {
+	*domain.GameImage
+	TmpURL values.GameImageTmpURL
+}
+
+type GameVideoAsset struct {
+	*domain.GameVideo
+	TmpURL values.GameVideoTmpURL
+	// PosterTmpURL
+	// ポスター画像が存在しない場合はinvalid。
+	PosterTmpURL OptionGameVideoPosterTmpURL
+}
+
+type GameFileAsset struct {
+	*domain.GameFile
+	TmpURL values.GameFileTmpURL
+}
@@ -14,6 +14,7 @@ type GameVideoV2 interface {
 	// SaveGameVideo
 	// ゲーム動画の保存。
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// 保存するとゲームのストレージの上限を超える場合、ErrGameStorageQuotaExceededを返す。
 	SaveGameVideo(ctx context.Context, reader io.Reader, gameID values.GameID) (*domain.GameVideo, error)
 	// GetGameVideos
 	// ゲーム動画一覧の取得。
@@ -30,4 +31,17 @@ type GameVideoV2 interface {
 	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
 	// ゲーム動画IDに対応するゲーム動画が存在しない場合、ErrInvalidGameVideoIDを返す。
 	GetGameVideoMeta(ctx context.Context, gameID values.GameID, videoID values.GameVideoID) (*domain.GameVideo, error)
+	// SaveGameVideoPoster
+	// ゲーム動画のポスター画像の保存。
+	// 既にポスター画像が存在する場合は上書きする。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// ゲーム動画IDに対応するゲーム動画が存在しない場合、ErrInvalidGameVideoIDを返す。
+	// 画像がjpeg、png、gif、webpのいずれでもない場合、ErrInvalidFormatを返す。
+	SaveGameVideoPoster(ctx context.Context, reader io.Reader, gameID values.GameID, videoID values.GameVideoID) (*domain.GameVideo, error)
+	// GetGameVideoPoster
+	// ゲーム動画のポスター画像の一時的(1分間)に有効なurlを返す。
+	// ゲームIDに対応するゲームが存在しない場合、ErrInvalidGameIDを返す。
+	// ゲーム動画IDに対応するゲーム動画が存在しない場合、ErrInvalidGameVideoIDを返す。
+	// ポスター画像が存在しない場合、ErrNoGameVideoPosterを返す。
+	GetGameVideoPoster(ctx context.Context, gameID values.GameID, videoID values.GameVideoID) (values.GameVideoPosterTmpURL, error)
 }
@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+)
+
+// EditionBundle
+// オフライン配布用に生成したエディションのバンドル(zipファイル)を扱う。
+type EditionBundle interface {
+	// SaveEditionBundle
+	// バンドルを保存する。
+	// 既にバンドルが存在する場合、ErrAlreadyExistsを返す。
+	SaveEditionBundle(ctx context.Context, reader io.Reader, bundleID values.EditionBundleID) error
+	// GetTempURL
+	// バンドルの一時URLを生成する。
+	// バンドルが存在しない場合、ErrNotFoundを返す。
+	GetTempURL(ctx context.Context, bundle *domain.EditionBundle, expires time.Duration) (values.EditionBundleTmpURL, error)
+}
@@ -0,0 +1,40 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// EditionBundle
+// 書き込みは移行先のストレージのみに行い、
+// 読み込みは移行先に存在しない場合のみ移行元のストレージから行う。
+type EditionBundle struct {
+	primary   storage.EditionBundle
+	secondary storage.EditionBundle
+}
+
+func NewEditionBundle(primary storage.EditionBundle, secondary storage.EditionBundle) *EditionBundle {
+	return &EditionBundle{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (eb *EditionBundle) SaveEditionBundle(ctx context.Context, reader io.Reader, bundleID values.EditionBundleID) error {
+	return eb.primary.SaveEditionBundle(ctx, reader, bundleID)
+}
+
+func (eb *EditionBundle) GetTempURL(ctx context.Context, bundle *domain.EditionBundle, expires time.Duration) (values.EditionBundleTmpURL, error) {
+	url, err := eb.primary.GetTempURL(ctx, bundle, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return eb.secondary.GetTempURL(ctx, bundle, expires)
+	}
+
+	return url, err
+}
@@ -0,0 +1,55 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// GameFile
+// 書き込みは移行先のストレージのみに行い、
+// 読み込みは移行先に存在しない場合のみ移行元のストレージから行う。
+type GameFile struct {
+	primary   storage.GameFile
+	secondary storage.GameFile
+}
+
+func NewGameFile(primary storage.GameFile, secondary storage.GameFile) *GameFile {
+	return &GameFile{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (gf *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, hash values.GameFileHash) error {
+	return gf.primary.SaveGameFile(ctx, reader, hash)
+}
+
+func (gf *GameFile) LoadGameFile(ctx context.Context, writer io.Writer, file *domain.GameFile) error {
+	err := gf.primary.LoadGameFile(ctx, writer, file)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gf.secondary.LoadGameFile(ctx, writer, file)
+	}
+
+	return err
+}
+
+func (gf *GameFile) GetTempURL(ctx context.Context, file *domain.GameFile, expires time.Duration) (values.GameFileTmpURL, error) {
+	url, err := gf.primary.GetTempURL(ctx, file, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gf.secondary.GetTempURL(ctx, file, expires)
+	}
+
+	return url, err
+}
+
+func (gf *GameFile) GetTempURLs(ctx context.Context, files []*domain.GameFile, expires time.Duration) ([]values.GameFileTmpURL, error) {
+	return storage.GetTempURLs(ctx, files, func(ctx context.Context, file *domain.GameFile) (values.GameFileTmpURL, error) {
+		return gf.GetTempURL(ctx, file, expires)
+	})
+}
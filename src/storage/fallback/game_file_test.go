@@ -0,0 +1,288 @@
+package fallback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSaveGameFile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	primaryBuf := bytes.NewBuffer(nil)
+	primary := mock.NewGameFile(ctrl, primaryBuf)
+	secondary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+
+	gameFile := NewGameFile(primary, secondary)
+
+	hash := values.NewGameFileHashFromBytes([]byte("hash"))
+	primary.
+		EXPECT().
+		SaveGameFile(gomock.Any(), hash).
+		Return(nil)
+
+	err := gameFile.SaveGameFile(context.Background(), bytes.NewBufferString("test"), hash)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", primaryBuf.String())
+}
+
+func TestGetGameFileTempURL(t *testing.T) {
+	t.Parallel()
+
+	primaryURL := values.NewGameFileTmpURL(&url.URL{Scheme: "https", Host: "primary.example.com"})
+	secondaryURL := values.NewGameFileTmpURL(&url.URL{Scheme: "https", Host: "secondary.example.com"})
+
+	type test struct {
+		description      string
+		primaryURL       values.GameFileTmpURL
+		primaryErr       error
+		executeSecondary bool
+		secondaryURL     values.GameFileTmpURL
+		secondaryErr     error
+		expect           values.GameFileTmpURL
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description: "移行先に存在するので移行先のURL",
+			primaryURL:  primaryURL,
+			expect:      primaryURL,
+		},
+		{
+			description:      "移行先に存在しないので移行元のURL",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryURL:     secondaryURL,
+			expect:           secondaryURL,
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+
+			gameFile := NewGameFile(primary, secondary)
+
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				values.NewGameFileHashFromBytes([]byte("hash")),
+				time.Now(),
+			)
+
+			primary.
+				EXPECT().
+				GetTempURL(gomock.Any(), file, time.Minute).
+				Return(testCase.primaryURL, testCase.primaryErr)
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					GetTempURL(gomock.Any(), file, time.Minute).
+					Return(testCase.secondaryURL, testCase.secondaryErr)
+			}
+
+			tmpURL, err := gameFile.GetTempURL(context.Background(), file, time.Minute)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, tmpURL)
+		})
+	}
+}
+
+func TestGetGameFileTempURLs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	primary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+	secondary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+
+	gameFile := NewGameFile(primary, secondary)
+
+	newFile := func() *domain.GameFile {
+		return domain.NewGameFile(
+			values.NewGameFileID(),
+			values.GameFileTypeJar,
+			values.NewGameFileEntryPoint("main.jar"),
+			values.NewGameFileHashFromBytes([]byte("hash")),
+			time.Now(),
+		)
+	}
+	file1 := newFile()
+	file2 := newFile()
+
+	primaryURL := values.NewGameFileTmpURL(&url.URL{Scheme: "https", Host: "primary.example.com"})
+	secondaryURL := values.NewGameFileTmpURL(&url.URL{Scheme: "https", Host: "secondary.example.com"})
+
+	// 移行中は、ファイルごとに移行先と移行元のどちらにあるかが異なる
+	primary.
+		EXPECT().
+		GetTempURL(gomock.Any(), file1, time.Minute).
+		Return(primaryURL, nil)
+	primary.
+		EXPECT().
+		GetTempURL(gomock.Any(), file2, time.Minute).
+		Return(nil, storage.ErrNotFound)
+	secondary.
+		EXPECT().
+		GetTempURL(gomock.Any(), file2, time.Minute).
+		Return(secondaryURL, nil)
+
+	tmpURLs, err := gameFile.GetTempURLs(context.Background(), []*domain.GameFile{file1, file2}, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, []values.GameFileTmpURL{primaryURL, secondaryURL}, tmpURLs)
+}
+
+func TestLoadGameFile(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		primaryContent   string
+		primaryErr       error
+		executeSecondary bool
+		secondaryContent string
+		secondaryErr     error
+		expect           string
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description:    "移行先に存在するので移行先から読み込む",
+			primaryContent: "primary",
+			expect:         "primary",
+		},
+		{
+			description:      "移行先に存在しないので移行元から読み込む",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryContent: "secondary",
+			expect:           "secondary",
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewGameFile(ctrl, bytes.NewBuffer(nil))
+
+			gameFile := NewGameFile(primary, secondary)
+
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				values.NewGameFileHashFromBytes([]byte("hash")),
+				time.Now(),
+			)
+
+			primary.
+				EXPECT().
+				LoadGameFile(gomock.Any(), gomock.Any(), file).
+				DoAndReturn(func(_ context.Context, writer io.Writer, _ *domain.GameFile) error {
+					if testCase.primaryErr != nil {
+						return testCase.primaryErr
+					}
+
+					_, err := io.WriteString(writer, testCase.primaryContent)
+					return err
+				})
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					LoadGameFile(gomock.Any(), gomock.Any(), file).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ *domain.GameFile) error {
+						if testCase.secondaryErr != nil {
+							return testCase.secondaryErr
+						}
+
+						_, err := io.WriteString(writer, testCase.secondaryContent)
+						return err
+					})
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameFile.LoadGameFile(context.Background(), buf, file)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, buf.String())
+		})
+	}
+}
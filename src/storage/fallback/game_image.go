@@ -0,0 +1,68 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// GameImage
+// 書き込みは移行先のストレージのみに行い、
+// 読み込みは移行先に存在しない場合のみ移行元のストレージから行う。
+type GameImage struct {
+	primary   storage.GameImage
+	secondary storage.GameImage
+}
+
+func NewGameImage(primary storage.GameImage, secondary storage.GameImage) *GameImage {
+	return &GameImage{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (gi *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, imageID values.GameImageID) error {
+	return gi.primary.SaveGameImage(ctx, reader, imageID)
+}
+
+func (gi *GameImage) LoadGameImage(ctx context.Context, writer io.Writer, imageID values.GameImageID) error {
+	err := gi.primary.LoadGameImage(ctx, writer, imageID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gi.secondary.LoadGameImage(ctx, writer, imageID)
+	}
+
+	return err
+}
+
+func (gi *GameImage) GetTempURL(ctx context.Context, image *domain.GameImage, expires time.Duration) (values.GameImageTmpURL, error) {
+	url, err := gi.primary.GetTempURL(ctx, image, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gi.secondary.GetTempURL(ctx, image, expires)
+	}
+
+	return url, err
+}
+
+func (gi *GameImage) GetTempURLs(ctx context.Context, images []*domain.GameImage, expires time.Duration) ([]values.GameImageTmpURL, error) {
+	return storage.GetTempURLs(ctx, images, func(ctx context.Context, image *domain.GameImage) (values.GameImageTmpURL, error) {
+		return gi.GetTempURL(ctx, image, expires)
+	})
+}
+
+func (gi *GameImage) SaveGameImageVariant(ctx context.Context, reader io.Reader, variantID values.GameImageVariantID) error {
+	return gi.primary.SaveGameImageVariant(ctx, reader, variantID)
+}
+
+func (gi *GameImage) GetVariantTempURL(ctx context.Context, variant *domain.GameImageVariant, expires time.Duration) (values.GameImageTmpURL, error) {
+	url, err := gi.primary.GetVariantTempURL(ctx, variant, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gi.secondary.GetVariantTempURL(ctx, variant, expires)
+	}
+
+	return url, err
+}
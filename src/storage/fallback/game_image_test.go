@@ -0,0 +1,139 @@
+package fallback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoadGameImage(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		primaryContent   string
+		primaryErr       error
+		executeSecondary bool
+		secondaryContent string
+		secondaryErr     error
+		expect           string
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description:    "移行先に存在するので移行先から読み込む",
+			primaryContent: "primary",
+			expect:         "primary",
+		},
+		{
+			description:      "移行先に存在しないので移行元から読み込む",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryContent: "secondary",
+			expect:           "secondary",
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewGameImage(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewGameImage(ctrl, bytes.NewBuffer(nil))
+
+			gameImage := NewGameImage(primary, secondary)
+
+			imageID := values.NewGameImageID()
+
+			primary.
+				EXPECT().
+				LoadGameImage(gomock.Any(), gomock.Any(), imageID).
+				DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameImageID) error {
+					if testCase.primaryErr != nil {
+						return testCase.primaryErr
+					}
+
+					_, err := io.WriteString(writer, testCase.primaryContent)
+					return err
+				})
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					LoadGameImage(gomock.Any(), gomock.Any(), imageID).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameImageID) error {
+						if testCase.secondaryErr != nil {
+							return testCase.secondaryErr
+						}
+
+						_, err := io.WriteString(writer, testCase.secondaryContent)
+						return err
+					})
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameImage.LoadGameImage(context.Background(), buf, imageID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, buf.String())
+		})
+	}
+}
+
+func TestSaveGameImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	primaryBuf := bytes.NewBuffer(nil)
+	primary := mock.NewGameImage(ctrl, primaryBuf)
+	secondary := mock.NewGameImage(ctrl, bytes.NewBuffer(nil))
+
+	gameImage := NewGameImage(primary, secondary)
+
+	imageID := values.NewGameImageID()
+	primary.
+		EXPECT().
+		SaveGameImage(gomock.Any(), imageID).
+		Return(nil)
+
+	err := gameImage.SaveGameImage(context.Background(), bytes.NewBufferString("test"), imageID)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", primaryBuf.String())
+}
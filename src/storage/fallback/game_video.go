@@ -0,0 +1,68 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// GameVideo
+// 書き込みは移行先のストレージのみに行い、
+// 読み込みは移行先に存在しない場合のみ移行元のストレージから行う。
+type GameVideo struct {
+	primary   storage.GameVideo
+	secondary storage.GameVideo
+}
+
+func NewGameVideo(primary storage.GameVideo, secondary storage.GameVideo) *GameVideo {
+	return &GameVideo{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (gv *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	return gv.primary.SaveGameVideo(ctx, reader, videoID)
+}
+
+func (gv *GameVideo) LoadGameVideo(ctx context.Context, writer io.Writer, videoID values.GameVideoID) error {
+	err := gv.primary.LoadGameVideo(ctx, writer, videoID)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gv.secondary.LoadGameVideo(ctx, writer, videoID)
+	}
+
+	return err
+}
+
+func (gv *GameVideo) GetTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoTmpURL, error) {
+	url, err := gv.primary.GetTempURL(ctx, video, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gv.secondary.GetTempURL(ctx, video, expires)
+	}
+
+	return url, err
+}
+
+func (gv *GameVideo) GetTempURLs(ctx context.Context, videos []*domain.GameVideo, expires time.Duration) ([]values.GameVideoTmpURL, error) {
+	return storage.GetTempURLs(ctx, videos, func(ctx context.Context, video *domain.GameVideo) (values.GameVideoTmpURL, error) {
+		return gv.GetTempURL(ctx, video, expires)
+	})
+}
+
+func (gv *GameVideo) SaveGameVideoPoster(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	return gv.primary.SaveGameVideoPoster(ctx, reader, videoID)
+}
+
+func (gv *GameVideo) GetPosterTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoPosterTmpURL, error) {
+	url, err := gv.primary.GetPosterTempURL(ctx, video, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return gv.secondary.GetPosterTempURL(ctx, video, expires)
+	}
+
+	return url, err
+}
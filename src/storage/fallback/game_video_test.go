@@ -0,0 +1,232 @@
+package fallback
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPosterTempURL(t *testing.T) {
+	t.Parallel()
+
+	primaryURL := values.NewGameVideoPosterTmpURL(&url.URL{Scheme: "https", Host: "primary.example.com"})
+	secondaryURL := values.NewGameVideoPosterTmpURL(&url.URL{Scheme: "https", Host: "secondary.example.com"})
+
+	type test struct {
+		description      string
+		primaryURL       values.GameVideoPosterTmpURL
+		primaryErr       error
+		executeSecondary bool
+		secondaryURL     values.GameVideoPosterTmpURL
+		secondaryErr     error
+		expect           values.GameVideoPosterTmpURL
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description: "移行先に存在するので移行先のURL",
+			primaryURL:  primaryURL,
+			expect:      primaryURL,
+		},
+		{
+			description:      "移行先に存在しないので移行元のURL",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryURL:     secondaryURL,
+			expect:           secondaryURL,
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewGameVideo(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewGameVideo(ctrl, bytes.NewBuffer(nil))
+
+			gameVideo := NewGameVideo(primary, secondary)
+
+			video := domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now())
+
+			primary.
+				EXPECT().
+				GetPosterTempURL(gomock.Any(), video, time.Minute).
+				Return(testCase.primaryURL, testCase.primaryErr)
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					GetPosterTempURL(gomock.Any(), video, time.Minute).
+					Return(testCase.secondaryURL, testCase.secondaryErr)
+			}
+
+			tmpURL, err := gameVideo.GetPosterTempURL(context.Background(), video, time.Minute)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, tmpURL)
+		})
+	}
+}
+
+func TestSaveGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	primaryBuf := bytes.NewBuffer(nil)
+	primary := mock.NewGameVideo(ctrl, primaryBuf)
+	secondary := mock.NewGameVideo(ctrl, bytes.NewBuffer(nil))
+
+	gameVideo := NewGameVideo(primary, secondary)
+
+	videoID := values.NewGameVideoID()
+	primary.
+		EXPECT().
+		SaveGameVideoPoster(gomock.Any(), videoID).
+		Return(nil)
+
+	err := gameVideo.SaveGameVideoPoster(context.Background(), bytes.NewBufferString("test"), videoID)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", primaryBuf.String())
+}
+
+func TestLoadGameVideo(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		primaryContent   string
+		primaryErr       error
+		executeSecondary bool
+		secondaryContent string
+		secondaryErr     error
+		expect           string
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description:    "移行先に存在するので移行先から読み込む",
+			primaryContent: "primary",
+			expect:         "primary",
+		},
+		{
+			description:      "移行先に存在しないので移行元から読み込む",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryContent: "secondary",
+			expect:           "secondary",
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewGameVideo(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewGameVideo(ctrl, bytes.NewBuffer(nil))
+
+			gameVideo := NewGameVideo(primary, secondary)
+
+			videoID := values.NewGameVideoID()
+
+			primary.
+				EXPECT().
+				LoadGameVideo(gomock.Any(), gomock.Any(), videoID).
+				DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameVideoID) error {
+					if testCase.primaryErr != nil {
+						return testCase.primaryErr
+					}
+
+					_, err := io.WriteString(writer, testCase.primaryContent)
+					return err
+				})
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					LoadGameVideo(gomock.Any(), gomock.Any(), videoID).
+					DoAndReturn(func(_ context.Context, writer io.Writer, _ values.GameVideoID) error {
+						if testCase.secondaryErr != nil {
+							return testCase.secondaryErr
+						}
+
+						_, err := io.WriteString(writer, testCase.secondaryContent)
+						return err
+					})
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameVideo.LoadGameVideo(context.Background(), buf, videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, buf.String())
+		})
+	}
+}
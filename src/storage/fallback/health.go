@@ -0,0 +1,39 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// Health
+// 移行元のストレージからも読み込むので、両方のストレージの状態を確認する。
+type Health struct {
+	primary   storage.Health
+	secondary storage.Health
+}
+
+func NewHealth(primary storage.Health, secondary storage.Health) *Health {
+	return &Health{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (h *Health) Ping(ctx context.Context) error {
+	var errs []error
+
+	err := h.primary.Ping(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("primary: %w", err))
+	}
+
+	err = h.secondary.Ping(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("secondary: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,47 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// Objects
+// 書き込みは移行先のストレージのみに行い、
+// 読み込みは移行先に存在しない場合のみ移行元のストレージから行う。
+// 移行中のストレージの検証で、配信できるオブジェクトを存在するものとして扱うために使う。
+type Objects struct {
+	primary   storage.Objects
+	secondary storage.Objects
+}
+
+func NewObjects(primary storage.Objects, secondary storage.Objects) *Objects {
+	return &Objects{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (o *Objects) ExistsObject(ctx context.Context, kind storage.ObjectKind, id string) (bool, error) {
+	exists, err := o.primary.ExistsObject(ctx, kind, id)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	return o.secondary.ExistsObject(ctx, kind, id)
+}
+
+func (o *Objects) LoadObject(ctx context.Context, kind storage.ObjectKind, id string, writer io.Writer) error {
+	err := o.primary.LoadObject(ctx, kind, id, writer)
+	if errors.Is(err, storage.ErrNotFound) {
+		return o.secondary.LoadObject(ctx, kind, id, writer)
+	}
+
+	return err
+}
+
+func (o *Objects) SaveObject(ctx context.Context, kind storage.ObjectKind, id string, reader io.Reader) error {
+	return o.primary.SaveObject(ctx, kind, id, reader)
+}
@@ -0,0 +1,163 @@
+package fallback
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExistsObject(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		primaryExists    bool
+		primaryErr       error
+		executeSecondary bool
+		secondaryExists  bool
+		secondaryErr     error
+		expect           bool
+		isErr            bool
+	}
+
+	testCases := []test{
+		{
+			description:   "移行先に存在するので移行元は見ない",
+			primaryExists: true,
+			expect:        true,
+		},
+		{
+			description:      "移行先に存在しないが移行元に存在する",
+			executeSecondary: true,
+			secondaryExists:  true,
+			expect:           true,
+		},
+		{
+			description:      "どちらにも存在しない",
+			executeSecondary: true,
+		},
+		{
+			description: "移行先がエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+		{
+			description:      "移行元がエラーなのでエラー",
+			executeSecondary: true,
+			secondaryErr:     errors.New("error"),
+			isErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewObjects(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewObjects(ctrl, bytes.NewBuffer(nil))
+
+			objects := NewObjects(primary, secondary)
+
+			primary.
+				EXPECT().
+				ExistsObject(gomock.Any(), storage.ObjectKindGameImage, "id").
+				Return(testCase.primaryExists, testCase.primaryErr)
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					ExistsObject(gomock.Any(), storage.ObjectKindGameImage, "id").
+					Return(testCase.secondaryExists, testCase.secondaryErr)
+			}
+
+			exists, err := objects.ExistsObject(t.Context(), storage.ObjectKindGameImage, "id")
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expect, exists)
+		})
+	}
+}
+
+func TestLoadObject(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description      string
+		primaryErr       error
+		executeSecondary bool
+		secondaryErr     error
+		isErr            bool
+		err              error
+	}
+
+	testCases := []test{
+		{
+			description: "移行先から読み込める",
+		},
+		{
+			description:      "移行先に存在しないので移行元から読み込む",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+		},
+		{
+			description:      "どちらにも存在しないのでErrNotFound",
+			primaryErr:       storage.ErrNotFound,
+			executeSecondary: true,
+			secondaryErr:     storage.ErrNotFound,
+			isErr:            true,
+			err:              storage.ErrNotFound,
+		},
+		{
+			description: "移行先がErrNotFound以外のエラーなので移行元は見ない",
+			primaryErr:  errors.New("error"),
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+
+			primary := mock.NewObjects(ctrl, bytes.NewBuffer(nil))
+			secondary := mock.NewObjects(ctrl, bytes.NewBuffer(nil))
+
+			objects := NewObjects(primary, secondary)
+
+			buf := bytes.NewBuffer(nil)
+
+			primary.
+				EXPECT().
+				LoadObject(gomock.Any(), storage.ObjectKindGameFileBlob, "hash", buf).
+				Return(testCase.primaryErr)
+			if testCase.executeSecondary {
+				secondary.
+					EXPECT().
+					LoadObject(gomock.Any(), storage.ObjectKindGameFileBlob, "hash", buf).
+					Return(testCase.secondaryErr)
+			}
+
+			err := objects.LoadObject(t.Context(), storage.ObjectKindGameFileBlob, "hash", buf)
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else {
+					assert.ErrorIs(t, err, testCase.err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
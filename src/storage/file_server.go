@@ -0,0 +1,12 @@
+package storage
+
+import "net/http"
+
+// FileServer
+// ストレージ自身がHTTPでファイルを配信する場合に実装する。
+// swift、s3のように外部のサーバーがファイルを配信する場合は不要。
+type FileServer interface {
+	http.Handler
+	// PathPrefix ファイル配信を行うパス
+	PathPrefix() string
+}
@@ -10,6 +10,21 @@ import (
 )
 
 type GameFile interface {
-	SaveGameFile(ctx context.Context, reader io.Reader, fileID values.GameFileID) error
+	// SaveGameFile
+	// ゲームファイルの実体を、ハッシュ値をキーとして保存する。
+	// 同じ内容のファイルは一度だけ保存すればよいので、既に存在する場合はErrAlreadyExistsを返す。
+	SaveGameFile(ctx context.Context, reader io.Reader, hash values.GameFileHash) error
+	// LoadGameFile
+	// ゲームファイルの実体をwriterに書き込む。
+	// GetTempURLと同様に、ハッシュ値をキーとしたファイルが存在しない場合はファイルIDをキーとしたファイルを読み込む。
+	// どちらも存在しない場合、ErrNotFoundを返す。
+	LoadGameFile(ctx context.Context, writer io.Writer, file *domain.GameFile) error
+	// GetTempURL
+	// ハッシュ値をキーとして保存されたファイルの一時URLを返す。
+	// 存在しない場合は、重複排除の導入前にファイルIDをキーとして保存されたファイルの一時URLを返す。
 	GetTempURL(ctx context.Context, file *domain.GameFile, expires time.Duration) (values.GameFileTmpURL, error)
+	// GetTempURLs
+	// 複数のファイルの一時URLをまとめて生成し、filesと同じ順番で返す。
+	// 1つでも存在しないファイルがある場合はErrNotFoundを返す。
+	GetTempURLs(ctx context.Context, files []*domain.GameFile, expires time.Duration) ([]values.GameFileTmpURL, error)
 }
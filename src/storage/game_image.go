@@ -11,5 +11,14 @@ import (
 
 type GameImage interface {
 	SaveGameImage(ctx context.Context, reader io.Reader, imageID values.GameImageID) error
+	// LoadGameImage
+	// 派生画像の生成のため、保存済みの画像をwriterに書き込む。
+	LoadGameImage(ctx context.Context, writer io.Writer, imageID values.GameImageID) error
 	GetTempURL(ctx context.Context, image *domain.GameImage, expires time.Duration) (values.GameImageTmpURL, error)
+	// GetTempURLs
+	// 複数の画像の一時URLをまとめて生成し、imagesと同じ順番で返す。
+	// 1つでも存在しない画像がある場合はErrNotFoundを返す。
+	GetTempURLs(ctx context.Context, images []*domain.GameImage, expires time.Duration) ([]values.GameImageTmpURL, error)
+	SaveGameImageVariant(ctx context.Context, reader io.Reader, variantID values.GameImageVariantID) error
+	GetVariantTempURL(ctx context.Context, variant *domain.GameImageVariant, expires time.Duration) (values.GameImageTmpURL, error)
 }
@@ -11,5 +11,18 @@ import (
 
 type GameVideo interface {
 	SaveGameVideo(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error
+	// LoadGameVideo
+	// オフライン配布用のバンドルの生成のため、保存済みの動画をwriterに書き込む。
+	// 動画が存在しない場合、ErrNotFoundを返す。
+	LoadGameVideo(ctx context.Context, writer io.Writer, videoID values.GameVideoID) error
 	GetTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoTmpURL, error)
+	// GetTempURLs
+	// 複数の動画の一時URLをまとめて生成し、videosと同じ順番で返す。
+	// 1つでも存在しない動画がある場合はErrNotFoundを返す。
+	GetTempURLs(ctx context.Context, videos []*domain.GameVideo, expires time.Duration) ([]values.GameVideoTmpURL, error)
+	// SaveGameVideoPoster
+	// 動画のポスター画像を保存する。
+	// 既にポスター画像が存在する場合は上書きする。
+	SaveGameVideoPoster(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error
+	GetPosterTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoPosterTmpURL, error)
 }
@@ -0,0 +1,11 @@
+package storage
+
+import "context"
+
+// Health
+// readinessの確認のため、ストレージの状態を確認する。
+type Health interface {
+	// Ping
+	// ストレージに接続してファイルを読み書きできる状態かを確認する。
+	Ping(ctx context.Context) error
+}
@@ -9,6 +9,22 @@ import (
 	"github.com/traPtitech/trap-collection-server/src/config"
 )
 
+const (
+	directoryNameFiles = "files"
+	// directoryNameFileBlobs
+	// ハッシュ値をファイル名としてゲームファイルの実体を保存するディレクトリ
+	directoryNameFileBlobs = "file_blobs"
+	directoryNameImages    = "images"
+	// directoryNameImageVariants
+	// サムネイルなどの派生画像を保存するディレクトリ
+	directoryNameImageVariants = "image_variants"
+	directoryNameVideos        = "videos"
+	directoryNameVideoPosters  = "video_posters"
+	// directoryNameBundles
+	// オフライン配布用のエディションのバンドルを保存するディレクトリ
+	directoryNameBundles = "bundles"
+)
+
 type DirectoryManager struct {
 	rootPath string
 }
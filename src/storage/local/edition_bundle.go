@@ -0,0 +1,81 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+type EditionBundle struct {
+	bundleRootPath string
+	urlSigner      *URLSigner
+}
+
+func NewEditionBundle(directoryManager *DirectoryManager, urlSigner *URLSigner) (*EditionBundle, error) {
+	bundleRootPath, err := directoryManager.setupDirectory(directoryNameBundles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup directory: %w", err)
+	}
+
+	return &EditionBundle{
+		bundleRootPath: bundleRootPath,
+		urlSigner:      urlSigner,
+	}, nil
+}
+
+func (eb *EditionBundle) SaveEditionBundle(ctx context.Context, reader io.Reader, bundleID values.EditionBundleID) error {
+	ctx, span := tracer.Start(ctx, "EditionBundle.SaveEditionBundle")
+	defer span.End()
+
+	bundlePath := path.Join(eb.bundleRootPath, uuid.UUID(bundleID).String())
+
+	_, err := os.Stat(bundlePath)
+	if err == nil {
+		return storage.ErrAlreadyExists
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	f, err := os.Create(bundlePath)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, reader)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) GetTempURL(ctx context.Context, bundle *domain.EditionBundle, expires time.Duration) (values.EditionBundleTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.GetTempURL")
+	defer span.End()
+
+	bundleID := uuid.UUID(bundle.GetID())
+
+	_, err := os.Stat(path.Join(eb.bundleRootPath, bundleID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	tmpURL := eb.urlSigner.createTempURL(buildObjectPath(directoryNameBundles, bundleID.String()), expires)
+
+	return values.NewEditionBundleTmpURL(tmpURL), nil
+}
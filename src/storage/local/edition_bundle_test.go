@@ -0,0 +1,202 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"go.uber.org/mock/gomock"
+)
+
+func newEditionBundleForTest(t *testing.T, rootPath string) *EditionBundle {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v", err)
+	}
+	t.Cleanup(func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v\n", err)
+		}
+	})
+
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	editionBundle, err := NewEditionBundle(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create edition bundle: %v\n", err)
+	}
+
+	return editionBundle
+}
+
+func TestSaveEditionBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rootPath := "./save_edition_bundle_test"
+	editionBundle := newEditionBundleForTest(t, rootPath)
+	bundleRootPath := filepath.Join(rootPath, "bundles")
+
+	type test struct {
+		description string
+		bundleID    values.EditionBundleID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在しないので保存できる",
+			bundleID:    values.NewEditionBundleID(),
+		},
+		{
+			description: "ファイルが存在するので保存できない",
+			bundleID:    values.NewEditionBundleID(),
+			isFileExist: true,
+			isErr:       true,
+			err:         storage.ErrAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.isFileExist {
+				f, err := os.Create(filepath.Join(bundleRootPath, uuid.UUID(testCase.bundleID).String()))
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+				f.Close()
+			}
+
+			expectBytes := []byte("bundle")
+
+			err := editionBundle.SaveEditionBundle(ctx, bytes.NewReader(expectBytes), testCase.bundleID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			f, err := os.Open(filepath.Join(bundleRootPath, uuid.UUID(testCase.bundleID).String()))
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			defer f.Close()
+
+			actualBytes, err := io.ReadAll(f)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+
+			assert.Equal(t, expectBytes, actualBytes)
+		})
+	}
+}
+
+func TestEditionBundleGetTempURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rootPath := "./edition_bundle_get_temp_url_test"
+	editionBundle := newEditionBundleForTest(t, rootPath)
+	bundleRootPath := filepath.Join(rootPath, "bundles")
+
+	type test struct {
+		description string
+		bundleID    values.EditionBundleID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在するので一時URLを生成できる",
+			bundleID:    values.NewEditionBundleID(),
+			isFileExist: true,
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			bundleID:    values.NewEditionBundleID(),
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.isFileExist {
+				f, err := os.Create(filepath.Join(bundleRootPath, uuid.UUID(testCase.bundleID).String()))
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+				f.Close()
+			}
+
+			bundle := domain.NewEditionBundle(testCase.bundleID, values.NewEditionID(), values.EditionBundleStatusCompleted, time.Now())
+
+			tmpURL, err := editionBundle.GetTempURL(ctx, bundle, time.Minute)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			actualURL := (*url.URL)(tmpURL)
+			assert.Equal(t, "/api/storage/bundles/"+uuid.UUID(testCase.bundleID).String(), actualURL.Path)
+			assert.NotEmpty(t, actualURL.Query().Get(queryKeyTmpURLSig))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package local
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/logger"
+)
+
+// FileServer
+// URLSignerで発行した一時URLに対してファイルを配信する。
+// http.ServeContentを使うため、Rangeリクエストにも対応する。
+type FileServer struct {
+	directoryManager *DirectoryManager
+	urlSigner        *URLSigner
+}
+
+func NewFileServer(directoryManager *DirectoryManager, urlSigner *URLSigner) *FileServer {
+	return &FileServer{
+		directoryManager: directoryManager,
+		urlSigner:        urlSigner,
+	}
+}
+
+func (s *FileServer) PathPrefix() string {
+	return s.urlSigner.pathPrefix()
+}
+
+// ServeHTTP
+// PathPrefixを取り除いた/{directory}/{id}の形式のパスでリクエストを受け付ける。
+func (s *FileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	objectPath := r.URL.Path
+	directoryName, name, ok := parseObjectPath(objectPath)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// swiftのTempURLと同様に、署名が不正・期限切れの場合は401を返す
+	err := s.urlSigner.verify(objectPath, r.URL.Query(), time.Now())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	f, err := os.Open(path.Join(s.directoryManager.rootPath, directoryName, name))
+	if errors.Is(err, os.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		logger.Error(r.Context(), "failed to open file", slog.Any("error", err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		logger.Error(r.Context(), "failed to stat file", slog.Any("error", err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+func parseObjectPath(objectPath string) (string, string, bool) {
+	directoryName, name, ok := strings.Cut(strings.TrimPrefix(objectPath, "/"), "/")
+	if !ok || !isObjectName(directoryName, name) {
+		return "", "", false
+	}
+
+	return directoryName, name, true
+}
+
+// isObjectName
+// ディレクトリに保存されるファイルの名前として正しい形式か確認する。
+func isObjectName(directoryName string, name string) bool {
+	switch directoryName {
+	case directoryNameFileBlobs:
+		return isBlobName(name)
+	case directoryNameFiles, directoryNameImages, directoryNameImageVariants, directoryNameVideos, directoryNameVideoPosters, directoryNameBundles:
+		id, err := uuid.Parse(name)
+		// uuid.Parseは{}やurn:uuid:付きの形式も受け付けるので、正規の形式のみを許可する
+		return err == nil && id.String() == name
+	}
+
+	return false
+}
+
+// isBlobName
+// ゲームファイルの実体のファイル名(小文字16進数表記のMD5ハッシュ値)か確認する。
+func isBlobName(name string) bool {
+	if len(name) != hex.EncodedLen(md5.Size) {
+		return false
+	}
+
+	for _, c := range name {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// buildObjectPath
+// URLSignerで署名する対象のパスを生成する。
+func buildObjectPath(directoryName string, name string) string {
+	return "/" + path.Join(directoryName, name)
+}
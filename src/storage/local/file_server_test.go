@@ -0,0 +1,246 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./file_server_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameVideo, err := NewGameVideo(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game video: %v", err)
+	}
+
+	fileServer := NewFileServer(directoryManager, urlSigner)
+	assert.Equal(t, "/api/storage", fileServer.PathPrefix())
+
+	content := []byte("0123456789abcdefghij")
+
+	videoID := values.NewGameVideoID()
+	err = gameVideo.SaveGameVideo(ctx, bytes.NewReader(content), videoID)
+	if err != nil {
+		t.Fatalf("failed to save game video: %v", err)
+	}
+	video := domain.NewGameVideo(videoID, values.GameVideoTypeMp4, time.Now())
+
+	_, err = gameVideo.GetTempURL(ctx, domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now()), time.Minute)
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("error must be %v, but actual is %v", storage.ErrNotFound, err)
+	}
+
+	tmpURL, err := gameVideo.GetTempURL(ctx, video, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to get temp url: %v", err)
+	}
+
+	expiredURL, err := gameVideo.GetTempURL(ctx, video, -time.Second)
+	if err != nil {
+		t.Fatalf("failed to get temp url: %v", err)
+	}
+
+	// 別のファイルの署名を使い回しても配信されない
+	otherURL := *(*url.URL)(tmpURL)
+	otherURL.Path = "/api/storage/videos/" + uuid.New().String()
+
+	type test struct {
+		description string
+		method      string
+		url         *url.URL
+		rangeHeader string
+		statusCode  int
+		body        []byte
+	}
+
+	testCases := []test{
+		{
+			description: "正しいURLなので配信される",
+			method:      http.MethodGet,
+			url:         (*url.URL)(tmpURL),
+			statusCode:  http.StatusOK,
+			body:        content,
+		},
+		{
+			description: "Rangeリクエストに対応している",
+			method:      http.MethodGet,
+			url:         (*url.URL)(tmpURL),
+			rangeHeader: "bytes=5-9",
+			statusCode:  http.StatusPartialContent,
+			body:        content[5:10],
+		},
+		{
+			description: "HEADリクエストにも対応している",
+			method:      http.MethodHead,
+			url:         (*url.URL)(tmpURL),
+			statusCode:  http.StatusOK,
+			body:        []byte{},
+		},
+		{
+			description: "有効期限が切れているので401",
+			method:      http.MethodGet,
+			url:         (*url.URL)(expiredURL),
+			statusCode:  http.StatusUnauthorized,
+		},
+		{
+			description: "署名が異なるので401",
+			method:      http.MethodGet,
+			url:         &otherURL,
+			statusCode:  http.StatusUnauthorized,
+		},
+		{
+			description: "ディレクトリが不正なので404",
+			method:      http.MethodGet,
+			url:         &url.URL{Path: "/api/storage/../" + uuid.UUID(videoID).String(), RawQuery: (*url.URL)(tmpURL).RawQuery},
+			statusCode:  http.StatusNotFound,
+		},
+		{
+			description: "POSTは許可されていないので405",
+			method:      http.MethodPost,
+			url:         (*url.URL)(tmpURL),
+			statusCode:  http.StatusMethodNotAllowed,
+		},
+	}
+
+	handler := http.StripPrefix(fileServer.PathPrefix(), fileServer)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			req := httptest.NewRequest(testCase.method, testCase.url.RequestURI(), nil)
+			if testCase.rangeHeader != "" {
+				req.Header.Set("Range", testCase.rangeHeader)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, testCase.statusCode, rec.Code)
+			if testCase.body == nil {
+				return
+			}
+
+			body, err := io.ReadAll(rec.Body)
+			if err != nil {
+				t.Fatalf("failed to read body: %v", err)
+			}
+			assert.Equal(t, testCase.body, body)
+		})
+	}
+}
+
+func TestParseObjectPath(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+
+	type test struct {
+		description   string
+		objectPath    string
+		directoryName string
+		name          string
+		ok            bool
+	}
+
+	testCases := []test{
+		{
+			description:   "UUIDのファイル",
+			objectPath:    "/videos/" + id,
+			directoryName: "videos",
+			name:          id,
+			ok:            true,
+		},
+		{
+			description:   "ゲームファイルの実体",
+			objectPath:    "/file_blobs/098f6bcd4621d373cade4e832627b4f6",
+			directoryName: "file_blobs",
+			name:          "098f6bcd4621d373cade4e832627b4f6",
+			ok:            true,
+		},
+		{
+			description: "ハッシュ値が大文字なのでfalse",
+			objectPath:  "/file_blobs/098F6BCD4621D373CADE4E832627B4F6",
+		},
+		{
+			description: "ハッシュ値の長さが不正なのでfalse",
+			objectPath:  "/file_blobs/098f6bcd",
+		},
+		{
+			description: "ゲームファイルの実体のディレクトリにUUIDなのでfalse",
+			objectPath:  "/file_blobs/" + id,
+		},
+		{
+			description: "UUIDが正規の形式でないのでfalse",
+			objectPath:  "/videos/{" + id + "}",
+		},
+		{
+			description: "想定外のディレクトリなのでfalse",
+			objectPath:  "/other/" + id,
+		},
+		{
+			description: "ディレクトリがないのでfalse",
+			objectPath:  "/" + id,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			directoryName, name, ok := parseObjectPath(testCase.objectPath)
+
+			assert.Equal(t, testCase.ok, ok)
+			assert.Equal(t, testCase.directoryName, directoryName)
+			assert.Equal(t, testCase.name, name)
+		})
+	}
+}
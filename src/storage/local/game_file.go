@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"net/url"
 	"os"
 	"path"
 	"time"
@@ -19,25 +18,37 @@ import (
 
 type GameFile struct {
 	fileRootPath     string
+	blobRootPath     string
 	directoryManager *DirectoryManager
+	urlSigner        *URLSigner
 }
 
-func NewGameFile(directoryManager *DirectoryManager) (*GameFile, error) {
-	fileRootPath, err := directoryManager.setupDirectory("files")
+func NewGameFile(directoryManager *DirectoryManager, urlSigner *URLSigner) (*GameFile, error) {
+	fileRootPath, err := directoryManager.setupDirectory(directoryNameFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup directory: %w", err)
+	}
+
+	blobRootPath, err := directoryManager.setupDirectory(directoryNameFileBlobs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup directory: %w", err)
 	}
 
 	return &GameFile{
 		fileRootPath:     fileRootPath,
+		blobRootPath:     blobRootPath,
 		directoryManager: directoryManager,
+		urlSigner:        urlSigner,
 	}, nil
 }
 
-func (gf *GameFile) SaveGameFile(_ context.Context, reader io.Reader, fileID values.GameFileID) error {
-	filePath := path.Join(gf.fileRootPath, uuid.UUID(fileID).String())
+func (gf *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, hash values.GameFileHash) error {
+	ctx, span := tracer.Start(ctx, "GameFile.SaveGameFile")
+	defer span.End()
 
-	_, err := os.Stat(filePath)
+	blobPath := path.Join(gf.blobRootPath, hash.String())
+
+	_, err := os.Stat(blobPath)
 	if err == nil {
 		return storage.ErrAlreadyExists
 	}
@@ -45,13 +56,33 @@ func (gf *GameFile) SaveGameFile(_ context.Context, reader io.Reader, fileID val
 		return fmt.Errorf("failed to stat file: %w", err)
 	}
 
-	f, err := os.Create(filePath)
+	// 同じハッシュ値のファイルは同じ内容なので、書き込み途中のファイルが見えないことだけ保証すればよい
+	err = replaceFile(gf.blobRootPath, hash.String(), reader)
+	if err != nil {
+		return fmt.Errorf("failed to save file: %w", err)
+	}
+
+	return nil
+}
+
+func (gf *GameFile) LoadGameFile(ctx context.Context, writer io.Writer, file *domain.GameFile) error {
+	ctx, span := tracer.Start(ctx, "GameFile.LoadGameFile")
+	defer span.End()
+
+	f, err := os.Open(path.Join(gf.blobRootPath, file.GetHash().String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		// 重複排除の導入前に保存されたファイルは、ファイルIDをファイル名として保存されている
+		f, err = os.Open(path.Join(gf.fileRootPath, uuid.UUID(file.GetID()).String()))
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return storage.ErrNotFound
+	}
 	if err != nil {
 		return fmt.Errorf("failed to open file: %w", err)
 	}
 	defer f.Close()
 
-	_, err = io.Copy(f, reader)
+	_, err = io.Copy(writer, f)
 	if err != nil {
 		return fmt.Errorf("failed to copy: %w", err)
 	}
@@ -59,12 +90,43 @@ func (gf *GameFile) SaveGameFile(_ context.Context, reader io.Reader, fileID val
 	return nil
 }
 
-func (gf *GameFile) GetTempURL(_ context.Context, file *domain.GameFile, _ time.Duration) (values.GameFileTmpURL, error) {
-	// 正しいURLにはならないが、開発環境用のmockのため妥協する
-	tmpURL, err := url.Parse(fmt.Sprintf("file://%s", path.Join(gf.fileRootPath, uuid.UUID(file.GetID()).String())))
+func (gf *GameFile) GetTempURL(ctx context.Context, file *domain.GameFile, expires time.Duration) (values.GameFileTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetTempURL")
+	defer span.End()
+
+	blobName := file.GetHash().String()
+
+	_, err := os.Stat(path.Join(gf.blobRootPath, blobName))
+	if err == nil {
+		tmpURL := gf.urlSigner.createTempURL(buildObjectPath(directoryNameFileBlobs, blobName), expires)
+
+		return values.NewGameFileTmpURL(tmpURL), nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	// 重複排除の導入前に保存されたファイルは、ファイルIDをファイル名として保存されている
+	fileID := uuid.UUID(file.GetID())
+
+	_, err = os.Stat(path.Join(gf.fileRootPath, fileID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse url: %w", err)
+		return nil, fmt.Errorf("failed to stat file: %w", err)
 	}
 
+	tmpURL := gf.urlSigner.createTempURL(buildObjectPath(directoryNameFiles, fileID.String()), expires)
+
 	return values.NewGameFileTmpURL(tmpURL), nil
 }
+
+func (gf *GameFile) GetTempURLs(ctx context.Context, files []*domain.GameFile, expires time.Duration) ([]values.GameFileTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, files, func(ctx context.Context, file *domain.GameFile) (values.GameFileTmpURL, error) {
+		return gf.GetTempURL(ctx, file, expires)
+	})
+}
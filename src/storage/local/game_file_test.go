@@ -5,13 +5,16 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/storage"
 	"go.uber.org/mock/gomock"
@@ -41,16 +44,29 @@ func TestSaveGameFile(t *testing.T) {
 		}
 	}()
 
-	gameFile, err := NewGameFile(directoryManager)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameFile, err := NewGameFile(directoryManager, urlSigner)
 	if err != nil {
 		t.Fatalf("failed to create game file: %v", err)
 	}
 
-	fileRootPath := filepath.Join(string(rootPath), "files")
+	blobRootPath := filepath.Join(string(rootPath), "file_blobs")
 
 	type test struct {
 		description string
-		fileID      values.GameFileID
+		hash        values.GameFileHash
 		reader      *bytes.Buffer
 		isFileExist bool
 		isErr       bool
@@ -60,12 +76,12 @@ func TestSaveGameFile(t *testing.T) {
 	testCases := []test{
 		{
 			description: "ファイルが存在しないので保存できる",
-			fileID:      values.NewGameFileID(),
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 		},
 		{
-			description: "ファイルが存在するので保存できない",
-			fileID:      values.NewGameFileID(),
+			description: "同じハッシュ値のファイルが存在するので保存できない",
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 			isFileExist: true,
 			isErr:       true,
@@ -76,7 +92,7 @@ func TestSaveGameFile(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.description, func(t *testing.T) {
 			if testCase.isFileExist {
-				f, err := os.Create(filepath.Join(fileRootPath, uuid.UUID(testCase.fileID).String()))
+				f, err := os.Create(filepath.Join(blobRootPath, testCase.hash.String()))
 				if err != nil {
 					t.Fatalf("failed to write file: %v", err)
 				}
@@ -85,7 +101,7 @@ func TestSaveGameFile(t *testing.T) {
 
 			expectBytes := testCase.reader.Bytes()
 
-			err := gameFile.SaveGameFile(ctx, testCase.reader, testCase.fileID)
+			err := gameFile.SaveGameFile(ctx, testCase.reader, testCase.hash)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -100,7 +116,7 @@ func TestSaveGameFile(t *testing.T) {
 				return
 			}
 
-			f, err := os.Open(filepath.Join(fileRootPath, uuid.UUID(testCase.fileID).String()))
+			f, err := os.Open(filepath.Join(blobRootPath, testCase.hash.String()))
 			if err != nil {
 				t.Fatalf("failed to read file: %v", err)
 			}
@@ -115,3 +131,257 @@ func TestSaveGameFile(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGameFileTempURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./get_game_file_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameFile, err := NewGameFile(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game file: %v", err)
+	}
+
+	type test struct {
+		description string
+		isBlobExist bool
+		isFileExist bool
+		expectPath  func(file *domain.GameFile) string
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ハッシュ値のファイルが存在するのでそのURL",
+			isBlobExist: true,
+			expectPath: func(file *domain.GameFile) string {
+				return "/api/storage/file_blobs/" + file.GetHash().String()
+			},
+		},
+		{
+			description: "重複排除の導入前のファイルなのでファイルIDのURL",
+			isFileExist: true,
+			expectPath: func(file *domain.GameFile) string {
+				return "/api/storage/files/" + uuid.UUID(file.GetID()).String()
+			},
+		},
+		{
+			description: "両方存在する場合はハッシュ値のファイルのURL",
+			isBlobExist: true,
+			isFileExist: true,
+			expectPath: func(file *domain.GameFile) string {
+				return "/api/storage/file_blobs/" + file.GetHash().String()
+			},
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				newTestGameFileHash(),
+				time.Now(),
+			)
+
+			if testCase.isBlobExist {
+				err := gameFile.SaveGameFile(ctx, bytes.NewBufferString("test"), file.GetHash())
+				if err != nil {
+					t.Fatalf("failed to save game file: %v", err)
+				}
+			}
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(rootPath, "files", uuid.UUID(file.GetID()).String()), []byte("test"), 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			tmpURL, err := gameFile.GetTempURL(ctx, file, time.Minute)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expectPath(file), (*url.URL)(tmpURL).Path)
+		})
+	}
+}
+
+func TestLoadGameFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./load_game_file_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameFile, err := NewGameFile(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game file: %v", err)
+	}
+
+	type test struct {
+		description string
+		blobContent string
+		isBlobExist bool
+		fileContent string
+		isFileExist bool
+		expect      string
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ハッシュ値のファイルが存在するのでその内容",
+			isBlobExist: true,
+			blobContent: "blob",
+			expect:      "blob",
+		},
+		{
+			description: "重複排除の導入前のファイルなのでファイルIDのファイルの内容",
+			isFileExist: true,
+			fileContent: "file",
+			expect:      "file",
+		},
+		{
+			description: "両方存在する場合はハッシュ値のファイルの内容",
+			isBlobExist: true,
+			blobContent: "blob",
+			isFileExist: true,
+			fileContent: "file",
+			expect:      "blob",
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			file := domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				values.NewGameFileEntryPoint("main.jar"),
+				newTestGameFileHash(),
+				time.Now(),
+			)
+
+			if testCase.isBlobExist {
+				err := gameFile.SaveGameFile(ctx, bytes.NewBufferString(testCase.blobContent), file.GetHash())
+				if err != nil {
+					t.Fatalf("failed to save game file: %v", err)
+				}
+			}
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(rootPath, "files", uuid.UUID(file.GetID()).String()), []byte(testCase.fileContent), 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameFile.LoadGameFile(ctx, buf, file)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, testCase.expect, buf.String())
+		})
+	}
+}
+
+func newTestGameFileHash() values.GameFileHash {
+	id := uuid.New()
+	return values.NewGameFileHashFromBytes(id[:])
+}
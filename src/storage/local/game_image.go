@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"net/url"
 	"os"
 	"path"
 	"time"
@@ -19,22 +18,34 @@ import (
 
 type GameImage struct {
 	imageRootPath    string
+	variantRootPath  string
 	directoryManager *DirectoryManager
+	urlSigner        *URLSigner
 }
 
-func NewGameImage(directoryManager *DirectoryManager) (*GameImage, error) {
-	imageRootPath, err := directoryManager.setupDirectory("images")
+func NewGameImage(directoryManager *DirectoryManager, urlSigner *URLSigner) (*GameImage, error) {
+	imageRootPath, err := directoryManager.setupDirectory(directoryNameImages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup directory: %w", err)
+	}
+
+	variantRootPath, err := directoryManager.setupDirectory(directoryNameImageVariants)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup directory: %w", err)
 	}
 
 	return &GameImage{
 		imageRootPath:    imageRootPath,
+		variantRootPath:  variantRootPath,
 		directoryManager: directoryManager,
+		urlSigner:        urlSigner,
 	}, nil
 }
 
-func (gi *GameImage) SaveGameImage(_ context.Context, reader io.Reader, imageID values.GameImageID) error {
+func (gi *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, imageID values.GameImageID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.SaveGameImage")
+	defer span.End()
+
 	imagePath := path.Join(gi.imageRootPath, uuid.UUID(imageID).String())
 
 	_, err := os.Stat(imagePath)
@@ -59,11 +70,98 @@ func (gi *GameImage) SaveGameImage(_ context.Context, reader io.Reader, imageID
 	return nil
 }
 
-func (gi *GameImage) GetTempURL(_ context.Context, image *domain.GameImage, _ time.Duration) (values.GameImageTmpURL, error) {
-	// 正しいURLにはならないが、開発環境用のmockのため妥協する
-	tmpURL, err := url.Parse(fmt.Sprintf("file://%s", path.Join(gi.imageRootPath, uuid.UUID(image.GetID()).String())))
+func (gi *GameImage) LoadGameImage(ctx context.Context, writer io.Writer, imageID values.GameImageID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.LoadGameImage")
+	defer span.End()
+
+	f, err := os.Open(path.Join(gi.imageRootPath, uuid.UUID(imageID).String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(writer, f)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
+
+func (gi *GameImage) GetTempURL(ctx context.Context, image *domain.GameImage, expires time.Duration) (values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetTempURL")
+	defer span.End()
+
+	imageID := uuid.UUID(image.GetID())
+
+	_, err := os.Stat(path.Join(gi.imageRootPath, imageID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse url: %w", err)
+		return nil, fmt.Errorf("failed to stat file: %w", err)
 	}
+
+	tmpURL := gi.urlSigner.createTempURL(buildObjectPath(directoryNameImages, imageID.String()), expires)
+
+	return values.NewGameImageTmpURL(tmpURL), nil
+}
+
+func (gi *GameImage) GetTempURLs(ctx context.Context, images []*domain.GameImage, expires time.Duration) ([]values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, images, func(ctx context.Context, image *domain.GameImage) (values.GameImageTmpURL, error) {
+		return gi.GetTempURL(ctx, image, expires)
+	})
+}
+
+func (gi *GameImage) SaveGameImageVariant(ctx context.Context, reader io.Reader, variantID values.GameImageVariantID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.SaveGameImageVariant")
+	defer span.End()
+
+	variantPath := path.Join(gi.variantRootPath, uuid.UUID(variantID).String())
+
+	_, err := os.Stat(variantPath)
+	if err == nil {
+		return storage.ErrAlreadyExists
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	f, err := os.Create(variantPath)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, reader)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
+
+func (gi *GameImage) GetVariantTempURL(ctx context.Context, variant *domain.GameImageVariant, expires time.Duration) (values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetVariantTempURL")
+	defer span.End()
+
+	variantID := uuid.UUID(variant.GetID())
+
+	_, err := os.Stat(path.Join(gi.variantRootPath, variantID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	tmpURL := gi.urlSigner.createTempURL(buildObjectPath(directoryNameImageVariants, variantID.String()), expires)
+
 	return values.NewGameImageTmpURL(tmpURL), nil
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
@@ -41,7 +42,20 @@ func TestSaveGameImage(t *testing.T) {
 		}
 	}()
 
-	gameImage, err := NewGameImage(directoryManager)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameImage, err := NewGameImage(directoryManager, urlSigner)
 	if err != nil {
 		t.Fatalf("failed to create game image: %v", err)
 	}
@@ -115,3 +129,212 @@ func TestSaveGameImage(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveGameImageVariant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./save_game_image_variant_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v\n", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameImage, err := NewGameImage(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game image: %v", err)
+	}
+
+	variantRootPath := filepath.Join(string(rootPath), "image_variants")
+
+	type test struct {
+		description string
+		variantID   values.GameImageVariantID
+		reader      *bytes.Buffer
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在しないので保存できる",
+			variantID:   values.NewGameImageVariantID(),
+			reader:      bytes.NewBufferString("a"),
+		},
+		{
+			description: "ファイルが存在するので保存できない",
+			variantID:   values.NewGameImageVariantID(),
+			reader:      bytes.NewBufferString("b"),
+			isFileExist: true,
+			isErr:       true,
+			err:         storage.ErrAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.isFileExist {
+				f, err := os.Create(filepath.Join(variantRootPath, uuid.UUID(testCase.variantID).String()))
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+				f.Close()
+			}
+
+			expectBytes := testCase.reader.Bytes()
+
+			err := gameImage.SaveGameImageVariant(ctx, testCase.reader, testCase.variantID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			f, err := os.Open(filepath.Join(variantRootPath, uuid.UUID(testCase.variantID).String()))
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			defer f.Close()
+
+			actualBytes, err := io.ReadAll(f)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+
+			assert.Equal(t, expectBytes, actualBytes)
+		})
+	}
+}
+
+func TestLoadGameImage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./load_game_image_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v\n", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameImage, err := NewGameImage(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game image: %v", err)
+	}
+
+	imageRootPath := filepath.Join(string(rootPath), "images")
+
+	type test struct {
+		description string
+		imageID     values.GameImageID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在するので読み込める",
+			imageID:     values.NewGameImageID(),
+			isFileExist: true,
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			imageID:     values.NewGameImageID(),
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			expectBytes := []byte("a")
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(imageRootPath, uuid.UUID(testCase.imageID).String()), expectBytes, 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameImage.LoadGameImage(ctx, buf, testCase.imageID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+}
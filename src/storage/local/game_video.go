@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
-	"net/url"
 	"os"
 	"path"
 	"time"
@@ -19,22 +18,34 @@ import (
 
 type GameVideo struct {
 	videoRootPath    string
+	posterRootPath   string
 	directoryManager *DirectoryManager
+	urlSigner        *URLSigner
 }
 
-func NewGameVideo(directoryManager *DirectoryManager) (*GameVideo, error) {
-	videoRootPath, err := directoryManager.setupDirectory("videos")
+func NewGameVideo(directoryManager *DirectoryManager, urlSigner *URLSigner) (*GameVideo, error) {
+	videoRootPath, err := directoryManager.setupDirectory(directoryNameVideos)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup directory: %w", err)
 	}
 
+	posterRootPath, err := directoryManager.setupDirectory(directoryNameVideoPosters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup poster directory: %w", err)
+	}
+
 	return &GameVideo{
 		videoRootPath:    videoRootPath,
+		posterRootPath:   posterRootPath,
 		directoryManager: directoryManager,
+		urlSigner:        urlSigner,
 	}, nil
 }
 
-func (gv *GameVideo) SaveGameVideo(_ context.Context, reader io.Reader, videoID values.GameVideoID) error {
+func (gv *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideo")
+	defer span.End()
+
 	videoPath := path.Join(gv.videoRootPath, uuid.UUID(videoID).String())
 
 	_, err := os.Stat(videoPath)
@@ -59,12 +70,82 @@ func (gv *GameVideo) SaveGameVideo(_ context.Context, reader io.Reader, videoID
 	return nil
 }
 
-func (gv *GameVideo) GetTempURL(_ context.Context, video *domain.GameVideo, _ time.Duration) (values.GameVideoTmpURL, error) {
-	// 正しいURLにはならないが、開発環境用のmockのため妥協する
-	tmpURL, err := url.Parse(fmt.Sprintf("file://%s", path.Join(gv.videoRootPath, uuid.UUID(video.GetID()).String())))
+func (gv *GameVideo) LoadGameVideo(ctx context.Context, writer io.Writer, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.LoadGameVideo")
+	defer span.End()
+
+	f, err := os.Open(path.Join(gv.videoRootPath, uuid.UUID(videoID).String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(writer, f)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
+
+func (gv *GameVideo) GetTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetTempURL")
+	defer span.End()
+
+	videoID := uuid.UUID(video.GetID())
+
+	_, err := os.Stat(path.Join(gv.videoRootPath, videoID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse url: %w", err)
+		return nil, fmt.Errorf("failed to stat file: %w", err)
 	}
 
+	tmpURL := gv.urlSigner.createTempURL(buildObjectPath(directoryNameVideos, videoID.String()), expires)
+
 	return values.NewGameVideoTmpURL(tmpURL), nil
 }
+
+func (gv *GameVideo) GetTempURLs(ctx context.Context, videos []*domain.GameVideo, expires time.Duration) ([]values.GameVideoTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, videos, func(ctx context.Context, video *domain.GameVideo) (values.GameVideoTmpURL, error) {
+		return gv.GetTempURL(ctx, video, expires)
+	})
+}
+
+func (gv *GameVideo) SaveGameVideoPoster(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideoPoster")
+	defer span.End()
+
+	err := replaceFile(gv.posterRootPath, uuid.UUID(videoID).String(), reader)
+	if err != nil {
+		return fmt.Errorf("failed to save video poster: %w", err)
+	}
+
+	return nil
+}
+
+func (gv *GameVideo) GetPosterTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoPosterTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetPosterTempURL")
+	defer span.End()
+
+	videoID := uuid.UUID(video.GetID())
+
+	_, err := os.Stat(path.Join(gv.posterRootPath, videoID.String()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	tmpURL := gv.urlSigner.createTempURL(buildObjectPath(directoryNameVideoPosters, videoID.String()), expires)
+
+	return values.NewGameVideoPosterTmpURL(tmpURL), nil
+}
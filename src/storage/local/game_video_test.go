@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/domain"
 	"github.com/traPtitech/trap-collection-server/src/domain/values"
 	"github.com/traPtitech/trap-collection-server/src/storage"
 	"github.com/traPtitech/trap-collection-server/testdata"
@@ -43,7 +46,20 @@ func TestSaveGameVideo(t *testing.T) {
 		}
 	}()
 
-	gameVideo, err := NewGameVideo(directoryManager)
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameVideo, err := NewGameVideo(directoryManager, urlSigner)
 	if err != nil {
 		t.Fatalf("failed to create game video: %v\n", err)
 	}
@@ -133,3 +149,216 @@ func TestSaveGameVideo(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveGameVideoPoster(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./save_game_video_poster_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v\n", err)
+		}
+	}()
+
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameVideo, err := NewGameVideo(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game video: %v\n", err)
+	}
+
+	posterRootPath := filepath.Join(string(rootPath), "video_posters")
+
+	type test struct {
+		description string
+		videoID     values.GameVideoID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在しないので保存できる",
+			videoID:     values.NewGameVideoID(),
+		},
+		{
+			description: "ファイルが存在しても上書きできる",
+			videoID:     values.NewGameVideoID(),
+			isFileExist: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(posterRootPath, uuid.UUID(testCase.videoID).String()), []byte("old poster"), 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			expectBytes := []byte("new poster")
+
+			err := gameVideo.SaveGameVideoPoster(ctx, bytes.NewReader(expectBytes), testCase.videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			actualBytes, err := os.ReadFile(filepath.Join(posterRootPath, uuid.UUID(testCase.videoID).String()))
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+
+			assert.Equal(t, expectBytes, actualBytes)
+
+			tmpURL, err := gameVideo.GetPosterTempURL(ctx, domain.NewGameVideo(testCase.videoID, values.GameVideoTypeMp4, time.Now()), time.Minute)
+			assert.NoError(t, err)
+			assert.Contains(t, (*url.URL)(tmpURL).Path, "/video_posters/"+uuid.UUID(testCase.videoID).String())
+		})
+	}
+
+	t.Run("ポスター画像が存在しないのでErrNotFound", func(t *testing.T) {
+		_, err := gameVideo.GetPosterTempURL(ctx, domain.NewGameVideo(values.NewGameVideoID(), values.GameVideoTypeMp4, time.Now()), time.Minute)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	entries, err := os.ReadDir(posterRootPath)
+	if err != nil {
+		t.Fatalf("failed to read directory: %v", err)
+	}
+	// 一時ファイルが残っていない
+	assert.Len(t, entries, len(testCases))
+}
+
+func TestLoadGameVideo(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./load_game_video_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v\n", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	mockConf.
+		EXPECT().
+		TmpURLKey().
+		Return("key", nil)
+	mockConf.
+		EXPECT().
+		BaseURL().
+		Return(&url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"}, nil)
+	urlSigner, err := NewURLSigner(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create url signer: %v", err)
+	}
+
+	gameVideo, err := NewGameVideo(directoryManager, urlSigner)
+	if err != nil {
+		t.Fatalf("failed to create game video: %v", err)
+	}
+
+	videoRootPath := filepath.Join(string(rootPath), "videos")
+
+	type test struct {
+		description string
+		videoID     values.GameVideoID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ファイルが存在するので読み込める",
+			videoID:     values.NewGameVideoID(),
+			isFileExist: true,
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			videoID:     values.NewGameVideoID(),
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			expectBytes := []byte("a")
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(videoRootPath, uuid.UUID(testCase.videoID).String()), expectBytes, 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameVideo.LoadGameVideo(ctx, buf, testCase.videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+}
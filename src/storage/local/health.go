@@ -0,0 +1,54 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ storage.Health = &Health{}
+
+type Health struct {
+	rootPath string
+}
+
+func NewHealth(directoryManager *DirectoryManager) *Health {
+	return &Health{
+		rootPath: directoryManager.rootPath,
+	}
+}
+
+func (h *Health) Ping(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "Health.Ping")
+	defer span.End()
+
+	info, err := os.Stat(h.rootPath)
+	if err != nil {
+		return fmt.Errorf("failed to get root directory info: %w", err)
+	}
+
+	if !info.IsDir() {
+		return errors.New("root path is not directory")
+	}
+
+	// ディスクのマウントが外れて読み込み専用になっている場合などを検出するため、実際に書き込んでみる
+	f, err := os.CreateTemp(h.rootPath, ".health-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+
+	err = os.Remove(f.Name())
+	if err != nil {
+		return fmt.Errorf("failed to remove temp file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	rootPath := "./health_test"
+	err := os.MkdirAll(rootPath, 0755)
+	if err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	filePath := path.Join(rootPath, "file")
+	err = os.WriteFile(filePath, []byte("file"), 0644)
+	if err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	testCases := map[string]struct {
+		rootPath string
+		isErr    bool
+	}{
+		"ディレクトリに書き込めるのでエラーなし": {
+			rootPath: rootPath,
+		},
+		"ディレクトリが存在しないのでエラー": {
+			rootPath: path.Join(rootPath, "not_exist"),
+			isErr:    true,
+		},
+		"ディレクトリでないのでエラー": {
+			rootPath: filePath,
+			isErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			health := NewHealth(&DirectoryManager{
+				rootPath: testCase.rootPath,
+			})
+
+			err := health.Ping(context.Background())
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			// 確認用のファイルが残っていない
+			entries, err := os.ReadDir(testCase.rootPath)
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+		})
+	}
+}
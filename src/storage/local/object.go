@@ -0,0 +1,150 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ storage.Objects = &Objects{}
+
+type Objects struct {
+	rootPaths map[storage.ObjectKind]string
+}
+
+func NewObjects(directoryManager *DirectoryManager) (*Objects, error) {
+	directoryNames := map[storage.ObjectKind]string{
+		storage.ObjectKindGameFile:         directoryNameFiles,
+		storage.ObjectKindGameImage:        directoryNameImages,
+		storage.ObjectKindGameImageVariant: directoryNameImageVariants,
+		storage.ObjectKindGameVideo:        directoryNameVideos,
+		storage.ObjectKindGameVideoPoster:  directoryNameVideoPosters,
+		storage.ObjectKindGameFileBlob:     directoryNameFileBlobs,
+	}
+
+	rootPaths := make(map[storage.ObjectKind]string, len(directoryNames))
+	for kind, directoryName := range directoryNames {
+		rootPath, err := directoryManager.setupDirectory(directoryName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup directory(%s): %w", directoryName, err)
+		}
+
+		rootPaths[kind] = rootPath
+	}
+
+	return &Objects{
+		rootPaths: rootPaths,
+	}, nil
+}
+
+func (o *Objects) ExistsObject(ctx context.Context, kind storage.ObjectKind, id string) (bool, error) {
+	ctx, span := tracer.Start(ctx, "Objects.ExistsObject")
+	defer span.End()
+
+	objectPath, err := o.objectPath(kind, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get object path: %w", err)
+	}
+
+	_, err = os.Stat(objectPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	return true, nil
+}
+
+func (o *Objects) LoadObject(ctx context.Context, kind storage.ObjectKind, id string, writer io.Writer) error {
+	ctx, span := tracer.Start(ctx, "Objects.LoadObject")
+	defer span.End()
+
+	objectPath, err := o.objectPath(kind, id)
+	if err != nil {
+		return fmt.Errorf("failed to get object path: %w", err)
+	}
+
+	f, err := os.Open(objectPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(writer, f)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Objects) SaveObject(ctx context.Context, kind storage.ObjectKind, id string, reader io.Reader) error {
+	ctx, span := tracer.Start(ctx, "Objects.SaveObject")
+	defer span.End()
+
+	objectPath, err := o.objectPath(kind, id)
+	if err != nil {
+		return fmt.Errorf("failed to get object path: %w", err)
+	}
+
+	err = replaceFile(path.Dir(objectPath), path.Base(objectPath), reader)
+	if err != nil {
+		return fmt.Errorf("failed to save object: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Objects) objectPath(kind storage.ObjectKind, id string) (string, error) {
+	rootPath, ok := o.rootPaths[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown object kind: %d", kind)
+	}
+
+	// idがパスとして解釈されないよう、FileServerと同じ形式のみを許可する
+	if !isObjectName(path.Base(rootPath), id) {
+		return "", fmt.Errorf("invalid object id: %s", id)
+	}
+
+	return path.Join(rootPath, id), nil
+}
+
+// replaceFile
+// 書き込み中のファイルが配信されないよう、一時ファイルに書き込んでからrenameする。
+// 既にファイルが存在する場合は上書きする。
+func replaceFile(directoryPath string, name string, reader io.Reader) error {
+	f, err := os.CreateTemp(directoryPath, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	_, err = io.Copy(f, reader)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	err = os.Rename(f.Name(), path.Join(directoryPath, name))
+	if err != nil {
+		return fmt.Errorf("failed to rename file: %w", err)
+	}
+
+	return nil
+}
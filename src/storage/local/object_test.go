@@ -0,0 +1,171 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+	"go.uber.org/mock/gomock"
+)
+
+func TestObjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	rootPath := "./objects_test"
+	mockConf := mock.NewMockStorageLocal(ctrl)
+	mockConf.
+		EXPECT().
+		Path().
+		Return(rootPath, nil)
+	directoryManager, err := NewDirectoryManager(mockConf)
+	if err != nil {
+		t.Fatalf("failed to create directory manager: %v\n", err)
+		return
+	}
+	defer func() {
+		err := os.RemoveAll(rootPath)
+		if err != nil {
+			t.Fatalf("failed to remove directory: %v", err)
+		}
+	}()
+
+	objects, err := NewObjects(directoryManager)
+	if err != nil {
+		t.Fatalf("failed to create objects: %v", err)
+	}
+
+	type test struct {
+		description string
+		kind        storage.ObjectKind
+		id          string
+		directory   string
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "ゲームファイルを保存できる",
+			kind:        storage.ObjectKindGameFile,
+			directory:   "files",
+		},
+		{
+			description: "ゲーム画像を保存できる",
+			kind:        storage.ObjectKindGameImage,
+			directory:   "images",
+		},
+		{
+			description: "派生画像を保存できる",
+			kind:        storage.ObjectKindGameImageVariant,
+			directory:   "image_variants",
+		},
+		{
+			description: "ゲーム動画を保存できる",
+			kind:        storage.ObjectKindGameVideo,
+			directory:   "videos",
+		},
+		{
+			description: "ポスター画像を保存できる",
+			kind:        storage.ObjectKindGameVideoPoster,
+			directory:   "video_posters",
+		},
+		{
+			description: "ゲームファイルの実体を保存できる",
+			kind:        storage.ObjectKindGameFileBlob,
+			id:          "098f6bcd4621d373cade4e832627b4f6",
+			directory:   "file_blobs",
+		},
+		{
+			description: "ファイルが存在しても上書きできる",
+			kind:        storage.ObjectKindGameFile,
+			directory:   "files",
+			isFileExist: true,
+		},
+		{
+			description: "ハッシュ値が不正なのでエラー",
+			kind:        storage.ObjectKindGameFileBlob,
+			id:          "../files/098f6bcd4621d373cade4e832627b4f6",
+			isErr:       true,
+		},
+		{
+			description: "UUIDが不正なのでエラー",
+			kind:        storage.ObjectKindGameFile,
+			id:          "../images/" + uuid.NewString(),
+			isErr:       true,
+		},
+		{
+			description: "想定外の種類なのでエラー",
+			kind:        100,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			id := testCase.id
+			if id == "" {
+				id = uuid.NewString()
+			}
+
+			if testCase.isFileExist {
+				err := os.WriteFile(filepath.Join(rootPath, testCase.directory, id), []byte("old"), 0644)
+				if err != nil {
+					t.Fatalf("failed to write file: %v", err)
+				}
+			}
+
+			if !testCase.isErr {
+				exists, err := objects.ExistsObject(ctx, testCase.kind, id)
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.isFileExist, exists)
+			}
+
+			expectBytes := []byte("new")
+			err := objects.SaveObject(ctx, testCase.kind, id, bytes.NewReader(expectBytes))
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			actualBytes, err := os.ReadFile(filepath.Join(rootPath, testCase.directory, id))
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			assert.Equal(t, expectBytes, actualBytes)
+
+			exists, err := objects.ExistsObject(ctx, testCase.kind, id)
+			assert.NoError(t, err)
+			assert.True(t, exists)
+
+			buf := bytes.NewBuffer(nil)
+			err = objects.LoadObject(ctx, testCase.kind, id, buf)
+			assert.NoError(t, err)
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+
+	t.Run("オブジェクトが存在しないのでErrNotFound", func(t *testing.T) {
+		err := objects.LoadObject(ctx, storage.ObjectKindGameFile, uuid.NewString(), bytes.NewBuffer(nil))
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
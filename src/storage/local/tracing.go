@@ -0,0 +1,5 @@
+package local
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/traPtitech/trap-collection-server/src/storage/local")
@@ -0,0 +1,118 @@
+package local
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+)
+
+const (
+	// swiftのTempURLと同じクエリパラメーター名を使う
+	queryKeyTmpURLSig     = "temp_url_sig"
+	queryKeyTmpURLExpires = "temp_url_expires"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrURLExpired       = errors.New("url expired")
+)
+
+// URLSigner
+// ローカルストレージのファイルに対する一時URLの発行・検証を行う。
+// 署名はHMAC-SHA256で、swiftのTempURLと同様に"メソッド\n有効期限\nパス"に対して計算する。
+type URLSigner struct {
+	key     []byte
+	baseURL *url.URL
+}
+
+func NewURLSigner(conf config.StorageLocal) (*URLSigner, error) {
+	key, err := conf.TmpURLKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tmp url key: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("tmp url key is empty")
+	}
+
+	baseURL, err := conf.BaseURL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base url: %w", err)
+	}
+
+	// 他のルートと衝突しないよう、ファイル配信は専用のパス以下で行う
+	baseURL.Path = strings.TrimSuffix(baseURL.Path, "/")
+	if baseURL.Path == "" {
+		return nil, errors.New("base url must have a path")
+	}
+
+	return &URLSigner{
+		key:     []byte(key),
+		baseURL: baseURL,
+	}, nil
+}
+
+// pathPrefix
+// ファイル配信を行うルートのパス
+func (s *URLSigner) pathPrefix() string {
+	return s.baseURL.Path
+}
+
+// createTempURL
+// objectPath(例: /videos/{id})に対する、expires後に失効する一時URLを発行する。
+func (s *URLSigner) createTempURL(objectPath string, expires time.Duration) *url.URL {
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+
+	query := url.Values{}
+	query.Set(queryKeyTmpURLSig, s.sign(http.MethodGet, expiresAt, objectPath))
+	query.Set(queryKeyTmpURLExpires, expiresAt)
+
+	tmpURL := *s.baseURL
+	tmpURL.Path = path.Join(s.baseURL.Path, objectPath)
+	tmpURL.RawQuery = query.Encode()
+
+	return &tmpURL
+}
+
+// verify
+// objectPathに対するクエリパラメーターの署名と有効期限を検証する。
+func (s *URLSigner) verify(objectPath string, query url.Values, now time.Time) error {
+	sig := query.Get(queryKeyTmpURLSig)
+	strExpiresAt := query.Get(queryKeyTmpURLExpires)
+	if sig == "" || strExpiresAt == "" {
+		return ErrInvalidSignature
+	}
+
+	expiresAt, err := strconv.ParseInt(strExpiresAt, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	expectedSig := s.sign(http.MethodGet, strExpiresAt, objectPath)
+	if !hmac.Equal([]byte(sig), []byte(expectedSig)) {
+		return ErrInvalidSignature
+	}
+
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return ErrURLExpired
+	}
+
+	return nil
+}
+
+func (s *URLSigner) sign(method string, expiresAt string, objectPath string) string {
+	mac := hmac.New(sha256.New, s.key)
+	// hash.HashのWriteはエラーを返さない
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%s", method, expiresAt, objectPath)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,171 @@
+package local
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/config/mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewURLSigner(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description string
+		key         string
+		baseURL     *url.URL
+		pathPrefix  string
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			key:         "key",
+			baseURL:     &url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"},
+			pathPrefix:  "/api/storage",
+		},
+		{
+			description: "末尾の/は取り除かれる",
+			key:         "key",
+			baseURL:     &url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage/"},
+			pathPrefix:  "/api/storage",
+		},
+		{
+			description: "keyが空なのでエラー",
+			key:         "",
+			baseURL:     &url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"},
+			isErr:       true,
+		},
+		{
+			description: "pathがないのでエラー",
+			key:         "key",
+			baseURL:     &url.URL{Scheme: "http", Host: "localhost:3000", Path: "/"},
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockConf := mock.NewMockStorageLocal(ctrl)
+			mockConf.
+				EXPECT().
+				TmpURLKey().
+				Return(testCase.key, nil)
+			mockConf.
+				EXPECT().
+				BaseURL().
+				Return(testCase.baseURL, nil).
+				AnyTimes()
+
+			urlSigner, err := NewURLSigner(mockConf)
+
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.pathPrefix, urlSigner.pathPrefix())
+		})
+	}
+}
+
+func TestURLSignerVerify(t *testing.T) {
+	t.Parallel()
+
+	urlSigner := &URLSigner{
+		key:     []byte("key"),
+		baseURL: &url.URL{Scheme: "http", Host: "localhost:3000", Path: "/api/storage"},
+	}
+
+	now := time.Now()
+	objectPath := "/videos/b4d8fa2e-0a57-4c4b-8f4c-4d6f1f0a3b7e"
+
+	tmpURL := urlSigner.createTempURL(objectPath, time.Minute)
+	assert.Equal(t, "http", tmpURL.Scheme)
+	assert.Equal(t, "localhost:3000", tmpURL.Host)
+	assert.Equal(t, "/api/storage"+objectPath, tmpURL.Path)
+
+	validQuery := tmpURL.Query()
+
+	tamperedExpiresQuery := tmpURL.Query()
+	tamperedExpiresQuery.Set(queryKeyTmpURLExpires, strconv.FormatInt(now.Add(time.Hour).Unix(), 10))
+
+	otherKeySigner := &URLSigner{
+		key:     []byte("other"),
+		baseURL: urlSigner.baseURL,
+	}
+	otherKeyQuery := otherKeySigner.createTempURL(objectPath, time.Minute).Query()
+
+	expiredQuery := urlSigner.createTempURL(objectPath, -time.Second).Query()
+
+	type test struct {
+		description string
+		objectPath  string
+		query       url.Values
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "正しい署名なのでエラーなし",
+			objectPath:  objectPath,
+			query:       validQuery,
+		},
+		{
+			description: "パスが異なるのでエラー",
+			objectPath:  "/files/b4d8fa2e-0a57-4c4b-8f4c-4d6f1f0a3b7e",
+			query:       validQuery,
+			err:         ErrInvalidSignature,
+		},
+		{
+			description: "有効期限が書き換えられているのでエラー",
+			objectPath:  objectPath,
+			query:       tamperedExpiresQuery,
+			err:         ErrInvalidSignature,
+		},
+		{
+			description: "鍵が異なるのでエラー",
+			objectPath:  objectPath,
+			query:       otherKeyQuery,
+			err:         ErrInvalidSignature,
+		},
+		{
+			description: "クエリパラメーターがないのでエラー",
+			objectPath:  objectPath,
+			query:       url.Values{},
+			err:         ErrInvalidSignature,
+		},
+		{
+			description: "有効期限が切れているのでエラー",
+			objectPath:  objectPath,
+			query:       expiredQuery,
+			err:         ErrURLExpired,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			err := urlSigner.verify(testCase.objectPath, testCase.query, now)
+
+			if testCase.err != nil {
+				if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
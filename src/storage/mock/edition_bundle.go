@@ -0,0 +1,80 @@
+package mock
+
+import (
+	"bytes"
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/traPtitech/trap-collection-server/src/domain"
+	values "github.com/traPtitech/trap-collection-server/src/domain/values"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// EditionBundle is a mock of EditionBundle interface.
+type EditionBundle struct {
+	ctrl     *gomock.Controller
+	recorder *EditionBundleMockRecorder
+	buf      *bytes.Buffer
+}
+
+// EditionBundleMockRecorder is the mock recorder for MockEditionBundle.
+type EditionBundleMockRecorder struct {
+	mock *EditionBundle
+}
+
+// NewEditionBundle creates a new mock instance.
+func NewEditionBundle(ctrl *gomock.Controller, buf *bytes.Buffer) *EditionBundle {
+	mock := &EditionBundle{ctrl: ctrl}
+	mock.recorder = &EditionBundleMockRecorder{mock}
+	mock.buf = buf
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *EditionBundle) EXPECT() *EditionBundleMockRecorder {
+	return m.recorder
+}
+
+// SaveEditionBundle mocks base method.
+func (m *EditionBundle) SaveEditionBundle(ctx context.Context, reader io.Reader, bundleID values.EditionBundleID) error {
+	ret0 := m.saveEditionBundle(ctx, bundleID)
+
+	// 途中で読み込みに失敗した場合は、実際のストレージと同様にエラーを返す
+	_, err := io.Copy(m.buf, reader)
+	if err != nil {
+		return err
+	}
+
+	return ret0
+}
+
+func (m *EditionBundle) saveEditionBundle(ctx context.Context, bundleID values.EditionBundleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEditionBundle", ctx, bundleID)
+	ret0, _ := ret[0].(error)
+
+	return ret0
+}
+
+// SaveEditionBundle indicates an expected call of SaveEditionBundle.
+func (mr *EditionBundleMockRecorder) SaveEditionBundle(ctx, bundleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEditionBundle", reflect.TypeOf((*EditionBundle)(nil).saveEditionBundle), ctx, bundleID)
+}
+
+// GetTempURL mocks base method.
+func (m *EditionBundle) GetTempURL(ctx context.Context, bundle *domain.EditionBundle, expires time.Duration) (values.EditionBundleTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTempURL", ctx, bundle, expires)
+	ret0, _ := ret[0].(values.EditionBundleTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTempURL indicates an expected call of GetTempURL.
+func (mr *EditionBundleMockRecorder) GetTempURL(ctx, bundle, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURL", reflect.TypeOf((*EditionBundle)(nil).GetTempURL), ctx, bundle, expires)
+}
@@ -52,9 +52,24 @@ func (mr *GameFileMockRecorder) GetTempURL(ctx, file, expires interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURL", reflect.TypeOf((*GameFile)(nil).GetTempURL), ctx, file, expires)
 }
 
+// GetTempURLs mocks base method.
+func (m *GameFile) GetTempURLs(ctx context.Context, files []*domain.GameFile, expires time.Duration) ([]values.GameFileTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTempURLs", ctx, files, expires)
+	ret0, _ := ret[0].([]values.GameFileTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTempURLs indicates an expected call of GetTempURLs.
+func (mr *GameFileMockRecorder) GetTempURLs(ctx, files, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURLs", reflect.TypeOf((*GameFile)(nil).GetTempURLs), ctx, files, expires)
+}
+
 // SaveGameFile mocks base method.
-func (m *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, fileID values.GameFileID) error {
-	ret0 := m.saveGameFile(ctx, fileID)
+func (m *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, hash values.GameFileHash) error {
+	ret0 := m.saveGameFile(ctx, hash)
 
 	_, err := io.Copy(m.buf, reader)
 	if err != nil {
@@ -64,16 +79,30 @@ func (m *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, fileID va
 	return ret0
 }
 
-func (m *GameFile) saveGameFile(ctx context.Context, fileID values.GameFileID) error {
+func (m *GameFile) saveGameFile(ctx context.Context, hash values.GameFileHash) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveGameFile", ctx, fileID)
+	ret := m.ctrl.Call(m, "SaveGameFile", ctx, hash)
 	ret0, _ := ret[0].(error)
 
 	return ret0
 }
 
 // SaveGameFile indicates an expected call of SaveGameFile.
-func (mr *GameFileMockRecorder) SaveGameFile(ctx, file interface{}) *gomock.Call {
+func (mr *GameFileMockRecorder) SaveGameFile(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameFile", reflect.TypeOf((*GameFile)(nil).saveGameFile), ctx, hash)
+}
+
+// LoadGameFile mocks base method.
+func (m *GameFile) LoadGameFile(ctx context.Context, writer io.Writer, file *domain.GameFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadGameFile", ctx, writer, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadGameFile indicates an expected call of LoadGameFile.
+func (mr *GameFileMockRecorder) LoadGameFile(ctx, writer, file interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameFile", reflect.TypeOf((*GameFile)(nil).saveGameFile), ctx, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadGameFile", reflect.TypeOf((*GameFile)(nil).LoadGameFile), ctx, writer, file)
 }
@@ -52,13 +52,29 @@ func (mr *GameImageMockRecorder) GetTempURL(ctx, image, expires interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURL", reflect.TypeOf((*GameImage)(nil).GetTempURL), ctx, image, expires)
 }
 
+// GetTempURLs mocks base method.
+func (m *GameImage) GetTempURLs(ctx context.Context, images []*domain.GameImage, expires time.Duration) ([]values.GameImageTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTempURLs", ctx, images, expires)
+	ret0, _ := ret[0].([]values.GameImageTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTempURLs indicates an expected call of GetTempURLs.
+func (mr *GameImageMockRecorder) GetTempURLs(ctx, images, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURLs", reflect.TypeOf((*GameImage)(nil).GetTempURLs), ctx, images, expires)
+}
+
 // SaveGameImage mocks base method.
 func (m *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, imageID values.GameImageID) error {
 	ret0 := m.saveGameImage(ctx, imageID)
 
+	// 途中で読み込みに失敗した場合は、実際のストレージと同様にエラーを返す
 	_, err := io.Copy(m.buf, reader)
 	if err != nil {
-		m.ctrl.T.Fatalf("unexpected error copying to buffer: %v", err)
+		return err
 	}
 
 	return ret0
@@ -77,3 +93,59 @@ func (mr *GameImageMockRecorder) SaveGameImage(ctx, image interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameImage", reflect.TypeOf((*GameImage)(nil).saveGameImage), ctx, image)
 }
+
+// GetVariantTempURL mocks base method.
+func (m *GameImage) GetVariantTempURL(ctx context.Context, variant *domain.GameImageVariant, expires time.Duration) (values.GameImageTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantTempURL", ctx, variant, expires)
+	ret0, _ := ret[0].(values.GameImageTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantTempURL indicates an expected call of GetVariantTempURL.
+func (mr *GameImageMockRecorder) GetVariantTempURL(ctx, variant, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantTempURL", reflect.TypeOf((*GameImage)(nil).GetVariantTempURL), ctx, variant, expires)
+}
+
+// SaveGameImageVariant mocks base method.
+// 派生画像の内容はbufに書き込まず読み捨てる。
+func (m *GameImage) SaveGameImageVariant(ctx context.Context, reader io.Reader, variantID values.GameImageVariantID) error {
+	ret0 := m.saveGameImageVariant(ctx, variantID)
+
+	_, err := io.Copy(io.Discard, reader)
+	if err != nil {
+		m.ctrl.T.Fatalf("unexpected error reading variant: %v", err)
+	}
+
+	return ret0
+}
+
+func (m *GameImage) saveGameImageVariant(ctx context.Context, variantID values.GameImageVariantID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveGameImageVariant", ctx, variantID)
+	ret0, _ := ret[0].(error)
+
+	return ret0
+}
+
+// SaveGameImageVariant indicates an expected call of SaveGameImageVariant.
+func (mr *GameImageMockRecorder) SaveGameImageVariant(ctx, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameImageVariant", reflect.TypeOf((*GameImage)(nil).saveGameImageVariant), ctx, variantID)
+}
+
+// LoadGameImage mocks base method.
+func (m *GameImage) LoadGameImage(ctx context.Context, writer io.Writer, imageID values.GameImageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadGameImage", ctx, writer, imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadGameImage indicates an expected call of LoadGameImage.
+func (mr *GameImageMockRecorder) LoadGameImage(ctx, writer, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadGameImage", reflect.TypeOf((*GameImage)(nil).LoadGameImage), ctx, writer, imageID)
+}
@@ -52,13 +52,29 @@ func (mr *GameVideoMockRecorder) GetTempURL(ctx, video, expires interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURL", reflect.TypeOf((*GameVideo)(nil).GetTempURL), ctx, video, expires)
 }
 
+// GetTempURLs mocks base method.
+func (m *GameVideo) GetTempURLs(ctx context.Context, videos []*domain.GameVideo, expires time.Duration) ([]values.GameVideoTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTempURLs", ctx, videos, expires)
+	ret0, _ := ret[0].([]values.GameVideoTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTempURLs indicates an expected call of GetTempURLs.
+func (mr *GameVideoMockRecorder) GetTempURLs(ctx, videos, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTempURLs", reflect.TypeOf((*GameVideo)(nil).GetTempURLs), ctx, videos, expires)
+}
+
 // SaveGameVideo mocks base method.
 func (m *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
 	ret0 := m.saveGameVideo(ctx, videoID)
 
+	// 途中で読み込みに失敗した場合は、実際のストレージと同様にエラーを返す
 	_, err := io.Copy(m.buf, reader)
 	if err != nil {
-		m.ctrl.T.Fatalf("unexpected error copying to buffer: %v", err)
+		return err
 	}
 
 	return ret0
@@ -77,3 +93,58 @@ func (mr *GameVideoMockRecorder) SaveGameVideo(ctx, video interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameVideo", reflect.TypeOf((*GameVideo)(nil).saveGameVideo), ctx, video)
 }
+
+// LoadGameVideo mocks base method.
+func (m *GameVideo) LoadGameVideo(ctx context.Context, writer io.Writer, videoID values.GameVideoID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadGameVideo", ctx, writer, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadGameVideo indicates an expected call of LoadGameVideo.
+func (mr *GameVideoMockRecorder) LoadGameVideo(ctx, writer, videoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadGameVideo", reflect.TypeOf((*GameVideo)(nil).LoadGameVideo), ctx, writer, videoID)
+}
+
+// GetPosterTempURL mocks base method.
+func (m *GameVideo) GetPosterTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoPosterTmpURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPosterTempURL", ctx, video, expires)
+	ret0, _ := ret[0].(values.GameVideoPosterTmpURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPosterTempURL indicates an expected call of GetPosterTempURL.
+func (mr *GameVideoMockRecorder) GetPosterTempURL(ctx, video, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPosterTempURL", reflect.TypeOf((*GameVideo)(nil).GetPosterTempURL), ctx, video, expires)
+}
+
+// SaveGameVideoPoster mocks base method.
+func (m *GameVideo) SaveGameVideoPoster(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	ret0 := m.saveGameVideoPoster(ctx, videoID)
+
+	_, err := io.Copy(m.buf, reader)
+	if err != nil {
+		m.ctrl.T.Fatalf("unexpected error copying to buffer: %v", err)
+	}
+
+	return ret0
+}
+
+func (m *GameVideo) saveGameVideoPoster(ctx context.Context, videoID values.GameVideoID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveGameVideoPoster", ctx, videoID)
+	ret0, _ := ret[0].(error)
+
+	return ret0
+}
+
+// SaveGameVideoPoster indicates an expected call of SaveGameVideoPoster.
+func (mr *GameVideoMockRecorder) SaveGameVideoPoster(ctx, videoID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGameVideoPoster", reflect.TypeOf((*GameVideo)(nil).saveGameVideoPoster), ctx, videoID)
+}
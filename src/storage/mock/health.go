@@ -0,0 +1,45 @@
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Health is a mock of Health interface.
+type Health struct {
+	ctrl     *gomock.Controller
+	recorder *HealthMockRecorder
+}
+
+// HealthMockRecorder is the mock recorder for MockHealth.
+type HealthMockRecorder struct {
+	mock *Health
+}
+
+// NewHealth creates a new mock instance.
+func NewHealth(ctrl *gomock.Controller) *Health {
+	mock := &Health{ctrl: ctrl}
+	mock.recorder = &HealthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Health) EXPECT() *HealthMockRecorder {
+	return m.recorder
+}
+
+// Ping mocks base method.
+func (m *Health) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *HealthMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*Health)(nil).Ping), ctx)
+}
@@ -0,0 +1,91 @@
+package mock
+
+import (
+	"bytes"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	storage "github.com/traPtitech/trap-collection-server/src/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Objects is a mock of Objects interface.
+type Objects struct {
+	ctrl     *gomock.Controller
+	recorder *ObjectsMockRecorder
+	buf      *bytes.Buffer
+}
+
+// ObjectsMockRecorder is the mock recorder for MockObjects.
+type ObjectsMockRecorder struct {
+	mock *Objects
+}
+
+// NewObjects creates a new mock instance.
+func NewObjects(ctrl *gomock.Controller, buf *bytes.Buffer) *Objects {
+	mock := &Objects{ctrl: ctrl}
+	mock.recorder = &ObjectsMockRecorder{mock}
+	mock.buf = buf
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Objects) EXPECT() *ObjectsMockRecorder {
+	return m.recorder
+}
+
+// ExistsObject mocks base method.
+func (m *Objects) ExistsObject(ctx context.Context, kind storage.ObjectKind, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsObject", ctx, kind, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsObject indicates an expected call of ExistsObject.
+func (mr *ObjectsMockRecorder) ExistsObject(ctx, kind, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsObject", reflect.TypeOf((*Objects)(nil).ExistsObject), ctx, kind, id)
+}
+
+// LoadObject mocks base method.
+func (m *Objects) LoadObject(ctx context.Context, kind storage.ObjectKind, id string, writer io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadObject", ctx, kind, id, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadObject indicates an expected call of LoadObject.
+func (mr *ObjectsMockRecorder) LoadObject(ctx, kind, id, writer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadObject", reflect.TypeOf((*Objects)(nil).LoadObject), ctx, kind, id, writer)
+}
+
+// SaveObject mocks base method.
+func (m *Objects) SaveObject(ctx context.Context, kind storage.ObjectKind, id string, reader io.Reader) error {
+	ret0 := m.saveObject(ctx, kind, id)
+
+	_, err := io.Copy(m.buf, reader)
+	if err != nil {
+		m.ctrl.T.Fatalf("unexpected error copying to buffer: %v", err)
+	}
+
+	return ret0
+}
+
+func (m *Objects) saveObject(ctx context.Context, kind storage.ObjectKind, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveObject", ctx, kind, id)
+	ret0, _ := ret[0].(error)
+
+	return ret0
+}
+
+// SaveObject indicates an expected call of SaveObject.
+func (mr *ObjectsMockRecorder) SaveObject(ctx, kind, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveObject", reflect.TypeOf((*Objects)(nil).saveObject), ctx, kind, id)
+}
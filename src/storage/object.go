@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// ObjectKind
+// ストレージ間の移行で扱うオブジェクトの種類。
+type ObjectKind int8
+
+const (
+	ObjectKindGameFile ObjectKind = iota + 1
+	ObjectKindGameImage
+	ObjectKindGameImageVariant
+	ObjectKindGameVideo
+	ObjectKindGameVideoPoster
+	// ObjectKindGameFileBlob
+	// ハッシュ値をキーとして保存されたゲームファイルの実体。
+	// ObjectKindGameFileは、重複排除の導入前にファイルIDをキーとして保存されたゲームファイル。
+	ObjectKindGameFileBlob
+)
+
+func (k ObjectKind) String() string {
+	switch k {
+	case ObjectKindGameFile:
+		return "game_file"
+	case ObjectKindGameImage:
+		return "game_image"
+	case ObjectKindGameImageVariant:
+		return "game_image_variant"
+	case ObjectKindGameVideo:
+		return "game_video"
+	case ObjectKindGameVideoPoster:
+		return "game_video_poster"
+	case ObjectKindGameFileBlob:
+		return "game_file_blob"
+	}
+
+	return "unknown"
+}
+
+// Objects
+// ストレージ間の移行のため、種類とIDを指定してオブジェクトを読み書きする。
+// オブジェクトのキーは、GameFileなどの各ストレージと同じものを使う。
+// idは、ObjectKindGameFileBlobではハッシュ値の16進数表記、それ以外ではUUIDの文字列。
+type Objects interface {
+	// ExistsObject
+	// オブジェクトが存在するかを返す。
+	ExistsObject(ctx context.Context, kind ObjectKind, id string) (bool, error)
+	// LoadObject
+	// オブジェクトをwriterに書き込む。
+	// オブジェクトが存在しない場合、ErrNotFoundを返す。
+	LoadObject(ctx context.Context, kind ObjectKind, id string, writer io.Writer) error
+	// SaveObject
+	// オブジェクトを保存する。
+	// 既にオブジェクトが存在する場合は上書きする。
+	SaveObject(ctx context.Context, kind ObjectKind, id string, reader io.Reader) error
+}
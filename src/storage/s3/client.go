@@ -2,17 +2,22 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 	"time"
 
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/transfermanager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/storage"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type Client struct {
@@ -74,6 +79,10 @@ func setupS3(
 		awsConfig.WithCredentialsProvider(
 			credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, ""),
 		),
+		// S3へのリクエストをトレースする
+		awsConfig.WithHTTPClient(&http.Client{
+			Transport: otelhttp.NewTransport(awshttp.NewBuildableClient().GetTransport()),
+		}),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
@@ -112,10 +121,20 @@ func (c *Client) saveFile(
 		}
 	}
 
+	return c.putFile(ctx, name, content)
+}
+
+// putFile
+// 既にオブジェクトが存在する場合は上書きする。
+func (c *Client) putFile(
+	ctx context.Context,
+	name string,
+	content io.Reader,
+) error {
 	tm := transfermanager.New(c.client, func(o *transfermanager.Options) {
 		o.Concurrency = 5
 	})
-	_, err = tm.UploadObject(ctx, &transfermanager.UploadObjectInput{
+	_, err := tm.UploadObject(ctx, &transfermanager.UploadObjectInput{
 		Bucket: &c.bucket,
 		Key:    &name,
 		Body:   content,
@@ -163,3 +182,55 @@ func (c *Client) createTempURL(ctx context.Context, name string, expires time.Du
 
 	return tmpURL, nil
 }
+
+// ping
+// バケットにアクセスできるかで、接続できるかを確認する
+func (c *Client) ping(ctx context.Context) error {
+	_, err := c.client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: &c.bucket,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to head bucket: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) existsFile(ctx context.Context, name string) (bool, error) {
+	objects, err := c.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket: &c.bucket,
+		Prefix: &name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	for _, object := range objects.Contents {
+		if object.Key != nil && *object.Key == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *Client) loadFile(ctx context.Context, name string, w io.Writer) error {
+	res, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &c.bucket,
+		Key:    &name,
+	})
+	var awsErr *types.NoSuchKey
+	if err != nil && errors.As(err, &awsErr) {
+		return fmt.Errorf("failed to get object: %w", storage.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get object: %w", err)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to copy: %w", err)
+	}
+
+	return nil
+}
@@ -15,7 +15,6 @@ import (
 
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/ory/dockertest/v3"
 	"github.com/ory/dockertest/v3/docker"
 	"github.com/stretchr/testify/assert"
@@ -365,24 +364,3 @@ func TestCreateTempURL(t *testing.T) {
 		})
 	}
 }
-
-func (c *Client) loadFile(ctx context.Context, name string, w io.Writer) error {
-	res, err := c.client.GetObject(ctx, &s3.GetObjectInput{
-		Bucket: &c.bucket,
-		Key:    &name,
-	})
-	var awsErr *types.NoSuchKey
-	if err != nil && errors.As(err, &awsErr) {
-		return fmt.Errorf("failed to get object: %w", storage.ErrNotFound)
-	}
-	if err != nil {
-		return fmt.Errorf("failed to get object: %w", err)
-	}
-
-	_, err = io.Copy(w, res.Body)
-	if err != nil {
-		return fmt.Errorf("failed to copy: %w", err)
-	}
-
-	return nil
-}
@@ -23,12 +23,15 @@ func NewGameFile(client *Client) *GameFile {
 	}
 }
 
-func (gf *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, fileID values.GameFileID) error {
-	fileKey := gf.fileKey(fileID)
+func (gf *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, hash values.GameFileHash) error {
+	ctx, span := tracer.Start(ctx, "GameFile.SaveGameFile")
+	defer span.End()
+
+	blobKey := gf.blobKey(hash)
 
 	err := gf.client.saveFile(
 		ctx,
-		fileKey,
+		blobKey,
 		reader,
 	)
 	if errors.Is(err, ErrAlreadyExists) {
@@ -41,10 +44,34 @@ func (gf *GameFile) SaveGameFile(ctx context.Context, reader io.Reader, fileID v
 	return nil
 }
 
+func (gf *GameFile) LoadGameFile(ctx context.Context, writer io.Writer, file *domain.GameFile) error {
+	ctx, span := tracer.Start(ctx, "GameFile.LoadGameFile")
+	defer span.End()
+
+	err := gf.client.loadFile(ctx, gf.blobKey(file.GetHash()), writer)
+	if errors.Is(err, storage.ErrNotFound) {
+		// 重複排除の導入前に保存されたファイルは、ファイルIDをキーとして保存されている
+		err = gf.client.loadFile(ctx, gf.fileKey(file.GetID()), writer)
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load file: %w", err)
+	}
+
+	return nil
+}
+
 func (gf *GameFile) GetTempURL(ctx context.Context, file *domain.GameFile, expires time.Duration) (values.GameFileTmpURL, error) {
-	fileKey := gf.fileKey(file.GetID())
+	ctx, span := tracer.Start(ctx, "GameFile.GetTempURL")
+	defer span.End()
 
-	url, err := gf.client.createTempURL(ctx, fileKey, expires)
+	url, err := gf.client.createTempURL(ctx, gf.blobKey(file.GetHash()), expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		// 重複排除の導入前に保存されたファイルは、ファイルIDをキーとして保存されている
+		url, err = gf.client.createTempURL(ctx, gf.fileKey(file.GetID()), expires)
+	}
 	if errors.Is(err, storage.ErrNotFound) {
 		return nil, storage.ErrNotFound
 	}
@@ -55,6 +82,19 @@ func (gf *GameFile) GetTempURL(ctx context.Context, file *domain.GameFile, expir
 	return url, nil
 }
 
+func (gf *GameFile) GetTempURLs(ctx context.Context, files []*domain.GameFile, expires time.Duration) ([]values.GameFileTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameFile.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, files, func(ctx context.Context, file *domain.GameFile) (values.GameFileTmpURL, error) {
+		return gf.GetTempURL(ctx, file, expires)
+	})
+}
+
 func (gf *GameFile) fileKey(fileID values.GameFileID) string {
 	return fmt.Sprintf("files/%s", uuid.UUID(fileID).String())
 }
+
+func (gf *GameFile) blobKey(hash values.GameFileHash) string {
+	return fmt.Sprintf("files/blobs/%s", hash.String())
+}
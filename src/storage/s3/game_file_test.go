@@ -23,7 +23,7 @@ func TestSaveGameFile(t *testing.T) {
 
 	type test struct {
 		description string
-		fileID      values.GameFileID
+		hash        values.GameFileHash
 		reader      *bytes.Buffer
 		isFileExist bool
 		isErr       bool
@@ -33,12 +33,12 @@ func TestSaveGameFile(t *testing.T) {
 	testCases := []test{
 		{
 			description: "特に問題ないのでエラーなし",
-			fileID:      values.NewGameFileID(),
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 		},
 		{
-			description: "ファイルが存在するのでErrAlreadyExists",
-			fileID:      values.NewGameFileID(),
+			description: "同じハッシュ値のファイルが存在するのでErrAlreadyExists",
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 			isFileExist: true,
 			isErr:       true,
@@ -53,7 +53,7 @@ func TestSaveGameFile(t *testing.T) {
 			if testCase.isFileExist {
 				err := testClient.saveFile(
 					ctx,
-					fmt.Sprintf("files/%s", uuid.UUID(testCase.fileID).String()),
+					fmt.Sprintf("files/blobs/%s", testCase.hash.String()),
 					strings.NewReader(""),
 				)
 				if err != nil {
@@ -63,7 +63,7 @@ func TestSaveGameFile(t *testing.T) {
 
 			expectBytes := testCase.reader.Bytes()
 
-			err := gameFileStorage.SaveGameFile(ctx, testCase.reader, testCase.fileID)
+			err := gameFileStorage.SaveGameFile(ctx, testCase.reader, testCase.hash)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -79,7 +79,7 @@ func TestSaveGameFile(t *testing.T) {
 			}
 
 			buf := bytes.NewBuffer(nil)
-			err = testClient.loadFile(ctx, fmt.Sprintf("files/%s", uuid.UUID(testCase.fileID).String()), buf)
+			err = testClient.loadFile(ctx, fmt.Sprintf("files/blobs/%s", testCase.hash.String()), buf)
 			if err != nil {
 				t.Fatalf("failed to load file: %v", err)
 			}
@@ -98,6 +98,7 @@ func TestFileGetTempURL(t *testing.T) {
 		description string
 		file        *domain.GameFile
 		buf         *bytes.Buffer
+		isBlobExist bool
 		isFileExist bool
 		isErr       bool
 		err         error
@@ -114,6 +115,18 @@ func TestFileGetTempURL(t *testing.T) {
 				time.Now(),
 			),
 			buf:         bytes.NewBufferString("test"),
+			isBlobExist: true,
+		},
+		{
+			description: "重複排除の導入前のファイルなのでファイルIDのキーのURL",
+			file: domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				"path/to/game.jar",
+				newTestGameFileHash(),
+				time.Now(),
+			),
+			buf:         bytes.NewBufferString("test"),
 			isFileExist: true,
 		},
 		{
@@ -122,7 +135,7 @@ func TestFileGetTempURL(t *testing.T) {
 				values.NewGameFileID(),
 				values.GameFileTypeJar,
 				"path/to/game.jar",
-				values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+				newTestGameFileHash(),
 				time.Now(),
 			),
 			buf:   bytes.NewBufferString("test"),
@@ -135,6 +148,17 @@ func TestFileGetTempURL(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			expectBytes := testCase.buf.Bytes()
 
+			if testCase.isBlobExist {
+				err := testClient.saveFile(
+					ctx,
+					fmt.Sprintf("files/blobs/%s", testCase.file.GetHash().String()),
+					testCase.buf,
+				)
+				if err != nil {
+					t.Fatalf("failed to create file: %v", err)
+				}
+			}
+
 			if testCase.isFileExist {
 				err := testClient.saveFile(
 					ctx,
@@ -193,3 +217,21 @@ func TestFileKey(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("files/%s", uuid.UUID(fileID).String()), key)
 	}
 }
+
+func TestBlobKey(t *testing.T) {
+	t.Parallel()
+
+	// clientは使わないのでnilでOK
+	gameFileStorage := NewGameFile(nil)
+
+	hash := values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6})
+
+	key := gameFileStorage.blobKey(hash)
+
+	assert.Equal(t, "files/blobs/098f6bcd4621d373cade4e832627b4f6", key)
+}
+
+func newTestGameFileHash() values.GameFileHash {
+	id := uuid.New()
+	return values.NewGameFileHashFromBytes(id[:])
+}
@@ -24,6 +24,9 @@ func NewGameImage(client *Client) *GameImage {
 }
 
 func (gi *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, imageID values.GameImageID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.SaveGameImage")
+	defer span.End()
+
 	imageKey := gi.imageKey(imageID)
 
 	err := gi.client.saveFile(
@@ -41,7 +44,27 @@ func (gi *GameImage) SaveGameImage(ctx context.Context, reader io.Reader, imageI
 	return nil
 }
 
+func (gi *GameImage) LoadGameImage(ctx context.Context, writer io.Writer, imageID values.GameImageID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.LoadGameImage")
+	defer span.End()
+
+	imageKey := gi.imageKey(imageID)
+
+	err := gi.client.loadFile(ctx, imageKey, writer)
+	if errors.Is(err, storage.ErrNotFound) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load image: %w", err)
+	}
+
+	return nil
+}
+
 func (gi *GameImage) GetTempURL(ctx context.Context, image *domain.GameImage, expires time.Duration) (values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetTempURL")
+	defer span.End()
+
 	filekey := gi.imageKey(image.GetID())
 
 	url, err := gi.client.createTempURL(ctx, filekey, expires)
@@ -55,7 +78,59 @@ func (gi *GameImage) GetTempURL(ctx context.Context, image *domain.GameImage, ex
 	return url, nil
 }
 
+func (gi *GameImage) GetTempURLs(ctx context.Context, images []*domain.GameImage, expires time.Duration) ([]values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, images, func(ctx context.Context, image *domain.GameImage) (values.GameImageTmpURL, error) {
+		return gi.GetTempURL(ctx, image, expires)
+	})
+}
+
+func (gi *GameImage) SaveGameImageVariant(ctx context.Context, reader io.Reader, variantID values.GameImageVariantID) error {
+	ctx, span := tracer.Start(ctx, "GameImage.SaveGameImageVariant")
+	defer span.End()
+
+	variantKey := gi.variantKey(variantID)
+
+	err := gi.client.saveFile(
+		ctx,
+		variantKey,
+		reader,
+	)
+	if errors.Is(err, ErrAlreadyExists) {
+		return storage.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save image variant: %w", err)
+	}
+
+	return nil
+}
+
+func (gi *GameImage) GetVariantTempURL(ctx context.Context, variant *domain.GameImageVariant, expires time.Duration) (values.GameImageTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameImage.GetVariantTempURL")
+	defer span.End()
+
+	filekey := gi.variantKey(variant.GetID())
+
+	url, err := gi.client.createTempURL(ctx, filekey, expires)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get temp url: %w", err)
+	}
+
+	return url, nil
+}
+
 // imageKey 変更時にはオブジェクトストレージのキーを変更する必要があるので要注意
 func (gi *GameImage) imageKey(imageID values.GameImageID) string {
 	return fmt.Sprintf("images/%s", uuid.UUID(imageID).String())
 }
+
+// variantKey 変更時にはオブジェクトストレージのキーを変更する必要があるので要注意
+func (gi *GameImage) variantKey(variantID values.GameImageVariantID) string {
+	return fmt.Sprintf("images/variants/%s", uuid.UUID(variantID).String())
+}
@@ -0,0 +1,32 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ storage.Health = &Health{}
+
+type Health struct {
+	client *Client
+}
+
+func NewHealth(client *Client) *Health {
+	return &Health{
+		client: client,
+	}
+}
+
+func (h *Health) Ping(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "Health.Ping")
+	defer span.End()
+
+	err := h.client.ping(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ping s3: %w", err)
+	}
+
+	return nil
+}
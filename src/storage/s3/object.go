@@ -0,0 +1,116 @@
+package s3
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+var _ storage.Objects = &Objects{}
+
+type Objects struct {
+	client *Client
+}
+
+func NewObjects(client *Client) *Objects {
+	return &Objects{
+		client: client,
+	}
+}
+
+func (o *Objects) ExistsObject(ctx context.Context, kind storage.ObjectKind, id string) (bool, error) {
+	ctx, span := tracer.Start(ctx, "Objects.ExistsObject")
+	defer span.End()
+
+	key, err := o.objectKey(kind, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to get object key: %w", err)
+	}
+
+	exists, err := o.client.existsFile(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to check object: %w", err)
+	}
+
+	return exists, nil
+}
+
+func (o *Objects) LoadObject(ctx context.Context, kind storage.ObjectKind, id string, writer io.Writer) error {
+	ctx, span := tracer.Start(ctx, "Objects.LoadObject")
+	defer span.End()
+
+	key, err := o.objectKey(kind, id)
+	if err != nil {
+		return fmt.Errorf("failed to get object key: %w", err)
+	}
+
+	err = o.client.loadFile(ctx, key, writer)
+	if errors.Is(err, storage.ErrNotFound) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load object: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Objects) SaveObject(ctx context.Context, kind storage.ObjectKind, id string, reader io.Reader) error {
+	ctx, span := tracer.Start(ctx, "Objects.SaveObject")
+	defer span.End()
+
+	key, err := o.objectKey(kind, id)
+	if err != nil {
+		return fmt.Errorf("failed to get object key: %w", err)
+	}
+
+	err = o.client.putFile(
+		ctx,
+		key,
+		reader,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save object: %w", err)
+	}
+
+	return nil
+}
+
+// objectKey
+// GameFileなどの各ストレージと同じキーを返す。
+func (o *Objects) objectKey(kind storage.ObjectKind, id string) (string, error) {
+	if kind == storage.ObjectKindGameFileBlob {
+		hash, err := hex.DecodeString(id)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode hash: %w", err)
+		}
+
+		return (&GameFile{}).blobKey(values.NewGameFileHashFromBytes(hash)), nil
+	}
+
+	uuidID, err := uuid.Parse(id)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse id: %w", err)
+	}
+
+	switch kind {
+	case storage.ObjectKindGameFile:
+		return (&GameFile{}).fileKey(values.NewGameFileIDFromUUID(uuidID)), nil
+	case storage.ObjectKindGameImage:
+		return (&GameImage{}).imageKey(values.GameImageID(uuidID)), nil
+	case storage.ObjectKindGameImageVariant:
+		return (&GameImage{}).variantKey(values.GameImageVariantID(uuidID)), nil
+	case storage.ObjectKindGameVideo:
+		return (&GameVideo{}).videoKey(values.NewGameVideoIDFromUUID(uuidID)), nil
+	case storage.ObjectKindGameVideoPoster:
+		return (&GameVideo{}).posterKey(values.NewGameVideoIDFromUUID(uuidID)), nil
+	default:
+		return "", fmt.Errorf("unknown object kind: %d", kind)
+	}
+}
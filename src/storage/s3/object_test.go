@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+func TestObjects(t *testing.T) {
+	ctx := context.Background()
+
+	objects := NewObjects(testClient)
+
+	id := uuid.NewString()
+
+	exists, err := objects.ExistsObject(ctx, storage.ObjectKindGameFile, id)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	err = objects.LoadObject(ctx, storage.ObjectKindGameFile, id, bytes.NewBuffer(nil))
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	for _, content := range [][]byte{[]byte("old"), []byte("new")} {
+		// 2回目は上書きになる
+		err = objects.SaveObject(ctx, storage.ObjectKindGameFile, id, bytes.NewReader(content))
+		assert.NoError(t, err)
+
+		buf := bytes.NewBuffer(nil)
+		err = testClient.loadFile(ctx, fmt.Sprintf("files/%s", id), buf)
+		if err != nil {
+			t.Fatalf("failed to load file: %v", err)
+		}
+		assert.Equal(t, content, buf.Bytes())
+
+		buf = bytes.NewBuffer(nil)
+		err = objects.LoadObject(ctx, storage.ObjectKindGameFile, id, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, content, buf.Bytes())
+	}
+
+	exists, err = objects.ExistsObject(ctx, storage.ObjectKindGameFile, id)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestObjectKey(t *testing.T) {
+	t.Parallel()
+
+	// clientは使わないのでnilでOK
+	objects := NewObjects(nil)
+
+	id := uuid.NewString()
+
+	type test struct {
+		description string
+		kind        storage.ObjectKind
+		id          string
+		expect      string
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "ゲームファイル",
+			kind:        storage.ObjectKindGameFile,
+			id:          id,
+			expect:      fmt.Sprintf("files/%s", id),
+		},
+		{
+			description: "ゲーム画像",
+			kind:        storage.ObjectKindGameImage,
+			id:          id,
+			expect:      fmt.Sprintf("images/%s", id),
+		},
+		{
+			description: "派生画像",
+			kind:        storage.ObjectKindGameImageVariant,
+			id:          id,
+			expect:      fmt.Sprintf("images/variants/%s", id),
+		},
+		{
+			description: "ゲーム動画",
+			kind:        storage.ObjectKindGameVideo,
+			id:          id,
+			expect:      fmt.Sprintf("videos/%s", id),
+		},
+		{
+			description: "ポスター画像",
+			kind:        storage.ObjectKindGameVideoPoster,
+			id:          id,
+			expect:      fmt.Sprintf("videos/posters/%s", id),
+		},
+		{
+			description: "ゲームファイルの実体",
+			kind:        storage.ObjectKindGameFileBlob,
+			id:          "098f6bcd4621d373cade4e832627b4f6",
+			expect:      "files/blobs/098f6bcd4621d373cade4e832627b4f6",
+		},
+		{
+			description: "ハッシュ値が不正なのでエラー",
+			kind:        storage.ObjectKindGameFileBlob,
+			id:          "invalid",
+			isErr:       true,
+		},
+		{
+			description: "UUIDが不正なのでエラー",
+			kind:        storage.ObjectKindGameFile,
+			id:          "invalid",
+			isErr:       true,
+		},
+		{
+			description: "想定外の種類なのでエラー",
+			kind:        100,
+			id:          id,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := objects.objectKey(testCase.kind, testCase.id)
+			if testCase.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expect, key)
+		})
+	}
+}
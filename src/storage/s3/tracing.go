@@ -0,0 +1,5 @@
+package s3
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/traPtitech/trap-collection-server/src/storage/s3")
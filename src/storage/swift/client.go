@@ -11,6 +11,7 @@ import (
 
 	"github.com/ncw/swift/v2"
 	"github.com/traPtitech/trap-collection-server/src/config"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type Client struct {
@@ -77,12 +78,17 @@ func setupSwift(
 	tennantName string,
 	tennantID string,
 ) (*swift.Connection, error) {
+	// ncw/swiftのデフォルトと同じ設定のTransportに、トレースを追加する
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = 2048
+
 	c := &swift.Connection{
-		UserName: userName,
-		ApiKey:   password,
-		AuthUrl:  authURL.String(),
-		Tenant:   tennantName,
-		TenantId: tennantID,
+		UserName:  userName,
+		ApiKey:    password,
+		AuthUrl:   authURL.String(),
+		Tenant:    tennantName,
+		TenantId:  tennantID,
+		Transport: otelhttp.NewTransport(transport),
 	}
 
 	err := c.Authenticate(ctx)
@@ -112,6 +118,18 @@ func (c *Client) saveFile(
 		return fmt.Errorf("failed to get object: %w", err)
 	}
 
+	return c.putFile(ctx, name, contentType, hash, content)
+}
+
+// putFile
+// 既にオブジェクトが存在する場合は上書きする。
+func (c *Client) putFile(
+	ctx context.Context,
+	name string,
+	contentType string,
+	hash string,
+	content io.Reader,
+) error {
 	var checksum string
 	if len(hash) == 0 {
 		checksum = ""
@@ -163,3 +181,50 @@ func (c *Client) createTempURL(ctx context.Context, name string, expires time.Du
 
 	return tmpURL, nil
 }
+
+// ping
+// コンテナの情報を取得できるかで、接続できるかを確認する
+func (c *Client) ping(ctx context.Context) error {
+	_, _, err := c.connection.Container(ctx, c.containerName)
+	if err != nil {
+		return fmt.Errorf("failed to get container: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) existsFile(ctx context.Context, name string) (bool, error) {
+	_, _, err := c.connection.Object(ctx, c.containerName, name)
+	if errors.Is(err, swift.ObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return true, nil
+}
+
+func (c *Client) loadFile(ctx context.Context, name string, w io.Writer) error {
+	_, _, err := c.connection.Object(ctx, c.containerName, name)
+	if errors.Is(err, swift.ObjectNotFound) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get object: %w", err)
+	}
+
+	_, err = c.connection.ObjectGet(
+		ctx,
+		c.containerName,
+		name,
+		w,
+		true,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return nil
+}
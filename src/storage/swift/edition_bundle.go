@@ -0,0 +1,67 @@
+package swift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/traPtitech/trap-collection-server/src/domain"
+	"github.com/traPtitech/trap-collection-server/src/domain/values"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+type EditionBundle struct {
+	client *Client
+}
+
+func NewEditionBundle(client *Client) *EditionBundle {
+	return &EditionBundle{
+		client: client,
+	}
+}
+
+func (eb *EditionBundle) SaveEditionBundle(ctx context.Context, reader io.Reader, bundleID values.EditionBundleID) error {
+	ctx, span := tracer.Start(ctx, "EditionBundle.SaveEditionBundle")
+	defer span.End()
+
+	bundleKey := eb.bundleKey(bundleID)
+
+	err := eb.client.saveFile(
+		ctx,
+		bundleKey,
+		"application/zip",
+		"",
+		reader,
+	)
+	if errors.Is(err, ErrAlreadyExists) {
+		return storage.ErrAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save bundle: %w", err)
+	}
+
+	return nil
+}
+
+func (eb *EditionBundle) GetTempURL(ctx context.Context, bundle *domain.EditionBundle, expires time.Duration) (values.EditionBundleTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "EditionBundle.GetTempURL")
+	defer span.End()
+
+	url, err := eb.client.createTempURL(ctx, eb.bundleKey(bundle.GetID()), expires)
+	if errors.Is(err, ErrNotFound) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get temp url: %w", err)
+	}
+
+	return url, nil
+}
+
+// bundleKey 変更時にはオブジェクトストレージのキーを変更する必要があるので要注意
+func (eb *EditionBundle) bundleKey(bundleID values.EditionBundleID) string {
+	return fmt.Sprintf("bundles/%s", uuid.UUID(bundleID).String())
+}
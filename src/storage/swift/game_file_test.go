@@ -30,7 +30,7 @@ func TestSaveGameFile(t *testing.T) {
 
 	type test struct {
 		description string
-		fileID      values.GameFileID
+		hash        values.GameFileHash
 		reader      *bytes.Buffer
 		isFileExist bool
 		isErr       bool
@@ -40,12 +40,12 @@ func TestSaveGameFile(t *testing.T) {
 	testCases := []test{
 		{
 			description: "特に問題ないのでエラーなし",
-			fileID:      values.NewGameFileID(),
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 		},
 		{
-			description: "ファイルが存在するのでErrAlreadyExists",
-			fileID:      values.NewGameFileID(),
+			description: "同じハッシュ値のファイルが存在するのでErrAlreadyExists",
+			hash:        newTestGameFileHash(),
 			reader:      bytes.NewBufferString("test"),
 			isFileExist: true,
 			isErr:       true,
@@ -60,7 +60,7 @@ func TestSaveGameFile(t *testing.T) {
 			if testCase.isFileExist {
 				err := client.saveFile(
 					ctx,
-					fmt.Sprintf("files/%s", uuid.UUID(testCase.fileID).String()),
+					fmt.Sprintf("files/blobs/%s", testCase.hash.String()),
 					"text/plain",
 					"",
 					strings.NewReader(""),
@@ -72,7 +72,7 @@ func TestSaveGameFile(t *testing.T) {
 
 			expectBytes := testCase.reader.Bytes()
 
-			err := gameFileStorage.SaveGameFile(ctx, testCase.reader, testCase.fileID)
+			err := gameFileStorage.SaveGameFile(ctx, testCase.reader, testCase.hash)
 
 			if testCase.isErr {
 				if testCase.err == nil {
@@ -88,7 +88,7 @@ func TestSaveGameFile(t *testing.T) {
 			}
 
 			buf := bytes.NewBuffer(nil)
-			err = client.loadFile(ctx, fmt.Sprintf("files/%s", uuid.UUID(testCase.fileID).String()), buf)
+			err = client.loadFile(ctx, fmt.Sprintf("files/blobs/%s", testCase.hash.String()), buf)
 			if err != nil {
 				t.Fatalf("failed to load file: %v", err)
 			}
@@ -113,6 +113,7 @@ func TestFileGetTempURL(t *testing.T) {
 		description string
 		file        *domain.GameFile
 		buf         *bytes.Buffer
+		isBlobExist bool
 		isFileExist bool
 		isErr       bool
 		err         error
@@ -129,6 +130,18 @@ func TestFileGetTempURL(t *testing.T) {
 				time.Now(),
 			),
 			buf:         bytes.NewBufferString("test"),
+			isBlobExist: true,
+		},
+		{
+			description: "重複排除の導入前のファイルなのでファイルIDのキーのURL",
+			file: domain.NewGameFile(
+				values.NewGameFileID(),
+				values.GameFileTypeJar,
+				"path/to/game.jar",
+				newTestGameFileHash(),
+				time.Now(),
+			),
+			buf:         bytes.NewBufferString("test"),
 			isFileExist: true,
 		},
 		{
@@ -137,7 +150,7 @@ func TestFileGetTempURL(t *testing.T) {
 				values.NewGameFileID(),
 				values.GameFileTypeJar,
 				"path/to/game.jar",
-				values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6}),
+				newTestGameFileHash(),
 				time.Now(),
 			),
 			buf:   bytes.NewBufferString("test"),
@@ -150,6 +163,19 @@ func TestFileGetTempURL(t *testing.T) {
 		t.Run(testCase.description, func(t *testing.T) {
 			expectBytes := testCase.buf.Bytes()
 
+			if testCase.isBlobExist {
+				err := client.saveFile(
+					ctx,
+					fmt.Sprintf("files/blobs/%s", testCase.file.GetHash().String()),
+					"",
+					"",
+					testCase.buf,
+				)
+				if err != nil {
+					t.Fatalf("failed to create file: %v", err)
+				}
+			}
+
 			if testCase.isFileExist {
 				err := client.saveFile(
 					ctx,
@@ -210,3 +236,21 @@ func TestFileKey(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("files/%s", uuid.UUID(fileID).String()), key)
 	}
 }
+
+func TestBlobKey(t *testing.T) {
+	t.Parallel()
+
+	// clientは使わないのでnilでOK
+	gameFileStorage := NewGameFile(nil)
+
+	hash := values.NewGameFileHashFromBytes([]byte{0x09, 0x8f, 0x6b, 0xcd, 0x46, 0x21, 0xd3, 0x73, 0xca, 0xde, 0x4e, 0x83, 0x26, 0x27, 0xb4, 0xf6})
+
+	key := gameFileStorage.blobKey(hash)
+
+	assert.Equal(t, "files/blobs/098f6bcd4621d373cade4e832627b4f6", key)
+}
+
+func newTestGameFileHash() values.GameFileHash {
+	id := uuid.New()
+	return values.NewGameFileHashFromBytes(id[:])
+}
@@ -259,3 +259,167 @@ func TestImageKey(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("images/%s", uuid.UUID(image.GetID()).String()), key)
 	}
 }
+
+func TestSaveGameImageVariant(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	client, err := newTestClient(ctx, ctrl, "save_game_image_variant")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	type test struct {
+		description string
+		variantID   values.GameImageVariantID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			variantID:   values.NewGameImageVariantID(),
+		},
+		{
+			description: "ファイルが存在するのでErrAlreadyExists",
+			variantID:   values.NewGameImageVariantID(),
+			isFileExist: true,
+			isErr:       true,
+			err:         storage.ErrAlreadyExists,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			gameImageStorage := NewGameImage(client)
+
+			if testCase.isFileExist {
+				err := client.saveFile(
+					ctx,
+					fmt.Sprintf("images/variants/%s", uuid.UUID(testCase.variantID).String()),
+					"text/plain",
+					"",
+					strings.NewReader(""),
+				)
+				if err != nil {
+					t.Fatalf("failed to create file: %v", err)
+				}
+			}
+
+			imgBuf := bytes.NewBufferString("hoge")
+			expectBytes := imgBuf.Bytes()
+
+			err := gameImageStorage.SaveGameImageVariant(ctx, imgBuf, testCase.variantID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err = client.loadFile(ctx, fmt.Sprintf("images/variants/%s", uuid.UUID(testCase.variantID).String()), buf)
+			if err != nil {
+				t.Fatalf("failed to load file: %v", err)
+			}
+
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+}
+
+func TestImageVariantKey(t *testing.T) {
+	t.Parallel()
+
+	// clientは使わないのでnilでOK
+	gameImageStorage := NewGameImage(nil)
+
+	loopNum := 100
+
+	for i := 0; i < loopNum; i++ {
+		variantID := values.NewGameImageVariantID()
+
+		key := gameImageStorage.variantKey(variantID)
+
+		assert.Equal(t, fmt.Sprintf("images/variants/%s", uuid.UUID(variantID).String()), key)
+	}
+}
+
+func TestLoadGameImage(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	client, err := newTestClient(ctx, ctrl, "load_game_image")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	type test struct {
+		description string
+		imageID     values.GameImageID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			imageID:     values.NewGameImageID(),
+			isFileExist: true,
+		},
+		{
+			description: "ファイルが存在しないのでErrNotFound",
+			imageID:     values.NewGameImageID(),
+			isErr:       true,
+			err:         storage.ErrNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			gameImageStorage := NewGameImage(client)
+
+			expectBytes := []byte("hoge")
+			if testCase.isFileExist {
+				err := client.saveFile(
+					ctx,
+					fmt.Sprintf("images/%s", uuid.UUID(testCase.imageID).String()),
+					"",
+					"",
+					bytes.NewReader(expectBytes),
+				)
+				if err != nil {
+					t.Fatalf("failed to create file: %v", err)
+				}
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err := gameImageStorage.LoadGameImage(ctx, buf, testCase.imageID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+}
@@ -24,6 +24,9 @@ func NewGameVideo(client *Client) *GameVideo {
 }
 
 func (gv *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideo")
+	defer span.End()
+
 	videoKey := gv.videoKey(videoID)
 
 	err := gv.client.saveFile(
@@ -43,7 +46,27 @@ func (gv *GameVideo) SaveGameVideo(ctx context.Context, reader io.Reader, videoI
 	return nil
 }
 
+func (gv *GameVideo) LoadGameVideo(ctx context.Context, writer io.Writer, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.LoadGameVideo")
+	defer span.End()
+
+	videoKey := gv.videoKey(videoID)
+
+	err := gv.client.loadFile(ctx, videoKey, writer)
+	if errors.Is(err, ErrNotFound) {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load video: %w", err)
+	}
+
+	return nil
+}
+
 func (gv *GameVideo) GetTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetTempURL")
+	defer span.End()
+
 	fileKey := gv.videoKey(video.GetID())
 
 	url, err := gv.client.createTempURL(ctx, fileKey, expires)
@@ -57,6 +80,57 @@ func (gv *GameVideo) GetTempURL(ctx context.Context, video *domain.GameVideo, ex
 	return url, nil
 }
 
+func (gv *GameVideo) GetTempURLs(ctx context.Context, videos []*domain.GameVideo, expires time.Duration) ([]values.GameVideoTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetTempURLs")
+	defer span.End()
+
+	return storage.GetTempURLs(ctx, videos, func(ctx context.Context, video *domain.GameVideo) (values.GameVideoTmpURL, error) {
+		return gv.GetTempURL(ctx, video, expires)
+	})
+}
+
+func (gv *GameVideo) SaveGameVideoPoster(ctx context.Context, reader io.Reader, videoID values.GameVideoID) error {
+	ctx, span := tracer.Start(ctx, "GameVideo.SaveGameVideoPoster")
+	defer span.End()
+
+	posterKey := gv.posterKey(videoID)
+
+	err := gv.client.putFile(
+		ctx,
+		posterKey,
+		"",
+		"",
+		reader,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save video poster: %w", err)
+	}
+
+	return nil
+}
+
+func (gv *GameVideo) GetPosterTempURL(ctx context.Context, video *domain.GameVideo, expires time.Duration) (values.GameVideoPosterTmpURL, error) {
+	ctx, span := tracer.Start(ctx, "GameVideo.GetPosterTempURL")
+	defer span.End()
+
+	posterKey := gv.posterKey(video.GetID())
+
+	url, err := gv.client.createTempURL(ctx, posterKey, expires)
+	if errors.Is(err, ErrNotFound) {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get temp url: %w", err)
+	}
+
+	return url, nil
+}
+
 func (gv *GameVideo) videoKey(videoID values.GameVideoID) string {
 	return fmt.Sprintf("videos/%s", uuid.UUID(videoID).String())
 }
+
+// posterKey 変更時にはオブジェクトストレージのキーを変更する必要があるので要注意
+func (gv *GameVideo) posterKey(videoID values.GameVideoID) string {
+	return fmt.Sprintf("videos/posters/%s", uuid.UUID(videoID).String())
+}
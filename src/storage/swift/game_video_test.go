@@ -230,6 +230,80 @@ func TestVideoGetTempURL(t *testing.T) {
 	}
 }
 
+func TestSaveGameVideoPoster(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	client, err := newTestClient(ctx, ctrl, "save_game_video_poster")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	gameVideoStorage := NewGameVideo(client)
+
+	type test struct {
+		description string
+		videoID     values.GameVideoID
+		isFileExist bool
+		isErr       bool
+		err         error
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラーなし",
+			videoID:     values.NewGameVideoID(),
+		},
+		{
+			description: "ファイルが存在しても上書きできる",
+			videoID:     values.NewGameVideoID(),
+			isFileExist: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.isFileExist {
+				err := client.saveFile(
+					ctx,
+					fmt.Sprintf("videos/posters/%s", uuid.UUID(testCase.videoID).String()),
+					"text/plain",
+					"",
+					strings.NewReader("old poster"),
+				)
+				if err != nil {
+					t.Fatalf("failed to create file: %v", err)
+				}
+			}
+
+			expectBytes := []byte("new poster")
+
+			err := gameVideoStorage.SaveGameVideoPoster(ctx, bytes.NewReader(expectBytes), testCase.videoID)
+
+			if testCase.isErr {
+				if testCase.err == nil {
+					assert.Error(t, err)
+				} else if !errors.Is(err, testCase.err) {
+					t.Errorf("error must be %v, but actual is %v", testCase.err, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			if err != nil {
+				return
+			}
+
+			buf := bytes.NewBuffer(nil)
+			err = client.loadFile(ctx, fmt.Sprintf("videos/posters/%s", uuid.UUID(testCase.videoID).String()), buf)
+			if err != nil {
+				t.Fatalf("failed to load file: %v", err)
+			}
+
+			assert.Equal(t, expectBytes, buf.Bytes())
+		})
+	}
+}
+
 func TestVideoKey(t *testing.T) {
 	t.Parallel()
 
@@ -246,3 +320,20 @@ func TestVideoKey(t *testing.T) {
 		assert.Equal(t, fmt.Sprintf("videos/%s", uuid.UUID(videoID).String()), key)
 	}
 }
+
+func TestPosterKey(t *testing.T) {
+	t.Parallel()
+
+	// clientは使わないのでnilでOK
+	gameVideoStorage := NewGameVideo(nil)
+
+	loopNum := 100
+
+	for i := 0; i < loopNum; i++ {
+		videoID := values.NewGameVideoID()
+
+		key := gameVideoStorage.posterKey(videoID)
+
+		assert.Equal(t, fmt.Sprintf("videos/posters/%s", uuid.UUID(videoID).String()), key)
+	}
+}
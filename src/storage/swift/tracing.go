@@ -0,0 +1,5 @@
+package swift
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/traPtitech/trap-collection-server/src/storage/swift")
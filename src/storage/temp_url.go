@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxTempURLConcurrency
+// GetTempURLsで並行して一時URLを生成する数の上限。
+// オブジェクトの存在確認でストレージへのリクエストが発生するので、並行数を抑える。
+const maxTempURLConcurrency = 8
+
+// GetTempURLs
+// 各ストレージのGetTempURLsの実装に使う。
+// getTempURLを並行して呼び出し、itemsと同じ順番で一時URLを返す。
+// 1つでも失敗した場合は、最初に発生したエラーを返す。
+func GetTempURLs[T, U any](ctx context.Context, items []T, getTempURL func(context.Context, T) (U, error)) ([]U, error) {
+	urls := make([]U, len(items))
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxTempURLConcurrency)
+	for i, item := range items {
+		eg.Go(func() error {
+			url, err := getTempURL(ctx, item)
+			if err != nil {
+				return err
+			}
+
+			urls[i] = url
+
+			return nil
+		})
+	}
+
+	err := eg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTempURLs(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description string
+		items       []int
+		errItem     int
+		expect      []string
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "特に問題ないのでエラー無し",
+			items:       []int{1, 2, 3},
+			errItem:     -1,
+			expect:      []string{"url1", "url2", "url3"},
+		},
+		{
+			description: "並行数の上限より多くても順番通りに返す",
+			items:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			errItem:     -1,
+			expect:      []string{"url1", "url2", "url3", "url4", "url5", "url6", "url7", "url8", "url9", "url10", "url11", "url12"},
+		},
+		{
+			description: "空でもエラー無し",
+			items:       []int{},
+			errItem:     -1,
+			expect:      []string{},
+		},
+		{
+			description: "1つでもエラーなのでエラー",
+			items:       []int{1, 2, 3},
+			errItem:     2,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			t.Parallel()
+
+			urls, err := GetTempURLs(context.Background(), testCase.items, func(_ context.Context, item int) (string, error) {
+				if item == testCase.errItem {
+					return "", ErrNotFound
+				}
+
+				return fmt.Sprintf("url%d", item), nil
+			})
+
+			if testCase.isErr {
+				assert.ErrorIs(t, err, ErrNotFound)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.expect, urls)
+		})
+	}
+}
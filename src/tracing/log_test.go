@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLogHandler(t *testing.T) {
+	t.Parallel()
+
+	provider := sdktrace.NewTracerProvider()
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	spanCtx, span := provider.Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	testCases := map[string]struct {
+		ctx          context.Context
+		withTraceIDs bool
+	}{
+		"スパンがあるのでtrace_idとspan_idが付与される": {
+			ctx:          spanCtx,
+			withTraceIDs: true,
+		},
+		"スパンが無いので付与されない": {
+			ctx: context.Background(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			logger := slog.New(NewLogHandler(slog.NewTextHandler(buf, nil))).
+				With(slog.String("key", "value"))
+
+			logger.InfoContext(testCase.ctx, "message")
+
+			assert.Contains(t, buf.String(), "key=value")
+			if testCase.withTraceIDs {
+				assert.Contains(t, buf.String(), "trace_id="+span.SpanContext().TraceID().String())
+				assert.Contains(t, buf.String(), "span_id="+span.SpanContext().SpanID().String())
+			} else {
+				assert.NotContains(t, buf.String(), "trace_id")
+				assert.NotContains(t, buf.String(), "span_id")
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// TracerProvider
+// アプリケーション全体で使うTracerProvider。
+// 作成時にOpenTelemetryのグローバルなTracerProviderとして設定される。
+type TracerProvider struct {
+	trace.TracerProvider
+	shutdown func(ctx context.Context) error
+}
+
+func NewTracerProvider(conf config.Tracing) (*TracerProvider, error) {
+	exporterType, err := conf.Exporter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tracing exporter: %w", err)
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch exporterType {
+	case config.TracingExporterNone:
+		return setTracerProvider(&TracerProvider{
+			TracerProvider: noop.NewTracerProvider(),
+			shutdown:       func(context.Context) error { return nil },
+		}), nil
+	case config.TracingExporterOTLP:
+		// 送信先などはOTEL_EXPORTER_OTLP_*の環境変数から読み込まれる
+		exporter, err = otlptracehttp.New(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+		}
+	case config.TracingExporterStdout:
+		exporter, err = stdouttrace.New(
+			stdouttrace.WithWriter(os.Stdout),
+			stdouttrace.WithPrettyPrint(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: %d", exporterType)
+	}
+
+	serviceName, err := conf.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service name: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	// サンプリングはOTEL_TRACES_SAMPLERなどの環境変数で設定できる
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	return setTracerProvider(&TracerProvider{
+		TracerProvider: provider,
+		shutdown:       provider.Shutdown,
+	}), nil
+}
+
+func setTracerProvider(tracerProvider *TracerProvider) *TracerProvider {
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return tracerProvider
+}
+
+// Shutdown
+// 送信待ちのスパンを送信してから終了する
+func (tracerProvider *TracerProvider) Shutdown(ctx context.Context) error {
+	return tracerProvider.shutdown(ctx)
+}
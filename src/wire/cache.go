@@ -3,15 +3,110 @@
 package wire
 
 import (
+	"fmt"
+
 	"github.com/google/wire"
 	"github.com/traPtitech/trap-collection-server/src/cache"
+	"github.com/traPtitech/trap-collection-server/src/cache/redis"
 	"github.com/traPtitech/trap-collection-server/src/cache/ristretto"
+	"github.com/traPtitech/trap-collection-server/src/config"
 )
 
 var cacheSet = wire.NewSet(
-	wire.Bind(new(cache.User), new(*ristretto.User)),
-	ristretto.NewUser,
+	wire.FieldsOf(new(*Cache), "User"),
+	wire.FieldsOf(new(*Cache), "Seat"),
+	wire.FieldsOf(new(*Cache), "Health"),
 
-	wire.Bind(new(cache.Seat), new(*ristretto.Seat)),
-	ristretto.NewSeat,
+	cacheSwitch,
 )
+
+type Cache struct {
+	User   cache.User
+	Seat   cache.Seat
+	Health cache.Health
+	// close
+	// キャッシュのバックエンドとの接続を閉じる。
+	// プロセス内のキャッシュなど、閉じるものが無い場合はnil。
+	close func() error
+}
+
+func newCache(user cache.User, seat cache.Seat, health cache.Health) *Cache {
+	return &Cache{
+		User:   user,
+		Seat:   seat,
+		Health: health,
+	}
+}
+
+func newRedisCache(client *redis.Client, user cache.User, seat cache.Seat, health cache.Health) *Cache {
+	c := newCache(user, seat, health)
+	c.close = client.Close
+
+	return c
+}
+
+// Close
+// キャッシュのバックエンドとの接続を閉じる。
+func (c *Cache) Close() error {
+	if c.close == nil {
+		return nil
+	}
+
+	return c.close()
+}
+
+func cacheSwitch(
+	conf config.Cache,
+	ristrettoConf config.CacheRistretto,
+	redisConf config.CacheRedis,
+) (*Cache, error) {
+	cacheType, err := conf.Type()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache type: %w", err)
+	}
+
+	switch cacheType {
+	case config.CacheTypeRistretto:
+		return injectRistrettoCache(ristrettoConf)
+	case config.CacheTypeRedis:
+		return injectRedisCache(redisConf)
+	}
+
+	return nil, fmt.Errorf("unknown cache type: %d", cacheType)
+}
+
+func injectRistrettoCache(conf config.CacheRistretto) (*Cache, error) {
+	wire.Build(
+		wire.Bind(new(cache.User), new(*ristretto.User)),
+		ristretto.NewUser,
+
+		wire.Bind(new(cache.Seat), new(*ristretto.Seat)),
+		ristretto.NewSeat,
+
+		wire.Bind(new(cache.Health), new(*ristretto.Health)),
+		ristretto.NewHealth,
+
+		newCache,
+	)
+
+	return nil, nil
+}
+
+func injectRedisCache(conf config.CacheRedis) (*Cache, error) {
+	wire.Build(
+		redis.NewClient,
+
+		wire.Bind(new(cache.User), new(*redis.User)),
+		redis.NewUser,
+
+		wire.Bind(new(cache.Seat), new(*redis.Seat)),
+		redis.NewSeat,
+
+		wire.Bind(new(cache.Health), new(*redis.Health)),
+		redis.NewHealth,
+
+		newRedisCache,
+	)
+
+	return nil, nil
+}
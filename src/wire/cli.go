@@ -0,0 +1,112 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/wire"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/repository/gorm2"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/storage"
+)
+
+// CLIApp
+// 運用のためのサブコマンドから使うservice。
+// HTTPサーバーと定期実行ジョブは起動しない。
+type CLIApp struct {
+	AdminAuth           service.AdminAuthV2
+	EditionAuth         service.EditionAuth
+	GamePlayLog         service.GamePlayLogV2
+	Seat                service.Seat
+	StorageHealth       storage.Health
+	StorageVerification service.StorageVerification
+	StorageSizeBackfill service.StorageSizeBackfill
+	repository.DB
+	cache *Cache
+}
+
+func newCLIApp(
+	adminAuth service.AdminAuthV2,
+	editionAuth service.EditionAuth,
+	gamePlayLog service.GamePlayLogV2,
+	seat service.Seat,
+	storageHealth storage.Health,
+	storageVerification service.StorageVerification,
+	storageSizeBackfill service.StorageSizeBackfill,
+	db repository.DB,
+	cache *Cache,
+) *CLIApp {
+	return &CLIApp{
+		AdminAuth:           adminAuth,
+		EditionAuth:         editionAuth,
+		GamePlayLog:         gamePlayLog,
+		Seat:                seat,
+		StorageHealth:       storageHealth,
+		StorageVerification: storageVerification,
+		StorageSizeBackfill: storageSizeBackfill,
+		DB:                  db,
+		cache:               cache,
+	}
+}
+
+// Close
+// キャッシュとDBとの接続を閉じる。
+func (app *CLIApp) Close() error {
+	var errs []error
+	if err := app.cache.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close cache: %w", err))
+	}
+	if err := app.DB.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close db: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func InjectCLI() (*CLIApp, error) {
+	wire.Build(
+		configSet,
+		serviceSet,
+		authSet,
+		cacheSet,
+		repositorySet,
+		storageSet,
+
+		newCLIApp,
+	)
+
+	return nil, nil
+}
+
+// MigrationApp
+// マイグレーションの状態の確認と適用に使う。
+// 接続時にマイグレーションを適用しないよう、repositorySetは使わない。
+type MigrationApp struct {
+	repository.Migration
+	repository.DB
+}
+
+func newMigrationApp(migration repository.Migration, db repository.DB) *MigrationApp {
+	return &MigrationApp{
+		Migration: migration,
+		DB:        db,
+	}
+}
+
+func InjectMigration() (*MigrationApp, error) {
+	wire.Build(
+		configSet,
+
+		wire.Bind(new(repository.DB), new(*gorm2.DB)),
+		gorm2.NewDBWithoutMigration,
+		wire.Bind(new(repository.Migration), new(*gorm2.Migration)),
+		gorm2.NewMigration,
+
+		newMigrationApp,
+	)
+
+	return nil, nil
+}
@@ -15,12 +15,21 @@ var configSet = wire.NewSet(
 	wire.Bind(new(config.AuthTraQ), new(*v1.AuthTraQ)),
 	v1.NewAuthTraQ,
 
+	wire.Bind(new(config.Cache), new(*v1.Cache)),
+	v1.NewCache,
+
 	wire.Bind(new(config.CacheRistretto), new(*v1.CacheRistretto)),
 	v1.NewCacheRistretto,
 
+	wire.Bind(new(config.CacheRedis), new(*v1.CacheRedis)),
+	v1.NewCacheRedis,
+
 	wire.Bind(new(config.Handler), new(*v1.Handler)),
 	v1.NewHandler,
 
+	wire.Bind(new(config.HandlerRateLimit), new(*v1.HandlerRateLimit)),
+	v1.NewHandlerRateLimit,
+
 	wire.Bind(new(config.RepositoryGorm2), new(*v1.RepositoryGorm2)),
 	v1.NewRepositoryGorm2,
 
@@ -42,6 +51,15 @@ var configSet = wire.NewSet(
 	wire.Bind(new(config.StorageS3), new(*v1.StorageS3)),
 	v1.NewStorageS3,
 
+	wire.Bind(new(config.Scanner), new(*v1.Scanner)),
+	v1.NewScanner,
+
+	wire.Bind(new(config.ScannerClamAV), new(*v1.ScannerClamAV)),
+	v1.NewScannerClamAV,
+
+	wire.Bind(new(config.Tracing), new(*v1.Tracing)),
+	v1.NewTracing,
+
 	wire.Bind(new(config.Migration), new(*v1.Migration)),
 	v1.NewMigration,
 )
@@ -16,6 +16,7 @@ var (
 	handlerSet = wire.NewSet(
 		session.NewSession,
 		handler.NewAPI,
+		handler.NewHealth,
 		cron.NewCron,
 		// handlerV1Set,
 		handlerV2Set,
@@ -51,11 +52,19 @@ var (
 		v2.NewGameFile,
 		v2.NewGameImage,
 		v2.NewGameVideo,
+		v2.NewGameStorage,
 		v2.NewGamePlayLog,
 		v2.NewGameCreator,
 		v2.NewGameFeedback,
 		v2.NewEdition,
 		v2.NewEditionAuth,
+		v2.NewEditionBundle,
+		v2.NewRateLimit,
+		wire.Bind(new(v2.RateLimitStore), new(*v2.MemoryRateLimitStore)),
+		v2.NewMemoryRateLimitStore,
 		v2.NewSeat,
+		v2.NewAuditLog,
+		v2.NewStatus,
+		v2.NewStorageVerification,
 	)
 )
@@ -57,8 +57,13 @@ var repositorySet = wire.NewSet(
 	wire.Bind(new(repository.GameFileV2), new(*gorm2.GameFileV2)),
 	gorm2.NewGameFileV2,
 
+	wire.Bind(new(repository.GameStorageV2), new(*gorm2.GameStorageV2)),
+	gorm2.NewGameStorageV2,
+
 	wire.Bind(new(repository.Edition), new(*gorm2.Edition)),
 	gorm2.NewEdition,
+	wire.Bind(new(repository.EditionBundle), new(*gorm2.EditionBundle)),
+	gorm2.NewEditionBundle,
 
 	wire.Bind(new(repository.ProductKey), new(*gorm2.ProductKey)),
 	gorm2.NewProductKey,
@@ -83,4 +88,16 @@ var repositorySet = wire.NewSet(
 
 	wire.Bind(new(repository.GameFeedback), new(*gorm2.GameFeedback)),
 	gorm2.NewGameFeedback,
+
+	wire.Bind(new(repository.AuditLog), new(*gorm2.AuditLog)),
+	gorm2.NewAuditLog,
+
+	wire.Bind(new(repository.GameRoleInvitation), new(*gorm2.GameRoleInvitation)),
+	gorm2.NewGameRoleInvitation,
+
+	wire.Bind(new(repository.Migration), new(*gorm2.Migration)),
+	gorm2.NewMigration,
+
+	wire.Bind(new(repository.StorageVerification), new(*gorm2.StorageVerification)),
+	gorm2.NewStorageVerification,
 )
@@ -0,0 +1,46 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"fmt"
+
+	"github.com/google/wire"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/scanner"
+	"github.com/traPtitech/trap-collection-server/src/scanner/clamav"
+	"github.com/traPtitech/trap-collection-server/src/scanner/noop"
+	"github.com/traPtitech/trap-collection-server/src/scanner/rules"
+)
+
+var scannerSet = wire.NewSet(
+	scannerSwitch,
+)
+
+func scannerSwitch(conf config.Scanner, clamAVConf config.ScannerClamAV) (scanner.GameFile, error) {
+	scannerType, err := conf.Type()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scanner type: %w", err)
+	}
+
+	switch scannerType {
+	case config.ScannerTypeNone:
+		return noop.NewGameFile(), nil
+	case config.ScannerTypeRules:
+		return rules.NewGameFile(), nil
+	case config.ScannerTypeClamAV:
+		return injectClamAVScanner(clamAVConf)
+	}
+
+	return nil, fmt.Errorf("unknown scanner type: %d", scannerType)
+}
+
+func injectClamAVScanner(conf config.ScannerClamAV) (scanner.GameFile, error) {
+	wire.Build(
+		wire.Bind(new(scanner.GameFile), new(*clamav.GameFile)),
+
+		clamav.NewGameFile,
+	)
+
+	return nil, nil
+}
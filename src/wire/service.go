@@ -72,12 +72,18 @@ var (
 		wire.Bind(new(service.GameFileV2), new(*v2.GameFile)),
 		v2.NewGameFile,
 
+		wire.Bind(new(service.GameStorageV2), new(*v2.GameStorage)),
+		v2.NewGameStorage,
+
 		wire.Bind(new(service.Edition), new(*v2.Edition)),
 		v2.NewEdition,
 
 		wire.Bind(new(service.EditionAuth), new(*v2.EditionAuth)),
 		v2.NewEditionAuth,
 
+		wire.Bind(new(service.EditionBundle), new(*v2.EditionBundle)),
+		v2.NewEditionBundle,
+
 		wire.Bind(new(service.GameRoleV2), new(*v2.GameRole)),
 		v2.NewGameRole,
 
@@ -99,6 +105,18 @@ var (
 		wire.Bind(new(service.GameFeedback), new(*v2.GameFeedback)),
 		v2.NewGameFeedback,
 
+		wire.Bind(new(service.AuditLog), new(*v2.AuditLog)),
+		v2.NewAuditLog,
+
+		wire.Bind(new(service.Status), new(*v2.Status)),
+		v2.NewStatus,
+
+		wire.Bind(new(service.StorageVerification), new(*v2.StorageVerification)),
+		v2.NewStorageVerification,
+
+		wire.Bind(new(service.StorageSizeBackfill), new(*v2.StorageSizeBackfill)),
+		v2.NewStorageSizeBackfill,
+
 		// wire.Bind(new(service.User), new(*v1.User)),
 		// v1.NewUser,
 
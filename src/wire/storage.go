@@ -8,6 +8,7 @@ import (
 	"github.com/google/wire"
 	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/fallback"
 	"github.com/traPtitech/trap-collection-server/src/storage/local"
 	"github.com/traPtitech/trap-collection-server/src/storage/s3"
 	"github.com/traPtitech/trap-collection-server/src/storage/swift"
@@ -18,6 +19,10 @@ var (
 		wire.FieldsOf(new(*Storage), "GameImage"),
 		wire.FieldsOf(new(*Storage), "GameVideo"),
 		wire.FieldsOf(new(*Storage), "GameFile"),
+		wire.FieldsOf(new(*Storage), "FileServer"),
+		wire.FieldsOf(new(*Storage), "EditionBundle"),
+		wire.FieldsOf(new(*Storage), "Objects"),
+		wire.FieldsOf(new(*Storage), "Health"),
 
 		storageSwitch,
 	)
@@ -27,31 +32,136 @@ type Storage struct {
 	GameImage storage.GameImage
 	GameVideo storage.GameVideo
 	GameFile  storage.GameFile
+	// EditionBundle オフライン配布用のエディションのバンドル
+	EditionBundle storage.EditionBundle
+	// FileServer ストレージ自身がファイルを配信しない場合はnil
+	FileServer storage.FileServer
+	// Objects ストレージ間の移行・ストレージの検証用
+	Objects storage.Objects
+	// Health readiness確認用
+	Health storage.Health
 }
 
 func newStorage(
 	gameImage storage.GameImage,
 	gameVideo storage.GameVideo,
 	gameFile storage.GameFile,
+	editionBundle storage.EditionBundle,
+	objects storage.Objects,
+	health storage.Health,
 ) (*Storage, error) {
 	return &Storage{
-		GameImage: gameImage,
-		GameVideo: gameVideo,
-		GameFile:  gameFile,
+		GameImage:     gameImage,
+		GameVideo:     gameVideo,
+		GameFile:      gameFile,
+		EditionBundle: editionBundle,
+		Objects:       objects,
+		Health:        health,
 	}, nil
 }
 
+func newLocalStorage(
+	gameImage storage.GameImage,
+	gameVideo storage.GameVideo,
+	gameFile storage.GameFile,
+	editionBundle storage.EditionBundle,
+	fileServer storage.FileServer,
+	objects storage.Objects,
+	health storage.Health,
+) (*Storage, error) {
+	return &Storage{
+		GameImage:     gameImage,
+		GameVideo:     gameVideo,
+		GameFile:      gameFile,
+		EditionBundle: editionBundle,
+		FileServer:    fileServer,
+		Objects:       objects,
+		Health:        health,
+	}, nil
+}
+
+// newFallbackStorage
+// primaryに存在しないファイルをsecondaryから読み込むストレージを作る。
+// 書き込みはprimaryにのみ行う。
+func newFallbackStorage(primary *Storage, secondary *Storage) *Storage {
+	fileServer := primary.FileServer
+	if fileServer == nil {
+		fileServer = secondary.FileServer
+	}
+
+	return &Storage{
+		GameImage:     fallback.NewGameImage(primary.GameImage, secondary.GameImage),
+		GameVideo:     fallback.NewGameVideo(primary.GameVideo, secondary.GameVideo),
+		GameFile:      fallback.NewGameFile(primary.GameFile, secondary.GameFile),
+		EditionBundle: fallback.NewEditionBundle(primary.EditionBundle, secondary.EditionBundle),
+		FileServer:    fileServer,
+		Objects:       fallback.NewObjects(primary.Objects, secondary.Objects),
+		Health:        fallback.NewHealth(primary.Health, secondary.Health),
+	}
+}
+
 func storageSwitch(
 	conf config.Storage,
 	swiftConf config.StorageSwift,
 	localConf config.StorageLocal,
 	s3Conf config.StorageS3,
 ) (*Storage, error) {
+	primary, secondary, err := storagePair(conf, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if secondary == nil {
+		return primary, nil
+	}
+
+	return newFallbackStorage(primary, secondary), nil
+}
+
+// storagePair
+// STORAGEのストレージと、STORAGE_FALLBACKのストレージを作る。
+// STORAGE_FALLBACKが設定されていない場合、secondaryはnil。
+func storagePair(
+	conf config.Storage,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+) (primary *Storage, secondary *Storage, err error) {
 	storageType, err := conf.Type()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get storage type: %w", err)
+		return nil, nil, fmt.Errorf("failed to get storage type: %w", err)
+	}
+
+	primary, err = injectStorageByType(storageType, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fallbackType, ok, err := conf.FallbackType()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fallback storage type: %w", err)
+	}
+	if !ok {
+		return primary, nil, nil
+	}
+	if fallbackType == storageType {
+		return nil, nil, fmt.Errorf("fallback storage type must differ from storage type: %d", storageType)
 	}
 
+	secondary, err = injectStorageByType(fallbackType, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to inject fallback storage: %w", err)
+	}
+
+	return primary, secondary, nil
+}
+
+func injectStorageByType(
+	storageType config.StorageType,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+) (*Storage, error) {
 	switch storageType {
 	case config.StorageTypeSwift:
 		return injectSwiftStorage(swiftConf)
@@ -69,11 +179,17 @@ func injectSwiftStorage(conf config.StorageSwift) (*Storage, error) {
 		wire.Bind(new(storage.GameImage), new(*swift.GameImage)),
 		wire.Bind(new(storage.GameVideo), new(*swift.GameVideo)),
 		wire.Bind(new(storage.GameFile), new(*swift.GameFile)),
+		wire.Bind(new(storage.EditionBundle), new(*swift.EditionBundle)),
+		wire.Bind(new(storage.Objects), new(*swift.Objects)),
+		wire.Bind(new(storage.Health), new(*swift.Health)),
 
 		swift.NewClient,
 		swift.NewGameImage,
 		swift.NewGameVideo,
 		swift.NewGameFile,
+		swift.NewEditionBundle,
+		swift.NewObjects,
+		swift.NewHealth,
 
 		newStorage,
 	)
@@ -86,13 +202,22 @@ func injectLocalStorage(conf config.StorageLocal) (*Storage, error) {
 		wire.Bind(new(storage.GameImage), new(*local.GameImage)),
 		wire.Bind(new(storage.GameVideo), new(*local.GameVideo)),
 		wire.Bind(new(storage.GameFile), new(*local.GameFile)),
+		wire.Bind(new(storage.EditionBundle), new(*local.EditionBundle)),
+		wire.Bind(new(storage.FileServer), new(*local.FileServer)),
+		wire.Bind(new(storage.Objects), new(*local.Objects)),
+		wire.Bind(new(storage.Health), new(*local.Health)),
 
 		local.NewDirectoryManager,
+		local.NewURLSigner,
 		local.NewGameImage,
 		local.NewGameVideo,
 		local.NewGameFile,
+		local.NewEditionBundle,
+		local.NewFileServer,
+		local.NewObjects,
+		local.NewHealth,
 
-		newStorage,
+		newLocalStorage,
 	)
 
 	return nil, nil
@@ -103,11 +228,17 @@ func injectS3Storage(conf config.StorageS3) (*Storage, error) {
 		wire.Bind(new(storage.GameImage), new(*s3.GameImage)),
 		wire.Bind(new(storage.GameVideo), new(*s3.GameVideo)),
 		wire.Bind(new(storage.GameFile), new(*s3.GameFile)),
+		wire.Bind(new(storage.EditionBundle), new(*s3.EditionBundle)),
+		wire.Bind(new(storage.Objects), new(*s3.Objects)),
+		wire.Bind(new(storage.Health), new(*s3.Health)),
 
 		s3.NewClient,
 		s3.NewGameImage,
 		s3.NewGameVideo,
 		s3.NewGameFile,
+		s3.NewEditionBundle,
+		s3.NewObjects,
+		s3.NewHealth,
 
 		newStorage,
 	)
@@ -0,0 +1,70 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"errors"
+
+	"github.com/google/wire"
+	"github.com/traPtitech/trap-collection-server/src/config"
+	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	v2 "github.com/traPtitech/trap-collection-server/src/service/v2"
+)
+
+type StorageMigrationApp struct {
+	service.StorageMigration
+	repository.DB
+}
+
+func newStorageMigrationApp(storageMigration service.StorageMigration, db repository.DB) *StorageMigrationApp {
+	return &StorageMigrationApp{
+		StorageMigration: storageMigration,
+		DB:               db,
+	}
+}
+
+// newStorageMigration
+// STORAGE_FALLBACKのストレージを移行元、STORAGEのストレージを移行先とする。
+// 移行元と移行先が同じ型のため、wireに任せず手で組み立てる。
+func newStorageMigration(
+	conf config.Storage,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+	gameRepository repository.GameV2,
+	gameFileRepository repository.GameFileV2,
+	gameImageRepository repository.GameImageV2,
+	gameVideoRepository repository.GameVideoV2,
+) (*v2.StorageMigration, error) {
+	primary, secondary, err := storagePair(conf, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, err
+	}
+	if secondary == nil {
+		return nil, errors.New("STORAGE_FALLBACK is not set")
+	}
+
+	return v2.NewStorageMigration(
+		gameRepository,
+		gameFileRepository,
+		gameImageRepository,
+		gameVideoRepository,
+		secondary.Objects,
+		primary.Objects,
+	), nil
+}
+
+func InjectStorageMigration() (*StorageMigrationApp, error) {
+	wire.Build(
+		configSet,
+		repositorySet,
+
+		wire.Bind(new(service.StorageMigration), new(*v2.StorageMigration)),
+		newStorageMigration,
+
+		newStorageMigrationApp,
+	)
+
+	return nil, nil
+}
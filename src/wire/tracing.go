@@ -0,0 +1,12 @@
+//go:build wireinject
+
+package wire
+
+import (
+	"github.com/google/wire"
+	"github.com/traPtitech/trap-collection-server/src/tracing"
+)
+
+var tracingSet = wire.NewSet(
+	tracing.NewTracerProvider,
+)
@@ -3,35 +3,103 @@
 package wire
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
 	"github.com/google/wire"
+	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/handler"
 	"github.com/traPtitech/trap-collection-server/src/handler/cron"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
+	"github.com/traPtitech/trap-collection-server/src/tracing"
 )
 
 type App struct {
 	*handler.API
 	*cron.Cron
 	repository.DB
+	*tracing.TracerProvider
+	cache           *Cache
+	shutdownTimeout time.Duration
 }
 
-func newApp(api *handler.API, cronHandler *cron.Cron, db repository.DB) *App {
+func newApp(appConf config.App, api *handler.API, cronHandler *cron.Cron, db repository.DB, tracerProvider *tracing.TracerProvider, cache *Cache) (*App, error) {
+	shutdownTimeout, err := appConf.ShutdownTimeout()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shutdown timeout: %w", err)
+	}
+
 	return &App{
-		API:  api,
-		Cron: cronHandler,
-		DB:   db,
+		API:             api,
+		Cron:            cronHandler,
+		DB:              db,
+		TracerProvider:  tracerProvider,
+		cache:           cache,
+		shutdownTimeout: shutdownTimeout,
+	}, nil
+}
+
+// Run
+// ctxがキャンセルされるまでHTTPサーバーと定期実行ジョブを動かし、その後終了処理を行う。
+// HTTPサーバーが異常終了した場合も終了処理を行う。
+func (app *App) Run(ctx context.Context) error {
+	if err := app.Cron.Start(); err != nil {
+		return errors.Join(err, app.shutdown())
 	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- app.API.Start()
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		logger.Info(ctx, "shutting down", slog.Duration("timeout", app.shutdownTimeout))
+	case err = <-serverErr:
+		if err != nil {
+			err = fmt.Errorf("http server stopped: %w", err)
+		}
+	}
+
+	return errors.Join(err, app.shutdown())
 }
 
-func (app *App) Run() error {
-	defer app.DB.Close()
-	defer app.Cron.Stop()
+// shutdown
+// 新しいリクエストとジョブの受付を止め、処理中のものの完了を待ってから、
+// 未送信のトレースを送信してキャッシュとDBとの接続を閉じる。
+// HTTPサーバーと定期実行ジョブの終了は合わせてshutdownTimeoutまで待つ。
+func (app *App) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
+	defer cancel()
 
-	if err := app.Cron.Start(); err != nil {
-		return err
+	var errs []error
+	if err := app.API.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if err := app.Cron.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to stop cron: %w", err))
+	}
+
+	app.shutdownTracerProvider()
+
+	if err := app.cache.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close cache: %w", err))
+	}
+	if err := app.DB.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close db: %w", err))
 	}
 
-	return app.API.Start()
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+	logger.Info(ctx, "shutdown completed")
+
+	return nil
 }
 
 func InjectApp() (*App, error) {
@@ -43,9 +111,21 @@ func InjectApp() (*App, error) {
 		handlerSet,
 		repositorySet,
 		storageSet,
+		scannerSet,
+		tracingSet,
 
 		newApp,
 	)
 
 	return nil, nil
 }
+
+func (app *App) shutdownTracerProvider() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := app.TracerProvider.Shutdown(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to shutdown tracer provider", slog.Any("error", err))
+	}
+}
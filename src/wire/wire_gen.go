@@ -7,25 +7,156 @@
 package wire
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/google/wire"
 	"github.com/traPtitech/trap-collection-server/src/auth/traQ"
+	"github.com/traPtitech/trap-collection-server/src/cache"
+	"github.com/traPtitech/trap-collection-server/src/cache/redis"
 	"github.com/traPtitech/trap-collection-server/src/cache/ristretto"
 	"github.com/traPtitech/trap-collection-server/src/config"
 	"github.com/traPtitech/trap-collection-server/src/config/v1"
 	"github.com/traPtitech/trap-collection-server/src/handler"
 	"github.com/traPtitech/trap-collection-server/src/handler/cron"
 	"github.com/traPtitech/trap-collection-server/src/handler/session"
-	"github.com/traPtitech/trap-collection-server/src/handler/v2"
+	v2_2 "github.com/traPtitech/trap-collection-server/src/handler/v2"
+	"github.com/traPtitech/trap-collection-server/src/logger"
 	"github.com/traPtitech/trap-collection-server/src/repository"
 	"github.com/traPtitech/trap-collection-server/src/repository/gorm2"
-	v2_2 "github.com/traPtitech/trap-collection-server/src/service/v2"
+	"github.com/traPtitech/trap-collection-server/src/scanner"
+	"github.com/traPtitech/trap-collection-server/src/scanner/clamav"
+	"github.com/traPtitech/trap-collection-server/src/scanner/noop"
+	"github.com/traPtitech/trap-collection-server/src/scanner/rules"
+	"github.com/traPtitech/trap-collection-server/src/service"
+	"github.com/traPtitech/trap-collection-server/src/service/v2"
 	"github.com/traPtitech/trap-collection-server/src/storage"
+	"github.com/traPtitech/trap-collection-server/src/storage/fallback"
 	"github.com/traPtitech/trap-collection-server/src/storage/local"
 	"github.com/traPtitech/trap-collection-server/src/storage/s3"
 	"github.com/traPtitech/trap-collection-server/src/storage/swift"
+	"github.com/traPtitech/trap-collection-server/src/tracing"
+	"log/slog"
+	"time"
 )
 
+// Injectors from cache.go:
+
+func injectRistrettoCache(conf config.CacheRistretto) (*Cache, error) {
+	user, err := ristretto.NewUser(conf)
+	if err != nil {
+		return nil, err
+	}
+	seat, err := ristretto.NewSeat(conf)
+	if err != nil {
+		return nil, err
+	}
+	health := ristretto.NewHealth()
+	cache := newCache(user, seat, health)
+	return cache, nil
+}
+
+func injectRedisCache(conf config.CacheRedis) (*Cache, error) {
+	client, err := redis.NewClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	user, err := redis.NewUser(conf, client)
+	if err != nil {
+		return nil, err
+	}
+	seat, err := redis.NewSeat(conf, client)
+	if err != nil {
+		return nil, err
+	}
+	health := redis.NewHealth(client)
+	cache := newRedisCache(client, user, seat, health)
+	return cache, nil
+}
+
+// Injectors from cli.go:
+
+func InjectCLI() (*CLIApp, error) {
+	app := v1.NewApp()
+	repositoryGorm2 := v1.NewRepositoryGorm2()
+	migration := v1.NewMigration()
+	db, err := gorm2.NewDB(app, repositoryGorm2, migration)
+	if err != nil {
+		return nil, err
+	}
+	adminAuth := gorm2.NewAdminAuth(db)
+	authTraQ := v1.NewAuthTraQ()
+	user, err := traq.NewUser(authTraQ)
+	if err != nil {
+		return nil, err
+	}
+	cache := v1.NewCache()
+	cacheRistretto := v1.NewCacheRistretto()
+	cacheRedis := v1.NewCacheRedis()
+	wireCache, err := cacheSwitch(cache, cacheRistretto, cacheRedis)
+	if err != nil {
+		return nil, err
+	}
+	cacheUser := wireCache.User
+	v2User := v2.NewUser(user, cacheUser)
+	auditLog := gorm2.NewAuditLog(db)
+	v2AuditLog := v2.NewAuditLog(auditLog)
+	v2AdminAuth := v2.NewAdminAuth(db, adminAuth, v2User, v2AuditLog)
+	edition := gorm2.NewEdition(db)
+	productKey := gorm2.NewProductKey(db)
+	accessToken := gorm2.NewAccessToken(db)
+	gameFileV2 := gorm2.NewGameFileV2(db)
+	editionAuth := v2.NewEditionAuth(db, edition, productKey, accessToken, gameFileV2, v2User, v2AuditLog)
+	gamePlayLogV2 := gorm2.NewGamePlayLogV2(db)
+	gameV2 := gorm2.NewGameV2(db)
+	gameVersionV2 := gorm2.NewGameVersionV2(db)
+	gamePlayLog := v2.NewGamePlayLog(db, gamePlayLogV2, edition, gameV2, gameVersionV2, accessToken)
+	seat := gorm2.NewSeat(db)
+	cacheSeat := wireCache.Seat
+	v2Seat := v2.NewSeat(db, seat, cacheSeat)
+	storage := v1.NewStorage()
+	storageSwift := v1.NewStorageSwift()
+	storageLocal := v1.NewStorageLocal()
+	storageS3 := v1.NewStorageS3()
+	wireStorage, err := storageSwitch(storage, storageSwift, storageLocal, storageS3)
+	if err != nil {
+		return nil, err
+	}
+	health := wireStorage.Health
+	gameImageV2 := gorm2.NewGameImageV2(db)
+	gameVideoV2 := gorm2.NewGameVideoV2(db)
+	storageVerification := gorm2.NewStorageVerification(db)
+	objects := wireStorage.Objects
+	v2StorageVerification := v2.NewStorageVerification(gameV2, gameFileV2, gameImageV2, gameVideoV2, storageVerification, objects)
+	gameStorageV2 := gorm2.NewGameStorageV2(db)
+	storageSizeBackfill := v2.NewStorageSizeBackfill(gameStorageV2, objects)
+	cliApp := newCLIApp(v2AdminAuth, editionAuth, gamePlayLog, v2Seat, health, v2StorageVerification, storageSizeBackfill, db, wireCache)
+	return cliApp, nil
+}
+
+func InjectMigration() (*MigrationApp, error) {
+	app := v1.NewApp()
+	repositoryGorm2 := v1.NewRepositoryGorm2()
+	db, err := gorm2.NewDBWithoutMigration(app, repositoryGorm2)
+	if err != nil {
+		return nil, err
+	}
+	migration := v1.NewMigration()
+	gorm2Migration := gorm2.NewMigration(db, repositoryGorm2, migration)
+	migrationApp := newMigrationApp(gorm2Migration, db)
+	return migrationApp, nil
+}
+
+// Injectors from scanner.go:
+
+func injectClamAVScanner(conf config.ScannerClamAV) (scanner.GameFile, error) {
+	gameFile, err := clamav.NewGameFile(conf)
+	if err != nil {
+		return nil, err
+	}
+	return gameFile, nil
+}
+
 // Injectors from storage.go:
 
 func injectSwiftStorage(conf config.StorageSwift) (*Storage, error) {
@@ -36,7 +167,10 @@ func injectSwiftStorage(conf config.StorageSwift) (*Storage, error) {
 	gameImage := swift.NewGameImage(client)
 	gameVideo := swift.NewGameVideo(client)
 	gameFile := swift.NewGameFile(client)
-	storage, err := newStorage(gameImage, gameVideo, gameFile)
+	editionBundle := swift.NewEditionBundle(client)
+	objects := swift.NewObjects(client)
+	health := swift.NewHealth(client)
+	storage, err := newStorage(gameImage, gameVideo, gameFile, editionBundle, objects, health)
 	if err != nil {
 		return nil, err
 	}
@@ -48,19 +182,33 @@ func injectLocalStorage(conf config.StorageLocal) (*Storage, error) {
 	if err != nil {
 		return nil, err
 	}
-	gameImage, err := local.NewGameImage(directoryManager)
+	urlSigner, err := local.NewURLSigner(conf)
 	if err != nil {
 		return nil, err
 	}
-	gameVideo, err := local.NewGameVideo(directoryManager)
+	gameImage, err := local.NewGameImage(directoryManager, urlSigner)
 	if err != nil {
 		return nil, err
 	}
-	gameFile, err := local.NewGameFile(directoryManager)
+	gameVideo, err := local.NewGameVideo(directoryManager, urlSigner)
 	if err != nil {
 		return nil, err
 	}
-	storage, err := newStorage(gameImage, gameVideo, gameFile)
+	gameFile, err := local.NewGameFile(directoryManager, urlSigner)
+	if err != nil {
+		return nil, err
+	}
+	editionBundle, err := local.NewEditionBundle(directoryManager, urlSigner)
+	if err != nil {
+		return nil, err
+	}
+	fileServer := local.NewFileServer(directoryManager, urlSigner)
+	objects, err := local.NewObjects(directoryManager)
+	if err != nil {
+		return nil, err
+	}
+	health := local.NewHealth(directoryManager)
+	storage, err := newLocalStorage(gameImage, gameVideo, gameFile, editionBundle, fileServer, objects, health)
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +223,42 @@ func injectS3Storage(conf config.StorageS3) (*Storage, error) {
 	gameImage := s3.NewGameImage(client)
 	gameVideo := s3.NewGameVideo(client)
 	gameFile := s3.NewGameFile(client)
-	storage, err := newStorage(gameImage, gameVideo, gameFile)
+	editionBundle := s3.NewEditionBundle(client)
+	objects := s3.NewObjects(client)
+	health := s3.NewHealth(client)
+	storage, err := newStorage(gameImage, gameVideo, gameFile, editionBundle, objects, health)
 	if err != nil {
 		return nil, err
 	}
 	return storage, nil
 }
 
+// Injectors from storage_migration.go:
+
+func InjectStorageMigration() (*StorageMigrationApp, error) {
+	storage := v1.NewStorage()
+	storageSwift := v1.NewStorageSwift()
+	storageLocal := v1.NewStorageLocal()
+	storageS3 := v1.NewStorageS3()
+	app := v1.NewApp()
+	repositoryGorm2 := v1.NewRepositoryGorm2()
+	migration := v1.NewMigration()
+	db, err := gorm2.NewDB(app, repositoryGorm2, migration)
+	if err != nil {
+		return nil, err
+	}
+	gameV2 := gorm2.NewGameV2(db)
+	gameFileV2 := gorm2.NewGameFileV2(db)
+	gameImageV2 := gorm2.NewGameImageV2(db)
+	gameVideoV2 := gorm2.NewGameVideoV2(db)
+	storageMigration, err := newStorageMigration(storage, storageSwift, storageLocal, storageS3, gameV2, gameFileV2, gameImageV2, gameVideoV2)
+	if err != nil {
+		return nil, err
+	}
+	storageMigrationApp := newStorageMigrationApp(storageMigration, db)
+	return storageMigrationApp, nil
+}
+
 // Injectors from wire.go:
 
 func InjectApp() (*App, error) {
@@ -91,34 +268,50 @@ func InjectApp() (*App, error) {
 	if err != nil {
 		return nil, err
 	}
-	context := v2.NewContext()
-	v2Session, err := v2.NewSession(sessionSession)
+	repositoryGorm2 := v1.NewRepositoryGorm2()
+	migration := v1.NewMigration()
+	db, err := gorm2.NewDB(app, repositoryGorm2, migration)
 	if err != nil {
 		return nil, err
 	}
-	serviceV2 := v1.NewServiceV2()
-	authTraQ := v1.NewAuthTraQ()
-	user, err := traq.NewUser(authTraQ)
+	gorm2Migration := gorm2.NewMigration(db, repositoryGorm2, migration)
+	storage := v1.NewStorage()
+	storageSwift := v1.NewStorageSwift()
+	storageLocal := v1.NewStorageLocal()
+	storageS3 := v1.NewStorageS3()
+	wireStorage, err := storageSwitch(storage, storageSwift, storageLocal, storageS3)
 	if err != nil {
 		return nil, err
 	}
+	health := wireStorage.Health
+	cache := v1.NewCache()
 	cacheRistretto := v1.NewCacheRistretto()
-	ristrettoUser, err := ristretto.NewUser(cacheRistretto)
+	cacheRedis := v1.NewCacheRedis()
+	wireCache, err := cacheSwitch(cache, cacheRistretto, cacheRedis)
 	if err != nil {
 		return nil, err
 	}
-	v2User := v2_2.NewUser(user, ristrettoUser)
-	oidc, err := traq.NewOIDC(authTraQ)
+	cacheHealth := wireCache.Health
+	status := v2.NewStatus(app, db, gorm2Migration, health, cacheHealth)
+	handlerHealth := handler.NewHealth(status)
+	context := v2_2.NewContext()
+	v2Session, err := v2_2.NewSession(sessionSession)
+	if err != nil {
+		return nil, err
+	}
+	serviceV2 := v1.NewServiceV2()
+	authTraQ := v1.NewAuthTraQ()
+	user, err := traq.NewUser(authTraQ)
 	if err != nil {
 		return nil, err
 	}
-	v2OIDC, err := v2_2.NewOIDC(serviceV2, v2User, oidc)
+	cacheUser := wireCache.User
+	v2User := v2.NewUser(user, cacheUser)
+	oidc, err := traq.NewOIDC(authTraQ)
 	if err != nil {
 		return nil, err
 	}
-	repositoryGorm2 := v1.NewRepositoryGorm2()
-	migration := v1.NewMigration()
-	db, err := gorm2.NewDB(app, repositoryGorm2, migration)
+	v2OIDC, err := v2.NewOIDC(serviceV2, v2User, oidc)
 	if err != nil {
 		return nil, err
 	}
@@ -126,111 +319,404 @@ func InjectApp() (*App, error) {
 	gameV2 := gorm2.NewGameV2(db)
 	gameVersionV2 := gorm2.NewGameVersionV2(db)
 	gameFileV2 := gorm2.NewGameFileV2(db)
-	v2Edition := v2_2.NewEdition(db, edition, gameV2, gameVersionV2, gameFileV2)
+	auditLog := gorm2.NewAuditLog(db)
+	v2AuditLog := v2.NewAuditLog(auditLog)
+	v2Edition := v2.NewEdition(db, edition, gameV2, gameVersionV2, gameFileV2, v2User, v2AuditLog)
 	productKey := gorm2.NewProductKey(db)
 	accessToken := gorm2.NewAccessToken(db)
-	editionAuth := v2_2.NewEditionAuth(db, edition, productKey, accessToken)
+	editionAuth := v2.NewEditionAuth(db, edition, productKey, accessToken, gameFileV2, v2User, v2AuditLog)
 	gameManagementRole := gorm2.NewGameManagementRole(db)
-	gameRole := v2_2.NewGameRole(db, gameV2, gameManagementRole, v2User)
+	gameRoleInvitation := gorm2.NewGameRoleInvitation(db)
+	gameRole := v2.NewGameRole(db, gameV2, gameManagementRole, gameRoleInvitation, v2User, v2AuditLog)
 	adminAuth := gorm2.NewAdminAuth(db)
-	v2AdminAuth := v2_2.NewAdminAuth(db, adminAuth, v2User)
+	v2AdminAuth := v2.NewAdminAuth(db, adminAuth, v2User, v2AuditLog)
 	gameGenre := gorm2.NewGameGenre(db)
-	game := v2_2.NewGame(db, gameV2, gameManagementRole, gameGenre, v2User)
-	checker := v2.NewChecker(context, v2Session, v2OIDC, v2Edition, editionAuth, gameRole, v2AdminAuth, game)
-	oAuth2, err := v2.NewOAuth2(v1Handler, v2Session, v2OIDC)
+	game := v2.NewGame(db, gameV2, gameManagementRole, gameGenre, v2User, v2AuditLog)
+	checker := v2_2.NewChecker(context, v2Session, v2OIDC, v2Edition, editionAuth, gameRole, v2AdminAuth, game)
+	oAuth2, err := v2_2.NewOAuth2(v1Handler, v2Session, v2OIDC)
 	if err != nil {
 		return nil, err
 	}
-	user2 := v2.NewUser(v2Session, v2OIDC)
-	admin := v2.NewAdmin(v2AdminAuth, v2Session)
-	v2Game := v2.NewGame(v2Session, game)
-	v2GameRole := v2.NewGameRole(gameRole, game, v2Session)
-	v2GameGenre := v2_2.NewGameGenre(db, gameGenre)
-	gameGenre2 := v2.NewGameGenre(v2GameGenre, game, v2Session)
+	user2 := v2_2.NewUser(v2Session, v2OIDC)
+	admin := v2_2.NewAdmin(v2AdminAuth, v2Session)
+	v2Game := v2_2.NewGame(v2Session, game)
+	v2GameRole := v2_2.NewGameRole(gameRole, game, v2Session)
+	v2GameGenre := v2.NewGameGenre(db, gameGenre, v2User, v2AuditLog)
+	gameGenre2 := v2_2.NewGameGenre(v2GameGenre, game, v2Session)
 	gameImageV2 := gorm2.NewGameImageV2(db)
 	gameVideoV2 := gorm2.NewGameVideoV2(db)
-	gameVersion := v2_2.NewGameVersion(db, gameV2, gameImageV2, gameVideoV2, gameFileV2, gameVersionV2)
-	v2GameVersion := v2.NewGameVersion(gameVersion)
-	storage := v1.NewStorage()
-	storageSwift := v1.NewStorageSwift()
-	storageLocal := v1.NewStorageLocal()
-	storageS3 := v1.NewStorageS3()
-	wireStorage, err := storageSwitch(storage, storageSwift, storageLocal, storageS3)
+	gameImage := wireStorage.GameImage
+	gameVideo := wireStorage.GameVideo
+	gameFile := wireStorage.GameFile
+	gameVersion := v2.NewGameVersion(db, gameV2, gameImageV2, gameVideoV2, gameFileV2, gameVersionV2, edition, gameImage, gameVideo, gameFile)
+	v2GameVersion := v2_2.NewGameVersion(gameVersion)
+	v1Scanner := v1.NewScanner()
+	scannerClamAV := v1.NewScannerClamAV()
+	scannerGameFile, err := scannerSwitch(v1Scanner, scannerClamAV)
 	if err != nil {
 		return nil, err
 	}
-	gameFile := wireStorage.GameFile
-	v2GameFile := v2_2.NewGameFile(db, gameV2, gameFileV2, gameFile)
-	gameFile2 := v2.NewGameFile(v2GameFile)
-	gameImage := wireStorage.GameImage
-	v2GameImage := v2_2.NewGameImage(db, gameV2, gameImageV2, gameImage)
-	gameImage2 := v2.NewGameImage(v2GameImage)
-	gameVideo := wireStorage.GameVideo
-	v2GameVideo := v2_2.NewGameVideo(db, gameV2, gameVideoV2, gameVideo)
-	gameVideo2 := v2.NewGameVideo(v2GameVideo)
+	gameStorageV2 := gorm2.NewGameStorageV2(db)
+	gameStorage, err := v2.NewGameStorage(serviceV2, db, gameV2, gameStorageV2, v2User, v2AuditLog)
+	if err != nil {
+		return nil, err
+	}
+	v2GameFile, err := v2.NewGameFile(serviceV2, db, gameV2, gameFileV2, gameFile, scannerGameFile, gameStorage, v2User, v2AuditLog)
+	if err != nil {
+		return nil, err
+	}
+	gameFile2 := v2_2.NewGameFile(v2GameFile, v2Session)
+	v2GameImage := v2.NewGameImage(db, gameV2, gameImageV2, gameImage, gameStorage)
+	gameImage2 := v2_2.NewGameImage(v2GameImage)
+	v2GameVideo := v2.NewGameVideo(db, gameV2, gameVideoV2, gameVideo, gameStorage)
+	gameVideo2 := v2_2.NewGameVideo(v2GameVideo)
+	v2GameStorage := v2_2.NewGameStorage(gameStorage, v2Session)
 	gamePlayLogV2 := gorm2.NewGamePlayLogV2(db)
-	gamePlayLog := v2_2.NewGamePlayLog(db, gamePlayLogV2, edition, gameV2, gameVersionV2)
-	v2GamePlayLog := v2.NewGamePlayLog(gamePlayLog)
+	gamePlayLog := v2.NewGamePlayLog(db, gamePlayLogV2, edition, gameV2, gameVersionV2, accessToken)
+	v2GamePlayLog := v2_2.NewGamePlayLog(context, gamePlayLog)
 	gameCreator := gorm2.NewGameCreator(db)
-	v2GameCreator := v2_2.NewGameCreator(gameCreator, gameV2, db, v2User)
-	gameCreator2 := v2.NewGameCreator(v2GameCreator)
+	v2GameCreator := v2.NewGameCreator(gameCreator, gameV2, db, v2User)
+	gameCreator2 := v2_2.NewGameCreator(v2GameCreator)
 	gameFeedback := gorm2.NewGameFeedback(db)
-	v2GameFeedback := v2_2.NewGameFeedback(gameV2, gameFeedback)
-	gameFeedback2 := v2.NewGameFeedback(v2GameFeedback)
-	edition2 := v2.NewEdition(v2Edition)
-	v2EditionAuth := v2.NewEditionAuth(context, editionAuth)
+	v2GameFeedback := v2.NewGameFeedback(gameV2, gameFeedback)
+	gameFeedback2 := v2_2.NewGameFeedback(v2GameFeedback)
+	edition2 := v2_2.NewEdition(v2Session, v2Edition)
+	v2EditionAuth := v2_2.NewEditionAuth(context, v2Session, editionAuth)
+	editionBundle := gorm2.NewEditionBundle(db)
+	storageEditionBundle := wireStorage.EditionBundle
+	v2EditionBundle, err := v2.NewEditionBundle(serviceV2, edition, editionBundle, gameImageV2, gameVideoV2, gameFileV2, gameImage, gameVideo, gameFile, storageEditionBundle)
+	if err != nil {
+		return nil, err
+	}
+	editionBundle2 := v2_2.NewEditionBundle(v2EditionBundle)
 	seat := gorm2.NewSeat(db)
-	ristrettoSeat, err := ristretto.NewSeat(cacheRistretto)
+	cacheSeat := wireCache.Seat
+	v2Seat := v2.NewSeat(db, seat, cacheSeat)
+	seat2 := v2_2.NewSeat(v2Seat)
+	auditLog2 := v2_2.NewAuditLog(v2AuditLog)
+	v2Status := v2_2.NewStatus(status)
+	storageVerification := gorm2.NewStorageVerification(db)
+	objects := wireStorage.Objects
+	v2StorageVerification := v2.NewStorageVerification(gameV2, gameFileV2, gameImageV2, gameVideoV2, storageVerification, objects)
+	storageVerification2 := v2_2.NewStorageVerification(v2StorageVerification)
+	handlerRateLimit := v1.NewHandlerRateLimit()
+	memoryRateLimitStore := v2_2.NewMemoryRateLimitStore()
+	rateLimit, err := v2_2.NewRateLimit(handlerRateLimit, memoryRateLimitStore)
 	if err != nil {
 		return nil, err
 	}
-	v2Seat := v2_2.NewSeat(db, seat, ristrettoSeat)
-	seat2 := v2.NewSeat(v2Seat)
-	api := v2.NewAPI(checker, v2Session, oAuth2, user2, admin, v2Game, v2GameRole, gameGenre2, v2GameVersion, gameFile2, gameImage2, gameVideo2, v2GamePlayLog, gameCreator2, gameFeedback2, edition2, v2EditionAuth, seat2)
-	handlerAPI, err := handler.NewAPI(app, v1Handler, sessionSession, api)
+	api := v2_2.NewAPI(checker, v2Session, oAuth2, user2, admin, v2Game, v2GameRole, gameGenre2, v2GameVersion, gameFile2, gameImage2, gameVideo2, v2GameStorage, v2GamePlayLog, gameCreator2, gameFeedback2, edition2, v2EditionAuth, editionBundle2, seat2, auditLog2, v2Status, storageVerification2, rateLimit)
+	fileServer := wireStorage.FileServer
+	handlerAPI, err := handler.NewAPI(app, v1Handler, sessionSession, handlerHealth, api, fileServer)
+	if err != nil {
+		return nil, err
+	}
+	cronCron := cron.NewCron(gamePlayLog, v2GameImage, v2GameFile, v2EditionBundle, v2StorageVerification, status)
+	v1Tracing := v1.NewTracing()
+	tracerProvider, err := tracing.NewTracerProvider(v1Tracing)
+	if err != nil {
+		return nil, err
+	}
+	wireApp, err := newApp(app, handlerAPI, cronCron, db, tracerProvider, wireCache)
 	if err != nil {
 		return nil, err
 	}
-	cronCron := cron.NewCron(gamePlayLog)
-	wireApp := newApp(handlerAPI, cronCron, db)
 	return wireApp, nil
 }
 
+// cache.go:
+
+var cacheSet = wire.NewSet(wire.FieldsOf(new(*Cache), "User"), wire.FieldsOf(new(*Cache), "Seat"), wire.FieldsOf(new(*Cache), "Health"), cacheSwitch)
+
+type Cache struct {
+	User   cache.User
+	Seat   cache.Seat
+	Health cache.Health
+	// close
+	// キャッシュのバックエンドとの接続を閉じる。
+	// プロセス内のキャッシュなど、閉じるものが無い場合はnil。
+	close func() error
+}
+
+func newCache(user cache.User, seat cache.Seat, health cache.Health) *Cache {
+	return &Cache{
+		User:   user,
+		Seat:   seat,
+		Health: health,
+	}
+}
+
+func newRedisCache(client *redis.Client, user cache.User, seat cache.Seat, health cache.Health) *Cache {
+	c := newCache(user, seat, health)
+	c.close = client.Close
+
+	return c
+}
+
+// Close
+// キャッシュのバックエンドとの接続を閉じる。
+func (c *Cache) Close() error {
+	if c.close == nil {
+		return nil
+	}
+
+	return c.close()
+}
+
+func cacheSwitch(
+	conf config.Cache,
+	ristrettoConf config.CacheRistretto,
+	redisConf config.CacheRedis,
+) (*Cache, error) {
+	cacheType, err := conf.Type()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache type: %w", err)
+	}
+
+	switch cacheType {
+	case config.CacheTypeRistretto:
+		return injectRistrettoCache(ristrettoConf)
+	case config.CacheTypeRedis:
+		return injectRedisCache(redisConf)
+	}
+
+	return nil, fmt.Errorf("unknown cache type: %d", cacheType)
+}
+
+// cli.go:
+
+// CLIApp
+// 運用のためのサブコマンドから使うservice。
+// HTTPサーバーと定期実行ジョブは起動しない。
+type CLIApp struct {
+	AdminAuth           service.AdminAuthV2
+	EditionAuth         service.EditionAuth
+	GamePlayLog         service.GamePlayLogV2
+	Seat                service.Seat
+	StorageHealth       storage.Health
+	StorageVerification service.StorageVerification
+	StorageSizeBackfill service.StorageSizeBackfill
+	repository.DB
+
+	cache *Cache
+}
+
+func newCLIApp(
+	adminAuth service.AdminAuthV2,
+	editionAuth service.EditionAuth,
+	gamePlayLog service.GamePlayLogV2,
+	seat service.Seat,
+	storageHealth storage.Health,
+	storageVerification service.StorageVerification,
+	storageSizeBackfill service.StorageSizeBackfill,
+	db repository.DB, cache2 *Cache,
+) *CLIApp {
+	return &CLIApp{
+		AdminAuth:           adminAuth,
+		EditionAuth:         editionAuth,
+		GamePlayLog:         gamePlayLog,
+		Seat:                seat,
+		StorageHealth:       storageHealth,
+		StorageVerification: storageVerification,
+		StorageSizeBackfill: storageSizeBackfill,
+		DB:                  db,
+		cache:               cache2,
+	}
+}
+
+// Close
+// キャッシュとDBとの接続を閉じる。
+func (app *CLIApp) Close() error {
+	var errs []error
+	if err := app.cache.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close cache: %w", err))
+	}
+	if err := app.DB.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close db: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// MigrationApp
+// マイグレーションの状態の確認と適用に使う。
+// 接続時にマイグレーションを適用しないよう、repositorySetは使わない。
+type MigrationApp struct {
+	repository.Migration
+	repository.DB
+}
+
+func newMigrationApp(migration repository.Migration, db repository.DB) *MigrationApp {
+	return &MigrationApp{
+		Migration: migration,
+		DB:        db,
+	}
+}
+
+// scanner.go:
+
+var scannerSet = wire.NewSet(
+	scannerSwitch,
+)
+
+func scannerSwitch(conf config.Scanner, clamAVConf config.ScannerClamAV) (scanner.GameFile, error) {
+	scannerType, err := conf.Type()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scanner type: %w", err)
+	}
+
+	switch scannerType {
+	case config.ScannerTypeNone:
+		return noop.NewGameFile(), nil
+	case config.ScannerTypeRules:
+		return rules.NewGameFile(), nil
+	case config.ScannerTypeClamAV:
+		return injectClamAVScanner(clamAVConf)
+	}
+
+	return nil, fmt.Errorf("unknown scanner type: %d", scannerType)
+}
+
 // storage.go:
 
 var (
-	storageSet = wire.NewSet(wire.FieldsOf(new(*Storage), "GameImage"), wire.FieldsOf(new(*Storage), "GameVideo"), wire.FieldsOf(new(*Storage), "GameFile"), storageSwitch)
+	storageSet = wire.NewSet(wire.FieldsOf(new(*Storage), "GameImage"), wire.FieldsOf(new(*Storage), "GameVideo"), wire.FieldsOf(new(*Storage), "GameFile"), wire.FieldsOf(new(*Storage), "FileServer"), wire.FieldsOf(new(*Storage), "EditionBundle"), wire.FieldsOf(new(*Storage), "Objects"), wire.FieldsOf(new(*Storage), "Health"), storageSwitch)
 )
 
 type Storage struct {
 	GameImage storage.GameImage
 	GameVideo storage.GameVideo
 	GameFile  storage.GameFile
+	// EditionBundle オフライン配布用のエディションのバンドル
+	EditionBundle storage.EditionBundle
+	// FileServer ストレージ自身がファイルを配信しない場合はnil
+	FileServer storage.FileServer
+	// Objects ストレージ間の移行・ストレージの検証用
+	Objects storage.Objects
+	// Health readiness確認用
+	Health storage.Health
 }
 
 func newStorage(
 	gameImage storage.GameImage,
 	gameVideo storage.GameVideo,
 	gameFile storage.GameFile,
+	editionBundle storage.EditionBundle,
+	objects storage.Objects,
+	health storage.Health,
+) (*Storage, error) {
+	return &Storage{
+		GameImage:     gameImage,
+		GameVideo:     gameVideo,
+		GameFile:      gameFile,
+		EditionBundle: editionBundle,
+		Objects:       objects,
+		Health:        health,
+	}, nil
+}
+
+func newLocalStorage(
+	gameImage storage.GameImage,
+	gameVideo storage.GameVideo,
+	gameFile storage.GameFile,
+	editionBundle storage.EditionBundle,
+	fileServer storage.FileServer,
+	objects storage.Objects,
+	health storage.Health,
 ) (*Storage, error) {
 	return &Storage{
-		GameImage: gameImage,
-		GameVideo: gameVideo,
-		GameFile:  gameFile,
+		GameImage:     gameImage,
+		GameVideo:     gameVideo,
+		GameFile:      gameFile,
+		EditionBundle: editionBundle,
+		FileServer:    fileServer,
+		Objects:       objects,
+		Health:        health,
 	}, nil
 }
 
+// newFallbackStorage
+// primaryに存在しないファイルをsecondaryから読み込むストレージを作る。
+// 書き込みはprimaryにのみ行う。
+func newFallbackStorage(primary *Storage, secondary *Storage) *Storage {
+	fileServer := primary.FileServer
+	if fileServer == nil {
+		fileServer = secondary.FileServer
+	}
+
+	return &Storage{
+		GameImage:     fallback.NewGameImage(primary.GameImage, secondary.GameImage),
+		GameVideo:     fallback.NewGameVideo(primary.GameVideo, secondary.GameVideo),
+		GameFile:      fallback.NewGameFile(primary.GameFile, secondary.GameFile),
+		EditionBundle: fallback.NewEditionBundle(primary.EditionBundle, secondary.EditionBundle),
+		FileServer:    fileServer,
+		Objects:       fallback.NewObjects(primary.Objects, secondary.Objects),
+		Health:        fallback.NewHealth(primary.Health, secondary.Health),
+	}
+}
+
 func storageSwitch(
 	conf config.Storage,
 	swiftConf config.StorageSwift,
 	localConf config.StorageLocal,
 	s3Conf config.StorageS3,
 ) (*Storage, error) {
+	primary, secondary, err := storagePair(conf, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if secondary == nil {
+		return primary, nil
+	}
+
+	return newFallbackStorage(primary, secondary), nil
+}
+
+// storagePair
+// STORAGEのストレージと、STORAGE_FALLBACKのストレージを作る。
+// STORAGE_FALLBACKが設定されていない場合、secondaryはnil。
+func storagePair(
+	conf config.Storage,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+) (primary *Storage, secondary *Storage, err error) {
 	storageType, err := conf.Type()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get storage type: %w", err)
+		return nil, nil, fmt.Errorf("failed to get storage type: %w", err)
+	}
+
+	primary, err = injectStorageByType(storageType, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fallbackType, ok, err := conf.FallbackType()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fallback storage type: %w", err)
+	}
+	if !ok {
+		return primary, nil, nil
+	}
+	if fallbackType == storageType {
+		return nil, nil, fmt.Errorf("fallback storage type must differ from storage type: %d", storageType)
+	}
+
+	secondary, err = injectStorageByType(fallbackType, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to inject fallback storage: %w", err)
 	}
 
+	return primary, secondary, nil
+}
+
+func injectStorageByType(
+	storageType config.StorageType,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+) (*Storage, error) {
 	switch storageType {
 	case config.StorageTypeSwift:
 		return injectSwiftStorage(swiftConf)
@@ -243,29 +729,144 @@ func storageSwitch(
 	return nil, fmt.Errorf("unknown storage type: %d", storageType)
 }
 
+// storage_migration.go:
+
+type StorageMigrationApp struct {
+	service.StorageMigration
+	repository.DB
+}
+
+func newStorageMigrationApp(storageMigration service.StorageMigration, db repository.DB) *StorageMigrationApp {
+	return &StorageMigrationApp{
+		StorageMigration: storageMigration,
+		DB:               db,
+	}
+}
+
+// newStorageMigration
+// STORAGE_FALLBACKのストレージを移行元、STORAGEのストレージを移行先とする。
+// 移行元と移行先が同じ型のため、wireに任せず手で組み立てる。
+func newStorageMigration(
+	conf config.Storage,
+	swiftConf config.StorageSwift,
+	localConf config.StorageLocal,
+	s3Conf config.StorageS3,
+	gameRepository repository.GameV2,
+	gameFileRepository repository.GameFileV2,
+	gameImageRepository repository.GameImageV2,
+	gameVideoRepository repository.GameVideoV2,
+) (*v2.StorageMigration, error) {
+	primary, secondary, err := storagePair(conf, swiftConf, localConf, s3Conf)
+	if err != nil {
+		return nil, err
+	}
+	if secondary == nil {
+		return nil, errors.New("STORAGE_FALLBACK is not set")
+	}
+
+	return v2.NewStorageMigration(
+		gameRepository,
+		gameFileRepository,
+		gameImageRepository,
+		gameVideoRepository,
+		secondary.Objects,
+		primary.Objects,
+	), nil
+}
+
 // wire.go:
 
 type App struct {
 	*handler.API
 	*cron.Cron
 	repository.DB
+
+	*tracing.TracerProvider
+	cache           *Cache
+	shutdownTimeout time.Duration
 }
 
-func newApp(api *handler.API, cronHandler *cron.Cron, db repository.DB) *App {
+func newApp(appConf config.App, api *handler.API, cronHandler *cron.Cron, db repository.DB, tracerProvider *tracing.TracerProvider, cache2 *Cache) (*App, error) {
+	shutdownTimeout, err := appConf.ShutdownTimeout()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shutdown timeout: %w", err)
+	}
+
 	return &App{
-		API:  api,
-		Cron: cronHandler,
-		DB:   db,
+		API:             api,
+		Cron:            cronHandler,
+		DB:              db,
+		TracerProvider:  tracerProvider,
+		cache:           cache2,
+		shutdownTimeout: shutdownTimeout,
+	}, nil
+}
+
+// Run
+// ctxがキャンセルされるまでHTTPサーバーと定期実行ジョブを動かし、その後終了処理を行う。
+// HTTPサーバーが異常終了した場合も終了処理を行う。
+func (app *App) Run(ctx context.Context) error {
+	if err := app.Cron.Start(); err != nil {
+		return errors.Join(err, app.shutdown())
 	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- app.API.Start()
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		logger.Info(ctx, "shutting down", slog.Duration("timeout", app.shutdownTimeout))
+	case err = <-serverErr:
+		if err != nil {
+			err = fmt.Errorf("http server stopped: %w", err)
+		}
+	}
+
+	return errors.Join(err, app.shutdown())
 }
 
-func (app *App) Run() error {
-	defer app.DB.Close()
-	defer app.Cron.Stop()
+// shutdown
+// 新しいリクエストとジョブの受付を止め、処理中のものの完了を待ってから、
+// 未送信のトレースを送信してキャッシュとDBとの接続を閉じる。
+// HTTPサーバーと定期実行ジョブの終了は合わせてshutdownTimeoutまで待つ。
+func (app *App) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
+	defer cancel()
 
-	if err := app.Cron.Start(); err != nil {
-		return err
+	var errs []error
+	if err := app.API.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
 	}
+	if err := app.Cron.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to stop cron: %w", err))
+	}
+
+	app.shutdownTracerProvider()
 
-	return app.API.Start()
+	if err := app.cache.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close cache: %w", err))
+	}
+	if err := app.DB.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close db: %w", err))
+	}
+
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+	logger.Info(ctx, "shutdown completed")
+
+	return nil
+}
+
+func (app *App) shutdownTracerProvider() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := app.TracerProvider.Shutdown(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to shutdown tracer provider", slog.Any("error", err))
+	}
 }